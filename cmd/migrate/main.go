@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/logger"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// main applies the migrations to the main database and then to every registered tenant.
+// Each tenant schema keeps its own goose version table, so tenants can be migrated independently.
+func main() {
+	dir := flag.String("dir", "migrations", "directory with SQL migrations")
+	only := flag.String("tenant", "", "migrate only this tenant")
+	flag.Parse()
+
+	ctx := context.Background()
+	cfg := config.Must()
+	log, _ := logger.CreateLogger(cfg.Logger)
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		log.Fatal("error setting migration dialect", zap.Error(err))
+	}
+
+	// The main database comes first: it also provides shared extensions to tenant schemas.
+	if *only == "" {
+		if err := migrate(ctx, config.Tenant{ID: "main"}, cfg.DatabaseURL(), *dir); err != nil {
+			log.Fatal("error migrating main database", zap.Error(err))
+		}
+		log.Info("migrated main database")
+	}
+
+	if !cfg.Tenancy.Enabled {
+		return
+	}
+
+	for _, t := range cfg.Tenancy.Tenants {
+		if *only != "" && t.ID != *only {
+			continue
+		}
+
+		if err := migrate(ctx, t, cfg.DatabaseURL(), *dir); err != nil {
+			log.Fatal("error migrating tenant", zap.String("tenant", t.ID), zap.Error(err))
+		}
+		log.Info("migrated tenant", zap.String("tenant", t.ID), zap.String("schema", t.Schema))
+	}
+}
+
+// migrate creates the tenant schema if needed and applies all pending migrations in it.
+//
+// Parameters:
+//   - ctx: Context for the migration.
+//   - t: The tenant to migrate.
+//   - mainURL: The main database URL, used when the tenant has no database of its own.
+//   - dir: The directory with SQL migrations.
+//
+// Returns:
+//   - An error if connecting, creating the schema, or migrating fails.
+func migrate(ctx context.Context, t config.Tenant, mainURL, dir string) error {
+	poolCfg, err := tenancy.PoolConfig(t, mainURL)
+	if err != nil {
+		return err
+	}
+
+	db := stdlib.OpenDB(*poolCfg.ConnConfig)
+	defer func(db *sql.DB) {
+		_ = db.Close()
+	}(db)
+
+	if t.Schema != "" {
+		if _, err := db.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{t.Schema}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to create schema %s: %w", t.Schema, err)
+		}
+	}
+
+	if err := goose.UpContext(ctx, db, dir); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return nil
+}
@@ -5,29 +5,127 @@ import (
 	"errors"
 	"net/http"
 	"os/signal"
-	"strconv"
 	"syscall"
 	"time"
 
-	"github.com/aliskhannn/delayed-notifier/pkg/email"
-	"github.com/go-playground/validator/v10"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
+	adminhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/admin"
+	archiveformathandler "github.com/aliskhannn/calendar-service/internal/api/handlers/archiveformat"
+	attendeehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/attendee"
 	authhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/auth"
+	calendarhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/calendar"
+	coldstoragehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/coldstorage"
+	contacthandler "github.com/aliskhannn/calendar-service/internal/api/handlers/contact"
+	delegatehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/delegate"
+	demohandler "github.com/aliskhannn/calendar-service/internal/api/handlers/demo"
+	embedhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/embed"
 	eventhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/event"
+	exporthandler "github.com/aliskhannn/calendar-service/internal/api/handlers/export"
+	feedhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/feed"
+	followerhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/follower"
+	grouphandler "github.com/aliskhannn/calendar-service/internal/api/handlers/group"
+	importhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/imports"
+	jobhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/job"
+	machinehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/machine"
+	metahandler "github.com/aliskhannn/calendar-service/internal/api/handlers/meta"
+	notehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/note"
+	notificationhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/notification"
+	onboardinghandler "github.com/aliskhannn/calendar-service/internal/api/handlers/onboarding"
+	preferencehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/preference"
+	projecthandler "github.com/aliskhannn/calendar-service/internal/api/handlers/project"
+	proposalhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/proposal"
+	reminderhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/reminder"
+	reporthandler "github.com/aliskhannn/calendar-service/internal/api/handlers/report"
+	rulehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/rule"
+	shortlinkhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/shortlink"
+	tzmigrationhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/tzmigration"
+	usagehandler "github.com/aliskhannn/calendar-service/internal/api/handlers/usage"
+	viewhandler "github.com/aliskhannn/calendar-service/internal/api/handlers/view"
 	"github.com/aliskhannn/calendar-service/internal/api/router"
 	"github.com/aliskhannn/calendar-service/internal/api/server"
+	"github.com/aliskhannn/calendar-service/internal/buildinfo"
+	"github.com/aliskhannn/calendar-service/internal/captcha"
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/coldstorage"
 	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/dbtrace"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
 	"github.com/aliskhannn/calendar-service/internal/logger"
+	"github.com/aliskhannn/calendar-service/internal/maintenance"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/priority"
+	"github.com/aliskhannn/calendar-service/internal/reporter"
+	attendeerepo "github.com/aliskhannn/calendar-service/internal/repository/attendee"
+	calendarrepo "github.com/aliskhannn/calendar-service/internal/repository/calendar"
+	coldstoragerepo "github.com/aliskhannn/calendar-service/internal/repository/coldstorage"
+	contactrepo "github.com/aliskhannn/calendar-service/internal/repository/contact"
+	datakeyrepo "github.com/aliskhannn/calendar-service/internal/repository/datakey"
+	delegaterepo "github.com/aliskhannn/calendar-service/internal/repository/delegate"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
 	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+	followerrepo "github.com/aliskhannn/calendar-service/internal/repository/follower"
+	grouprepo "github.com/aliskhannn/calendar-service/internal/repository/group"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	noterepo "github.com/aliskhannn/calendar-service/internal/repository/note"
+	notificationrepo "github.com/aliskhannn/calendar-service/internal/repository/notification"
+	onboardingrepo "github.com/aliskhannn/calendar-service/internal/repository/onboarding"
+	preferencerepo "github.com/aliskhannn/calendar-service/internal/repository/preference"
+	projectrepo "github.com/aliskhannn/calendar-service/internal/repository/project"
+	proposalrepo "github.com/aliskhannn/calendar-service/internal/repository/proposal"
+	reminderrepo "github.com/aliskhannn/calendar-service/internal/repository/reminder"
+	reportrepo "github.com/aliskhannn/calendar-service/internal/repository/report"
+	rulerepo "github.com/aliskhannn/calendar-service/internal/repository/rule"
+	securityrepo "github.com/aliskhannn/calendar-service/internal/repository/security"
+	shortlinkrepo "github.com/aliskhannn/calendar-service/internal/repository/shortlink"
+	suggestionrepo "github.com/aliskhannn/calendar-service/internal/repository/suggestion"
+	tzmigrationrepo "github.com/aliskhannn/calendar-service/internal/repository/tzmigration"
+	usagerepo "github.com/aliskhannn/calendar-service/internal/repository/usage"
 	userrepo "github.com/aliskhannn/calendar-service/internal/repository/user"
+	viewrepo "github.com/aliskhannn/calendar-service/internal/repository/view"
+	archiveformatsvc "github.com/aliskhannn/calendar-service/internal/service/archiveformat"
+	attendeesvc "github.com/aliskhannn/calendar-service/internal/service/attendee"
+	calendarsvc "github.com/aliskhannn/calendar-service/internal/service/calendar"
+	coldstoragesvc "github.com/aliskhannn/calendar-service/internal/service/coldstorage"
+	contactsvc "github.com/aliskhannn/calendar-service/internal/service/contact"
+	delegatesvc "github.com/aliskhannn/calendar-service/internal/service/delegate"
+	embedsvc "github.com/aliskhannn/calendar-service/internal/service/embed"
 	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
+	eventchangesvc "github.com/aliskhannn/calendar-service/internal/service/eventchange"
+	exportsvc "github.com/aliskhannn/calendar-service/internal/service/export"
+	feedsvc "github.com/aliskhannn/calendar-service/internal/service/feed"
+	followersvc "github.com/aliskhannn/calendar-service/internal/service/follower"
+	groupsvc "github.com/aliskhannn/calendar-service/internal/service/group"
+	importsvc "github.com/aliskhannn/calendar-service/internal/service/imports"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+	machinesvc "github.com/aliskhannn/calendar-service/internal/service/machine"
+	notesvc "github.com/aliskhannn/calendar-service/internal/service/note"
+	notificationsvc "github.com/aliskhannn/calendar-service/internal/service/notification"
+	onboardingsvc "github.com/aliskhannn/calendar-service/internal/service/onboarding"
+	preferencesvc "github.com/aliskhannn/calendar-service/internal/service/preference"
+	projectsvc "github.com/aliskhannn/calendar-service/internal/service/project"
+	proposalsvc "github.com/aliskhannn/calendar-service/internal/service/proposal"
+	remindersvc "github.com/aliskhannn/calendar-service/internal/service/reminder"
+	reportsvc "github.com/aliskhannn/calendar-service/internal/service/report"
+	rulesvc "github.com/aliskhannn/calendar-service/internal/service/rule"
+	shortlinksvc "github.com/aliskhannn/calendar-service/internal/service/shortlink"
+	suggestionsvc "github.com/aliskhannn/calendar-service/internal/service/suggestion"
+	tzmigrationsvc "github.com/aliskhannn/calendar-service/internal/service/tzmigration"
+	usagesvc "github.com/aliskhannn/calendar-service/internal/service/usage"
 	usersvc "github.com/aliskhannn/calendar-service/internal/service/user"
+	viewsvc "github.com/aliskhannn/calendar-service/internal/service/view"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+	"github.com/aliskhannn/calendar-service/internal/validation"
 	"github.com/aliskhannn/calendar-service/internal/worker/archiver"
+	demoworker "github.com/aliskhannn/calendar-service/internal/worker/demo"
+	jobworker "github.com/aliskhannn/calendar-service/internal/worker/job"
 	"github.com/aliskhannn/calendar-service/internal/worker/reminder"
+	reportworker "github.com/aliskhannn/calendar-service/internal/worker/report"
+	suggestionworker "github.com/aliskhannn/calendar-service/internal/worker/suggestion"
 )
 
 func main() {
@@ -39,62 +137,218 @@ func main() {
 	cfg := config.Must()
 
 	// Initialize logger and validator.
-	log := logger.CreateLogger()
-	val := validator.New()
+	log, logLevel := logger.CreateLogger(cfg.Logger)
+	val := validation.New(cfg.Validation)
 
-	// Connect to database.
-	dbPool, err := pgxpool.New(ctx, cfg.DatabaseURL())
+	// Initialize error reporting and report error logs from all components.
+	rep, err := reporter.New(cfg.Reporting)
+	if err != nil {
+		log.Fatal("error initializing error reporting", zap.Error(err))
+	}
+	defer rep.Flush(2 * time.Second)
+
+	log = log.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(c, logger.Redact(rep.Core(), cfg.Logger.StrictRedaction))
+	}))
+
+	// Connect to database; with tenancy enabled, queries are routed to the tenant's database or schema.
+	// Every query is recorded in the metrics, and slow ones are logged.
+	dbPool, err := tenancy.Open(ctx, cfg, dbtrace.New(cfg.Database.SlowQueryThreshold, log))
 	if err != nil {
 		log.Fatal("error creating connection pool", zap.Error(err))
 	}
 
+	// Time source of all time-dependent components.
+	clk := clock.Real()
+
 	// Repositories.
 	userRepo := userrepo.New(dbPool)
-	eventRepo := eventrepo.New(dbPool)
+	eventRepo := eventrepo.New(dbPool, clk)
+	reminderRepo := reminderrepo.New(dbPool)
+	projectRepo := projectrepo.New(dbPool)
+	usageRepo := usagerepo.New(dbPool)
+	dataKeyRepo := datakeyrepo.New(dbPool)
+	securityRepo := securityrepo.New(dbPool)
+	viewRepo := viewrepo.New(dbPool)
+	notificationRepo := notificationrepo.New(dbPool)
+	jobRepo := jobrepo.New(dbPool)
+	ruleRepo := rulerepo.New(dbPool)
+	suggestionRepo := suggestionrepo.New(dbPool)
+	embedRepo := embedrepo.New(dbPool)
+	shortLinkRepo := shortlinkrepo.New(dbPool)
+	feedRepo := feedrepo.New(dbPool)
+	onboardingRepo := onboardingrepo.New(dbPool)
+	delegateRepo := delegaterepo.New(dbPool)
+	attendeeRepo := attendeerepo.New(dbPool)
+	preferenceRepo := preferencerepo.New(dbPool)
+	followerRepo := followerrepo.New(dbPool)
+	proposalRepo := proposalrepo.New(dbPool, clk)
+	noteRepo := noterepo.New(dbPool)
+	calendarRepo := calendarrepo.New(dbPool)
+	tzMigrationRepo := tzmigrationrepo.New(dbPool)
+	coldStorageRepo := coldstoragerepo.New(dbPool)
+	contactRepo := contactrepo.New(dbPool)
+	groupRepo := grouprepo.New(dbPool)
+	reportRepo := reportrepo.New(dbPool)
 
-	// Repositories.
-	userSvc := usersvc.New(userRepo, cfg)
-	eventSvc := eventsvc.New(eventRepo)
+	// Encryption of event content at rest with per-user data keys.
+	contentCipher, err := encryption.New(cfg.Encryption, dataKeyRepo)
+	if err != nil {
+		log.Fatal("error initializing encryption", zap.Error(err))
+	}
+
+	// Email delivery provider for reminders, reporting bounces and complaints to the notification log.
+	emailProvider, err := email.New(cfg.Email, clk)
+	if err != nil {
+		log.Fatal("error initializing email provider", zap.Error(err))
+	}
+	emailProvider = email.NewThrottled(emailProvider, cfg.Email.Throttle, clk)
+
+	// Services.
+	userSvc := usersvc.New(userRepo, securityRepo, cfg, clk)
+	ruleSvc := rulesvc.New(ruleRepo, viewRepo, contentCipher, clk)
+	preferenceSvc := preferencesvc.New(preferenceRepo, cfg.Unsubscribe)
+	eventChangeSvc := eventchangesvc.New(attendeeRepo, preferenceSvc, emailProvider, log)
+	eventSvc := eventsvc.New(eventRepo, cfg.Event, contentCipher, ruleSvc, eventChangeSvc)
+	reminderSvc := remindersvc.New(reminderRepo, cfg.Reminder, contentCipher, emailProvider, userSvc, clk)
+	projectSvc := projectsvc.New(projectRepo, contentCipher)
+	usageSvc := usagesvc.New(usageRepo, cfg.Usage)
+	viewSvc := viewsvc.New(viewRepo, contentCipher, clk)
+	notificationSvc := notificationsvc.New(notificationRepo)
+	jobSvc := jobsvc.New(jobRepo, cfg.Job, clk)
+	importSvc := importsvc.New(jobSvc, eventSvc, projectSvc, cfg.Import, clk, log)
+	exportSvc := exportsvc.New(eventSvc, jobSvc, cfg.Export)
+	tzMigrationSvc := tzmigrationsvc.New(tzMigrationRepo, jobSvc)
+	archiveFormatSvc := archiveformatsvc.New(eventRepo, jobSvc)
+	coldStorageSvc := coldstoragesvc.New(coldStorageRepo, coldstorage.NewS3(cfg.Archiver.ColdStorage, clk), jobSvc, cfg.Archiver.ColdStorage, clk)
+	suggestionSvc := suggestionsvc.New(suggestionRepo, projectRepo, contentCipher, cfg.Suggestion)
+	embedSvc := embedsvc.New(embedRepo, viewRepo, contentCipher, cfg.Embed, clk)
+	shortLinkSvc := shortlinksvc.New(shortLinkRepo, contentCipher, cfg.ShortLink, clk)
+	feedSvc := feedsvc.New(feedRepo, eventRepo, contentCipher, cfg.Feed, clk)
+	onboardingSvc := onboardingsvc.New(onboardingRepo, projectSvc, eventSvc, viewSvc, clk)
+	delegateSvc := delegatesvc.New(delegateRepo)
+	attendeeSvc := attendeesvc.New(attendeeRepo, cfg.Invitation, contentCipher, eventChangeSvc, emailProvider, log)
+	followerSvc := followersvc.New(followerRepo, contentCipher)
+	proposalSvc := proposalsvc.New(proposalRepo, contentCipher, emailProvider, userSvc, log)
+	noteSvc := notesvc.New(noteRepo, contentCipher)
+	calendarSvc := calendarsvc.New(calendarRepo)
+	contactSvc := contactsvc.New(contactRepo)
+	groupSvc := groupsvc.New(groupRepo)
+	reportSvc := reportsvc.New(reportRepo, cfg.Report, clk)
+	machineSvc := machinesvc.New(cfg.Machine, cfg.JWT, clk)
 
-	// Reminder channel.
-	reminderCh := make(chan model.Reminder, 100)
+	// Runners of the background job kinds.
+	jobSvc.Register(model.JobCalendarImport, importSvc)
+	jobSvc.Register(model.JobPDFExport, exportSvc)
+	jobSvc.Register(model.JobTimezoneMigration, tzMigrationSvc)
+	jobSvc.Register(model.JobColdStorageRestore, coldStorageSvc)
+	jobSvc.Register(model.JobArchiveVerification, archiveFormatSvc)
 
 	// HTTP Handlers.
 	authHandler := authhandler.New(userSvc, log, val)
-	eventHandler := eventhandler.New(eventSvc, reminderCh, log, val)
-
-	// Email client for reminders.
-	smtpPort, err := strconv.Atoi(cfg.Email.SMTPPort)
-	if err != nil {
-		log.Fatal("error parsing SMTP port", zap.Error(err))
-	}
+	eventHandler := eventhandler.New(eventSvc, suggestionSvc, delegateSvc, userSvc, attendeeSvc, log, val)
+	projectHandler := projecthandler.New(projectSvc, log, val)
+	usageHandler := usagehandler.New(usageSvc, log)
+	viewHandler := viewhandler.New(viewSvc, log, val)
+	ruleHandler := rulehandler.New(ruleSvc, log, val)
+	importHandler := importhandler.New(importSvc, cfg.Import.MaxArchiveSize, log)
+	jobHandler := jobhandler.New(jobSvc, log)
+	exportHandler := exporthandler.New(exportSvc, log)
+	embedHandler := embedhandler.New(embedSvc, cfg.Embed.CacheMaxAge, log, val)
+	shortLinkHandler := shortlinkhandler.New(shortLinkSvc, log, val)
+	reminderHandler := reminderhandler.New(reminderSvc, log)
+	feedHandler := feedhandler.New(feedSvc, cfg.Feed.RefreshInterval, log, val)
+	onboardingHandler := onboardinghandler.New(onboardingSvc, log, val)
+	demoHandler := demohandler.New(userSvc, onboardingSvc, log, val)
+	delegateHandler := delegatehandler.New(delegateSvc, log, val)
+	attendeeHandler := attendeehandler.New(attendeeSvc, log, val)
+	preferenceHandler := preferencehandler.New(preferenceSvc, log, val)
+	followerHandler := followerhandler.New(followerSvc, log)
+	proposalHandler := proposalhandler.New(proposalSvc, log, val)
+	noteHandler := notehandler.New(noteSvc, log, val)
+	calendarHandler := calendarhandler.New(calendarSvc, log, val)
+	contactHandler := contacthandler.New(contactSvc, log, val)
+	groupHandler := grouphandler.New(groupSvc, log, val)
+	reportHandler := reporthandler.New(reportSvc, log)
+	tzMigrationHandler := tzmigrationhandler.New(tzMigrationSvc, log)
+	coldStorageHandler := coldstoragehandler.New(coldStorageSvc, log)
+	archiveFormatHandler := archiveformathandler.New(archiveFormatSvc, log)
+	debugLog := middlewares.NewDebugLog(log)
+	maintenanceMode := maintenance.New(cfg.Maintenance)
 
-	emailClient := email.NewClient(
-		cfg.Email.SMTPHost,
-		smtpPort,
-		cfg.Email.Username,
-		cfg.Email.Password,
-		cfg.Email.From,
-	)
+	notificationHandler := notificationhandler.New(notificationSvc, emailProvider, log)
 
 	// Start reminder worker.
-	reminderWorker := reminder.NewWorker(reminderCh, userSvc, emailClient, log)
-	reminderWorker.Start(ctx)
+	reminderWorker := reminder.NewWorker(reminderSvc, userSvc, preferenceSvc, emailProvider, maintenanceMode, dbPool.Tenants(), clk, log)
+	reminderWorker.Start(ctx, cfg.Reminder.PollInterval)
 
 	// Start archiver worker.
-	archiverWorker := archiver.NewWorker(eventSvc, log)
+	archiverWorker := archiver.NewWorker(eventSvc, reminderSvc, coldStorageSvc, maintenanceMode, dbPool.Tenants(), cfg.Archiver, clk, log)
 	archiverWorker.Start(ctx, cfg.Archiver.Interval)
 
+	// Start suggestion worker.
+	suggestionWorker := suggestionworker.NewWorker(suggestionSvc, maintenanceMode, dbPool.Tenants(), clk, log)
+	suggestionWorker.Start(ctx, cfg.Suggestion.Interval)
+
+	// Start report worker.
+	reportWorker := reportworker.NewWorker(reportSvc, maintenanceMode, dbPool.Tenants(), clk, log)
+	reportWorker.Start(ctx, cfg.Report.Interval)
+
+	// Start job worker pool.
+	jobWorker := jobworker.NewWorker(jobSvc, maintenanceMode, dbPool.Tenants(), cfg.Job, clk, log)
+	jobWorker.Start(ctx)
+
+	// Start demo cleanup worker, wiping the throwaway accounts of a public demo instance.
+	if cfg.Demo.Enabled {
+		demoWorker := demoworker.NewWorker(userSvc, maintenanceMode, dbPool.Tenants(), clk, log)
+		demoWorker.Start(ctx, cfg.Demo.CleanupInterval)
+	}
+
+	// Machine handler, serving internal services; it triggers reminder delivery through the worker.
+	machineHandler := machinehandler.New(machineSvc, eventSvc, reminderWorker, log)
+
+	// Meta handler, publishing the API version, the build and the changelog.
+	build := buildinfo.Get()
+	metaHandler := metahandler.New(build, log)
+
+	// Admin handler, reporting the status of the workers.
+	adminHandler := adminhandler.New(logLevel, debugLog, maintenanceMode, reminderSvc, reminderWorker, archiverWorker, jobWorker, userSvc, log, val)
+
+	// Brute-force protection of login and registration.
+	captchaMiddleware := func(next http.Handler) http.Handler { return next }
+	if cfg.Captcha.Enabled {
+		verifier, err := captcha.NewVerifier(cfg.Captcha)
+		if err != nil {
+			log.Fatal("error initializing captcha verifier", zap.Error(err))
+		}
+		tracker := captcha.NewTracker(cfg.Captcha.Threshold, cfg.Captcha.Window)
+		captchaMiddleware = middlewares.Captcha(tracker, verifier, cfg.Captcha.Header, log)
+	}
+
+	// Concurrency limits keeping imports and exports from starving interactive requests.
+	priorityMiddleware := func(next http.Handler) http.Handler { return next }
+	if cfg.Priority.Enabled {
+		priorityMiddleware = middlewares.Priority(priority.New(cfg.Priority), cfg.Priority.RetryAfter)
+	}
+
 	// Async logging.
-	logCh := make(chan middlewares.LogEntry, 100)
-	middlewares.StartAsyncLogger(logCh, log)
+	asyncLog := middlewares.NewAsyncLogger(cfg.Logger.Async, log)
 
 	// Setup router and server.
-	r := router.New(authHandler, eventHandler, cfg, logCh)
-	s := server.New(cfg.Server.HTTPPort, r)
+	r := router.New(
+		authHandler, eventHandler, projectHandler, viewHandler, usageHandler, adminHandler,
+		notificationHandler, importHandler, jobHandler, exportHandler, ruleHandler, embedHandler, shortLinkHandler, reminderHandler, feedHandler, onboardingHandler, demoHandler, delegateHandler, attendeeHandler, preferenceHandler, followerHandler, proposalHandler, tzMigrationHandler, noteHandler, calendarHandler, machineHandler, coldStorageHandler, archiveFormatHandler, metaHandler, contactHandler, groupHandler, reportHandler, cfg, asyncLog, debugLog, rep, middlewares.Usage(usageSvc, log), maintenanceMode,
+		middlewares.Tenant(dbPool, cfg.Tenancy.Header), captchaMiddleware, priorityMiddleware,
+	)
+	s := server.New(cfg.Server, r)
 
 	go func() {
-		log.Info("starting HTTP server", zap.String("port", cfg.Server.HTTPPort))
+		log.Info("starting HTTP server",
+			zap.String("port", cfg.Server.HTTPPort),
+			zap.String("commit", build.Commit),
+			zap.Bool("tls", s.TLSEnabled()),
+			zap.Bool("http2", s.TLSEnabled() && cfg.Server.HTTP2.Enabled),
+		)
 		if err = s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Fatal("server failed", zap.Error(err))
 		}
@@ -104,19 +358,48 @@ func main() {
 	<-ctx.Done()
 	log.Info("shutdown signal received")
 
-	// Graceful shutdown with timeout.
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// Drain connections with timeout: report not ready, then finish in-flight requests.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ReadinessDelay+cfg.Server.DrainTimeout)
 	defer cancel()
 
-	log.Info("shutting down HTTP server...")
-	if err = s.Shutdown(shutdownCtx); err != nil {
+	log.Info("draining HTTP server...", zap.Duration("readiness_delay", cfg.Server.ReadinessDelay))
+	if err = s.Drain(shutdownCtx, cfg.Server.ReadinessDelay); err != nil {
 		log.Error("could not shutdown HTTP server", zap.Error(err))
 	}
 
 	if errors.Is(shutdownCtx.Err(), context.DeadlineExceeded) {
+		flushRequestLog(asyncLog, log)
 		log.Fatal("timeout exceeded, forcing shutdown")
 	}
 
+	// No requests are in flight anymore, so the request log can be flushed.
+	flushRequestLog(asyncLog, log)
+
+	// Let in-flight reminders finish before the pool goes away.
+	log.Info("waiting for reminder worker...")
+	reminderWorker.Stop()
+
+	// Interrupt running jobs; they are marked as failed.
+	log.Info("waiting for job workers...")
+	jobWorker.Stop()
+
 	log.Info("closing database pool...")
 	dbPool.Close()
 }
+
+// flushRequestLog writes the buffered request log entries, waiting at most five seconds.
+//
+// Parameters:
+//   - asyncLog: The async request logger to close.
+//   - log: The logger for reporting the outcome.
+func flushRequestLog(asyncLog *middlewares.AsyncLogger, log *zap.Logger) {
+	log.Info("flushing request logs...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := asyncLog.Close(ctx); err != nil {
+		log.Error("could not flush request logs", zap.Error(err))
+	}
+	log.Info("request logs flushed", zap.Int64("dropped", asyncLog.Dropped()))
+}
@@ -0,0 +1,153 @@
+// Package changelog is the machine-readable changelog of the API, published under /api/meta.
+//
+// Every change to a public endpoint adds an entry here, dated with the release it ships in;
+// the date of the latest entry is the API version.
+package changelog
+
+import (
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// entries lists the changes of the API, the latest release first.
+var entries = []model.ChangelogEntry{
+	{
+		Date: "2025-10-21",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/events/", Field: "notifications", Description: "Per-event overrides of the notification preferences: channel, reminder lead time and reminders on or off."},
+			{Type: model.APIChangeAdded, Endpoint: "PUT /api/events/{id}", Field: "notifications", Description: "Replace the notification overrides of an event; omitted removes them."},
+		},
+	},
+	{
+		Date: "2025-10-20",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "GET /api/events/trash", Description: "Deleted events, the most recently deleted first, until they are purged."},
+			{Type: model.APIChangeChanged, Endpoint: "DELETE /api/events/{id}", Description: "Deleted events move to the trash and can be restored until they are purged."},
+			{Type: model.APIChangeChanged, Endpoint: "POST /api/events/{id}/restore", Description: "Restores trashed events as well as archived ones."},
+		},
+	},
+	{
+		Date: "2025-10-19",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "/api/groups", Description: "Named attendee groups whose membership changes apply to the upcoming events they are invited to."},
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/events/{id}/attendees/groups", Description: "Invite the members of a group to an event."},
+			{Type: model.APIChangeAdded, Endpoint: "GET /api/events/{id}/attendees", Field: "group_id", Description: "Group an attendee was invited through, if any."},
+		},
+	},
+	{
+		Date: "2025-10-18",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "/api/user/contacts", Description: "Contacts for attendee autocomplete, ranked by invitations, with manual add and remove."},
+		},
+	},
+	{
+		Date: "2025-10-17",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "DELETE /api/events/", Description: "Delete the events in a date range and report how many were deleted."},
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/events/bulk-delete", Description: "Delete events by a list of IDs and report how many were deleted."},
+		},
+	},
+	{
+		Date: "2025-10-16",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "GET /api/meta/version", Description: "API version and build information of the service."},
+			{Type: model.APIChangeAdded, Endpoint: "GET /api/meta/changelog", Description: "Machine-readable changelog and active deprecations of the API."},
+		},
+	},
+	{
+		Date: "2025-10-15",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/admin/archive-verifications", Description: "Backfill and compare the archive formats in a background job."},
+		},
+	},
+	{
+		Date: "2025-10-14",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/events/", Field: "location", Description: "Optional location of an event, with optional latitude and longitude."},
+			{Type: model.APIChangeAdded, Endpoint: "PUT /api/events/{id}", Field: "location", Description: "Optional location of an event, with optional latitude and longitude."},
+			{Type: model.APIChangeAdded, Field: "X-Request-Deadline", Description: "Request header shortening the timeout of a request; Grpc-Timeout is accepted too. Requests past their deadline fail with 504 deadline_exceeded."},
+		},
+	},
+	{
+		Date: "2025-10-13",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/admin/cold-storage/restores", Description: "Restore events exported to cold storage into the archive in a background job."},
+		},
+	},
+	{
+		Date: "2025-10-12",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "/api/calendars", Description: "Calendars users sort their events into, with a default calendar per user."},
+			{Type: model.APIChangeAdded, Endpoint: "GET /api/events/day", Field: "calendar_id", Description: "Query parameter limiting event listings to one calendar; also accepted by the week, month and search listings."},
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/oauth/token", Description: "Client credentials grant issuing machine tokens to internal services."},
+		},
+	},
+	{
+		Date: "2025-10-11",
+		Changes: []model.APIChange{
+			{Type: model.APIChangeAdded, Endpoint: "POST /api/events/", Field: "on_behalf_of", Description: "Create an event in the calendar of a user who added the authenticated user as a delegate."},
+			{Type: model.APIChangeChanged, Endpoint: "POST /api/events/", Description: "The owner of a new event is the authenticated user; a user_id of another user is rejected with 403."},
+			{
+				Type:        model.APIChangeDeprecated,
+				Endpoint:    "POST /api/events/",
+				Field:       "user_id",
+				Description: "The owner is taken from the token; user_id is ignored unless it names another user.",
+				Replacement: "on_behalf_of",
+			},
+		},
+	},
+}
+
+// Version returns the current version of the API, the date of its latest changelog entry.
+//
+// Returns:
+//   - The API version, YYYY-MM-DD.
+func Version() string {
+	return entries[0].Date
+}
+
+// Entries returns the changelog entries released after a date, the latest release first.
+//
+// Parameters:
+//   - since: The date, YYYY-MM-DD, after which entries are returned; empty for all entries.
+//
+// Returns:
+//   - The matching entries.
+func Entries(since string) []model.ChangelogEntry {
+	var result []model.ChangelogEntry
+	for _, e := range entries {
+		// Dates in YYYY-MM-DD compare chronologically as strings.
+		if e.Date <= since {
+			break
+		}
+		result = append(result, e)
+	}
+
+	return result
+}
+
+// Deprecations returns the deprecated endpoints, fields and headers that are still served,
+// the latest deprecation first.
+//
+// Returns:
+//   - The deprecation changes, without the ones removed since.
+func Deprecations() []model.APIChange {
+	removed := make(map[[2]string]bool)
+	for _, e := range entries {
+		for _, c := range e.Changes {
+			if c.Type == model.APIChangeRemoved {
+				removed[[2]string{c.Endpoint, c.Field}] = true
+			}
+		}
+	}
+
+	var result []model.APIChange
+	for _, e := range entries {
+		for _, c := range e.Changes {
+			if c.Type == model.APIChangeDeprecated && !removed[[2]string{c.Endpoint, c.Field}] {
+				result = append(result, c)
+			}
+		}
+	}
+
+	return result
+}
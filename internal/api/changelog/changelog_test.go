@@ -0,0 +1,53 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestEntries_Ordered(t *testing.T) {
+	valid := map[string]bool{
+		model.APIChangeAdded:      true,
+		model.APIChangeChanged:    true,
+		model.APIChangeDeprecated: true,
+		model.APIChangeRemoved:    true,
+	}
+
+	for i, e := range entries {
+		if _, err := time.Parse("2006-01-02", e.Date); err != nil {
+			t.Fatalf("entry %d: invalid date %q", i, e.Date)
+		}
+		if i > 0 && e.Date >= entries[i-1].Date {
+			t.Fatalf("entry %d: %s is not older than %s", i, e.Date, entries[i-1].Date)
+		}
+		if len(e.Changes) == 0 {
+			t.Fatalf("entry %s has no changes", e.Date)
+		}
+		for _, c := range e.Changes {
+			if !valid[c.Type] || c.Description == "" {
+				t.Fatalf("entry %s: invalid change %+v", e.Date, c)
+			}
+		}
+	}
+}
+
+func TestEntries_Since(t *testing.T) {
+	if got := Entries(""); len(got) != len(entries) {
+		t.Fatalf("expected all %d entries, got %d", len(entries), len(got))
+	}
+	if got := Entries(Version()); len(got) != 0 {
+		t.Fatalf("expected no entries after the current version, got %+v", got)
+	}
+	if got := Entries("2025-10-14"); len(got) == 0 || len(got) == len(entries) || got[len(got)-1].Date != "2025-10-15" {
+		t.Fatalf("expected the entries after 2025-10-14, got %+v", got)
+	}
+}
+
+func TestDeprecations(t *testing.T) {
+	deprecations := Deprecations()
+	if len(deprecations) != 1 || deprecations[0].Field != "user_id" || deprecations[0].Replacement != "on_behalf_of" {
+		t.Fatalf("expected the user_id deprecation, got %+v", deprecations)
+	}
+}
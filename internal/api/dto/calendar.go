@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Calendar represents the JSON contract of a calendar returned by the API.
+type Calendar struct {
+	ID        uuid.UUID `json:"id"`         // unique identifier for the calendar
+	Name      string    `json:"name"`       // name of the calendar
+	Color     string    `json:"color"`      // display color; empty for the default color
+	IsDefault bool      `json:"is_default"` // whether events created without a calendar go to this calendar
+	CreatedAt time.Time `json:"created_at"` // timestamp when the calendar was created
+	UpdatedAt time.Time `json:"updated_at"` // timestamp when the calendar was last updated
+}
+
+// NewCalendar converts a calendar model into its API representation.
+//
+// Parameters:
+//   - c: The calendar model to convert.
+//
+// Returns:
+//   - The calendar DTO.
+func NewCalendar(c model.Calendar) Calendar {
+	return Calendar{
+		ID:        c.ID,
+		Name:      c.Name,
+		Color:     c.Color,
+		IsDefault: c.IsDefault,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// NewCalendars converts a slice of calendar models into their API representations.
+//
+// Parameters:
+//   - calendars: The calendar models to convert.
+//
+// Returns:
+//   - A slice of calendar DTOs, never nil.
+func NewCalendars(calendars []model.Calendar) []Calendar {
+	result := make([]Calendar, 0, len(calendars))
+	for _, c := range calendars {
+		result = append(result, NewCalendar(c))
+	}
+
+	return result
+}
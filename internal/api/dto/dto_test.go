@@ -0,0 +1,213 @@
+package dto
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/locale"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// jsonKeys returns the sorted top-level keys of v encoded as JSON.
+func jsonKeys(t *testing.T, v interface{}) []string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var obj map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &obj))
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func TestEvent_Contract(t *testing.T) {
+	e := NewEvent(model.Event{ID: uuid.New(), Title: "Meeting"}, time.Now())
+
+	assert.Equal(t, []string{
+		"attendee_status", "calendar_id", "color", "created_at", "description", "end_date", "event_date", "follower_count", "id",
+		"is_critical", "is_past", "latitude", "location", "longitude", "notifications", "priority", "project_id", "recurrence_rule", "reminder_at", "reminder_timezone", "tags", "title", "updated_at", "user_id",
+	}, jsonKeys(t, e))
+}
+
+func TestNewEvent_IsPast(t *testing.T) {
+	now := time.Now()
+
+	past := NewEvent(model.Event{EventDate: now.Add(-time.Hour)}, now)
+	future := NewEvent(model.Event{EventDate: now.Add(time.Hour)}, now)
+
+	assert.True(t, past.IsPast)
+	assert.False(t, future.IsPast)
+
+	end := now.Add(time.Hour)
+	ongoing := NewEvent(model.Event{EventDate: now.Add(-time.Hour), EndDate: &end}, now)
+
+	assert.False(t, ongoing.IsPast)
+}
+
+func TestNewEvent_TagsNeverNull(t *testing.T) {
+	e := NewEvent(model.Event{}, time.Now())
+
+	assert.NotNil(t, e.Tags)
+}
+
+func TestNewEvent_HidesDescriptionFromInvitees(t *testing.T) {
+	now := time.Now()
+
+	owned := NewEvent(model.Event{Description: "agenda"}, now)
+	accepted := NewEvent(model.Event{Description: "agenda", AttendeeStatus: model.AttendeeAccepted}, now)
+	pending := NewEvent(model.Event{Title: "Sync", Description: "agenda", AttendeeStatus: model.AttendeeInvited}, now)
+
+	assert.Equal(t, "agenda", owned.Description)
+	assert.Nil(t, owned.HiddenFields)
+	assert.Equal(t, "agenda", accepted.Description)
+	assert.Nil(t, accepted.HiddenFields)
+	assert.Equal(t, "Sync", pending.Title)
+	assert.Empty(t, pending.Description)
+	assert.Equal(t, []string{"description"}, pending.HiddenFields)
+}
+
+func TestNewEventDetails_RunningLate(t *testing.T) {
+	now := time.Now()
+	soon, later := now.Add(5*time.Minute), now.Add(20*time.Minute)
+	bob, carol := uuid.New(), uuid.New()
+
+	details := NewEventDetails(model.Event{}, nil, []model.Attendee{
+		{UserID: bob, Name: "Bob", LateETA: &later},
+		{UserID: uuid.New(), Name: "Dave"},
+		{UserID: carol, Name: "Carol", LateETA: &soon},
+	}, now)
+
+	assert.NotNil(t, details.Related)
+	assert.Equal(t, []LateAttendee{{UserID: carol, Name: "Carol", ETA: soon}, {UserID: bob, Name: "Bob", ETA: later}}, details.RunningLate)
+	assert.NotNil(t, NewEventDetails(model.Event{}, nil, nil, now).RunningLate)
+}
+
+func TestNewEvents_Empty(t *testing.T) {
+	events := NewEvents(nil, time.Now())
+
+	assert.NotNil(t, events)
+	assert.Empty(t, events)
+}
+
+func TestUser_Contract(t *testing.T) {
+	u := NewUser(model.User{ID: uuid.New(), Email: "john@example.com", Password: "hash"})
+
+	assert.Equal(t, []string{"created_at", "email", "id", "name", "role", "updated_at"}, jsonKeys(t, u))
+}
+
+func TestDemoAccount_Contract(t *testing.T) {
+	a := NewDemoAccount(model.DemoAccount{UserID: uuid.New(), Email: "demo-1@demo.invalid", Password: "secret", Token: "abc"})
+
+	assert.Equal(t, []string{"email", "expires_at", "password", "token", "user_id"}, jsonKeys(t, a))
+}
+
+func TestToken_Contract(t *testing.T) {
+	assert.Equal(t, []string{"token"}, jsonKeys(t, Token{Token: "abc"}))
+}
+
+func TestEvents_AppendJSON(t *testing.T) {
+	projectID, calendarID := uuid.New(), uuid.New()
+	latitude, longitude := 52.520008, -1e-7
+	reminderAt := time.Date(2025, 3, 1, 8, 30, 0, 123456789, time.FixedZone("UTC+3", 3*3600))
+	muted := false
+
+	events := NewEvents([]model.Event{
+		{
+			ID:          uuid.New(),
+			UserID:      uuid.New(),
+			EventDate:   time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+			EndDate:     &reminderAt,
+			Title:       "Q&A <draft> \"quoted\" \\ back\tslash",
+			Description: "line\nbreak\r\x01\b\f    café \xff \U0001F600",
+			Location:    "Alexanderplatz 1 & <Room 2>",
+			Latitude:    &latitude,
+			Longitude:   &longitude,
+			Priority:    model.PriorityCritical,
+			ProjectID:   &projectID,
+			CalendarID:  &calendarID,
+			Color:       "#336699",
+			Tags:        []string{"work", "<b>"},
+			ReminderAt:  &reminderAt,
+			Notifications: &model.EventNotifications{
+				Channel:   model.ChannelEmail,
+				LeadTime:  90 * time.Minute,
+				Reminders: &muted,
+			},
+			DeletedAt: &reminderAt,
+		},
+		{ID: uuid.New(), Title: "Overrides", Notifications: &model.EventNotifications{LeadTime: time.Hour}},
+		{ID: uuid.New(), Title: "Invited", Description: "agenda", AttendeeStatus: model.AttendeeInvited},
+		{ID: uuid.New(), Title: "Plain"},
+	}, time.Now())
+
+	expected, err := json.Marshal([]Event(events))
+	require.NoError(t, err)
+
+	actual, err := events.AppendJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+
+	// Localized dates are encoded the same way.
+	de, err := locale.Lookup("de")
+	require.NoError(t, err)
+	events.Localize(de, time.UTC)
+	events[1].Localized.Time = ""
+
+	expected, err = json.Marshal([]Event(events))
+	require.NoError(t, err)
+	actual, err = events.AppendJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+	assert.Contains(t, string(actual), `"localized":{"date":"Samstag, 1. März 2025","time":"09:00","weekday":"Samstag","month":"März"}`)
+
+	empty, err := NewEvents(nil, time.Now()).AppendJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(empty))
+}
+
+func TestEvents_AppendJSON_TimeRange(t *testing.T) {
+	events := Events{{EventDate: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)}}
+
+	_, err := events.AppendJSON(nil)
+	assert.ErrorIs(t, err, ErrTimeRange)
+}
+
+func TestMonthGrid_Localize(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, time.September, d, 0, 0, 0, 0, time.UTC) }
+	g := model.MonthGrid{Month: day(1), WeekStart: time.Sunday}
+	for d := 0; d < 7; d++ {
+		g.Days = append(g.Days, model.MonthGridDay{Date: day(1).AddDate(0, 0, d-1), Events: []model.Event{}})
+	}
+	g.Days[2].Events = []model.Event{{Title: "Standup", EventDate: day(2).Add(7 * time.Hour)}}
+
+	fr, err := locale.Lookup("fr")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	grid := NewMonthGrid(g, time.Now())
+	grid.Localize(g, fr, tokyo)
+
+	require.NotNil(t, grid.Locale)
+	assert.Equal(t, "fr-FR", grid.Locale.Tag)
+	assert.Equal(t, "monday", grid.Locale.FirstDay)
+	assert.Equal(t, "septembre", grid.Locale.MonthName)
+	assert.Equal(t, []string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"}, grid.Locale.WeekdayNames)
+	assert.Equal(t, &LocalizedDate{Date: "dimanche 31 août 2025", Weekday: "dimanche", Month: "août"}, grid.Days[0].Localized)
+
+	events := grid.Days[2].Events.(Events)
+	assert.Equal(t, "16:00", events[0].Localized.Time)
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Embed represents the JSON contract of an embed returned to its owner.
+type Embed struct {
+	ID             uuid.UUID  `json:"id"`              // unique identifier for the embed
+	Name           string     `json:"name"`            // name of the embed, shown as the calendar title
+	ProjectID      *uuid.UUID `json:"project_id"`      // only events of this project; null for all events
+	AllowedDomains []string   `json:"allowed_domains"` // hosts allowed to embed the calendar, never null
+	RangeDays      int        `json:"range_days"`      // days from today shown when no range is requested
+	Token          string     `json:"token,omitempty"` // share token; only returned when the embed is created
+	CreatedAt      time.Time  `json:"created_at"`      // timestamp when the embed was created
+}
+
+// EmbedCalendar represents the public JSON contract of an embedded calendar.
+type EmbedCalendar struct {
+	Name   string       `json:"name"`   // name of the embed
+	From   string       `json:"from"`   // first day of the range (YYYY-MM-DD), inclusive
+	To     string       `json:"to"`     // end of the range (YYYY-MM-DD), exclusive
+	Events []EmbedEvent `json:"events"` // published events ordered by date, never null
+}
+
+// EmbedEvent represents an event published by an embed. Only what a public calendar shows is included.
+type EmbedEvent struct {
+	ID        uuid.UUID `json:"id"`         // identifier of the event, for following it
+	Title     string    `json:"title"`      // title of the event
+	EventDate time.Time `json:"event_date"` // date and time of the event
+	Color     string    `json:"color"`      // color of the event; empty if none
+}
+
+// NewEmbed converts an embed model into its API representation.
+//
+// Parameters:
+//   - e: The embed model to convert.
+//
+// Returns:
+//   - The embed DTO.
+func NewEmbed(e model.Embed) Embed {
+	domains := e.AllowedDomains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	return Embed{
+		ID:             e.ID,
+		Name:           e.Name,
+		ProjectID:      e.ProjectID,
+		AllowedDomains: domains,
+		RangeDays:      e.RangeDays,
+		Token:          e.Token,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+// NewEmbeds converts a slice of embed models into their API representations.
+//
+// Parameters:
+//   - embeds: The embed models to convert.
+//
+// Returns:
+//   - A slice of embed DTOs, never nil.
+func NewEmbeds(embeds []model.Embed) []Embed {
+	result := make([]Embed, 0, len(embeds))
+	for _, e := range embeds {
+		result = append(result, NewEmbed(e))
+	}
+
+	return result
+}
+
+// NewEmbedCalendar converts an embedded calendar into its public API representation.
+//
+// Parameters:
+//   - c: The embedded calendar to convert.
+//
+// Returns:
+//   - The embedded calendar DTO.
+func NewEmbedCalendar(c model.EmbedCalendar) EmbedCalendar {
+	events := make([]EmbedEvent, 0, len(c.Events))
+	for _, e := range c.Events {
+		events = append(events, EmbedEvent{ID: e.ID, Title: e.Title, EventDate: e.EventDate, Color: e.Color})
+	}
+
+	return EmbedCalendar{
+		Name:   c.Embed.Name,
+		From:   c.From.Format(time.DateOnly),
+		To:     c.To.Format(time.DateOnly),
+		Events: events,
+	}
+}
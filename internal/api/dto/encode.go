@@ -0,0 +1,306 @@
+package dto
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrTimeRange is returned when a timestamp cannot be represented in RFC 3339.
+var ErrTimeRange = errors.New("timestamp year outside of range [0,9999]")
+
+// Events is an event list response. It is encoded by appending to a caller-provided buffer
+// instead of going through encoding/json reflection, which matters for large day/week/month lists.
+type Events []Event
+
+// AppendJSON appends the JSON encoding of the events to buf.
+// The output is byte-for-byte identical to json.Marshal, including HTML escaping.
+//
+// Parameters:
+//   - buf: The buffer to append to.
+//
+// Returns:
+//   - The extended buffer.
+//   - An error if a timestamp cannot be encoded.
+func (events Events) AppendJSON(buf []byte) ([]byte, error) {
+	var err error
+
+	buf = append(buf, '[')
+	for i := range events {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = events[i].AppendJSON(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(buf, ']'), nil
+}
+
+// AppendJSON appends the JSON encoding of the event to buf.
+//
+// Parameters:
+//   - buf: The buffer to append to.
+//
+// Returns:
+//   - The extended buffer.
+//   - An error if a timestamp cannot be encoded.
+func (e *Event) AppendJSON(buf []byte) ([]byte, error) {
+	var err error
+
+	buf = append(buf, `{"id":`...)
+	buf = appendUUID(buf, e.ID)
+	buf = append(buf, `,"user_id":`...)
+	buf = appendUUID(buf, e.UserID)
+	buf = append(buf, `,"event_date":`...)
+	if buf, err = appendTime(buf, e.EventDate); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"end_date":`...)
+	if e.EndDate != nil {
+		if buf, err = appendTime(buf, *e.EndDate); err != nil {
+			return nil, err
+		}
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"title":`...)
+	buf = appendString(buf, e.Title)
+	buf = append(buf, `,"description":`...)
+	buf = appendString(buf, e.Description)
+	buf = append(buf, `,"location":`...)
+	buf = appendString(buf, e.Location)
+	buf = append(buf, `,"latitude":`...)
+	buf = appendOptionalFloat(buf, e.Latitude)
+	buf = append(buf, `,"longitude":`...)
+	buf = appendOptionalFloat(buf, e.Longitude)
+	buf = append(buf, `,"priority":`...)
+	buf = appendString(buf, e.Priority)
+	buf = append(buf, `,"is_critical":`...)
+	buf = appendBool(buf, e.IsCritical)
+	buf = append(buf, `,"project_id":`...)
+	if e.ProjectID != nil {
+		buf = appendUUID(buf, *e.ProjectID)
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"calendar_id":`...)
+	if e.CalendarID != nil {
+		buf = appendUUID(buf, *e.CalendarID)
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"color":`...)
+	buf = appendString(buf, e.Color)
+	buf = append(buf, `,"tags":`...)
+	if e.Tags != nil {
+		buf = append(buf, '[')
+		for i, tag := range e.Tags {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendString(buf, tag)
+		}
+		buf = append(buf, ']')
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"reminder_at":`...)
+	if e.ReminderAt != nil {
+		if buf, err = appendTime(buf, *e.ReminderAt); err != nil {
+			return nil, err
+		}
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"reminder_timezone":`...)
+	buf = appendString(buf, e.ReminderTimezone)
+	buf = append(buf, `,"notifications":`...)
+	if n := e.Notifications; n != nil {
+		buf = append(buf, `{"channel":`...)
+		buf = appendString(buf, n.Channel)
+		buf = append(buf, `,"lead_time":`...)
+		buf = appendString(buf, n.LeadTime)
+		buf = append(buf, `,"reminders":`...)
+		if n.Reminders != nil {
+			buf = appendBool(buf, *n.Reminders)
+		} else {
+			buf = append(buf, "null"...)
+		}
+		buf = append(buf, '}')
+	} else {
+		buf = append(buf, "null"...)
+	}
+	buf = append(buf, `,"recurrence_rule":`...)
+	buf = appendString(buf, e.RecurrenceRule)
+	buf = append(buf, `,"is_past":`...)
+	buf = appendBool(buf, e.IsPast)
+	buf = append(buf, `,"created_at":`...)
+	if buf, err = appendTime(buf, e.CreatedAt); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"updated_at":`...)
+	if buf, err = appendTime(buf, e.UpdatedAt); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"attendee_status":`...)
+	buf = appendString(buf, e.AttendeeStatus)
+	if len(e.HiddenFields) > 0 {
+		buf = append(buf, `,"hidden_fields":[`...)
+		for i, field := range e.HiddenFields {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendString(buf, field)
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"follower_count":`...)
+	buf = strconv.AppendInt(buf, int64(e.FollowerCount), 10)
+	if e.DeletedAt != nil {
+		buf = append(buf, `,"deleted_at":`...)
+		if buf, err = appendTime(buf, *e.DeletedAt); err != nil {
+			return nil, err
+		}
+	}
+	if e.Localized != nil {
+		buf = append(buf, `,"localized":{"date":`...)
+		buf = appendString(buf, e.Localized.Date)
+		if e.Localized.Time != "" {
+			buf = append(buf, `,"time":`...)
+			buf = appendString(buf, e.Localized.Time)
+		}
+		buf = append(buf, `,"weekday":`...)
+		buf = appendString(buf, e.Localized.Weekday)
+		buf = append(buf, `,"month":`...)
+		buf = appendString(buf, e.Localized.Month)
+		buf = append(buf, '}')
+	}
+
+	return append(buf, '}'), nil
+}
+
+// appendUUID appends a UUID as a quoted string in its canonical form.
+func appendUUID(buf []byte, id uuid.UUID) []byte {
+	var dst [36]byte
+	hex.Encode(dst[0:8], id[0:4])
+	dst[8] = '-'
+	hex.Encode(dst[9:13], id[4:6])
+	dst[13] = '-'
+	hex.Encode(dst[14:18], id[6:8])
+	dst[18] = '-'
+	hex.Encode(dst[19:23], id[8:10])
+	dst[23] = '-'
+	hex.Encode(dst[24:], id[10:])
+
+	buf = append(buf, '"')
+	buf = append(buf, dst[:]...)
+	return append(buf, '"')
+}
+
+// appendTime appends a timestamp as a quoted RFC 3339 string, as time.Time.MarshalJSON does.
+func appendTime(buf []byte, t time.Time) ([]byte, error) {
+	if y := t.Year(); y < 0 || y > 9999 {
+		return nil, ErrTimeRange
+	}
+
+	buf = append(buf, '"')
+	buf = t.AppendFormat(buf, time.RFC3339Nano)
+	return append(buf, '"'), nil
+}
+
+// appendOptionalFloat appends a JSON number formatted like encoding/json does, or null for nil.
+// Exponents are used for very small and very large magnitudes, without a leading zero in the exponent.
+func appendOptionalFloat(buf []byte, f *float64) []byte {
+	if f == nil {
+		return append(buf, "null"...)
+	}
+
+	format := byte('f')
+	if abs := math.Abs(*f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	buf = strconv.AppendFloat(buf, *f, format, -1, 64)
+	if format == 'e' {
+		// Shorten e-09 to e-9.
+		if n := len(buf); n >= 4 && buf[n-4] == 'e' && buf[n-3] == '-' && buf[n-2] == '0' {
+			buf[n-2] = buf[n-1]
+			buf = buf[:n-1]
+		}
+	}
+
+	return buf
+}
+
+// appendBool appends a JSON boolean.
+func appendBool(buf []byte, b bool) []byte {
+	if b {
+		return append(buf, "true"...)
+	}
+	return append(buf, "false"...)
+}
+
+// hexDigits are used to escape control characters as \u00XX.
+const hexDigits = "0123456789abcdef"
+
+// appendString appends a quoted JSON string with the same escaping rules as encoding/json:
+// control characters, quotes, backslashes, HTML-sensitive characters, and U+2028/U+2029
+// are escaped, and invalid UTF-8 is replaced with U+FFFD.
+func appendString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+
+			buf = append(buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, "\ufffd"...)
+		case r == '\u2028' || r == '\u2029':
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+
+	buf = append(buf, s[start:]...)
+	return append(buf, '"')
+}
@@ -0,0 +1,412 @@
+package dto
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/locale"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Event represents the JSON contract of an event returned by the API.
+// It decouples the API response from the internal model and adds computed fields.
+type Event struct {
+	ID               uuid.UUID           `json:"id"`                      // unique identifier for the event
+	UserID           uuid.UUID           `json:"user_id"`                 // identifier of the user who owns the event
+	EventDate        time.Time           `json:"event_date"`              // date and time when the event occurs
+	EndDate          *time.Time          `json:"end_date"`                // optional end of the event; null for events without a duration
+	Title            string              `json:"title"`                   // title of the event
+	Description      string              `json:"description"`             // optional description of the event
+	Location         string              `json:"location"`                // optional place of the event; empty if none
+	Latitude         *float64            `json:"latitude"`                // optional latitude of the location; null without coordinates
+	Longitude        *float64            `json:"longitude"`               // optional longitude of the location; null without coordinates
+	Priority         string              `json:"priority"`                // priority of the event (low, normal, high, critical)
+	IsCritical       bool                `json:"is_critical"`             // whether the event has critical priority, for flagging in clients
+	ProjectID        *uuid.UUID          `json:"project_id"`              // optional project the event belongs to
+	CalendarID       *uuid.UUID          `json:"calendar_id"`             // calendar of the owner the event belongs to
+	Color            string              `json:"color"`                   // display color; empty for the default color
+	Tags             []string            `json:"tags"`                    // labels of the event, never null
+	ReminderAt       *time.Time          `json:"reminder_at"`             // optional time for sending a reminder
+	ReminderTimezone string              `json:"reminder_timezone"`       // IANA time zone the reminder keeps its wall-clock time in; empty for a fixed instant
+	Notifications    *EventNotifications `json:"notifications"`           // overrides of the owner's notification preferences; null without overrides
+	RecurrenceRule   string              `json:"recurrence_rule"`         // RRULE of a recurring event; empty for a single event
+	IsPast           bool                `json:"is_past"`                 // whether the event is over: its end, or its date without an end, is in the past
+	CreatedAt        time.Time           `json:"created_at"`              // timestamp when the event was created
+	UpdatedAt        time.Time           `json:"updated_at"`              // timestamp when the event was last updated
+	AttendeeStatus   string              `json:"attendee_status"`         // invitation status of the requesting user; empty for their own events
+	HiddenFields     []string            `json:"hidden_fields,omitempty"` // fields blanked until the requesting invitee accepts; omitted if none
+	FollowerCount    int                 `json:"follower_count"`          // number of users following the event
+	DeletedAt        *time.Time          `json:"deleted_at,omitempty"`    // time the event was moved to the trash; omitted outside the trash
+
+	Localized *LocalizedDate `json:"localized,omitempty"` // event date formatted for the requested locale; omitted without a locale
+}
+
+// EventNotifications represents the JSON contract of the notification overrides of an event.
+type EventNotifications struct {
+	Channel   string `json:"channel"`   // channel reminders are sent through; empty for email
+	LeadTime  string `json:"lead_time"` // time before the start the reminder is sent at, e.g. 15m0s; empty for none
+	Reminders *bool  `json:"reminders"` // whether reminders are sent regardless of the owner's subscription; null follows it
+}
+
+// NewEventNotifications converts the notification overrides of an event into their API representation.
+//
+// Parameters:
+//   - n: The overrides, or nil.
+//
+// Returns:
+//   - The overrides DTO, or nil without overrides.
+func NewEventNotifications(n *model.EventNotifications) *EventNotifications {
+	if n == nil {
+		return nil
+	}
+
+	d := &EventNotifications{Channel: n.Channel, Reminders: n.Reminders}
+	if n.LeadTime > 0 {
+		d.LeadTime = n.LeadTime.String()
+	}
+
+	return d
+}
+
+// LocalizedDate represents a date formatted for a locale, next to its ISO timestamp.
+type LocalizedDate struct {
+	Date    string `json:"date"`           // long date, e.g. "Montag, 8. September 2025"
+	Time    string `json:"time,omitempty"` // time of day, e.g. "9:00 AM"; omitted for whole days
+	Weekday string `json:"weekday"`        // name of the weekday
+	Month   string `json:"month"`          // standalone name of the month
+}
+
+// NewLocalizedDate formats a date for a locale.
+//
+// Parameters:
+//   - t: The instant to format, in the location it is shown in.
+//   - l: The locale.
+//   - withTime: Whether the time of day is included.
+//
+// Returns:
+//   - The localized date.
+func NewLocalizedDate(t time.Time, l locale.Locale, withTime bool) *LocalizedDate {
+	d := &LocalizedDate{
+		Date:    l.FormatDate(t),
+		Weekday: l.WeekdayName(t.Weekday()),
+		Month:   l.MonthName(t.Month()),
+	}
+	if withTime {
+		d.Time = l.FormatTime(t)
+	}
+
+	return d
+}
+
+// Localize adds the event dates formatted for a locale to the events.
+//
+// Parameters:
+//   - l: The locale.
+//   - loc: The time zone the dates are shown in.
+func (events Events) Localize(l locale.Locale, loc *time.Location) {
+	for i := range events {
+		events[i].Localized = NewLocalizedDate(events[i].EventDate.In(loc), l, true)
+	}
+}
+
+// NewEvent converts an event model into its API representation.
+//
+// Parameters:
+//   - e: The event model to convert.
+//   - now: The reference time used for computed fields.
+//
+// Returns:
+//   - The event DTO.
+func NewEvent(e model.Event, now time.Time) Event {
+	tags := e.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	description := e.Description
+	var hidden []string
+	if !e.Visible("description") {
+		description = ""
+		hidden = append(hidden, "description")
+	}
+
+	return Event{
+		ID:               e.ID,
+		UserID:           e.UserID,
+		EventDate:        e.EventDate,
+		EndDate:          e.EndDate,
+		Title:            e.Title,
+		Description:      description,
+		Location:         e.Location,
+		Latitude:         e.Latitude,
+		Longitude:        e.Longitude,
+		Priority:         e.Priority,
+		IsCritical:       e.Priority == model.PriorityCritical,
+		ProjectID:        e.ProjectID,
+		CalendarID:       e.CalendarID,
+		Color:            e.Color,
+		Tags:             tags,
+		ReminderAt:       e.ReminderAt,
+		ReminderTimezone: e.ReminderTimezone,
+		Notifications:    NewEventNotifications(e.Notifications),
+		RecurrenceRule:   e.RecurrenceRule,
+		IsPast:           e.End().Before(now),
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+		AttendeeStatus:   e.AttendeeStatus,
+		HiddenFields:     hidden,
+		FollowerCount:    e.FollowerCount,
+		DeletedAt:        e.DeletedAt,
+	}
+}
+
+// NewEvents converts a slice of event models into their API representations.
+//
+// Parameters:
+//   - events: The event models to convert.
+//   - now: The reference time used for computed fields.
+//
+// Returns:
+//   - A slice of event DTOs, never nil.
+func NewEvents(events []model.Event, now time.Time) Events {
+	result := make(Events, 0, len(events))
+	for _, e := range events {
+		result = append(result, NewEvent(e, now))
+	}
+
+	return result
+}
+
+// RelatedEvent represents the JSON contract of an event linked to another event.
+type RelatedEvent struct {
+	ID        uuid.UUID `json:"id"`         // identifier of the related event
+	Title     string    `json:"title"`      // title of the related event
+	EventDate time.Time `json:"event_date"` // date and time of the related event
+	Relation  string    `json:"relation"`   // relation from the event's point of view (follow_up_of, blocked_by, followed_by, blocks)
+}
+
+// OccurrenceOverride represents the JSON contract of an occurrence of a recurring event with another time or title.
+type OccurrenceOverride struct {
+	Occurrence time.Time  `json:"occurrence"` // start of the occurrence as generated by the rule
+	EventDate  time.Time  `json:"event_date"` // start of the occurrence instead
+	EndDate    *time.Time `json:"end_date"`   // end of the occurrence; null keeps the duration of the series
+	Title      string     `json:"title"`      // title of the occurrence; empty keeps the title of the series
+}
+
+// EventDetails represents a single event returned with the events linked to it and the state of its attendees.
+type EventDetails struct {
+	Event
+	Related      []RelatedEvent         `json:"related"`                // events linked to the event in either direction
+	RunningLate  []LateAttendee         `json:"running_late"`           // attendees who reported running late, by expected arrival
+	Availability []AttendeeAvailability `json:"availability,omitempty"` // free/busy status of the attendees; only with include=availability
+}
+
+// AttendeeAvailability represents the JSON contract of the free/busy status of an attendee around the time of an event.
+type AttendeeAvailability struct {
+	UserID uuid.UUID    `json:"user_id"` // identifier of the attendee; the nil UUID for external attendees
+	Email  string       `json:"email"`   // email address of the attendee
+	Status string       `json:"status"`  // free, busy or unknown at the time of the event
+	Busy   []BusyPeriod `json:"busy"`    // periods the attendee is busy around the event, never null
+}
+
+// BusyPeriod represents the JSON contract of a period an attendee has other events.
+type BusyPeriod struct {
+	Start time.Time `json:"start"` // start of the period
+	End   time.Time `json:"end"`   // end of the period
+}
+
+// NewAvailability converts the availability of the attendees of an event into its API representation.
+//
+// Parameters:
+//   - availability: The availability of the attendees.
+//
+// Returns:
+//   - A slice of attendee availability DTOs, never nil.
+func NewAvailability(availability []model.Availability) []AttendeeAvailability {
+	result := make([]AttendeeAvailability, 0, len(availability))
+	for _, a := range availability {
+		busy := make([]BusyPeriod, 0, len(a.Busy))
+		for _, p := range a.Busy {
+			busy = append(busy, BusyPeriod(p))
+		}
+		result = append(result, AttendeeAvailability{UserID: a.UserID, Email: a.Email, Status: a.Status, Busy: busy})
+	}
+
+	return result
+}
+
+// LateAttendee represents the JSON contract of an attendee running late for an event.
+type LateAttendee struct {
+	UserID uuid.UUID `json:"user_id"` // identifier of the attendee
+	Name   string    `json:"name"`    // name of the attendee
+	ETA    time.Time `json:"eta"`     // time the attendee expects to arrive
+}
+
+// NewEventDetails converts an event model, its related events and its attendees into their API representation.
+//
+// Parameters:
+//   - e: The event model to convert.
+//   - related: The events linked to the event.
+//   - attendees: The attendees of the event; those who reported running late are listed.
+//   - now: The reference time used for computed fields.
+//
+// Returns:
+//   - The event details DTO; Related and RunningLate are never nil.
+func NewEventDetails(e model.Event, related []model.RelatedEvent, attendees []model.Attendee, now time.Time) EventDetails {
+	details := EventDetails{
+		Event:       NewEvent(e, now),
+		Related:     make([]RelatedEvent, 0, len(related)),
+		RunningLate: []LateAttendee{},
+	}
+	for _, r := range related {
+		details.Related = append(details.Related, RelatedEvent(r))
+	}
+	for _, a := range attendees {
+		if a.LateETA != nil {
+			details.RunningLate = append(details.RunningLate, LateAttendee{UserID: a.UserID, Name: a.Name, ETA: *a.LateETA})
+		}
+	}
+	slices.SortFunc(details.RunningLate, func(a, b LateAttendee) int { return a.ETA.Compare(b.ETA) })
+
+	return details
+}
+
+// EventSummary represents the number of events in a date range, per day, per calendar and per tag.
+type EventSummary struct {
+	From      string          `json:"from"`      // first day of the range (YYYY-MM-DD)
+	To        string          `json:"to"`        // last day of the range, inclusive (YYYY-MM-DD)
+	Total     int             `json:"total"`     // number of events in the range, counting every occurrence
+	Days      []DayCount      `json:"days"`      // days with events, ordered by date
+	Calendars []CalendarCount `json:"calendars"` // events per calendar; calendar_id is null for events without a calendar
+	Tags      []TagCount      `json:"tags"`      // events per tag, ordered by tag
+}
+
+// DayCount represents the number of events on a single day.
+type DayCount struct {
+	Date  string `json:"date"`  // the day (YYYY-MM-DD)
+	Count int    `json:"count"` // number of events on the day
+}
+
+// CalendarCount represents the number of events of a single calendar.
+type CalendarCount struct {
+	CalendarID *uuid.UUID `json:"calendar_id"` // the calendar; null for events without a calendar
+	Count      int        `json:"count"`       // number of events of the calendar
+}
+
+// TagCount represents the number of events with a single tag.
+type TagCount struct {
+	Tag   string `json:"tag"`   // the tag
+	Count int    `json:"count"` // number of events with the tag
+}
+
+// NewEventSummary converts an event summary model into its API representation.
+//
+// Parameters:
+//   - s: The event summary model to convert.
+//   - from: The first day of the range.
+//   - to: The last day of the range, inclusive.
+//
+// Returns:
+//   - The event summary DTO; Days, Calendars and Tags are never nil.
+func NewEventSummary(s model.EventSummary, from, to time.Time) EventSummary {
+	summary := EventSummary{
+		From:      from.Format(time.DateOnly),
+		To:        to.Format(time.DateOnly),
+		Total:     s.Total,
+		Days:      make([]DayCount, 0, len(s.Days)),
+		Calendars: make([]CalendarCount, 0, len(s.Calendars)),
+		Tags:      make([]TagCount, 0, len(s.Tags)),
+	}
+	for _, d := range s.Days {
+		summary.Days = append(summary.Days, DayCount{Date: d.Date.Format(time.DateOnly), Count: d.Count})
+	}
+	for _, c := range s.Calendars {
+		summary.Calendars = append(summary.Calendars, CalendarCount(c))
+	}
+	for _, t := range s.Tags {
+		summary.Tags = append(summary.Tags, TagCount(t))
+	}
+
+	return summary
+}
+
+// MonthGrid represents the weeks a calendar UI renders for a month, with the events of every day.
+type MonthGrid struct {
+	Month     string         `json:"month"`      // the month (YYYY-MM)
+	WeekStart string         `json:"week_start"` // first day of every week row, e.g. "monday"
+	Weeks     int            `json:"weeks"`      // number of week rows
+	Days      []MonthGridDay `json:"days"`       // all days of the grid in order, seven per week row
+
+	Locale *GridLocale `json:"locale,omitempty"` // names for rendering the grid in the requested locale; omitted without a locale
+}
+
+// GridLocale represents the names a client needs to render a month grid in a locale.
+type GridLocale struct {
+	Tag          string   `json:"tag"`           // BCP 47 tag of the locale, e.g. "de-DE"
+	FirstDay     string   `json:"first_day"`     // locale-aware first day of the week, e.g. "monday"
+	MonthName    string   `json:"month_name"`    // standalone name of the month
+	WeekdayNames []string `json:"weekday_names"` // weekday names in the order of the grid columns
+}
+
+// MonthGridDay represents a single day of a month grid.
+type MonthGridDay struct {
+	Date    string      `json:"date"`     // the day (YYYY-MM-DD)
+	InMonth bool        `json:"in_month"` // false for leading and trailing days of adjacent months
+	Events  interface{} `json:"events"`   // event DTOs of the day, or sparse objects if fields were requested
+
+	Localized *LocalizedDate `json:"localized,omitempty"` // the day formatted for the requested locale
+}
+
+// NewMonthGrid converts a month grid model into its API representation.
+//
+// Parameters:
+//   - g: The month grid model to convert.
+//   - now: The reference time used for computed fields.
+//
+// Returns:
+//   - The month grid DTO; the events of every day are never nil.
+func NewMonthGrid(g model.MonthGrid, now time.Time) MonthGrid {
+	grid := MonthGrid{
+		Month:     g.Month.Format("2006-01"),
+		WeekStart: strings.ToLower(g.WeekStart.String()),
+		Weeks:     len(g.Days) / 7,
+		Days:      make([]MonthGridDay, 0, len(g.Days)),
+	}
+	for _, d := range g.Days {
+		grid.Days = append(grid.Days, MonthGridDay{
+			Date:    d.Date.Format(time.DateOnly),
+			InMonth: d.InMonth,
+			Events:  NewEvents(d.Events, now),
+		})
+	}
+
+	return grid
+}
+
+// Localize adds the names of a locale to the grid, its days and their events.
+//
+// Parameters:
+//   - g: The month grid model the grid was converted from.
+//   - l: The locale.
+//   - loc: The time zone event dates are shown in.
+func (grid *MonthGrid) Localize(g model.MonthGrid, l locale.Locale, loc *time.Location) {
+	grid.Locale = &GridLocale{
+		Tag:          l.Tag,
+		FirstDay:     strings.ToLower(l.FirstDay.String()),
+		MonthName:    l.MonthName(g.Month.Month()),
+		WeekdayNames: make([]string, 0, 7),
+	}
+	for i := 0; i < 7; i++ {
+		grid.Locale.WeekdayNames = append(grid.Locale.WeekdayNames, l.WeekdayName((g.WeekStart+time.Weekday(i))%7))
+	}
+
+	for i := range grid.Days {
+		grid.Days[i].Localized = NewLocalizedDate(g.Days[i].Date, l, false)
+		if events, ok := grid.Days[i].Events.(Events); ok {
+			events.Localize(l, loc)
+		}
+	}
+}
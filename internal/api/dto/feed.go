@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Feed represents the JSON contract of an ICS feed returned to its owner.
+type Feed struct {
+	ID        uuid.UUID  `json:"id"`              // unique identifier for the feed
+	Name      string     `json:"name"`            // name of the feed, shown as the calendar name by clients
+	ProjectID *uuid.UUID `json:"project_id"`      // only events of this project; null for all events
+	Token     string     `json:"token,omitempty"` // secret token; only returned when the feed is created or regenerated
+	URL       string     `json:"url,omitempty"`   // path clients subscribe to; only returned together with the token
+	CreatedAt time.Time  `json:"created_at"`      // timestamp when the feed was created
+}
+
+// NewFeed converts a feed model into its API representation.
+//
+// Parameters:
+//   - f: The feed model to convert.
+//
+// Returns:
+//   - The feed DTO.
+func NewFeed(f model.Feed) Feed {
+	feed := Feed{
+		ID:        f.ID,
+		Name:      f.Name,
+		ProjectID: f.ProjectID,
+		Token:     f.Token,
+		CreatedAt: f.CreatedAt,
+	}
+	if f.Token != "" {
+		feed.URL = "/feeds/" + f.Token + ".ics"
+	}
+
+	return feed
+}
+
+// NewFeeds converts a slice of feed models into their API representations.
+//
+// Parameters:
+//   - feeds: The feed models to convert.
+//
+// Returns:
+//   - A slice of feed DTOs, never nil.
+func NewFeeds(feeds []model.Feed) []Feed {
+	result := make([]Feed, 0, len(feeds))
+	for _, f := range feeds {
+		result = append(result, NewFeed(f))
+	}
+
+	return result
+}
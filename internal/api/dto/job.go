@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Job represents the JSON contract of a background job and its progress.
+type Job struct {
+	ID              uuid.UUID       `json:"id"`               // unique identifier for the job
+	Kind            string          `json:"kind"`             // kind of operation, e.g. calendar_import
+	Status          string          `json:"status"`           // execution status (queued, running, completed, failed, cancelled)
+	Progress        int             `json:"progress"`         // processed units of work in percent
+	Total           int             `json:"total"`            // units of work; 0 if not known yet
+	Processed       int             `json:"processed"`        // units of work done so far
+	Result          json.RawMessage `json:"result"`           // kind-specific outcome; null until reported
+	Error           string          `json:"error"`            // reason of a failed job; empty otherwise
+	CancelRequested bool            `json:"cancel_requested"` // whether cancellation was requested
+	OutputType      string          `json:"output_type"`      // media type of the file the job produced; empty if none
+	CreatedAt       time.Time       `json:"created_at"`       // timestamp when the job was queued
+	StartedAt       *time.Time      `json:"started_at"`       // timestamp when a worker picked up the job
+	FinishedAt      *time.Time      `json:"finished_at"`      // timestamp when the job ended
+}
+
+// NewJob converts a job model into its API representation.
+//
+// Parameters:
+//   - j: The job model to convert.
+//
+// Returns:
+//   - The job DTO.
+func NewJob(j model.Job) Job {
+	progress := 0
+	switch {
+	case j.Status == model.JobCompleted:
+		progress = 100
+	case j.Total > 0:
+		progress = min(j.Processed*100/j.Total, 100)
+	}
+
+	return Job{
+		ID:              j.ID,
+		Kind:            j.Kind,
+		Status:          j.Status,
+		Progress:        progress,
+		Total:           j.Total,
+		Processed:       j.Processed,
+		Result:          j.Result,
+		Error:           j.Error,
+		CancelRequested: j.CancelRequested,
+		OutputType:      j.OutputType,
+		CreatedAt:       j.CreatedAt,
+		StartedAt:       j.StartedAt,
+		FinishedAt:      j.FinishedAt,
+	}
+}
+
+// NewJobs converts a slice of job models into their API representations.
+//
+// Parameters:
+//   - jobs: The job models to convert.
+//
+// Returns:
+//   - A slice of job DTOs, never nil.
+func NewJobs(jobs []model.Job) []Job {
+	result := make([]Job, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, NewJob(j))
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MachineToken represents the JSON contract of a token issued to an internal service,
+// named after the fields of OAuth 2.0 token responses.
+type MachineToken struct {
+	AccessToken string `json:"access_token"` // signed token to send as Bearer token
+	TokenType   string `json:"token_type"`   // always Bearer
+	ExpiresIn   int    `json:"expires_in"`   // lifetime of the token in seconds
+	Scope       string `json:"scope"`        // space-separated scopes granted to the token
+}
+
+// NewMachineToken converts a machine token model into its API representation.
+//
+// Parameters:
+//   - t: The machine token to convert.
+//
+// Returns:
+//   - The machine token DTO.
+func NewMachineToken(t model.MachineToken) MachineToken {
+	return MachineToken{
+		AccessToken: t.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(t.ExpiresIn.Seconds()),
+		Scope:       strings.Join(t.Scopes, " "),
+	}
+}
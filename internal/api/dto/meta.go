@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// APIPrefix represents a major version of the API and the path it is served under.
+type APIPrefix struct {
+	Name   string `json:"name"`   // name of the major version, e.g. v2
+	Prefix string `json:"prefix"` // path prefix of its routes, e.g. /api/v2
+}
+
+// Build represents the JSON contract of the build information of the service.
+type Build struct {
+	Commit    string `json:"commit"`     // commit the binary was built from; empty if unknown
+	BuildTime string `json:"build_time"` // time the binary was built, in RFC 3339; empty if unknown
+	GoVersion string `json:"go_version"` // version of the Go toolchain
+	Modified  bool   `json:"modified"`   // whether the binary was built from uncommitted changes
+}
+
+// Version represents the JSON contract of the version of the API and the service.
+type Version struct {
+	APIVersion string      `json:"api_version"` // date of the latest changelog entry, YYYY-MM-DD
+	Versions   []APIPrefix `json:"versions"`    // major versions of the API served side by side
+	Build      Build       `json:"build"`       // build information of the service
+}
+
+// APIChange represents the JSON contract of a single change of the API.
+type APIChange struct {
+	Type        string `json:"type"`                  // added, changed, deprecated or removed
+	Endpoint    string `json:"endpoint,omitempty"`    // method and path of the affected endpoint
+	Field       string `json:"field,omitempty"`       // affected request field or header
+	Description string `json:"description"`           // human-readable description of the change
+	Replacement string `json:"replacement,omitempty"` // what to use instead of a deprecated endpoint or field
+	Sunset      string `json:"sunset,omitempty"`      // date after which a deprecated endpoint or field may be removed
+}
+
+// ChangelogEntry represents the JSON contract of the API changes released on a date.
+type ChangelogEntry struct {
+	Date    string      `json:"date"`    // release date, YYYY-MM-DD
+	Changes []APIChange `json:"changes"` // changes released on that date
+}
+
+// Changelog represents the JSON contract of the changelog of the API.
+type Changelog struct {
+	APIVersion   string           `json:"api_version"`  // date of the latest changelog entry, YYYY-MM-DD
+	Entries      []ChangelogEntry `json:"entries"`      // changelog entries, the latest release first
+	Deprecations []APIChange      `json:"deprecations"` // deprecated endpoints, fields and headers still served
+}
+
+// NewVersion converts the API version, its major versions and the build information into their API representation.
+//
+// Parameters:
+//   - apiVersion: The date of the latest changelog entry.
+//   - prefixes: The major versions of the API.
+//   - b: The build information of the service.
+//
+// Returns:
+//   - The version DTO.
+func NewVersion(apiVersion string, prefixes []APIPrefix, b model.BuildInfo) Version {
+	return Version{
+		APIVersion: apiVersion,
+		Versions:   prefixes,
+		Build: Build{
+			Commit:    b.Commit,
+			BuildTime: b.BuildTime,
+			GoVersion: b.GoVersion,
+			Modified:  b.Modified,
+		},
+	}
+}
+
+// NewChangelog converts changelog entries and deprecations into their API representation.
+//
+// Parameters:
+//   - apiVersion: The date of the latest changelog entry.
+//   - entries: The changelog entries to convert.
+//   - deprecations: The deprecations still in effect.
+//
+// Returns:
+//   - The changelog DTO, with non-nil slices.
+func NewChangelog(apiVersion string, entries []model.ChangelogEntry, deprecations []model.APIChange) Changelog {
+	result := Changelog{
+		APIVersion:   apiVersion,
+		Entries:      make([]ChangelogEntry, 0, len(entries)),
+		Deprecations: newAPIChanges(deprecations),
+	}
+	for _, e := range entries {
+		result.Entries = append(result.Entries, ChangelogEntry{Date: e.Date, Changes: newAPIChanges(e.Changes)})
+	}
+
+	return result
+}
+
+// newAPIChanges converts API change models into their API representations, never nil.
+func newAPIChanges(changes []model.APIChange) []APIChange {
+	result := make([]APIChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, APIChange{
+			Type:        c.Type,
+			Endpoint:    c.Endpoint,
+			Field:       c.Field,
+			Description: c.Description,
+			Replacement: c.Replacement,
+			Sunset:      c.Sunset,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// NotificationLogEntry represents the JSON contract of a bounce or complaint in the notification log.
+type NotificationLogEntry struct {
+	ID         uuid.UUID `json:"id"`          // unique identifier of the entry
+	Provider   string    `json:"provider"`    // delivery provider that reported the feedback
+	Type       string    `json:"type"`        // feedback type, bounce or complaint
+	Recipient  string    `json:"recipient"`   // email address the feedback is about
+	Reason     string    `json:"reason"`      // provider's description of the feedback
+	OccurredAt time.Time `json:"occurred_at"` // time the provider observed the feedback
+}
+
+// NewNotificationLog converts notification log entry models into their API representation.
+//
+// Parameters:
+//   - entries: The notification log entry models to convert.
+//
+// Returns:
+//   - A non-nil slice of notification log entry DTOs.
+func NewNotificationLog(entries []model.NotificationLogEntry) []NotificationLogEntry {
+	result := make([]NotificationLogEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, NotificationLogEntry{
+			ID:         e.ID,
+			Provider:   e.Provider,
+			Type:       e.Type,
+			Recipient:  e.Recipient,
+			Reason:     e.Reason,
+			OccurredAt: e.OccurredAt,
+		})
+	}
+	return result
+}
+
+// NotificationHistoryEntry represents the JSON contract of a sent or failed reminder in a user's notification history.
+type NotificationHistoryEntry struct {
+	ID        uuid.UUID  `json:"id"`                   // unique identifier of the reminder
+	EventID   uuid.UUID  `json:"event_id"`             // identifier of the event the reminder was for
+	Message   string     `json:"message"`              // message of the reminder, typically the event title
+	RemindAt  time.Time  `json:"remind_at"`            // time the reminder was due
+	Status    string     `json:"status"`               // delivery status: sent, failed, or skipped after unsubscribing
+	SentAt    *time.Time `json:"sent_at"`              // time of the delivery; null for failed reminders
+	LastError string     `json:"last_error,omitempty"` // error of the last failed attempt
+	Archived  bool       `json:"archived"`             // whether the event has been archived since
+}
+
+// NewNotificationHistory converts reminder history entry models into their API representation.
+//
+// Parameters:
+//   - entries: The reminder history entry models to convert.
+//
+// Returns:
+//   - A non-nil slice of notification history entry DTOs.
+func NewNotificationHistory(entries []model.ReminderHistoryEntry) []NotificationHistoryEntry {
+	result := make([]NotificationHistoryEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, NotificationHistoryEntry{
+			ID:        e.ID,
+			EventID:   e.EventID,
+			Message:   e.Message,
+			RemindAt:  e.RemindAt,
+			Status:    e.Status,
+			SentAt:    e.SentAt,
+			LastError: e.LastError,
+			Archived:  e.Archived,
+		})
+	}
+	return result
+}
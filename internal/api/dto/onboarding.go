@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// OnboardingStatus represents the JSON contract of a user's progress through the guided setup.
+type OnboardingStatus struct {
+	Seeded    bool                   `json:"seeded"`    // whether the sample data was created
+	SeededAt  *time.Time             `json:"seeded_at"` // when the sample data was created
+	Steps     []model.OnboardingStep `json:"steps"`     // the steps in suggested order
+	Completed int                    `json:"completed"` // number of completed steps
+	Total     int                    `json:"total"`     // number of steps
+}
+
+// NewOnboardingStatus converts an onboarding status into its API representation.
+//
+// Parameters:
+//   - s: The onboarding status to convert.
+//
+// Returns:
+//   - The onboarding status DTO.
+func NewOnboardingStatus(s model.OnboardingStatus) OnboardingStatus {
+	status := OnboardingStatus{
+		Seeded:   s.SeededAt != nil,
+		SeededAt: s.SeededAt,
+		Steps:    s.Steps,
+		Total:    len(s.Steps),
+	}
+	for _, step := range s.Steps {
+		if step.Completed {
+			status.Completed++
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Project represents the JSON contract of a project returned by the API.
+type Project struct {
+	ID            uuid.UUID  `json:"id"`             // unique identifier for the project
+	Name          string     `json:"name"`           // name of the project
+	MilestoneDate *time.Time `json:"milestone_date"` // optional date the project is due
+	CreatedAt     time.Time  `json:"created_at"`     // timestamp when the project was created
+	UpdatedAt     time.Time  `json:"updated_at"`     // timestamp when the project was last updated
+}
+
+// TimelineItem represents a single bar or marker of a project timeline.
+type TimelineItem struct {
+	ID        uuid.UUID   `json:"id"`         // identifier of the event, task or project (for the milestone)
+	Kind      string      `json:"kind"`       // item kind (event, task, milestone)
+	Title     string      `json:"title"`      // title of the item
+	Start     *time.Time  `json:"start"`      // date of the item; null for tasks without a due date
+	Done      bool        `json:"done"`       // whether the item is completed
+	DependsOn []uuid.UUID `json:"depends_on"` // items that must happen first
+}
+
+// ProjectTimeline represents the Gantt-friendly timeline of a project.
+type ProjectTimeline struct {
+	Project  Project        `json:"project"`  // the project
+	Progress float64        `json:"progress"` // share of completed events and tasks (0.0-1.0)
+	Items    []TimelineItem `json:"items"`    // timeline items ordered by date
+}
+
+// NewProject converts a project model into its API representation.
+//
+// Parameters:
+//   - p: The project model to convert.
+//
+// Returns:
+//   - The project DTO.
+func NewProject(p model.Project) Project {
+	return Project{
+		ID:            p.ID,
+		Name:          p.Name,
+		MilestoneDate: p.MilestoneDate,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+// NewProjects converts a slice of project models into their API representations.
+//
+// Parameters:
+//   - projects: The project models to convert.
+//
+// Returns:
+//   - A slice of project DTOs, never nil.
+func NewProjects(projects []model.Project) []Project {
+	result := make([]Project, 0, len(projects))
+	for _, p := range projects {
+		result = append(result, NewProject(p))
+	}
+
+	return result
+}
+
+// NewProjectTimeline converts a project timeline into its API representation.
+//
+// Parameters:
+//   - t: The project timeline to convert.
+//
+// Returns:
+//   - The project timeline DTO; Items is never nil.
+func NewProjectTimeline(t model.ProjectTimeline) ProjectTimeline {
+	items := make([]TimelineItem, 0, len(t.Items))
+	for _, i := range t.Items {
+		items = append(items, TimelineItem(i))
+	}
+
+	return ProjectTimeline{
+		Project:  NewProject(t.Project),
+		Progress: t.Progress,
+		Items:    items,
+	}
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MeetingReport represents the JSON contract of the meeting load report of an organization.
+type MeetingReport struct {
+	OrgID        uuid.UUID           `json:"org_id"`        // identifier of the attendee group the organization is
+	WorkdayStart string              `json:"workday_start"` // start of the workday in the time zone of each member, e.g. "09:00"
+	WorkdayEnd   string              `json:"workday_end"`   // end of the workday, e.g. "17:00"
+	Members      []MemberMeetingLoad `json:"members"`       // members ordered by email, never null
+}
+
+// MemberMeetingLoad represents the weekly meeting load of a member of an organization.
+type MemberMeetingLoad struct {
+	UserID uuid.UUID         `json:"user_id"` // identifier of the member
+	Email  string            `json:"email"`   // email address of the member
+	Name   string            `json:"name"`    // name of the member
+	Weeks  []MeetingLoadWeek `json:"weeks"`   // most recent weeks first; empty until the member is aggregated
+}
+
+// MeetingLoadWeek represents the meeting load of a member in a week.
+type MeetingLoadWeek struct {
+	Week         string    `json:"week"`          // Monday the week starts on in the member's time zone, YYYY-MM-DD
+	Meetings     int       `json:"meetings"`      // meetings starting in the week
+	MeetingHours float64   `json:"meeting_hours"` // total hours in those meetings, rounded to two decimals
+	DayPercent   float64   `json:"day_percent"`   // share of the workdays spent in meetings, in percent
+	AfterHours   int       `json:"after_hours"`   // meetings not entirely within the workday
+	ComputedAt   time.Time `json:"computed_at"`   // time the week was last aggregated
+}
+
+// NewMeetingReport converts a meeting report model into its API representation.
+//
+// Parameters:
+//   - r: The meeting report model to convert.
+//
+// Returns:
+//   - The meeting report DTO.
+func NewMeetingReport(r model.MeetingReport) MeetingReport {
+	members := make([]MemberMeetingLoad, 0, len(r.Members))
+	for _, m := range r.Members {
+		weeks := make([]MeetingLoadWeek, 0, len(m.Weeks))
+		for _, w := range m.Weeks {
+			weeks = append(weeks, MeetingLoadWeek{
+				Week:         w.Week.Format(time.DateOnly),
+				Meetings:     w.Meetings,
+				MeetingHours: math.Round(float64(w.MeetingMinutes)/60*100) / 100,
+				DayPercent:   w.DayPercent,
+				AfterHours:   w.AfterHours,
+				ComputedAt:   w.ComputedAt,
+			})
+		}
+		members = append(members, MemberMeetingLoad{UserID: m.UserID, Email: m.Email, Name: m.Name, Weeks: weeks})
+	}
+
+	return MeetingReport{
+		OrgID:        r.GroupID,
+		WorkdayStart: clockTime(r.WorkdayStart),
+		WorkdayEnd:   clockTime(r.WorkdayEnd),
+		Members:      members,
+	}
+}
+
+// clockTime formats a time after midnight as hours and minutes, e.g. "09:00".
+func clockTime(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
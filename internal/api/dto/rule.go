@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Rule represents the JSON contract of an event rule returned by the API.
+type Rule struct {
+	ID         uuid.UUID             `json:"id"`         // unique identifier for the rule
+	Name       string                `json:"name"`       // name of the rule
+	Position   int                   `json:"position"`   // rules are evaluated in ascending position
+	Conditions []model.RuleCondition `json:"conditions"` // conditions an event must all match
+	Color      string                `json:"color"`      // color given to matching events; empty leaves the color unchanged
+	Tags       []string              `json:"tags"`       // tags added to matching events, never null
+	Enabled    bool                  `json:"enabled"`    // whether the rule is applied
+	CreatedAt  time.Time             `json:"created_at"` // timestamp when the rule was created
+	UpdatedAt  time.Time             `json:"updated_at"` // timestamp when the rule was last updated
+}
+
+// RulePreview represents an existing event a rule matches, with the color and tags the rule would give it.
+type RulePreview struct {
+	Event Event    `json:"event"` // the matching event as stored
+	Color string   `json:"color"` // color of the event after applying the rule
+	Tags  []string `json:"tags"`  // tags of the event after applying the rule, never null
+}
+
+// NewRule converts a rule model into its API representation.
+//
+// Parameters:
+//   - r: The rule model to convert.
+//
+// Returns:
+//   - The rule DTO.
+func NewRule(r model.EventRule) Rule {
+	tags := r.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return Rule{
+		ID:         r.ID,
+		Name:       r.Name,
+		Position:   r.Position,
+		Conditions: r.Conditions,
+		Color:      r.Color,
+		Tags:       tags,
+		Enabled:    r.Enabled,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// NewRules converts a slice of rule models into their API representations.
+//
+// Parameters:
+//   - rules: The rule models to convert.
+//
+// Returns:
+//   - A slice of rule DTOs, never nil.
+func NewRules(rules []model.EventRule) []Rule {
+	result := make([]Rule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, NewRule(r))
+	}
+
+	return result
+}
+
+// NewRulePreviews converts the events matched by a rule preview into their API representations.
+//
+// Parameters:
+//   - previews: The matching events with the color and tags the rule would give them.
+//   - now: The reference time for computing IsPast.
+//
+// Returns:
+//   - A slice of rule preview DTOs, never nil.
+func NewRulePreviews(previews []model.RulePreview, now time.Time) []RulePreview {
+	result := make([]RulePreview, 0, len(previews))
+	for _, p := range previews {
+		tags := p.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		result = append(result, RulePreview{Event: NewEvent(p.Event, now), Color: p.Color, Tags: tags})
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// SecurityEvent represents the JSON contract of an entry in a user's security event log.
+type SecurityEvent struct {
+	ID        uuid.UUID `json:"id"`         // unique identifier of the event
+	Type      string    `json:"type"`       // event type, e.g. login or login_failed
+	IP        string    `json:"ip"`         // client IP address
+	UserAgent string    `json:"user_agent"` // client user agent
+	CreatedAt time.Time `json:"created_at"` // time of the event
+}
+
+// NewSecurityEvents converts security event models into their API representation.
+//
+// Parameters:
+//   - events: The security event models to convert.
+//
+// Returns:
+//   - A non-nil slice of security event DTOs.
+func NewSecurityEvents(events []model.SecurityEvent) []SecurityEvent {
+	result := make([]SecurityEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, SecurityEvent{
+			ID:        e.ID,
+			Type:      e.Type,
+			IP:        e.IP,
+			UserAgent: e.UserAgent,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// ShortLink represents the JSON contract of a short link returned to the owner of the event.
+type ShortLink struct {
+	Code       string    `json:"code"`       // short code, resolved at /e/{code}
+	EventID    uuid.UUID `json:"event_id"`   // identifier of the shared event
+	Visibility string    `json:"visibility"` // what invitees see: busy, basic or details
+	ExpiresAt  time.Time `json:"expires_at"` // time from which the code no longer resolves
+	CreatedAt  time.Time `json:"created_at"` // timestamp when the link was created
+}
+
+// SharedEvent represents the public JSON contract of an event resolved by a short link.
+// Fields the visibility of the link does not allow are omitted.
+type SharedEvent struct {
+	EventID     uuid.UUID `json:"event_id"`              // identifier of the event, for following it
+	Visibility  string    `json:"visibility"`            // visibility of the link
+	Title       string    `json:"title,omitempty"`       // title; omitted for busy links
+	Description string    `json:"description,omitempty"` // description; only for details links
+	EventDate   time.Time `json:"event_date"`            // date and time of the event
+	Color       string    `json:"color,omitempty"`       // color; omitted for busy links and events without one
+	ExpiresAt   time.Time `json:"expires_at"`            // time from which the link no longer resolves
+	Attending   int       `json:"attending"`             // number of attendees and guests who accepted
+}
+
+// NewShortLink converts a short link model into its API representation.
+//
+// Parameters:
+//   - l: The short link model to convert.
+//
+// Returns:
+//   - The short link DTO.
+func NewShortLink(l model.ShortLink) ShortLink {
+	return ShortLink{
+		Code:       l.Code,
+		EventID:    l.EventID,
+		Visibility: l.Visibility,
+		ExpiresAt:  l.ExpiresAt,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+// NewShortLinks converts a slice of short link models into their API representations.
+//
+// Parameters:
+//   - links: The short link models to convert.
+//
+// Returns:
+//   - A slice of short link DTOs, never nil.
+func NewShortLinks(links []model.ShortLink) []ShortLink {
+	result := make([]ShortLink, 0, len(links))
+	for _, l := range links {
+		result = append(result, NewShortLink(l))
+	}
+
+	return result
+}
+
+// NewSharedEvent converts a shared event into its public API representation.
+//
+// Parameters:
+//   - e: The shared event to convert.
+//
+// Returns:
+//   - The shared event DTO.
+func NewSharedEvent(e model.SharedEvent) SharedEvent {
+	return SharedEvent{
+		EventID:     e.EventID,
+		Visibility:  e.Visibility,
+		Title:       e.Title,
+		Description: e.Description,
+		EventDate:   e.EventDate,
+		Color:       e.Color,
+		ExpiresAt:   e.ExpiresAt,
+		Attending:   e.Attending,
+	}
+}
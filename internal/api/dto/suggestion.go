@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Suggestion represents the tags and project suggested for a new event.
+type Suggestion struct {
+	Tags      []string   `json:"tags"`       // suggested tags, most likely first, never null
+	ProjectID *uuid.UUID `json:"project_id"` // suggested project; null if there is none
+}
+
+// CreatedEvent represents the result of creating an event.
+type CreatedEvent struct {
+	ID          uuid.UUID  `json:"id"`          // unique identifier of the created event
+	Suggestions Suggestion `json:"suggestions"` // tags and project suggested from the user's previous events
+	AutoTagged  bool       `json:"auto_tagged"` // whether the suggestions were applied to the event
+}
+
+// NewCreatedEvent builds the API representation of a created event.
+//
+// Parameters:
+//   - id: The UUID of the created event.
+//   - s: The suggestion made for the event.
+//   - autoTagged: Whether the suggestion was applied.
+//
+// Returns:
+//   - The created event DTO.
+func NewCreatedEvent(id uuid.UUID, s model.Suggestion, autoTagged bool) CreatedEvent {
+	tags := s.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return CreatedEvent{
+		ID:          id,
+		Suggestions: Suggestion{Tags: tags, ProjectID: s.ProjectID},
+		AutoTagged:  autoTagged,
+	}
+}
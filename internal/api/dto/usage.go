@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Usage represents the JSON contract of a user's API usage in the current month.
+type Usage struct {
+	Period    time.Time `json:"period"`    // start of the metering period
+	ResetsAt  time.Time `json:"resets_at"` // start of the next metering period
+	Calls     int64     `json:"calls"`     // API calls made in the period
+	Limit     *int64    `json:"limit"`     // monthly quota; null if unlimited
+	Remaining *int64    `json:"remaining"` // calls left in the period; null if unlimited
+}
+
+// NewUsage converts a usage model into its API representation.
+//
+// Parameters:
+//   - u: The usage model to convert.
+//
+// Returns:
+//   - The usage DTO.
+func NewUsage(u model.Usage) Usage {
+	result := Usage{
+		Period:   u.Period,
+		ResetsAt: u.ResetsAt,
+		Calls:    u.Calls,
+	}
+
+	if u.Limit > 0 {
+		limit, remaining := u.Limit, u.Remaining()
+		result.Limit = &limit
+		result.Remaining = &remaining
+	}
+
+	return result
+}
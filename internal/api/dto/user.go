@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// User represents the JSON contract of a user returned by the API.
+// Sensitive fields such as the password hash are never part of it.
+type User struct {
+	ID        uuid.UUID `json:"id"`         // unique identifier for the user
+	Email     string    `json:"email"`      // user's email address
+	Name      string    `json:"name"`       // user's name
+	Role      string    `json:"role"`       // user's role
+	CreatedAt time.Time `json:"created_at"` // timestamp when the user was created
+	UpdatedAt time.Time `json:"updated_at"` // timestamp when the user was last updated
+}
+
+// NewUser converts a user model into its API representation.
+//
+// Parameters:
+//   - u: The user model to convert.
+//
+// Returns:
+//   - The user DTO.
+func NewUser(u model.User) User {
+	return User{
+		ID:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      u.Role,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
+
+// Token represents the JSON contract of an issued authentication token.
+type Token struct {
+	Token string `json:"token"` // signed JWT
+}
+
+// DemoAccount represents the JSON contract of a throwaway account registered in demo mode.
+type DemoAccount struct {
+	UserID    uuid.UUID `json:"user_id"`    // identifier of the account
+	Email     string    `json:"email"`      // generated email address to log in with
+	Password  string    `json:"password"`   // generated password, returned only once
+	Token     string    `json:"token"`      // signed JWT of the account
+	ExpiresAt time.Time `json:"expires_at"` // time after which the account and its data are deleted
+}
+
+// NewDemoAccount converts a demo account model into its API representation.
+//
+// Parameters:
+//   - a: The demo account model to convert.
+//
+// Returns:
+//   - The demo account DTO.
+func NewDemoAccount(a model.DemoAccount) DemoAccount {
+	return DemoAccount(a)
+}
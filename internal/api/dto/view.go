@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// View represents the JSON contract of a saved view returned by the API.
+type View struct {
+	ID        uuid.UUID        `json:"id"`         // unique identifier for the view
+	Name      string           `json:"name"`       // name of the view
+	Filter    model.ViewFilter `json:"filter"`     // criteria the events must match
+	CreatedAt time.Time        `json:"created_at"` // timestamp when the view was created
+	UpdatedAt time.Time        `json:"updated_at"` // timestamp when the view was last updated
+}
+
+// ViewEvents represents the events currently matching a saved view.
+type ViewEvents struct {
+	View   View   `json:"view"`   // the view
+	Events Events `json:"events"` // matching events ordered by date
+}
+
+// NewView converts a view model into its API representation.
+//
+// Parameters:
+//   - v: The view model to convert.
+//
+// Returns:
+//   - The view DTO.
+func NewView(v model.View) View {
+	return View{
+		ID:        v.ID,
+		Name:      v.Name,
+		Filter:    v.Filter,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+}
+
+// NewViews converts a slice of view models into their API representations.
+//
+// Parameters:
+//   - views: The view models to convert.
+//
+// Returns:
+//   - A slice of view DTOs, never nil.
+func NewViews(views []model.View) []View {
+	result := make([]View, 0, len(views))
+	for _, v := range views {
+		result = append(result, NewView(v))
+	}
+
+	return result
+}
+
+// NewViewEvents converts a view and its matching events into their API representation.
+//
+// Parameters:
+//   - v: The view model.
+//   - events: The events matching the view.
+//   - now: The reference time for computing IsPast.
+//
+// Returns:
+//   - The view events DTO; Events is never nil.
+func NewViewEvents(v model.View, events []model.Event, now time.Time) ViewEvents {
+	return ViewEvents{
+		View:   NewView(v),
+		Events: NewEvents(events, now),
+	}
+}
@@ -0,0 +1,333 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/maintenance"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// reminderQueue reports the backlog of pending reminders.
+type reminderQueue interface {
+	// QueueStats counts the pending reminders and finds the oldest one that is due.
+	QueueStats(ctx context.Context) (model.ReminderQueueStats, error)
+}
+
+// reminderWorker reports the activity of the reminder worker of this instance.
+type reminderWorker interface {
+	// Status reports the activity of the worker since it started.
+	Status() model.ReminderWorkerStatus
+}
+
+// archiverWorker reports the activity of the archiver worker of this instance.
+type archiverWorker interface {
+	// Status reports the activity of the worker since it started.
+	Status() model.ArchiverStatus
+}
+
+// jobWorker reports the activity of the job worker pool of this instance.
+type jobWorker interface {
+	// Status reports the activity of the pool since it started.
+	Status() model.JobWorkerStatus
+}
+
+// Handler manages HTTP requests for operator-only administration endpoints.
+type Handler struct {
+	logLevel    zap.AtomicLevel       // logLevel is the runtime-adjustable level of the application logger
+	debugLog    *middlewares.DebugLog // debugLog holds the request/response debug logging settings
+	maintenance *maintenance.Mode     // maintenance is the runtime-toggleable maintenance mode
+	queue       reminderQueue         // queue reports the backlog of pending reminders
+	reminders   reminderWorker        // reminders reports the activity of the reminder worker
+	archiver    archiverWorker        // archiver reports the activity of the archiver worker
+	jobs        jobWorker             // jobs reports the activity of the job worker pool
+	users       userDirectory         // users lists user accounts and changes their roles
+	logger      *zap.Logger           // logger logs application events and errors
+	validator   *validator.Validate   // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - logLevel: The atomic level of the application logger.
+//   - debugLog: The runtime debug logging settings.
+//   - m: The maintenance mode of the service.
+//   - queue: The reminder queue statistics.
+//   - reminders: The reminder worker of this instance.
+//   - archiver: The archiver worker of this instance.
+//   - jobs: The job worker pool of this instance.
+//   - users: The user accounts of the service.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(
+	logLevel zap.AtomicLevel,
+	debugLog *middlewares.DebugLog,
+	m *maintenance.Mode,
+	queue reminderQueue,
+	reminders reminderWorker,
+	archiver archiverWorker,
+	jobs jobWorker,
+	users userDirectory,
+	l *zap.Logger,
+	v *validator.Validate,
+) *Handler {
+	return &Handler{
+		logLevel:    logLevel,
+		debugLog:    debugLog,
+		maintenance: m,
+		queue:       queue,
+		reminders:   reminders,
+		archiver:    archiver,
+		jobs:        jobs,
+		users:       users,
+		logger:      l,
+		validator:   v,
+	}
+}
+
+// LogLevelRequest represents the payload for changing the log level at runtime.
+type LogLevelRequest struct {
+	Level string `json:"level" validate:"required,oneof=debug info warn error"`
+}
+
+// LogLevelResponse represents the current log level.
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
+
+// GetLogLevel handles HTTP requests to read the current log level.
+func (h *Handler) GetLogLevel(w http.ResponseWriter, _ *http.Request) {
+	response.OK(w, LogLevelResponse{Level: h.logLevel.Level().String()})
+}
+
+// SetLogLevel handles HTTP requests to change the log level without restarting the process.
+// It decodes and validates the requested level and applies it to the application logger.
+func (h *Handler) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req LogLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode log level request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	if err := h.logLevel.UnmarshalText([]byte(req.Level)); err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid log level"))
+		return
+	}
+
+	h.logger.Info("log level changed", zap.String("level", req.Level))
+	response.OK(w, LogLevelResponse{Level: h.logLevel.Level().String()})
+}
+
+// GetDebugLogging handles HTTP requests to read the request/response debug logging settings.
+func (h *Handler) GetDebugLogging(w http.ResponseWriter, _ *http.Request) {
+	response.OK(w, h.debugLog.Settings())
+}
+
+// SetDebugLogging handles HTTP requests to toggle request/response debug logging at runtime.
+// The settings select which routes and users are logged.
+func (h *Handler) SetDebugLogging(w http.ResponseWriter, r *http.Request) {
+	var req middlewares.DebugSettings
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode debug logging request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	h.debugLog.Set(req)
+
+	h.logger.Info("debug logging changed",
+		zap.Bool("enabled", req.Enabled),
+		zap.Strings("routes", req.Routes),
+		zap.Int("users", len(req.UserIDs)),
+	)
+	response.OK(w, h.debugLog.Settings())
+}
+
+// MaintenanceRequest represents the payload for switching maintenance mode.
+type MaintenanceRequest struct {
+	Enabled    bool   `json:"enabled"`     // whether to enable maintenance mode
+	RetryAfter string `json:"retry_after"` // optional Retry-After duration, e.g. "10m"; keeps the current one when empty
+	Message    string `json:"message"`     // optional message returned to clients
+}
+
+// MaintenanceResponse represents the current maintenance mode.
+type MaintenanceResponse struct {
+	Enabled    bool   `json:"enabled"`     // whether maintenance mode is on
+	RetryAfter string `json:"retry_after"` // Retry-After duration sent to clients
+	Message    string `json:"message"`     // message returned to clients
+}
+
+// GetMaintenance handles HTTP requests to read the maintenance mode.
+func (h *Handler) GetMaintenance(w http.ResponseWriter, _ *http.Request) {
+	response.OK(w, newMaintenanceResponse(h.maintenance.Settings()))
+}
+
+// SetMaintenance handles HTTP requests to switch maintenance mode at runtime.
+// While it is on, non-admin API requests get 503 and background workers pause.
+// The switch applies to the instance serving the request.
+func (h *Handler) SetMaintenance(w http.ResponseWriter, r *http.Request) {
+	var req MaintenanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode maintenance request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	var retryAfter time.Duration
+	if req.RetryAfter != "" {
+		d, err := time.ParseDuration(req.RetryAfter)
+		if err != nil || d <= 0 {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid retry_after"))
+			return
+		}
+		retryAfter = d
+	}
+
+	h.maintenance.Set(maintenance.Settings{
+		Enabled:    req.Enabled,
+		RetryAfter: retryAfter,
+		Message:    req.Message,
+	})
+
+	settings := h.maintenance.Settings()
+	h.logger.Warn("maintenance mode changed",
+		zap.Bool("enabled", settings.Enabled),
+		zap.Duration("retry_after", settings.RetryAfter),
+	)
+	response.OK(w, newMaintenanceResponse(settings))
+}
+
+// newMaintenanceResponse converts maintenance settings into their API representation.
+func newMaintenanceResponse(s maintenance.Settings) MaintenanceResponse {
+	return MaintenanceResponse{
+		Enabled:    s.Enabled,
+		RetryAfter: s.RetryAfter.String(),
+		Message:    s.Message,
+	}
+}
+
+// ReminderWorkerResponse represents the state of the reminder pipeline.
+type ReminderWorkerResponse struct {
+	QueueDepth       int        `json:"queue_depth"`        // due reminders waiting to be claimed
+	Pending          int        `json:"pending"`            // pending reminders, including future and leased ones
+	Leased           int        `json:"leased"`             // reminders leased to a worker instance
+	OldestPendingAge float64    `json:"oldest_pending_age"` // seconds the oldest due reminder has been waiting; 0 if none
+	InFlight         int64      `json:"in_flight"`          // reminders being sent by this instance
+	Sent             int64      `json:"sent"`               // reminders delivered by this instance
+	Failed           int64      `json:"failed"`             // failed delivery attempts of this instance
+	Deferred         int64      `json:"deferred"`           // reminders this instance deferred because their recipient domain was throttled
+	Skipped          int64      `json:"skipped"`            // reminders this instance skipped because their user unsubscribed
+	Errors           int64      `json:"errors"`             // failures to claim reminders or record their outcome
+	LastPollAt       *time.Time `json:"last_poll_at"`       // time of the last poll; null before the first one
+}
+
+// ArchiverWorkerResponse represents the state of the archiver worker.
+type ArchiverWorkerResponse struct {
+	Runs            int64      `json:"runs"`              // archiving passes started by this instance
+	Errors          int64      `json:"errors"`            // tenant passes that failed
+	LastRunAt       *time.Time `json:"last_run_at"`       // start of the last pass; null before the first one
+	LastRunDuration string     `json:"last_run_duration"` // duration of the last pass, e.g. "1.5s"
+	LastRunArchived int        `json:"last_run_archived"` // events archived by the last pass
+	LastRunExported int        `json:"last_run_exported"` // archived events exported to cold storage by the last pass
+	LastRunPurged   int        `json:"last_run_purged"`   // trashed events permanently deleted by the last pass
+	LastError       string     `json:"last_error"`        // error of the last failed tenant pass
+}
+
+// JobWorkerResponse represents the state of the job worker pool.
+type JobWorkerResponse struct {
+	Workers    int        `json:"workers"`      // size of the pool
+	Running    int64      `json:"running"`      // jobs being executed by this instance
+	Completed  int64      `json:"completed"`    // jobs completed by this instance
+	Failed     int64      `json:"failed"`       // jobs that failed on this instance
+	Cancelled  int64      `json:"cancelled"`    // jobs cancelled while running on this instance
+	Errors     int64      `json:"errors"`       // failures to claim jobs or record their outcome
+	LastPollAt *time.Time `json:"last_poll_at"` // time of the last poll; null before the first one
+}
+
+// WorkersResponse represents the status of the background workers.
+type WorkersResponse struct {
+	Reminder ReminderWorkerResponse `json:"reminder"` // reminder worker and queue
+	Archiver ArchiverWorkerResponse `json:"archiver"` // archiver worker
+	Jobs     JobWorkerResponse      `json:"jobs"`     // job worker pool
+}
+
+// GetWorkers handles HTTP requests to read the status of the background workers, so operators can spot
+// a stuck pipeline. Queue statistics are read from the database of the request's tenant;
+// worker counters describe the instance serving the request since it started.
+func (h *Handler) GetWorkers(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.queue.QueueStats(r.Context())
+	if err != nil {
+		h.logger.Error("failed to get reminder queue stats", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, newWorkersResponse(stats, h.reminders.Status(), h.archiver.Status(), h.jobs.Status(), time.Now()))
+}
+
+// newWorkersResponse converts the worker and queue statuses into their API representation.
+func newWorkersResponse(
+	stats model.ReminderQueueStats,
+	reminders model.ReminderWorkerStatus,
+	archiver model.ArchiverStatus,
+	jobs model.JobWorkerStatus,
+	now time.Time,
+) WorkersResponse {
+	var oldestAge float64
+	if stats.OldestDueAt != nil {
+		oldestAge = now.Sub(*stats.OldestDueAt).Seconds()
+	}
+
+	return WorkersResponse{
+		Reminder: ReminderWorkerResponse{
+			QueueDepth:       stats.Due,
+			Pending:          stats.Pending,
+			Leased:           stats.Leased,
+			OldestPendingAge: oldestAge,
+			InFlight:         reminders.InFlight,
+			Sent:             reminders.Sent,
+			Failed:           reminders.Failed,
+			Deferred:         reminders.Deferred,
+			Skipped:          reminders.Skipped,
+			Errors:           reminders.Errors,
+			LastPollAt:       reminders.LastPollAt,
+		},
+		Archiver: ArchiverWorkerResponse{
+			Runs:            archiver.Runs,
+			Errors:          archiver.Errors,
+			LastRunAt:       archiver.LastRunAt,
+			LastRunDuration: archiver.LastRunDuration.String(),
+			LastRunArchived: archiver.LastRunArchived,
+			LastRunExported: archiver.LastRunExported,
+			LastRunPurged:   archiver.LastRunPurged,
+			LastError:       archiver.LastError,
+		},
+		Jobs: JobWorkerResponse{
+			Workers:    jobs.Workers,
+			Running:    jobs.Running,
+			Completed:  jobs.Completed,
+			Failed:     jobs.Failed,
+			Cancelled:  jobs.Cancelled,
+			Errors:     jobs.Errors,
+			LastPollAt: jobs.LastPollAt,
+		},
+	}
+}
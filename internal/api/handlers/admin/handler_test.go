@@ -0,0 +1,422 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/maintenance"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	userrepo "github.com/aliskhannn/calendar-service/internal/repository/user"
+)
+
+// fakeQueue reports fixed reminder queue statistics.
+type fakeQueue struct {
+	stats model.ReminderQueueStats
+	err   error
+}
+
+func (q *fakeQueue) QueueStats(context.Context) (model.ReminderQueueStats, error) {
+	return q.stats, q.err
+}
+
+// fakeReminderWorker reports a fixed reminder worker status.
+type fakeReminderWorker struct{ status model.ReminderWorkerStatus }
+
+func (w *fakeReminderWorker) Status() model.ReminderWorkerStatus { return w.status }
+
+// fakeArchiver reports a fixed archiver status.
+type fakeArchiver struct{ status model.ArchiverStatus }
+
+func (a *fakeArchiver) Status() model.ArchiverStatus { return a.status }
+
+// fakeJobWorker reports a fixed job worker pool status.
+type fakeJobWorker struct{ status model.JobWorkerStatus }
+
+func (j *fakeJobWorker) Status() model.JobWorkerStatus { return j.status }
+
+// fakeUsers serves users from memory and records role changes.
+type fakeUsers struct {
+	users []model.User
+	err   error
+	email string     // email filter of the last ListUsers call
+	page  model.Page // page of the last ListUsers call
+	mode  string     // total mode of the last CountUsers call
+}
+
+func (u *fakeUsers) ListUsers(_ context.Context, email string, page model.Page) ([]model.User, error) {
+	u.email, u.page = email, page
+	from := min(page.Offset, len(u.users))
+	return u.users[from:min(from+page.Limit+1, len(u.users))], u.err
+}
+
+func (u *fakeUsers) CountUsers(_ context.Context, _ string, mode string) (int, error) {
+	u.mode = mode
+	return len(u.users), u.err
+}
+
+func (u *fakeUsers) SetRole(_ context.Context, id uuid.UUID, role string, _ model.ClientInfo) error {
+	for i := range u.users {
+		if u.users[i].ID == id {
+			u.users[i].Role = role
+			return nil
+		}
+	}
+	return userrepo.ErrUserNotFound
+}
+
+func setupHandler() (*Handler, zap.AtomicLevel) {
+	h, level, _ := setupUsersHandler()
+	return h, level
+}
+
+func setupUsersHandler() (*Handler, zap.AtomicLevel, *fakeUsers) {
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+	m := maintenance.New(config.Maintenance{RetryAfter: 5 * time.Minute})
+	users := &fakeUsers{}
+	h := New(level, middlewares.NewDebugLog(zap.NewNop()), m,
+		&fakeQueue{}, &fakeReminderWorker{}, &fakeArchiver{}, &fakeJobWorker{}, users, zap.NewNop(), validator.New())
+	return h, level, users
+}
+
+func TestHandler_SetLogLevel_Success(t *testing.T) {
+	h, level := setupHandler()
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/log-level", bytes.NewReader([]byte(`{"level":"debug"}`)))
+	w := httptest.NewRecorder()
+
+	h.SetLogLevel(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if level.Level() != zapcore.DebugLevel {
+		t.Fatalf("expected level debug, got %s", level.Level())
+	}
+}
+
+func TestHandler_SetLogLevel_Invalid(t *testing.T) {
+	h, level := setupHandler()
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/log-level", bytes.NewReader([]byte(`{"level":"verbose"}`)))
+	w := httptest.NewRecorder()
+
+	h.SetLogLevel(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if level.Level() != zapcore.InfoLevel {
+		t.Fatalf("expected level to stay info, got %s", level.Level())
+	}
+}
+
+func TestHandler_GetLogLevel(t *testing.T) {
+	h, _ := setupHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/log-level", nil)
+	w := httptest.NewRecorder()
+
+	h.GetLogLevel(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte(`"level":"info"`)) {
+		t.Fatalf("unexpected body: %s", w.Body.String())
+	}
+}
+
+func TestHandler_SetDebugLogging(t *testing.T) {
+	h, _ := setupHandler()
+
+	body := []byte(`{"enabled":true,"routes":["/api/events"]}`)
+	req := httptest.NewRequest(http.MethodPut, "/admin/debug-logging", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.SetDebugLogging(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if s := h.debugLog.Settings(); !s.Enabled || len(s.Routes) != 1 {
+		t.Fatalf("unexpected settings: %+v", s)
+	}
+}
+
+func TestHandler_SetMaintenance(t *testing.T) {
+	h, _ := setupHandler()
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/maintenance", bytes.NewReader([]byte(`{"enabled":true,"retry_after":"10m"}`)))
+	w := httptest.NewRecorder()
+
+	h.SetMaintenance(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if s := h.maintenance.Settings(); !s.Enabled || s.RetryAfter != 10*time.Minute {
+		t.Fatalf("expected maintenance enabled for 10m, got %+v", s)
+	}
+}
+
+func TestHandler_SetMaintenance_InvalidRetryAfter(t *testing.T) {
+	h, _ := setupHandler()
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/maintenance", bytes.NewReader([]byte(`{"enabled":true,"retry_after":"soon"}`)))
+	w := httptest.NewRecorder()
+
+	h.SetMaintenance(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if h.maintenance.Enabled() {
+		t.Fatal("expected maintenance mode to stay off")
+	}
+}
+
+func TestHandler_GetWorkers(t *testing.T) {
+	h, _ := setupHandler()
+
+	oldest := time.Now().Add(-10 * time.Minute)
+	lastRun := time.Now().Add(-time.Hour)
+	h.queue = &fakeQueue{stats: model.ReminderQueueStats{Pending: 12, Due: 4, Leased: 2, OldestDueAt: &oldest}}
+	h.reminders = &fakeReminderWorker{status: model.ReminderWorkerStatus{InFlight: 2, Sent: 40, Failed: 3, Errors: 1}}
+	h.archiver = &fakeArchiver{status: model.ArchiverStatus{
+		Runs: 5, LastRunAt: &lastRun, LastRunDuration: 1500 * time.Millisecond, LastRunArchived: 7, LastRunExported: 3,
+	}}
+	h.jobs = &fakeJobWorker{status: model.JobWorkerStatus{Workers: 2, Running: 1, Completed: 9, Cancelled: 1}}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/workers", nil)
+	w := httptest.NewRecorder()
+
+	h.GetWorkers(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result WorkersResponse `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	reminder := resp.Result.Reminder
+	if reminder.QueueDepth != 4 || reminder.InFlight != 2 || reminder.Failed != 3 || reminder.Errors != 1 {
+		t.Fatalf("unexpected reminder status: %+v", reminder)
+	}
+	if reminder.OldestPendingAge < 600 || reminder.OldestPendingAge > 660 {
+		t.Fatalf("expected oldest pending age of about 600s, got %v", reminder.OldestPendingAge)
+	}
+	if reminder.LastPollAt != nil {
+		t.Fatalf("expected no poll yet, got %v", reminder.LastPollAt)
+	}
+
+	archiver := resp.Result.Archiver
+	if archiver.Runs != 5 || archiver.LastRunDuration != "1.5s" || archiver.LastRunArchived != 7 || archiver.LastRunExported != 3 || archiver.LastRunAt == nil {
+		t.Fatalf("unexpected archiver status: %+v", archiver)
+	}
+
+	jobs := resp.Result.Jobs
+	if jobs.Workers != 2 || jobs.Running != 1 || jobs.Completed != 9 || jobs.Cancelled != 1 {
+		t.Fatalf("unexpected job worker status: %+v", jobs)
+	}
+}
+
+func TestHandler_GetWorkers_QueueError(t *testing.T) {
+	h, _ := setupHandler()
+	h.queue = &fakeQueue{err: errors.New("connection refused")}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/workers", nil)
+	w := httptest.NewRecorder()
+
+	h.GetWorkers(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	h, _, users := setupUsersHandler()
+	users.users = []model.User{{ID: uuid.New(), Email: "jane@example.com", Password: "hash", Role: model.RoleAdmin}}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users?email=jane&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	h.ListUsers(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if users.email != "jane" || users.page != (model.Page{Limit: 10}) {
+		t.Fatalf("unexpected filter: email %q, page %+v", users.email, users.page)
+	}
+
+	var resp struct {
+		Result []map[string]any `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || resp.Result[0]["email"] != "jane@example.com" || resp.Result[0]["role"] != "admin" {
+		t.Fatalf("unexpected users: %v", resp.Result)
+	}
+	if _, ok := resp.Result[0]["password"]; ok {
+		t.Fatal("password must not be returned")
+	}
+}
+
+func TestHandler_ListUsers_Envelope(t *testing.T) {
+	h, _, users := setupUsersHandler()
+	for i := 0; i < 3; i++ {
+		users.users = append(users.users, model.User{ID: uuid.New(), Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+
+	type page struct {
+		Result struct {
+			Items          []map[string]any `json:"items"`
+			NextCursor     *string          `json:"next_cursor"`
+			Total          *int             `json:"total"`
+			TotalEstimated bool             `json:"total_estimated"`
+		} `json:"result"`
+	}
+
+	get := func(query string) page {
+		req := httptest.NewRequest(http.MethodGet, "/v2/admin/users?"+query, nil)
+		w := httptest.NewRecorder()
+		response.Envelope(http.HandlerFunc(h.ListUsers)).ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var p page
+		if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return p
+	}
+
+	first := get("limit=2&total=estimated")
+	if len(first.Result.Items) != 2 || first.Result.NextCursor == nil {
+		t.Fatalf("unexpected first page: %+v", first.Result)
+	}
+	if first.Result.Total == nil || *first.Result.Total != 3 || !first.Result.TotalEstimated || users.mode != model.TotalEstimated {
+		t.Fatalf("unexpected total: %+v", first.Result)
+	}
+
+	second := get("limit=2&cursor=" + *first.Result.NextCursor)
+	if len(second.Result.Items) != 1 || second.Result.Items[0]["email"] != "user2@example.com" {
+		t.Fatalf("unexpected second page: %+v", second.Result)
+	}
+	if second.Result.NextCursor != nil || second.Result.Total != nil {
+		t.Fatalf("expected the last page without a total, got %+v", second.Result)
+	}
+}
+
+func TestHandler_ListUsers_InvalidLimit(t *testing.T) {
+	h, _ := setupHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users?limit=0", nil)
+	w := httptest.NewRecorder()
+
+	h.ListUsers(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+// roleRequest builds a role change request of an admin for a user.
+func roleRequest(adminID, userID uuid.UUID, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPut, "/admin/users/"+userID.String()+"/role", bytes.NewReader([]byte(body)))
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", userID.String())
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	return req.WithContext(context.WithValue(ctx, middlewares.UserIDKey, adminID))
+}
+
+func TestHandler_SetUserRole(t *testing.T) {
+	h, _, users := setupUsersHandler()
+	userID := uuid.New()
+	users.users = []model.User{{ID: userID, Role: model.RoleUser}}
+
+	w := httptest.NewRecorder()
+	h.SetUserRole(w, roleRequest(uuid.New(), userID, `{"role":"admin"}`))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if users.users[0].Role != model.RoleAdmin {
+		t.Fatalf("expected role admin, got %s", users.users[0].Role)
+	}
+}
+
+func TestHandler_SetUserRole_Errors(t *testing.T) {
+	adminID := uuid.New()
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		body   string
+		want   int
+	}{
+		{name: "own role", userID: adminID, body: `{"role":"user"}`, want: http.StatusBadRequest},
+		{name: "invalid role", userID: uuid.New(), body: `{"role":"owner"}`, want: http.StatusBadRequest},
+		{name: "unknown user", userID: uuid.New(), body: `{"role":"admin"}`, want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := setupHandler()
+
+			w := httptest.NewRecorder()
+			h.SetUserRole(w, roleRequest(adminID, tt.userID, tt.body))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestUI(t *testing.T) {
+	ui := UI(config.Tenancy{Enabled: true, Header: "X-Tenant-ID"})
+
+	w := httptest.NewRecorder()
+	ui.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte("<title>Calendar Service Admin</title>")) {
+		t.Fatalf("unexpected page: %s", w.Body.String())
+	}
+	if w.Header().Get("Content-Security-Policy") == "" {
+		t.Fatal("expected a Content-Security-Policy header")
+	}
+
+	w = httptest.NewRecorder()
+	ui.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/config.json", nil))
+
+	if got := w.Body.String(); got != `{"tenancy":true,"tenant_header":"X-Tenant-ID"}`+"\n" {
+		t.Fatalf("unexpected config: %s", got)
+	}
+}
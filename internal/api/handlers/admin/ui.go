@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// uiFiles holds the static files of the admin web UI.
+//
+//go:embed ui
+var uiFiles embed.FS
+
+// uiConfig tells the admin web UI how to sign in.
+type uiConfig struct {
+	Tenancy      bool   `json:"tenancy"`       // whether the login needs a tenant
+	TenantHeader string `json:"tenant_header"` // request header carrying the tenant ID at login
+}
+
+// UI returns the handler serving the admin web UI under /admin/.
+// The UI is a static page without data of its own: it signs in through the login endpoint and
+// works with the admin API, which requires the admin role, so the files themselves are public.
+//
+// Parameters:
+//   - cfg: The tenancy configuration, passed to the UI so it can send the tenant header at login.
+//
+// Returns:
+//   - An HTTP handler for the files of the UI.
+func UI(cfg config.Tenancy) http.Handler {
+	files, err := fs.Sub(uiFiles, "ui")
+	if err != nil {
+		panic(err) // the directory is embedded at build time
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/admin/", http.StripPrefix("/admin", http.FileServer(http.FS(files))))
+	mux.HandleFunc("/admin/config.json", func(w http.ResponseWriter, _ *http.Request) {
+		response.JSON(w, http.StatusOK, uiConfig{Tenancy: cfg.Enabled, TenantHeader: cfg.Header})
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy",
+			"default-src 'none'; script-src 'self'; style-src 'self'; connect-src 'self'; form-action 'none'; frame-ancestors 'none'; base-uri 'none'")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("Cache-Control", "no-cache")
+		mux.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,240 @@
+// Admin UI of the calendar service. It talks to the admin API with the bearer token of a signed-in admin;
+// the token is kept in session storage and never leaves this tab.
+"use strict";
+
+const tokenKey = "calendar-admin-token";
+let config = {tenancy: false, tenant_header: ""};
+
+const $ = (id) => document.getElementById(id);
+
+// api calls the JSON API and returns the result, throwing the error message of failed requests.
+async function api(method, path, body, headers) {
+  const init = {method, headers: Object.assign({"Accept": "application/json"}, headers)};
+  const token = sessionStorage.getItem(tokenKey);
+  if (token) {
+    init.headers["Authorization"] = "Bearer " + token;
+  }
+  if (body !== undefined) {
+    init.headers["Content-Type"] = "application/json";
+    init.body = JSON.stringify(body);
+  }
+
+  const res = await fetch(path, init);
+  const data = await res.json().catch(() => ({}));
+  if (res.status === 401 || res.status === 403) {
+    signOut(res.status === 403 ? "This account is not an admin." : "Your session has expired.");
+  }
+  if (!res.ok) {
+    throw new Error(data.error || res.statusText);
+  }
+  return data.result;
+}
+
+function flash(message) {
+  $("status").textContent = message;
+  setTimeout(() => { if ($("status").textContent === message) $("status").textContent = ""; }, 4000);
+}
+
+function fail(err) {
+  flash("Error: " + err.message);
+}
+
+function signOut(message) {
+  sessionStorage.removeItem(tokenKey);
+  $("app").hidden = true;
+  $("logout").hidden = true;
+  $("login").hidden = false;
+  $("login-error").textContent = message || "";
+}
+
+async function signIn(token) {
+  sessionStorage.setItem(tokenKey, token);
+  $("login").hidden = true;
+  $("logout").hidden = false;
+  $("app").hidden = false;
+  await Promise.all([loadWorkers(), loadUsers(), loadSettings()]).catch(fail);
+}
+
+// list renders key/value pairs into a definition list.
+function list(dl, entries) {
+  dl.replaceChildren();
+  for (const [key, value] of entries) {
+    const dt = document.createElement("dt");
+    dt.textContent = key;
+    const dd = document.createElement("dd");
+    dd.textContent = value === null || value === undefined || value === "" ? "–" : String(value);
+    dl.append(dt, dd);
+  }
+}
+
+function time(value) {
+  return value ? new Date(value).toLocaleString() : null;
+}
+
+async function loadWorkers() {
+  const w = await api("GET", "/api/admin/workers");
+  list($("workers-reminder"), [
+    ["Due", w.reminder.queue_depth],
+    ["Pending", w.reminder.pending],
+    ["Leased", w.reminder.leased],
+    ["Oldest due", w.reminder.oldest_pending_age ? Math.round(w.reminder.oldest_pending_age) + "s" : null],
+    ["In flight", w.reminder.in_flight],
+    ["Sent", w.reminder.sent],
+    ["Failed", w.reminder.failed],
+    ["Deferred", w.reminder.deferred],
+    ["Skipped", w.reminder.skipped],
+    ["Errors", w.reminder.errors],
+    ["Last poll", time(w.reminder.last_poll_at)],
+  ]);
+  list($("workers-archiver"), [
+    ["Runs", w.archiver.runs],
+    ["Errors", w.archiver.errors],
+    ["Last run", time(w.archiver.last_run_at)],
+    ["Duration", w.archiver.last_run_at ? w.archiver.last_run_duration : null],
+    ["Archived", w.archiver.last_run_archived],
+    ["Exported to cold storage", w.archiver.last_run_exported],
+    ["Last error", w.archiver.last_error],
+  ]);
+  list($("workers-jobs"), [
+    ["Workers", w.jobs.workers],
+    ["Running", w.jobs.running],
+    ["Completed", w.jobs.completed],
+    ["Failed", w.jobs.failed],
+    ["Cancelled", w.jobs.cancelled],
+    ["Errors", w.jobs.errors],
+    ["Last poll", time(w.jobs.last_poll_at)],
+  ]);
+}
+
+async function loadUsers() {
+  const email = $("users-form").elements.email.value.trim();
+  const users = await api("GET", "/api/admin/users?email=" + encodeURIComponent(email));
+  const rows = users.map((u) => {
+    const tr = document.createElement("tr");
+    for (const value of [u.email, u.name, time(u.created_at), u.role]) {
+      const td = document.createElement("td");
+      td.textContent = value;
+      tr.append(td);
+    }
+
+    const role = u.role === "admin" ? "user" : "admin";
+    const button = document.createElement("button");
+    button.textContent = role === "admin" ? "Make admin" : "Revoke admin";
+    button.addEventListener("click", async () => {
+      if (!confirm(button.textContent + ": " + u.email + "?")) return;
+      try {
+        await api("PUT", "/api/admin/users/" + u.id + "/role", {role});
+        flash("Role of " + u.email + " changed to " + role);
+        await loadUsers();
+      } catch (err) {
+        fail(err);
+      }
+    });
+    const td = document.createElement("td");
+    td.append(button);
+    tr.append(td);
+    return tr;
+  });
+  $("users").replaceChildren(...rows);
+}
+
+async function loadSettings() {
+  const [maintenance, level, debug] = await Promise.all([
+    api("GET", "/api/admin/maintenance"),
+    api("GET", "/api/admin/log-level"),
+    api("GET", "/api/admin/debug-logging"),
+  ]);
+
+  const m = $("maintenance-form").elements;
+  m.enabled.checked = maintenance.enabled;
+  m.retry_after.value = maintenance.retry_after;
+  m.message.value = maintenance.message;
+
+  $("log-level-form").elements.level.value = level.level;
+
+  const d = $("debug-form").elements;
+  d.enabled.checked = debug.enabled;
+  d.routes.value = (debug.routes || []).join(", ");
+  d.user_ids.value = (debug.user_ids || []).join(", ");
+}
+
+function split(value) {
+  return value.split(",").map((s) => s.trim()).filter((s) => s !== "");
+}
+
+// submit saves a settings form and reloads the settings.
+function submit(id, save) {
+  $(id).addEventListener("submit", async (e) => {
+    e.preventDefault();
+    try {
+      await save(e.target.elements);
+      flash("Saved");
+      await loadSettings();
+    } catch (err) {
+      fail(err);
+    }
+  });
+}
+
+submit("maintenance-form", (f) => api("PUT", "/api/admin/maintenance", {
+  enabled: f.enabled.checked,
+  retry_after: f.retry_after.value.trim(),
+  message: f.message.value,
+}));
+submit("log-level-form", (f) => api("PUT", "/api/admin/log-level", {level: f.level.value}));
+submit("debug-form", (f) => api("PUT", "/api/admin/debug-logging", {
+  enabled: f.enabled.checked,
+  routes: split(f.routes.value),
+  user_ids: split(f.user_ids.value),
+}));
+
+$("users-form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  loadUsers().catch(fail);
+});
+
+$("workers-refresh").addEventListener("click", () => loadWorkers().catch(fail));
+
+$("login-form").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  const headers = {};
+  if (config.tenancy && f.tenant.value.trim() !== "") {
+    headers[config.tenant_header] = f.tenant.value.trim();
+  }
+  try {
+    const result = await api("POST", "/api/user/login", {email: f.email.value, password: f.password.value}, headers);
+    f.password.value = "";
+    await signIn(result.token);
+  } catch (err) {
+    $("login-error").textContent = err.message;
+  }
+});
+
+$("token-form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  signIn(f.token.value.trim());
+  f.token.value = "";
+});
+
+$("logout").addEventListener("click", () => signOut());
+
+setInterval(() => {
+  if (!$("app").hidden && document.visibilityState === "visible") {
+    loadWorkers().catch(() => {});
+  }
+}, 10000);
+
+fetch("config.json")
+  .then((res) => res.json())
+  .then((c) => {
+    config = c;
+    $("tenant-field").hidden = !config.tenancy;
+  })
+  .catch(() => {})
+  .finally(() => {
+    if (sessionStorage.getItem(tokenKey)) {
+      signIn(sessionStorage.getItem(tokenKey));
+    }
+  });
@@ -0,0 +1,156 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	userrepo "github.com/aliskhannn/calendar-service/internal/repository/user"
+)
+
+const (
+	defaultUserLimit = 50  // users returned when no limit is given
+	maxUserLimit     = 500 // largest accepted limit
+)
+
+// userDirectory lists user accounts and changes their roles.
+type userDirectory interface {
+	// ListUsers retrieves a page of the users whose email contains the given substring, newest first.
+	ListUsers(ctx context.Context, email string, page model.Page) ([]model.User, error)
+
+	// CountUsers counts the users whose email contains the given substring, exactly or estimated.
+	CountUsers(ctx context.Context, email string, mode string) (int, error)
+
+	// SetRole changes the role of a user and records the change in the user's security event log.
+	SetRole(ctx context.Context, id uuid.UUID, role string, client model.ClientInfo) error
+}
+
+// UserResponse represents a user account in the admin API.
+type UserResponse struct {
+	ID        uuid.UUID `json:"id"`         // unique identifier of the user
+	Email     string    `json:"email"`      // email address of the user
+	Name      string    `json:"name"`       // name of the user
+	Role      string    `json:"role"`       // role of the user, user or admin
+	CreatedAt time.Time `json:"created_at"` // time the user registered
+}
+
+// RoleRequest represents the payload for changing the role of a user.
+type RoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=user admin"`
+}
+
+// ListUsers handles HTTP requests to list the user accounts of the request's tenant, newest first.
+// The optional "email" query parameter filters by a substring of the email address
+// and "limit" caps the number of users; under /api/v2 the users are paginated with the "cursor", "limit"
+// and "total" query parameters.
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	page, err := response.ParseLimitedPage(r, defaultUserLimit, maxUserLimit)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	email := r.URL.Query().Get("email")
+	users, err := h.users.ListUsers(r.Context(), email, page)
+	if err != nil {
+		h.logger.Error("failed to list users", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n, err := h.users.CountUsers(r.Context(), email, page.Total)
+		if err != nil {
+			h.logger.Error("failed to count users", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		total = &n
+	}
+
+	result := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		result = append(result, UserResponse{ID: u.ID, Email: u.Email, Name: u.Name, Role: u.Role, CreatedAt: u.CreatedAt})
+	}
+
+	response.WritePage(w, r, page, result, total)
+}
+
+// SetUserRole handles HTTP requests to grant or revoke the admin role of a user.
+// Admins cannot change their own role, so the last admin cannot lock everyone out.
+// The new role applies from the user's next login.
+func (h *Handler) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || adminID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid user id"))
+		return
+	}
+
+	if id == adminID {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("cannot change your own role"))
+		return
+	}
+
+	var req RoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode role request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	if err := h.users.SetRole(r.Context(), id, req.Role, clientInfo(r)); err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			response.Fail(w, http.StatusNotFound, userrepo.ErrUserNotFound)
+			return
+		}
+
+		h.logger.Error("failed to change user role", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Warn("user role changed",
+		zap.String("user_id", id.String()),
+		zap.String("role", req.Role),
+		zap.String("admin_id", adminID.String()),
+	)
+	response.OK(w, "role changed")
+}
+
+// clientInfo extracts the IP address and user agent of the client performing a request.
+func clientInfo(r *http.Request) model.ClientInfo {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return model.ClientInfo{
+		IP:        ip,
+		UserAgent: r.UserAgent(),
+	}
+}
@@ -0,0 +1,49 @@
+package archiveformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Create handles HTTP requests to verify the new archive format against archived_reminders, backfilling it first
+// if requested. The verification is run by a background job; the response is 202 Accepted with the job,
+// whose progress and result are polled through the jobs API.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req model.ArchiveVerification
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode archive verification request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	job, err := h.service.Start(r.Context(), userID, req)
+	if err != nil {
+		h.logger.Error("failed to start archive verification", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Warn("archive verification started",
+		zap.String("user_id", userID.String()),
+		zap.String("job_id", job.ID.String()),
+		zap.Bool("backfill", req.Backfill),
+	)
+	response.Accepted(w, dto.NewJob(job))
+}
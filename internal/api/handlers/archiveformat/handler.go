@@ -0,0 +1,39 @@
+package archiveformat
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/archiveformat/mock_archiveformat_service.go -package=mocks
+
+// verificationService defines the interface for the verification of the new archive format.
+type verificationService interface {
+	// Start queues a job verifying the new archive format.
+	Start(ctx context.Context, userID uuid.UUID, req model.ArchiveVerification) (model.Job, error)
+}
+
+// Handler manages HTTP requests for archive verifications.
+type Handler struct {
+	service verificationService // service handles business logic for archive verifications
+	logger  *zap.Logger         // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The verification service for starting archive verifications.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s verificationService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
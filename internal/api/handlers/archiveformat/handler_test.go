@@ -0,0 +1,70 @@
+package archiveformat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksarchiveformatsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/archiveformat"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestHandler_Create(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		err  error
+		want int
+	}{
+		"accepted":      {body: `{"backfill":true}`, want: http.StatusAccepted},
+		"queue failure": {body: `{}`, err: fmt.Errorf("start archive verification: boom"), want: http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := mocksarchiveformatsvc.NewMockverificationService(ctrl)
+			h := New(mockService, zap.NewNop())
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/archive-verifications", bytes.NewReader([]byte(tt.body)))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Start(gomock.Any(), userID, gomock.Any()).
+				Return(model.Job{ID: uuid.New(), Kind: model.JobArchiveVerification, Status: model.JobQueued}, tt.err)
+
+			h.Create(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Create_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	h := New(mocksarchiveformatsvc.NewMockverificationService(ctrl), zap.NewNop())
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/archive-verifications", bytes.NewReader([]byte(`{`)))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,391 @@
+package attendee
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	attendeerepo "github.com/aliskhannn/calendar-service/internal/repository/attendee"
+	attendeesvc "github.com/aliskhannn/calendar-service/internal/service/attendee"
+)
+
+// InviteRequest represents the payload for inviting a user to an event.
+type InviteRequest struct {
+	Email string `json:"email" validate:"required,email"` // email address of the user, or of the person without an account, to invite
+}
+
+// Invite handles HTTP requests to invite another user to an event of the authenticated user.
+func (h *Handler) Invite(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req InviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	a, err := h.service.Invite(r.Context(), eventID, userID, req.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, attendeerepo.ErrUserNotFound):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrUserNotFound)
+		case errors.Is(err, attendeerepo.ErrEventNotFound):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrEventNotFound)
+		case errors.Is(err, attendeerepo.ErrSelfInvite):
+			response.Fail(w, http.StatusBadRequest, attendeerepo.ErrSelfInvite)
+		default:
+			h.logger.Error("failed to invite attendee",
+				zap.String("user_id", userID.String()),
+				zap.String("event_id", eventID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("attendee invited",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("attendee_id", a.UserID.String()),
+	)
+	response.Created(w, a)
+}
+
+// LateRequest represents the payload for reporting that the authenticated user is running late for an event.
+type LateRequest struct {
+	ETA time.Time `json:"eta" validate:"required"` // time the user expects to arrive, in RFC 3339 format
+}
+
+// List handles HTTP requests to list the attendees of an event the authenticated user owns or is invited to.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	attendees, err := h.service.ListAttendees(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, attendeerepo.ErrEventNotFound) {
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrEventNotFound)
+			return
+		}
+
+		h.logger.Error("failed to list attendees",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	if attendees == nil {
+		attendees = []model.Attendee{}
+	}
+	response.List(w, r, attendees)
+}
+
+// Accept handles HTTP requests to accept the authenticated user's invitation to an event.
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, h.service.Accept)
+}
+
+// Decline handles HTTP requests to decline the authenticated user's invitation to an event.
+func (h *Handler) Decline(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, h.service.Decline)
+}
+
+// respond records the authenticated user's response to an invitation with the given service method.
+func (h *Handler) respond(
+	w http.ResponseWriter, r *http.Request,
+	record func(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error),
+) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	a, err := record(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, attendeerepo.ErrAttendeeNotFound) {
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrAttendeeNotFound)
+			return
+		}
+
+		h.logger.Error("failed to respond to invitation",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("invitation answered",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("status", a.Status),
+	)
+	response.OK(w, a)
+}
+
+// Remove handles HTTP requests to withdraw the invitation of a user to an event of the authenticated user.
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse attendee ID from URL parameter.
+	attendeeID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		h.logger.Warn("invalid attendee id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid attendee id"))
+		return
+	}
+
+	if err := h.service.RemoveAttendee(r.Context(), eventID, userID, attendeeID); err != nil {
+		if errors.Is(err, attendeerepo.ErrAttendeeNotFound) {
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrAttendeeNotFound)
+			return
+		}
+
+		h.logger.Error("failed to remove attendee",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("attendee removed",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("attendee_id", attendeeID.String()),
+	)
+	response.OK(w, "attendee removed")
+}
+
+// RunningLate handles HTTP requests to report that the authenticated user is running late for an event they accepted.
+func (h *Handler) RunningLate(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req LateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	a, err := h.service.RunningLate(r.Context(), eventID, userID, req.ETA)
+	if err != nil {
+		switch {
+		case errors.Is(err, attendeesvc.ErrInvalidETA):
+			response.Fail(w, http.StatusBadRequest, attendeesvc.ErrInvalidETA)
+		case errors.Is(err, attendeerepo.ErrAttendeeNotFound):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrAttendeeNotFound)
+		default:
+			h.logger.Error("failed to report running late",
+				zap.String("user_id", userID.String()),
+				zap.String("event_id", eventID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("attendee running late",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+	)
+	response.OK(w, a)
+}
+
+// RSVP handles the RSVP links emailed to external attendees, with the token and response query parameters.
+// It serves both GET, for links opened in a browser, and POST, for pages that confirm the response first.
+func (h *Handler) RSVP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("missing token"))
+		return
+	}
+
+	a, err := h.service.RSVP(r.Context(), token, r.URL.Query().Get("response"))
+	if err != nil {
+		switch {
+		case errors.Is(err, attendeesvc.ErrInvalidResponse):
+			response.Fail(w, http.StatusBadRequest, attendeesvc.ErrInvalidResponse)
+		case errors.Is(err, attendeerepo.ErrInvitationNotFound):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrInvitationNotFound)
+		default:
+			h.logger.Error("failed to record rsvp", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("invitation answered through rsvp link",
+		zap.String("event_id", a.EventID.String()),
+		zap.String("status", a.Status),
+	)
+	response.OK(w, a)
+}
+
+// GuestRequest represents the payload for asking for an RSVP link to an event shared by a short link.
+type GuestRequest struct {
+	Email string `json:"email" validate:"required,email"` // email address the RSVP link is sent to
+}
+
+// RequestGuestRSVP handles public HTTP requests of guests for an RSVP link to the event a short code shares.
+// The link is emailed, so the response does not reveal whether the guest asked before.
+func (h *Handler) RequestGuestRSVP(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+
+	// Decode and validate request body.
+	var req GuestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	g, err := h.service.RequestGuestRSVP(r.Context(), code, req.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, attendeerepo.ErrSharedEventNotFound), errors.Is(err, attendeesvc.ErrGuestRSVPDisabled):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrSharedEventNotFound)
+		case errors.Is(err, attendeerepo.ErrGuestCooldown):
+			response.Fail(w, http.StatusTooManyRequests, attendeerepo.ErrGuestCooldown)
+		default:
+			h.logger.Error("failed to request guest rsvp", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("guest rsvp link requested", zap.String("event_id", g.EventID.String()))
+	response.Accepted(w, "rsvp link sent")
+}
+
+// GuestRSVP handles the RSVP links emailed to guests of shared events, with the token and response query parameters.
+// Like RSVP, it serves both GET and POST.
+func (h *Handler) GuestRSVP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("missing token"))
+		return
+	}
+
+	g, err := h.service.GuestRSVP(r.Context(), token, r.URL.Query().Get("response"))
+	if err != nil {
+		switch {
+		case errors.Is(err, attendeesvc.ErrInvalidResponse):
+			response.Fail(w, http.StatusBadRequest, attendeesvc.ErrInvalidResponse)
+		case errors.Is(err, attendeerepo.ErrGuestNotFound):
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrGuestNotFound)
+		case errors.Is(err, attendeerepo.ErrGuestLinkExpired):
+			response.Fail(w, http.StatusGone, attendeerepo.ErrGuestLinkExpired)
+		default:
+			h.logger.Error("failed to record guest rsvp", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("shared event answered through rsvp link",
+		zap.String("event_id", g.EventID.String()),
+		zap.String("status", g.Status),
+	)
+	response.OK(w, g)
+}
+
+// ListGuests handles HTTP requests to list the guests of an event the authenticated user owns or is invited to.
+func (h *Handler) ListGuests(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	guests, err := h.service.ListGuests(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, attendeerepo.ErrEventNotFound) {
+			response.Fail(w, http.StatusNotFound, attendeerepo.ErrEventNotFound)
+			return
+		}
+
+		h.logger.Error("failed to list guests",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	if guests == nil {
+		guests = []model.Guest{}
+	}
+	response.List(w, r, guests)
+}
+
+// parseRequest extracts the authenticated user and the event ID of the URL.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, eventID, true
+}
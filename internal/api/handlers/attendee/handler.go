@@ -0,0 +1,71 @@
+package attendee
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/attendee/mock_attendee_service.go -package=mocks
+
+// attendeeService defines the interface for managing the attendees of events.
+type attendeeService interface {
+	// Invite invites another user, identified by email address, to an event of the owner.
+	Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error)
+
+	// ListAttendees retrieves the attendees of an event, visible to its owner and its attendees.
+	ListAttendees(ctx context.Context, eventID, userID uuid.UUID) ([]model.Attendee, error)
+
+	// Accept records that a user takes part in an event they are invited to.
+	Accept(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error)
+
+	// Decline records that a user does not take part in an event they are invited to.
+	Decline(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error)
+
+	// RemoveAttendee withdraws the invitation of a user to an event of the owner.
+	RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error
+
+	// RSVP records the response of an external attendee through the RSVP link of their invitation.
+	RSVP(ctx context.Context, token, response string) (model.Attendee, error)
+
+	// RunningLate records that a user who accepted an event is running late and notifies the others.
+	RunningLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.Attendee, error)
+
+	// RequestGuestRSVP emails a guest of an event shared by a short link an RSVP link to answer it.
+	RequestGuestRSVP(ctx context.Context, code, email string) (model.Guest, error)
+
+	// GuestRSVP records the response of a guest through their RSVP link.
+	GuestRSVP(ctx context.Context, token, response string) (model.Guest, error)
+
+	// ListGuests retrieves the guests who asked to answer an event, visible to its owner and its attendees.
+	ListGuests(ctx context.Context, eventID, userID uuid.UUID) ([]model.Guest, error)
+}
+
+// Handler manages HTTP requests for the attendees of events.
+type Handler struct {
+	service   attendeeService     // service handles business logic for attendees
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The attendee service for managing attendees.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s attendeeService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,332 @@
+package attendee
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksattendeesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/attendee"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	attendeerepo "github.com/aliskhannn/calendar-service/internal/repository/attendee"
+	attendeesvc "github.com/aliskhannn/calendar-service/internal/service/attendee"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksattendeesvc.MockattendeeService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksattendeesvc.NewMockattendeeService(ctrl)
+	handler := New(mockService, zap.NewNop(), validator.New())
+	return ctrl, mockService, handler
+}
+
+// newRequest builds a request of the authenticated user with the given URL parameters.
+func newRequest(method, target string, body []byte, userID uuid.UUID, params map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	for k, v := range params {
+		rc.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Invite(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"invited":       {want: http.StatusCreated},
+		"unknown email": {err: fmt.Errorf("invite attendee: %w", attendeerepo.ErrUserNotFound), want: http.StatusNotFound},
+		"unknown event": {err: fmt.Errorf("invite attendee: %w", attendeerepo.ErrEventNotFound), want: http.StatusNotFound},
+		"own email":     {err: fmt.Errorf("invite attendee: %w", attendeerepo.ErrSelfInvite), want: http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			body, _ := json.Marshal(InviteRequest{Email: "guest@example.com"})
+			req := newRequest(http.MethodPost, "/events/"+eventID.String()+"/attendees", body, userID, map[string]string{"id": eventID.String()})
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Invite(gomock.Any(), eventID, userID, "guest@example.com").
+				Return(model.Attendee{EventID: eventID, UserID: uuid.New(), Status: model.AttendeeInvited}, tt.err)
+
+			h.Invite(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Invite_InvalidEventID(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(InviteRequest{Email: "guest@example.com"})
+	req := newRequest(http.MethodPost, "/events/abc/attendees", body, uuid.New(), map[string]string{"id": "abc"})
+	w := httptest.NewRecorder()
+
+	h.Invite(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Accept(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+	req := newRequest(http.MethodPost, "/events/"+eventID.String()+"/attendees/accept", nil, userID, map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		Accept(gomock.Any(), eventID, userID).
+		Return(model.Attendee{EventID: eventID, UserID: userID, Status: model.AttendeeAccepted}, nil)
+
+	h.Accept(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result model.Attendee `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Status != model.AttendeeAccepted {
+		t.Fatalf("expected status %q, got %q", model.AttendeeAccepted, resp.Result.Status)
+	}
+}
+
+func TestHandler_Decline_NotInvited(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+	req := newRequest(http.MethodPost, "/events/"+eventID.String()+"/attendees/decline", nil, userID, map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		Decline(gomock.Any(), eventID, userID).
+		Return(model.Attendee{}, fmt.Errorf("decline invitation: %w", attendeerepo.ErrAttendeeNotFound))
+
+	h.Decline(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_RunningLate(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"reported":      {want: http.StatusOK},
+		"eta in past":   {err: attendeesvc.ErrInvalidETA, want: http.StatusBadRequest},
+		"not attending": {err: fmt.Errorf("mark running late: %w", attendeerepo.ErrAttendeeNotFound), want: http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			eta := time.Date(2025, 10, 20, 9, 15, 0, 0, time.UTC)
+			body, _ := json.Marshal(LateRequest{ETA: eta})
+			req := newRequest(http.MethodPost, "/events/"+eventID.String()+"/late", body, userID, map[string]string{"id": eventID.String()})
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				RunningLate(gomock.Any(), eventID, userID, eta).
+				Return(model.Attendee{EventID: eventID, UserID: userID, Status: model.AttendeeAccepted, LateETA: &eta}, tt.err)
+
+			h.RunningLate(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_RunningLate_MissingETA(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	req := newRequest(http.MethodPost, "/events/"+eventID.String()+"/late", []byte(`{}`), uuid.New(), map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	h.RunningLate(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Remove(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID, attendeeID := uuid.New(), uuid.New(), uuid.New()
+	req := newRequest(http.MethodDelete, "/events/"+eventID.String()+"/attendees/"+attendeeID.String(), nil, userID,
+		map[string]string{"id": eventID.String(), "userID": attendeeID.String()})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().RemoveAttendee(gomock.Any(), eventID, userID, attendeeID).Return(nil)
+
+	h.Remove(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_RSVP(t *testing.T) {
+	tests := map[string]struct {
+		target string
+		err    error
+		want   int
+	}{
+		"accepted":       {target: "/rsvp?token=abc&response=accepted", want: http.StatusOK},
+		"missing token":  {target: "/rsvp?response=accepted", want: http.StatusBadRequest},
+		"invalid answer": {target: "/rsvp?token=abc&response=maybe", err: attendeesvc.ErrInvalidResponse, want: http.StatusBadRequest},
+		"unknown token":  {target: "/rsvp?token=abc&response=accepted", err: fmt.Errorf("rsvp: %w", attendeerepo.ErrInvitationNotFound), want: http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			if tt.want != http.StatusBadRequest || tt.err != nil {
+				mockService.EXPECT().
+					RSVP(gomock.Any(), "abc", gomock.Any()).
+					Return(model.Attendee{Status: model.AttendeeAccepted, External: true}, tt.err)
+			}
+
+			// RSVP links are opened without logging in.
+			w := httptest.NewRecorder()
+			h.RSVP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_RequestGuestRSVP(t *testing.T) {
+	tests := map[string]struct {
+		email string
+		err   error
+		want  int
+	}{
+		"sent":           {email: "guest@example.com", want: http.StatusAccepted},
+		"invalid email":  {email: "guest", want: http.StatusBadRequest},
+		"unknown code":   {email: "guest@example.com", err: fmt.Errorf("request guest rsvp: %w", attendeerepo.ErrSharedEventNotFound), want: http.StatusNotFound},
+		"not configured": {email: "guest@example.com", err: attendeesvc.ErrGuestRSVPDisabled, want: http.StatusNotFound},
+		"cooldown":       {email: "guest@example.com", err: fmt.Errorf("request guest rsvp: %w", attendeerepo.ErrGuestCooldown), want: http.StatusTooManyRequests},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			if tt.want != http.StatusBadRequest {
+				mockService.EXPECT().
+					RequestGuestRSVP(gomock.Any(), "Ab3dE9xY", tt.email).
+					Return(model.Guest{Email: tt.email, Status: model.GuestPending}, tt.err)
+			}
+
+			// Guests ask for RSVP links without logging in.
+			body, _ := json.Marshal(GuestRequest{Email: tt.email})
+			req := httptest.NewRequest(http.MethodPost, "/e/Ab3dE9xY/rsvp", bytes.NewReader(body))
+			rc := chi.NewRouteContext()
+			rc.URLParams.Add("code", "Ab3dE9xY")
+			w := httptest.NewRecorder()
+			h.RequestGuestRSVP(w, req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc)))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_GuestRSVP(t *testing.T) {
+	tests := map[string]struct {
+		target string
+		err    error
+		want   int
+	}{
+		"accepted":       {target: "/guests/rsvp?token=abc&response=accepted", want: http.StatusOK},
+		"missing token":  {target: "/guests/rsvp?response=accepted", want: http.StatusBadRequest},
+		"invalid answer": {target: "/guests/rsvp?token=abc&response=maybe", err: attendeesvc.ErrInvalidResponse, want: http.StatusBadRequest},
+		"unknown token":  {target: "/guests/rsvp?token=abc&response=accepted", err: fmt.Errorf("guest rsvp: %w", attendeerepo.ErrGuestNotFound), want: http.StatusNotFound},
+		"expired link":   {target: "/guests/rsvp?token=abc&response=accepted", err: fmt.Errorf("guest rsvp: %w", attendeerepo.ErrGuestLinkExpired), want: http.StatusGone},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			if tt.want != http.StatusBadRequest || tt.err != nil {
+				mockService.EXPECT().
+					GuestRSVP(gomock.Any(), "abc", gomock.Any()).
+					Return(model.Guest{Status: model.AttendeeAccepted}, tt.err)
+			}
+
+			w := httptest.NewRecorder()
+			h.GuestRSVP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_ListGuests(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+	mockService.EXPECT().ListGuests(gomock.Any(), eventID, userID).Return(nil, nil)
+
+	w := httptest.NewRecorder()
+	h.ListGuests(w, newRequest(http.MethodGet, "/events/"+eventID.String()+"/guests", nil, userID, map[string]string{"id": eventID.String()}))
+
+	var resp struct {
+		Result []model.Guest `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || w.Code != http.StatusOK || resp.Result == nil {
+		t.Fatalf("expected an empty list, got %d %s", w.Code, w.Body.String())
+	}
+}
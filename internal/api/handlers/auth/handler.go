@@ -13,7 +13,9 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
 	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/model"
 	userrepo "github.com/aliskhannn/calendar-service/internal/repository/user"
 )
 
@@ -27,10 +29,25 @@ type userService interface {
 	Create(ctx context.Context, email, name, password string) (uuid.UUID, error)
 
 	// GetByEmail validates the user's credentials and returns a JWT token if successful.
-	GetByEmail(ctx context.Context, email, password string) (string, error)
+	GetByEmail(ctx context.Context, email, password string, client model.ClientInfo) (string, error)
+
+	// ListSecurityEvents returns a page of the security events of a user, newest first.
+	ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error)
+
+	// CountSecurityEvents counts the security events of a user, exactly or estimated.
+	CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error)
+
+	// GetByID retrieves a user by their ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+
+	// SetTimezone changes the time zone the calendar days of a user are computed in.
+	SetTimezone(ctx context.Context, id uuid.UUID, timezone string) error
+
+	// SetSearchLanguage changes the language the events of a user are searched in.
+	SetSearchLanguage(ctx context.Context, id uuid.UUID, language string) error
 }
 
-// Handler handles HTTP requests for user registration and login.
+// Handler handles HTTP requests for user registration, login, the user's profile, and the account security log.
 type Handler struct {
 	service   userService
 	logger    *zap.Logger
@@ -50,13 +67,14 @@ func New(s userService, l *zap.Logger, v *validator.Validate) *Handler {
 type RegisterRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Name     string `json:"name" validate:"required"`
-	Password string `json:"password" validate:"required,min=8"`
+	Password string `json:"password" validate:"required,password"`
 }
 
 // LoginRequest represents the JSON payload for user login.
+// The password is not checked against the configured minimum length, so accounts keep working when it is raised.
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8"`
+	Password string `json:"password" validate:"required"`
 }
 
 // Register handles user registration requests.
@@ -101,10 +119,11 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.service.GetByEmail(r.Context(), req.Email, req.Password)
+	token, err := h.service.GetByEmail(r.Context(), req.Email, req.Password, clientInfo(r))
 	if err != nil {
 		if errors.Is(err, usersvc.ErrInvalidCredentials) {
 			response.Fail(w, http.StatusUnauthorized, err)
+			return
 		}
 		if errors.Is(err, userrepo.ErrUserNotFound) {
 			h.logger.Info("user not found", zap.String("email", req.Email))
@@ -118,5 +137,5 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.logger.Info("user logged in successfully", zap.String("email", req.Email))
-	response.OK(w, map[string]string{"token": token})
+	response.OK(w, dto.Token{Token: token})
 }
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -10,19 +11,22 @@ import (
 
 	mocksusersvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/user"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
 	"github.com/aliskhannn/calendar-service/internal/service/user"
+	"github.com/aliskhannn/calendar-service/internal/validation"
 )
 
 func setupUserHandler(t *testing.T) (*gomock.Controller, *mocksusersvc.MockuserService, *Handler) {
 	ctrl := gomock.NewController(t)
 	mockService := mocksusersvc.NewMockuserService(ctrl)
 	logger, _ := zap.NewDevelopment()
-	validate := validator.New()
+	validate := validation.New(config.Validation{})
 	handler := New(mockService, logger, validate)
 	return ctrl, mockService, handler
 }
@@ -92,7 +96,7 @@ func TestHandler_Login_Success(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password).
+		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password, gomock.Any()).
 		Return("token123", nil)
 
 	h.Login(w, req)
@@ -116,7 +120,7 @@ func TestHandler_Login_InvalidCredentials(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password).
+		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password, gomock.Any()).
 		Return("", user.ErrInvalidCredentials)
 
 	h.Login(w, req)
@@ -140,7 +144,7 @@ func TestHandler_Login_UserNotFound(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password).
+		GetByEmail(gomock.Any(), reqBody.Email, reqBody.Password, gomock.Any()).
 		Return("", errors.New("not found"))
 
 	h.Login(w, req)
@@ -177,3 +181,117 @@ func TestHandler_Login_InvalidBody(t *testing.T) {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
 	}
 }
+
+func TestHandler_SecurityEvents_Success(t *testing.T) {
+	ctrl, mockService, h := setupUserHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/security-events?limit=10", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ListSecurityEvents(gomock.Any(), userID, model.Page{Limit: 10}).
+		Return([]model.SecurityEvent{{ID: uuid.New(), Type: model.SecurityLogin, IP: "203.0.113.7"}}, nil)
+
+	h.SecurityEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_SecurityEvents_InvalidLimit(t *testing.T) {
+	ctrl, _, h := setupUserHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/security-events?limit=1000", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.SecurityEvents(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_UpdateProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"updated", nil, http.StatusOK},
+		{"unknown time zone", user.ErrUnknownTimezone, http.StatusBadRequest},
+		{"failed", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, mockService, h := setupUserHandler(t)
+			defer ctrl.Finish()
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPut, "/profile", bytes.NewBufferString(`{"timezone":"Europe/Berlin"}`))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().SetTimezone(gomock.Any(), userID, "Europe/Berlin").Return(tt.err)
+			if tt.err == nil {
+				mockService.EXPECT().GetByID(gomock.Any(), userID).Return(&model.User{ID: userID, Timezone: "Europe/Berlin"}, nil)
+			}
+
+			h.UpdateProfile(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+			if tt.err == nil {
+				var resp struct {
+					Result ProfileResponse `json:"result"`
+				}
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.Result.Timezone != "Europe/Berlin" {
+					t.Fatalf("expected time zone Europe/Berlin, got %q", resp.Result.Timezone)
+				}
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateProfile_SearchLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"updated", nil, http.StatusOK},
+		{"unknown language", user.ErrUnknownLanguage, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, mockService, h := setupUserHandler(t)
+			defer ctrl.Finish()
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPut, "/profile", bytes.NewBufferString(`{"search_language":"german"}`))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			// The time zone is left out of the request, so it is not changed.
+			mockService.EXPECT().SetSearchLanguage(gomock.Any(), userID, "german").Return(tt.err)
+			if tt.err == nil {
+				mockService.EXPECT().GetByID(gomock.Any(), userID).Return(&model.User{ID: userID, SearchLanguage: "german"}, nil)
+			}
+
+			h.UpdateProfile(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
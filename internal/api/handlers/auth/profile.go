@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	usersvc "github.com/aliskhannn/calendar-service/internal/service/user"
+)
+
+// ProfileResponse represents the profile of the authenticated user.
+type ProfileResponse struct {
+	ID             uuid.UUID `json:"id"`              // unique identifier of the user
+	Email          string    `json:"email"`           // email address of the user
+	Name           string    `json:"name"`            // name of the user
+	Role           string    `json:"role"`            // role of the user, user or admin
+	Timezone       string    `json:"timezone"`        // IANA time zone calendar days are computed in; empty for UTC
+	SearchLanguage string    `json:"search_language"` // language event search stems words in; empty for no stemming
+	CreatedAt      time.Time `json:"created_at"`      // time the user registered
+}
+
+// ProfileRequest represents the payload for updating the profile of the authenticated user.
+// Fields left out are not changed.
+type ProfileRequest struct {
+	Timezone       *string `json:"timezone"`        // IANA time zone such as Europe/Berlin; empty for UTC
+	SearchLanguage *string `json:"search_language"` // search language such as german; empty for no stemming
+}
+
+// Profile handles HTTP requests to read the profile of the authenticated user.
+func (h *Handler) Profile(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	user, err := h.service.GetByID(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, usersvc.ErrInvalidCredentials) {
+			response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+			return
+		}
+
+		h.logger.Error("failed to get profile", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, newProfile(user))
+}
+
+// UpdateProfile handles HTTP requests to change the time zone or the search language of the authenticated user.
+// Day, week and month queries without a tz parameter are computed in this time zone,
+// and event search stems words in the search language.
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req ProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode profile request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if req.Timezone != nil {
+		if err := h.service.SetTimezone(r.Context(), userID, *req.Timezone); err != nil {
+			h.failProfile(w, userID, err)
+			return
+		}
+	}
+
+	if req.SearchLanguage != nil {
+		if err := h.service.SetSearchLanguage(r.Context(), userID, *req.SearchLanguage); err != nil {
+			h.failProfile(w, userID, err)
+			return
+		}
+	}
+
+	h.Profile(w, r)
+}
+
+// failProfile writes the error response of a profile update that failed.
+func (h *Handler) failProfile(w http.ResponseWriter, userID uuid.UUID, err error) {
+	if errors.Is(err, usersvc.ErrUnknownTimezone) || errors.Is(err, usersvc.ErrUnknownLanguage) {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	h.logger.Error("failed to update profile", zap.String("user_id", userID.String()), zap.Error(err))
+	response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+}
+
+// newProfile converts a user into the profile returned by the API.
+func newProfile(user *model.User) ProfileResponse {
+	return ProfileResponse{
+		ID:             user.ID,
+		Email:          user.Email,
+		Name:           user.Name,
+		Role:           user.Role,
+		Timezone:       user.Timezone,
+		SearchLanguage: user.SearchLanguage,
+		CreatedAt:      user.CreatedAt,
+	}
+}
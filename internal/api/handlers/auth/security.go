@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+const (
+	defaultSecurityEventsLimit = 50  // events returned when no limit is given
+	maxSecurityEventsLimit     = 200 // largest accepted limit
+)
+
+// SecurityEvents handles HTTP requests to list the authenticated user's security events,
+// newest first. The optional "limit" query parameter caps the number of events;
+// under /api/v2 the events are paginated with the "cursor", "limit" and "total" query parameters.
+func (h *Handler) SecurityEvents(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	page, err := response.ParseLimitedPage(r, defaultSecurityEventsLimit, maxSecurityEventsLimit)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := h.service.ListSecurityEvents(r.Context(), userID, page)
+	if err != nil {
+		h.logger.Error("failed to list security events", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n, err := h.service.CountSecurityEvents(r.Context(), userID, page.Total)
+		if err != nil {
+			h.logger.Error("failed to count security events", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		total = &n
+	}
+
+	response.WritePage(w, r, page, dto.NewSecurityEvents(events), total)
+}
+
+// clientInfo extracts the client IP address and user agent of a request.
+// The remote address is already resolved from proxy headers by the RealIP middleware.
+func clientInfo(r *http.Request) model.ClientInfo {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return model.ClientInfo{
+		IP:        ip,
+		UserAgent: r.UserAgent(),
+	}
+}
@@ -0,0 +1,180 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	calendarrepo "github.com/aliskhannn/calendar-service/internal/repository/calendar"
+)
+
+// CalendarRequest represents the payload for creating a calendar or replacing its name and color.
+type CalendarRequest struct {
+	Name  string `json:"name" validate:"required,max=100"`         // name of the calendar, e.g. Work
+	Color string `json:"color" validate:"omitempty,display_color"` // optional display color
+}
+
+// Create handles HTTP requests to create a new calendar for the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.service.CreateCalendar(r.Context(), model.Calendar{UserID: userID, Name: req.Name, Color: req.Color})
+	if err != nil {
+		h.logger.Error("failed to create calendar", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, dto.NewCalendar(c))
+}
+
+// List handles HTTP requests to list the calendars of the authenticated user, the default calendar first.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	calendars, err := h.service.ListCalendars(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list calendars", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewCalendars(calendars))
+}
+
+// Get handles HTTP requests to retrieve a calendar of the authenticated user by its ID.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, calendarID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.service.GetCalendar(r.Context(), calendarID, userID)
+	if err != nil {
+		h.fail(w, "failed to get calendar", calendarID, err)
+		return
+	}
+
+	response.OK(w, dto.NewCalendar(c))
+}
+
+// Update handles HTTP requests to rename and recolor a calendar of the authenticated user.
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, calendarID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.service.UpdateCalendar(r.Context(), model.Calendar{ID: calendarID, UserID: userID, Name: req.Name, Color: req.Color})
+	if err != nil {
+		h.fail(w, "failed to update calendar", calendarID, err)
+		return
+	}
+
+	response.OK(w, dto.NewCalendar(c))
+}
+
+// Delete handles HTTP requests to delete a calendar of the authenticated user.
+// Its events move to the default calendar, which cannot be deleted.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, calendarID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCalendar(r.Context(), calendarID, userID); err != nil {
+		h.fail(w, "failed to delete calendar", calendarID, err)
+		return
+	}
+
+	response.OK(w, "calendar deleted")
+}
+
+// fail writes the error response for a failed operation on a calendar.
+func (h *Handler) fail(w http.ResponseWriter, msg string, calendarID uuid.UUID, err error) {
+	switch {
+	case errors.Is(err, calendarrepo.ErrCalendarNotFound):
+		response.Fail(w, http.StatusNotFound, calendarrepo.ErrCalendarNotFound)
+	case errors.Is(err, calendarrepo.ErrDefaultCalendar):
+		response.Fail(w, http.StatusConflict, calendarrepo.ErrDefaultCalendar)
+	default:
+		h.logger.Error(msg, zap.String("calendar_id", calendarID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+	}
+}
+
+// decodeRequest decodes and validates the calendar of the request body.
+// It writes the error response and returns false if the body is invalid.
+func (h *Handler) decodeRequest(w http.ResponseWriter, r *http.Request) (CalendarRequest, bool) {
+	var req CalendarRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return CalendarRequest{}, false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return CalendarRequest{}, false
+	}
+
+	return req, true
+}
+
+// userID extracts the authenticated user of the request.
+// It writes the error response and returns false if it is missing.
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// parseRequest extracts the authenticated user and the calendar ID of the URL.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse calendar ID from URL parameter.
+	calendarID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid calendar id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid calendar id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, calendarID, true
+}
@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/calendar/mock_calendar_service.go -package=mocks
+
+// calendarService defines the interface for calendar-related operations.
+type calendarService interface {
+	// CreateCalendar creates a new calendar and returns it.
+	CreateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error)
+
+	// GetCalendar retrieves a calendar of a user.
+	GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error)
+
+	// ListCalendars retrieves all calendars of a user, the default calendar first.
+	ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error)
+
+	// UpdateCalendar renames and recolors a calendar of a user and returns it.
+	UpdateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error)
+
+	// DeleteCalendar deletes a calendar of a user, moving its events to the default calendar.
+	DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error
+}
+
+// Handler manages HTTP requests for the calendars of users.
+type Handler struct {
+	service   calendarService     // service handles business logic for calendars
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The calendar service for managing calendars.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s calendarService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
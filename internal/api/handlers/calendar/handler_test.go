@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockscalendarsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/calendar"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	calendarrepo "github.com/aliskhannn/calendar-service/internal/repository/calendar"
+	"github.com/aliskhannn/calendar-service/internal/validation"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mockscalendarsvc.MockcalendarService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mockscalendarsvc.NewMockcalendarService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validation.New(config.Validation{}))
+	return ctrl, mockService, handler
+}
+
+func withCalendarID(req *http.Request, userID, calendarID uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", calendarID.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CalendarRequest{Name: "Work", Color: "blue"})
+
+	req := httptest.NewRequest(http.MethodPost, "/calendars", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateCalendar(gomock.Any(), model.Calendar{UserID: userID, Name: "Work", Color: "blue"}).
+		Return(model.Calendar{ID: uuid.New(), UserID: userID, Name: "Work", Color: "blue"}, nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Create_InvalidColor(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(CalendarRequest{Name: "Work", Color: "chartreuse"})
+
+	req := httptest.NewRequest(http.MethodPost, "/calendars", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Update_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, calendarID := uuid.New(), uuid.New()
+	body, _ := json.Marshal(CalendarRequest{Name: "Work"})
+
+	req := withCalendarID(httptest.NewRequest(http.MethodPut, "/calendars/"+calendarID.String(), bytes.NewReader(body)), userID, calendarID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		UpdateCalendar(gomock.Any(), model.Calendar{ID: calendarID, UserID: userID, Name: "Work"}).
+		Return(model.Calendar{}, fmt.Errorf("update calendar: %w", calendarrepo.ErrCalendarNotFound))
+
+	h.Update(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Delete_Default(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, calendarID := uuid.New(), uuid.New()
+
+	req := withCalendarID(httptest.NewRequest(http.MethodDelete, "/calendars/"+calendarID.String(), nil), userID, calendarID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		DeleteCalendar(gomock.Any(), calendarID, userID).
+		Return(fmt.Errorf("delete calendar: %w", calendarrepo.ErrDefaultCalendar))
+
+	h.Delete(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
@@ -0,0 +1,57 @@
+package coldstorage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	coldstoragesvc "github.com/aliskhannn/calendar-service/internal/service/coldstorage"
+)
+
+// Restore handles HTTP requests to restore events exported to cold storage into the archive,
+// those of one user or, with an empty body, all of them. The restore is run by a background job;
+// the response is 202 Accepted with the job, whose progress and result are polled through the jobs API.
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req model.ColdStorageRestore
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Warn("failed to decode cold storage restore request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	job, err := h.service.StartRestore(r.Context(), userID, req)
+	if err != nil {
+		if errors.Is(err, coldstoragesvc.ErrColdStorageDisabled) {
+			response.Fail(w, http.StatusConflict, err)
+			return
+		}
+
+		h.logger.Error("failed to start cold storage restore", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	fields := []zap.Field{zap.String("user_id", userID.String()), zap.String("job_id", job.ID.String())}
+	if req.UserID != nil {
+		fields = append(fields, zap.String("owner_id", req.UserID.String()))
+	}
+	h.logger.Warn("cold storage restore started", fields...)
+	response.Accepted(w, dto.NewJob(job))
+}
@@ -0,0 +1,39 @@
+package coldstorage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/coldstorage/mock_coldstorage_service.go -package=mocks
+
+// restoreService defines the interface for restoring events exported to cold storage.
+type restoreService interface {
+	// StartRestore queues a job restoring events from cold storage into the archive.
+	StartRestore(ctx context.Context, userID uuid.UUID, req model.ColdStorageRestore) (model.Job, error)
+}
+
+// Handler manages HTTP requests for restores from cold storage.
+type Handler struct {
+	service restoreService // service handles business logic for cold storage
+	logger  *zap.Logger    // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The cold storage service for starting restores.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s restoreService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
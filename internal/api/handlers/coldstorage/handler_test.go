@@ -0,0 +1,76 @@
+package coldstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mockscoldstoragesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/coldstorage"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	coldstoragesvc "github.com/aliskhannn/calendar-service/internal/service/coldstorage"
+)
+
+func TestHandler_Restore(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := map[string]struct {
+		body    string
+		request model.ColdStorageRestore
+		err     error
+		want    int
+	}{
+		"all events":    {body: ``, want: http.StatusAccepted},
+		"one user":      {body: `{"user_id":"` + ownerID.String() + `"}`, request: model.ColdStorageRestore{UserID: &ownerID}, want: http.StatusAccepted},
+		"disabled":      {body: `{}`, err: coldstoragesvc.ErrColdStorageDisabled, want: http.StatusConflict},
+		"queue failure": {body: `{}`, err: fmt.Errorf("start cold storage restore: boom"), want: http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := mockscoldstoragesvc.NewMockrestoreService(ctrl)
+			h := New(mockService, zap.NewNop())
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/cold-storage/restores", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				StartRestore(gomock.Any(), userID, tt.request).
+				Return(model.Job{ID: uuid.New(), Kind: model.JobColdStorageRestore, Status: model.JobQueued}, tt.err)
+
+			h.Restore(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Restore_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	h := New(mockscoldstoragesvc.NewMockrestoreService(ctrl), zap.NewNop())
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/cold-storage/restores", strings.NewReader(`{"user_id":"nope"}`))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Restore(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
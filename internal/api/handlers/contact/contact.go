@@ -0,0 +1,142 @@
+package contact
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	contactrepo "github.com/aliskhannn/calendar-service/internal/repository/contact"
+)
+
+// Contacts returned by default and at most, and the longest accepted query.
+const (
+	defaultLimit   = 10
+	maxLimit       = 50
+	maxQueryLength = 100
+)
+
+// ContactRequest represents the payload for adding a contact manually.
+type ContactRequest struct {
+	Email string `json:"email" validate:"required,email,max=254"` // email address of the contact
+	Name  string `json:"name" validate:"max=100"`                 // optional display name; defaults to the name of the registered user
+}
+
+// List handles HTTP requests to list the contacts of the authenticated user for attendee autocomplete.
+// The optional "q" query parameter matches the beginning of email addresses and of the words of names;
+// "limit" caps the number of contacts. The most frequently and recently invited contacts come first.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query().Get("q")
+	if utf8.RuneCountInString(query) > maxQueryLength {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("query must not exceed %d characters", maxQueryLength))
+		return
+	}
+
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("limit must be between 1 and %d", maxLimit))
+			return
+		}
+		limit = n
+	}
+
+	contacts, err := h.service.ListContacts(r.Context(), userID, query, limit)
+	if err != nil {
+		h.logger.Error("failed to list contacts", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, contacts)
+}
+
+// Add handles HTTP requests to add a contact of the authenticated user manually.
+// Adding an existing contact keeps its ranking and updates its name.
+func (h *Handler) Add(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req ContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	c, err := h.service.AddContact(r.Context(), model.Contact{UserID: userID, Email: req.Email, Name: req.Name})
+	if err != nil {
+		h.logger.Error("failed to add contact", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, c)
+}
+
+// Remove handles HTTP requests to remove a contact of the authenticated user.
+// Inviting the contact again adds it back.
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse contact ID from URL parameter.
+	contactID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid contact id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid contact id"))
+		return
+	}
+
+	if err := h.service.RemoveContact(r.Context(), contactID, userID); err != nil {
+		if errors.Is(err, contactrepo.ErrContactNotFound) {
+			response.Fail(w, http.StatusNotFound, contactrepo.ErrContactNotFound)
+			return
+		}
+
+		h.logger.Error("failed to remove contact", zap.String("contact_id", contactID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "contact removed")
+}
+
+// userID extracts the authenticated user of the request.
+// It writes the error response and returns false if it is missing.
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
@@ -0,0 +1,49 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/contact/mock_contact_service.go -package=mocks
+
+// contactService defines the interface for the contacts of users.
+type contactService interface {
+	// ListContacts retrieves the contacts of a user matching what they typed, best ranked first.
+	ListContacts(ctx context.Context, userID uuid.UUID, query string, limit int) ([]model.Contact, error)
+
+	// AddContact adds a contact manually.
+	AddContact(ctx context.Context, c model.Contact) (model.Contact, error)
+
+	// RemoveContact removes a contact of a user.
+	RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error
+}
+
+// Handler manages HTTP requests for the contacts of the authenticated user.
+type Handler struct {
+	service   contactService      // service handles business logic for contacts
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The contact service for managing contacts.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s contactService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,106 @@
+package contact
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockscontactsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/contact"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	contactrepo "github.com/aliskhannn/calendar-service/internal/repository/contact"
+	"github.com/aliskhannn/calendar-service/internal/validation"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mockscontactsvc.MockcontactService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mockscontactsvc.NewMockcontactService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validation.New(config.Validation{}))
+	return ctrl, mockService, handler
+}
+
+func TestHandler_List(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/user/contacts?q=an&limit=5", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ListContacts(gomock.Any(), userID, "an", 5).
+		Return([]model.Contact{{Email: "ana@example.com", InviteCount: 3}}, nil)
+
+	h.List(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_List_InvalidLimit(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/user/contacts?limit=%d", maxLimit+1), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.List(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Add_InvalidEmail(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(ContactRequest{Email: "not an email"})
+	req := httptest.NewRequest(http.MethodPost, "/user/contacts", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Add(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Remove_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, contactID := uuid.New(), uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/user/contacts/"+contactID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", contactID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		RemoveContact(gomock.Any(), contactID, userID).
+		Return(fmt.Errorf("remove contact: %w", contactrepo.ErrContactNotFound))
+
+	h.Remove(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
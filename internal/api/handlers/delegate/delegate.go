@@ -0,0 +1,128 @@
+package delegate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	delegaterepo "github.com/aliskhannn/calendar-service/internal/repository/delegate"
+)
+
+// AddRequest represents the payload for adding a delegate.
+type AddRequest struct {
+	Email string `json:"email" validate:"required,email"` // email address of the user allowed to create events
+}
+
+// Add handles HTTP requests to allow another user to create events in the authenticated user's calendar.
+func (h *Handler) Add(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req AddRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	d, err := h.service.AddDelegate(r.Context(), userID, req.Email)
+	if err != nil {
+		if errors.Is(err, delegaterepo.ErrUserNotFound) {
+			response.Fail(w, http.StatusNotFound, delegaterepo.ErrUserNotFound)
+			return
+		}
+		if errors.Is(err, delegaterepo.ErrSelfDelegation) {
+			response.Fail(w, http.StatusBadRequest, delegaterepo.ErrSelfDelegation)
+			return
+		}
+
+		h.logger.Error("failed to add delegate", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("delegate added",
+		zap.String("user_id", userID.String()),
+		zap.String("delegate_id", d.DelegateID.String()),
+	)
+	response.Created(w, d)
+}
+
+// List handles HTTP requests to list the delegates of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	delegates, err := h.service.ListDelegates(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list delegates", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	if delegates == nil {
+		delegates = []model.Delegate{}
+	}
+	response.List(w, r, delegates)
+}
+
+// Remove handles HTTP requests to revoke the permission of a delegate of the authenticated user.
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse delegate ID from URL parameter.
+	delegateID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid delegate id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid delegate id"))
+		return
+	}
+
+	if err := h.service.RemoveDelegate(r.Context(), userID, delegateID); err != nil {
+		if errors.Is(err, delegaterepo.ErrDelegateNotFound) {
+			response.Fail(w, http.StatusNotFound, delegaterepo.ErrDelegateNotFound)
+			return
+		}
+
+		h.logger.Error("failed to remove delegate", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("delegate removed",
+		zap.String("user_id", userID.String()),
+		zap.String("delegate_id", delegateID.String()),
+	)
+	response.OK(w, "delegate removed")
+}
@@ -0,0 +1,49 @@
+package delegate
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/delegate/mock_delegate_service.go -package=mocks
+
+// delegateService defines the interface for managing the delegates of a user.
+type delegateService interface {
+	// AddDelegate allows another user, identified by email address, to create events in the owner's calendar.
+	AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error)
+
+	// ListDelegates retrieves the delegates of a user.
+	ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error)
+
+	// RemoveDelegate revokes the permission of a delegate to create events in the owner's calendar.
+	RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error
+}
+
+// Handler manages HTTP requests for the delegates of the authenticated user.
+type Handler struct {
+	service   delegateService     // service handles business logic for delegates
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The delegate service for managing delegates.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s delegateService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,103 @@
+package delegate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksdelegatesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/delegate"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	delegaterepo "github.com/aliskhannn/calendar-service/internal/repository/delegate"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksdelegatesvc.MockdelegateService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksdelegatesvc.NewMockdelegateService(ctrl)
+	handler := New(mockService, zap.NewNop(), validator.New())
+	return ctrl, mockService, handler
+}
+
+func TestHandler_Add(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"added":         {want: http.StatusCreated},
+		"unknown email": {err: fmt.Errorf("add delegate: %w", delegaterepo.ErrUserNotFound), want: http.StatusNotFound},
+		"own email":     {err: fmt.Errorf("add delegate: %w", delegaterepo.ErrSelfDelegation), want: http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID := uuid.New()
+			body, _ := json.Marshal(AddRequest{Email: "assistant@example.com"})
+			req := httptest.NewRequest(http.MethodPost, "/delegates", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				AddDelegate(gomock.Any(), userID, "assistant@example.com").
+				Return(model.Delegate{OwnerID: userID, DelegateID: uuid.New(), Email: "assistant@example.com"}, tt.err)
+
+			h.Add(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Add_InvalidEmail(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(AddRequest{Email: "not an email"})
+	req := httptest.NewRequest(http.MethodPost, "/delegates", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Add(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Remove_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, delegateID := uuid.New(), uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/delegates/"+delegateID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", delegateID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		RemoveDelegate(gomock.Any(), userID, delegateID).
+		Return(fmt.Errorf("remove delegate: %w", delegaterepo.ErrDelegateNotFound))
+
+	h.Remove(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,53 @@
+package demo
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/demo/mock_demo.go -package=mocks
+
+// userService defines the creation of throwaway accounts.
+type userService interface {
+	// CreateDemo registers a demo account with generated credentials and returns them with a token.
+	CreateDemo(ctx context.Context, name string) (model.DemoAccount, error)
+}
+
+// onboardingService defines the creation of the sample data of new accounts.
+type onboardingService interface {
+	// Seed creates the sample data of a new user, scheduled in the given time zone.
+	Seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error)
+}
+
+// Handler manages HTTP requests of the public demo mode.
+type Handler struct {
+	users      userService         // service registering the throwaway accounts
+	onboarding onboardingService   // service seeding the accounts with sample data
+	logger     *zap.Logger         // logger logs application events and errors
+	validator  *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - u: The user service registering demo accounts.
+//   - o: The onboarding service seeding them with sample data.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(u userService, o onboardingService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		users:      u,
+		onboarding: o,
+		logger:     l,
+		validator:  v,
+	}
+}
@@ -0,0 +1,103 @@
+package demo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksdemo "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/demo"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksdemo.MockuserService, *mocksdemo.MockonboardingService, *Handler) {
+	ctrl := gomock.NewController(t)
+	users := mocksdemo.NewMockuserService(ctrl)
+	onboarding := mocksdemo.NewMockonboardingService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, users, onboarding, New(users, onboarding, logger, validator.New())
+}
+
+func TestHandler_Register(t *testing.T) {
+	ctrl, users, onboarding, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	account := model.DemoAccount{UserID: uuid.New(), Email: "demo-1@demo.invalid", Password: "secret", Token: "token"}
+	users.EXPECT().CreateDemo(gomock.Any(), "Jane").Return(account, nil)
+	onboarding.EXPECT().
+		Seed(gomock.Any(), account.UserID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+			if loc.String() != "Europe/Berlin" {
+				t.Fatalf("expected the requested time zone, got %s", loc)
+			}
+			return model.OnboardingSeed{}, nil
+		})
+
+	req := httptest.NewRequest(http.MethodPost, "/user/register", strings.NewReader(`{"name":"Jane","timezone":"Europe/Berlin"}`))
+	w := httptest.NewRecorder()
+	h.Register(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var body struct {
+		Result dto.DemoAccount `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.Result.Token != "token" || body.Result.Email != account.Email || body.Result.Password != "secret" {
+		t.Fatalf("unexpected account: %+v", body.Result)
+	}
+}
+
+func TestHandler_Register_SeedFails(t *testing.T) {
+	ctrl, users, onboarding, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	users.EXPECT().CreateDemo(gomock.Any(), "").Return(model.DemoAccount{UserID: uuid.New()}, nil)
+	onboarding.EXPECT().Seed(gomock.Any(), gomock.Any(), time.UTC).Return(model.OnboardingSeed{}, errors.New("db down"))
+
+	w := httptest.NewRecorder()
+	h.Register(w, httptest.NewRequest(http.MethodPost, "/user/register", nil))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Register_Errors(t *testing.T) {
+	ctrl, users, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	users.EXPECT().CreateDemo(gomock.Any(), gomock.Any()).Return(model.DemoAccount{}, errors.New("db down"))
+
+	tests := []struct {
+		body string
+		code int
+	}{
+		{`{invalid`, http.StatusBadRequest},
+		{`{"timezone":"Mars/Olympus"}`, http.StatusBadRequest},
+		{`{}`, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		h.Register(w, httptest.NewRequest(http.MethodPost, "/user/register", strings.NewReader(tt.body)))
+
+		if w.Code != tt.code {
+			t.Fatalf("%s: expected status %d, got %d", tt.body, tt.code, w.Code)
+		}
+	}
+}
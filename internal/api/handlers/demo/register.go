@@ -0,0 +1,65 @@
+package demo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+)
+
+// RegisterRequest represents the optional payload for registering a demo account.
+// Email and password are generated, so any sent by clients of the regular registration are ignored.
+type RegisterRequest struct {
+	Name     string `json:"name" validate:"max=255"`                // optional name of the account
+	Timezone string `json:"timezone" validate:"omitempty,timezone"` // IANA time zone the sample events are scheduled in; UTC if empty
+}
+
+// Register handles registration in demo mode: it creates a throwaway account with generated credentials,
+// seeds it with the sample data of the onboarding and returns the credentials with a token.
+// The account and its data are deleted once it expires.
+func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
+	// The body is optional.
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Warn("failed to decode demo register request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	loc := time.UTC
+	if req.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(req.Timezone); err != nil {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("unknown time zone %q", req.Timezone))
+			return
+		}
+	}
+
+	account, err := h.users.CreateDemo(r.Context(), req.Name)
+	if err != nil {
+		h.logger.Error("failed to register demo account", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	// An account without sample data is still usable, so seeding failures are only logged.
+	if _, err := h.onboarding.Seed(r.Context(), account.UserID, loc); err != nil {
+		h.logger.Warn("failed to seed demo account", zap.String("user_id", account.UserID.String()), zap.Error(err))
+	}
+
+	h.logger.Info("demo account registered", zap.String("user_id", account.UserID.String()))
+	response.Created(w, dto.NewDemoAccount(account))
+}
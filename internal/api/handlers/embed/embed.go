@@ -0,0 +1,139 @@
+package embed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
+	embedsvc "github.com/aliskhannn/calendar-service/internal/service/embed"
+)
+
+// CreateRequest represents the payload for creating a new embed.
+type CreateRequest struct {
+	Name           string     `json:"name" validate:"required,min=1,max=255"`                          // name shown as the calendar title
+	ProjectID      *uuid.UUID `json:"project_id"`                                                      // only publish events of this project
+	AllowedDomains []string   `json:"allowed_domains" validate:"max=20,dive,hostname_rfc1123,max=253"` // hosts allowed to embed the calendar; any when empty
+	RangeDays      int        `json:"range_days" validate:"omitempty,min=1"`                           // days from today shown by default, 30 if not set
+}
+
+// Create handles HTTP requests to create an embed for the authenticated user.
+// The share token is only included in this response.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	domains := make([]string, 0, len(req.AllowedDomains))
+	for _, d := range req.AllowedDomains {
+		domains = append(domains, strings.ToLower(d))
+	}
+
+	embed, err := h.service.CreateEmbed(r.Context(), model.Embed{
+		UserID:         userID,
+		Name:           req.Name,
+		ProjectID:      req.ProjectID,
+		AllowedDomains: domains,
+		RangeDays:      req.RangeDays,
+	})
+	if err != nil {
+		if errors.Is(err, embedrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusBadRequest, embedrepo.ErrProjectNotFound)
+			return
+		}
+
+		if errors.Is(err, embedsvc.ErrRangeTooLong) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to create embed", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, dto.NewEmbed(embed))
+}
+
+// List handles HTTP requests to list the embeds of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	embeds, err := h.service.ListEmbeds(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list embeds", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewEmbeds(embeds))
+}
+
+// Delete handles HTTP requests to delete an embed by its ID, revoking its share token.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse embed ID from URL parameter.
+	embedID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid embed id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid embed id"))
+		return
+	}
+
+	if err := h.service.DeleteEmbed(r.Context(), embedID, userID); err != nil {
+		if errors.Is(err, embedrepo.ErrEmbedNotFound) {
+			response.Fail(w, http.StatusNotFound, embedrepo.ErrEmbedNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete embed", zap.String("embed_id", embedID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "embed deleted")
+}
@@ -0,0 +1,56 @@
+package embed
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/embed/mock_embed_service.go -package=mocks
+
+// embedService defines the interface for embed operations.
+type embedService interface {
+	// CreateEmbed creates an embed with a new share token.
+	CreateEmbed(ctx context.Context, embed model.Embed) (model.Embed, error)
+
+	// ListEmbeds retrieves all embeds of a user.
+	ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error)
+
+	// DeleteEmbed deletes an embed of the specified user.
+	DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error
+
+	// GetCalendar retrieves the embed of a share token and the events it publishes within a range.
+	GetCalendar(ctx context.Context, token string, from, to *time.Time) (model.EmbedCalendar, error)
+}
+
+// Handler manages HTTP requests for embeds and the public calendars they publish.
+type Handler struct {
+	service     embedService        // service handles business logic for embeds
+	cacheMaxAge time.Duration       // how long embedded calendars may be cached
+	logger      *zap.Logger         // logger logs application events and errors
+	validator   *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The embed service for handling embed-related operations.
+//   - cacheMaxAge: How long browsers and CDNs may cache an embedded calendar.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s embedService, cacheMaxAge time.Duration, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:     s,
+		cacheMaxAge: cacheMaxAge,
+		logger:      l,
+		validator:   v,
+	}
+}
@@ -0,0 +1,197 @@
+package embed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksembedsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/embed"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksembedsvc.MockembedService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksembedsvc.NewMockembedService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, 5*time.Minute, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func withToken(req *http.Request, token string) *http.Request {
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("token", token)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+// calendar is an embedded calendar limited to example.com.
+var calendar = model.EmbedCalendar{
+	Embed:  model.Embed{Name: "Team <calendar>", AllowedDomains: []string{"example.com"}},
+	From:   time.Date(2030, 3, 10, 0, 0, 0, 0, time.UTC),
+	To:     time.Date(2030, 3, 17, 0, 0, 0, 0, time.UTC),
+	Events: []model.Event{{Title: "Launch", EventDate: time.Date(2030, 3, 11, 9, 30, 0, 0, time.UTC), Color: "blue"}},
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Name: "Team", AllowedDomains: []string{"Example.com"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/embeds", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateEmbed(gomock.Any(), model.Embed{UserID: userID, Name: "Team", AllowedDomains: []string{"example.com"}}).
+		Return(model.Embed{ID: uuid.New(), Name: "Team", Token: "secret"}, nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"token":"secret"`) {
+		t.Fatalf("expected the token in the response, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_Create_InvalidDomain(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(CreateRequest{Name: "Team", AllowedDomains: []string{"https://example.com/page"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/embeds", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Calendar_AllowedDomain(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetCalendar(gomock.Any(), "secret", nil, nil).Return(calendar, nil).Times(2)
+
+	req := withToken(httptest.NewRequest(http.MethodGet, "/embed/secret", nil), "secret")
+	req.Header.Set("Referer", "https://blog.example.com/about")
+	w := httptest.NewRecorder()
+
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://blog.example.com" {
+		t.Fatalf("unexpected allowed origin %q", got)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "public, max-age=300" {
+		t.Fatalf("unexpected cache control %q", got)
+	}
+
+	var resp struct {
+		Result struct {
+			Name   string `json:"name"`
+			From   string `json:"from"`
+			Events []struct {
+				Title string `json:"title"`
+			} `json:"events"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.From != "2030-03-10" || len(resp.Result.Events) != 1 || resp.Result.Events[0].Title != "Launch" {
+		t.Fatalf("unexpected calendar %+v", resp.Result)
+	}
+
+	// The same content is not sent again.
+	req = withToken(httptest.NewRequest(http.MethodGet, "/embed/secret", nil), "secret")
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("expected status %d, got %d", http.StatusNotModified, w.Code)
+	}
+}
+
+func TestHandler_Calendar_ForbiddenDomain(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetCalendar(gomock.Any(), "secret", nil, nil).Return(calendar, nil)
+
+	req := withToken(httptest.NewRequest(http.MethodGet, "/embed/secret", nil), "secret")
+	req.Header.Set("Referer", "https://notexample.com/")
+	w := httptest.NewRecorder()
+
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandler_Calendar_HTML(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetCalendar(gomock.Any(), "secret", nil, nil).Return(calendar, nil)
+
+	req := withToken(httptest.NewRequest(http.MethodGet, "/embed/secret?format=html&tz=Europe/Berlin", nil), "secret")
+	req.Header.Set("Referer", "https://example.com/")
+	w := httptest.NewRecorder()
+
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, "Team &lt;calendar&gt;") || !strings.Contains(body, "<time>10:30</time>Launch") {
+		t.Fatalf("unexpected page:\n%s", body)
+	}
+	if got := w.Header().Get("Content-Security-Policy"); got != "frame-ancestors example.com *.example.com" {
+		t.Fatalf("unexpected content security policy %q", got)
+	}
+}
+
+func TestHandler_Calendar_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetCalendar(gomock.Any(), "revoked", gomock.Any(), gomock.Any()).
+		Return(model.EmbedCalendar{}, embedrepo.ErrEmbedNotFound)
+
+	req := withToken(httptest.NewRequest(http.MethodGet, "/embed/revoked", nil), "revoked")
+	w := httptest.NewRecorder()
+
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,236 @@
+package embed
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
+	embedsvc "github.com/aliskhannn/calendar-service/internal/service/embed"
+)
+
+// calendarTemplate renders an embedded calendar as a self-contained page for an iframe.
+var calendarTemplate = template.Must(template.New("embed").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Name}}</title>
+<style>
+body{font-family:system-ui,sans-serif;font-size:14px;margin:0;padding:8px;color:#222}
+h1{font-size:16px;margin:0 0 8px}
+h2{font-size:13px;color:#666;margin:12px 0 4px}
+ul{list-style:none;margin:0;padding:0}
+li{padding:2px 0}
+.dot{display:inline-block;width:8px;height:8px;border-radius:50%;margin-right:6px;background:#999}
+time{color:#666;margin-right:6px}
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+{{range .Days}}<h2>{{.Date}}</h2>
+<ul>
+{{range .Events}}<li><span class="dot"{{if .Color}} style="background:{{.Color}}"{{end}}></span><time>{{.Time}}</time>{{.Title}}</li>
+{{end}}</ul>
+{{else}}<p>No events.</p>
+{{end}}</body>
+</html>
+`))
+
+// calendarPage is the data of calendarTemplate.
+type calendarPage struct {
+	Name string        // title of the calendar
+	Days []calendarDay // days with events in order
+}
+
+// calendarDay lists the events of one day of a calendarPage.
+type calendarDay struct {
+	Date   string          // formatted day
+	Events []calendarEvent // events of the day
+}
+
+// calendarEvent is an event of a calendarDay with its time in the page's zone.
+type calendarEvent struct {
+	dto.EmbedEvent
+	Time string // formatted time of the event
+}
+
+// Calendar handles public HTTP requests for an embedded calendar by its share token.
+// It accepts an optional range (from, to as YYYY-MM-DD or a relative expression such as "today"),
+// format=html for a prerendered page, and tz, the IANA zone the page shows days and times in
+// and relative dates are resolved in (UTC by default).
+// Pages outside the allowed domains of the embed are rejected; responses are cacheable and carry an ETag.
+func (h *Handler) Calendar(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	loc, err := dateexpr.Location(query.Get("tz"))
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid time zone"))
+		return
+	}
+	now := time.Now().In(loc)
+
+	from, err := dateexpr.Parsed(query.Get("from"), now)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid from date"))
+		return
+	}
+
+	to, err := dateexpr.Parsed(query.Get("to"), now)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid to date"))
+		return
+	}
+
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "html" {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("format must be json or html"))
+		return
+	}
+
+	cal, err := h.service.GetCalendar(r.Context(), chi.URLParam(r, "token"), from, to)
+	if err != nil {
+		if errors.Is(err, embedrepo.ErrEmbedNotFound) {
+			response.Fail(w, http.StatusNotFound, embedrepo.ErrEmbedNotFound)
+			return
+		}
+
+		if errors.Is(err, embedsvc.ErrInvalidRange) || errors.Is(err, embedsvc.ErrRangeTooLong) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to get embedded calendar", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	origin, ok := allowedOrigin(r, cal.Embed.AllowedDomains)
+	if !ok {
+		response.Fail(w, http.StatusForbidden, fmt.Errorf("domain not allowed"))
+		return
+	}
+
+	payload := dto.NewEmbedCalendar(cal)
+
+	var (
+		body        []byte
+		contentType string
+	)
+	if format == "html" {
+		var buf bytes.Buffer
+		if err := calendarTemplate.Execute(&buf, newCalendarPage(payload, loc)); err != nil {
+			h.logger.Error("failed to render embedded calendar", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		body, contentType = buf.Bytes(), "text/html; charset=utf-8"
+	} else {
+		if body, err = json.Marshal(response.Success{Result: payload}); err != nil {
+			h.logger.Error("failed to encode embedded calendar", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		contentType = "application/json"
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := w.Header()
+	header.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(h.cacheMaxAge.Seconds())))
+	header.Set("ETag", etag)
+	header.Set("Access-Control-Allow-Origin", origin)
+	header.Set("Content-Security-Policy", "frame-ancestors "+frameAncestors(cal.Embed.AllowedDomains))
+	if len(cal.Embed.AllowedDomains) > 0 {
+		// Whether the response is allowed depends on the embedding page.
+		header.Set("Vary", "Origin, Referer")
+	}
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// allowedOrigin checks the page embedding a calendar against the allowed domains of its embed.
+// The page is taken from the Origin header, or from the Referer for iframes and plain requests.
+// A domain allows itself and its subdomains; without domains, every page is allowed.
+//
+// Returns:
+//   - The value for Access-Control-Allow-Origin.
+//   - Whether the request is allowed.
+func allowedOrigin(r *http.Request, domains []string) (string, bool) {
+	if len(domains) == 0 {
+		return "*", true
+	}
+
+	source := r.Header.Get("Origin")
+	if source == "" {
+		source = r.Header.Get("Referer")
+	}
+
+	u, err := url.Parse(source)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return u.Scheme + "://" + u.Host, true
+		}
+	}
+
+	return "", false
+}
+
+// frameAncestors returns the CSP frame-ancestors sources of the allowed domains of an embed.
+func frameAncestors(domains []string) string {
+	if len(domains) == 0 {
+		return "*"
+	}
+
+	sources := make([]string, 0, 2*len(domains))
+	for _, d := range domains {
+		sources = append(sources, d, "*."+d)
+	}
+	return strings.Join(sources, " ")
+}
+
+// newCalendarPage groups the events of an embedded calendar by day in the given zone.
+func newCalendarPage(c dto.EmbedCalendar, loc *time.Location) calendarPage {
+	page := calendarPage{Name: c.Name}
+	for _, e := range c.Events {
+		at := e.EventDate.In(loc)
+		date := at.Format("Monday, January 2")
+		if n := len(page.Days); n == 0 || page.Days[n-1].Date != date {
+			page.Days = append(page.Days, calendarDay{Date: date})
+		}
+
+		day := &page.Days[len(page.Days)-1]
+		day.Events = append(day.Events, calendarEvent{EmbedEvent: e, Time: at.Format("15:04")})
+	}
+
+	return page
+}
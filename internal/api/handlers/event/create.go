@@ -2,6 +2,7 @@ package event
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,18 +10,47 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
 	"github.com/aliskhannn/calendar-service/internal/api/response"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
+	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
 )
 
 // CreateRequest represents the payload for creating a new event.
+// The event belongs to the authenticated user, or to the user named by on_behalf_of if the
+// authenticated user is one of their delegates.
 type CreateRequest struct {
-	UserID      uuid.UUID  `json:"user_id" validate:"required"`
-	Title       string     `json:"title" validate:"required,min=3,max=255"`
-	Description string     `json:"description" validate:"max=1000"`
-	EventDate   time.Time  `json:"event_date" validate:"required"`
-	ReminderAt  *time.Time `json:"reminder_at"` // optional reminder timestamp
+	UserID           *uuid.UUID `json:"user_id"`      // deprecated; if set, it must be the authenticated user
+	OnBehalfOf       *uuid.UUID `json:"on_behalf_of"` // optional user whose calendar the event is created in, as their delegate
+	Title            string     `json:"title" validate:"required,event_title"`
+	Description      string     `json:"description" validate:"event_description"`
+	Location         string     `json:"location" validate:"max=255"`                                     // optional place of the event, e.g. an address or a meeting room
+	Latitude         *float64   `json:"latitude" validate:"required_with=Longitude,omitempty,latitude"`  // optional latitude of the location, together with longitude
+	Longitude        *float64   `json:"longitude" validate:"required_with=Latitude,omitempty,longitude"` // optional longitude of the location, together with latitude
+	EventDate        time.Time  `json:"event_date" validate:"required"`
+	EndDate          *time.Time `json:"end_date"`                                                                    // optional end of the event, after event_date
+	Duration         string     `json:"duration" validate:"omitempty,excluded_with=EndDate"`                         // optional length of the event instead of end_date, e.g. 1h30m
+	Priority         string     `json:"priority" validate:"omitempty,oneof=low normal high critical"`                // optional, defaults to normal
+	ProjectID        *uuid.UUID `json:"project_id"`                                                                  // optional project the event belongs to
+	CalendarID       *uuid.UUID `json:"calendar_id"`                                                                 // optional calendar of the owner; defaults to their default calendar
+	Color            string     `json:"color" validate:"omitempty,display_color"`                                    // optional color; rules color events created without one
+	Tags             []string   `json:"tags" validate:"max=10,dive,min=1,max=32"`                                    // optional tags; rules may add more
+	ReminderAt       *time.Time `json:"reminder_at"`                                                                 // optional reminder timestamp
+	ReminderTimezone string     `json:"reminder_timezone" validate:"omitempty,excluded_without=ReminderAt,timezone"` // optional IANA time zone reminder_at is a wall-clock time in
+	RecurrenceRule   string     `json:"recurrence_rule" validate:"max=255"`                                          // optional RRULE repeating the event, e.g. FREQ=WEEKLY;BYDAY=MO
+
+	Notifications *NotificationsRequest `json:"notifications"` // optional overrides of the owner's notification preferences for the event
+}
+
+// NotificationsRequest represents the notification overrides of an event in create and update requests.
+// Fields left out follow the notification preferences of the owner.
+type NotificationsRequest struct {
+	Channel   string `json:"channel" validate:"omitempty,oneof=email"` // channel reminders are sent through; email is the only one so far
+	LeadTime  string `json:"lead_time"`                                // optional time before the start the reminder is sent at, e.g. 15m, instead of reminder_at
+	Reminders *bool  `json:"reminders"`                                // optional; true sends reminders even if the owner unsubscribed, false mutes them
 }
 
 // Create handles the creation of a new event.
@@ -28,9 +58,15 @@ type CreateRequest struct {
 // It performs the following steps:
 // 1. Extracts user ID from the request context.
 // 2. Decodes and validates the request body.
-// 3. Creates the event via the service.
-// 4. Pushes a reminder to the background worker channel if ReminderAt is set and in the future.
-// 5. Returns the created event ID in the response.
+// 3. Determines the owner: the authenticated user, or on_behalf_of if the user is one of its delegates.
+// 4. Suggests tags and a project from the user's previous events, applying them with auto_tag=true.
+// 5. Creates the event via the service.
+// 6. Returns the created event ID together with the suggestions in the response.
+//
+// The owner never comes from the body alone: a user_id other than the authenticated user and an
+// on_behalf_of the user is not a delegate of are rejected with 403.
+// Suggestions are best effort: if they cannot be made, the event is created without them.
+// They are not made for delegated events, as they would reveal the owner's tags and projects.
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	userIDVal := r.Context().Value(middlewares.UserIDKey)
 	userID, ok := userIDVal.(uuid.UUID)
@@ -42,7 +78,6 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req CreateRequest
-	req.UserID = userID
 
 	// Decode JSON payload.
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -58,21 +93,123 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Title == "" || req.EventDate.IsZero() || req.UserID == uuid.Nil {
+	if req.Title == "" || req.EventDate.IsZero() {
 		h.logger.Warn("missing required fields",
 			zap.String("title", req.Title),
 			zap.Time("event_date", req.EventDate),
-			zap.String("user_id", req.UserID.String()),
 		)
 		response.Fail(w, http.StatusBadRequest, fmt.Errorf("missing required fields"))
 		return
 	}
 
+	// The user ID comes from the token; a different one in the body is an attempt to create events for someone else.
+	if req.UserID != nil && *req.UserID != userID {
+		h.logger.Warn("user id in body does not match the authenticated user",
+			zap.String("user_id", userID.String()),
+			zap.String("body_user_id", req.UserID.String()),
+		)
+		response.Fail(w, http.StatusForbidden, fmt.Errorf("user_id must be the authenticated user; use on_behalf_of to create events for another user"))
+		return
+	}
+
+	ownerID := userID
+	if req.OnBehalfOf != nil && *req.OnBehalfOf != userID {
+		allowed, err := h.delegates.CanCreateFor(r.Context(), *req.OnBehalfOf, userID)
+		if err != nil {
+			h.logger.Error("failed to check delegate", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		if !allowed {
+			h.logger.Warn("user is not a delegate",
+				zap.String("user_id", userID.String()),
+				zap.String("on_behalf_of", req.OnBehalfOf.String()),
+			)
+			response.Fail(w, http.StatusForbidden, fmt.Errorf("not allowed to create events for this user"))
+			return
+		}
+		ownerID = *req.OnBehalfOf
+	}
+	delegated := ownerID != userID
+
+	endDate, err := eventEnd(req.EventDate, req.EndDate, req.Duration)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	notifications, err := eventNotifications(req.Notifications, req.ReminderAt)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	event := model.Event{
+		UserID:           ownerID,
+		Title:            req.Title,
+		Description:      req.Description,
+		Location:         req.Location,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		EventDate:        req.EventDate,
+		EndDate:          endDate,
+		Priority:         req.Priority,
+		ProjectID:        req.ProjectID,
+		CalendarID:       req.CalendarID,
+		Color:            req.Color,
+		Tags:             req.Tags,
+		ReminderAt:       req.ReminderAt,
+		ReminderTimezone: req.ReminderTimezone,
+		Notifications:    notifications,
+		RecurrenceRule:   req.RecurrenceRule,
+	}
+
+	var suggestion model.Suggestion
+	if !delegated {
+		suggestion, err = h.suggestions.Suggest(r.Context(), event)
+		if err != nil {
+			h.logger.Warn("failed to suggest tags", zap.String("user_id", userID.String()), zap.Error(err))
+			suggestion = model.Suggestion{}
+		}
+	}
+
+	autoTag := r.URL.Query().Get("auto_tag") == "true" && !delegated
+	if autoTag {
+		event.Tags = append(event.Tags, suggestion.Tags...)
+		if event.ProjectID == nil {
+			event.ProjectID = suggestion.ProjectID
+		}
+	}
+
 	// Create event in the service/repository.
-	id, err := h.service.CreateEvent(r.Context(), req.UserID, req.Title, req.Description, req.EventDate, req.ReminderAt)
+	id, err := h.service.CreateEvent(r.Context(), event)
 	if err != nil {
+		// Handle case where the event is assigned to an unknown project.
+		if errors.Is(err, eventrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrProjectNotFound)
+			return
+		}
+
+		// Handle case where the event is assigned to a calendar the owner does not have.
+		if errors.Is(err, eventrepo.ErrCalendarNotFound) {
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrCalendarNotFound)
+			return
+		}
+
+		// Handle case where the user already has as many events as allowed.
+		if errors.Is(err, eventsvc.ErrEventLimit) {
+			response.Fail(w, http.StatusForbidden, eventsvc.ErrEventLimit)
+			return
+		}
+
+		// Handle case where the end, the reminder time zone or the recurrence rule is invalid.
+		if errors.Is(err, eventsvc.ErrInvalidEnd) || errors.Is(err, eventsvc.ErrUnknownTimezone) || errors.Is(err, rrule.ErrInvalidRule) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
 		h.logger.Error("failed to create event",
-			zap.String("user_id", req.UserID.String()),
+			zap.String("user_id", ownerID.String()),
 			zap.String("title", req.Title),
 			zap.Error(err),
 		)
@@ -80,23 +217,70 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Push reminder to the worker if ReminderAt is set and in the future.
-	if req.ReminderAt != nil && req.ReminderAt.After(time.Now()) {
-		reminder := model.Reminder{
-			UserID:   req.UserID,
-			EventID:  id,
-			Message:  req.Title,
-			RemindAt: *req.ReminderAt,
-		}
+	if delegated {
+		h.logger.Info("event created by delegate",
+			zap.String("event_id", id.String()),
+			zap.String("user_id", ownerID.String()),
+			zap.String("delegate_id", userID.String()),
+		)
+	}
+
+	response.Created(w, dto.NewCreatedEvent(id, suggestion, autoTag))
+}
+
+// eventEnd returns the end of an event given either as an end time or as a duration from its start.
+//
+// Parameters:
+//   - start: The date and time the event starts.
+//   - end: The optional end time.
+//   - duration: The optional duration, a Go duration string such as "1h30m"; ignored if end is set.
+//
+// Returns:
+//   - The end of the event, or nil if neither is given.
+//   - An error if the duration cannot be parsed.
+func eventEnd(start time.Time, end *time.Time, duration string) (*time.Time, error) {
+	if end != nil || duration == "" {
+		return end, nil
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %q", duration)
+	}
+
+	at := start.Add(d)
+	return &at, nil
+}
 
-		select {
-		case h.reminderCh <- reminder:
-			h.logger.Info("reminder scheduled", zap.String("user_id", req.UserID.String()), zap.Time("remind_at", *req.ReminderAt))
-		default:
-			// Channel full, log warning.
-			h.logger.Warn("reminder channel is full, dropping reminder", zap.String("user_id", req.UserID.String()))
+// eventNotifications converts the notification overrides of a request into those of the event.
+//
+// Parameters:
+//   - req: The optional overrides of the request.
+//   - reminderAt: The reminder time of the request, which a lead time cannot be combined with;
+//     nil to let the lead time replace it.
+//
+// Returns:
+//   - The overrides, or nil if none is set.
+//   - An error if the lead time cannot be parsed, is not positive, or is given together with reminderAt.
+func eventNotifications(req *NotificationsRequest, reminderAt *time.Time) (*model.EventNotifications, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	n := model.EventNotifications{Channel: req.Channel, Reminders: req.Reminders}
+	if req.LeadTime != "" {
+		d, err := time.ParseDuration(req.LeadTime)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid lead_time %q", req.LeadTime)
+		}
+		if reminderAt != nil {
+			return nil, fmt.Errorf("lead_time cannot be combined with reminder_at")
 		}
+		n.LeadTime = d
 	}
 
-	response.Created(w, id)
+	if n == (model.EventNotifications{}) {
+		return nil, nil
+	}
+	return &n, nil
 }
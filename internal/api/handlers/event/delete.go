@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -10,14 +11,23 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
 	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
 )
 
+// BulkDeleteRequest represents the payload for deleting several events at once.
+type BulkDeleteRequest struct {
+	EventIDs []uuid.UUID `json:"event_ids" validate:"required,bulk_events"` // events to delete, at most the configured bulkMaxEvents
+}
+
 // Delete handles the HTTP request to delete an event by its ID.
 // It extracts the event ID from the URL parameter and the user ID from the request context,
 // validates them, and calls the service to delete the event. If successful, it returns a success response.
 // In case of errors (e.g., invalid ID, unauthorized user, or event not found), it returns an appropriate error response.
+//
+// For a recurring event, scope=occurrence&occurrence=<RFC 3339> deletes only that occurrence;
+// the default scope=all deletes the whole series.
 func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 	// Extract event ID from URL parameter.
 	idStr := chi.URLParam(r, "id")
@@ -44,6 +54,25 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Extract the optional occurrence the deletion is limited to.
+	occurrence, err := occurrenceParam(r)
+	if err != nil {
+		h.logger.Warn("invalid occurrence", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Delete a single occurrence of a recurring event.
+	if occurrence != nil {
+		if err := h.service.DeleteOccurrence(r.Context(), eventID, userID, *occurrence); err != nil {
+			h.failOccurrence(w, eventID, err)
+			return
+		}
+
+		response.OK(w, "occurrence deleted")
+		return
+	}
+
 	// Attempt to delete the event using the service.
 	if err := h.service.DeleteEvent(r.Context(), eventID, userID); err != nil {
 		// Handle case where event is not found.
@@ -66,3 +95,90 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 	// Return success response.
 	response.OK(w, "event deleted")
 }
+
+// DeleteRange handles HTTP requests to delete the authenticated user's events in a date range.
+// The from and to query parameters (date expressions like the listings accept) are required and to is inclusive;
+// the optional calendar_id limits the deletion to one of the user's calendars.
+// A recurring event is deleted with its whole series if it starts within the range.
+// The response reports the number of deleted events.
+func (h *Handler) DeleteRange(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Resolve and validate the date range in the user's time zone; both ends are required,
+	// so a missing parameter cannot clear the whole calendar.
+	now, err := h.queryNow(r, userID)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	from, err := dateexpr.Parse(r.URL.Query().Get("from"), now)
+	if err != nil {
+		h.logger.Warn("invalid from date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing from date"))
+		return
+	}
+	to, err := dateexpr.Parse(r.URL.Query().Get("to"), now)
+	if err != nil {
+		h.logger.Warn("invalid to date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing to date"))
+		return
+	}
+	if to.Before(from) {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("to must not be before from"))
+		return
+	}
+	calendarID, err := parseCalendarID(r)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	deleted, err := h.service.DeleteEventsInRange(r.Context(), userID, from, to, calendarID)
+	if err != nil {
+		h.logger.Error("failed to delete events in range", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, map[string]int{"deleted": deleted})
+}
+
+// BulkDelete handles HTTP requests to delete several of the authenticated user's events by their IDs.
+// IDs of unknown events or events of other users are skipped; the response reports the number of deleted events.
+func (h *Handler) BulkDelete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req BulkDeleteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	deleted, err := h.service.DeleteEvents(r.Context(), userID, req.EventIDs)
+	if err != nil {
+		h.logger.Error("failed to delete events", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, map[string]int{"deleted": deleted})
+}
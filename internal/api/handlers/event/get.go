@@ -2,20 +2,148 @@ package event
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
 	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
+	"github.com/aliskhannn/calendar-service/internal/locale"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
 	"github.com/aliskhannn/calendar-service/internal/model"
 	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
 )
 
+// Get handles HTTP requests to retrieve a single event by its ID.
+// The response includes the events linked to it ("related"), e.g. follow-ups and blockers,
+// and the attendees who reported running late ("running_late").
+// With include=availability, it adds the free/busy status of every attendee around the time of the event.
+// An optional comma-separated "fields" query parameter limits the returned fields, as for event lists.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	// Validate the optional sparse fieldset; the event is always read whole.
+	fields := parseFields(r.URL.Query().Get("fields"))
+	if _, err := fieldColumns(fields, eventDetailFields); err != nil {
+		h.logger.Warn("invalid fields", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrInvalidField)
+		return
+	}
+
+	// Validate the optional additional data; availability is the only one.
+	include := parseFields(r.URL.Query().Get("include"))
+	for _, inc := range include {
+		if inc != "availability" {
+			h.logger.Warn("invalid include", zap.String("include", inc))
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid include: %s", inc))
+			return
+		}
+	}
+
+	event, related, err := h.service.GetEvent(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, eventrepo.ErrEventNotFound) {
+			h.logger.Info("event not found", zap.String("eventID", eventID.String()))
+			response.Fail(w, http.StatusNotFound, fmt.Errorf("event not found"))
+			return
+		}
+
+		h.logger.Error("failed to get event", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	attendees, err := h.attendees.ListAttendees(r.Context(), eventID, userID)
+	if err != nil {
+		h.logger.Error("failed to list attendees", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	details := dto.NewEventDetails(event, related, attendees, time.Now())
+
+	if len(include) > 0 {
+		availability, err := h.service.GetAvailability(r.Context(), event, attendees)
+		if err != nil {
+			h.logger.Error("failed to get availability", zap.String("event_id", eventID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		details.Availability = dto.NewAvailability(availability)
+	}
+
+	// Return only the requested fields if a sparse fieldset was given.
+	if len(fields) > 0 {
+		sparse, err := projectFields([]dto.EventDetails{details}, eventDetailFields, fields)
+		if err != nil {
+			h.logger.Error("failed to select event fields", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+
+		response.OK(w, sparse[0])
+		return
+	}
+
+	response.OK(w, details)
+}
+
+// Exists handles HEAD requests checking whether an event exists, without a body in the response.
+// It answers 200 if the authenticated user has the event and 404 if not, without reading or decrypting the event.
+func (h *Handler) Exists(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	exists, err := h.service.EventExists(r.Context(), eventID, userID)
+	if err != nil {
+		h.logger.Error("failed to check event", zap.String("event_id", eventID.String()), zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 // GetDay handles HTTP requests to retrieve events for a specific day.
 // It delegates to the getEvents helper function, passing the service method for fetching daily events.
 func (h *Handler) GetDay(w http.ResponseWriter, r *http.Request) {
@@ -30,19 +158,130 @@ func (h *Handler) GetWeek(w http.ResponseWriter, r *http.Request) {
 
 // GetMonth handles HTTP requests to retrieve events for a specific month.
 // It delegates to the getEvents helper function, passing the service method for fetching monthly events.
+// With grid=true it returns the month grid instead; see getMonthGrid.
 func (h *Handler) GetMonth(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("grid") == "true" {
+		h.getMonthGrid(w, r)
+		return
+	}
+
 	h.getEvents(w, r, h.service.GetEventsForMonth)
 }
 
+// weekdays maps the accepted week_start values to weekdays.
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// getMonthGrid responds with the 5-6 week grid a calendar UI renders for the month of the date
+// query parameter, including the leading and trailing days of the adjacent months, with the events
+// of every day. Weeks start on Monday unless week_start names another weekday, or on the first day of the
+// week of the requested locale. The optional fields and calendar_id parameters apply to the events of every day.
+func (h *Handler) getMonthGrid(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Resolve the date expression in the user's time zone.
+	now, err := h.queryNow(r, userID)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	date, err := dateexpr.Parse(r.URL.Query().Get("date"), now)
+	if err != nil {
+		h.logger.Warn("invalid date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing date"))
+		return
+	}
+
+	// Resolve the optional locale of the response.
+	l, err := negotiateLocale(w, r)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Parse the first day of the week.
+	weekStart := time.Monday
+	if l != nil {
+		weekStart = l.FirstDay
+	}
+	if v := r.URL.Query().Get("week_start"); v != "" {
+		if weekStart, ok = weekdays[strings.ToLower(v)]; !ok {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid week_start"))
+			return
+		}
+	}
+
+	fields := parseFields(r.URL.Query().Get("fields"))
+	opts := model.EventListOptions{Location: now.Location()}
+	if opts.Fields, err = fieldColumns(fields, eventFields); err != nil {
+		h.logger.Warn("invalid fields", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrInvalidField)
+		return
+	}
+	if opts.CalendarID, err = parseCalendarID(r); err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	grid, err := h.service.GetMonthGrid(r.Context(), userID, date, weekStart, opts)
+	if err != nil {
+		// Handle case where an unknown field was requested.
+		if errors.Is(err, eventrepo.ErrInvalidField) {
+			h.logger.Warn("invalid fields", zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrInvalidField)
+			return
+		}
+
+		h.logger.Error("failed to fetch month grid", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	result := dto.NewMonthGrid(grid, now)
+	if l != nil {
+		result.Localize(grid, *l, now.Location())
+	}
+
+	// Return only the requested fields of every event if a sparse fieldset was given.
+	if len(fields) > 0 {
+		for i := range result.Days {
+			sparse, err := selectFields(result.Days[i].Events.(dto.Events), fields)
+			if err != nil {
+				h.logger.Error("failed to select event fields", zap.Error(err))
+				response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+				return
+			}
+			result.Days[i].Events = sparse
+		}
+	}
+
+	response.OK(w, result)
+}
+
 // getEvents is a helper function that retrieves events for a given user and date range.
 // It extracts and validates the user ID from the request context and the date from query parameters,
 // then calls the provided fetch function to retrieve events. It handles errors and sends appropriate responses.
+// An optional comma-separated "fields" query parameter limits the returned event fields (e.g. fields=id,title,event_date),
+// and an optional "calendar_id" query parameter limits the events to one of the user's calendars.
 //
 // Parameters:
 //   - w: The HTTP response writer to send the response.
 //   - r: The HTTP request containing the user context and query parameters.
 //   - fetch: A function that retrieves events for a specific user and date.
-func (h *Handler) getEvents(w http.ResponseWriter, r *http.Request, fetch func(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)) {
+func (h *Handler) getEvents(w http.ResponseWriter, r *http.Request, fetch func(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)) {
 	// Extract and validate user ID from request context.
 	userIDVal := r.Context().Value(middlewares.UserIDKey)
 	userID, ok := userIDVal.(uuid.UUID)
@@ -60,17 +299,49 @@ func (h *Handler) getEvents(w http.ResponseWriter, r *http.Request, fetch func(c
 		return
 	}
 
-	// Parse date string into time.Time.
-	eventDate, err := time.Parse(time.DateOnly, dateStr)
+	// Resolve the date expression in the user's time zone.
+	now, err := h.queryNow(r, userID)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	eventDate, err := dateexpr.Parse(dateStr, now)
 	if err != nil {
 		h.logger.Warn("invalid date", zap.Error(err))
 		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid date"))
 		return
 	}
 
+	// Resolve the optional locale of the response.
+	l, err := negotiateLocale(w, r)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Extract the optional sparse fieldset and calendar; fields are selected by the columns they are filled from.
+	fields := parseFields(r.URL.Query().Get("fields"))
+	opts := model.EventListOptions{Location: now.Location()}
+	if opts.Fields, err = fieldColumns(fields, eventFields); err != nil {
+		h.logger.Warn("invalid fields", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrInvalidField)
+		return
+	}
+	if opts.CalendarID, err = parseCalendarID(r); err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Fetch events using the provided fetch function.
-	events, err := fetch(r.Context(), userID, eventDate)
+	events, err := fetch(r.Context(), userID, eventDate, opts)
 	if err != nil {
+		// Handle case where an unknown field was requested.
+		if errors.Is(err, eventrepo.ErrInvalidField) {
+			h.logger.Warn("invalid fields", zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrInvalidField)
+			return
+		}
+
 		// Handle case where no events are found.
 		if errors.Is(err, eventrepo.ErrEventNotFound) {
 			h.logger.Info("events not found", zap.String("userID", userID.String()), zap.Time("date", eventDate))
@@ -83,6 +354,255 @@ func (h *Handler) getEvents(w http.ResponseWriter, r *http.Request, fetch func(c
 		return
 	}
 
+	result := dto.NewEvents(events, time.Now())
+	if l != nil {
+		result.Localize(*l, now.Location())
+	}
+
+	// Return only the requested fields if a sparse fieldset was given.
+	if len(fields) > 0 {
+		sparse, err := selectFields(result, fields)
+		if err != nil {
+			h.logger.Error("failed to select event fields", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+
+		response.List(w, r, sparse)
+		return
+	}
+
 	// Return successful response with events.
-	response.OK(w, events)
+	response.List(w, r, result)
+}
+
+// queryNow returns the current time in the time zone of the request: the optional "tz" query parameter,
+// otherwise the time zone in the user's profile, otherwise UTC, which is also used if the profile cannot be read. Relative date expressions such as "today" or
+// "-7d" are resolved in it, and the boundaries of the queried days are computed in it.
+//
+// Parameters:
+//   - r: The HTTP request.
+//   - userID: The UUID of the authenticated user.
+//
+// Returns:
+//   - The current time in the time zone of the request.
+//   - An error if the tz parameter names an unknown time zone.
+func (h *Handler) queryNow(r *http.Request, userID uuid.UUID) (time.Time, error) {
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		loc, err := dateexpr.Location(tz)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Now().In(loc), nil
+	}
+
+	loc, err := h.profiles.Location(r.Context(), userID)
+	if err != nil {
+		h.logger.Warn("failed to get time zone of user, using UTC", zap.String("user_id", userID.String()), zap.Error(err))
+		loc = time.UTC
+	}
+	return time.Now().In(loc), nil
+}
+
+// negotiateLocale returns the locale of the response: the "locale" query parameter if given,
+// otherwise the preferred supported language of the Accept-Language header.
+// The chosen locale is announced in the Content-Language header of the response.
+//
+// Parameters:
+//   - w: The HTTP response writer.
+//   - r: The HTTP request.
+//
+// Returns:
+//   - The locale, or nil if none was requested or no requested language is supported.
+//   - An error if the locale query parameter names an unsupported locale.
+func negotiateLocale(w http.ResponseWriter, r *http.Request) (*locale.Locale, error) {
+	w.Header().Add("Vary", "Accept-Language")
+
+	var l locale.Locale
+	if tag := r.URL.Query().Get("locale"); tag != "" {
+		var err error
+		if l, err = locale.Lookup(tag); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, tag)
+		}
+	} else if accepted, ok := locale.FromAcceptLanguage(r.Header.Get("Accept-Language")); ok {
+		l = accepted
+	} else {
+		return nil, nil
+	}
+
+	w.Header().Set("Content-Language", l.Tag)
+	return &l, nil
+}
+
+// parseFields splits a comma-separated fields query parameter into trimmed, non-empty field names.
+func parseFields(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+
+	var fields []string
+	for _, f := range strings.Split(raw, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// parseCalendarID parses the optional calendar_id query parameter of an event listing.
+// It returns nil if the parameter is not set.
+func parseCalendarID(r *http.Request) (*uuid.UUID, error) {
+	raw := r.URL.Query().Get("calendar_id")
+	if raw == "" {
+		return nil, nil
+	}
+
+	calendarID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar_id")
+	}
+
+	return &calendarID, nil
+}
+
+// eventFields and eventDetailFields map the JSON field names of an event DTO, and of the event details returned
+// by Get, to their struct field index paths, so that sparse fieldsets can be encoded without a marshal/unmarshal
+// round trip per event.
+var (
+	eventFields       = jsonFields(reflect.TypeOf(dto.Event{}))
+	eventDetailFields = jsonFields(reflect.TypeOf(dto.EventDetails{}))
+)
+
+// computedFields maps the event fields computed by the DTO to the columns they are computed from.
+// All other fields are stored in the column of the same name.
+var computedFields = map[string][]string{
+	"is_critical": {"priority"},
+	"is_past":     {"event_date", "end_date"},
+	"localized":   {"event_date"},
+	"deleted_at":  nil, // only set in the trash, which has no sparse fieldsets
+}
+
+// jsonFields maps the JSON field names of a struct type to their field index paths.
+// Fields of embedded structs without a JSON name are promoted, as encoding/json does.
+func jsonFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for promoted, index := range jsonFields(f.Type) {
+				fields[promoted] = append([]int{i}, index...)
+			}
+			continue
+		}
+		if name != "" && name != "-" {
+			fields[name] = []int{i}
+		}
+	}
+	return fields
+}
+
+// fieldColumns validates a sparse fieldset against the JSON field names of the event DTO and returns the columns
+// needed to fill them, including those computed fields are derived from.
+//
+// Parameters:
+//   - fields: The requested JSON field names.
+//   - index: The JSON fields of the returned DTO, e.g. eventFields.
+//
+// Returns:
+//   - The columns to select, in the order the fields were requested; empty if no field needs a column.
+//   - An error wrapping eventrepo.ErrInvalidField if a field is not returned by the DTO.
+func fieldColumns(fields []string, index map[string][]int) ([]string, error) {
+	var columns []string
+	seen := make(map[string]bool, len(fields))
+	add := func(c string) {
+		if !seen[c] {
+			seen[c] = true
+			columns = append(columns, c)
+		}
+	}
+	for _, f := range fields {
+		if _, ok := index[f]; !ok {
+			return nil, fmt.Errorf("%w: %s", eventrepo.ErrInvalidField, f)
+		}
+		if derived, ok := computedFields[f]; ok {
+			for _, c := range derived {
+				add(c)
+			}
+			continue
+		}
+		if _, ok := eventFields[f]; ok {
+			add(f)
+		}
+	}
+
+	return columns, nil
+}
+
+// selectFields encodes event DTOs as JSON objects containing only the given fields.
+// Fields keep the requested order; unknown and repeated fields are skipped.
+// The objects share one underlying buffer.
+//
+// Parameters:
+//   - events: The events to encode.
+//   - fields: The JSON field names to keep.
+//
+// Returns:
+//   - A slice of encoded objects with only the requested fields.
+//   - An error if a field value cannot be encoded.
+func selectFields(events []dto.Event, fields []string) ([]json.RawMessage, error) {
+	return projectFields(events, eventFields, fields)
+}
+
+// projectFields encodes values as JSON objects containing only the given fields of index.
+// Fields keep the requested order; unknown and repeated fields are skipped.
+// The objects share one underlying buffer.
+func projectFields[T any](values []T, index map[string][]int, fields []string) ([]json.RawMessage, error) {
+	// Resolve field indexes and pre-encode the keys once for all values.
+	indexes := make([][]int, 0, len(fields))
+	keys := make([][]byte, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		i, ok := index[f]
+		if !ok || seen[f] {
+			continue
+		}
+		seen[f] = true
+		key, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, i)
+		keys = append(keys, append(key, ':'))
+	}
+
+	buf := make([]byte, 0, len(values)*64*(len(indexes)+1))
+	offsets := make([]int, 0, len(values)+1)
+	for _, e := range values {
+		offsets = append(offsets, len(buf))
+		v := reflect.ValueOf(&e).Elem()
+
+		buf = append(buf, '{')
+		for j, i := range indexes {
+			if j > 0 {
+				buf = append(buf, ',')
+			}
+			value, err := json.Marshal(v.FieldByIndex(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, keys[j]...)
+			buf = append(buf, value...)
+		}
+		buf = append(buf, '}')
+	}
+	offsets = append(offsets, len(buf))
+
+	result := make([]json.RawMessage, len(values))
+	for i := range result {
+		result[i] = buf[offsets[i]:offsets[i+1]:offsets[i+1]]
+	}
+
+	return result, nil
 }
@@ -16,40 +16,134 @@ import (
 // eventService defines the interface for event-related operations.
 // It provides methods for creating, updating, deleting, and retrieving events for a user.
 type eventService interface {
-	// CreateEvent creates a new event for the specified user and returns the event ID.
-	CreateEvent(ctx context.Context, userID uuid.UUID, title, description string, date time.Time, reminderAt *time.Time) (uuid.UUID, error)
+	// CreateEvent creates a new event and returns the event ID.
+	CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error)
 
-	// UpdateEvent updates an existing event for the specified user and event ID.
-	UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, title, description string, date time.Time, reminderAt *time.Time) error
+	// UpdateEvent updates an existing event identified by its ID and owner.
+	UpdateEvent(ctx context.Context, event model.Event) error
 
-	// DeleteEvent deletes an event for the specified user and event ID.
+	// EventExists reports whether a user has an event with the given ID.
+	EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+
+	// GetEvent retrieves a single event for the specified user together with its related events.
+	GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, []model.RelatedEvent, error)
+
+	// GetAvailability retrieves the free/busy status of the attendees of an event at its time.
+	GetAvailability(ctx context.Context, event model.Event, attendees []model.Attendee) ([]model.Availability, error)
+
+	// LinkEvents makes an event depend on a related event of the same user.
+	LinkEvents(ctx context.Context, link model.EventLink, userID uuid.UUID) error
+
+	// UnlinkEvents removes the link between an event and a related event.
+	UnlinkEvents(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error
+
+	// UpdateOccurrence replaces a single occurrence of a recurring event with a standalone event.
+	UpdateOccurrence(ctx context.Context, event model.Event, occurrence time.Time) (uuid.UUID, error)
+
+	// OverrideOccurrence changes the time or title of a single occurrence of a recurring event.
+	OverrideOccurrence(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error
+
+	// RevertOccurrence removes the override of a single occurrence of a recurring event.
+	RevertOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error
+
+	// ListOverrides retrieves the overridden occurrences of a recurring event.
+	ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error)
+
+	// DeleteEvent moves an event of the user to the trash.
 	DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error
 
+	// DeleteEvents moves the events of a user with the given IDs to the trash and returns how many were moved.
+	DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) (int, error)
+
+	// DeleteEventsInRange moves the events of a user starting within a date range to the trash and returns how many were moved.
+	DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) (int, error)
+
+	// DeleteOccurrence deletes a single occurrence of a recurring event.
+	DeleteOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error
+
+	// RestoreEvent takes an event of the user out of the trash, or moves an archived event back to the calendar.
+	RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error)
+
+	// ListTrash retrieves a page of the trashed events of a user, the most recently deleted first.
+	ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error)
+
+	// RestoreTrash takes all the events of a user out of the trash and returns how many were restored.
+	RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// EmptyTrash permanently deletes all the events of a user in the trash and returns how many were deleted.
+	EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error)
+
 	// GetEventsForDay retrieves all events for a specific user on a given day.
-	GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
 
 	// GetEventsForWeek retrieves all events for a specific user within a week starting from the given date.
-	GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
 
 	// GetEventsForMonth retrieves all events for a specific user within a month starting from the given date.
-	GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
+
+	// GetMonthGrid retrieves the events of the weeks a calendar UI renders for the month of the given date, grouped per day.
+	GetMonthGrid(ctx context.Context, userID uuid.UUID, date time.Time, weekStart time.Weekday, opts model.EventListOptions) (model.MonthGrid, error)
+
+	// GetEventSummary counts the events of a user per day, per calendar and per tag within a date range.
+	GetEventSummary(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) (model.EventSummary, error)
+
+	// SearchEvents retrieves a page of the events of a user matching a full-text search, best matches first.
+	SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error)
+
+	// CountSearchResults counts the events of a user matching a full-text search, exactly or estimated.
+	CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error)
+
+	// SuggestTitles retrieves the titles of the user's events starting with a prefix, most recently scheduled first.
+	SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error)
+}
+
+// suggestionService defines the suggestion of tags and a project for new events.
+type suggestionService interface {
+	// Suggest proposes tags and a project for an event that is about to be created.
+	Suggest(ctx context.Context, event model.Event) (model.Suggestion, error)
+}
+
+// delegateService defines the permission check of events created in the calendar of another user.
+type delegateService interface {
+	// CanCreateFor reports whether a user may create events in the calendar of the owner.
+	CanCreateFor(ctx context.Context, ownerID, userID uuid.UUID) (bool, error)
+}
+
+// profileService defines the lookup of the time zone stored in the profile of a user.
+type profileService interface {
+	// Location returns the time zone the calendar days of a user are computed in.
+	Location(ctx context.Context, userID uuid.UUID) (*time.Location, error)
+}
+
+// attendeeService defines the lookup of the attendees of an event.
+type attendeeService interface {
+	// ListAttendees retrieves the attendees of an event, visible to its owner and its attendees.
+	ListAttendees(ctx context.Context, eventID, userID uuid.UUID) ([]model.Attendee, error)
 }
 
 // Handler manages HTTP requests for event-related operations.
-// It encapsulates the event service, reminder channel, logger, and validator for handling requests.
+// It encapsulates the event, suggestion, delegate, profile and attendee services, logger, and validator for handling requests.
 type Handler struct {
-	service    eventService          // service handles business logic for event operations
-	reminderCh chan<- model.Reminder // reminderCh sends reminders for events
-	logger     *zap.Logger           // logger logs application events and errors
-	validator  *validator.Validate   // validator validates incoming request data
+	service     eventService        // service handles business logic for event operations
+	suggestions suggestionService   // suggestions proposes tags and projects for new events
+	delegates   delegateService     // delegates checks events created in the calendar of another user
+	profiles    profileService      // profiles provides the time zone of users querying without a tz parameter
+	attendees   attendeeService     // attendees provides the state of the attendees of an event
+	logger      *zap.Logger         // logger logs application events and errors
+	validator   *validator.Validate // validator validates incoming request data
 }
 
 // New creates a new Handler instance with the provided dependencies.
-// It initializes the Handler with an event service, reminder channel, logger, and validator.
+// It initializes the Handler with an event service, suggestion service, delegate service, profile service,
+// attendee service, logger, and validator.
 //
 // Parameters:
 //   - s: The event service for handling event-related operations.
-//   - reminderCh: The channel for sending event reminders.
+//   - sg: The suggestion service proposing tags and projects for new events.
+//   - d: The delegate service checking events created on behalf of another user.
+//   - p: The profile service providing the stored time zone of users.
+//   - a: The attendee service providing the attendees of events.
 //   - l: The logger for logging application events and errors.
 //   - v: The validator for validating request data.
 //
@@ -57,14 +151,20 @@ type Handler struct {
 //   - A pointer to the initialized Handler.
 func New(
 	s eventService,
-	reminderCh chan<- model.Reminder,
+	sg suggestionService,
+	d delegateService,
+	p profileService,
+	a attendeeService,
 	l *zap.Logger,
 	v *validator.Validate,
 ) *Handler {
 	return &Handler{
-		service:    s,
-		reminderCh: reminderCh,
-		logger:     l,
-		validator:  v,
+		service:     s,
+		suggestions: sg,
+		delegates:   d,
+		profiles:    p,
+		attendees:   a,
+		logger:      l,
+		validator:   v,
 	}
 }
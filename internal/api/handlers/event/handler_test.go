@@ -4,33 +4,57 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	mockseventsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/event"
 	"github.com/aliskhannn/calendar-service/internal/repository/event"
+	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/validation"
 )
 
 func setupHandler(t *testing.T) (*gomock.Controller, *mockseventsvc.MockeventService, *Handler) {
 	ctrl := gomock.NewController(t)
 	mockService := mockseventsvc.NewMockeventService(ctrl)
 	logger, _ := zap.NewDevelopment()
-	validate := validator.New()
-	handler := New(mockService, logger, validate)
+	validate := validation.New(config.Validation{})
+	mockSuggestions := mockseventsvc.NewMocksuggestionService(ctrl)
+	mockSuggestions.EXPECT().Suggest(gomock.Any(), gomock.Any()).Return(model.Suggestion{}, nil).AnyTimes()
+	handler := New(mockService, mockSuggestions, mockseventsvc.NewMockdelegateService(ctrl), utcProfiles(ctrl), noAttendees(ctrl), logger, validate)
 	return ctrl, mockService, handler
 }
 
+// noAttendees returns an attendee service for events without attendees.
+func noAttendees(ctrl *gomock.Controller) *mockseventsvc.MockattendeeService {
+	attendees := mockseventsvc.NewMockattendeeService(ctrl)
+	attendees.EXPECT().ListAttendees(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return attendees
+}
+
+// utcProfiles returns a profile service for users without a stored time zone.
+func utcProfiles(ctrl *gomock.Controller) *mockseventsvc.MockprofileService {
+	profiles := mockseventsvc.NewMockprofileService(ctrl)
+	profiles.EXPECT().Location(gomock.Any(), gomock.Any()).Return(time.UTC, nil).AnyTimes()
+	return profiles
+}
+
 func TestHandler_Create_Success(t *testing.T) {
 	ctrl, mockService, h := setupHandler(t)
 	defer ctrl.Finish()
@@ -39,7 +63,6 @@ func TestHandler_Create_Success(t *testing.T) {
 	reqBody := CreateRequest{
 		Title:     "Test Event",
 		EventDate: time.Now(),
-		UserID:    userID,
 	}
 	body, _ := json.Marshal(reqBody)
 
@@ -48,7 +71,7 @@ func TestHandler_Create_Success(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		CreateEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		CreateEvent(gomock.Any(), gomock.Any()).
 		Return(uuid.New(), nil)
 
 	h.Create(w, req)
@@ -58,6 +81,310 @@ func TestHandler_Create_Success(t *testing.T) {
 	}
 }
 
+func TestHandler_Create_Duration(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	start := time.Date(2030, 1, 1, 22, 0, 0, 0, time.UTC)
+	body, _ := json.Marshal(map[string]interface{}{"title": "Night shift", "event_date": start, "duration": "8h"})
+
+	req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			if e.EndDate == nil || !e.EndDate.Equal(start.Add(8*time.Hour)) {
+				t.Fatalf("expected the event to end 8h after it starts, got %v", e.EndDate)
+			}
+			return uuid.New(), nil
+		})
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Create_InvalidEnd(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := map[string]map[string]interface{}{
+		"invalid duration":     {"duration": "two hours"},
+		"end and duration":     {"end_date": end, "duration": "1h"},
+		"end before the start": {"end_date": start.Add(-time.Hour)},
+		"negative duration":    {"duration": "-1h"},
+		"longer than 366 days": {"duration": "10000h"},
+	}
+	mockService.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		Return(uuid.Nil, eventsvc.ErrInvalidEnd).
+		Times(3)
+
+	for name, fields := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := map[string]interface{}{"title": "Meeting", "event_date": start}
+			for k, v := range fields {
+				payload[k] = v
+			}
+			body, _ := json.Marshal(payload)
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Create_InvalidCoordinates(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	tests := map[string]map[string]interface{}{
+		"latitude without longitude": {"latitude": 52.52},
+		"longitude without latitude": {"longitude": 13.405},
+		"latitude out of range":      {"latitude": 91.0, "longitude": 13.405},
+		"longitude out of range":     {"latitude": 52.52, "longitude": -181.0},
+	}
+
+	for name, fields := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := map[string]interface{}{"title": "Meeting", "event_date": time.Now().Add(time.Hour), "location": "Berlin"}
+			for k, v := range fields {
+				payload[k] = v
+			}
+			body, _ := json.Marshal(payload)
+
+			req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Create_Notifications(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"title":         "Flight",
+		"event_date":    time.Now().Add(24 * time.Hour),
+		"notifications": map[string]interface{}{"channel": "email", "lead_time": "3h", "reminders": true},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	on := true
+	mockService.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			want := model.EventNotifications{Channel: model.ChannelEmail, LeadTime: 3 * time.Hour, Reminders: &on}
+			if e.Notifications == nil || !reflect.DeepEqual(*e.Notifications, want) {
+				t.Fatalf("expected notification overrides %+v, got %+v", want, e.Notifications)
+			}
+			return uuid.New(), nil
+		})
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+}
+
+func TestHandler_Create_InvalidNotifications(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	tests := map[string]map[string]interface{}{
+		"unsupported channel":         {"notifications": map[string]interface{}{"channel": "sms"}},
+		"invalid lead time":           {"notifications": map[string]interface{}{"lead_time": "an hour"}},
+		"negative lead time":          {"notifications": map[string]interface{}{"lead_time": "-1h"}},
+		"lead time and reminder time": {"notifications": map[string]interface{}{"lead_time": "1h"}, "reminder_at": time.Now()},
+	}
+
+	for name, fields := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := map[string]interface{}{"title": "Meeting", "event_date": time.Now().Add(time.Hour)}
+			for k, v := range fields {
+				payload[k] = v
+			}
+			body, _ := json.Marshal(payload)
+
+			req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Create_Suggestions(t *testing.T) {
+	for _, autoTag := range []bool{false, true} {
+		ctrl := gomock.NewController(t)
+		mockService := mockseventsvc.NewMockeventService(ctrl)
+		mockSuggestions := mockseventsvc.NewMocksuggestionService(ctrl)
+		h := New(mockService, mockSuggestions, mockseventsvc.NewMockdelegateService(ctrl), utcProfiles(ctrl), noAttendees(ctrl), zap.NewNop(), validation.New(config.Validation{}))
+
+		userID := uuid.New()
+		projectID := uuid.New()
+		eventID := uuid.New()
+		body, _ := json.Marshal(CreateRequest{Title: "Team standup", EventDate: time.Now(), Tags: []string{"daily"}})
+
+		target := "/events"
+		if autoTag {
+			target += "?auto_tag=true"
+		}
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		w := httptest.NewRecorder()
+
+		mockSuggestions.EXPECT().Suggest(gomock.Any(), gomock.Any()).
+			Return(model.Suggestion{Tags: []string{"work"}, ProjectID: &projectID}, nil)
+		mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+				wantTags := []string{"daily"}
+				if autoTag {
+					wantTags = []string{"daily", "work"}
+				}
+				if !reflect.DeepEqual(e.Tags, wantTags) {
+					t.Fatalf("auto_tag=%v: expected tags %v, got %v", autoTag, wantTags, e.Tags)
+				}
+				if (e.ProjectID != nil) != autoTag {
+					t.Fatalf("auto_tag=%v: unexpected project %v", autoTag, e.ProjectID)
+				}
+				return eventID, nil
+			})
+
+		h.Create(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+		}
+
+		var resp struct {
+			Result dto.CreatedEvent `json:"result"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Result.ID != eventID || resp.Result.AutoTagged != autoTag {
+			t.Fatalf("unexpected result: %+v", resp.Result)
+		}
+		if !reflect.DeepEqual(resp.Result.Suggestions.Tags, []string{"work"}) || *resp.Result.Suggestions.ProjectID != projectID {
+			t.Fatalf("unexpected suggestions: %+v", resp.Result.Suggestions)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+func TestHandler_Create_SuggestionsFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mockseventsvc.NewMockeventService(ctrl)
+	mockSuggestions := mockseventsvc.NewMocksuggestionService(ctrl)
+	h := New(mockService, mockSuggestions, mockseventsvc.NewMockdelegateService(ctrl), utcProfiles(ctrl), noAttendees(ctrl), zap.NewNop(), validation.New(config.Validation{}))
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Title: "Team standup", EventDate: time.Now()})
+	req := httptest.NewRequest(http.MethodPost, "/events?auto_tag=true", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockSuggestions.EXPECT().Suggest(gomock.Any(), gomock.Any()).Return(model.Suggestion{}, errors.New("model unavailable"))
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Create_UserIDMismatch(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(map[string]interface{}{"user_id": uuid.New(), "title": "Team standup", "event_date": time.Now()})
+	req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandler_Create_OnBehalfOf(t *testing.T) {
+	for _, allowed := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+		mockService := mockseventsvc.NewMockeventService(ctrl)
+		mockDelegates := mockseventsvc.NewMockdelegateService(ctrl)
+		h := New(mockService, mockseventsvc.NewMocksuggestionService(ctrl), mockDelegates, utcProfiles(ctrl), noAttendees(ctrl), zap.NewNop(), validation.New(config.Validation{}))
+
+		userID, ownerID := uuid.New(), uuid.New()
+		body, _ := json.Marshal(CreateRequest{OnBehalfOf: &ownerID, Title: "Board meeting", EventDate: time.Now()})
+		req := httptest.NewRequest(http.MethodPost, "/events?auto_tag=true", bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		w := httptest.NewRecorder()
+
+		// Delegated events get no suggestions, which would reveal the owner's tags and projects.
+		mockDelegates.EXPECT().CanCreateFor(gomock.Any(), ownerID, userID).Return(allowed, nil)
+		if allowed {
+			mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+					if e.UserID != ownerID {
+						t.Fatalf("expected the event to belong to %s, got %s", ownerID, e.UserID)
+					}
+					return uuid.New(), nil
+				})
+		}
+
+		h.Create(w, req)
+
+		want := http.StatusForbidden
+		if allowed {
+			want = http.StatusCreated
+		}
+		if w.Code != want {
+			t.Fatalf("allowed=%v: expected status %d, got %d", allowed, want, w.Code)
+		}
+
+		ctrl.Finish()
+	}
+}
+
 func TestHandler_Create_InvalidBody(t *testing.T) {
 	ctrl, _, h := setupHandler(t)
 	defer ctrl.Finish()
@@ -102,90 +429,1246 @@ func TestHandler_Delete_Success(t *testing.T) {
 	}
 }
 
-func TestHandler_GetDay_Success(t *testing.T) {
+func TestHandler_Exists(t *testing.T) {
+	for _, exists := range []bool{true, false} {
+		ctrl, mockService, h := setupHandler(t)
+
+		eventID := uuid.New()
+		userID := uuid.New()
+
+		req := httptest.NewRequest(http.MethodHead, "/events/"+eventID.String(), nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+		w := httptest.NewRecorder()
+
+		mockService.EXPECT().EventExists(gomock.Any(), eventID, userID).Return(exists, nil)
+
+		h.Exists(w, req)
+
+		want := http.StatusNotFound
+		if exists {
+			want = http.StatusOK
+		}
+		if w.Code != want || w.Body.Len() != 0 {
+			t.Fatalf("exists=%v: expected status %d without body, got %d: %q", exists, want, w.Code, w.Body.String())
+		}
+
+		ctrl.Finish()
+	}
+}
+
+func TestHandler_Restore_NotFound(t *testing.T) {
 	ctrl, mockService, h := setupHandler(t)
 	defer ctrl.Finish()
 
+	eventID := uuid.New()
 	userID := uuid.New()
-	date := time.Now()
-	req := httptest.NewRequest(http.MethodGet, "/events/day?date="+date.Format("2006-01-02"), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/restore", nil)
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
 	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
 	w := httptest.NewRecorder()
 
-	mockEvents := []model.Event{{Title: "Event 1", EventDate: date}}
 	mockService.EXPECT().
-		GetEventsForDay(gomock.Any(), userID, gomock.Any()).
-		Return(mockEvents, nil)
+		RestoreEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{}, event.ErrEventNotFound)
 
-	h.GetDay(w, req)
+	h.Restore(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Trash_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	deletedAt := time.Now().Add(-time.Hour)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/trash?limit=1", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ListTrash(gomock.Any(), userID, model.Page{Limit: 1}).
+		Return([]model.Event{
+			{ID: uuid.New(), UserID: userID, Title: "Dentist", DeletedAt: &deletedAt},
+			{ID: uuid.New(), UserID: userID, Title: "Gym", DeletedAt: &deletedAt},
+		}, nil)
+
+	h.Trash(w, req)
 
 	if w.Code != http.StatusOK {
 		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
+
+	var resp struct {
+		Result struct {
+			Items      []dto.Event `json:"items"`
+			NextCursor *string     `json:"next_cursor"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result.Items) != 1 || resp.Result.NextCursor == nil {
+		t.Fatalf("expected one trashed event and more to come, got %+v", resp.Result)
+	}
+	if resp.Result.Items[0].DeletedAt == nil {
+		t.Fatal("expected the deletion time of the trashed event")
+	}
 }
 
-func TestHandler_Update_Success(t *testing.T) {
+func TestHandler_RestoreTrash(t *testing.T) {
 	ctrl, mockService, h := setupHandler(t)
 	defer ctrl.Finish()
 
 	userID := uuid.New()
-	eventID := uuid.New()
-	reqBody := UpdateRequest{
-		Title:     "Updated",
-		EventDate: time.Now(),
+
+	req := httptest.NewRequest(http.MethodPost, "/events/trash/restore-all", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().RestoreTrash(gomock.Any(), userID).Return(3, nil)
+
+	h.RestoreTrash(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
-	body, _ := json.Marshal(reqBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String(), bytes.NewReader(body))
+	var resp struct {
+		Result map[string]int `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result["restored"] != 3 {
+		t.Fatalf("expected 3 restored events, got %+v", resp.Result)
+	}
+}
+
+func TestHandler_EmptyTrash(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+
+	req := httptest.NewRequest(http.MethodDelete, "/events/trash", nil)
 	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
 
-	// chi RouteContext для URLParam
-	rc := chi.NewRouteContext()
-	rc.URLParams.Add("id", eventID.String())
-	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	mockService.EXPECT().EmptyTrash(gomock.Any(), userID).Return(0, errors.New("connection reset"))
+
+	h.EmptyTrash(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestHandler_Summary_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
 
+	req := httptest.NewRequest(http.MethodGet, "/events/summary?from=2025-09-01&to=2025-09-30", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		UpdateEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(nil)
+		GetEventSummary(gomock.Any(), userID, from, to, model.EventListOptions{Location: time.UTC}).
+		Return(model.EventSummary{Total: 3, Days: []model.DayCount{{Date: from, Count: 3}}, Tags: []model.TagCount{{Tag: "work", Count: 2}}}, nil)
 
-	h.Update(w, req)
+	h.Summary(w, req)
 
 	if w.Code != http.StatusOK {
 		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
+
+	var resp struct {
+		Result dto.EventSummary `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Total != 3 || len(resp.Result.Days) != 1 || resp.Result.Days[0].Date != "2025-09-01" ||
+		resp.Result.Calendars == nil || len(resp.Result.Tags) != 1 || resp.Result.Tags[0].Tag != "work" {
+		t.Fatalf("unexpected summary: %+v", resp.Result)
+	}
 }
 
-func TestHandler_Update_NotFound(t *testing.T) {
+func TestHandler_Search_Success(t *testing.T) {
 	ctrl, mockService, h := setupHandler(t)
 	defer ctrl.Finish()
 
 	userID := uuid.New()
-	eventID := uuid.New()
-	reqBody := UpdateRequest{
-		Title:     "Updated",
-		EventDate: time.Now(),
-	}
-	body, _ := json.Marshal(reqBody)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	search := model.EventSearch{Query: "dentist", From: &from, To: &to}
+	page := model.Page{Limit: 1, Total: model.TotalExact}
 
-	req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String(), bytes.NewReader(body))
+	req := httptest.NewRequest(http.MethodGet, "/events/search?q=+dentist+&from=2025-01-01&to=2025-06-30&limit=1&total=exact", nil)
 	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
 
-	// chi RouteContext для URLParam
-	rc := chi.NewRouteContext()
-	rc.URLParams.Add("id", eventID.String())
-	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	mockService.EXPECT().SearchEvents(gomock.Any(), userID, search, page).Return([]model.Event{
+		{ID: uuid.New(), UserID: userID, Title: "Dentist", EventDate: time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)},
+		{ID: uuid.New(), UserID: userID, Title: "Dentist follow-up", EventDate: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)},
+	}, nil)
+	mockService.EXPECT().CountSearchResults(gomock.Any(), userID, search, model.TotalExact).Return(2, nil)
+
+	h.Search(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
 
+	var resp struct {
+		Result struct {
+			Items      []dto.Event `json:"items"`
+			NextCursor *string     `json:"next_cursor"`
+			Total      *int        `json:"total"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result.Items) != 1 || resp.Result.Items[0].Title != "Dentist" {
+		t.Fatalf("unexpected items: %+v", resp.Result.Items)
+	}
+	if resp.Result.NextCursor == nil || resp.Result.Total == nil || *resp.Result.Total != 2 {
+		t.Fatalf("unexpected page: %s", w.Body.String())
+	}
+}
+
+func TestHandler_Search_InvalidRequest(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	for _, query := range []string{"", "q=+", "q=" + strings.Repeat("a", 201), "q=dentist&from=someday", "q=dentist&from=2025-02-01&to=2025-01-01", "q=dentist&limit=0"} {
+		req := httptest.NewRequest(http.MethodGet, "/events/search?"+query, nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		w := httptest.NewRecorder()
+
+		h.Search(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Search_Strict(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	search := model.EventSearch{Query: "Café", Strict: true}
+
+	req := httptest.NewRequest(http.MethodGet, "/events/search?q=Caf%C3%A9&strict=true", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
 	w := httptest.NewRecorder()
 
-	mockService.EXPECT().
-		UpdateEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(event.ErrEventNotFound)
+	mockService.EXPECT().SearchEvents(gomock.Any(), userID, search, model.Page{Limit: response.DefaultPageLimit}).Return([]model.Event{}, nil)
 
-	h.Update(w, req)
+	h.Search(w, req)
 
-	if w.Code != http.StatusNotFound {
-		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_Suggest(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/suggest?q=cafe&limit=5", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().SuggestTitles(gomock.Any(), userID, "cafe", false, 5).Return([]string{"Café with Anna"}, nil)
+
+	h.Suggest(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []string `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || resp.Result[0] != "Café with Anna" {
+		t.Fatalf("unexpected titles: %v", resp.Result)
+	}
+}
+
+func TestHandler_Suggest_InvalidRequest(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	for _, query := range []string{"", "q=+", "q=" + strings.Repeat("a", 201), "q=cafe&limit=0", "q=cafe&limit=51", "q=cafe&limit=ten"} {
+		req := httptest.NewRequest(http.MethodGet, "/events/suggest?"+query, nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		w := httptest.NewRecorder()
+
+		h.Suggest(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Summary_InvalidRange(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	for _, query := range []string{"from=2025-09-01", "from=2025-09-30&to=2025-09-01", "from=2025-01-01&to=2026-01-02"} {
+		req := httptest.NewRequest(http.MethodGet, "/events/summary?"+query, nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		w := httptest.NewRecorder()
+
+		h.Summary(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_DeleteRange(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodDelete, "/events?from=2025-09-01&to=2025-09-30", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		DeleteEventsInRange(gomock.Any(), userID, from, to, (*uuid.UUID)(nil)).
+		Return(4, nil)
+
+	h.DeleteRange(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"deleted":4`) {
+		t.Fatalf("expected the number of deleted events, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_DeleteRange_InvalidRange(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	// Both ends are required, so a missing parameter cannot clear the whole calendar.
+	for _, query := range []string{"", "from=2025-09-01", "to=2025-09-01", "from=2025-09-30&to=2025-09-01"} {
+		req := httptest.NewRequest(http.MethodDelete, "/events?"+query, nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		w := httptest.NewRecorder()
+
+		h.DeleteRange(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%q: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_BulkDelete(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	body, _ := json.Marshal(BulkDeleteRequest{EventIDs: ids})
+
+	req := httptest.NewRequest(http.MethodPost, "/events/bulk-delete", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		DeleteEvents(gomock.Any(), userID, ids).
+		Return(2, nil)
+
+	h.BulkDelete(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_BulkDelete_TooMany(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	for _, n := range []int{0, validation.DefaultBulkMaxEvents + 1} {
+		ids := make([]uuid.UUID, n)
+		for i := range ids {
+			ids[i] = uuid.New()
+		}
+		body, _ := json.Marshal(BulkDeleteRequest{EventIDs: ids})
+
+		req := httptest.NewRequest(http.MethodPost, "/events/bulk-delete", bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		w := httptest.NewRecorder()
+
+		h.BulkDelete(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%d events: expected status %d, got %d", n, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_GetMonth_Grid(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	date := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/month?date=2025-09-10&grid=true&week_start=sunday", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	days := make([]model.MonthGridDay, 35)
+	for i := range days {
+		days[i] = model.MonthGridDay{Date: time.Date(2025, 8, 31+i, 0, 0, 0, 0, time.UTC), InMonth: i > 0 && i < 31, Events: []model.Event{}}
+	}
+	mockService.EXPECT().
+		GetMonthGrid(gomock.Any(), userID, date, time.Sunday, model.EventListOptions{Location: time.UTC}).
+		Return(model.MonthGrid{Month: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), WeekStart: time.Sunday, Days: days}, nil)
+
+	h.GetMonth(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result dto.MonthGrid `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Weeks != 5 || resp.Result.WeekStart != "sunday" || resp.Result.Days[0].Date != "2025-08-31" {
+		t.Fatalf("unexpected grid: %+v", resp.Result)
+	}
+}
+
+func TestHandler_GetMonth_GridLocale(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	date := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/month?date=2025-09-10&grid=true", nil)
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	days := make([]model.MonthGridDay, 35)
+	for i := range days {
+		days[i] = model.MonthGridDay{Date: time.Date(2025, 8, 31+i, 0, 0, 0, 0, time.UTC), InMonth: i > 0 && i < 31, Events: []model.Event{}}
+	}
+	mockService.EXPECT().
+		GetMonthGrid(gomock.Any(), userID, date, time.Sunday, model.EventListOptions{Location: time.UTC}).
+		Return(model.MonthGrid{Month: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), WeekStart: time.Sunday, Days: days}, nil)
+
+	h.GetMonth(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if got := w.Header().Get("Content-Language"); got != "en-US" {
+		t.Fatalf("expected Content-Language en-US, got %q", got)
+	}
+
+	var resp struct {
+		Result dto.MonthGrid `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Locale == nil || resp.Result.Locale.MonthName != "September" || resp.Result.Days[0].Localized == nil {
+		t.Fatalf("unexpected grid: %+v", resp.Result)
+	}
+}
+
+func TestHandler_GetMonth_GridInvalidWeekStart(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/month?date=2025-09-10&grid=true&week_start=someday", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.GetMonth(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_GetDay_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	date := time.Now()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date="+date.Format("2006-01-02"), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockEvents := []model.Event{{Title: "Event 1", EventDate: date}}
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), model.EventListOptions{Location: time.UTC}).
+		Return(mockEvents, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_GetDay_RelativeDate(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=yesterday&tz=Asia/Tokyo", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Now().In(tokyo)
+	want := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, time.UTC)
+
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, want, model.EventListOptions{Location: tokyo}).
+		Return([]model.Event{{Title: "Event 1"}}, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_GetDay_ProfileTimezone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mockseventsvc.NewMockeventService(ctrl)
+	mockProfiles := mockseventsvc.NewMockprofileService(ctrl)
+	h := New(mockService, mockseventsvc.NewMocksuggestionService(ctrl), mockseventsvc.NewMockdelegateService(ctrl), mockProfiles, noAttendees(ctrl), zap.NewNop(), validation.New(config.Validation{}))
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=2025-09-08", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	mockProfiles.EXPECT().Location(gomock.Any(), userID).Return(tokyo, nil)
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), model.EventListOptions{Location: tokyo}).
+		Return([]model.Event{{Title: "Event 1"}}, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_GetDay_Locale(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=2025-09-08&locale=de", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), model.EventListOptions{Location: time.UTC}).
+		Return([]model.Event{{Title: "Event 1", EventDate: time.Date(2025, 9, 8, 9, 0, 0, 0, time.UTC)}}, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"localized":{"date":"Montag, 8. September 2025","time":"09:00","weekday":"Montag","month":"September"}`) {
+		t.Fatalf("unexpected body: %s", w.Body.String())
+	}
+}
+
+func TestHandler_GetDay_UnsupportedLocale(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=today&locale=xx", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_GetDay_InvalidTimezone(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=today&tz=Mars/Olympus", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_GetDay_Calendar(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, calendarID := uuid.New(), uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=today&calendar_id="+calendarID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), model.EventListOptions{Location: time.UTC, CalendarID: &calendarID}).
+		Return([]model.Event{{Title: "Standup", CalendarID: &calendarID}}, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_GetDay_InvalidCalendar(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=today&calendar_id=work", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_GetDay_Fields(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	date := time.Now()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date="+date.Format("2006-01-02")+"&fields=id,title", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockEvents := []model.Event{{ID: uuid.New(), Title: "Event 1", Description: "long text"}}
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), model.EventListOptions{Fields: []string{"id", "title"}, Location: time.UTC}).
+		Return(mockEvents, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || len(resp.Result[0]) != 2 {
+		t.Fatalf("expected one event with 2 fields, got %v", resp.Result)
+	}
+}
+
+func TestHandler_GetDay_ComputedFields(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/day?date=2025-01-01&fields=id,is_past", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	// Computed fields are accepted and select the columns they are computed from.
+	mockService.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), model.EventListOptions{Fields: []string{"id", "event_date", "end_date"}, Location: time.UTC}).
+		Return([]model.Event{{ID: uuid.New(), EventDate: time.Now().Add(-time.Hour)}}, nil)
+
+	h.GetDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || len(resp.Result[0]) != 2 || resp.Result[0]["is_past"] != true {
+		t.Fatalf("expected one past event with 2 fields, got %v", resp.Result)
+	}
+}
+
+func TestHandler_GetDay_InvalidFields(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	userID := uuid.New()
+	for _, fields := range []string{"password", "related"} {
+		req := httptest.NewRequest(http.MethodGet, "/events/day?date=2025-01-01&fields=id,"+fields, nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		w := httptest.NewRecorder()
+
+		// Unknown fields are rejected before the events are read.
+		h.GetDay(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("fields=%s: expected status %d, got %d", fields, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Get_Fields(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID, userID := uuid.New(), uuid.New()
+	withRoute := func(req *http.Request) *http.Request {
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	}
+
+	related := []model.RelatedEvent{{ID: uuid.New(), Title: "Kickoff", Relation: model.LinkFollowUpOf}}
+	mockService.EXPECT().
+		GetEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{ID: eventID, Title: "Retro", Description: "notes", EventDate: time.Now().Add(time.Hour)}, related, nil)
+
+	w := httptest.NewRecorder()
+	h.Get(w, withRoute(httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"?fields=title,is_past,related", nil)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result map[string]json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 3 || string(resp.Result["title"]) != `"Retro"` || string(resp.Result["is_past"]) != "false" {
+		t.Fatalf("expected title, is_past and related only, got %s", resp.Result)
+	}
+	if !strings.Contains(string(resp.Result["related"]), "Kickoff") {
+		t.Fatalf("expected the related events, got %s", resp.Result["related"])
+	}
+
+	// Unknown fields are rejected without reading the event.
+	w = httptest.NewRecorder()
+	h.Get(w, withRoute(httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"?fields=password", nil)))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Get_Availability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mockseventsvc.NewMockeventService(ctrl)
+	mockAttendees := mockseventsvc.NewMockattendeeService(ctrl)
+	h := New(mockService, mockseventsvc.NewMocksuggestionService(ctrl), mockseventsvc.NewMockdelegateService(ctrl),
+		utcProfiles(ctrl), mockAttendees, zap.NewNop(), validation.New(config.Validation{}))
+
+	eventID, userID, attendeeID := uuid.New(), uuid.New(), uuid.New()
+	withRoute := func(req *http.Request) *http.Request {
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	}
+
+	start := time.Date(2030, 3, 4, 10, 0, 0, 0, time.UTC)
+	eta := start.Add(10 * time.Minute)
+	event := model.Event{ID: eventID, UserID: userID, Title: "Retro", EventDate: start}
+	attendees := []model.Attendee{{UserID: attendeeID, Name: "Bob", Email: "bob@example.com", Status: model.AttendeeAccepted, LateETA: &eta}}
+
+	mockService.EXPECT().GetEvent(gomock.Any(), eventID, userID).Return(event, nil, nil)
+	mockAttendees.EXPECT().ListAttendees(gomock.Any(), eventID, userID).Return(attendees, nil)
+	mockService.EXPECT().
+		GetAvailability(gomock.Any(), event, attendees).
+		Return([]model.Availability{{UserID: attendeeID, Email: "bob@example.com", Status: model.AvailabilityBusy,
+			Busy: []model.BusyPeriod{{Start: start, End: start.Add(time.Hour)}}}}, nil)
+
+	w := httptest.NewRecorder()
+	h.Get(w, withRoute(httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"?include=availability", nil)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result dto.EventDetails `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result.Availability) != 1 || resp.Result.Availability[0].Status != model.AvailabilityBusy ||
+		len(resp.Result.Availability[0].Busy) != 1 {
+		t.Fatalf("expected the attendee to be busy, got %+v", resp.Result.Availability)
+	}
+	if len(resp.Result.RunningLate) != 1 || resp.Result.RunningLate[0].Name != "Bob" || !resp.Result.RunningLate[0].ETA.Equal(eta) {
+		t.Fatalf("expected Bob to be running late, got %+v", resp.Result.RunningLate)
+	}
+
+	// Unknown includes are rejected without reading the event.
+	w = httptest.NewRecorder()
+	h.Get(w, withRoute(httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"?include=titles", nil)))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Update_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	eventID := uuid.New()
+	reqBody := UpdateRequest{
+		Title:     "Updated",
+		EventDate: time.Now(),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String(), bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
+	// chi RouteContext для URLParam
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		UpdateEvent(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	h.Update(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_Update_RoundTripsLeadTime(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	eventID := uuid.New()
+	eventDate := time.Date(2030, 3, 4, 9, 0, 0, 0, time.UTC)
+	remindAt := eventDate.Add(-30 * time.Minute)
+	notifications := &model.EventNotifications{LeadTime: 30 * time.Minute}
+
+	withRoute := func(req *http.Request) *http.Request {
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	}
+
+	// The event as read: reminder_at derived from the lead time, and the lead time itself.
+	mockService.EXPECT().
+		GetEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{ID: eventID, UserID: userID, Title: "Standup", EventDate: eventDate,
+			ReminderAt: &remindAt, Notifications: notifications}, nil, nil)
+
+	w := httptest.NewRecorder()
+	h.Get(w, withRoute(httptest.NewRequest(http.MethodGet, "/events/"+eventID.String(), nil)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	// Writing the resource back keeps the lead time.
+	mockService.EXPECT().
+		UpdateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) error {
+			if e.Notifications == nil || *e.Notifications != *notifications {
+				t.Fatalf("expected notification overrides %+v, got %+v", notifications, e.Notifications)
+			}
+			return nil
+		})
+
+	w = httptest.NewRecorder()
+	h.Update(w, withRoute(httptest.NewRequest(http.MethodPut, "/events/"+eventID.String(), bytes.NewReader(resp.Result))))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestHandler_Update_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	eventID := uuid.New()
+	reqBody := UpdateRequest{
+		Title:     "Updated",
+		EventDate: time.Now(),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String(), bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
+	// chi RouteContext для URLParam
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		UpdateEvent(gomock.Any(), gomock.Any()).
+		Return(event.ErrEventNotFound)
+
+	h.Update(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Get_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	userID := uuid.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	related := []model.RelatedEvent{{ID: uuid.New(), Title: "Kickoff", Relation: model.LinkFollowUpOf}}
+	mockService.EXPECT().
+		GetEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{ID: eventID, Title: "Retro"}, related, nil)
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			ID      uuid.UUID `json:"id"`
+			Related []struct {
+				Relation string `json:"relation"`
+			} `json:"related"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.ID != eventID || len(resp.Result.Related) != 1 || resp.Result.Related[0].Relation != model.LinkFollowUpOf {
+		t.Fatalf("unexpected response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	userID := uuid.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{}, nil, fmt.Errorf("get event: %w", event.ErrEventNotFound))
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Link_OrderBroken(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	userID := uuid.New()
+	body, _ := json.Marshal(LinkRequest{RelatedEventID: uuid.New(), Type: model.LinkBlockedBy})
+
+	req := httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/links", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		LinkEvents(gomock.Any(), gomock.Any(), userID).
+		Return(eventsvc.ErrLinkOrderBroken)
+
+	h.Link(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func BenchmarkSelectFields(b *testing.B) {
+	events := make([]model.Event, 1000)
+	for i := range events {
+		events[i] = model.Event{ID: uuid.New(), UserID: uuid.New(), Title: "Weekly sync", Description: "Agenda and notes", EventDate: time.Now()}
+	}
+	result := dto.NewEvents(events, time.Now())
+	fields := []string{"id", "title", "event_date", "is_past"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := selectFields(result, fields); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestHandler_Update_Occurrence(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	eventID := uuid.New()
+	detachedID := uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+	body, _ := json.Marshal(UpdateRequest{Title: "Moved", EventDate: occurrence.Add(time.Hour)})
+
+	req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String()+"?scope=occurrence&occurrence=2030-01-08T10:00:00%2B01:00", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		UpdateOccurrence(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event, at time.Time) (uuid.UUID, error) {
+			if e.ID != eventID || !at.Equal(occurrence) {
+				t.Fatalf("unexpected occurrence %v of %v", at, e.ID)
+			}
+			return detachedID, nil
+		})
+
+	h.Update(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Update_InvalidScope(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	body, _ := json.Marshal(UpdateRequest{Title: "Moved", EventDate: time.Now()})
+
+	for _, query := range []string{"?scope=following", "?scope=occurrence", "?scope=occurrence&occurrence=2030-01-08"} {
+		req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String()+query, bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+		w := httptest.NewRecorder()
+		h.Update(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Delete_Occurrence(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	userID := uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"deleted", nil, http.StatusOK},
+		{"not recurring", event.ErrNotRecurring, http.StatusBadRequest},
+		{"no such occurrence", eventsvc.ErrOccurrenceNotFound, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodDelete, "/events/"+eventID.String()+"?scope=occurrence&occurrence=2030-01-08T09:00:00Z", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+		w := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			DeleteOccurrence(gomock.Any(), eventID, userID, occurrence).
+			Return(tt.err)
+
+		h.Delete(w, req)
+
+		if w.Code != tt.want {
+			t.Fatalf("%s: expected status %d, got %d", tt.name, tt.want, w.Code)
+		}
+	}
+}
+
+func TestHandler_Override(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID, userID := uuid.New(), uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+	override := model.OccurrenceOverride{Occurrence: occurrence, EventDate: occurrence.Add(time.Hour), Title: "Standup (late)"}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"overridden", nil, http.StatusOK},
+		{"not recurring", event.ErrNotRecurring, http.StatusBadRequest},
+		{"no such occurrence", eventsvc.ErrOccurrenceNotFound, http.StatusNotFound},
+		{"invalid end", eventsvc.ErrInvalidEnd, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		body, _ := json.Marshal(OverrideRequest{Occurrence: occurrence, EventDate: override.EventDate, Title: override.Title})
+		req := httptest.NewRequest(http.MethodPut, "/events/"+eventID.String()+"/overrides", bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+		w := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			OverrideOccurrence(gomock.Any(), eventID, userID, override).
+			Return(tt.err)
+
+		h.Override(w, req)
+
+		if w.Code != tt.want {
+			t.Fatalf("%s: expected status %d, got %d", tt.name, tt.want, w.Code)
+		}
+	}
+}
+
+func TestHandler_RevertOverride(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID, userID := uuid.New(), uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"reverted", nil, http.StatusOK},
+		{"not overridden", event.ErrOverrideNotFound, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodDelete, "/events/"+eventID.String()+"/overrides?occurrence=2030-01-08T09:00:00Z", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+		rc := chi.NewRouteContext()
+		rc.URLParams.Add("id", eventID.String())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+
+		w := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			RevertOccurrence(gomock.Any(), eventID, userID, occurrence).
+			Return(tt.err)
+
+		h.RevertOverride(w, req)
+
+		if w.Code != tt.want {
+			t.Fatalf("%s: expected status %d, got %d", tt.name, tt.want, w.Code)
+		}
 	}
 }
@@ -0,0 +1,138 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
+)
+
+// LinkRequest represents the payload for linking an event to an event it depends on.
+type LinkRequest struct {
+	RelatedEventID uuid.UUID `json:"related_event_id" validate:"required"`                   // event the event depends on
+	Type           string    `json:"type" validate:"required,oneof=follow_up_of blocked_by"` // link type
+}
+
+// Link handles HTTP requests to link an event to a related event it depends on.
+// It extracts the user ID from the request context and the event ID from the URL,
+// decodes the link from the request body, and calls the service to create it.
+// Links that would place the event before the related event are rejected with 409 Conflict.
+func (h *Handler) Link(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req LinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	link := model.EventLink{
+		EventID:        eventID,
+		RelatedEventID: req.RelatedEventID,
+		Type:           req.Type,
+	}
+
+	// Link the events using the service.
+	if err := h.service.LinkEvents(r.Context(), link, userID); err != nil {
+		switch {
+		case errors.Is(err, eventsvc.ErrSelfLink):
+			response.Fail(w, http.StatusBadRequest, eventsvc.ErrSelfLink)
+		case errors.Is(err, eventsvc.ErrLinkOrderBroken):
+			response.Fail(w, http.StatusConflict, eventsvc.ErrLinkOrderBroken)
+		case errors.Is(err, eventrepo.ErrEventNotFound):
+			response.Fail(w, http.StatusNotFound, fmt.Errorf("event not found"))
+		default:
+			h.logger.Error("failed to link events",
+				zap.String("event_id", eventID.String()),
+				zap.String("related_event_id", req.RelatedEventID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	// Return success response.
+	response.Created(w, link)
+}
+
+// Unlink handles HTTP requests to remove the link between an event and a related event.
+// It extracts the user ID from the request context and both event IDs from the URL,
+// and calls the service to remove the link.
+func (h *Handler) Unlink(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event IDs from URL parameters.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	relatedEventID, err := uuid.Parse(chi.URLParam(r, "relatedID"))
+	if err != nil {
+		h.logger.Warn("invalid related event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid related event id"))
+		return
+	}
+
+	// Remove the link using the service.
+	if err := h.service.UnlinkEvents(r.Context(), eventID, relatedEventID, userID); err != nil {
+		if errors.Is(err, eventrepo.ErrLinkNotFound) {
+			response.Fail(w, http.StatusNotFound, eventrepo.ErrLinkNotFound)
+			return
+		}
+
+		h.logger.Error("failed to unlink events",
+			zap.String("event_id", eventID.String()),
+			zap.String("related_event_id", relatedEventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	// Return success response.
+	response.OK(w, "event link removed")
+}
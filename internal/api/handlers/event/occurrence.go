@@ -0,0 +1,68 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
+)
+
+// Scopes of an update or deletion of a recurring event.
+const (
+	scopeAll        = "all"        // the whole series; the default
+	scopeOccurrence = "occurrence" // a single occurrence given by the occurrence query parameter
+)
+
+// occurrenceParam reads the "scope" and "occurrence" query parameters of an update or deletion.
+//
+// Parameters:
+//   - r: The HTTP request.
+//
+// Returns:
+//   - The start of the addressed occurrence, or nil if the whole event is addressed.
+//   - An error if the scope is unknown or the occurrence is missing or not an RFC 3339 timestamp.
+func occurrenceParam(r *http.Request) (*time.Time, error) {
+	query := r.URL.Query()
+	switch query.Get("scope") {
+	case "", scopeAll:
+		return nil, nil
+	case scopeOccurrence:
+	default:
+		return nil, fmt.Errorf("invalid scope, expected all or occurrence")
+	}
+
+	occurrence, err := time.Parse(time.RFC3339, query.Get("occurrence"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid occurrence, expected an RFC 3339 timestamp")
+	}
+
+	return &occurrence, nil
+}
+
+// failOccurrence writes the error response of a failed update or deletion of a single occurrence.
+func (h *Handler) failOccurrence(w http.ResponseWriter, eventID uuid.UUID, err error) {
+	switch {
+	case errors.Is(err, eventrepo.ErrEventNotFound):
+		response.Fail(w, http.StatusNotFound, fmt.Errorf("event not found"))
+	case errors.Is(err, eventrepo.ErrNotRecurring):
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrNotRecurring)
+	case errors.Is(err, eventsvc.ErrOccurrenceNotFound):
+		response.Fail(w, http.StatusNotFound, eventsvc.ErrOccurrenceNotFound)
+	case errors.Is(err, eventrepo.ErrProjectNotFound):
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrProjectNotFound)
+	case errors.Is(err, eventrepo.ErrCalendarNotFound):
+		response.Fail(w, http.StatusBadRequest, eventrepo.ErrCalendarNotFound)
+	case errors.Is(err, eventsvc.ErrInvalidEnd), errors.Is(err, eventsvc.ErrUnknownTimezone):
+		response.Fail(w, http.StatusBadRequest, err)
+	default:
+		h.logger.Error("failed to change occurrence", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+	}
+}
@@ -0,0 +1,135 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+)
+
+// OverrideRequest represents the payload for changing the time or title of a single occurrence of a recurring event.
+type OverrideRequest struct {
+	Occurrence time.Time  `json:"occurrence" validate:"required"` // start of the occurrence as generated by the rule
+	EventDate  time.Time  `json:"event_date" validate:"required"` // new start of the occurrence
+	EndDate    *time.Time `json:"end_date"`                       // optional new end; omitted keeps the duration of the series
+	Title      string     `json:"title" validate:"max=255"`       // optional new title; empty keeps the title of the series
+}
+
+// Override handles HTTP requests to change the time or title of a single occurrence of a recurring event.
+// The occurrence stays part of the series: it is listed, published in feeds and reminded of with it.
+// Overriding an occurrence again replaces the previous override.
+func (h *Handler) Override(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.seriesParams(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req OverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	override := model.OccurrenceOverride{
+		Occurrence: req.Occurrence,
+		EventDate:  req.EventDate,
+		EndDate:    req.EndDate,
+		Title:      req.Title,
+	}
+
+	if err := h.service.OverrideOccurrence(r.Context(), eventID, userID, override); err != nil {
+		h.failOccurrence(w, eventID, err)
+		return
+	}
+
+	response.OK(w, "occurrence overridden")
+}
+
+// RevertOverride handles HTTP requests to remove the override of the occurrence given by the occurrence query
+// parameter (RFC 3339), so it takes place at the time and with the title of the series again.
+func (h *Handler) RevertOverride(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.seriesParams(w, r)
+	if !ok {
+		return
+	}
+
+	occurrence, err := time.Parse(time.RFC3339, r.URL.Query().Get("occurrence"))
+	if err != nil {
+		h.logger.Warn("invalid occurrence", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid occurrence, expected an RFC 3339 timestamp"))
+		return
+	}
+
+	if err := h.service.RevertOccurrence(r.Context(), eventID, userID, occurrence); err != nil {
+		if errors.Is(err, eventrepo.ErrOverrideNotFound) {
+			response.Fail(w, http.StatusNotFound, eventrepo.ErrOverrideNotFound)
+			return
+		}
+		h.failOccurrence(w, eventID, err)
+		return
+	}
+
+	response.OK(w, "override removed")
+}
+
+// ListOverrides handles HTTP requests to list the overridden occurrences of a recurring event.
+func (h *Handler) ListOverrides(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.seriesParams(w, r)
+	if !ok {
+		return
+	}
+
+	overrides, err := h.service.ListOverrides(r.Context(), eventID, userID)
+	if err != nil {
+		h.failOccurrence(w, eventID, err)
+		return
+	}
+
+	result := make([]dto.OccurrenceOverride, 0, len(overrides))
+	for _, o := range overrides {
+		result = append(result, dto.OccurrenceOverride(o))
+	}
+
+	response.OK(w, result)
+}
+
+// seriesParams extracts the user ID from the request context and the event ID from the URL,
+// writing the error response if either is missing or invalid.
+func (h *Handler) seriesParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, eventID, true
+}
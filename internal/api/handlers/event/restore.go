@@ -0,0 +1,131 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+)
+
+// Restore handles the HTTP request to take an event out of the trash or move an archived event back to the calendar.
+// The event keeps all its fields, including the reminder time, and its reminders are restored with their delivery state.
+// It returns the restored event, or 404 if the user has no trashed or archived event with this ID.
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	event, err := h.service.RestoreEvent(r.Context(), eventID, userID)
+	if err != nil {
+		// Handle case where the event is neither trashed nor archived.
+		if errors.Is(err, eventrepo.ErrEventNotFound) {
+			h.logger.Info("deleted event not found", zap.String("eventID", eventID.String()))
+			response.Fail(w, http.StatusNotFound, fmt.Errorf("deleted event not found"))
+			return
+		}
+
+		// Log and handle unexpected errors.
+		h.logger.Error("failed to restore event",
+			zap.String("event_id", eventID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	// Return the restored event.
+	response.OK(w, dto.NewEvent(event, time.Now()))
+}
+
+// Trash handles the HTTP request to list the events of the authenticated user in the trash, the most recently
+// deleted first, with their deleted_at. The list is paginated with "limit" and "cursor"; trashed events are
+// purged after the trash retention and can be restored until then.
+func (h *Handler) Trash(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	page, err := response.ParsePage(r)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := h.service.ListTrash(r.Context(), userID, page)
+	if err != nil {
+		h.logger.Error("failed to list trash", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, response.NewPage(page, dto.NewEvents(events, time.Now()), nil))
+}
+
+// RestoreTrash handles the HTTP request to move all the trashed events of the authenticated user back to the
+// calendar at once. Reminders that fell due while the events were in the trash are skipped, like for a single
+// restore; the response reports the number of restored events.
+func (h *Handler) RestoreTrash(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	restored, err := h.service.RestoreTrash(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to restore trash", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, map[string]int{"restored": restored})
+}
+
+// EmptyTrash handles the HTTP request to permanently delete all the trashed events of the authenticated user
+// without waiting for the trash retention; the response reports the number of deleted events.
+func (h *Handler) EmptyTrash(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	deleted, err := h.service.EmptyTrash(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to empty trash", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, map[string]int{"deleted": deleted})
+}
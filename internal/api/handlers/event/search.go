@@ -0,0 +1,157 @@
+package event
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// maxSearchQueryLength is the longest accepted search query, in characters.
+const maxSearchQueryLength = 200
+
+// Title suggestions returned by default and at most.
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 50
+)
+
+// Search handles HTTP requests to search the titles and descriptions of the user's events.
+// The "q" query parameter holds the search terms; the optional "from" and "to" dates (inclusive)
+// limit the results to a date range and the optional "calendar_id" to one of the user's calendars.
+// Case and accents are ignored unless "strict" is true, which matches the query as it is. Results are always returned in the pagination envelope,
+// paginated with the "cursor", "limit" and "total" query parameters.
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	search := model.EventSearch{Query: strings.TrimSpace(r.URL.Query().Get("q")), Strict: r.URL.Query().Get("strict") == "true"}
+	if err := validateSearchQuery(search.Query); err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Resolve the optional date range in the user's time zone.
+	now, err := h.queryNow(r, userID)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	if v := r.URL.Query().Get("from"); v != "" {
+		from, err := dateexpr.Parse(v, now)
+		if err != nil {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid from date"))
+			return
+		}
+		search.From = &from
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		to, err := dateexpr.Parse(v, now)
+		if err != nil {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid to date"))
+			return
+		}
+		to = to.AddDate(0, 0, 1)
+		search.To = &to
+	}
+	if search.From != nil && search.To != nil && !search.To.After(*search.From) {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("to must not be before from"))
+		return
+	}
+	if search.CalendarID, err = parseCalendarID(r); err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := response.ParsePage(r)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := h.service.SearchEvents(r.Context(), userID, search, page)
+	if err != nil {
+		h.logger.Error("failed to search events", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n, err := h.service.CountSearchResults(r.Context(), userID, search, page.Total)
+		if err != nil {
+			h.logger.Error("failed to count search results", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		total = &n
+	}
+
+	response.OK(w, response.NewPage(page, dto.NewEvents(events, time.Now()), total))
+}
+
+// Suggest handles HTTP requests to complete the title of a new event from the titles of the user's events.
+// The "q" query parameter holds the beginning of the title; case and accents are ignored unless "strict" is true.
+// The optional "limit" parameter caps the number of titles, 10 by default. Suggestions complete what is being typed
+// and are not paginated, so they are returned as a plain list under both API versions.
+func (h *Handler) Suggest(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	prefix := strings.TrimSpace(r.URL.Query().Get("q"))
+	if err := validateSearchQuery(prefix); err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	limit := defaultSuggestLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxSuggestLimit {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("limit must be between 1 and %d", maxSuggestLimit))
+			return
+		}
+		limit = n
+	}
+
+	titles, err := h.service.SuggestTitles(r.Context(), userID, prefix, r.URL.Query().Get("strict") == "true", limit)
+	if err != nil {
+		h.logger.Error("failed to suggest titles", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, titles)
+}
+
+// validateSearchQuery checks that a search query or title prefix is present and not too long.
+func validateSearchQuery(q string) error {
+	if q == "" {
+		return fmt.Errorf("missing search query")
+	}
+	if utf8.RuneCountInString(q) > maxSearchQueryLength {
+		return fmt.Errorf("search query must not exceed %d characters", maxSearchQueryLength)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package event
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// maxSummaryDays is the longest date range a summary may cover.
+const maxSummaryDays = 366
+
+// Summary handles HTTP requests to count events per day, per calendar and per tag in a date range.
+// The from and to query parameters (YYYY-MM-DD) are required, to is inclusive, and the range
+// may cover at most maxSummaryDays days. Days are bucketed in the time zone of the request, and
+// every occurrence of a recurring event counts. No event payloads are returned, which makes it a cheap
+// query for month-view badges.
+func (h *Handler) Summary(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Resolve and validate the date range in the user's time zone.
+	now, err := h.queryNow(r, userID)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	from, err := dateexpr.Parse(r.URL.Query().Get("from"), now)
+	if err != nil {
+		h.logger.Warn("invalid from date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing from date"))
+		return
+	}
+	to, err := dateexpr.Parse(r.URL.Query().Get("to"), now)
+	if err != nil {
+		h.logger.Warn("invalid to date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing to date"))
+		return
+	}
+	if to.Before(from) {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("to must not be before from"))
+		return
+	}
+	if to.Sub(from) >= maxSummaryDays*24*time.Hour {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("date range must not exceed %d days", maxSummaryDays))
+		return
+	}
+
+	summary, err := h.service.GetEventSummary(r.Context(), userID, from, to, model.EventListOptions{Location: now.Location()})
+	if err != nil {
+		h.logger.Error("failed to summarize events", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewEventSummary(summary, from, to))
+}
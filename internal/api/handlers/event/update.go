@@ -14,23 +14,44 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/model"
 	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
+	eventsvc "github.com/aliskhannn/calendar-service/internal/service/event"
 )
 
 // UpdateRequest represents the expected JSON structure for updating an event.
-// It includes fields for the event title, description, event date, and optional reminder time,
+// It includes fields for the event title, description, event date, priority, and optional reminder time,
 // with validation rules applied to ensure data integrity.
 type UpdateRequest struct {
-	Title       string     `json:"title" validate:"required,min=3,max=255"` // Title of the event, required, 3-255 characters
-	Description string     `json:"description" validate:"max=1000"`         // optional description, max 1000 characters
-	EventDate   time.Time  `json:"event_date" validate:"required"`          // date and time of the event, required
-	ReminderAt  *time.Time `json:"reminder_at"`                             // optional reminder time for the event
+	Title            string     `json:"title" validate:"required,event_title"`                                       // Title of the event, required, 3 characters up to the configured maximum
+	Description      string     `json:"description" validate:"event_description"`                                    // optional description, up to the configured maximum
+	Location         string     `json:"location" validate:"max=255"`                                                 // optional place of the event; replaces the current location
+	Latitude         *float64   `json:"latitude" validate:"required_with=Longitude,omitempty,latitude"`              // optional latitude of the location, together with longitude
+	Longitude        *float64   `json:"longitude" validate:"required_with=Latitude,omitempty,longitude"`             // optional longitude of the location, together with latitude
+	EventDate        time.Time  `json:"event_date" validate:"required"`                                              // date and time of the event, required
+	EndDate          *time.Time `json:"end_date"`                                                                    // optional end of the event, after event_date; omitted removes the end
+	Duration         string     `json:"duration" validate:"omitempty,excluded_with=EndDate"`                         // optional length of the event instead of end_date, e.g. 1h30m
+	Priority         string     `json:"priority" validate:"omitempty,oneof=low normal high critical"`                // optional priority, defaults to normal
+	ProjectID        *uuid.UUID `json:"project_id"`                                                                  // optional project the event belongs to
+	CalendarID       *uuid.UUID `json:"calendar_id"`                                                                 // optional calendar to move the event to; omitted keeps the current calendar
+	Color            string     `json:"color" validate:"omitempty,display_color"`                                    // optional color; replaces the current color
+	Tags             []string   `json:"tags" validate:"max=10,dive,min=1,max=32"`                                    // optional tags; replace the current tags
+	ReminderAt       *time.Time `json:"reminder_at"`                                                                 // optional reminder time for the event
+	ReminderTimezone string     `json:"reminder_timezone" validate:"omitempty,excluded_without=ReminderAt,timezone"` // optional IANA time zone reminder_at is a wall-clock time in
+	RecurrenceRule   string     `json:"recurrence_rule" validate:"max=255"`                                          // optional RRULE; replaces the current rule, empty stops the recurrence
+
+	Notifications *NotificationsRequest `json:"notifications"` // optional notification overrides; omitted removes them
 }
 
 // Update handles HTTP requests to update an existing event by its ID.
 // It extracts and validates the user ID from the request context, the event ID from the URL,
 // and the event data from the request body. It then calls the service to update the event.
 // If successful, it returns a success response; otherwise, it returns an appropriate error response.
+//
+// For a recurring event, scope=occurrence&occurrence=<RFC 3339> changes only that occurrence: it is detached
+// from the series and replaced by a standalone event, whose ID is returned with 201 Created.
+// The default scope=all updates the whole series.
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	// Extract and validate user ID from request context.
 	userIDVal := r.Context().Value(middlewares.UserIDKey)
@@ -57,6 +78,14 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Extract the optional occurrence the update is limited to.
+	occurrence, err := occurrenceParam(r)
+	if err != nil {
+		h.logger.Warn("invalid occurrence", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Decode and validate request body.
 	var req UpdateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -72,8 +101,60 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	endDate, err := eventEnd(req.EventDate, req.EndDate, req.Duration)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// A lead time wins over reminder_at, so an event read from the API can be written back as is:
+	// its reminder_at was derived from the lead time.
+	notifications, err := eventNotifications(req.Notifications, nil)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Update the event using the service.
-	if err := h.service.UpdateEvent(r.Context(), eventID, userID, req.Title, req.Description, req.EventDate, req.ReminderAt); err != nil {
+	event := model.Event{
+		ID:               eventID,
+		UserID:           userID,
+		Title:            req.Title,
+		Description:      req.Description,
+		Location:         req.Location,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		EventDate:        req.EventDate,
+		EndDate:          endDate,
+		Priority:         req.Priority,
+		ProjectID:        req.ProjectID,
+		CalendarID:       req.CalendarID,
+		Color:            req.Color,
+		Tags:             req.Tags,
+		ReminderAt:       req.ReminderAt,
+		ReminderTimezone: req.ReminderTimezone,
+		Notifications:    notifications,
+		RecurrenceRule:   req.RecurrenceRule,
+	}
+
+	// Detach a single occurrence of a recurring event.
+	if occurrence != nil {
+		if req.RecurrenceRule != "" {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("recurrence_rule cannot be set for a single occurrence"))
+			return
+		}
+
+		id, err := h.service.UpdateOccurrence(r.Context(), event, *occurrence)
+		if err != nil {
+			h.failOccurrence(w, eventID, err)
+			return
+		}
+
+		response.Created(w, id)
+		return
+	}
+
+	if err := h.service.UpdateEvent(r.Context(), event); err != nil {
 		// Handle case where event is not found.
 		if errors.Is(err, eventrepo.ErrEventNotFound) {
 			h.logger.Info("event not found", zap.String("eventID", eventID.String()))
@@ -81,6 +162,31 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Handle case where the event is assigned to an unknown project.
+		if errors.Is(err, eventrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrProjectNotFound)
+			return
+		}
+
+		// Handle case where the event is moved to a calendar the user does not have.
+		if errors.Is(err, eventrepo.ErrCalendarNotFound) {
+			response.Fail(w, http.StatusBadRequest, eventrepo.ErrCalendarNotFound)
+			return
+		}
+
+		// Handle case where the end, the reminder time zone or the recurrence rule is invalid.
+		if errors.Is(err, eventsvc.ErrInvalidEnd) || errors.Is(err, eventsvc.ErrUnknownTimezone) || errors.Is(err, rrule.ErrInvalidRule) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// Handle case where the new date breaks the order of linked events.
+		if errors.Is(err, eventsvc.ErrLinkOrderBroken) {
+			h.logger.Info("event link order violated", zap.String("eventID", eventID.String()))
+			response.Fail(w, http.StatusConflict, eventsvc.ErrLinkOrderBroken)
+			return
+		}
+
 		// Log and handle unexpected errors.
 		h.logger.Error("unexpected error updating event", zap.Error(err))
 		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/dateexpr"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	exportsvc "github.com/aliskhannn/calendar-service/internal/service/export"
+)
+
+// weekdays maps the accepted week_start values to weekdays.
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// PDF handles HTTP requests to export the events of the authenticated user as a printable agenda.
+// The from and to query parameters (YYYY-MM-DD) are required and to is inclusive; layout is week
+// (default) or month, tz an IANA time zone (default UTC) and week_start the first day of every week
+// (default Monday). Short ranges are answered with the PDF; longer ranges are rendered by a background
+// job and answered with 202 Accepted and the job, whose output is downloaded from the jobs API.
+func (h *Handler) PDF(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	// Resolve the date range in the time zone of the agenda.
+	loc, err := dateexpr.Location(q.Get("tz"))
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+	now := time.Now().In(loc)
+	from, err := dateexpr.Parse(q.Get("from"), now)
+	if err != nil {
+		h.logger.Warn("invalid from date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing from date"))
+		return
+	}
+	to, err := dateexpr.Parse(q.Get("to"), now)
+	if err != nil {
+		h.logger.Warn("invalid to date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing to date"))
+		return
+	}
+
+	req := model.PDFExport{From: from, To: to, Layout: model.LayoutWeek, Timezone: q.Get("tz"), WeekStart: time.Monday}
+	if v := q.Get("layout"); v != "" {
+		req.Layout = v
+	}
+	if v := q.Get("week_start"); v != "" {
+		if req.WeekStart, ok = weekdays[strings.ToLower(v)]; !ok {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid week_start"))
+			return
+		}
+	}
+
+	doc, job, err := h.service.ExportPDF(r.Context(), userID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, exportsvc.ErrInvalidRange),
+			errors.Is(err, exportsvc.ErrRangeTooLong),
+			errors.Is(err, exportsvc.ErrUnknownLayout),
+			errors.Is(err, exportsvc.ErrUnknownTimezone):
+			response.Fail(w, http.StatusBadRequest, err)
+		default:
+			h.logger.Error("failed to export pdf", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	if job != nil {
+		response.Accepted(w, dto.NewJob(*job))
+		return
+	}
+
+	filename := fmt.Sprintf("agenda-%s-%s.pdf", from.Format(time.DateOnly), to.Format(time.DateOnly))
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(doc)
+}
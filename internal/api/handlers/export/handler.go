@@ -0,0 +1,39 @@
+package export
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/export/mock_export_service.go -package=mocks
+
+// exportService defines the interface for agenda exports.
+type exportService interface {
+	// ExportPDF renders the agenda of a user's events as a PDF, or queues a job rendering it for long ranges.
+	ExportPDF(ctx context.Context, userID uuid.UUID, req model.PDFExport) ([]byte, *model.Job, error)
+}
+
+// Handler manages HTTP requests for exports of the user's calendar.
+type Handler struct {
+	service exportService // service handles business logic for exports
+	logger  *zap.Logger   // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The export service rendering the agendas.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s exportService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
@@ -0,0 +1,129 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksexportsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/export"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	exportsvc "github.com/aliskhannn/calendar-service/internal/service/export"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksexportsvc.MockexportService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksexportsvc.NewMockexportService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger)
+	return ctrl, mockService, handler
+}
+
+func withUser(req *http.Request, userID uuid.UUID) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+}
+
+func TestHandler_PDF_Document(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := withUser(httptest.NewRequest(http.MethodGet,
+		"/events/export.pdf?from=2030-03-04&to=2030-03-10&tz=Europe/Berlin&week_start=sunday", nil), userID)
+	w := httptest.NewRecorder()
+
+	want := model.PDFExport{
+		From:      time.Date(2030, 3, 4, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2030, 3, 10, 0, 0, 0, 0, time.UTC),
+		Layout:    model.LayoutWeek,
+		Timezone:  "Europe/Berlin",
+		WeekStart: time.Sunday,
+	}
+	mockService.EXPECT().ExportPDF(gomock.Any(), userID, want).Return([]byte("%PDF-1.4"), nil, nil)
+
+	h.PDF(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/pdf" {
+		t.Errorf("expected application/pdf, got %q", ct)
+	}
+	if cd := w.Header().Get("Content-Disposition"); cd != "attachment; filename=agenda-2030-03-04-2030-03-10.pdf" {
+		t.Errorf("unexpected content disposition %q", cd)
+	}
+}
+
+func TestHandler_PDF_Job(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := withUser(httptest.NewRequest(http.MethodGet, "/events/export.pdf?from=2030-01-01&to=2030-12-31&layout=month", nil), userID)
+	w := httptest.NewRecorder()
+
+	job := model.Job{ID: uuid.New(), Kind: model.JobPDFExport, Status: model.JobQueued, Total: 12}
+	mockService.EXPECT().ExportPDF(gomock.Any(), userID, gomock.Any()).Return(nil, &job, nil)
+
+	h.PDF(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d", http.StatusAccepted, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			ID   string `json:"id"`
+			Kind string `json:"kind"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.ID != job.ID.String() || resp.Result.Kind != model.JobPDFExport {
+		t.Errorf("unexpected job in response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_PDF_BadRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		err   error // returned by the service; nil if the request is rejected before
+	}{
+		{"missing from", "to=2030-01-01", nil},
+		{"invalid to", "from=2030-01-01&to=someday", nil},
+		{"invalid week start", "from=2030-01-01&to=2030-01-07&week_start=someday", nil},
+		{"unknown layout", "from=2030-01-01&to=2030-01-07&layout=year", exportsvc.ErrUnknownLayout},
+		{"range too long", "from=2030-01-01&to=2032-01-01", fmt.Errorf("%w: at most 366 days", exportsvc.ErrRangeTooLong)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			req := withUser(httptest.NewRequest(http.MethodGet, "/events/export.pdf?"+tc.query, nil), uuid.New())
+			w := httptest.NewRecorder()
+
+			if tc.err != nil {
+				mockService.EXPECT().ExportPDF(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, tc.err)
+			}
+
+			h.PDF(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
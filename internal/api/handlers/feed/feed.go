@@ -0,0 +1,157 @@
+package feed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+)
+
+// CreateRequest represents the payload for creating a new ICS feed.
+type CreateRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=255"` // name shown as the calendar name by clients
+	ProjectID *uuid.UUID `json:"project_id"`                             // only publish events of this project
+}
+
+// Create handles HTTP requests to create an ICS feed for the authenticated user.
+// The token and the subscription URL are only included in this response.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	feed, err := h.service.CreateFeed(r.Context(), model.Feed{
+		UserID:    userID,
+		Name:      req.Name,
+		ProjectID: req.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, feedrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusBadRequest, feedrepo.ErrProjectNotFound)
+			return
+		}
+
+		h.logger.Error("failed to create feed", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, dto.NewFeed(feed))
+}
+
+// List handles HTTP requests to list the ICS feeds of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	feeds, err := h.service.ListFeeds(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list feeds", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewFeeds(feeds))
+}
+
+// Regenerate handles HTTP requests to give an ICS feed a new token, revoking its previous URL.
+func (h *Handler) Regenerate(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse feed ID from URL parameter.
+	feedID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid feed id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid feed id"))
+		return
+	}
+
+	feed, err := h.service.RegenerateFeed(r.Context(), feedID, userID)
+	if err != nil {
+		if errors.Is(err, feedrepo.ErrFeedNotFound) {
+			response.Fail(w, http.StatusNotFound, feedrepo.ErrFeedNotFound)
+			return
+		}
+
+		h.logger.Error("failed to regenerate feed", zap.String("feed_id", feedID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewFeed(feed))
+}
+
+// Delete handles HTTP requests to delete an ICS feed by its ID, revoking its URL.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse feed ID from URL parameter.
+	feedID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid feed id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid feed id"))
+		return
+	}
+
+	if err := h.service.DeleteFeed(r.Context(), feedID, userID); err != nil {
+		if errors.Is(err, feedrepo.ErrFeedNotFound) {
+			response.Fail(w, http.StatusNotFound, feedrepo.ErrFeedNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete feed", zap.String("feed_id", feedID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "feed deleted")
+}
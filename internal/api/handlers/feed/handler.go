@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/feed/mock_feed_service.go -package=mocks
+
+// feedService defines the interface for ICS feed operations.
+type feedService interface {
+	// CreateFeed creates a feed with a new token.
+	CreateFeed(ctx context.Context, feed model.Feed) (model.Feed, error)
+
+	// ListFeeds retrieves all feeds of a user.
+	ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error)
+
+	// RegenerateFeed gives a feed of the specified user a new token.
+	RegenerateFeed(ctx context.Context, feedID, userID uuid.UUID) (model.Feed, error)
+
+	// DeleteFeed deletes a feed of the specified user.
+	DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error
+
+	// GetFeedCalendar retrieves the feed of a token and the events it publishes.
+	GetFeedCalendar(ctx context.Context, token string) (model.FeedCalendar, error)
+}
+
+// Handler manages HTTP requests for ICS feeds and the calendars clients subscribe to.
+type Handler struct {
+	service   feedService         // service handles business logic for feeds
+	refresh   time.Duration       // how often subscribed clients are asked to refresh a feed
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The feed service for handling feed-related operations.
+//   - refresh: How often subscribed clients are asked to refresh a feed; also how long it may be cached.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s feedService, refresh time.Duration, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		refresh:   refresh,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,172 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksfeedsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/feed"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksfeedsvc.MockfeedService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksfeedsvc.NewMockfeedService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, time.Hour, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func withParam(req *http.Request, key, value string) *http.Request {
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add(key, value)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Name: "Work"})
+
+	req := httptest.NewRequest(http.MethodPost, "/feeds", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateFeed(gomock.Any(), model.Feed{UserID: userID, Name: "Work"}).
+		Return(model.Feed{ID: uuid.New(), Name: "Work", Token: "secret"}, nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"url":"/feeds/secret.ics"`) {
+		t.Fatalf("expected the subscription url in the response, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_Regenerate_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, feedID := uuid.New(), uuid.New()
+	req := withParam(httptest.NewRequest(http.MethodPost, "/feeds/"+feedID.String()+"/token", nil), "id", feedID.String())
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		RegenerateFeed(gomock.Any(), feedID, userID).
+		Return(model.Feed{}, fmt.Errorf("regenerate feed: %w", feedrepo.ErrFeedNotFound))
+
+	h.Regenerate(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Calendar(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	standupEnd := time.Date(2030, 1, 7, 9, 15, 0, 0, time.UTC)
+	cal := model.FeedCalendar{
+		Feed: model.Feed{Name: "Work"},
+		Events: []model.Event{{
+			ID:        eventID,
+			Title:     "Launch",
+			EventDate: time.Date(2030, 3, 11, 9, 30, 0, 0, time.UTC),
+			Color:     "green",
+			Priority:  model.PriorityCritical,
+			UpdatedAt: time.Date(2030, 3, 1, 8, 0, 0, 0, time.UTC),
+		}, {
+			ID:        uuid.New(),
+			Title:     "Retro",
+			EventDate: time.Date(2030, 3, 12, 9, 30, 0, 0, time.UTC),
+			Color:     "#ff8800",
+			Priority:  model.PriorityLow,
+			UpdatedAt: time.Date(2030, 3, 1, 8, 0, 0, 0, time.UTC),
+		}, {
+			ID:                   uuid.New(),
+			Title:                "Standup",
+			EventDate:            time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC),
+			EndDate:              &standupEnd,
+			RecurrenceRule:       "FREQ=WEEKLY",
+			RecurrenceExceptions: []time.Time{time.Date(2030, 3, 18, 9, 0, 0, 0, time.UTC)},
+			Overrides: []model.OccurrenceOverride{
+				{Occurrence: time.Date(2030, 3, 25, 9, 0, 0, 0, time.UTC), EventDate: time.Date(2030, 3, 25, 11, 0, 0, 0, time.UTC), Title: "Standup (late)"},
+			},
+			UpdatedAt: time.Date(2030, 3, 1, 8, 0, 0, 0, time.UTC),
+		}},
+	}
+	mockService.EXPECT().GetFeedCalendar(gomock.Any(), "acme.secret").Return(cal, nil).Times(2)
+
+	req := withParam(httptest.NewRequest(http.MethodGet, "/feeds/acme.secret.ics", nil), "file", "acme.secret.ics")
+	w := httptest.NewRecorder()
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/calendar; charset=utf-8" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	body := w.Body.String()
+	for _, want := range []string{"UID:" + eventID.String() + "@calendar-service", "REFRESH-INTERVAL;VALUE=DURATION:PT1H", "X-PUBLISHED-TTL:PT1H", "COLOR:green", "PRIORITY:1\r\n", "PRIORITY:9\r\n",
+		"DTEND:20300107T091500Z", "RRULE:FREQ=WEEKLY\r\n", "EXDATE:20300318T090000Z\r\n",
+		"DTSTART:20300325T110000Z\r\nRECURRENCE-ID:20300325T090000Z\r\nDTEND:20300325T111500Z\r\n", "SUMMARY:Standup (late)\r\n"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected %q in the feed, got %s", want, body)
+		}
+	}
+	// Hex colors have no iCalendar representation.
+	if strings.Count(body, "COLOR:") != 1 {
+		t.Fatalf("expected only the palette color in the feed, got %s", body)
+	}
+
+	// A client polling with the ETag of an unchanged feed gets no body.
+	req = withParam(httptest.NewRequest(http.MethodGet, "/feeds/acme.secret.ics", nil), "file", "acme.secret.ics")
+	req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+	h.Calendar(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("expected status %d, got %d", http.StatusNotModified, w.Code)
+	}
+}
+
+func TestHandler_Calendar_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetFeedCalendar(gomock.Any(), "revoked").Return(model.FeedCalendar{}, feedrepo.ErrFeedNotFound)
+
+	for _, file := range []string{"revoked.ics", "secret.json"} {
+		req := withParam(httptest.NewRequest(http.MethodGet, "/feeds/"+file, nil), "file", file)
+		w := httptest.NewRecorder()
+		h.Calendar(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("%s: expected status %d, got %d", file, http.StatusNotFound, w.Code)
+		}
+	}
+}
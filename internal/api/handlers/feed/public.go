@@ -0,0 +1,133 @@
+package feed
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/ical"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+)
+
+// uidDomain is appended to event IDs to form the globally unique UIDs of published events.
+const uidDomain = "@calendar-service"
+
+// Calendar handles requests of calendar clients for an ICS feed by its file name, the token followed by .ics.
+// Responses carry an ETag, so clients polling an unchanged feed get 304 Not Modified.
+func (h *Handler) Calendar(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutSuffix(chi.URLParam(r, "file"), ".ics")
+	if !ok || token == "" {
+		response.Fail(w, http.StatusNotFound, feedrepo.ErrFeedNotFound)
+		return
+	}
+
+	cal, err := h.service.GetFeedCalendar(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, feedrepo.ErrFeedNotFound) {
+			response.Fail(w, http.StatusNotFound, feedrepo.ErrFeedNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get feed", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, newCalendar(cal), h.refresh); err != nil {
+		h.logger.Error("failed to render feed", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+	body := buf.Bytes()
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := w.Header()
+	// The URL is a secret of its owner, so only the subscribing client may cache the feed.
+	header.Set("Cache-Control", "private, max-age="+strconv.Itoa(int(h.refresh.Seconds())))
+	header.Set("ETag", etag)
+	header.Set("X-Robots-Tag", "noindex")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	header.Set("Content-Type", "text/calendar; charset=utf-8")
+	header.Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": "calendar.ics"}))
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// feedPriorities maps event priorities to iCalendar priorities; high shares the upper range (1-4) with critical.
+var feedPriorities = map[string]int{
+	model.PriorityCritical: 1,
+	model.PriorityHigh:     3,
+	model.PriorityNormal:   5,
+	model.PriorityLow:      9,
+}
+
+// newCalendar converts the events of a feed into an iCalendar calendar.
+// Only palette colors are kept, as iCalendar colors are CSS color names and cannot be hex colors.
+// Priorities are mapped onto the PRIORITY scale of RFC 5545, where 1 is the highest.
+// Recurring events keep their rule and exceptions, so clients expand them like the service does,
+// and are followed by their overridden occurrences.
+func newCalendar(c model.FeedCalendar) ical.Calendar {
+	events := make([]ical.Event, 0, len(c.Events))
+	for _, e := range c.Events {
+		event := ical.Event{
+			UID:         e.ID.String() + uidDomain,
+			Summary:     e.Title,
+			Description: e.Description,
+			Priority:    feedPriorities[e.Priority],
+			Start:       e.EventDate,
+			Rule:        e.RecurrenceRule,
+			Exceptions:  e.RecurrenceExceptions,
+			Updated:     e.UpdatedAt,
+		}
+		if e.EndDate != nil {
+			event.End = *e.EndDate
+		}
+		if slices.Contains(model.ColorPalette, e.Color) {
+			event.Color = e.Color
+		}
+		events = append(events, event)
+
+		// Overridden occurrences replace the occurrences of the series with the same UID.
+		for _, o := range e.Overrides {
+			if slices.ContainsFunc(e.RecurrenceExceptions, o.Occurrence.Equal) {
+				continue
+			}
+			occurrence := e.Override(o)
+			override := event
+			override.Summary = occurrence.Title
+			override.Start = occurrence.EventDate
+			override.End = time.Time{}
+			if occurrence.EndDate != nil {
+				override.End = *occurrence.EndDate
+			}
+			override.Rule = ""
+			override.Exceptions = nil
+			override.RecurrenceID = o.Occurrence
+			events = append(events, override)
+		}
+	}
+
+	return ical.Calendar{Name: c.Feed.Name, Events: events}
+}
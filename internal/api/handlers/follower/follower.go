@@ -0,0 +1,99 @@
+package follower
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	followerrepo "github.com/aliskhannn/calendar-service/internal/repository/follower"
+	followersvc "github.com/aliskhannn/calendar-service/internal/service/follower"
+)
+
+// Follow handles HTTP requests to follow a shared event of another user.
+func (h *Handler) Follow(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	f, err := h.service.Follow(r.Context(), eventID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, followerrepo.ErrEventNotFound):
+			response.Fail(w, http.StatusNotFound, followerrepo.ErrEventNotFound)
+		case errors.Is(err, followersvc.ErrOwnEvent):
+			response.Fail(w, http.StatusBadRequest, followersvc.ErrOwnEvent)
+		default:
+			h.logger.Error("failed to follow event",
+				zap.String("user_id", userID.String()),
+				zap.String("event_id", eventID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("event followed",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+	)
+	response.OK(w, f)
+}
+
+// Unfollow handles HTTP requests to stop following an event.
+func (h *Handler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Unfollow(r.Context(), eventID, userID); err != nil {
+		if errors.Is(err, followerrepo.ErrNotFollowing) {
+			response.Fail(w, http.StatusNotFound, followerrepo.ErrNotFollowing)
+			return
+		}
+
+		h.logger.Error("failed to unfollow event",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("event unfollowed",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+	)
+	response.OK(w, "event unfollowed")
+}
+
+// parseRequest extracts the authenticated user and the event ID of the URL.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, eventID, true
+}
@@ -0,0 +1,42 @@
+package follower
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/follower/mock_follower_service.go -package=mocks
+
+// followerService defines the interface for following the shared events of other users.
+type followerService interface {
+	// Follow makes a user follow an event shared through a short link or published by an embed.
+	Follow(ctx context.Context, eventID, userID uuid.UUID) (model.Follower, error)
+
+	// Unfollow stops a user from following an event.
+	Unfollow(ctx context.Context, eventID, userID uuid.UUID) error
+}
+
+// Handler manages HTTP requests for following events.
+type Handler struct {
+	service followerService // service handles business logic for followers
+	logger  *zap.Logger     // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The follower service for following events.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s followerService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
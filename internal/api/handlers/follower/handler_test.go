@@ -0,0 +1,109 @@
+package follower
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksfollowersvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/follower"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	followerrepo "github.com/aliskhannn/calendar-service/internal/repository/follower"
+	followersvc "github.com/aliskhannn/calendar-service/internal/service/follower"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksfollowersvc.MockfollowerService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksfollowersvc.NewMockfollowerService(ctrl)
+	handler := New(mockService, zap.NewNop())
+	return ctrl, mockService, handler
+}
+
+// newRequest builds a request of the authenticated user for the given event.
+func newRequest(method string, userID uuid.UUID, eventID string) *http.Request {
+	req := httptest.NewRequest(method, "/events/"+eventID+"/follow", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Follow(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"followed":     {want: http.StatusOK},
+		"not shared":   {err: fmt.Errorf("follow event: %w", followerrepo.ErrEventNotFound), want: http.StatusNotFound},
+		"own event":    {err: followersvc.ErrOwnEvent, want: http.StatusBadRequest},
+		"server error": {err: fmt.Errorf("follow event: db down"), want: http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Follow(gomock.Any(), eventID, userID).
+				Return(model.Follower{EventID: eventID, UserID: userID}, tt.err)
+
+			h.Follow(w, newRequest(http.MethodPost, userID, eventID.String()))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Follow_InvalidEventID(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.Follow(w, newRequest(http.MethodPost, uuid.New(), "not-a-uuid"))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Unfollow(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"unfollowed":    {want: http.StatusOK},
+		"not following": {err: fmt.Errorf("unfollow event: %w", followerrepo.ErrNotFollowing), want: http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().Unfollow(gomock.Any(), eventID, userID).Return(tt.err)
+
+			h.Unfollow(w, newRequest(http.MethodDelete, userID, eventID.String()))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
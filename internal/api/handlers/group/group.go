@@ -0,0 +1,269 @@
+package group
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	grouprepo "github.com/aliskhannn/calendar-service/internal/repository/group"
+)
+
+// GroupRequest represents the payload for creating or renaming a group.
+type GroupRequest struct {
+	Name string `json:"name" validate:"required,max=100"` // name of the group, e.g. Backend team
+}
+
+// MemberRequest represents the payload for adding a member to a group.
+type MemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=254"` // email address of the registered user to add
+}
+
+// InviteRequest represents the payload for inviting a group to an event.
+type InviteRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"` // identifier of the group to invite
+}
+
+// Create handles HTTP requests to create an empty group of the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req GroupRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	g, err := h.service.CreateGroup(r.Context(), userID, req.Name)
+	if err != nil {
+		h.fail(w, "failed to create group", uuid.Nil, err)
+		return
+	}
+
+	response.Created(w, g)
+}
+
+// List handles HTTP requests to list the groups of the authenticated user with their member counts.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	groups, err := h.service.ListGroups(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list groups", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, groups)
+}
+
+// Get handles HTTP requests to retrieve a group of the authenticated user with its members.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, groupID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	g, err := h.service.GetGroup(r.Context(), groupID, userID)
+	if err != nil {
+		h.fail(w, "failed to get group", groupID, err)
+		return
+	}
+
+	response.OK(w, g)
+}
+
+// Rename handles HTTP requests to rename a group of the authenticated user.
+func (h *Handler) Rename(w http.ResponseWriter, r *http.Request) {
+	userID, groupID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req GroupRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	g, err := h.service.RenameGroup(r.Context(), groupID, userID, req.Name)
+	if err != nil {
+		h.fail(w, "failed to rename group", groupID, err)
+		return
+	}
+
+	response.OK(w, g)
+}
+
+// Delete handles HTTP requests to delete a group of the authenticated user.
+// Its members stay invited to the events the group was invited to.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, groupID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteGroup(r.Context(), groupID, userID); err != nil {
+		h.fail(w, "failed to delete group", groupID, err)
+		return
+	}
+
+	response.OK(w, "group deleted")
+}
+
+// AddMember handles HTTP requests to add a registered user to a group of the authenticated user.
+// The member is invited to the events the group is invited to that have not started yet.
+func (h *Handler) AddMember(w http.ResponseWriter, r *http.Request) {
+	userID, groupID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req MemberRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	m, invited, err := h.service.AddMember(r.Context(), groupID, userID, req.Email)
+	if err != nil {
+		h.fail(w, "failed to add group member", groupID, err)
+		return
+	}
+
+	response.Created(w, map[string]any{"member": m, "invited": invited})
+}
+
+// RemoveMember handles HTTP requests to remove a member from a group of the authenticated user.
+// The invitations the group sent the member to events that have not started yet are withdrawn.
+func (h *Handler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	userID, groupID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse member ID from URL parameter.
+	memberID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		h.logger.Warn("invalid member id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid member id"))
+		return
+	}
+
+	withdrawn, err := h.service.RemoveMember(r.Context(), groupID, userID, memberID)
+	if err != nil {
+		h.fail(w, "failed to remove group member", groupID, err)
+		return
+	}
+
+	response.OK(w, map[string]int{"withdrawn": withdrawn})
+}
+
+// Invite handles HTTP requests to invite a group of the authenticated user to one of their events.
+// Members already invited keep their response, and the event follows the group's membership until it starts.
+func (h *Handler) Invite(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req InviteRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	invited, err := h.service.InviteGroup(r.Context(), eventID, userID, req.GroupID)
+	if err != nil {
+		h.fail(w, "failed to invite group", req.GroupID, err)
+		return
+	}
+
+	h.logger.Info("group invited",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("group_id", req.GroupID.String()),
+		zap.Int("invited", invited),
+	)
+	response.OK(w, map[string]int{"invited": invited})
+}
+
+// fail writes the error response for a failed operation on a group.
+func (h *Handler) fail(w http.ResponseWriter, msg string, groupID uuid.UUID, err error) {
+	for _, target := range []error{
+		grouprepo.ErrGroupNotFound,
+		grouprepo.ErrUserNotFound,
+		grouprepo.ErrMemberNotFound,
+		grouprepo.ErrEventNotFound,
+	} {
+		if errors.Is(err, target) {
+			response.Fail(w, http.StatusNotFound, target)
+			return
+		}
+	}
+
+	if errors.Is(err, grouprepo.ErrGroupExists) {
+		response.Fail(w, http.StatusConflict, grouprepo.ErrGroupExists)
+		return
+	}
+
+	h.logger.Error(msg, zap.String("group_id", groupID.String()), zap.Error(err))
+	response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+}
+
+// decodeRequest decodes and validates the request body into req.
+// It writes the error response and returns false if the body is invalid.
+func (h *Handler) decodeRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return false
+	}
+
+	return true
+}
+
+// userID extracts the authenticated user of the request.
+// It writes the error response and returns false if it is missing.
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// parseRequest extracts the authenticated user and the ID of the URL, a group or an event depending on the route.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, id, true
+}
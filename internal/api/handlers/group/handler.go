@@ -0,0 +1,64 @@
+package group
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/group/mock_group_service.go -package=mocks
+
+// groupService defines the interface for attendee groups and the invitations they send.
+type groupService interface {
+	// CreateGroup creates a new, empty group of a user.
+	CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error)
+
+	// ListGroups retrieves the groups of a user with their member counts.
+	ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error)
+
+	// GetGroup retrieves a group of a user with its members.
+	GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error)
+
+	// RenameGroup changes the name of a group of a user.
+	RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error)
+
+	// DeleteGroup deletes a group of a user.
+	DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error
+
+	// AddMember adds a registered user to a group and invites them to the upcoming events the group is invited to.
+	AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error)
+
+	// RemoveMember removes a user from a group and withdraws the group's invitations to upcoming events.
+	RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error)
+
+	// InviteGroup invites the members of a group to an event of the same owner.
+	InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error)
+}
+
+// Handler manages HTTP requests for the attendee groups of the authenticated user.
+type Handler struct {
+	service   groupService        // service handles business logic for attendee groups
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The group service for managing attendee groups.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s groupService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,117 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksgroupsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/group"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	grouprepo "github.com/aliskhannn/calendar-service/internal/repository/group"
+	"github.com/aliskhannn/calendar-service/internal/validation"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksgroupsvc.MockgroupService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksgroupsvc.NewMockgroupService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validation.New(config.Validation{}))
+	return ctrl, mockService, handler
+}
+
+func withID(req *http.Request, userID, id uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", id.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Exists(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(GroupRequest{Name: "Backend team"})
+
+	req := httptest.NewRequest(http.MethodPost, "/groups", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateGroup(gomock.Any(), userID, "Backend team").
+		Return(model.AttendeeGroup{}, fmt.Errorf("create group: %w", grouprepo.ErrGroupExists))
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestHandler_AddMember_UserNotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, groupID := uuid.New(), uuid.New()
+	body, _ := json.Marshal(MemberRequest{Email: "nobody@example.com"})
+
+	req := withID(httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/members", bytes.NewReader(body)), userID, groupID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		AddMember(gomock.Any(), groupID, userID, "nobody@example.com").
+		Return(model.GroupMember{}, 0, fmt.Errorf("add group member: %w", grouprepo.ErrUserNotFound))
+
+	h.AddMember(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Invite_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID, groupID := uuid.New(), uuid.New(), uuid.New()
+	body, _ := json.Marshal(InviteRequest{GroupID: groupID})
+
+	req := withID(httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/attendees/groups", bytes.NewReader(body)), userID, eventID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().InviteGroup(gomock.Any(), eventID, userID, groupID).Return(3, nil)
+
+	h.Invite(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandler_Invite_MissingGroup(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+
+	req := withID(httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/attendees/groups", bytes.NewReader([]byte(`{}`))), userID, eventID)
+	w := httptest.NewRecorder()
+
+	h.Invite(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
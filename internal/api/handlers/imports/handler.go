@@ -0,0 +1,45 @@
+package imports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/imports/mock_import_service.go -package=mocks
+
+// importService defines the interface for calendar archive imports.
+type importService interface {
+	// StartImport checks an archive and queues a job importing its events.
+	StartImport(ctx context.Context, userID uuid.UUID, archive []byte) (model.Job, error)
+
+	// GetImport retrieves an import job with its progress.
+	GetImport(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+}
+
+// Handler manages HTTP requests for calendar archive imports.
+type Handler struct {
+	service        importService // service handles business logic for imports
+	maxArchiveSize int64         // maximum size of an uploaded archive in bytes
+	logger         *zap.Logger   // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The import service for handling import operations.
+//   - maxArchiveSize: The maximum size of an uploaded archive in bytes.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s importService, maxArchiveSize int64, l *zap.Logger) *Handler {
+	return &Handler{
+		service:        s,
+		maxArchiveSize: maxArchiveSize,
+		logger:         l,
+	}
+}
@@ -0,0 +1,121 @@
+package imports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksimportsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/imports"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	importsvc "github.com/aliskhannn/calendar-service/internal/service/imports"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksimportsvc.MockimportService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksimportsvc.NewMockimportService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, 16, logger)
+	return ctrl, mockService, handler
+}
+
+func TestHandler_Create_Accepted(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/imports", bytes.NewReader([]byte("PK archive")))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		StartImport(gomock.Any(), userID, []byte("PK archive")).
+		Return(model.Job{ID: uuid.New(), Kind: model.JobCalendarImport, Status: model.JobQueued, Total: 10}, nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d", http.StatusAccepted, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			Status   string `json:"status"`
+			Progress int    `json:"progress"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Status != model.JobQueued || resp.Result.Progress != 0 {
+		t.Errorf("unexpected import: %+v", resp.Result)
+	}
+}
+
+func TestHandler_Create_Rejected(t *testing.T) {
+	cases := map[string]struct {
+		body   string
+		err    error
+		status int
+	}{
+		"too large upload":  {body: "an archive above the limit", status: http.StatusRequestEntityTooLarge},
+		"zip bomb":          {body: "PK", err: importsvc.ErrArchiveTooLarge, status: http.StatusRequestEntityTooLarge},
+		"not a zip":         {body: "PK", err: fmt.Errorf("%w: not a zip file", importsvc.ErrInvalidArchive), status: http.StatusBadRequest},
+		"without calendars": {body: "PK", err: importsvc.ErrNoCalendars, status: http.StatusBadRequest},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/imports", bytes.NewReader([]byte(tc.body)))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+			w := httptest.NewRecorder()
+
+			if tc.err != nil {
+				mockService.EXPECT().StartImport(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Job{}, tc.err)
+			}
+
+			h.Create(w, req)
+
+			if w.Code != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, importID := uuid.New(), uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/imports/"+importID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", importID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetImport(gomock.Any(), importID, userID).
+		Return(model.Job{}, fmt.Errorf("get import: %w", jobrepo.ErrJobNotFound))
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
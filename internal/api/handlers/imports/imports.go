@@ -0,0 +1,97 @@
+package imports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	importsvc "github.com/aliskhannn/calendar-service/internal/service/imports"
+)
+
+// Create handles HTTP requests to import a Google Takeout or Apple Calendar archive.
+// The zip archive is sent as the request body. The events are imported by a background job;
+// the response is 202 Accepted with the job, whose progress is polled with Get or the jobs API.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Read the archive, rejecting uploads above the size limit.
+	archive, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxArchiveSize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			response.Fail(w, http.StatusRequestEntityTooLarge, importsvc.ErrArchiveTooLarge)
+			return
+		}
+
+		h.logger.Warn("failed to read archive", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	job, err := h.service.StartImport(r.Context(), userID, archive)
+	if err != nil {
+		switch {
+		case errors.Is(err, importsvc.ErrArchiveTooLarge):
+			response.Fail(w, http.StatusRequestEntityTooLarge, importsvc.ErrArchiveTooLarge)
+		case errors.Is(err, importsvc.ErrInvalidArchive), errors.Is(err, importsvc.ErrNoCalendars):
+			h.logger.Warn("archive rejected", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, err)
+		default:
+			h.logger.Error("failed to start import", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	response.Accepted(w, dto.NewJob(job))
+}
+
+// Get handles HTTP requests to read the progress of an import job by its ID.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse import ID from URL parameter.
+	importID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid import id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid import id"))
+		return
+	}
+
+	job, err := h.service.GetImport(r.Context(), importID, userID)
+	if err != nil {
+		if errors.Is(err, jobrepo.ErrJobNotFound) {
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrJobNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get import", zap.String("import_id", importID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewJob(job))
+}
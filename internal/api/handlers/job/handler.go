@@ -0,0 +1,51 @@
+package job
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/job/mock_job_service.go -package=mocks
+
+// jobService defines the interface for background job operations.
+type jobService interface {
+	// ListJobs retrieves the most recent jobs of a user.
+	ListJobs(ctx context.Context, userID uuid.UUID) ([]model.Job, error)
+
+	// GetJob retrieves a job with its progress.
+	GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// GetOutput retrieves the file produced by a completed job.
+	GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// CancelJob cancels a queued job or asks the worker of a running job to stop.
+	CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// RollbackJob reverts a finished bulk operation by deleting the events it created.
+	RollbackJob(ctx context.Context, jobID, userID uuid.UUID) (int, error)
+}
+
+// Handler manages HTTP requests for background jobs and their progress.
+type Handler struct {
+	service jobService  // service handles business logic for jobs
+	logger  *zap.Logger // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The job service for handling job-related operations.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s jobService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
@@ -0,0 +1,202 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksjobsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/job"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksjobsvc.MockjobService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksjobsvc.NewMockjobService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger)
+	return ctrl, mockService, handler
+}
+
+func withJobID(req *http.Request, userID, jobID uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", jobID.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_List_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().ListJobs(gomock.Any(), userID).Return([]model.Job{
+		{ID: uuid.New(), Kind: model.JobCalendarImport, Status: model.JobRunning, Total: 200, Processed: 50},
+	}, nil)
+
+	h.List(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []dto.Job `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || resp.Result[0].Progress != 25 {
+		t.Fatalf("expected one job at 25%%, got %+v", resp.Result)
+	}
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, jobID := uuid.New(), uuid.New()
+	req := withJobID(httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String(), nil), userID, jobID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetJob(gomock.Any(), jobID, userID).
+		Return(model.Job{}, fmt.Errorf("get job: %w", jobrepo.ErrJobNotFound))
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Cancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"running job", nil, http.StatusOK},
+		{"not found", fmt.Errorf("cancel job: %w", jobrepo.ErrJobNotFound), http.StatusNotFound},
+		{"already finished", fmt.Errorf("cancel job: %w", jobrepo.ErrJobFinished), http.StatusConflict},
+		{"database error", fmt.Errorf("db down"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, jobID := uuid.New(), uuid.New()
+			req := withJobID(httptest.NewRequest(http.MethodPost, "/jobs/"+jobID.String()+"/cancel", nil), userID, jobID)
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				CancelJob(gomock.Any(), jobID, userID).
+				Return(model.Job{ID: jobID, Status: model.JobRunning, CancelRequested: true}, tc.err)
+
+			h.Cancel(w, req)
+
+			if w.Code != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Rollback(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"finished import", nil, http.StatusOK},
+		{"not found", fmt.Errorf("rollback job: %w", jobrepo.ErrJobNotFound), http.StatusNotFound},
+		{"export", jobsvc.ErrNotReversible, http.StatusConflict},
+		{"running", jobsvc.ErrJobRunning, http.StatusConflict},
+		{"expired", jobsvc.ErrUndoExpired, http.StatusGone},
+		{"database error", fmt.Errorf("db down"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, jobID := uuid.New(), uuid.New()
+			req := withJobID(httptest.NewRequest(http.MethodPost, "/operations/"+jobID.String()+"/rollback", nil), userID, jobID)
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().RollbackJob(gomock.Any(), jobID, userID).Return(7, tc.err)
+
+			h.Rollback(w, req)
+
+			if w.Code != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Output(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, jobID := uuid.New(), uuid.New()
+	req := withJobID(httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String()+"/output", nil), userID, jobID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetOutput(gomock.Any(), jobID, userID).
+		Return(model.Job{ID: jobID, Kind: model.JobPDFExport, Output: []byte("%PDF-1.4"), OutputType: "application/pdf"}, nil)
+
+	h.Output(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/pdf" {
+		t.Errorf("expected application/pdf, got %q", ct)
+	}
+	if cd := w.Header().Get("Content-Disposition"); cd != "attachment; filename=pdf_export.pdf" {
+		t.Errorf("unexpected content disposition %q", cd)
+	}
+	if w.Body.String() != "%PDF-1.4" {
+		t.Errorf("unexpected body %q", w.Body.String())
+	}
+}
+
+func TestHandler_Output_NotReady(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, jobID := uuid.New(), uuid.New()
+	req := withJobID(httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String()+"/output", nil), userID, jobID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetOutput(gomock.Any(), jobID, userID).
+		Return(model.Job{}, fmt.Errorf("get job output: %w", jobrepo.ErrNoOutput))
+
+	h.Output(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,198 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+// List handles HTTP requests to list the most recent jobs of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	jobs, err := h.service.ListJobs(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list jobs", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewJobs(jobs))
+}
+
+// Get handles HTTP requests to read the status and progress of a job by its ID.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse job ID from URL parameter.
+	jobID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid job id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid job id"))
+		return
+	}
+
+	job, err := h.service.GetJob(r.Context(), jobID, userID)
+	if err != nil {
+		if errors.Is(err, jobrepo.ErrJobNotFound) {
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrJobNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get job", zap.String("job_id", jobID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewJob(job))
+}
+
+// Output handles HTTP requests to download the file produced by a completed job, e.g. a PDF agenda.
+// The response is 404 while the job has not completed or if it produced no file.
+func (h *Handler) Output(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse job ID from URL parameter.
+	jobID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid job id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid job id"))
+		return
+	}
+
+	job, err := h.service.GetOutput(r.Context(), jobID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobrepo.ErrJobNotFound):
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrJobNotFound)
+		case errors.Is(err, jobrepo.ErrNoOutput):
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrNoOutput)
+		default:
+			h.logger.Error("failed to get job output", zap.String("job_id", jobID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	filename := job.Kind
+	if exts, _ := mime.ExtensionsByType(job.OutputType); len(exts) > 0 {
+		filename += exts[0]
+	}
+
+	w.Header().Set("Content-Type", job.OutputType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(job.Output)
+}
+
+// Cancel handles HTTP requests to cancel a job by its ID.
+// A queued job is cancelled right away; a running job stops shortly after, which can be
+// observed with Get. Cancelling a finished job is a conflict.
+func (h *Handler) Cancel(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse job ID from URL parameter.
+	jobID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid job id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid job id"))
+		return
+	}
+
+	job, err := h.service.CancelJob(r.Context(), jobID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobrepo.ErrJobNotFound):
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrJobNotFound)
+		case errors.Is(err, jobrepo.ErrJobFinished):
+			response.Fail(w, http.StatusConflict, jobrepo.ErrJobFinished)
+		default:
+			h.logger.Error("failed to cancel job", zap.String("job_id", jobID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	response.OK(w, dto.NewJob(job))
+}
+
+// Rollback handles HTTP requests to undo a bulk operation, such as a calendar import, by its job ID.
+// The events the operation created are deleted if its undo window has not passed yet.
+// Running operations and operations that created no events are a conflict; an expired window is 410 Gone.
+func (h *Handler) Rollback(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse job ID from URL parameter.
+	jobID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid operation id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid operation id"))
+		return
+	}
+
+	deleted, err := h.service.RollbackJob(r.Context(), jobID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobrepo.ErrJobNotFound):
+			response.Fail(w, http.StatusNotFound, jobrepo.ErrJobNotFound)
+		case errors.Is(err, jobsvc.ErrNotReversible):
+			response.Fail(w, http.StatusConflict, jobsvc.ErrNotReversible)
+		case errors.Is(err, jobsvc.ErrJobRunning):
+			response.Fail(w, http.StatusConflict, jobsvc.ErrJobRunning)
+		case errors.Is(err, jobsvc.ErrUndoExpired):
+			response.Fail(w, http.StatusGone, jobsvc.ErrUndoExpired)
+		default:
+			h.logger.Error("failed to roll back job", zap.String("job_id", jobID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	response.OK(w, map[string]int{"deleted": deleted})
+}
@@ -0,0 +1,58 @@
+package machine
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/machine/mock_machine.go -package=mocks
+
+// tokenIssuer defines the interface for issuing machine tokens to registered clients.
+type tokenIssuer interface {
+	// IssueToken authenticates a client by its ID and secret and issues a machine token for the requested scopes.
+	IssueToken(clientID, secret string, scopes []string) (model.MachineToken, error)
+}
+
+// eventService defines the interface for reading the events of any user.
+type eventService interface {
+	// GetEventsInRange retrieves the events of a user from one instant up to, but not including, another.
+	GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error)
+}
+
+// reminderDispatcher defines the interface for triggering the delivery of due reminders.
+type reminderDispatcher interface {
+	// Poll claims a batch of due reminders right away and sends them in the background.
+	Poll(ctx context.Context)
+}
+
+// Handler manages HTTP requests of internal services authenticated by machine tokens.
+type Handler struct {
+	tokens    tokenIssuer        // tokens issues machine tokens to registered clients
+	events    eventService       // events reads the events of users
+	reminders reminderDispatcher // reminders triggers the delivery of due reminders
+	logger    *zap.Logger        // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - tokens: The issuer of machine tokens.
+//   - events: The event service reading the events of users.
+//   - reminders: The reminder worker of this instance.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(tokens tokenIssuer, events eventService, reminders reminderDispatcher, l *zap.Logger) *Handler {
+	return &Handler{
+		tokens:    tokens,
+		events:    events,
+		reminders: reminders,
+		logger:    l,
+	}
+}
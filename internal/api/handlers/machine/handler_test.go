@@ -0,0 +1,149 @@
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	mocksmachine "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/machine"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	machinesvc "github.com/aliskhannn/calendar-service/internal/service/machine"
+)
+
+type mocks struct {
+	tokens    *mocksmachine.MocktokenIssuer
+	events    *mocksmachine.MockeventService
+	reminders *mocksmachine.MockreminderDispatcher
+}
+
+func setupHandler(t *testing.T) (*gomock.Controller, mocks, *Handler) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		tokens:    mocksmachine.NewMocktokenIssuer(ctrl),
+		events:    mocksmachine.NewMockeventService(ctrl),
+		reminders: mocksmachine.NewMockreminderDispatcher(ctrl),
+	}
+	logger, _ := zap.NewDevelopment()
+	return ctrl, m, New(m.tokens, m.events, m.reminders, logger)
+}
+
+func tokenRequest(form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestHandler_Token_BasicAuth(t *testing.T) {
+	ctrl, m, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := tokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {"events:read"}})
+	req.SetBasicAuth("notifier", "s3cret")
+	w := httptest.NewRecorder()
+
+	m.tokens.EXPECT().
+		IssueToken("notifier", "s3cret", []string{model.ScopeEventsRead}).
+		Return(model.MachineToken{Token: "tok", Scopes: []string{model.ScopeEventsRead}, ExpiresIn: time.Hour}, nil)
+
+	h.Token(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			AccessToken string `json:"access_token"`
+			TokenType   string `json:"token_type"`
+			ExpiresIn   int    `json:"expires_in"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.AccessToken != "tok" || resp.Result.TokenType != "Bearer" || resp.Result.ExpiresIn != 3600 {
+		t.Fatalf("unexpected token response %+v", resp.Result)
+	}
+}
+
+func TestHandler_Token_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+		err  error
+		want int
+	}{
+		{"unsupported grant", url.Values{"grant_type": {"password"}}, nil, http.StatusBadRequest},
+		{"invalid client", url.Values{"grant_type": {"client_credentials"}, "client_id": {"notifier"}, "client_secret": {"guess"}},
+			machinesvc.ErrInvalidClient, http.StatusUnauthorized},
+		{"invalid scope", url.Values{"grant_type": {"client_credentials"}, "client_id": {"notifier"}, "scope": {"events:write"}},
+			machinesvc.ErrInvalidScope, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, m, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			if tt.err != nil {
+				m.tokens.EXPECT().IssueToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.MachineToken{}, tt.err)
+			}
+
+			w := httptest.NewRecorder()
+			h.Token(w, tokenRequest(tt.form))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_UserEvents(t *testing.T) {
+	ctrl, m, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	req := httptest.NewRequest(http.MethodGet, "/internal/users/"+userID.String()+"/events?from=2030-01-01T00:00:00Z&to=2030-01-08T00:00:00Z", nil)
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", userID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	w := httptest.NewRecorder()
+
+	m.events.EXPECT().GetEventsInRange(gomock.Any(), userID, from, to).Return(nil, fmt.Errorf("db down"))
+
+	h.UserEvents(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestHandler_DispatchReminders(t *testing.T) {
+	ctrl, m, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	m.reminders.EXPECT().Poll(gomock.Any())
+
+	w := httptest.NewRecorder()
+	h.DispatchReminders(w, httptest.NewRequest(http.MethodPost, "/internal/reminders/dispatch", nil))
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d", http.StatusAccepted, w.Code)
+	}
+}
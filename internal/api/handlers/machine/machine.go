@@ -0,0 +1,99 @@
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	machinesvc "github.com/aliskhannn/calendar-service/internal/service/machine"
+)
+
+// Token handles client credentials requests of internal services for machine tokens.
+// The form-encoded body carries grant_type=client_credentials, the client credentials unless they are
+// sent with HTTP Basic authentication, and optionally scope, the space-separated scopes to request.
+func (h *Handler) Token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if grant := r.PostForm.Get("grant_type"); grant != "client_credentials" {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("unsupported grant type %q", grant))
+		return
+	}
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	token, err := h.tokens.IssueToken(clientID, secret, strings.Fields(r.PostForm.Get("scope")))
+	if err != nil {
+		switch {
+		case errors.Is(err, machinesvc.ErrInvalidClient):
+			h.logger.Warn("invalid machine client credentials", zap.String("client_id", clientID))
+			response.Fail(w, http.StatusUnauthorized, machinesvc.ErrInvalidClient)
+		case errors.Is(err, machinesvc.ErrInvalidScope):
+			response.Fail(w, http.StatusBadRequest, machinesvc.ErrInvalidScope)
+		default:
+			h.logger.Error("failed to issue machine token", zap.String("client_id", clientID), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("issued machine token", zap.String("client_id", clientID), zap.Strings("scopes", token.Scopes))
+	response.OK(w, dto.NewMachineToken(token))
+}
+
+// UserEvents handles requests of internal services for the events of a user.
+// The from and to query parameters (RFC 3339) are required; to is exclusive.
+func (h *Handler) UserEvents(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid user id"))
+		return
+	}
+
+	q := r.URL.Query()
+	from, err := time.Parse(time.RFC3339, q.Get("from"))
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing from time"))
+		return
+	}
+	to, err := time.Parse(time.RFC3339, q.Get("to"))
+	if err != nil || !to.After(from) {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid or missing to time"))
+		return
+	}
+
+	events, err := h.events.GetEventsInRange(r.Context(), userID, from, to)
+	if err != nil {
+		h.logger.Error("failed to get events for machine client",
+			zap.Any("client_id", r.Context().Value(middlewares.ClientIDKey)),
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewEvents(events, time.Now()))
+}
+
+// DispatchReminders handles requests of internal services to deliver due reminders right away.
+// The reminders are sent in the background, so the request is answered with 202 Accepted.
+func (h *Handler) DispatchReminders(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("reminder dispatch triggered", zap.Any("client_id", r.Context().Value(middlewares.ClientIDKey)))
+	h.reminders.Poll(r.Context())
+	response.Accepted(w, "reminder dispatch started")
+}
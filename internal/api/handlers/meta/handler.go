@@ -0,0 +1,65 @@
+package meta
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/changelog"
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// dateLayout is the layout of changelog dates.
+const dateLayout = "2006-01-02"
+
+// prefixes lists the major versions of the API; both are served from the same routes.
+var prefixes = []dto.APIPrefix{
+	{Name: "v1", Prefix: "/api"},
+	{Name: "v2", Prefix: "/api/v2"}, // lists in a {items, next_cursor, total} envelope
+}
+
+// Handler serves the version and changelog of the API, so clients can detect changes and deprecations.
+type Handler struct {
+	build  model.BuildInfo // build information of the running binary
+	logger *zap.Logger     // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the given build information and logger.
+//
+// Parameters:
+//   - build: The build information of the running binary.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(build model.BuildInfo, l *zap.Logger) *Handler {
+	return &Handler{
+		build:  build,
+		logger: l,
+	}
+}
+
+// Version handles HTTP requests for the API version, its major versions and the build of the service.
+func (h *Handler) Version(w http.ResponseWriter, r *http.Request) {
+	response.OK(w, dto.NewVersion(changelog.Version(), prefixes, h.build))
+}
+
+// Changelog handles HTTP requests for the changelog of the API and the deprecations still in effect.
+// An optional "since" query parameter, YYYY-MM-DD, limits the entries to the releases after that date,
+// e.g. the API version a client was written against; deprecations are always returned in full.
+func (h *Handler) Changelog(w http.ResponseWriter, r *http.Request) {
+	since := r.URL.Query().Get("since")
+	if since != "" {
+		if _, err := time.Parse(dateLayout, since); err != nil {
+			h.logger.Warn("invalid changelog date", zap.String("since", since), zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid since date, expected YYYY-MM-DD"))
+			return
+		}
+	}
+
+	response.OK(w, dto.NewChangelog(changelog.Version(), changelog.Entries(since), changelog.Deprecations()))
+}
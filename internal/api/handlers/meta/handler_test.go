@@ -0,0 +1,72 @@
+package meta
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/changelog"
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestHandler_Version(t *testing.T) {
+	h := New(model.BuildInfo{Commit: "abc123", BuildTime: "2025-10-16T09:00:00Z", GoVersion: "go1.24.0"}, zap.NewNop())
+
+	w := httptest.NewRecorder()
+	h.Version(w, httptest.NewRequest(http.MethodGet, "/api/meta/version", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result dto.Version `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.APIVersion != changelog.Version() || resp.Result.Build.Commit != "abc123" || len(resp.Result.Versions) != 2 {
+		t.Fatalf("unexpected version %+v", resp.Result)
+	}
+}
+
+func TestHandler_Changelog(t *testing.T) {
+	h := New(model.BuildInfo{}, zap.NewNop())
+
+	w := httptest.NewRecorder()
+	h.Changelog(w, httptest.NewRequest(http.MethodGet, "/api/meta/changelog?since=2025-10-14", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result dto.Changelog `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	for _, e := range resp.Result.Entries {
+		if e.Date <= "2025-10-14" {
+			t.Fatalf("expected only entries after 2025-10-14, got %s", e.Date)
+		}
+	}
+	if len(resp.Result.Deprecations) == 0 {
+		t.Fatalf("expected deprecations regardless of since")
+	}
+}
+
+func TestHandler_Changelog_InvalidSince(t *testing.T) {
+	h := New(model.BuildInfo{}, zap.NewNop())
+
+	w := httptest.NewRecorder()
+	h.Changelog(w, httptest.NewRequest(http.MethodGet, "/api/meta/changelog?since=yesterday", nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
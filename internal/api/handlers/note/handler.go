@@ -0,0 +1,49 @@
+package note
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/note/mock_note_service.go -package=mocks
+
+// noteService defines the interface for the private notes of users on events.
+type noteService interface {
+	// GetNote retrieves the note of a user on an event.
+	GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error)
+
+	// SetNote writes the note of a user on an event they can see.
+	SetNote(ctx context.Context, n model.Note) (model.Note, error)
+
+	// DeleteNote deletes the note of a user on an event.
+	DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error
+}
+
+// Handler manages HTTP requests for private notes on events.
+type Handler struct {
+	service   noteService         // service handles business logic for notes
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The note service for managing notes.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s noteService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,110 @@
+package note
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mocksnotesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/note"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	noterepo "github.com/aliskhannn/calendar-service/internal/repository/note"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksnotesvc.MocknoteService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksnotesvc.NewMocknoteService(ctrl)
+	handler := New(mockService, zap.NewNop(), validator.New())
+	return ctrl, mockService, handler
+}
+
+// newRequest builds a request of the authenticated user for the note on the given event.
+func newRequest(method string, userID uuid.UUID, eventID string, body string) *http.Request {
+	req := httptest.NewRequest(method, "/events/"+eventID+"/note", bytes.NewBufferString(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", eventID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Set(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"written":      {want: http.StatusOK},
+		"not visible":  {err: fmt.Errorf("set note: %w", noterepo.ErrEventNotFound), want: http.StatusNotFound},
+		"server error": {err: fmt.Errorf("set note: db down"), want: http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			w := httptest.NewRecorder()
+
+			n := model.Note{EventID: eventID, UserID: userID, Text: "Bring the slides"}
+			mockService.EXPECT().SetNote(gomock.Any(), n).Return(n, tt.err)
+
+			h.Set(w, newRequest(http.MethodPut, userID, eventID.String(), `{"text":"Bring the slides"}`))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Set_InvalidRequest(t *testing.T) {
+	_, _, h := setupHandler(t)
+
+	for _, body := range []string{`{"text":""}`, `{"text":"` + strings.Repeat("a", 5001) + `"}`, `not json`} {
+		w := httptest.NewRecorder()
+
+		h.Set(w, newRequest(http.MethodPut, uuid.New(), uuid.New().String(), body))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().GetNote(gomock.Any(), eventID, userID).Return(model.Note{}, fmt.Errorf("get note: %w", noterepo.ErrNoteNotFound))
+
+	h.Get(w, newRequest(http.MethodGet, userID, eventID.String(), ""))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Delete_InvalidEventID(t *testing.T) {
+	_, _, h := setupHandler(t)
+	w := httptest.NewRecorder()
+
+	h.Delete(w, newRequest(http.MethodDelete, uuid.New(), "not-a-uuid", ""))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,136 @@
+package note
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	noterepo "github.com/aliskhannn/calendar-service/internal/repository/note"
+)
+
+// NoteRequest represents the payload for writing a private note on an event.
+type NoteRequest struct {
+	Text string `json:"text" validate:"required,max=5000"` // text of the note, at most 5000 characters
+}
+
+// Get handles HTTP requests to read the private note of the authenticated user on an event.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := h.service.GetNote(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, noterepo.ErrNoteNotFound) {
+			response.Fail(w, http.StatusNotFound, noterepo.ErrNoteNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get note",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, n)
+}
+
+// Set handles HTTP requests to write the private note of the authenticated user on an event
+// they own, are invited to or follow, replacing their previous note on it.
+func (h *Handler) Set(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req NoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	n, err := h.service.SetNote(r.Context(), model.Note{EventID: eventID, UserID: userID, Text: req.Text})
+	if err != nil {
+		if errors.Is(err, noterepo.ErrEventNotFound) {
+			response.Fail(w, http.StatusNotFound, noterepo.ErrEventNotFound)
+			return
+		}
+
+		h.logger.Error("failed to set note",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, n)
+}
+
+// Delete handles HTTP requests to delete the private note of the authenticated user on an event.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteNote(r.Context(), eventID, userID); err != nil {
+		if errors.Is(err, noterepo.ErrNoteNotFound) {
+			response.Fail(w, http.StatusNotFound, noterepo.ErrNoteNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete note",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "note deleted")
+}
+
+// parseRequest extracts the authenticated user and the event ID of the URL.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, eventID, true
+}
@@ -0,0 +1,133 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/notification/mock_notification_service.go -package=mocks
+
+const (
+	defaultLogLimit = 50  // entries returned when no limit is given
+	maxLogLimit     = 500 // largest accepted limit
+)
+
+// notificationService defines the interface for notification log operations.
+type notificationService interface {
+	// RecordFeedback stores feedback entries and returns the number of dropped ones.
+	RecordFeedback(ctx context.Context, entries []model.NotificationLogEntry) (int, error)
+
+	// ListEntries retrieves a page of the entries of the notification log, newest first.
+	ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error)
+
+	// CountEntries counts the entries of the notification log, exactly or estimated.
+	CountEntries(ctx context.Context, recipient string, mode string) (int, error)
+}
+
+// feedbackSource defines the parsing of bounce and complaint webhooks of the email provider.
+type feedbackSource interface {
+	// Name returns the name of the provider.
+	Name() string
+
+	// Feedback verifies a webhook request and returns the feedback it reports.
+	Feedback(r *http.Request) ([]model.NotificationLogEntry, error)
+}
+
+// Handler handles the bounce and complaint webhook of the email provider and lists the notification log.
+type Handler struct {
+	service  notificationService // service records and lists the notification log
+	provider feedbackSource      // email provider parsing its webhooks
+	logger   *zap.Logger         // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the given notification service, email provider and logger.
+//
+// Parameters:
+//   - s: The notification service.
+//   - p: The configured email provider.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s notificationService, p feedbackSource, l *zap.Logger) *Handler {
+	return &Handler{
+		service:  s,
+		provider: p,
+		logger:   l,
+	}
+}
+
+// Webhook handles bounce and complaint notifications of the email provider.
+// Requests are authenticated with the provider's signature scheme instead of a user token.
+func (h *Handler) Webhook(w http.ResponseWriter, r *http.Request) {
+	entries, err := h.provider.Feedback(r)
+	if err != nil {
+		switch {
+		case errors.Is(err, email.ErrWebhookUnsupported):
+			response.Fail(w, http.StatusNotFound, err)
+		case errors.Is(err, email.ErrInvalidSignature):
+			h.logger.Warn("rejected email webhook", zap.String("provider", h.provider.Name()), zap.Error(err))
+			response.Fail(w, http.StatusUnauthorized, err)
+		default:
+			h.logger.Warn("invalid email webhook", zap.String("provider", h.provider.Name()), zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid webhook payload"))
+		}
+		return
+	}
+
+	dropped, err := h.service.RecordFeedback(r.Context(), entries)
+	if err != nil {
+		h.logger.Error("failed to record email feedback", zap.String("provider", h.provider.Name()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+	if dropped > 0 {
+		h.logger.Warn("dropped email feedback without a known tenant",
+			zap.String("provider", h.provider.Name()),
+			zap.Int("dropped", dropped),
+		)
+	}
+
+	response.OK(w, map[string]int{"recorded": len(entries) - dropped})
+}
+
+// List handles HTTP requests to list the notification log, newest first.
+// The optional "recipient" query parameter filters by email address and "limit" caps the number of entries;
+// under /api/v2 the log is paginated with the "cursor", "limit" and "total" query parameters.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := response.ParseLimitedPage(r, defaultLogLimit, maxLogLimit)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	recipient := r.URL.Query().Get("recipient")
+	entries, err := h.service.ListEntries(r.Context(), recipient, page)
+	if err != nil {
+		h.logger.Error("failed to list notification log", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n, err := h.service.CountEntries(r.Context(), recipient, page.Total)
+		if err != nil {
+			h.logger.Error("failed to count notification log", zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		total = &n
+	}
+
+	response.WritePage(w, r, page, dto.NewNotificationLog(entries), total)
+}
@@ -0,0 +1,150 @@
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksnotification "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/notification"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestHandler(t *testing.T) (*Handler, *mocksnotification.MocknotificationService, *mocksnotification.MockfeedbackSource) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockService := mocksnotification.NewMocknotificationService(ctrl)
+	mockProvider := mocksnotification.NewMockfeedbackSource(ctrl)
+	mockProvider.EXPECT().Name().Return("ses").AnyTimes()
+
+	return New(mockService, mockProvider, zap.NewNop()), mockService, mockProvider
+}
+
+func TestHandler_Webhook(t *testing.T) {
+	h, mockService, mockProvider := newTestHandler(t)
+
+	entries := []model.NotificationLogEntry{
+		{Tenant: "acme", Type: model.FeedbackBounce, Recipient: "gone@example.com"},
+		{Type: model.FeedbackComplaint, Recipient: "angry@example.com"},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/email", nil)
+	w := httptest.NewRecorder()
+
+	mockProvider.EXPECT().Feedback(req).Return(entries, nil)
+	mockService.EXPECT().RecordFeedback(gomock.Any(), entries).Return(1, nil)
+
+	h.Webhook(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			Recorded int `json:"recorded"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Recorded != 1 {
+		t.Fatalf("expected 1 recorded entry, got %d", resp.Result.Recorded)
+	}
+}
+
+func TestHandler_Webhook_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"unsupported", email.ErrWebhookUnsupported, http.StatusNotFound},
+		{"invalid signature", email.ErrInvalidSignature, http.StatusUnauthorized},
+		{"malformed", errors.New("decode sns message: unexpected end of JSON input"), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _, mockProvider := newTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/email", nil)
+			w := httptest.NewRecorder()
+
+			mockProvider.EXPECT().Feedback(req).Return(nil, tt.err)
+
+			h.Webhook(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Webhook_RecordError(t *testing.T) {
+	h, mockService, mockProvider := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/email", nil)
+	w := httptest.NewRecorder()
+
+	mockProvider.EXPECT().Feedback(req).Return([]model.NotificationLogEntry{{Recipient: "gone@example.com"}}, nil)
+	mockService.EXPECT().RecordFeedback(gomock.Any(), gomock.Any()).Return(0, errors.New("connection refused"))
+
+	h.Webhook(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	h, mockService, _ := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/notifications?recipient=gone@example.com&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ListEntries(gomock.Any(), "gone@example.com", model.Page{Limit: 10}).
+		Return([]model.NotificationLogEntry{{ID: uuid.New(), Provider: "ses", Type: model.FeedbackBounce, Recipient: "gone@example.com"}}, nil)
+
+	h.List(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []struct {
+			Type      string `json:"type"`
+			Recipient string `json:"recipient"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || resp.Result[0].Type != model.FeedbackBounce {
+		t.Fatalf("unexpected response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_List_InvalidLimit(t *testing.T) {
+	h, _, _ := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/notifications?limit=1000", nil)
+	w := httptest.NewRecorder()
+
+	h.List(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,47 @@
+package onboarding
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/onboarding/mock_onboarding_service.go -package=mocks
+
+// onboardingService defines the interface for onboarding new users.
+type onboardingService interface {
+	// Seed creates the sample data of a new user, scheduled in the given time zone.
+	Seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error)
+
+	// Status retrieves the progress of a user through the guided setup.
+	Status(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error)
+}
+
+// Handler manages HTTP requests for the onboarding of the authenticated user.
+type Handler struct {
+	service   onboardingService   // service creates sample data and tracks the guided setup
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The onboarding service.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s onboardingService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
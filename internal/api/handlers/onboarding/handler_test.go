@@ -0,0 +1,139 @@
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksonboardingsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/onboarding"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	onboardingsvc "github.com/aliskhannn/calendar-service/internal/service/onboarding"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksonboardingsvc.MockonboardingService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksonboardingsvc.NewMockonboardingService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func newRequest(method, body string, userID uuid.UUID) *http.Request {
+	req := httptest.NewRequest(method, "/user/onboarding", strings.NewReader(body))
+	return req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+}
+
+func TestHandler_Seed_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		Seed(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+			if loc.String() != "Europe/Berlin" {
+				t.Fatalf("expected the requested time zone, got %s", loc)
+			}
+			return model.OnboardingSeed{ProjectID: uuid.New()}, nil
+		})
+
+	h.Seed(w, newRequest(http.MethodPost, `{"timezone":"Europe/Berlin"}`, userID))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Seed_EmptyBodyUsesUTC(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().Seed(gomock.Any(), userID, time.UTC).Return(model.OnboardingSeed{}, nil)
+
+	h.Seed(w, newRequest(http.MethodPost, "", userID))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Seed_InvalidTimezone(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.Seed(w, newRequest(http.MethodPost, `{"timezone":"Mars/Olympus"}`, uuid.New()))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Seed_AlreadySeeded(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	mockService.EXPECT().Seed(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.OnboardingSeed{}, onboardingsvc.ErrAlreadySeeded)
+
+	h.Seed(w, newRequest(http.MethodPost, "", uuid.New()))
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestHandler_Status(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().Status(gomock.Any(), userID).Return(model.OnboardingStatus{
+		Steps: []model.OnboardingStep{
+			{Name: model.StepCreateProject, Completed: true},
+			{Name: model.StepCreateEvent},
+		},
+	}, nil)
+
+	h.Status(w, newRequest(http.MethodGet, "", userID))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); !strings.Contains(body, `"completed":1`) || !strings.Contains(body, `"seeded":false`) {
+		t.Fatalf("unexpected body: %s", body)
+	}
+}
+
+func TestHandler_Status_Error(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	mockService.EXPECT().Status(gomock.Any(), gomock.Any()).Return(model.OnboardingStatus{}, fmt.Errorf("onboarding status: %w", errors.New("db error")))
+
+	h.Status(w, newRequest(http.MethodGet, "", uuid.New()))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
@@ -0,0 +1,92 @@
+package onboarding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	onboardingsvc "github.com/aliskhannn/calendar-service/internal/service/onboarding"
+)
+
+// SeedRequest represents the optional payload for creating the sample data.
+type SeedRequest struct {
+	Timezone string `json:"timezone" validate:"omitempty,timezone"` // IANA time zone the example events are scheduled in; UTC if empty
+}
+
+// Seed handles HTTP requests to create the sample data of the authenticated user:
+// a starter project with example events and the default saved views. It succeeds only once per user.
+func (h *Handler) Seed(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// The body is optional.
+	var req SeedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	loc := time.UTC
+	if req.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(req.Timezone); err != nil {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("unknown time zone %q", req.Timezone))
+			return
+		}
+	}
+
+	seed, err := h.service.Seed(r.Context(), userID, loc)
+	if err != nil {
+		if errors.Is(err, onboardingsvc.ErrAlreadySeeded) {
+			response.Fail(w, http.StatusConflict, onboardingsvc.ErrAlreadySeeded)
+			return
+		}
+
+		h.logger.Error("failed to create sample data", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, seed)
+}
+
+// Status handles HTTP requests to get the authenticated user's progress through the guided setup.
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	status, err := h.service.Status(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get onboarding status", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewOnboardingStatus(status))
+}
@@ -0,0 +1,49 @@
+package preference
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/preference/mock_preference_service.go -package=mocks
+
+// preferenceService defines the interface for managing notification preferences.
+type preferenceService interface {
+	// Preferences retrieves the subscriptions of a user to every notification category.
+	Preferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error)
+
+	// SetSubscribed subscribes a user to a notification category or unsubscribes them from it.
+	SetSubscribed(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error)
+
+	// Unsubscribe unsubscribes the user of a signed unsubscribe token from its category.
+	Unsubscribe(ctx context.Context, token string) (model.Unsubscription, error)
+}
+
+// Handler manages HTTP requests for notification preferences and unsubscribe links.
+type Handler struct {
+	service   preferenceService   // service handles business logic for notification preferences
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The preference service for managing notification preferences.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s preferenceService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,140 @@
+package preference
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockspreferencesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/preference"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	preferencesvc "github.com/aliskhannn/calendar-service/internal/service/preference"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mockspreferencesvc.MockpreferenceService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mockspreferencesvc.NewMockpreferenceService(ctrl)
+	handler := New(mockService, zap.NewNop(), validator.New())
+	return ctrl, mockService, handler
+}
+
+func TestHandler_Unsubscribe(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"unsubscribed":  {want: http.StatusOK},
+		"invalid token": {err: fmt.Errorf("unsubscribe: %w", preferencesvc.ErrInvalidToken), want: http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			// Mail clients unsubscribe with a POST to the same link (RFC 8058).
+			req := httptest.NewRequest(http.MethodPost, "/unsubscribe?token=abc.def", nil)
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Unsubscribe(gomock.Any(), "abc.def").
+				Return(model.Unsubscription{
+					Category:    model.NotificationReminders,
+					Preferences: []model.NotificationPreference{{Category: model.NotificationReminders}},
+				}, tt.err)
+
+			h.Unsubscribe(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Unsubscribe_MissingToken(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.Unsubscribe(w, httptest.NewRequest(http.MethodGet, "/unsubscribe", nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Update(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		err  error
+		want int
+	}{
+		"resubscribed":       {body: `{"category": "reminders", "subscribed": true}`, want: http.StatusOK},
+		"missing subscribed": {body: `{"category": "reminders"}`, want: http.StatusBadRequest},
+		"unknown category": {
+			body: `{"category": "reminders", "subscribed": true}`,
+			err:  fmt.Errorf("%w: %q", preferencesvc.ErrUnknownCategory, "reminders"),
+			want: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPut, "/user/notifications/preferences", bytes.NewBufferString(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				SetSubscribed(gomock.Any(), userID, model.NotificationReminders, true).
+				Return(model.NotificationPreference{Category: model.NotificationReminders, Subscribed: true}, tt.err).
+				MaxTimes(1)
+
+			h.Update(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/user/notifications/preferences", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		Preferences(gomock.Any(), userID).
+		Return([]model.NotificationPreference{{Category: model.NotificationReminders, Subscribed: true}}, nil)
+
+	h.List(w, req)
+
+	var resp struct {
+		Result []model.NotificationPreference `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || !resp.Result[0].Subscribed {
+		t.Fatalf("unexpected preferences %+v", resp.Result)
+	}
+}
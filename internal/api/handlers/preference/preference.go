@@ -0,0 +1,114 @@
+package preference
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	preferencesvc "github.com/aliskhannn/calendar-service/internal/service/preference"
+)
+
+// UpdateRequest represents the payload for changing the subscription to a notification category.
+type UpdateRequest struct {
+	Category   string `json:"category" validate:"required"`   // notification category, e.g. reminders
+	Subscribed *bool  `json:"subscribed" validate:"required"` // whether notifications of the category are sent
+}
+
+// List handles HTTP requests to read the notification preferences of the authenticated user,
+// one entry per category, for rendering a preferences page.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	prefs, err := h.service.Preferences(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get notification preferences", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, prefs)
+}
+
+// Update handles HTTP requests to subscribe the authenticated user to a notification category
+// or unsubscribe them from it.
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	p, err := h.service.SetSubscribed(r.Context(), userID, req.Category, *req.Subscribed)
+	if err != nil {
+		if errors.Is(err, preferencesvc.ErrUnknownCategory) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to set notification preference", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("notification preference updated",
+		zap.String("user_id", userID.String()),
+		zap.String("category", p.Category),
+		zap.Bool("subscribed", p.Subscribed),
+	)
+	response.OK(w, p)
+}
+
+// Unsubscribe handles the unsubscribe links of notification emails, authenticated by their signed token.
+// It serves both GET, for links opened in a browser, and POST, for one-click unsubscription by mail clients
+// (RFC 8058). The response holds all preferences of the user, so the landing page can show them.
+func (h *Handler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("missing token"))
+		return
+	}
+
+	u, err := h.service.Unsubscribe(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, preferencesvc.ErrInvalidToken) {
+			h.logger.Warn("invalid unsubscribe token", zap.Error(err))
+			response.Fail(w, http.StatusBadRequest, preferencesvc.ErrInvalidToken)
+			return
+		}
+
+		h.logger.Error("failed to unsubscribe", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Info("unsubscribed through link", zap.String("category", u.Category))
+	response.OK(w, u)
+}
@@ -0,0 +1,58 @@
+package project
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/project/mock_project_service.go -package=mocks
+
+// projectService defines the interface for project-related operations.
+type projectService interface {
+	// CreateProject creates a new project and returns its ID.
+	CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error)
+
+	// ListProjects retrieves all projects of a user.
+	ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+
+	// DeleteProject deletes a project and its tasks, keeping its events.
+	DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error
+
+	// CreateTask adds a task to a project and returns its ID.
+	CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error)
+
+	// SetTaskDone marks a task of a project as completed or not completed.
+	SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error
+
+	// GetTimeline builds the timeline and progress of a project.
+	GetTimeline(ctx context.Context, projectID, userID uuid.UUID) (model.ProjectTimeline, error)
+}
+
+// Handler manages HTTP requests for projects, their tasks and timelines.
+type Handler struct {
+	service   projectService      // service handles business logic for projects
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The project service for handling project-related operations.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s projectService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
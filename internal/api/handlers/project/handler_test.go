@@ -0,0 +1,110 @@
+package project
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksprojectsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/project"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	projectrepo "github.com/aliskhannn/calendar-service/internal/repository/project"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksprojectsvc.MockprojectService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksprojectsvc.NewMockprojectService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func withProjectID(req *http.Request, userID, projectID uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", projectID.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Name: "Launch"})
+
+	req := httptest.NewRequest(http.MethodPost, "/projects", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateProject(gomock.Any(), model.Project{UserID: userID, Name: "Launch"}).
+		Return(uuid.New(), nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Timeline_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, projectID := uuid.New(), uuid.New()
+	req := withProjectID(httptest.NewRequest(http.MethodGet, "/projects/"+projectID.String()+"/timeline", nil), userID, projectID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetTimeline(gomock.Any(), projectID, userID).
+		Return(model.ProjectTimeline{Project: model.Project{ID: projectID, Name: "Launch"}, Progress: 0.25}, nil)
+
+	h.Timeline(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			Progress float64           `json:"progress"`
+			Items    []json.RawMessage `json:"items"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Progress != 0.25 || resp.Result.Items == nil {
+		t.Fatalf("unexpected response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_Timeline_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, projectID := uuid.New(), uuid.New()
+	req := withProjectID(httptest.NewRequest(http.MethodGet, "/projects/"+projectID.String()+"/timeline", nil), userID, projectID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		GetTimeline(gomock.Any(), projectID, userID).
+		Return(model.ProjectTimeline{}, projectrepo.ErrProjectNotFound)
+
+	h.Timeline(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,153 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	projectrepo "github.com/aliskhannn/calendar-service/internal/repository/project"
+)
+
+// CreateRequest represents the payload for creating a new project.
+type CreateRequest struct {
+	Name          string     `json:"name" validate:"required,min=3,max=255"` // name of the project
+	MilestoneDate *time.Time `json:"milestone_date"`                         // optional date the project is due
+}
+
+// Create handles HTTP requests to create a new project for the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	id, err := h.service.CreateProject(r.Context(), model.Project{
+		UserID:        userID,
+		Name:          req.Name,
+		MilestoneDate: req.MilestoneDate,
+	})
+	if err != nil {
+		h.logger.Error("failed to create project", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, id)
+}
+
+// List handles HTTP requests to list the projects of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	projects, err := h.service.ListProjects(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list projects", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewProjects(projects))
+}
+
+// Delete handles HTTP requests to delete a project by its ID. Events of the project are kept.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse project ID from URL parameter.
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid project id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid project id"))
+		return
+	}
+
+	if err := h.service.DeleteProject(r.Context(), projectID, userID); err != nil {
+		if errors.Is(err, projectrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusNotFound, projectrepo.ErrProjectNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete project", zap.String("project_id", projectID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "project deleted")
+}
+
+// Timeline handles HTTP requests to retrieve the Gantt-friendly timeline of a project,
+// including its events, tasks, milestone and overall progress.
+func (h *Handler) Timeline(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse project ID from URL parameter.
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid project id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid project id"))
+		return
+	}
+
+	timeline, err := h.service.GetTimeline(r.Context(), projectID, userID)
+	if err != nil {
+		if errors.Is(err, projectrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusNotFound, projectrepo.ErrProjectNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get project timeline", zap.String("project_id", projectID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewProjectTimeline(timeline))
+}
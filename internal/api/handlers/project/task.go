@@ -0,0 +1,128 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	projectrepo "github.com/aliskhannn/calendar-service/internal/repository/project"
+)
+
+// CreateTaskRequest represents the payload for adding a task to a project.
+type CreateTaskRequest struct {
+	Title   string     `json:"title" validate:"required,min=3,max=255"` // title of the task
+	DueDate *time.Time `json:"due_date"`                                // optional due date of the task
+}
+
+// UpdateTaskRequest represents the payload for completing or reopening a task.
+type UpdateTaskRequest struct {
+	Done bool `json:"done"` // whether the task is completed
+}
+
+// CreateTask handles HTTP requests to add a task to a project.
+func (h *Handler) CreateTask(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse project ID from URL parameter.
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid project id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid project id"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	id, err := h.service.CreateTask(r.Context(), model.ProjectTask{
+		ProjectID: projectID,
+		Title:     req.Title,
+		DueDate:   req.DueDate,
+	}, userID)
+	if err != nil {
+		if errors.Is(err, projectrepo.ErrProjectNotFound) {
+			response.Fail(w, http.StatusNotFound, projectrepo.ErrProjectNotFound)
+			return
+		}
+
+		h.logger.Error("failed to create task", zap.String("project_id", projectID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, id)
+}
+
+// UpdateTask handles HTTP requests to complete or reopen a task of a project.
+func (h *Handler) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse project and task IDs from URL parameters.
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid project id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid project id"))
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		h.logger.Warn("invalid task id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid task id"))
+		return
+	}
+
+	var req UpdateTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.service.SetTaskDone(r.Context(), projectID, taskID, userID, req.Done); err != nil {
+		if errors.Is(err, projectrepo.ErrTaskNotFound) {
+			response.Fail(w, http.StatusNotFound, projectrepo.ErrTaskNotFound)
+			return
+		}
+
+		h.logger.Error("failed to update task", zap.String("task_id", taskID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "task updated")
+}
@@ -0,0 +1,52 @@
+package proposal
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/proposal/mock_proposal_service.go -package=mocks
+
+// proposalService defines the interface for proposing new times for events and deciding on them.
+type proposalService interface {
+	// Propose proposes a new time for an event the user attends and notifies the owner.
+	Propose(ctx context.Context, p model.Proposal) (model.Proposal, error)
+
+	// ListProposals retrieves the proposals of an event visible to the user.
+	ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error)
+
+	// Accept accepts a pending proposal for an event of the owner and moves the event.
+	Accept(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error)
+
+	// Decline declines a pending proposal for an event of the owner.
+	Decline(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error)
+}
+
+// Handler manages HTTP requests for the time proposals of events.
+type Handler struct {
+	service   proposalService     // service handles business logic for proposals
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The proposal service for managing proposals.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s proposalService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,132 @@
+package proposal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksproposalsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/proposal"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	proposalrepo "github.com/aliskhannn/calendar-service/internal/repository/proposal"
+	proposalsvc "github.com/aliskhannn/calendar-service/internal/service/proposal"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksproposalsvc.MockproposalService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksproposalsvc.NewMockproposalService(ctrl)
+	handler := New(mockService, zap.NewNop(), validator.New())
+	return ctrl, mockService, handler
+}
+
+// newRequest builds a request of the authenticated user with the given URL parameters.
+func newRequest(method string, body []byte, userID uuid.UUID, params map[string]string) *http.Request {
+	req := httptest.NewRequest(method, "/events/"+params["id"]+"/proposals", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	for k, v := range params {
+		rc.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Propose(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"proposed":      {want: http.StatusCreated},
+		"not attending": {err: fmt.Errorf("propose time: %w", proposalrepo.ErrEventNotFound), want: http.StatusNotFound},
+		"invalid end":   {err: proposalsvc.ErrInvalidEnd, want: http.StatusBadRequest},
+		"recurring":     {err: proposalsvc.ErrRecurringEvent, want: http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID := uuid.New(), uuid.New()
+			date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+			body, _ := json.Marshal(ProposeRequest{EventDate: date})
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Propose(gomock.Any(), model.Proposal{EventID: eventID, UserID: userID, EventDate: date}).
+				Return(model.Proposal{ID: uuid.New(), Status: model.ProposalPending}, tt.err)
+
+			h.Propose(w, newRequest(http.MethodPost, body, userID, map[string]string{"id": eventID.String()}))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Propose_MissingDate(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.Propose(w, newRequest(http.MethodPost, []byte(`{}`), uuid.New(), map[string]string{"id": uuid.New().String()}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Accept(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"accepted":  {want: http.StatusOK},
+		"not found": {err: fmt.Errorf("accept proposal: %w", proposalrepo.ErrProposalNotFound), want: http.StatusNotFound},
+		"decided":   {err: fmt.Errorf("accept proposal: %w", proposalrepo.ErrProposalDecided), want: http.StatusConflict},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			userID, eventID, proposalID := uuid.New(), uuid.New(), uuid.New()
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Accept(gomock.Any(), eventID, proposalID, userID).
+				Return(model.Proposal{ID: proposalID, Status: model.ProposalAccepted}, tt.err)
+
+			h.Accept(w, newRequest(http.MethodPost, nil, userID, map[string]string{"id": eventID.String(), "proposalID": proposalID.String()}))
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Decline_InvalidProposalID(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.Decline(w, newRequest(http.MethodPost, nil, uuid.New(), map[string]string{"id": uuid.New().String(), "proposalID": "nope"}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,185 @@
+package proposal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	proposalrepo "github.com/aliskhannn/calendar-service/internal/repository/proposal"
+	proposalsvc "github.com/aliskhannn/calendar-service/internal/service/proposal"
+)
+
+// ProposeRequest represents the payload for proposing a new time for an event.
+type ProposeRequest struct {
+	EventDate time.Time  `json:"event_date" validate:"required"` // proposed start of the event
+	EndDate   *time.Time `json:"end_date"`                       // optional proposed end; the event keeps its duration without one
+}
+
+// Propose handles HTTP requests to propose a new time for an event the authenticated user attends.
+func (h *Handler) Propose(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Decode and validate request body.
+	var req ProposeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	p, err := h.service.Propose(r.Context(), model.Proposal{
+		EventID:   eventID,
+		UserID:    userID,
+		EventDate: req.EventDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, proposalrepo.ErrEventNotFound):
+			response.Fail(w, http.StatusNotFound, proposalrepo.ErrEventNotFound)
+		case errors.Is(err, proposalsvc.ErrInvalidEnd):
+			response.Fail(w, http.StatusBadRequest, proposalsvc.ErrInvalidEnd)
+		case errors.Is(err, proposalsvc.ErrRecurringEvent):
+			response.Fail(w, http.StatusBadRequest, proposalsvc.ErrRecurringEvent)
+		default:
+			h.logger.Error("failed to propose time",
+				zap.String("user_id", userID.String()),
+				zap.String("event_id", eventID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info("time proposed",
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("proposal_id", p.ID.String()),
+	)
+	response.Created(w, p)
+}
+
+// List handles HTTP requests to list the proposals of an event: all of them for its owner,
+// the authenticated user's own ones for an attendee.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	proposals, err := h.service.ListProposals(r.Context(), eventID, userID)
+	if err != nil {
+		h.logger.Error("failed to list proposals",
+			zap.String("user_id", userID.String()),
+			zap.String("event_id", eventID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	if proposals == nil {
+		proposals = []model.Proposal{}
+	}
+	response.List(w, r, proposals)
+}
+
+// Accept handles HTTP requests to accept a proposal for an event of the authenticated user.
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.service.Accept, "proposal accepted")
+}
+
+// Decline handles HTTP requests to decline a proposal for an event of the authenticated user.
+func (h *Handler) Decline(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.service.Decline, "proposal declined")
+}
+
+// decide records the decision of the owner on the proposal of the URL with the given service method.
+func (h *Handler) decide(
+	w http.ResponseWriter,
+	r *http.Request,
+	apply func(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error),
+	msg string,
+) {
+	userID, eventID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse proposal ID from URL parameter.
+	proposalID, err := uuid.Parse(chi.URLParam(r, "proposalID"))
+	if err != nil {
+		h.logger.Warn("invalid proposal id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid proposal id"))
+		return
+	}
+
+	p, err := apply(r.Context(), eventID, proposalID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, proposalrepo.ErrProposalNotFound):
+			response.Fail(w, http.StatusNotFound, proposalrepo.ErrProposalNotFound)
+		case errors.Is(err, proposalrepo.ErrEventNotFound):
+			response.Fail(w, http.StatusNotFound, proposalrepo.ErrEventNotFound)
+		case errors.Is(err, proposalrepo.ErrProposalDecided):
+			response.Fail(w, http.StatusConflict, proposalrepo.ErrProposalDecided)
+		default:
+			h.logger.Error("failed to decide on proposal",
+				zap.String("user_id", userID.String()),
+				zap.String("proposal_id", proposalID.String()),
+				zap.Error(err),
+			)
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	h.logger.Info(msg,
+		zap.String("user_id", userID.String()),
+		zap.String("event_id", eventID.String()),
+		zap.String("proposal_id", proposalID.String()),
+	)
+	response.OK(w, p)
+}
+
+// parseRequest extracts the authenticated user and the event ID of the URL.
+// It writes the error response and returns false if either is missing or invalid.
+func (h *Handler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, eventID, true
+}
@@ -0,0 +1,52 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/reminder/mock_reminder_service.go -package=mocks
+
+// reminderService defines the interface for the notification history of a user.
+type reminderService interface {
+	// ListHistory retrieves a page of the sent and failed reminders of a user.
+	ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error)
+
+	// CountHistory counts the sent and failed reminders of a user, exactly or estimated.
+	CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error)
+
+	// DeleteHistory deletes the notification history of a user and returns the number of deleted reminders.
+	DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// DeleteHistoryEntry deletes a sent or failed reminder of a user.
+	DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error
+
+	// SendTest sends a test notification through a channel; within the cooldown it returns the time left.
+	SendTest(ctx context.Context, userID uuid.UUID, channel string) (model.TestNotification, time.Duration, error)
+}
+
+// Handler manages HTTP requests for the notification history and test notifications of the authenticated user.
+type Handler struct {
+	service reminderService // service lists and deletes the notification history and sends test notifications
+	logger  *zap.Logger     // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The reminder service.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s reminderService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
@@ -0,0 +1,233 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksremindersvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/reminder"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	reminderrepo "github.com/aliskhannn/calendar-service/internal/repository/reminder"
+	remindersvc "github.com/aliskhannn/calendar-service/internal/service/reminder"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksremindersvc.MockreminderService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksremindersvc.NewMockreminderService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, New(mockService, logger)
+}
+
+func TestHandler_History(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/user/notifications/history?limit=10", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().ListHistory(gomock.Any(), userID, model.Page{Limit: 10}).Return([]model.ReminderHistoryEntry{
+		{ID: uuid.New(), Message: "Standup", RemindAt: time.Now(), Status: model.ReminderFailed, LastError: "smtp down"},
+	}, nil)
+
+	h.History(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"last_error":"smtp down"`) {
+		t.Fatalf("expected the entry in the response, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_History_Envelope(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/v2/user/notifications/history?limit=1&total=exact", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	page := model.Page{Limit: 1, Total: model.TotalExact}
+	mockService.EXPECT().ListHistory(gomock.Any(), userID, page).Return([]model.ReminderHistoryEntry{
+		{ID: uuid.New(), Message: "Standup", RemindAt: time.Now(), Status: model.ReminderSent},
+		{ID: uuid.New(), Message: "Review", RemindAt: time.Now(), Status: model.ReminderSent},
+	}, nil)
+	mockService.EXPECT().CountHistory(gomock.Any(), userID, model.TotalExact).Return(7, nil)
+
+	response.Envelope(http.HandlerFunc(h.History)).ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			Items      []map[string]interface{} `json:"items"`
+			NextCursor *string                  `json:"next_cursor"`
+			Total      *int                     `json:"total"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result.Items) != 1 || resp.Result.NextCursor == nil || resp.Result.Total == nil || *resp.Result.Total != 7 {
+		t.Fatalf("unexpected page: %s", w.Body.String())
+	}
+}
+
+func TestHandler_History_InvalidLimit(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/user/notifications/history?limit=1000", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.History(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_DeleteHistory(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/user/notifications/history", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().DeleteHistory(gomock.Any(), userID).Return(4, nil)
+
+	h.DeleteHistory(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"deleted":4`) {
+		t.Fatalf("expected the deleted count, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_DeleteHistoryEntry_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/user/notifications/history/"+id.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", id.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().DeleteHistoryEntry(gomock.Any(), id, gomock.Any()).Return(reminderrepo.ErrReminderNotFound)
+
+	h.DeleteHistoryEntry(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Test(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/notifications/test", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().SendTest(gomock.Any(), userID, model.ChannelEmail).
+		Return(model.TestNotification{Channel: model.ChannelEmail, Recipient: "jane@example.com"}, time.Duration(0), nil)
+
+	h.Test(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"recipient":"jane@example.com"`) {
+		t.Fatalf("expected the recipient in the response, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_Test_TooSoon(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodPost, "/notifications/test", strings.NewReader(`{"channel":"email"}`))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().SendTest(gomock.Any(), gomock.Any(), model.ChannelEmail).
+		Return(model.TestNotification{}, 1500*time.Millisecond, remindersvc.ErrTestTooSoon)
+
+	h.Test(w, req)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "2" {
+		t.Fatalf("expected Retry-After rounded up to 2, got %q", got)
+	}
+}
+
+func TestHandler_Test_Throttled(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodPost, "/notifications/test", strings.NewReader(`{"channel":"email"}`))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	throttled := &email.ThrottledError{Domain: "gmail.com", RetryAt: time.Now().Add(20 * time.Second)}
+	mockService.EXPECT().SendTest(gomock.Any(), gomock.Any(), model.ChannelEmail).
+		Return(model.TestNotification{}, time.Duration(0), fmt.Errorf("send test notification: %w", throttled))
+
+	h.Test(w, req)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Fatal("expected a Retry-After header")
+	}
+}
+
+func TestHandler_Test_UnsupportedChannel(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodPost, "/notifications/test", strings.NewReader(`{"channel":"telegram"}`))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().SendTest(gomock.Any(), gomock.Any(), "telegram").
+		Return(model.TestNotification{}, time.Duration(0), remindersvc.ErrUnsupportedChannel)
+
+	h.Test(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
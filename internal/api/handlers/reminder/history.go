@@ -0,0 +1,114 @@
+package reminder
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	reminderrepo "github.com/aliskhannn/calendar-service/internal/repository/reminder"
+)
+
+const (
+	defaultHistoryLimit = 50  // entries returned when no limit is given
+	maxHistoryLimit     = 200 // largest accepted limit
+)
+
+// History handles HTTP requests to list the authenticated user's notification history,
+// most recently due first. The optional "limit" query parameter caps the number of entries;
+// under /api/v2 the history is paginated with the "cursor", "limit" and "total" query parameters.
+func (h *Handler) History(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	page, err := response.ParseLimitedPage(r, defaultHistoryLimit, maxHistoryLimit)
+	if err != nil {
+		response.Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	entries, err := h.service.ListHistory(r.Context(), userID, page)
+	if err != nil {
+		h.logger.Error("failed to list notification history", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n, err := h.service.CountHistory(r.Context(), userID, page.Total)
+		if err != nil {
+			h.logger.Error("failed to count notification history", zap.String("user_id", userID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+			return
+		}
+		total = &n
+	}
+
+	response.WritePage(w, r, page, dto.NewNotificationHistory(entries), total)
+}
+
+// DeleteHistory handles HTTP requests to purge the authenticated user's notification history.
+// Pending reminders are not affected.
+func (h *Handler) DeleteHistory(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	deleted, err := h.service.DeleteHistory(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to delete notification history", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, map[string]int{"deleted": deleted})
+}
+
+// DeleteHistoryEntry handles HTTP requests to delete one entry of the authenticated user's notification history.
+func (h *Handler) DeleteHistoryEntry(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse entry ID from URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid history entry id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid history entry id"))
+		return
+	}
+
+	if err := h.service.DeleteHistoryEntry(r.Context(), id, userID); err != nil {
+		if errors.Is(err, reminderrepo.ErrReminderNotFound) {
+			response.Fail(w, http.StatusNotFound, fmt.Errorf("history entry not found"))
+			return
+		}
+
+		h.logger.Error("failed to delete notification history entry", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "history entry deleted")
+}
@@ -0,0 +1,72 @@
+package reminder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	remindersvc "github.com/aliskhannn/calendar-service/internal/service/reminder"
+)
+
+// TestRequest represents the optional payload for sending a test notification.
+type TestRequest struct {
+	Channel string `json:"channel"` // channel to send through; "email" if empty
+}
+
+// Test handles HTTP requests to immediately send a test notification with a sample reminder
+// to the authenticated user, so they can verify their notification setup.
+func (h *Handler) Test(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// The body is optional.
+	var req TestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+	if req.Channel == "" {
+		req.Channel = model.ChannelEmail
+	}
+
+	sent, wait, err := h.service.SendTest(r.Context(), userID, req.Channel)
+	if err != nil {
+		var throttled *email.ThrottledError
+		switch {
+		case errors.As(err, &throttled):
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(throttled.RetryAt).Seconds()))))
+			response.Fail(w, http.StatusTooManyRequests, fmt.Errorf("too many emails to %s, try again later", throttled.Domain))
+		case errors.Is(err, remindersvc.ErrUnsupportedChannel):
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("%w: %q", remindersvc.ErrUnsupportedChannel, req.Channel))
+		case errors.Is(err, remindersvc.ErrTestTooSoon):
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			response.Fail(w, http.StatusTooManyRequests, remindersvc.ErrTestTooSoon)
+		default:
+			// Delivery errors may reveal provider details, so they are only logged.
+			h.logger.Error("failed to send test notification", zap.String("user_id", userID.String()),
+				zap.String("channel", req.Channel), zap.Error(err))
+			response.Fail(w, http.StatusBadGateway, fmt.Errorf("failed to send test notification"))
+		}
+		return
+	}
+
+	response.OK(w, sent)
+}
@@ -0,0 +1,102 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	reportrepo "github.com/aliskhannn/calendar-service/internal/repository/report"
+)
+
+// defaultWeeks is the number of most recent weeks reported per member when the weeks parameter is omitted.
+const defaultWeeks = 4
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/report/mock_report_service.go -package=mocks
+
+// reportService defines the interface for reading meeting load reports.
+type reportService interface {
+	// GetMeetingReport retrieves the meeting load report of a group of the owner.
+	GetMeetingReport(ctx context.Context, groupID, ownerID uuid.UUID, weeks int) (model.MeetingReport, error)
+}
+
+// Handler handles HTTP requests for the reports of organizations. An organization is an attendee group:
+// its owner administers it and reads its reports, and its members are the people reported on.
+type Handler struct {
+	service reportService // service reads the aggregated reports
+	logger  *zap.Logger   // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the given report service and logger.
+//
+// Parameters:
+//   - s: The report service.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s reportService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
+
+// Meetings handles HTTP requests to read the meeting load report of an organization administered by the
+// authenticated user: per member and week, the meeting hours, the share of the workdays spent in meetings
+// and the meetings held after hours. The optional "weeks" parameter (1-52, default 4) limits the report to
+// the most recent weeks. It returns 404 if the user owns no attendee group with this ID.
+func (h *Handler) Meetings(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse organization ID from URL parameter.
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid org id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid org id"))
+		return
+	}
+
+	weeks := defaultWeeks
+	if v := r.URL.Query().Get("weeks"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 52 {
+			response.Fail(w, http.StatusBadRequest, fmt.Errorf("weeks must be between 1 and 52"))
+			return
+		}
+		weeks = n
+	}
+
+	report, err := h.service.GetMeetingReport(r.Context(), orgID, userID, weeks)
+	if err != nil {
+		if errors.Is(err, reportrepo.ErrGroupNotFound) {
+			response.Fail(w, http.StatusNotFound, fmt.Errorf("org not found"))
+			return
+		}
+
+		h.logger.Error("failed to get meeting report",
+			zap.String("org_id", orgID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewMeetingReport(report))
+}
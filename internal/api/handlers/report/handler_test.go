@@ -0,0 +1,116 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksreportsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/report"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	reportrepo "github.com/aliskhannn/calendar-service/internal/repository/report"
+)
+
+// newRequest builds a request for the meeting report of an organization by a user.
+func newRequest(orgID, userID uuid.UUID, query string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/orgs/"+orgID.String()+"/reports/meetings"+query, nil)
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", orgID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	return req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+}
+
+func TestHandler_Meetings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocksreportsvc.NewMockreportService(ctrl)
+	h := New(mockService, zap.NewNop())
+
+	orgID, userID := uuid.New(), uuid.New()
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	mockService.EXPECT().
+		GetMeetingReport(gomock.Any(), orgID, userID, 8).
+		Return(model.MeetingReport{
+			GroupID:      orgID,
+			WorkdayStart: 9*time.Hour + 30*time.Minute,
+			WorkdayEnd:   18 * time.Hour,
+			Members: []model.MemberMeetingLoad{{
+				UserID: uuid.New(),
+				Email:  "alice@example.com",
+				Weeks:  []model.MeetingLoadWeek{{Week: week, Meetings: 2, MeetingMinutes: 100, DayPercent: 4.2, AfterHours: 1}},
+			}},
+		}, nil)
+
+	w := httptest.NewRecorder()
+	h.Meetings(w, newRequest(orgID, userID, "?weeks=8"))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result dto.MeetingReport `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.WorkdayStart != "09:30" || resp.Result.WorkdayEnd != "18:00" {
+		t.Fatalf("expected the workday 09:30 to 18:00, got %s to %s", resp.Result.WorkdayStart, resp.Result.WorkdayEnd)
+	}
+	if len(resp.Result.Members) != 1 || len(resp.Result.Members[0].Weeks) != 1 {
+		t.Fatalf("expected one member with one week, got %+v", resp.Result.Members)
+	}
+	got := resp.Result.Members[0].Weeks[0]
+	if got.Week != "2025-10-20" || got.MeetingHours != 1.67 || got.DayPercent != 4.2 || got.AfterHours != 1 {
+		t.Fatalf("unexpected week %+v", got)
+	}
+}
+
+func TestHandler_Meetings_InvalidWeeks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := New(mocksreportsvc.NewMockreportService(ctrl), zap.NewNop())
+
+	for _, weeks := range []string{"0", "53", "two"} {
+		w := httptest.NewRecorder()
+		h.Meetings(w, newRequest(uuid.New(), uuid.New(), "?weeks="+weeks))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("weeks=%s: expected status %d, got %d", weeks, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandler_Meetings_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocksreportsvc.NewMockreportService(ctrl)
+	h := New(mockService, zap.NewNop())
+
+	orgID, userID := uuid.New(), uuid.New()
+	mockService.EXPECT().
+		GetMeetingReport(gomock.Any(), orgID, userID, defaultWeeks).
+		Return(model.MeetingReport{}, fmt.Errorf("get meeting report: %w", reportrepo.ErrGroupNotFound))
+
+	w := httptest.NewRecorder()
+	h.Meetings(w, newRequest(orgID, userID, ""))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
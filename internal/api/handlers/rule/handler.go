@@ -0,0 +1,56 @@
+package rule
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/rule/mock_rule_service.go -package=mocks
+
+// ruleService defines the interface for event rule operations.
+type ruleService interface {
+	// CreateRule saves a new rule and returns its ID.
+	CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error)
+
+	// ListRules retrieves all rules of a user in evaluation order.
+	ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error)
+
+	// UpdateRule replaces a rule of the specified user.
+	UpdateRule(ctx context.Context, rule model.EventRule) error
+
+	// DeleteRule deletes a rule of the specified user.
+	DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error
+
+	// Preview evaluates a rule against the existing events of a user without changing them.
+	Preview(ctx context.Context, rule model.EventRule, from, to *time.Time) ([]model.RulePreview, error)
+}
+
+// Handler manages HTTP requests for event rules and their previews.
+type Handler struct {
+	service   ruleService         // service handles business logic for rules
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The rule service for handling rule-related operations.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s ruleService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
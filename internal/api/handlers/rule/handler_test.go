@@ -0,0 +1,175 @@
+package rule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksrulesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/rule"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	rulerepo "github.com/aliskhannn/calendar-service/internal/repository/rule"
+	rulesvc "github.com/aliskhannn/calendar-service/internal/service/rule"
+	"github.com/aliskhannn/calendar-service/internal/validation"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksrulesvc.MockruleService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksrulesvc.NewMockruleService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validation.New(config.Validation{}))
+	return ctrl, mockService, handler
+}
+
+func withRuleID(req *http.Request, userID, ruleID uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", ruleID.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body := `{"name":"Standups","conditions":[{"field":"title","operator":"contains","value":"standup"}],"color":"blue","tags":["work"]}`
+	req := httptest.NewRequest(http.MethodPost, "/rules", bytes.NewBufferString(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateRule(gomock.Any(), model.EventRule{
+			UserID:     userID,
+			Name:       "Standups",
+			Conditions: []model.RuleCondition{{Field: "title", Operator: "contains", Value: "standup"}},
+			Color:      "blue",
+			Tags:       []string{"work"},
+			Enabled:    true,
+		}).
+		Return(uuid.New(), nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Create_Invalid(t *testing.T) {
+	bodies := map[string]string{
+		"unknown color":    `{"name":"r","conditions":[{"field":"title","operator":"contains","value":"x"}],"color":"chartreuse"}`,
+		"unknown operator": `{"name":"r","conditions":[{"field":"title","operator":"matches","value":"x"}],"color":"blue"}`,
+		"no conditions":    `{"name":"r","conditions":[],"color":"#1e90ff"}`,
+	}
+
+	for name, body := range bodies {
+		t.Run(name, func(t *testing.T) {
+			ctrl, _, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/rules", bytes.NewBufferString(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Create_NoAction(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body := `{"name":"r","conditions":[{"field":"title","operator":"contains","value":"x"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/rules", bytes.NewBufferString(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().CreateRule(gomock.Any(), gomock.Any()).Return(uuid.Nil, rulesvc.ErrNoAction)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Update_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, ruleID := uuid.New(), uuid.New()
+	body := `{"name":"Standups","conditions":[{"field":"title","operator":"contains","value":"standup"}],"color":"blue","enabled":false}`
+	req := withRuleID(httptest.NewRequest(http.MethodPut, "/rules/"+ruleID.String(), bytes.NewBufferString(body)), userID, ruleID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		UpdateRule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rule model.EventRule) error {
+			if rule.ID != ruleID || rule.UserID != userID || rule.Enabled {
+				t.Errorf("unexpected rule %+v", rule)
+			}
+			return fmt.Errorf("update rule: %w", rulerepo.ErrRuleNotFound)
+		})
+
+	h.Update(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Preview(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body := `{"conditions":[{"field":"title","operator":"contains","value":"standup"}],"tags":["work"]}`
+	req := httptest.NewRequest(http.MethodPost, "/rules/preview?from=2025-10-01&to=2025-10-31", bytes.NewBufferString(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().
+		Preview(gomock.Any(), gomock.Any(), &from, &to).
+		Return([]model.RulePreview{{Event: model.Event{ID: uuid.New(), Title: "Standup"}, Tags: []string{"work"}}}, nil)
+
+	h.Preview(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result []struct {
+			Event struct {
+				Title string `json:"title"`
+			} `json:"event"`
+			Tags []string `json:"tags"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Result) != 1 || resp.Result[0].Event.Title != "Standup" || len(resp.Result[0].Tags) != 1 {
+		t.Errorf("unexpected preview: %+v", resp.Result)
+	}
+}
@@ -0,0 +1,287 @@
+package rule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	rulerepo "github.com/aliskhannn/calendar-service/internal/repository/rule"
+	rulesvc "github.com/aliskhannn/calendar-service/internal/service/rule"
+)
+
+// ConditionRequest represents a single condition of a rule.
+type ConditionRequest struct {
+	Field    string `json:"field" validate:"required,oneof=title description priority"`     // event field to test
+	Operator string `json:"operator" validate:"required,oneof=contains equals starts_with"` // comparison, case-insensitive
+	Value    string `json:"value" validate:"required,max=255"`                              // value the field is compared with
+}
+
+// RuleRequest represents the payload for creating or replacing a rule.
+type RuleRequest struct {
+	Name       string             `json:"name" validate:"required,min=1,max=255"`           // name of the rule
+	Position   int                `json:"position" validate:"min=0"`                        // evaluation order, ascending
+	Conditions []ConditionRequest `json:"conditions" validate:"required,min=1,max=10,dive"` // conditions an event must all match
+	Color      string             `json:"color" validate:"omitempty,display_color"`         // color given to matching events
+	Tags       []string           `json:"tags" validate:"max=10,dive,min=1,max=32"`         // tags added to matching events
+	Enabled    *bool              `json:"enabled"`                                          // optional, defaults to true
+}
+
+// PreviewRequest represents the payload of a rule preview; the rule does not have to be saved.
+type PreviewRequest struct {
+	Conditions []ConditionRequest `json:"conditions" validate:"required,min=1,max=10,dive"` // conditions an event must all match
+	Color      string             `json:"color" validate:"omitempty,display_color"`         // color given to matching events
+	Tags       []string           `json:"tags" validate:"max=10,dive,min=1,max=32"`         // tags added to matching events
+}
+
+// Create handles HTTP requests to save a new rule for the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req RuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	id, err := h.service.CreateRule(r.Context(), toRule(req, userID))
+	if err != nil {
+		if isInvalid(err) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to create rule", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, id)
+}
+
+// List handles HTTP requests to list the rules of the authenticated user in evaluation order.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	rules, err := h.service.ListRules(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list rules", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewRules(rules))
+}
+
+// Update handles HTTP requests to replace a rule by its ID.
+// Events the rule has already colored or tagged are not changed.
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse rule ID from URL parameter.
+	ruleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid rule id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid rule id"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req RuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	rule := toRule(req, userID)
+	rule.ID = ruleID
+	if err := h.service.UpdateRule(r.Context(), rule); err != nil {
+		switch {
+		case errors.Is(err, rulerepo.ErrRuleNotFound):
+			response.Fail(w, http.StatusNotFound, rulerepo.ErrRuleNotFound)
+		case isInvalid(err):
+			response.Fail(w, http.StatusBadRequest, err)
+		default:
+			h.logger.Error("failed to update rule", zap.String("rule_id", ruleID.String()), zap.Error(err))
+			response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		}
+		return
+	}
+
+	response.OK(w, "rule updated")
+}
+
+// Delete handles HTTP requests to delete a rule by its ID.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse rule ID from URL parameter.
+	ruleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid rule id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid rule id"))
+		return
+	}
+
+	if err := h.service.DeleteRule(r.Context(), ruleID, userID); err != nil {
+		if errors.Is(err, rulerepo.ErrRuleNotFound) {
+			response.Fail(w, http.StatusNotFound, rulerepo.ErrRuleNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete rule", zap.String("rule_id", ruleID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "rule deleted")
+}
+
+// Preview handles HTTP requests to dry-run a rule against the existing events of the authenticated user.
+// The optional from and to query parameters (YYYY-MM-DD, to inclusive) limit the events evaluated;
+// by default the next 30 days are. Nothing is changed.
+func (h *Handler) Preview(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse the optional date range.
+	from, err := parseDate(r.URL.Query().Get("from"))
+	if err != nil {
+		h.logger.Warn("invalid from date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid from date"))
+		return
+	}
+	to, err := parseDate(r.URL.Query().Get("to"))
+	if err != nil {
+		h.logger.Warn("invalid to date", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid to date"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req PreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	rule := toRule(RuleRequest{Conditions: req.Conditions, Color: req.Color, Tags: req.Tags}, userID)
+	previews, err := h.service.Preview(r.Context(), rule, from, to)
+	if err != nil {
+		if isInvalid(err) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to preview rule", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewRulePreviews(previews, time.Now()))
+}
+
+// toRule converts a rule request of a user into a rule model.
+func toRule(req RuleRequest, userID uuid.UUID) model.EventRule {
+	rule := model.EventRule{
+		UserID:     userID,
+		Name:       req.Name,
+		Position:   req.Position,
+		Conditions: make([]model.RuleCondition, 0, len(req.Conditions)),
+		Color:      req.Color,
+		Tags:       req.Tags,
+		Enabled:    req.Enabled == nil || *req.Enabled,
+	}
+	for _, c := range req.Conditions {
+		rule.Conditions = append(rule.Conditions, model.RuleCondition(c))
+	}
+
+	return rule
+}
+
+// parseDate parses an optional YYYY-MM-DD query parameter; an empty value is nil.
+func parseDate(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	d, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// isInvalid reports whether err is caused by an invalid rule or preview range.
+func isInvalid(err error) bool {
+	return errors.Is(err, rulesvc.ErrNoAction) ||
+		errors.Is(err, rulesvc.ErrUnknownField) ||
+		errors.Is(err, rulesvc.ErrUnknownOperator) ||
+		errors.Is(err, rulesvc.ErrInvalidRange) ||
+		errors.Is(err, rulesvc.ErrRangeTooLong)
+}
@@ -0,0 +1,52 @@
+package shortlink
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/shortlink/mock_shortlink_service.go -package=mocks
+
+// shortLinkService defines the interface for short link operations.
+type shortLinkService interface {
+	// CreateShortLink creates a short link to an event with a new code.
+	CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error)
+
+	// ListShortLinks retrieves the short links of an event.
+	ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error)
+
+	// RevokeShortLink deletes a short link of an event.
+	RevokeShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error
+
+	// Resolve retrieves what a short link shows of its event.
+	Resolve(ctx context.Context, code string) (model.SharedEvent, error)
+}
+
+// Handler manages HTTP requests for short links and the events they share.
+type Handler struct {
+	service   shortLinkService    // service handles business logic for short links
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The short link service for handling short-link-related operations.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s shortLinkService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
@@ -0,0 +1,159 @@
+package shortlink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mocksshortlinksvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/shortlink"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	shortlinkrepo "github.com/aliskhannn/calendar-service/internal/repository/shortlink"
+	shortlinksvc "github.com/aliskhannn/calendar-service/internal/service/shortlink"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksshortlinksvc.MockshortLinkService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksshortlinksvc.NewMockshortLinkService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func withParams(req *http.Request, params map[string]string) *http.Request {
+	rc := chi.NewRouteContext()
+	for k, v := range params {
+		rc.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, eventID := uuid.New(), uuid.New()
+	body, _ := json.Marshal(CreateRequest{Visibility: model.ShortLinkBusy})
+
+	req := httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/shortlink", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	req = withParams(req, map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateShortLink(gomock.Any(), model.ShortLink{EventID: eventID, UserID: userID, Visibility: model.ShortLinkBusy}).
+		Return(model.ShortLink{Code: "Ab3dE9xY", EventID: eventID, Visibility: model.ShortLinkBusy}, nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"code":"Ab3dE9xY"`) {
+		t.Fatalf("expected the code in the response, got %s", w.Body.String())
+	}
+}
+
+func TestHandler_Create_EmptyBody(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/shortlink", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	req = withParams(req, map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().CreateShortLink(gomock.Any(), gomock.Any()).Return(model.ShortLink{}, shortlinkrepo.ErrEventNotFound)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandler_Create_InvalidVisibility(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Visibility: "everything"})
+
+	req := httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/shortlink", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	req = withParams(req, map[string]string{"id": eventID.String()})
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandler_Resolve(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"ok", nil, http.StatusOK},
+		{"revoked", fmt.Errorf("resolve short link: %w", shortlinkrepo.ErrShortLinkNotFound), http.StatusNotFound},
+		{"expired", shortlinksvc.ErrLinkExpired, http.StatusGone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, mockService, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			req := withParams(httptest.NewRequest(http.MethodGet, "/e/Ab3dE9xY", nil), map[string]string{"code": "Ab3dE9xY"})
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().Resolve(gomock.Any(), "Ab3dE9xY").
+				Return(model.SharedEvent{Visibility: model.ShortLinkBusy, EventDate: time.Now()}, tt.err)
+
+			h.Resolve(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+			if tt.err == nil && strings.Contains(w.Body.String(), `"title"`) {
+				t.Fatalf("expected no title for a busy link, got %s", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Revoke_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	eventID := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/events/"+eventID.String()+"/shortlinks/Ab3dE9xY", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	req = withParams(req, map[string]string{"id": eventID.String(), "code": "Ab3dE9xY"})
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().RevokeShortLink(gomock.Any(), "Ab3dE9xY", eventID, gomock.Any()).
+		Return(shortlinkrepo.ErrShortLinkNotFound)
+
+	h.Revoke(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
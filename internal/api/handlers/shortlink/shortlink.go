@@ -0,0 +1,175 @@
+package shortlink
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	shortlinkrepo "github.com/aliskhannn/calendar-service/internal/repository/shortlink"
+	shortlinksvc "github.com/aliskhannn/calendar-service/internal/service/shortlink"
+)
+
+// CreateRequest represents the payload for creating a short link to an event.
+// The body may be empty to create a basic link with the default expiry.
+type CreateRequest struct {
+	Visibility string     `json:"visibility" validate:"omitempty,oneof=busy basic details"` // what invitees see, basic if not set
+	ExpiresAt  *time.Time `json:"expires_at"`                                               // expiry; the configured default if not set
+}
+
+// Create handles HTTP requests to create a short link to an event of the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	link := model.ShortLink{EventID: eventID, UserID: userID, Visibility: req.Visibility}
+	if req.ExpiresAt != nil {
+		link.ExpiresAt = *req.ExpiresAt
+	}
+
+	link, err = h.service.CreateShortLink(r.Context(), link)
+	if err != nil {
+		if errors.Is(err, shortlinkrepo.ErrEventNotFound) {
+			response.Fail(w, http.StatusNotFound, shortlinkrepo.ErrEventNotFound)
+			return
+		}
+
+		if errors.Is(err, shortlinksvc.ErrInvalidExpiry) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to create short link", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, dto.NewShortLink(link))
+}
+
+// List handles HTTP requests to list the short links of an event of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	links, err := h.service.ListShortLinks(r.Context(), eventID, userID)
+	if err != nil {
+		h.logger.Error("failed to list short links", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewShortLinks(links))
+}
+
+// Revoke handles HTTP requests to revoke a short link of an event of the authenticated user.
+func (h *Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse event ID from URL parameter.
+	eventID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid event id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid event id"))
+		return
+	}
+
+	code := chi.URLParam(r, "code")
+	if err := h.service.RevokeShortLink(r.Context(), code, eventID, userID); err != nil {
+		if errors.Is(err, shortlinkrepo.ErrShortLinkNotFound) {
+			response.Fail(w, http.StatusNotFound, shortlinkrepo.ErrShortLinkNotFound)
+			return
+		}
+
+		h.logger.Error("failed to revoke short link", zap.String("event_id", eventID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "short link revoked")
+}
+
+// Resolve handles public HTTP requests for the event a short code shares.
+// It needs no authentication: the code is the credential. Unknown and revoked codes
+// respond with 404, expired ones with 410.
+func (h *Handler) Resolve(w http.ResponseWriter, r *http.Request) {
+	event, err := h.service.Resolve(r.Context(), chi.URLParam(r, "code"))
+	if err != nil {
+		if errors.Is(err, shortlinkrepo.ErrShortLinkNotFound) {
+			response.Fail(w, http.StatusNotFound, shortlinkrepo.ErrShortLinkNotFound)
+			return
+		}
+
+		if errors.Is(err, shortlinksvc.ErrLinkExpired) {
+			response.Fail(w, http.StatusGone, shortlinksvc.ErrLinkExpired)
+			return
+		}
+
+		h.logger.Error("failed to resolve short link", zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Robots-Tag", "noindex")
+	response.OK(w, dto.NewSharedEvent(event))
+}
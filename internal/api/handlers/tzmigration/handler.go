@@ -0,0 +1,39 @@
+package tzmigration
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/tzmigration/mock_tzmigration_service.go -package=mocks
+
+// migrationService defines the interface for the migration of event dates stored without a time zone.
+type migrationService interface {
+	// Start checks a migration request and queues a job running it.
+	Start(ctx context.Context, userID uuid.UUID, req model.TimezoneMigration) (model.Job, error)
+}
+
+// Handler manages HTTP requests for timezone migrations.
+type Handler struct {
+	service migrationService // service handles business logic for timezone migrations
+	logger  *zap.Logger      // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The migration service for starting timezone migrations.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s migrationService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
@@ -0,0 +1,73 @@
+package tzmigration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockstzmigrationsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/tzmigration"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	tzmigrationsvc "github.com/aliskhannn/calendar-service/internal/service/tzmigration"
+)
+
+func TestHandler_Create(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		err  error
+		want int
+	}{
+		"accepted":         {body: `{"timezone":"Europe/Berlin","dry_run":true}`, want: http.StatusAccepted},
+		"unknown timezone": {body: `{"timezone":"Mars/Olympus"}`, err: fmt.Errorf("%w: %q", tzmigrationsvc.ErrUnknownTimezone, "Mars/Olympus"), want: http.StatusBadRequest},
+		"no timezone":      {body: `{}`, err: tzmigrationsvc.ErrNoTimezone, want: http.StatusBadRequest},
+		"queue failure":    {body: `{"per_user":true}`, err: fmt.Errorf("start timezone migration: boom"), want: http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := mockstzmigrationsvc.NewMockmigrationService(ctrl)
+			h := New(mockService, zap.NewNop())
+
+			userID := uuid.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/timezone-migrations", bytes.NewReader([]byte(tt.body)))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				Start(gomock.Any(), userID, gomock.Any()).
+				Return(model.Job{ID: uuid.New(), Kind: model.JobTimezoneMigration, Status: model.JobQueued}, tt.err)
+
+			h.Create(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Create_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	h := New(mockstzmigrationsvc.NewMockmigrationService(ctrl), zap.NewNop())
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/timezone-migrations", bytes.NewReader([]byte(`{`)))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
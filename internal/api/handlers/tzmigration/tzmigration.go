@@ -0,0 +1,58 @@
+package tzmigration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	tzmigrationsvc "github.com/aliskhannn/calendar-service/internal/service/tzmigration"
+)
+
+// Create handles HTTP requests to start a timezone migration of the event dates stored without a time zone.
+// The migration is run by a background job; the response is 202 Accepted with the job,
+// whose progress and result are polled through the jobs API.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userID, ok := r.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req model.TimezoneMigration
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to decode timezone migration request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	job, err := h.service.Start(r.Context(), userID, req)
+	if err != nil {
+		if errors.Is(err, tzmigrationsvc.ErrNoTimezone) || errors.Is(err, tzmigrationsvc.ErrUnknownTimezone) {
+			response.Fail(w, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error("failed to start timezone migration", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	h.logger.Warn("timezone migration started",
+		zap.String("user_id", userID.String()),
+		zap.String("job_id", job.ID.String()),
+		zap.String("timezone", req.Timezone),
+		zap.Bool("per_user", req.PerUser),
+		zap.Bool("dry_run", req.DryRun),
+	)
+	response.Accepted(w, dto.NewJob(job))
+}
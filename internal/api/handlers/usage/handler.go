@@ -0,0 +1,65 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/usage/mock_usage_service.go -package=mocks
+
+// usageService defines the interface for reading API usage.
+type usageService interface {
+	// Get returns the API usage of a user in the current period.
+	Get(ctx context.Context, userID uuid.UUID) (model.Usage, error)
+}
+
+// Handler handles HTTP requests for API usage.
+type Handler struct {
+	service usageService // service reads API usage counters
+	logger  *zap.Logger  // logger logs application events and errors
+}
+
+// New creates a new Handler instance with the given usage service and logger.
+//
+// Parameters:
+//   - s: The usage service.
+//   - l: The logger for logging application events and errors.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s usageService, l *zap.Logger) *Handler {
+	return &Handler{
+		service: s,
+		logger:  l,
+	}
+}
+
+// Get handles HTTP requests to read the authenticated user's API usage in the current month.
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	usage, err := h.service.Get(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get api usage", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewUsage(usage))
+}
@@ -0,0 +1,70 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksusagesvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/usage"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestHandler_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocksusagesvc.NewMockusageService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	h := New(mockService, logger)
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/user/usage", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		Get(gomock.Any(), userID).
+		Return(model.Usage{Calls: 30, Limit: 100}, nil)
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			Calls     int64  `json:"calls"`
+			Remaining *int64 `json:"remaining"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.Calls != 30 || resp.Result.Remaining == nil || *resp.Result.Remaining != 70 {
+		t.Fatalf("unexpected response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_Get_Unauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger, _ := zap.NewDevelopment()
+	h := New(mocksusagesvc.NewMockusageService(ctrl), logger)
+
+	w := httptest.NewRecorder()
+	h.Get(w, httptest.NewRequest(http.MethodGet, "/api/user/usage", nil))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=handler.go -destination=../../../mocks/api/handlers/view/mock_view_service.go -package=mocks
+
+// viewService defines the interface for saved view operations.
+type viewService interface {
+	// CreateView saves a new view and returns its ID.
+	CreateView(ctx context.Context, view model.View) (uuid.UUID, error)
+
+	// ListViews retrieves all views of a user.
+	ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error)
+
+	// DeleteView deletes a view of the specified user.
+	DeleteView(ctx context.Context, viewID, userID uuid.UUID) error
+
+	// GetViewEvents retrieves a view and the events currently matching it.
+	GetViewEvents(ctx context.Context, viewID, userID uuid.UUID) (model.View, []model.Event, error)
+}
+
+// Handler manages HTTP requests for saved views and their events.
+type Handler struct {
+	service   viewService         // service handles business logic for views
+	logger    *zap.Logger         // logger logs application events and errors
+	validator *validator.Validate // validator validates incoming request data
+}
+
+// New creates a new Handler instance with the provided dependencies.
+//
+// Parameters:
+//   - s: The view service for handling view-related operations.
+//   - l: The logger for logging application events and errors.
+//   - v: The validator for validating request data.
+//
+// Returns:
+//   - A pointer to the initialized Handler.
+func New(s viewService, l *zap.Logger, v *validator.Validate) *Handler {
+	return &Handler{
+		service:   s,
+		logger:    l,
+		validator: v,
+	}
+}
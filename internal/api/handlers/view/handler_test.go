@@ -0,0 +1,161 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksviewsvc "github.com/aliskhannn/calendar-service/internal/mocks/api/handlers/view"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	viewrepo "github.com/aliskhannn/calendar-service/internal/repository/view"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocksviewsvc.MockviewService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocksviewsvc.NewMockviewService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := New(mockService, logger, validator.New())
+	return ctrl, mockService, handler
+}
+
+func withViewID(req *http.Request, userID, viewID uuid.UUID) *http.Request {
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	rc := chi.NewRouteContext()
+	rc.URLParams.Add("id", viewID.String())
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	body, _ := json.Marshal(CreateRequest{Name: "High priority next week", Priorities: []string{"high"}, Range: "next_week"})
+
+	req := httptest.NewRequest(http.MethodPost, "/views", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, userID))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CreateView(gomock.Any(), model.View{
+			UserID: userID,
+			Name:   "High priority next week",
+			Filter: model.ViewFilter{Priorities: []string{"high"}, Range: model.RangeNextWeek},
+		}).
+		Return(uuid.New(), nil)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandler_Create_InvalidFilter(t *testing.T) {
+	cases := map[string]CreateRequest{
+		"unknown range":    {Name: "Soon", Range: "next_year"},
+		"unknown priority": {Name: "Urgent", Priorities: []string{"urgent"}},
+		"project conflict": {Name: "Both", ProjectID: func() *uuid.UUID { id := uuid.New(); return &id }(), WithoutProject: true},
+	}
+
+	for name, payload := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, _, h := setupHandler(t)
+			defer ctrl.Finish()
+
+			body, _ := json.Marshal(payload)
+			req := httptest.NewRequest(http.MethodPost, "/views", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Create_Duplicate(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body, _ := json.Marshal(CreateRequest{Name: "Today", Range: "today"})
+	req := httptest.NewRequest(http.MethodPost, "/views", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.UserIDKey, uuid.New()))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().CreateView(gomock.Any(), gomock.Any()).Return(uuid.Nil, viewrepo.ErrViewExists)
+
+	h.Create(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestHandler_Events_Success(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, viewID := uuid.New(), uuid.New()
+	req := withViewID(httptest.NewRequest(http.MethodGet, "/views/"+viewID.String()+"/events", nil), userID, viewID)
+	w := httptest.NewRecorder()
+
+	view := model.View{ID: viewID, Name: "Today", Filter: model.ViewFilter{Range: model.RangeToday}}
+	mockService.EXPECT().
+		GetViewEvents(gomock.Any(), viewID, userID).
+		Return(view, []model.Event{{ID: uuid.New(), Title: "Standup"}}, nil)
+
+	h.Events(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var resp struct {
+		Result struct {
+			View struct {
+				Filter model.ViewFilter `json:"filter"`
+			} `json:"view"`
+			Events []struct {
+				Title string `json:"title"`
+			} `json:"events"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Result.View.Filter.Range != model.RangeToday || len(resp.Result.Events) != 1 || resp.Result.Events[0].Title != "Standup" {
+		t.Fatalf("unexpected response: %+v", resp.Result)
+	}
+}
+
+func TestHandler_Events_NotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	userID, viewID := uuid.New(), uuid.New()
+	req := withViewID(httptest.NewRequest(http.MethodGet, "/views/"+viewID.String()+"/events", nil), userID, viewID)
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().GetViewEvents(gomock.Any(), viewID, userID).Return(model.View{}, nil, viewrepo.ErrViewNotFound)
+
+	h.Events(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,168 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	viewrepo "github.com/aliskhannn/calendar-service/internal/repository/view"
+)
+
+// CreateRequest represents the payload for creating a new saved view.
+type CreateRequest struct {
+	Name           string     `json:"name" validate:"required,min=1,max=255"`                                                                                         // name of the view
+	Text           string     `json:"text" validate:"max=255"`                                                                                                        // case-insensitive text in the title or description
+	Priorities     []string   `json:"priorities" validate:"omitempty,dive,oneof=low normal high critical"`                                                            // event priorities to include
+	ProjectID      *uuid.UUID `json:"project_id" validate:"excluded_with=WithoutProject"`                                                                             // only events of this project
+	WithoutProject bool       `json:"without_project"`                                                                                                                // only events without a project
+	Range          string     `json:"range" validate:"omitempty,oneof=today tomorrow this_week next_week this_month next_month next_7_days next_30_days past_7_days"` // relative date range
+}
+
+// Create handles HTTP requests to save a new view for the authenticated user.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Decode and validate request body.
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Warn("validation failed", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("validation error: %s", err.Error()))
+		return
+	}
+
+	id, err := h.service.CreateView(r.Context(), model.View{
+		UserID: userID,
+		Name:   req.Name,
+		Filter: model.ViewFilter{
+			Text:           req.Text,
+			Priorities:     req.Priorities,
+			ProjectID:      req.ProjectID,
+			WithoutProject: req.WithoutProject,
+			Range:          req.Range,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, viewrepo.ErrViewExists) {
+			response.Fail(w, http.StatusConflict, viewrepo.ErrViewExists)
+			return
+		}
+
+		h.logger.Error("failed to create view", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.Created(w, id)
+}
+
+// List handles HTTP requests to list the saved views of the authenticated user.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	views, err := h.service.ListViews(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list views", zap.String("user_id", userID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.List(w, r, dto.NewViews(views))
+}
+
+// Delete handles HTTP requests to delete a saved view by its ID.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse view ID from URL parameter.
+	viewID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid view id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid view id"))
+		return
+	}
+
+	if err := h.service.DeleteView(r.Context(), viewID, userID); err != nil {
+		if errors.Is(err, viewrepo.ErrViewNotFound) {
+			response.Fail(w, http.StatusNotFound, viewrepo.ErrViewNotFound)
+			return
+		}
+
+		h.logger.Error("failed to delete view", zap.String("view_id", viewID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, "view deleted")
+}
+
+// Events handles HTTP requests to retrieve the events currently matching a saved view.
+// Relative ranges such as "next_week" are resolved at request time.
+func (h *Handler) Events(w http.ResponseWriter, r *http.Request) {
+	// Extract and validate user ID from request context.
+	userIDVal := r.Context().Value(middlewares.UserIDKey)
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		h.logger.Warn("missing or invalid user id in context")
+		response.Fail(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	// Parse view ID from URL parameter.
+	viewID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.logger.Warn("invalid view id", zap.Error(err))
+		response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid view id"))
+		return
+	}
+
+	view, events, err := h.service.GetViewEvents(r.Context(), viewID, userID)
+	if err != nil {
+		if errors.Is(err, viewrepo.ErrViewNotFound) {
+			response.Fail(w, http.StatusNotFound, viewrepo.ErrViewNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get view events", zap.String("view_id", viewID.String()), zap.Error(err))
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+		return
+	}
+
+	response.OK(w, dto.NewViewEvents(view, events, time.Now()))
+}
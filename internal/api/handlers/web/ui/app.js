@@ -0,0 +1,353 @@
+// Calendar web UI of the calendar service: month, week and day views with quick-add, on top of the event API.
+// The bearer token is kept in local storage; views are addressed by the URL hash, e.g. #/week/2025-09-08.
+"use strict";
+
+const tokenKey = "calendar-token";
+const tz = Intl.DateTimeFormat().resolvedOptions().timeZone;
+const weekdays = ["sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"];
+
+let config = {tenancy: false, tenant_header: "", demo: false};
+let registering = false;
+let weekStart = 1; // first day of the week, updated from the month grid of the locale
+let state = {view: "month", date: startOfDay(new Date())};
+
+const $ = (id) => document.getElementById(id);
+
+// api calls the JSON API and returns the result; missing event lists (404) are returned as empty lists.
+async function api(method, path, body, headers) {
+  const init = {method, headers: Object.assign({"Accept": "application/json"}, headers)};
+  const token = localStorage.getItem(tokenKey);
+  if (token) {
+    init.headers["Authorization"] = "Bearer " + token;
+  }
+  if (body !== undefined) {
+    init.headers["Content-Type"] = "application/json";
+    init.body = JSON.stringify(body);
+  }
+
+  const res = await fetch(path, init);
+  const data = await res.json().catch(() => ({}));
+  if (res.status === 401 && token) {
+    signOut("Your session has expired.");
+  }
+  if (res.status === 404 && method === "GET") {
+    return [];
+  }
+  if (!res.ok) {
+    throw new Error(data.error || res.statusText);
+  }
+  return data.result;
+}
+
+function flash(message) {
+  $("status").textContent = message;
+  setTimeout(() => { if ($("status").textContent === message) $("status").textContent = ""; }, 4000);
+}
+
+function fail(err) {
+  flash("Error: " + err.message);
+}
+
+// claims decodes the payload of the stored token; the signature is checked by the server.
+function claims() {
+  try {
+    const payload = localStorage.getItem(tokenKey).split(".")[1].replace(/-/g, "+").replace(/_/g, "/");
+    return JSON.parse(decodeURIComponent(escape(atob(payload))));
+  } catch (e) {
+    return {};
+  }
+}
+
+function startOfDay(d) {
+  return new Date(d.getFullYear(), d.getMonth(), d.getDate());
+}
+
+function addDays(d, n) {
+  return new Date(d.getFullYear(), d.getMonth(), d.getDate() + n);
+}
+
+function ymd(d) {
+  const pad = (n) => String(n).padStart(2, "0");
+  return d.getFullYear() + "-" + pad(d.getMonth() + 1) + "-" + pad(d.getDate());
+}
+
+function parseYmd(s) {
+  const [y, m, d] = s.split("-").map(Number);
+  return new Date(y, m - 1, d);
+}
+
+function startOfWeek(d) {
+  return addDays(d, -((d.getDay() - weekStart + 7) % 7));
+}
+
+function query(path, date, extra) {
+  return path + "?date=" + ymd(date) + "&tz=" + encodeURIComponent(tz) + (extra || "");
+}
+
+// overlaps reports whether an event takes place in [from, to): events with an end_date span it,
+// events without one take place at their date.
+function overlaps(e, from, to) {
+  const start = new Date(e.event_date);
+  if (!e.end_date) return start >= from && start < to;
+  return start < to && new Date(e.end_date) > from;
+}
+
+// eventsBetween fetches the events taking place in [from, to) in local time.
+// The day and week endpoints select by UTC date, so one extra day is fetched on each side.
+async function eventsBetween(from, to) {
+  const requests = [];
+  for (let end = to; ; end = addDays(end, -8)) {
+    requests.push(api("GET", query("/api/events/week", end))); // the 7 days before end and end itself
+    if (addDays(end, -7) <= addDays(from, -1)) break;
+  }
+  const lists = await Promise.all(requests);
+
+  const seen = new Set();
+  return lists.flat()
+    .filter((e) => {
+      const key = e.id + e.event_date;
+      if (seen.has(key) || !overlaps(e, from, to)) return false;
+      seen.add(key);
+      return true;
+    })
+    .sort((a, b) => new Date(a.event_date) - new Date(b.event_date));
+}
+
+function eventElement(e) {
+  const div = document.createElement("div");
+  div.className = "event";
+  if (e.color) div.style.borderLeftColor = e.color;
+  const time = document.createElement("time");
+  const clock = (d) => new Date(d).toLocaleTimeString([], {hour: "2-digit", minute: "2-digit"});
+  time.textContent = clock(e.event_date) + (e.end_date ? "–" + clock(e.end_date) : "");
+  div.append(time, document.createTextNode(e.title));
+  div.title = e.title + (e.description ? "\n" + e.description : "");
+  return div;
+}
+
+function selectDay(d) {
+  const f = $("quick-add").elements;
+  f.date.value = ymd(d);
+}
+
+async function renderMonth() {
+  const grid = await api("GET", query("/api/events/month", state.date, "&grid=true"));
+  weekStart = weekdays.indexOf(grid.week_start);
+
+  const month = parseYmd(grid.month + "-01");
+  $("title").textContent = month.toLocaleDateString([], {month: "long", year: "numeric"});
+
+  const view = document.createElement("div");
+  view.className = "grid";
+  for (let i = 0; i < 7; i++) {
+    const head = document.createElement("div");
+    head.className = "head";
+    head.textContent = grid.locale
+      ? grid.locale.weekday_names[i]
+      : addDays(startOfWeek(month), i).toLocaleDateString([], {weekday: "short"});
+    view.append(head);
+  }
+
+  const today = ymd(new Date());
+  for (const day of grid.days) {
+    const date = parseYmd(day.date);
+    const cell = document.createElement("div");
+    cell.className = "cell" + (day.in_month ? "" : " out") + (day.date === today ? " today" : "") +
+      (day.events.length ? " has-events" : "") + (day.date === ymd(state.date) ? " selected" : "");
+    const num = document.createElement("span");
+    num.className = "num";
+    num.textContent = date.getDate();
+    cell.append(num);
+    for (const e of day.events) cell.append(eventElement(e));
+    cell.addEventListener("click", () => go("day", date));
+    view.append(cell);
+  }
+
+  $("view").replaceChildren(view);
+}
+
+async function renderDays(from, days) {
+  const events = await eventsBetween(from, addDays(from, days));
+
+  const view = document.createElement("div");
+  view.className = "days";
+  for (let i = 0; i < days; i++) {
+    const date = addDays(from, i);
+    const section = document.createElement("section");
+    section.className = "day";
+    const h = document.createElement("h2");
+    h.textContent = date.toLocaleDateString([], {weekday: "long", day: "numeric", month: "long"});
+    h.addEventListener("click", () => selectDay(date));
+    section.append(h);
+
+    const own = events.filter((e) => overlaps(e, date, addDays(date, 1)));
+    for (const e of own) section.append(eventElement(e));
+    if (own.length === 0) {
+      const p = document.createElement("p");
+      p.className = "empty";
+      p.textContent = "No events";
+      section.append(p);
+    }
+    view.append(section);
+  }
+
+  $("view").replaceChildren(view);
+}
+
+async function render() {
+  for (const b of document.querySelectorAll("[data-view]")) {
+    b.classList.toggle("active", b.dataset.view === state.view);
+  }
+  selectDay(state.date);
+
+  try {
+    switch (state.view) {
+      case "week": {
+        const from = startOfWeek(state.date);
+        const to = addDays(from, 6);
+        $("title").textContent = from.toLocaleDateString([], {day: "numeric", month: "short"}) + " – " +
+          to.toLocaleDateString([], {day: "numeric", month: "short", year: "numeric"});
+        await renderDays(from, 7);
+        break;
+      }
+      case "day":
+        $("title").textContent = state.date.toLocaleDateString([], {dateStyle: "full"});
+        await renderDays(state.date, 1);
+        break;
+      default:
+        await renderMonth();
+    }
+  } catch (err) {
+    fail(err);
+  }
+}
+
+// go shows a view of a date by updating the URL hash.
+function go(view, date) {
+  location.hash = "#/" + view + "/" + ymd(date);
+}
+
+function route() {
+  const [, view, date] = location.hash.split("/");
+  state = {
+    view: ["month", "week", "day"].includes(view) ? view : "month",
+    date: /^\d{4}-\d{2}-\d{2}$/.test(date || "") ? parseYmd(date) : startOfDay(new Date()),
+  };
+  if (!$("app").hidden) render();
+}
+
+function step(direction) {
+  const d = state.date;
+  switch (state.view) {
+    case "week":
+      return go("week", addDays(d, 7 * direction));
+    case "day":
+      return go("day", addDays(d, direction));
+    default:
+      return go("month", new Date(d.getFullYear(), d.getMonth() + direction, 1));
+  }
+}
+
+function signOut(message) {
+  localStorage.removeItem(tokenKey);
+  $("app").hidden = true;
+  $("login").hidden = false;
+  $("login-error").textContent = message || "";
+}
+
+function signIn(token) {
+  localStorage.setItem(tokenKey, token);
+  $("login").hidden = true;
+  $("app").hidden = false;
+  $("admin-link").hidden = claims().role !== "admin";
+  render();
+}
+
+$("login-toggle").addEventListener("click", (e) => {
+  e.preventDefault();
+  registering = !registering;
+  $("name-field").hidden = !registering;
+  $("login-form").elements.name.required = registering;
+  $("login-submit").textContent = registering ? "Create account" : "Sign in";
+  $("login-toggle").textContent = registering ? "I already have an account" : "Create an account";
+});
+
+// tenantHeaders returns the tenant header of the login form, if tenancy is enabled.
+function tenantHeaders() {
+  const tenant = $("login-form").elements.tenant.value.trim();
+  return config.tenancy && tenant !== "" ? {[config.tenant_header]: tenant} : {};
+}
+
+$("login-form").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  const headers = tenantHeaders();
+
+  try {
+    if (registering) {
+      await api("POST", "/api/user/register", {email: f.email.value, name: f.name.value, password: f.password.value}, headers);
+    }
+    const result = await api("POST", "/api/user/login", {email: f.email.value, password: f.password.value}, headers);
+    f.password.value = "";
+    signIn(result.token);
+  } catch (err) {
+    $("login-error").textContent = err.message;
+  }
+});
+
+// In demo mode, registration creates a throwaway account with sample events and signs in right away.
+$("demo-start").addEventListener("click", async () => {
+  try {
+    const account = await api("POST", "/api/user/register", {timezone: tz}, tenantHeaders());
+    signIn(account.token);
+    flash("Demo account " + account.email + " until " + new Date(account.expires_at).toLocaleString());
+  } catch (err) {
+    $("login-error").textContent = err.message;
+  }
+});
+
+$("quick-add").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  try {
+    await api("POST", "/api/events/", {
+      title: f.title.value.trim(),
+      event_date: new Date(f.date.value + "T" + f.time.value).toISOString(),
+    });
+    flash("Added " + f.title.value.trim());
+    f.title.value = "";
+    render();
+  } catch (err) {
+    fail(err);
+  }
+});
+
+for (const b of document.querySelectorAll("[data-view]")) {
+  b.addEventListener("click", () => go(b.dataset.view, state.date));
+}
+$("prev").addEventListener("click", () => step(-1));
+$("next").addEventListener("click", () => step(1));
+$("today").addEventListener("click", () => go(state.view, new Date()));
+$("logout").addEventListener("click", () => signOut());
+window.addEventListener("hashchange", route);
+
+$("quick-add").elements.time.value = "09:00";
+route();
+
+fetch("/ui/config.json")
+  .then((res) => res.json())
+  .then((c) => {
+    config = c;
+    $("tenant-field").hidden = !config.tenancy;
+    $("demo-field").hidden = !config.demo;
+    $("login-toggle").hidden = config.demo;
+  })
+  .catch(() => {})
+  .finally(() => {
+    const token = localStorage.getItem(tokenKey);
+    if (token && (claims().exp || 0) * 1000 > Date.now()) {
+      signIn(token);
+    } else {
+      signOut();
+    }
+  });
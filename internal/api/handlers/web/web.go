@@ -0,0 +1,57 @@
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// uiFiles holds the static files of the calendar web UI.
+//
+//go:embed ui
+var uiFiles embed.FS
+
+// uiConfig tells the calendar web UI how to sign in.
+type uiConfig struct {
+	Tenancy      bool   `json:"tenancy"`       // whether login and registration need a tenant
+	TenantHeader string `json:"tenant_header"` // request header carrying the tenant ID before login
+	Demo         bool   `json:"demo"`          // whether registration creates throwaway demo accounts
+}
+
+// UI returns the handler serving the calendar web UI: the page at / and its assets under /ui/.
+// The UI is a single page without data of its own that signs in and works with the event API,
+// so the files are served without authentication.
+//
+// Parameters:
+//   - tenancy: The tenancy configuration, passed to the UI so it can send the tenant header at login.
+//   - demo: The demo mode configuration; in demo mode the UI offers throwaway accounts instead of registration.
+//
+// Returns:
+//   - An HTTP handler for the files of the UI.
+func UI(tenancy config.Tenancy, demo config.Demo) http.Handler {
+	files, err := fs.Sub(uiFiles, "ui")
+	if err != nil {
+		panic(err) // the directory is embedded at build time
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/{$}", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFileFS(w, r, files, "index.html")
+	})
+	mux.Handle("/ui/", http.StripPrefix("/ui", http.FileServer(http.FS(files))))
+	mux.HandleFunc("/ui/config.json", func(w http.ResponseWriter, _ *http.Request) {
+		response.JSON(w, http.StatusOK, uiConfig{Tenancy: tenancy.Enabled, TenantHeader: tenancy.Header, Demo: demo.Enabled})
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy",
+			"default-src 'none'; script-src 'self'; style-src 'self'; connect-src 'self'; form-action 'none'; frame-ancestors 'none'; base-uri 'none'")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("Cache-Control", "no-cache")
+		mux.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,47 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestUI(t *testing.T) {
+	ui := UI(config.Tenancy{}, config.Demo{Enabled: true})
+
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{path: "/", status: http.StatusOK, contentType: "text/html", contains: `<script src="/ui/app.js"></script>`},
+		{path: "/ui/app.js", status: http.StatusOK, contentType: "text/javascript", contains: "/api/events/month"},
+		{path: "/ui/style.css", status: http.StatusOK, contentType: "text/css", contains: ".grid"},
+		{path: "/ui/config.json", status: http.StatusOK, contentType: "application/json", contains: `"tenancy":false,"tenant_header":"","demo":true`},
+		{path: "/ui/missing.js", status: http.StatusNotFound},
+		{path: "/calendar", status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ui.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, w.Code)
+			}
+			if !strings.HasPrefix(w.Header().Get("Content-Type"), tt.contentType) {
+				t.Fatalf("expected content type %s, got %s", tt.contentType, w.Header().Get("Content-Type"))
+			}
+			if !strings.Contains(w.Body.String(), tt.contains) {
+				t.Fatalf("expected body to contain %q", tt.contains)
+			}
+			if w.Header().Get("Content-Security-Policy") == "" {
+				t.Fatal("expected a Content-Security-Policy header")
+			}
+		})
+	}
+}
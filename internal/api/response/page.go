@@ -0,0 +1,228 @@
+package response
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+const (
+	DefaultPageLimit = 50  // items per page when no limit is given
+	MaxPageLimit     = 500 // largest accepted limit
+)
+
+// envelopeKey marks the context of requests whose lists are returned in a Page envelope.
+type envelopeKey struct{}
+
+// Page represents the JSON structure of a list returned by the /api/v2 endpoints.
+type Page struct {
+	Items          interface{} `json:"items"`                     // the items of the page, never null
+	NextCursor     *string     `json:"next_cursor"`               // cursor of the next page; null on the last page
+	Total          *int        `json:"total"`                     // number of items of all pages; null unless requested
+	TotalEstimated bool        `json:"total_estimated,omitempty"` // whether the total is an estimate
+}
+
+// Envelope is the middleware of the /api/v2 routes: lists written with List and WritePage are
+// returned in a Page envelope instead of as a plain array.
+//
+// Parameters:
+//   - next: The next handler in the chain.
+//
+// Returns:
+//   - The handler marking the request context.
+func Envelope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), envelopeKey{}, true)))
+	})
+}
+
+// Enveloped reports whether the lists of a request are returned in a Page envelope.
+//
+// Parameters:
+//   - r: The HTTP request.
+//
+// Returns:
+//   - True for requests of the /api/v2 routes.
+func Enveloped(r *http.Request) bool {
+	v, _ := r.Context().Value(envelopeKey{}).(bool)
+	return v
+}
+
+// ParsePage reads the window of a list from the "cursor", "limit" and "total" query parameters.
+// The cursor is the opaque next_cursor of the previous page; "total" is "exact" or "estimated"
+// and requests the total number of items.
+//
+// Parameters:
+//   - r: The HTTP request.
+//
+// Returns:
+//   - The requested page; the first DefaultPageLimit items without a total by default.
+//   - An error describing the invalid parameter.
+func ParsePage(r *http.Request) (model.Page, error) {
+	return parsePage(r, DefaultPageLimit, MaxPageLimit)
+}
+
+// ParseLimitedPage reads the window of a list whose requests of the first API version already accepted
+// a "limit" query parameter, with its own default and maximum. Such requests read only the limit;
+// requests of the /api/v2 routes read the page like ParsePage, with the default and maximum of the list.
+//
+// Parameters:
+//   - r: The HTTP request.
+//   - defaultLimit: The number of items returned when no limit is given.
+//   - maxLimit: The largest accepted limit.
+//
+// Returns:
+//   - The requested page.
+//   - An error describing the invalid parameter.
+func ParseLimitedPage(r *http.Request, defaultLimit, maxLimit int) (model.Page, error) {
+	if Enveloped(r) {
+		return parsePage(r, defaultLimit, maxLimit)
+	}
+
+	limit, err := parseLimit(r, defaultLimit, maxLimit)
+	if err != nil {
+		return model.Page{}, err
+	}
+	return model.Page{Limit: limit}, nil
+}
+
+// parsePage implements ParsePage with the given default and maximum limit.
+func parsePage(r *http.Request, defaultLimit, maxLimit int) (model.Page, error) {
+	limit, err := parseLimit(r, defaultLimit, maxLimit)
+	if err != nil {
+		return model.Page{}, err
+	}
+
+	q := r.URL.Query()
+	page := model.Page{Limit: limit, Total: q.Get("total")}
+
+	if raw := q.Get("cursor"); raw != "" {
+		offset, err := decodeCursor(raw)
+		if err != nil {
+			return model.Page{}, fmt.Errorf("invalid cursor")
+		}
+		page.Offset = offset
+	}
+
+	switch page.Total {
+	case model.TotalNone, model.TotalExact, model.TotalEstimated:
+	default:
+		return model.Page{}, fmt.Errorf("total must be %q or %q", model.TotalExact, model.TotalEstimated)
+	}
+
+	return page, nil
+}
+
+// NewPage builds the envelope of a page. The items are those from the offset of the page on,
+// and may include one more item than the limit to tell whether a next page exists.
+//
+// Parameters:
+//   - page: The requested page.
+//   - items: The items from the offset on, up to page.Limit+1 of them.
+//   - total: The number of items of all pages, or nil if it was not requested.
+//
+// Returns:
+//   - The envelope, with the items trimmed to the limit.
+func NewPage[S ~[]T, T any](page model.Page, items S, total *int) Page {
+	p := Page{Total: total, TotalEstimated: total != nil && page.Total == model.TotalEstimated}
+
+	if len(items) > page.Limit {
+		items = items[:page.Limit]
+		next := encodeCursor(page.Offset + page.Limit)
+		p.NextCursor = &next
+	}
+	if items == nil {
+		items = S{}
+	}
+	p.Items = items
+
+	return p
+}
+
+// List sends a complete list with a 200 OK status code. Requests of the /api/v2 routes receive
+// the page selected by ParsePage in a Page envelope, the total being the length of the list;
+// other requests receive the plain list.
+//
+// Parameters:
+//   - w: The HTTP response writer to send the response.
+//   - r: The HTTP request.
+//   - items: All items of the list.
+func List[S ~[]T, T any](w http.ResponseWriter, r *http.Request, items S) {
+	if !Enveloped(r) {
+		OK(w, items)
+		return
+	}
+
+	page, err := ParsePage(r)
+	if err != nil {
+		Fail(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var total *int
+	if page.Total != model.TotalNone {
+		n := len(items)
+		total = &n
+		page.Total = model.TotalExact
+	}
+
+	from := min(page.Offset, len(items))
+	to := min(from+page.Limit+1, len(items))
+	OK(w, NewPage(page, items[from:to], total))
+}
+
+// WritePage sends a page of a list paginated by the repository with a 200 OK status code.
+// Requests of the /api/v2 routes receive it in a Page envelope; other requests receive
+// the items up to the limit.
+//
+// Parameters:
+//   - w: The HTTP response writer to send the response.
+//   - r: The HTTP request.
+//   - page: The requested page.
+//   - items: The items from the offset on, up to page.Limit+1 of them.
+//   - total: The number of items of all pages, or nil if it was not requested.
+func WritePage[S ~[]T, T any](w http.ResponseWriter, r *http.Request, page model.Page, items S, total *int) {
+	if !Enveloped(r) {
+		OK(w, items[:min(len(items), page.Limit)])
+		return
+	}
+
+	OK(w, NewPage(page, items, total))
+}
+
+// parseLimit reads the "limit" query parameter.
+func parseLimit(r *http.Request, defaultLimit, maxLimit int) (int, error) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return defaultLimit, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 || n > maxLimit {
+		return 0, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	return n, nil
+}
+
+// encodeCursor encodes the offset of a page as an opaque cursor.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("o:" + strconv.Itoa(offset)))
+}
+
+// decodeCursor decodes the offset of a cursor returned by encodeCursor.
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(raw) < 3 || string(raw[:2]) != "o:" {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	offset, err := strconv.Atoi(string(raw[2:]))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return offset, nil
+}
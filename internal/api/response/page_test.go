@@ -0,0 +1,115 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// listPage is the decoded envelope of a list response.
+type listPage struct {
+	Result struct {
+		Items          []int   `json:"items"`
+		NextCursor     *string `json:"next_cursor"`
+		Total          *int    `json:"total"`
+		TotalEstimated bool    `json:"total_estimated"`
+	} `json:"result"`
+}
+
+// serveList serves a list of n integers with List, under /api/v2 if enveloped.
+func serveList(t *testing.T, n int, target string, enveloped bool) *httptest.ResponseRecorder {
+	t.Helper()
+
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		List(w, r, items)
+	})
+	if enveloped {
+		h = Envelope(h)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func TestList_Plain(t *testing.T) {
+	w := serveList(t, 3, "/api/items?limit=1", false)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":[0,1,2]}`, w.Body.String())
+}
+
+func TestList_Envelope(t *testing.T) {
+	w := serveList(t, 5, "/api/v2/items?limit=2&total=exact", true)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var first listPage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &first))
+	assert.Equal(t, []int{0, 1}, first.Result.Items)
+	require.NotNil(t, first.Result.NextCursor)
+	require.NotNil(t, first.Result.Total)
+	assert.Equal(t, 5, *first.Result.Total)
+
+	w = serveList(t, 5, "/api/v2/items?limit=3&cursor="+*first.Result.NextCursor, true)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var last listPage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &last))
+	assert.Equal(t, []int{2, 3, 4}, last.Result.Items)
+	assert.Nil(t, last.Result.NextCursor)
+	assert.Nil(t, last.Result.Total)
+}
+
+func TestList_EnvelopeEmpty(t *testing.T) {
+	w := serveList(t, 0, "/api/v2/items", true)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":{"items":[],"next_cursor":null,"total":null}}`, w.Body.String())
+}
+
+func TestList_InvalidPage(t *testing.T) {
+	for _, query := range []string{"limit=0", "limit=501", "cursor=bogus", "cursor=" + encodeCursor(-1), "total=approximate"} {
+		w := serveList(t, 3, "/api/v2/items?"+query, true)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestParseLimitedPage(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/users?limit=20&total=exact&cursor=bogus", nil)
+	page, err := ParseLimitedPage(r, 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, model.Page{Limit: 20}, page, "first version requests read only the limit")
+
+	_, err = ParseLimitedPage(httptest.NewRequest(http.MethodGet, "/api/users?limit=200", nil), 10, 100)
+	assert.EqualError(t, err, "limit must be between 1 and 100")
+
+	r = httptest.NewRequest(http.MethodGet, "/api/v2/users?total=estimated&cursor="+encodeCursor(40), nil)
+	Envelope(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		page, err = ParseLimitedPage(r, 10, 100)
+	})).ServeHTTP(httptest.NewRecorder(), r)
+	require.NoError(t, err)
+	assert.Equal(t, model.Page{Offset: 40, Limit: 10, Total: model.TotalEstimated}, page)
+}
+
+func TestNewPage(t *testing.T) {
+	total := 1000
+	p := NewPage(model.Page{Offset: 10, Limit: 2, Total: model.TotalEstimated}, []string{"a", "b", "c"}, &total)
+
+	assert.Equal(t, []string{"a", "b"}, p.Items)
+	require.NotNil(t, p.NextCursor)
+	offset, err := decodeCursor(*p.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, 12, offset)
+	assert.True(t, p.TotalEstimated)
+}
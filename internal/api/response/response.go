@@ -1,10 +1,24 @@
 package response
 
 import (
+	"bytes"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"sync"
 )
 
+// maxPooledBuffer is the capacity above which encoding buffers are not returned to the pool,
+// so that a single huge response does not pin its memory for the lifetime of the process.
+const maxPooledBuffer = 1 << 20
+
+// bufferPool holds reusable buffers for encoding response bodies.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
 // Success represents the JSON structure for a successful HTTP response.
 // It contains a single field, Result, which holds the response data.
 type Success struct {
@@ -17,18 +31,48 @@ type Error struct {
 	Message string `json:"error"` // The error message describing the failure
 }
 
+// TimeoutError represents the JSON structure of the response to a request that exceeded its deadline.
+// Clients tell it apart from other errors by its code and see how long the request was given.
+type TimeoutError struct {
+	Message   string `json:"error"`      // The error message describing the failure
+	Code      string `json:"code"`       // Machine-readable reason, always "deadline_exceeded"
+	TimeoutMS int64  `json:"timeout_ms"` // Time the request was given, in milliseconds
+}
+
+// jsonAppender is implemented by payloads that encode themselves into a buffer without
+// reflection, such as dto.Events; see encode.
+type jsonAppender interface {
+	AppendJSON(buf []byte) ([]byte, error)
+}
+
 // JSON writes a JSON response to the provided HTTP response writer.
-// It sets the Content-Type header to application/json, writes the specified status code,
-// and encodes the provided data as JSON.
+// The data is encoded into a pooled buffer first, so the body is written in a single call
+// with a Content-Length header, and an encoding failure still results in a 500 response
+// instead of a truncated body.
 //
 // Parameters:
 //   - w: The HTTP response writer to send the response.
 //   - status: The HTTP status code for the response.
 //   - data: The data to be encoded as JSON in the response body.
 func JSON(w http.ResponseWriter, status int, data interface{}) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBuffer {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if err := encode(buf, data); err != nil {
+		buf.Reset()
+		_ = json.NewEncoder(buf).Encode(Error{Message: "internal server error"})
+		status = http.StatusInternalServerError
+	}
+
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
 	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(data)
+	_, _ = w.Write(buf.Bytes())
 }
 
 // OK sends a successful HTTP response with a 200 OK status code.
@@ -51,6 +95,17 @@ func Created(w http.ResponseWriter, result interface{}) {
 	JSON(w, http.StatusCreated, Success{Result: result})
 }
 
+// Accepted sends a successful HTTP response with a 202 Accepted status code,
+// for requests whose processing continues in the background.
+// It wraps the provided result in a Success struct and encodes it as JSON.
+//
+// Parameters:
+//   - w: The HTTP response writer to send the response.
+//   - result: The data to be included in the response.
+func Accepted(w http.ResponseWriter, result interface{}) {
+	JSON(w, http.StatusAccepted, Success{Result: result})
+}
+
 // Fail sends an error HTTP response with the specified status code.
 // It wraps the provided error message in an Error struct and encodes it as JSON.
 //
@@ -61,3 +116,27 @@ func Created(w http.ResponseWriter, result interface{}) {
 func Fail(w http.ResponseWriter, status int, err error) {
 	JSON(w, status, Error{Message: err.Error()})
 }
+
+// encode writes the JSON encoding of data, followed by a newline, into buf.
+// Successful responses whose result implements jsonAppender bypass encoding/json.
+//
+// Parameters:
+//   - buf: The buffer to write to.
+//   - data: The data to encode.
+//
+// Returns:
+//   - An error if the data cannot be encoded.
+func encode(buf *bytes.Buffer, data interface{}) error {
+	if s, ok := data.(Success); ok {
+		if a, ok := s.Result.(jsonAppender); ok {
+			b, err := a.AppendJSON(append(buf.AvailableBuffer(), `{"result":`...))
+			if err != nil {
+				return err
+			}
+			_, _ = buf.Write(append(b, "}\n"...))
+			return nil
+		}
+	}
+
+	return json.NewEncoder(buf).Encode(data)
+}
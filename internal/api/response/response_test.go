@@ -0,0 +1,106 @@
+package response
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/api/dto"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// newEventPayload builds an event list response of n events, as returned by the day/week/month endpoints.
+func newEventPayload(n int) dto.Events {
+	now := time.Now()
+	events := make([]model.Event, n)
+	for i := range events {
+		events[i] = model.Event{
+			ID:          uuid.New(),
+			UserID:      uuid.New(),
+			EventDate:   now.Add(time.Duration(i) * time.Hour),
+			Title:       "Weekly sync " + strconv.Itoa(i),
+			Description: "Agenda: status updates, blockers, and planning for the next sprint.",
+			Priority:    model.PriorityNormal,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+	}
+	return dto.NewEvents(events, now)
+}
+
+// discardWriter is a response writer that drops the body, so that benchmarks measure encoding only.
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header         { return w.header }
+func (w *discardWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *discardWriter) WriteHeader(int)             {}
+
+func TestOK(t *testing.T) {
+	w := httptest.NewRecorder()
+	OK(w, newEventPayload(3))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, strconv.Itoa(w.Body.Len()), w.Header().Get("Content-Length"))
+
+	var resp struct {
+		Result []dto.Event `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Result, 3)
+}
+
+func TestOK_AppenderMatchesEncodingJSON(t *testing.T) {
+	payload := newEventPayload(3)
+
+	w := httptest.NewRecorder()
+	OK(w, payload)
+
+	expected, err := json.Marshal(Success{Result: []dto.Event(payload)})
+	require.NoError(t, err)
+	assert.Equal(t, string(expected)+"\n", w.Body.String())
+}
+
+func TestJSON_EncodingError(t *testing.T) {
+	w := httptest.NewRecorder()
+	OK(w, math.Inf(1))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"internal server error"}`, w.Body.String())
+}
+
+func BenchmarkJSON_Events1k(b *testing.B) {
+	payload := newEventPayload(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	w := &discardWriter{header: http.Header{}}
+	for i := 0; i < b.N; i++ {
+		OK(w, payload)
+	}
+}
+
+// BenchmarkJSON_Events1k_EncodingJSON measures the previous implementation, which encoded the
+// payload with encoding/json straight into the response writer, as a baseline for BenchmarkJSON_Events1k.
+func BenchmarkJSON_Events1k_EncodingJSON(b *testing.B) {
+	payload := Success{Result: []dto.Event(newEventPayload(1000))}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	w := &discardWriter{header: http.Header{}}
+	for i := 0; i < b.N; i++ {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(payload)
+	}
+}
@@ -7,25 +7,144 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/admin"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/archiveformat"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/attendee"
 	"github.com/aliskhannn/calendar-service/internal/api/handlers/auth"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/calendar"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/coldstorage"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/contact"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/delegate"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/demo"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/embed"
 	"github.com/aliskhannn/calendar-service/internal/api/handlers/event"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/export"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/feed"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/follower"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/group"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/imports"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/job"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/machine"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/meta"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/note"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/notification"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/onboarding"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/preference"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/project"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/proposal"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/reminder"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/report"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/rule"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/shortlink"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/tzmigration"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/usage"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/view"
+	"github.com/aliskhannn/calendar-service/internal/api/handlers/web"
+	"github.com/aliskhannn/calendar-service/internal/api/response"
 	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/maintenance"
+	"github.com/aliskhannn/calendar-service/internal/metrics"
 	"github.com/aliskhannn/calendar-service/internal/middlewares"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/reporter"
 )
 
+// requestTimeout is the longest time a request may take; clients can ask for less with a deadline header.
+const requestTimeout = 15 * time.Second
+
 // New creates and configures a new HTTP router for the calendar service.
-// It sets up middleware, public routes for user authentication, and protected routes for event management.
-// The router uses the provided authentication and event handlers, configuration, and logging channel.
+// It sets up middleware, public routes for user authentication, protected routes for event and project management,
+// and admin-only routes for operators.
+// The router uses the provided authentication, event, project, and admin handlers, configuration, and logging channel.
 //
 // Parameters:
 //   - authHandler: The handler for authentication-related endpoints (e.g., register, login).
 //   - eventHandler: The handler for event-related endpoints (e.g., create, update, delete, get events).
+//   - projectHandler: The handler for project-related endpoints (e.g., projects, tasks, timelines).
+//   - viewHandler: The handler for saved views and their events.
+//   - usageHandler: The handler for reading the user's API usage.
+//   - adminHandler: The handler for operator-only endpoints (e.g., log level).
+//   - notificationHandler: The handler for the email provider webhook and the notification log.
+//   - importHandler: The handler for calendar archive imports and their progress.
+//   - jobHandler: The handler for background jobs, their progress and cancellation.
+//   - exportHandler: The handler for printable PDF agendas.
+//   - ruleHandler: The handler for event color-coding rules and their previews.
+//   - embedHandler: The handler for embeds and the public calendars they publish.
+//   - shortlinkHandler: The handler for short links to events and the events they share.
+//   - reminderHandler: The handler for the user's notification history and test notifications.
+//   - feedHandler: The handler for ICS feeds and the calendars clients subscribe to.
+//   - onboardingHandler: The handler for the sample data and guided setup of new users.
+//   - demoHandler: The handler registering throwaway accounts in demo mode.
+//   - delegateHandler: The handler for the users allowed to create events in the user's calendar.
+//   - attendeeHandler: The handler for the users invited to the user's events.
+//   - preferenceHandler: The handler for notification preferences and unsubscribe links.
+//   - followerHandler: The handler for following the shared events of other users.
+//   - proposalHandler: The handler for the new times attendees propose for events.
+//   - tzMigrationHandler: The handler starting the migration of event dates stored without a time zone.
+//   - noteHandler: The handler for the private notes of users on events.
+//   - calendarHandler: The handler for the calendars users sort their events into.
+//   - machineHandler: The handler for machine tokens and the internal routes of other services.
+//   - coldStorageHandler: The handler starting restores of events exported to cold storage.
+//   - archiveFormatHandler: The handler starting verifications of the new archive format.
+//   - metaHandler: The handler for the version and changelog of the API.
+//   - contactHandler: The handler for the contacts suggested as attendees.
+//   - groupHandler: The handler for attendee groups and the events they are invited to.
+//   - reportHandler: The handler for the reports of organizations, the attendee groups of their administrators.
 //   - config: The application configuration, including JWT settings for authentication.
-//   - logCh: The channel for sending log entries generated by the logger middleware.
+//   - asyncLog: The async logger receiving the request log entries generated by the logger middleware.
+//   - debugLog: The runtime settings of the request/response debug logging middleware.
+//   - rep: The error reporter that captures panics with request context.
+//   - meter: The middleware metering API calls per user and enforcing quotas.
+//   - m: The maintenance mode; while on, non-admin requests are rejected with 503.
+//   - tenant: The middleware resolving the tenant of a request from the tenant header.
+//   - captcha: The middleware requiring a CAPTCHA after repeated failed logins, registrations or guest RSVP requests.
+//   - prio: The middleware limiting concurrent interactive and bulk API requests.
 //
 // Returns:
 //   - An HTTP handler configured with routes and middleware.
-func New(authHandler *auth.Handler, eventHandler *event.Handler, config *config.Config, logCh chan<- middlewares.LogEntry) http.Handler {
+func New(
+	authHandler *auth.Handler,
+	eventHandler *event.Handler,
+	projectHandler *project.Handler,
+	viewHandler *view.Handler,
+	usageHandler *usage.Handler,
+	adminHandler *admin.Handler,
+	notificationHandler *notification.Handler,
+	importHandler *imports.Handler,
+	jobHandler *job.Handler,
+	exportHandler *export.Handler,
+	ruleHandler *rule.Handler,
+	embedHandler *embed.Handler,
+	shortlinkHandler *shortlink.Handler,
+	reminderHandler *reminder.Handler,
+	feedHandler *feed.Handler,
+	onboardingHandler *onboarding.Handler,
+	demoHandler *demo.Handler,
+	delegateHandler *delegate.Handler,
+	attendeeHandler *attendee.Handler,
+	preferenceHandler *preference.Handler,
+	followerHandler *follower.Handler,
+	proposalHandler *proposal.Handler,
+	tzMigrationHandler *tzmigration.Handler,
+	noteHandler *note.Handler,
+	calendarHandler *calendar.Handler,
+	machineHandler *machine.Handler,
+	coldStorageHandler *coldstorage.Handler,
+	archiveFormatHandler *archiveformat.Handler,
+	metaHandler *meta.Handler,
+	contactHandler *contact.Handler,
+	groupHandler *group.Handler,
+	reportHandler *report.Handler,
+	config *config.Config,
+	asyncLog *middlewares.AsyncLogger,
+	debugLog *middlewares.DebugLog,
+	rep *reporter.Reporter,
+	meter func(http.Handler) http.Handler,
+	m *maintenance.Mode,
+	tenant func(http.Handler) http.Handler,
+	captcha func(http.Handler) http.Handler,
+	prio func(http.Handler) http.Handler,
+) http.Handler {
 	// Initialize a new Chi router.
 	r := chi.NewRouter()
 
@@ -33,34 +152,311 @@ func New(authHandler *auth.Handler, eventHandler *event.Handler, config *config.
 	r.Use(middleware.RequestID)                 // adds a unique request ID to each request
 	r.Use(middleware.RealIP)                    // sets the remote address to the real client IP
 	r.Use(middleware.Recoverer)                 // recovers from panics and returns a 500 error
-	r.Use(middleware.Timeout(15 * time.Second)) // sets a timeout of 15 seconds for requests
-	r.Use(middlewares.Logger(logCh))            // logs request details to the provided log channel
+	r.Use(rep.Middleware())                     // reports panics with request context to the error tracker
+	r.Use(middleware.Timeout(requestTimeout))   // sets a timeout of 15 seconds for requests
+	r.Use(middlewares.Deadline(requestTimeout)) // shortens it to the deadline given by the client, if any
+	r.Use(middlewares.Logger(asyncLog))         // logs request details through the async logger
 
 	// Initialize authentication middleware with JWT configuration.
 	authMiddleware := middlewares.Auth(config.JWT)
 
-	// Define API routes under /api.
-	r.Route("/api", func(r chi.Router) {
+	// Initialize maintenance mode middleware; admins pass, so it must run after auth on protected routes.
+	maintenanceMiddleware := middlewares.Maintenance(m)
+
+	// Initialize debug logging middleware; it is a no-op until enabled by an admin.
+	debugMiddleware := middlewares.Debug(debugLog)
+
+	// Initialize the guard of instance-wide changes; it is a no-op unless the service runs in demo mode.
+	demoGuard := middlewares.DisabledInDemo(config.Demo.Enabled)
+
+	// In demo mode, registration creates throwaway accounts with sample data instead of regular accounts.
+	register := authHandler.Register
+	if config.Demo.Enabled {
+		register = demoHandler.Register
+	}
+
+	// Bounce and complaint webhook of the email provider, authenticated by the provider's signature.
+	// It lives outside /api because providers cannot send a tenant header; the tenant travels with the message.
+	r.Post("/webhooks/email", notificationHandler.Webhook)
+
+	// Public calendars embedded into websites, authenticated by their share token.
+	// Embedding pages cannot send a tenant header either; the tenant is part of the token.
+	r.With(maintenanceMiddleware).Get("/embed/{token}", embedHandler.Calendar)
+
+	// Events shared with invitees through short links; like share tokens, the code carries the tenant.
+	r.With(maintenanceMiddleware).Get("/e/{code}", shortlinkHandler.Resolve)
+
+	// ICS feeds polled by calendar clients, which cannot send a tenant header or log in; the token carries the tenant.
+	r.With(maintenanceMiddleware).Get("/feeds/{file}", feedHandler.Calendar)
+
+	// One-click unsubscribe links of notification emails; mail clients post to them (RFC 8058), users open them.
+	// Neither logs in or sends a tenant header; the signed token carries the user, the category and the tenant.
+	r.With(maintenanceMiddleware).Get("/unsubscribe", preferenceHandler.Unsubscribe)
+	r.With(maintenanceMiddleware).Post("/unsubscribe", preferenceHandler.Unsubscribe)
+
+	// RSVP links of the invitations emailed to people without an account; the token carries the tenant.
+	r.With(maintenanceMiddleware).Get("/rsvp", attendeeHandler.RSVP)
+	r.With(maintenanceMiddleware).Post("/rsvp", attendeeHandler.RSVP)
+
+	// Guests of shared events ask for RSVP links by email; asking is open to anyone with the code, so repeated
+	// failures require a CAPTCHA. The links are answered like invitations, the token carrying the tenant.
+	r.With(maintenanceMiddleware, captcha).Post("/e/{code}/rsvp", attendeeHandler.RequestGuestRSVP)
+	r.With(maintenanceMiddleware).Get("/guests/rsvp", attendeeHandler.GuestRSVP)
+	r.With(maintenanceMiddleware).Post("/guests/rsvp", attendeeHandler.GuestRSVP)
+
+	// Admin web UI; its static files are public, the admin API it calls requires the admin role.
+	r.Get("/admin", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/admin/", http.StatusMovedPermanently)
+	})
+	r.Handle("/admin/*", admin.UI(config.Tenancy))
+
+	// Calendar web UI for end users, optional for deployments with their own frontend.
+	if config.WebUI.Enabled {
+		ui := web.UI(config.Tenancy, config.Demo)
+		r.Get("/", ui.ServeHTTP)
+		r.Get("/ui/*", ui.ServeHTTP)
+	}
+
+	// Version and changelog of the API; public and tenant-independent, so clients can check them before logging in.
+	r.Route("/api/meta", func(r chi.Router) {
+		r.Get("/version", metaHandler.Version)     // API version, major versions and build information
+		r.Get("/changelog", metaHandler.Changelog) // changelog entries and deprecations still in effect
+	})
+
+	// Define the API routes, served under /api and /api/v2.
+	api := func(r chi.Router) {
+		r.Use(tenant) // route database access to the tenant of the request
+		r.Use(prio)   // shed bulk requests first when the instance is over capacity
+
 		// Public routes (no authentication required).
 		r.Route("/user", func(r chi.Router) {
-			r.Post("/register", authHandler.Register) // endpoint for user registration
-			r.Post("/login", authHandler.Login)       // endpoint for user login
+			r.Use(maintenanceMiddleware) // reject requests while in maintenance mode
+			r.Use(debugMiddleware)       // log sanitized bodies when debug logging matches
+
+			r.With(captcha).Post("/register", register)       // endpoint for user registration
+			r.With(captcha).Post("/login", authHandler.Login) // endpoint for user login
+
+			r.With(authMiddleware).Get("/usage", usageHandler.Get)                     // API usage and quota of the current month
+			r.With(authMiddleware).Get("/security-events", authHandler.SecurityEvents) // logins and other account security events
+			r.With(authMiddleware).Get("/profile", authHandler.Profile)                // profile of the user
+			r.With(authMiddleware).Put("/profile", authHandler.UpdateProfile)          // change the user's time zone or search language
+
+			r.With(authMiddleware).Get("/contacts", contactHandler.List)           // contacts for attendee autocomplete, best ranked first
+			r.With(authMiddleware).Post("/contacts", contactHandler.Add)           // add a contact manually
+			r.With(authMiddleware).Delete("/contacts/{id}", contactHandler.Remove) // remove a contact
+
+			r.With(authMiddleware).Get("/notifications/history", reminderHandler.History)                    // sent, failed and skipped reminders
+			r.With(authMiddleware).Delete("/notifications/history", reminderHandler.DeleteHistory)           // purge the notification history
+			r.With(authMiddleware).Delete("/notifications/history/{id}", reminderHandler.DeleteHistoryEntry) // delete one history entry
+			r.With(authMiddleware).Get("/notifications/preferences", preferenceHandler.List)                 // notification categories the user is subscribed to
+			r.With(authMiddleware).Put("/notifications/preferences", preferenceHandler.Update)               // subscribe to or unsubscribe from a category
+
+			r.With(authMiddleware).Post("/onboarding/seed", onboardingHandler.Seed)    // create a starter project, example events and default views
+			r.With(authMiddleware).Get("/onboarding/status", onboardingHandler.Status) // completed steps of the guided setup
+		})
+
+		// Client credentials grant of internal services; the client authenticates with its ID and secret.
+		r.With(maintenanceMiddleware).Post("/oauth/token", machineHandler.Token)
+
+		// Internal routes for other services, authenticated by machine tokens with the scope of each route.
+		// User tokens are not accepted here, and machine tokens are not accepted anywhere else.
+		r.Route("/internal", func(r chi.Router) {
+			r.Use(maintenanceMiddleware) // reject requests while in maintenance mode
+
+			r.With(middlewares.MachineAuth(config.JWT, model.ScopeEventsRead)).
+				Get("/users/{id}/events", machineHandler.UserEvents) // events of a user in a time range
+			r.With(middlewares.MachineAuth(config.JWT, model.ScopeRemindersDispatch)).
+				Post("/reminders/dispatch", machineHandler.DispatchReminders) // send due reminders right away
 		})
 
 		// Protected routes (require authentication).
 		r.Group(func(r chi.Router) {
-			r.Use(authMiddleware) // apply authentication middleware to all routes in this group
+			r.Use(authMiddleware)        // apply authentication middleware to all routes in this group
+			r.Use(rep.UserMiddleware())  // attach the authenticated user to reported errors
+			r.Use(maintenanceMiddleware) // reject non-admin requests while in maintenance mode
+			r.Use(debugMiddleware)       // log sanitized bodies when debug logging matches (after auth to match by user)
+			r.Use(meter)                 // count API calls per user and reject them once the quota is exceeded
 
 			// Event-related routes
 			r.Route("/events", func(r chi.Router) {
-				r.Post("/", eventHandler.Create)       // create a new event
-				r.Put("/{id}", eventHandler.Update)    // update an existing event by ID
-				r.Delete("/{id}", eventHandler.Delete) // delete an event by ID
-				r.Get("/day", eventHandler.GetDay)     // retrieve events for a specific day
-				r.Get("/week", eventHandler.GetWeek)   // retrieve events for a specific week
-				r.Get("/month", eventHandler.GetMonth) // retrieve events for a specific month
+				r.Post("/", eventHandler.Create)                        // create a new event
+				r.Delete("/", eventHandler.DeleteRange)                 // delete the events in a date range
+				r.Post("/bulk-delete", eventHandler.BulkDelete)         // delete events by a list of IDs
+				r.Get("/{id}", eventHandler.Get)                        // retrieve an event by ID with its related events
+				r.Head("/{id}", eventHandler.Exists)                    // check whether an event exists without reading it
+				r.Put("/{id}", eventHandler.Update)                     // update an existing event by ID
+				r.Delete("/{id}", eventHandler.Delete)                  // delete an event by ID
+				r.Post("/{id}/restore", eventHandler.Restore)           // restore a trashed or archived event with its reminders
+				r.Get("/trash", eventHandler.Trash)                     // list the deleted events that can still be restored
+				r.Delete("/trash", eventHandler.EmptyTrash)             // permanently delete every trashed event
+				r.Post("/trash/restore-all", eventHandler.RestoreTrash) // restore every trashed event at once
+				r.Get("/day", eventHandler.GetDay)                      // retrieve events for a specific day
+				r.Get("/week", eventHandler.GetWeek)                    // retrieve events for a specific week
+				r.Get("/month", eventHandler.GetMonth)                  // retrieve events for a specific month
+				r.Get("/summary", eventHandler.Summary)                 // count events per day, calendar and tag in a date range
+				r.Get("/search", eventHandler.Search)                   // full-text search over titles and descriptions
+				r.Get("/suggest", eventHandler.Suggest)                 // complete the title of a new event
+				r.Get("/export.pdf", exportHandler.PDF)                 // export a printable week or month agenda
+
+				r.Post("/{id}/links", eventHandler.Link)                 // link the event to an event it depends on
+				r.Delete("/{id}/links/{relatedID}", eventHandler.Unlink) // remove a link
+
+				r.Get("/{id}/overrides", eventHandler.ListOverrides)     // list the changed occurrences of a recurring event
+				r.Put("/{id}/overrides", eventHandler.Override)          // change the time or title of one occurrence
+				r.Delete("/{id}/overrides", eventHandler.RevertOverride) // revert an occurrence to the series
+
+				r.Post("/{id}/shortlink", shortlinkHandler.Create)           // share the event with invitees under a short code
+				r.Get("/{id}/shortlinks", shortlinkHandler.List)             // list the event's short links
+				r.Delete("/{id}/shortlinks/{code}", shortlinkHandler.Revoke) // revoke a short link
+
+				r.Post("/{id}/attendees", attendeeHandler.Invite)            // invite a user, or email an invitation to a non-user
+				r.Get("/{id}/attendees", attendeeHandler.List)               // list the event's attendees and their responses
+				r.Post("/{id}/attendees/accept", attendeeHandler.Accept)     // accept an invitation to the event
+				r.Post("/{id}/attendees/decline", attendeeHandler.Decline)   // decline an invitation to the event
+				r.Post("/{id}/attendees/groups", groupHandler.Invite)        // invite the members of a group, following its membership
+				r.Delete("/{id}/attendees/{userID}", attendeeHandler.Remove) // withdraw an invitation
+				r.Post("/{id}/late", attendeeHandler.RunningLate)            // report running late as an attendee, notifying the others
+				r.Get("/{id}/guests", attendeeHandler.ListGuests)            // list the guests who answered through the event's short links
+				r.Post("/{id}/follow", followerHandler.Follow)               // follow a shared event of another user
+				r.Delete("/{id}/follow", followerHandler.Unfollow)           // stop following an event
+				r.Get("/{id}/note", noteHandler.Get)                         // read the user's private note on the event
+				r.Put("/{id}/note", noteHandler.Set)                         // write the user's private note on the event
+				r.Delete("/{id}/note", noteHandler.Delete)                   // delete the user's private note on the event
+
+				r.Post("/{id}/proposals", proposalHandler.Propose)                      // propose a new time as an attendee
+				r.Get("/{id}/proposals", proposalHandler.List)                          // list the proposals of the event
+				r.Post("/{id}/proposals/{proposalID}/accept", proposalHandler.Accept)   // move the event to the proposed time
+				r.Post("/{id}/proposals/{proposalID}/decline", proposalHandler.Decline) // decline a proposal
+			})
+
+			// Project-related routes
+			r.Route("/projects", func(r chi.Router) {
+				r.Post("/", projectHandler.Create)                       // create a new project
+				r.Get("/", projectHandler.List)                          // list the user's projects
+				r.Delete("/{id}", projectHandler.Delete)                 // delete a project, keeping its events
+				r.Get("/{id}/timeline", projectHandler.Timeline)         // retrieve the project timeline and progress
+				r.Post("/{id}/tasks", projectHandler.CreateTask)         // add a task to the project
+				r.Put("/{id}/tasks/{taskID}", projectHandler.UpdateTask) // complete or reopen a task
+			})
+
+			// Calendar routes
+			r.Route("/calendars", func(r chi.Router) {
+				r.Post("/", calendarHandler.Create)       // create a new calendar
+				r.Get("/", calendarHandler.List)          // list the user's calendars, the default calendar first
+				r.Get("/{id}", calendarHandler.Get)       // retrieve a calendar
+				r.Put("/{id}", calendarHandler.Update)    // rename or recolor a calendar
+				r.Delete("/{id}", calendarHandler.Delete) // delete a calendar, moving its events to the default calendar
+			})
+
+			// Attendee group routes
+			r.Route("/groups", func(r chi.Router) {
+				r.Post("/", groupHandler.Create)                              // create an empty group
+				r.Get("/", groupHandler.List)                                 // list the user's groups with their member counts
+				r.Get("/{id}", groupHandler.Get)                              // retrieve a group with its members
+				r.Put("/{id}", groupHandler.Rename)                           // rename a group
+				r.Delete("/{id}", groupHandler.Delete)                        // delete a group, keeping the invitations it sent
+				r.Post("/{id}/members", groupHandler.AddMember)               // add a member, inviting them to upcoming group events
+				r.Delete("/{id}/members/{userID}", groupHandler.RemoveMember) // remove a member, withdrawing their group invitations
+			})
+
+			// Organization routes; an organization is an attendee group administered by its owner
+			r.Route("/orgs", func(r chi.Router) {
+				r.Get("/{id}/reports/meetings", reportHandler.Meetings) // weekly meeting load of the members
+			})
+
+			// Delegate routes
+			r.Route("/delegates", func(r chi.Router) {
+				r.Post("/", delegateHandler.Add)          // allow another user to create events in the calendar
+				r.Get("/", delegateHandler.List)          // list the user's delegates
+				r.Delete("/{id}", delegateHandler.Remove) // revoke a delegate
+			})
+
+			// Saved view routes
+			r.Route("/views", func(r chi.Router) {
+				r.Post("/", viewHandler.Create)           // save a new view
+				r.Get("/", viewHandler.List)              // list the user's views
+				r.Delete("/{id}", viewHandler.Delete)     // delete a view
+				r.Get("/{id}/events", viewHandler.Events) // retrieve the events currently matching a view
+			})
+
+			// Event rule routes
+			r.Route("/rules", func(r chi.Router) {
+				r.Post("/", ruleHandler.Create)         // save a new rule
+				r.Get("/", ruleHandler.List)            // list the user's rules in evaluation order
+				r.Post("/preview", ruleHandler.Preview) // dry-run a rule against existing events
+				r.Put("/{id}", ruleHandler.Update)      // replace a rule
+				r.Delete("/{id}", ruleHandler.Delete)   // delete a rule
+			})
+
+			// Embed routes
+			r.Route("/embeds", func(r chi.Router) {
+				r.Post("/", embedHandler.Create)       // publish a calendar under a new share token
+				r.Get("/", embedHandler.List)          // list the user's embeds
+				r.Delete("/{id}", embedHandler.Delete) // revoke an embed and its share token
+			})
+
+			// ICS feed routes
+			r.Route("/feeds", func(r chi.Router) {
+				r.Post("/", feedHandler.Create)               // publish a calendar under a new secret ICS URL
+				r.Get("/", feedHandler.List)                  // list the user's feeds
+				r.Post("/{id}/token", feedHandler.Regenerate) // replace the URL of a feed, revoking the old one
+				r.Delete("/{id}", feedHandler.Delete)         // revoke a feed and its URL
+			})
+
+			// Notification routes
+			r.Post("/notifications/test", reminderHandler.Test) // send a test notification with a sample reminder right away
+
+			// Calendar archive import routes
+			r.Route("/imports", func(r chi.Router) {
+				r.Post("/", importHandler.Create) // upload a Google Takeout or Apple Calendar archive
+				r.Get("/{id}", importHandler.Get) // poll the progress of an import
+			})
+
+			// Background job routes
+			r.Route("/jobs", func(r chi.Router) {
+				r.Get("/", jobHandler.List)               // list the user's recent jobs
+				r.Get("/{id}/output", jobHandler.Output)  // download the file produced by a completed job
+				r.Get("/{id}", jobHandler.Get)            // poll the status and progress of a job
+				r.Post("/{id}/cancel", jobHandler.Cancel) // cancel a queued or running job
+			})
+
+			// Bulk operation routes; operations are identified by the ID of the job that ran them
+			r.Route("/operations", func(r chi.Router) {
+				r.Post("/{id}/rollback", jobHandler.Rollback) // undo an import within its undo window
+			})
+
+			// Admin-only routes.
+			r.Route("/admin", func(r chi.Router) {
+				r.Use(middlewares.RequireAdmin()) // only users with the admin role
+
+				r.Get("/log-level", adminHandler.GetLogLevel)                 // read the current log level
+				r.With(demoGuard).Put("/log-level", adminHandler.SetLogLevel) // change the log level at runtime
+
+				r.Get("/debug-logging", adminHandler.GetDebugLogging)                 // read debug logging settings
+				r.With(demoGuard).Put("/debug-logging", adminHandler.SetDebugLogging) // toggle debug logging at runtime
+
+				r.Get("/maintenance", adminHandler.GetMaintenance)                 // read maintenance mode
+				r.With(demoGuard).Put("/maintenance", adminHandler.SetMaintenance) // switch maintenance mode at runtime
+
+				r.Method(http.MethodGet, "/metrics", metrics.Handler()) // process metrics, e.g. dropped log entries
+				r.Get("/workers", adminHandler.GetWorkers)              // status of the background workers and the reminder queue
+				r.Get("/notifications", notificationHandler.List)       // email bounces and complaints reported by the provider
+
+				r.Get("/users", adminHandler.ListUsers)                             // list user accounts
+				r.With(demoGuard).Put("/users/{id}/role", adminHandler.SetUserRole) // grant or revoke the admin role
+
+				r.With(demoGuard).Post("/timezone-migrations", tzMigrationHandler.Create)     // reinterpret legacy event dates in a background job
+				r.With(demoGuard).Post("/cold-storage/restores", coldStorageHandler.Restore)  // restore exported events into the archive in a background job
+				r.With(demoGuard).Post("/archive-verifications", archiveFormatHandler.Create) // backfill and compare the archive formats in a background job
 			})
 		})
+	}
+
+	r.Route("/api", api)
+	// Version 2 returns lists in a {items, next_cursor, total} envelope.
+	r.Route("/api/v2", func(r chi.Router) {
+		r.Use(response.Envelope)
+		api(r)
 	})
 
 	return r
@@ -1,19 +1,146 @@
 package server
 
-import "net/http"
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// Server wraps http.Server with readiness tracking and connection draining.
+// It serves the /healthz and /readyz probes itself, so they bypass the application middleware.
+type Server struct {
+	*http.Server
+	cfg   config.Server // listener, TLS and keep-alive settings
+	ready atomic.Bool   // whether the server accepts new traffic
+}
 
 // New creates and configures a new HTTP server instance.
-// It initializes the server with the specified address and handler.
+// It initializes the server with the configured address, keep-alive and HTTP/2 settings
+// and the given handler, and marks it as ready.
+//
+// No WriteTimeout is set: it would cut long-lived streaming responses, and under HTTP/2
+// it applies per stream. Streaming handlers should extend their own deadline with
+// http.ResponseController instead.
 //
 // Parameters:
-//   - addr: The address the server will listen on (e.g., ":8080").
+//   - cfg: The server configuration.
 //   - handler: The HTTP handler to process incoming requests.
 //
 // Returns:
-//   - A pointer to the configured http.Server instance.
-func New(addr string, handler http.Handler) *http.Server {
-	return &http.Server{
-		Addr:    addr,    // server listening address
-		Handler: handler, // handler for processing HTTP requests
+//   - A pointer to the configured Server instance.
+func New(cfg config.Server, handler http.Handler) *Server {
+	s := &Server{cfg: cfg}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.healthz) // liveness probe
+	mux.HandleFunc("/readyz", s.readyz)   // readiness probe
+	mux.Handle("/", handler)              // application routes
+
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(cfg.HTTP2.Enabled)
+
+	s.Server = &http.Server{
+		Addr:              cfg.HTTPPort,              // server listening address
+		Handler:           mux,                       // handler for processing HTTP requests
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,     // limit on slow request headers
+		IdleTimeout:       cfg.KeepAlive.IdleTimeout, // how long idle keep-alive connections are kept
+		Protocols:         protocols,                 // HTTP/1.1, plus HTTP/2 over TLS if enabled
+		HTTP2: &http.HTTP2Config{
+			MaxConcurrentStreams:          cfg.HTTP2.MaxConcurrentStreams,
+			MaxReceiveBufferPerStream:     cfg.HTTP2.MaxReceiveBufferPerStream,
+			MaxReceiveBufferPerConnection: cfg.HTTP2.MaxReceiveBufferPerConnection,
+			SendPingTimeout:               cfg.HTTP2.SendPingTimeout,
+			PingTimeout:                   cfg.HTTP2.PingTimeout,
+		},
+	}
+	s.SetKeepAlivesEnabled(cfg.KeepAlive.On())
+	s.ready.Store(true)
+
+	return s
+}
+
+// TLSEnabled reports whether the server is served over TLS.
+func (s *Server) TLSEnabled() bool {
+	return s.cfg.TLS.CertFile != "" || s.cfg.TLS.KeyFile != ""
+}
+
+// ListenAndServe listens on the configured address with the configured TCP keep-alive period
+// and serves requests, over TLS if a certificate is configured.
+// It always returns a non-nil error; after Shutdown or Close it is http.ErrServerClosed.
+func (s *Server) ListenAndServe() error {
+	lc := net.ListenConfig{KeepAlive: s.cfg.KeepAlive.TCPPeriod}
+	ln, err := lc.Listen(context.Background(), "tcp", s.Addr)
+	if err != nil {
+		return err
 	}
+
+	return s.Serve(ln)
+}
+
+// Serve accepts connections on the listener, over TLS if a certificate is configured.
+//
+// Parameters:
+//   - ln: The listener to accept connections on; it is closed when Serve returns.
+//
+// Returns:
+//   - A non-nil error; after Shutdown or Close it is http.ErrServerClosed.
+func (s *Server) Serve(ln net.Listener) error {
+	if s.TLSEnabled() {
+		return s.Server.ServeTLS(ln, s.cfg.TLS.CertFile, s.cfg.TLS.KeyFile)
+	}
+
+	return s.Server.Serve(ln)
+}
+
+// Ready reports whether the server currently accepts new traffic.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
+
+// Drain gracefully stops the server without dropping requests.
+// It first reports readiness=false on /readyz and waits for the given delay so that
+// load balancers stop routing new requests, then disables keep-alives and shuts down,
+// waiting for in-flight requests to finish until ctx is done.
+//
+// Parameters:
+//   - ctx: The context bounding the whole drain, including the readiness delay.
+//   - delay: How long to keep serving after readiness is switched off.
+//
+// Returns:
+//   - An error if the shutdown does not complete before ctx is done.
+func (s *Server) Drain(ctx context.Context, delay time.Duration) error {
+	s.ready.Store(false)
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.SetKeepAlivesEnabled(false)
+	return s.Shutdown(ctx)
+}
+
+// healthz reports that the process is alive.
+func (s *Server) healthz(w http.ResponseWriter, _ *http.Request) {
+	response.OK(w, "ok")
+}
+
+// readyz reports whether the server accepts new traffic.
+// It returns 503 Service Unavailable while draining.
+func (s *Server) readyz(w http.ResponseWriter, _ *http.Request) {
+	if !s.Ready() {
+		response.JSON(w, http.StatusServiceUnavailable, response.Error{Message: "draining"})
+		return
+	}
+
+	response.OK(w, "ready")
 }
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// writeCert writes a self-signed certificate for 127.0.0.1 and returns the file paths and a pool trusting it.
+func writeCert(t *testing.T) (certFile, keyFile string, pool *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "calendar-test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool = x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return certFile, keyFile, pool
+}
+
+// start serves handler on a random local port and returns its base URL.
+func start(t *testing.T, cfg config.Server, handler http.Handler) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := New(cfg, handler)
+	go func() { _ = s.Serve(ln) }()
+	t.Cleanup(func() { _ = s.Close() })
+
+	scheme := "http"
+	if s.TLSEnabled() {
+		scheme = "https"
+	}
+	return scheme + "://" + ln.Addr().String()
+}
+
+// newClient returns a client trusting pool that negotiates HTTP/2 when the server offers it.
+func newClient(pool *x509.CertPool) *http.Client {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+
+	return &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: pool},
+		Protocols:       protocols,
+	}}
+}
+
+// streamHandler writes server-sent events one by one, waiting for the client to acknowledge each
+// event before writing the next, so it fails unless every event is flushed immediately.
+func streamHandler(ack <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		for i := 0; i < 3; i++ {
+			_, _ = fmt.Fprintf(w, "data: %d\n\n", i)
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			select {
+			case <-ack:
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+}
+
+func TestServer_StreamingOverHTTP2(t *testing.T) {
+	certFile, keyFile, pool := writeCert(t)
+	ack := make(chan struct{})
+
+	url := start(t, config.Server{
+		KeepAlive: config.KeepAlive{IdleTimeout: time.Minute},
+		TLS:       config.TLS{CertFile: certFile, KeyFile: keyFile},
+		HTTP2:     config.HTTP2{Enabled: true, MaxConcurrentStreams: 10, MaxReceiveBufferPerStream: 64 << 10},
+	}, streamHandler(ack))
+
+	resp, err := newClient(pool).Get(url + "/events/stream")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 2, resp.ProtoMajor)
+
+	reader := bufio.NewReader(resp.Body)
+	for i := 0; i < 3; i++ {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("data: %d\n", i), line)
+		_, _ = reader.ReadString('\n')
+		ack <- struct{}{}
+	}
+}
+
+func TestServer_HTTP2Disabled(t *testing.T) {
+	certFile, keyFile, pool := writeCert(t)
+
+	url := start(t, config.Server{
+		TLS: config.TLS{CertFile: certFile, KeyFile: keyFile},
+	}, http.NotFoundHandler())
+
+	resp, err := newClient(pool).Get(url + "/healthz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, resp.ProtoMajor)
+}
+
+func TestServer_PlainHTTP(t *testing.T) {
+	disabled := false
+	url := start(t, config.Server{KeepAlive: config.KeepAlive{Enabled: &disabled}, HTTP2: config.HTTP2{Enabled: true}}, http.NotFoundHandler())
+
+	resp, err := http.Get(url + "/readyz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, resp.ProtoMajor)
+	assert.True(t, resp.Close, "keep-alive is disabled, the connection must not be reused")
+}
+
+func TestServer_KeepAliveByDefault(t *testing.T) {
+	// A configuration without a keepAlive section keeps reusing connections.
+	url := start(t, config.Server{}, http.NotFoundHandler())
+
+	resp, err := http.Get(url + "/readyz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.False(t, resp.Close, "keep-alive is enabled unless disabled explicitly")
+}
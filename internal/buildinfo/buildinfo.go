@@ -0,0 +1,46 @@
+// Package buildinfo describes the build of the running binary.
+//
+// The commit and build time are injected at compile time:
+//
+//	go build -ldflags "-X github.com/aliskhannn/calendar-service/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/aliskhannn/calendar-service/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Without them, the VCS information stamped by the Go toolchain is used, if any.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Build information injected with -ldflags -X.
+var (
+	Commit    string // full hash of the commit the binary was built from
+	BuildTime string // time the binary was built, in RFC 3339
+)
+
+// Get returns the build information of the running binary.
+// Values that were neither injected nor stamped by the toolchain are empty.
+//
+// Returns:
+//   - The commit, build time and Go version of the binary.
+func Get() model.BuildInfo {
+	info := model.BuildInfo{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = s.Value
+			case s.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = s.Value
+			case s.Key == "vcs.modified" && s.Value == "true":
+				info.Modified = true
+			}
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,118 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+var (
+	ErrRequired        = errors.New("captcha required")
+	ErrInvalid         = errors.New("invalid captcha")
+	ErrUnknownProvider = errors.New("unknown captcha provider")
+)
+
+// Providers and their verification endpoints.
+var verifyURLs = map[string]string{
+	"hcaptcha":  "https://api.hcaptcha.com/siteverify",
+	"recaptcha": "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// Verifier checks CAPTCHA tokens solved by clients.
+type Verifier interface {
+	// Verify checks a CAPTCHA token for the given client IP.
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// SiteVerifier verifies tokens with a siteverify endpoint, as offered by hCaptcha and reCAPTCHA.
+type SiteVerifier struct {
+	url    string       // verification endpoint
+	secret string       // secret key of the site
+	client *http.Client // HTTP client for verification requests
+}
+
+// NewVerifier creates a verifier for the configured provider.
+//
+// Parameters:
+//   - cfg: The CAPTCHA configuration.
+//
+// Returns:
+//   - The verifier.
+//   - ErrUnknownProvider if the provider is not supported.
+func NewVerifier(cfg config.Captcha) (*SiteVerifier, error) {
+	verifyURL, ok := verifyURLs[strings.ToLower(cfg.Provider)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, cfg.Provider)
+	}
+
+	return NewSiteVerifier(verifyURL, cfg.Secret), nil
+}
+
+// NewSiteVerifier creates a verifier for a siteverify endpoint.
+//
+// Parameters:
+//   - verifyURL: The verification endpoint.
+//   - secret: The secret key of the site.
+//
+// Returns:
+//   - A pointer to the initialized SiteVerifier.
+func NewSiteVerifier(verifyURL, secret string) *SiteVerifier {
+	return &SiteVerifier{
+		url:    verifyURL,
+		secret: secret,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Verify checks a CAPTCHA token with the provider.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - token: The token solved by the client.
+//   - remoteIP: The client IP address.
+//
+// Returns:
+//   - ErrInvalid if the provider rejects the token, or another error if the provider cannot be reached.
+func (v *SiteVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	form := url.Values{
+		"secret":   {v.secret},
+		"response": {token},
+		"remoteip": {remoteIP},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("create captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("verify captcha: unexpected status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode captcha response: %w", err)
+	}
+
+	if !result.Success {
+		return ErrInvalid
+	}
+
+	return nil
+}
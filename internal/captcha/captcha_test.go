@@ -0,0 +1,61 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestSiteVerifier_Verify(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+
+		if r.PostForm.Get("response") == "solved" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer srv.Close()
+
+	v := NewSiteVerifier(srv.URL, "secret")
+
+	assert.NoError(t, v.Verify(context.Background(), "solved", "203.0.113.7"))
+	assert.ErrorIs(t, v.Verify(context.Background(), "guessed", "203.0.113.7"), ErrInvalid)
+}
+
+func TestNewVerifier_UnknownProvider(t *testing.T) {
+	_, err := NewVerifier(config.Captcha{Provider: "turing"})
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
+
+func TestTracker(t *testing.T) {
+	now := time.Now()
+	tr := NewTracker(2, time.Minute)
+	tr.now = func() time.Time { return now }
+
+	tr.Fail("203.0.113.7")
+	assert.False(t, tr.Required("203.0.113.7"))
+
+	tr.Fail("203.0.113.7")
+	assert.True(t, tr.Required("203.0.113.7"))
+	assert.False(t, tr.Required("198.51.100.1"))
+
+	// Failures are forgotten once the window has passed.
+	now = now.Add(2 * time.Minute)
+	assert.False(t, tr.Required("203.0.113.7"))
+
+	tr.Fail("203.0.113.7")
+	tr.Fail("203.0.113.7")
+	tr.Reset("203.0.113.7")
+	assert.False(t, tr.Required("203.0.113.7"))
+}
@@ -0,0 +1,96 @@
+package captcha
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepSize is the number of tracked clients above which expired entries are removed.
+const sweepSize = 10000
+
+// Tracker counts failed attempts per client IP within a sliding window.
+// It is kept in memory, so every instance tracks the clients it serves.
+// It is safe for concurrent use.
+type Tracker struct {
+	mu        sync.Mutex          // mu guards failures
+	failures  map[string]*attempt // failures maps client IPs to their failed attempts
+	threshold int                 // failed attempts after which a CAPTCHA is required
+	window    time.Duration       // how long failed attempts are remembered
+	now       func() time.Time    // clock, replaceable in tests
+}
+
+// attempt records the failed attempts of one client.
+type attempt struct {
+	count int       // failed attempts in the window
+	last  time.Time // time of the most recent failure
+}
+
+// NewTracker creates a Tracker.
+//
+// Parameters:
+//   - threshold: The number of failed attempts after which a CAPTCHA is required.
+//   - window: How long failed attempts are remembered after the last one.
+//
+// Returns:
+//   - A pointer to the initialized Tracker.
+func NewTracker(threshold int, window time.Duration) *Tracker {
+	return &Tracker{
+		failures:  make(map[string]*attempt),
+		threshold: threshold,
+		window:    window,
+		now:       time.Now,
+	}
+}
+
+// Required reports whether the client must solve a CAPTCHA.
+func (t *Tracker) Required(ip string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.failures[ip]
+	if !ok {
+		return false
+	}
+	if t.expired(a) {
+		delete(t.failures, ip)
+		return false
+	}
+
+	return a.count >= t.threshold
+}
+
+// Fail records a failed attempt of the client.
+func (t *Tracker) Fail(ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.failures) > sweepSize {
+		for key, a := range t.failures {
+			if t.expired(a) {
+				delete(t.failures, key)
+			}
+		}
+	}
+
+	a, ok := t.failures[ip]
+	if !ok || t.expired(a) {
+		a = &attempt{}
+		t.failures[ip] = a
+	}
+
+	a.count++
+	a.last = t.now()
+}
+
+// Reset forgets the failed attempts of the client, e.g. after a successful login.
+func (t *Tracker) Reset(ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.failures, ip)
+}
+
+// expired reports whether the failed attempts are outside the window.
+func (t *Tracker) expired(a *attempt) bool {
+	return t.now().Sub(a.last) > t.window
+}
@@ -0,0 +1,232 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and creates timers.
+// Time-dependent components take a Clock instead of calling the time package directly,
+// so tests can control time with a Fake.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTicker returns a ticker that sends the current time on its channel every period.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks at intervals.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+
+	// Stop turns off the ticker. No more ticks are sent after Stop returns.
+	Stop()
+}
+
+// Real returns the clock of the system, backed by the time package.
+//
+// Returns:
+//   - The system clock.
+func Real() Clock {
+	return realClock{}
+}
+
+// realClock implements Clock with the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) NewTicker(d time.Duration) Ticker       { return realTicker{time.NewTicker(d)} }
+
+// realTicker adapts *time.Ticker to Ticker.
+type realTicker struct {
+	t *time.Ticker
+}
+
+func (r realTicker) C() <-chan time.Time { return r.t.C }
+func (r realTicker) Stop()               { r.t.Stop() }
+
+// Fake is a Clock for tests whose time only moves when the test advances it.
+// Timers and tickers fire when the time passes their deadline. Like their real
+// counterparts, tickers drop ticks that a slow receiver has not consumed yet.
+// It is safe for concurrent use.
+type Fake struct {
+	mu      sync.Mutex
+	cond    *sync.Cond   // signaled whenever a timer or ticker is created
+	now     time.Time    // current fake time
+	waiters []*fakeTimer // pending timers and active tickers
+}
+
+// fakeTimer is a pending After timer or an active ticker of a Fake.
+type fakeTimer struct {
+	at     time.Time      // next time the timer fires
+	period time.Duration  // ticker period; zero for one-shot timers
+	ch     chan time.Time // channel the time is sent on, buffered by one
+	clock  *Fake          // clock the timer belongs to
+}
+
+// NewFake creates a fake clock set to the given time.
+//
+// Parameters:
+//   - now: The initial time of the clock.
+//
+// Returns:
+//   - A pointer to the fake clock.
+func NewFake(now time.Time) *Fake {
+	f := &Fake{now: now}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now returns the current fake time.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Since returns the fake time elapsed since t.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// After returns a channel that receives the fake time once it has advanced by d.
+// A non-positive duration fires immediately.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := &fakeTimer{at: f.now.Add(d), ch: make(chan time.Time, 1), clock: f}
+	if d <= 0 {
+		t.ch <- f.now
+		return t.ch
+	}
+
+	f.add(t)
+	return t.ch
+}
+
+// NewTicker returns a ticker that ticks every time the fake time advances by d.
+// It panics if d is not positive, as time.NewTicker does.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := &fakeTimer{at: f.now.Add(d), period: d, ch: make(chan time.Time, 1), clock: f}
+	f.add(t)
+	return t
+}
+
+// Advance moves the fake time forward and fires the timers and tickers that became due, in deadline order.
+//
+// Parameters:
+//   - d: The duration to move forward by.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.set(f.now.Add(d))
+}
+
+// Set moves the fake time to t and fires the timers and tickers that became due.
+// Moving the time backwards fires nothing.
+//
+// Parameters:
+//   - t: The new time of the clock.
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.set(t)
+}
+
+// BlockUntil waits until at least n timers and tickers are waiting on the clock.
+// Tests use it to make sure a goroutine has started waiting before they advance the time.
+//
+// Parameters:
+//   - n: The number of waiting timers and tickers to wait for.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// add registers a timer. The caller must hold f.mu.
+func (f *Fake) add(t *fakeTimer) {
+	f.waiters = append(f.waiters, t)
+	f.cond.Broadcast()
+}
+
+// remove unregisters a timer. The caller must hold f.mu.
+func (f *Fake) remove(t *fakeTimer) {
+	for i, w := range f.waiters {
+		if w == t {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// set moves the time to now and fires due timers. The caller must hold f.mu.
+func (f *Fake) set(now time.Time) {
+	if now.Before(f.now) {
+		f.now = now
+		return
+	}
+	f.now = now
+
+	sort.Slice(f.waiters, func(i, j int) bool { return f.waiters[i].at.Before(f.waiters[j].at) })
+
+	pending := f.waiters[:0]
+	for _, t := range f.waiters {
+		if t.at.After(now) {
+			pending = append(pending, t)
+			continue
+		}
+
+		// Send without blocking: a ticker whose last tick was not consumed drops this one.
+		select {
+		case t.ch <- now:
+		default:
+		}
+
+		if t.period > 0 {
+			for !t.at.After(now) {
+				t.at = t.at.Add(t.period)
+			}
+			pending = append(pending, t)
+		}
+	}
+	f.waiters = pending
+}
+
+// C returns the channel on which the ticks are delivered.
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+// Stop turns off the ticker.
+func (t *fakeTimer) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.remove(t)
+}
@@ -0,0 +1,85 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var epoch = time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)
+
+func TestFake_After(t *testing.T) {
+	c := NewFake(epoch)
+	ch := c.After(time.Minute)
+
+	c.Advance(59 * time.Second)
+	select {
+	case <-ch:
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	c.Advance(time.Second)
+	select {
+	case got := <-ch:
+		assert.Equal(t, epoch.Add(time.Minute), got)
+	default:
+		t.Fatal("timer did not fire")
+	}
+
+	assert.Equal(t, time.Minute, c.Since(epoch))
+}
+
+func TestFake_After_NonPositive(t *testing.T) {
+	c := NewFake(epoch)
+
+	select {
+	case got := <-c.After(0):
+		assert.Equal(t, epoch, got)
+	default:
+		t.Fatal("timer did not fire immediately")
+	}
+}
+
+func TestFake_Ticker(t *testing.T) {
+	c := NewFake(epoch)
+	ticker := c.NewTicker(10 * time.Second)
+
+	c.Advance(10 * time.Second)
+	assert.Equal(t, epoch.Add(10*time.Second), <-ticker.C())
+
+	// Ticks that are not consumed are dropped, like with time.Ticker.
+	c.Advance(35 * time.Second)
+	assert.Equal(t, epoch.Add(45*time.Second), <-ticker.C())
+	select {
+	case <-ticker.C():
+		t.Fatal("unexpected extra tick")
+	default:
+	}
+
+	// The next tick keeps the original schedule.
+	c.Advance(5 * time.Second)
+	assert.Equal(t, epoch.Add(50*time.Second), <-ticker.C())
+
+	ticker.Stop()
+	c.Advance(time.Minute)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker ticked")
+	default:
+	}
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	c := NewFake(epoch)
+	done := make(chan time.Time)
+
+	go func() {
+		done <- <-c.After(time.Hour)
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(time.Hour)
+	assert.Equal(t, epoch.Add(time.Hour), <-done)
+}
@@ -0,0 +1,145 @@
+package coldstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/awsv4"
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// S3 stores objects in a bucket of AWS S3 or of an S3-compatible object store such as MinIO.
+// Requests are signed with Signature Version 4; objects of AWS S3 are addressed virtual-hosted style,
+// objects of other stores path style, which all of them support.
+type S3 struct {
+	baseURL     string       // URL of the bucket, without a trailing slash
+	region      string       // AWS region, part of the request signature
+	accessKeyID string       // AWS access key ID
+	secretKey   string       // AWS secret access key
+	client      *http.Client // HTTP client for API requests
+	clock       clock.Clock  // source of the signing time
+}
+
+// NewS3 creates an S3 object store for the bucket of the configuration.
+//
+// Parameters:
+//   - cfg: The cold storage configuration with the bucket, region, endpoint and credentials.
+//   - clk: The clock used to sign API requests.
+//
+// Returns:
+//   - A pointer to the initialized object store.
+func NewS3(cfg config.ColdStorage, clk clock.Clock) *S3 {
+	baseURL := "https://" + cfg.Bucket + ".s3." + cfg.Region + ".amazonaws.com"
+	if cfg.Endpoint != "" {
+		baseURL = strings.TrimSuffix(cfg.Endpoint, "/") + "/" + cfg.Bucket
+	}
+
+	return &S3{
+		baseURL:     baseURL,
+		region:      cfg.Region,
+		accessKeyID: cfg.AccessKeyID,
+		secretKey:   cfg.SecretAccessKey,
+		client:      &http.Client{Timeout: time.Minute},
+		clock:       clk,
+	}
+}
+
+// Put writes an object, replacing an object with the same key.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - key: The key of the object.
+//   - data: The content of the object.
+//   - contentType: The media type of the content.
+//
+// Returns:
+//   - An error if the store rejects the object or cannot be reached.
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return fmt.Errorf("create put object request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, data)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	return checkStatus(resp, "put object "+key)
+}
+
+// Get reads an object.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - key: The key of the object.
+//
+// Returns:
+//   - The content of the object.
+//   - ErrObjectNotFound if the bucket has no object with the key, or an error if the store cannot be read.
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create get object request: %w", err)
+	}
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("get object %s: %w", key, ErrObjectNotFound)
+	}
+	if err := checkStatus(resp, "get object "+key); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// newRequest creates a request for an object of the bucket.
+func (s *S3) newRequest(ctx context.Context, method, key string, data []byte) (*http.Request, error) {
+	u, err := url.Parse(s.baseURL + "/" + key)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(data))
+}
+
+// sign signs a request to the store; S3 requires the payload hash as a header of its own.
+func (s *S3) sign(req *http.Request, data []byte) {
+	req.Header.Set("X-Amz-Content-Sha256", awsv4.HexSHA256(data))
+	awsv4.Sign(req, data, "s3", s.region, s.accessKeyID, s.secretKey, s.clock.Now())
+}
+
+// checkStatus returns an error carrying the start of the response body unless the status is 2xx.
+func checkStatus(resp *http.Response, op string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s: unexpected status %d: %s", op, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,68 @@
+package coldstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestNewS3_BaseURL(t *testing.T) {
+	s := NewS3(config.ColdStorage{Bucket: "calendar-archive", Region: "eu-west-1"}, clock.Real())
+	assert.Equal(t, "https://calendar-archive.s3.eu-west-1.amazonaws.com", s.baseURL)
+
+	s = NewS3(config.ColdStorage{Bucket: "calendar-archive", Region: "us-east-1", Endpoint: "http://minio:9000/"}, clock.Real())
+	assert.Equal(t, "http://minio:9000/calendar-archive", s.baseURL)
+}
+
+func TestS3_PutAndGet(t *testing.T) {
+	objects := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "20260131T093000Z", r.Header.Get("X-Amz-Date"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential=AKID/20260131/eu-west-1/s3/aws4_request, "))
+		assert.Contains(t, r.Header.Get("Authorization"), "x-amz-content-sha256")
+
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path], _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				http.Error(w, "NoSuchKey", http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		}
+	}))
+	defer srv.Close()
+
+	s := NewS3(config.ColdStorage{
+		Bucket:          "calendar-archive",
+		Region:          "eu-west-1",
+		Endpoint:        srv.URL,
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	}, clock.NewFake(time.Date(2026, 1, 31, 9, 30, 0, 0, time.UTC)))
+
+	ctx := context.Background()
+	require.NoError(t, s.Put(ctx, "archive/2026/01/31/export.ndjson.gz", []byte("data"), "application/gzip"))
+	assert.Contains(t, objects, "/calendar-archive/archive/2026/01/31/export.ndjson.gz")
+
+	data, err := s.Get(ctx, "archive/2026/01/31/export.ndjson.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	_, err = s.Get(ctx, "archive/missing.ndjson.gz")
+	assert.True(t, errors.Is(err, ErrObjectNotFound))
+}
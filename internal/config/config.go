@@ -10,18 +10,116 @@ import (
 )
 
 // Config represents the application's configuration structure.
-// It encapsulates settings for the server, database, JWT, email, and archiver components.
+// It encapsulates settings for the server, maintenance mode, logger, error reporting, database, tenancy, encryption, JWT, machine clients, CAPTCHA, request priorities, email, events, request validation, API usage, reminder, and archiver components, and the demo mode.
 type Config struct {
-	Server   Server   `yaml:"server"`   // Server configuration
-	Database Database `yaml:"database"` // Database configuration
-	JWT      JWT      `yaml:"jwt"`      // JWT configuration for authentication
-	Email    Email    `yaml:"email"`    // Email configuration for SMTP
-	Archiver Archiver `yaml:"archiver"` // Archiver configuration for periodic tasks
+	Server      Server      `yaml:"server"`      // Server configuration
+	Maintenance Maintenance `yaml:"maintenance"` // Maintenance mode configuration
+	Logger      Logger      `yaml:"logger"`      // Logger configuration
+	Reporting   Reporting   `yaml:"reporting"`   // Error reporting configuration
+	Database    Database    `yaml:"database"`    // Database configuration
+	Tenancy     Tenancy     `yaml:"tenancy"`     // Multi-tenant database routing
+	Encryption  Encryption  `yaml:"encryption"`  // Encryption of event content at rest
+	JWT         JWT         `yaml:"jwt"`         // JWT configuration for authentication
+	Machine     Machine     `yaml:"machine"`     // Internal services calling the API with machine tokens
+	Captcha     Captcha     `yaml:"captcha"`     // CAPTCHA challenge after repeated failed logins
+	Priority    Priority    `yaml:"priority"`    // Concurrency limits of interactive and bulk requests
+	Email       Email       `yaml:"email"`       // Email delivery provider configuration
+	Event       Event       `yaml:"event"`       // Event business rules
+	Validation  Validation  `yaml:"validation"`  // Limits of request validation
+	Usage       Usage       `yaml:"usage"`       // API usage metering and quotas
+	Reminder    Reminder    `yaml:"reminder"`    // Reminder dispatch configuration
+	Import      Import      `yaml:"import"`      // Calendar archive imports
+	Job         Job         `yaml:"job"`         // Background job worker pool
+	Export      Export      `yaml:"export"`      // PDF agenda exports
+	Suggestion  Suggestion  `yaml:"suggestion"`  // Tag and project suggestions for new events
+	Report      Report      `yaml:"report"`      // Meeting load reports of attendee group owners
+	Embed       Embed       `yaml:"embed"`       // Public calendars embedded into websites
+	Feed        Feed        `yaml:"feed"`        // ICS subscription feeds for calendar clients
+	ShortLink   ShortLink   `yaml:"shortLink"`   // Short links sharing events with invitees
+	Archiver    Archiver    `yaml:"archiver"`    // Archiver configuration for periodic tasks
+	WebUI       WebUI       `yaml:"webUI"`       // Embedded calendar web UI for end users
+	Demo        Demo        `yaml:"demo"`        // Public demo mode with throwaway accounts
+	Unsubscribe Unsubscribe `yaml:"unsubscribe"` // Signed unsubscribe links in notification emails
+	Invitation  Invitation  `yaml:"invitation"`  // Email invitations of people without an account
 }
 
 // Server holds configuration for the HTTP server.
 type Server struct {
-	HTTPPort string `yaml:"httpPort"` // port on which the HTTP server listens
+	HTTPPort          string        `yaml:"httpPort"`          // port on which the HTTP server listens
+	DrainTimeout      time.Duration `yaml:"drainTimeout"`      // maximum time to finish in-flight requests on shutdown
+	ReadinessDelay    time.Duration `yaml:"readinessDelay"`    // time to report not ready before shutting down
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout"` // maximum time to read request headers; 0 means no limit
+	KeepAlive         KeepAlive     `yaml:"keepAlive"`         // connection reuse settings
+	TLS               TLS           `yaml:"tls"`               // TLS certificate; HTTP/2 is negotiated over TLS
+	HTTP2             HTTP2         `yaml:"http2"`             // HTTP/2 settings
+}
+
+// KeepAlive holds connection-level keep-alive settings.
+type KeepAlive struct {
+	Enabled     *bool         `yaml:"enabled"`     // reuse connections for several requests (HTTP/1.1 keep-alive); on when unset
+	IdleTimeout time.Duration `yaml:"idleTimeout"` // how long an idle connection is kept open
+	TCPPeriod   time.Duration `yaml:"tcpPeriod"`   // interval of TCP keep-alive probes; negative disables them
+}
+
+// On reports whether keep-alives are enabled. They are unless explicitly disabled, so configurations
+// without a keepAlive section keep reusing connections.
+func (k KeepAlive) On() bool {
+	return k.Enabled == nil || *k.Enabled
+}
+
+// TLS holds the certificate the server is served with. TLS is disabled when both files are empty.
+type TLS struct {
+	CertFile string `yaml:"certFile"` // path to the PEM certificate chain
+	KeyFile  string `yaml:"keyFile"`  // path to the PEM private key
+}
+
+// HTTP2 holds HTTP/2 settings, including per-stream and per-connection flow control windows.
+type HTTP2 struct {
+	Enabled                       bool          `yaml:"enabled"`                       // negotiate HTTP/2 via ALPN when TLS is enabled
+	MaxConcurrentStreams          int           `yaml:"maxConcurrentStreams"`          // concurrent streams per connection, each long-lived stream holds one
+	MaxReceiveBufferPerStream     int           `yaml:"maxReceiveBufferPerStream"`     // flow control window of a single stream, in bytes
+	MaxReceiveBufferPerConnection int           `yaml:"maxReceiveBufferPerConnection"` // flow control window of a connection, in bytes
+	SendPingTimeout               time.Duration `yaml:"sendPingTimeout"`               // ping idle connections after this long to detect dead peers; 0 disables
+	PingTimeout                   time.Duration `yaml:"pingTimeout"`                   // close the connection if a ping is not answered in time
+}
+
+// Maintenance holds the initial maintenance mode settings; they can be changed at runtime by admins.
+type Maintenance struct {
+	Enabled    bool          `yaml:"enabled"`    // start the service in maintenance mode
+	RetryAfter time.Duration `yaml:"retryAfter"` // Retry-After sent to clients while in maintenance mode
+	Message    string        `yaml:"message"`    // optional message returned to clients
+}
+
+// Logger holds configuration for application logging.
+type Logger struct {
+	Level           string   `yaml:"level"`           // minimum log level (debug, info, warn, error)
+	Encoding        string   `yaml:"encoding"`        // log encoding: json or console
+	File            LogFile  `yaml:"file"`            // optional rotated log file output
+	StrictRedaction bool     `yaml:"strictRedaction"` // also scrub messages, errors and debug bodies, and redact emails fully
+	Async           AsyncLog `yaml:"async"`           // asynchronous request log
+}
+
+// AsyncLog holds configuration for the asynchronous request log.
+type AsyncLog struct {
+	BufferSize    int           `yaml:"bufferSize"`    // entries buffered before new entries are dropped
+	BatchSize     int           `yaml:"batchSize"`     // maximum entries written per batch
+	FlushInterval time.Duration `yaml:"flushInterval"` // maximum time an entry waits for its batch to fill
+}
+
+// LogFile holds configuration for writing logs to a rotated file.
+type LogFile struct {
+	Path       string `yaml:"path"`       // log file path; file output is disabled when empty
+	MaxSize    int    `yaml:"maxSize"`    // maximum size in megabytes before rotation
+	MaxBackups int    `yaml:"maxBackups"` // maximum number of rotated files to keep
+	MaxAge     int    `yaml:"maxAge"`     // maximum number of days to keep rotated files
+	Compress   bool   `yaml:"compress"`   // whether rotated files are gzip-compressed
+}
+
+// Reporting holds configuration for the Sentry-compatible error tracker.
+type Reporting struct {
+	DSN         string  // error tracker DSN; reporting is disabled when empty
+	Environment string  `yaml:"environment"` // environment name attached to reported events
+	SampleRate  float64 `yaml:"sampleRate"`  // fraction of error events to send (0.0-1.0)
 }
 
 // Database holds configuration for connecting to a PostgreSQL database.
@@ -32,26 +130,288 @@ type Database struct {
 	Password string // Database password
 	Name     string // Database name
 	SSLMode  string `yaml:"sslmode"` // SSL mode for database connection
+
+	Region              string        `yaml:"region"`              // region of the primary database, which serves all writes
+	Replicas            []Replica     `yaml:"replicas"`            // regional read replicas serving lag-tolerant reads
+	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"` // how often replica health and latency are measured
+	SlowQueryThreshold  time.Duration `yaml:"slowQueryThreshold"`  // queries taking longer are logged with redacted parameters; 0 disables the log
+}
+
+// Replica is a regional read-only endpoint of the main database.
+type Replica struct {
+	Region string `yaml:"region"` // region the replica runs in, named in connection errors
+	URL    string `yaml:"url"`    // connection string of the replica
+}
+
+// Tenancy holds the tenant registry used to route database access per tenant.
+type Tenancy struct {
+	Enabled bool     `yaml:"enabled"` // route requests to tenant databases or schemas
+	Header  string   `yaml:"header"`  // request header carrying the tenant ID before login
+	Tenants []Tenant `yaml:"tenants"` // registered tenants
+}
+
+// Tenant describes where a single tenant's data lives.
+// A tenant with only a schema shares the main database (schema-per-tenant);
+// a tenant with a database URL gets its own database (database-per-tenant).
+type Tenant struct {
+	ID          string `yaml:"id"`          // tenant identifier sent by clients
+	Schema      string `yaml:"schema"`      // PostgreSQL schema holding the tenant's tables
+	DatabaseURL string `yaml:"databaseUrl"` // connection string of a dedicated database; the main database when empty
+}
+
+// Encryption holds configuration for application-level encryption of event content.
+type Encryption struct {
+	Enabled   bool   `yaml:"enabled"` // encrypt event titles and descriptions before storing them
+	MasterKey string // base64-encoded 256-bit key wrapping the per-user data keys
 }
 
 // JWT holds configuration for JSON Web Token authentication.
+// With an issuer or audience set, tokens are issued with it and tokens without it are rejected,
+// so tokens of other environments or services signed with the same secret cannot be replayed.
 type JWT struct {
-	Secret string        // Secret key for signing JWTs
-	TTL    time.Duration `yaml:"ttl"` // token time-to-live duration
+	Secret   string        // Secret key for signing JWTs
+	TTL      time.Duration `yaml:"ttl"`      // token time-to-live duration
+	Issuer   string        `yaml:"issuer"`   // "iss" claim of issued tokens, required on validation; not checked if empty
+	Audience string        `yaml:"audience"` // "aud" claim of issued tokens, required on validation; not checked if empty
+	Leeway   time.Duration `yaml:"leeway"`   // clock skew tolerated when checking the expiry and issue time of tokens
+}
+
+// Machine holds the clients of internal services that call the API with machine tokens instead of user accounts.
+// Clients exchange their ID and secret for a token with some of their scopes (client credentials grant);
+// machine tokens are signed like user tokens but are only accepted by the internal routes.
+type Machine struct {
+	TokenTTL time.Duration   `yaml:"tokenTTL"` // lifetime of issued machine tokens
+	Clients  []MachineClient `yaml:"clients"`  // registered clients; no machine tokens are issued when empty
+}
+
+// MachineClient is an internal service allowed to request machine tokens.
+type MachineClient struct {
+	ID         string   `yaml:"id"`         // client ID sent when requesting a token
+	SecretHash string   `yaml:"secretHash"` // hex-encoded SHA-256 of the client secret, so the secret itself is not configured
+	Scopes     []string `yaml:"scopes"`     // scopes the client may request, e.g. events:read, reminders:dispatch
 }
 
-// Email holds SMTP configuration for sending emails.
+// Captcha holds configuration for requiring a CAPTCHA after repeated failed logins or registrations.
+type Captcha struct {
+	Enabled   bool          `yaml:"enabled"`  // require a CAPTCHA from clients with too many failures
+	Provider  string        `yaml:"provider"` // verification provider: hcaptcha or recaptcha
+	Secret    string        // secret key of the site at the provider
+	Threshold int           `yaml:"threshold"` // failed attempts per IP after which a CAPTCHA is required
+	Window    time.Duration `yaml:"window"`    // how long failed attempts are remembered
+	Header    string        `yaml:"header"`    // request header carrying the solved CAPTCHA token
+}
+
+// Priority holds the concurrency limits that keep bulk requests from starving interactive ones.
+type Priority struct {
+	Enabled     bool          `yaml:"enabled"`     // limit concurrent API requests per class
+	Interactive int           `yaml:"interactive"` // concurrent interactive requests per instance
+	Bulk        int           `yaml:"bulk"`        // concurrent imports, exports and downloads per instance
+	MaxWait     time.Duration `yaml:"maxWait"`     // how long an interactive request waits for a slot
+	RetryAfter  time.Duration `yaml:"retryAfter"`  // Retry-After sent with 503 when no slot is free
+}
+
+// Email holds configuration for sending emails through the selected delivery provider.
 type Email struct {
-	SMTPHost string `mapstructure:"smtp_host"` // SMTP server host
-	SMTPPort string `mapstructure:"smtp_port"` // SMTP server port
-	Username string `mapstructure:"username"`  // SMTP username
-	Password string `mapstructure:"password"`  // SMTP password
-	From     string `mapstructure:"from"`      // sender email address
+	Provider string   `mapstructure:"provider"`  // delivery provider: smtp, ses, sendgrid or mailgun; smtp when empty
+	SMTPHost string   `mapstructure:"smtp_host"` // SMTP server host
+	SMTPPort string   `mapstructure:"smtp_port"` // SMTP server port
+	Username string   `mapstructure:"username"`  // SMTP username
+	Password string   `mapstructure:"password"`  // SMTP password
+	From     string   `mapstructure:"from"`      // sender email address, used by every provider
+	SES      SES      `mapstructure:"ses"`       // AWS SES settings
+	SendGrid SendGrid `mapstructure:"sendgrid"`  // SendGrid settings
+	Mailgun  Mailgun  `mapstructure:"mailgun"`   // Mailgun settings
+
+	Throttle EmailThrottle `mapstructure:"throttle"` // rate limits per recipient domain
+
+	DKIM            []DKIMKey `mapstructure:"dkim"`             // DKIM signing keys per sending domain, used by SMTP
+	BounceAddress   string    `mapstructure:"bounce_address"`   // envelope sender receiving bounces; the From address when empty
+	ListUnsubscribe string    `mapstructure:"list_unsubscribe"` // mailto: or https: address of the List-Unsubscribe header of notifications
+}
+
+// DKIMKey holds the DKIM signing key of one sending domain.
+type DKIMKey struct {
+	Domain         string `mapstructure:"domain"`           // sending domain, e.g. example.com
+	Selector       string `mapstructure:"selector"`         // selector of the public key record, e.g. mail for mail._domainkey.example.com
+	PrivateKeyFile string `mapstructure:"private_key_file"` // path of the PEM encoded RSA private key
+}
+
+// EmailThrottle holds the soft rate limits of emails per recipient domain.
+type EmailThrottle struct {
+	PerMinute int           `mapstructure:"per_minute"` // emails per minute to a domain without its own limit; 0 leaves them unlimited
+	Domains   []DomainLimit `mapstructure:"domains"`    // emails per minute to specific domains
+	MaxWait   time.Duration `mapstructure:"max_wait"`   // longest an email waits for a slot before it is deferred
+}
+
+// DomainLimit holds the rate limit of emails to one recipient domain.
+type DomainLimit struct {
+	Domain    string `mapstructure:"domain"`     // recipient domain, e.g. gmail.com
+	PerMinute int    `mapstructure:"per_minute"` // emails per minute to the domain
+}
+
+// SES holds configuration for sending emails through the AWS SES v2 API.
+type SES struct {
+	Region          string `mapstructure:"region"` // AWS region of the SES endpoint, e.g. eu-west-1
+	AccessKeyID     string // AWS access key ID
+	SecretAccessKey string // AWS secret access key
+	WebhookToken    string // token expected in the query of SNS bounce and complaint notifications
+}
+
+// SendGrid holds configuration for sending emails through the SendGrid v3 API.
+type SendGrid struct {
+	APIKey           string // API key with the mail send permission
+	WebhookPublicKey string // base64 public key verifying signed event webhooks
+}
+
+// Mailgun holds configuration for sending emails through the Mailgun API.
+type Mailgun struct {
+	Domain            string `mapstructure:"domain"`   // sending domain
+	BaseURL           string `mapstructure:"base_url"` // API base URL; https://api.eu.mailgun.net for EU domains
+	APIKey            string // private API key
+	WebhookSigningKey string // key verifying webhook signatures
+}
+
+// Event holds configuration for event business rules.
+type Event struct {
+	EnforceLinkOrder bool `yaml:"enforceLinkOrder"` // reject dates that place an event before an event it depends on
+	MaxPerUser       int  `yaml:"maxPerUser"`       // events a user may have, archived ones not counted; 0 disables the limit
+}
+
+// Validation holds the limits request payloads are validated against; 0 keeps the default of a limit.
+type Validation struct {
+	TitleMaxLength       int `yaml:"titleMaxLength"`       // characters of an event title, 255 by default
+	DescriptionMaxLength int `yaml:"descriptionMaxLength"` // characters of an event description, 1000 by default
+	PasswordMinLength    int `yaml:"passwordMinLength"`    // characters of the password of a new account, 8 by default
+	BulkMaxEvents        int `yaml:"bulkMaxEvents"`        // events a single bulk request may affect, 100 by default
+}
+
+// Usage holds configuration for per-user API usage metering.
+type Usage struct {
+	MonthlyQuota int64 `yaml:"monthlyQuota"` // API calls allowed per user and calendar month; 0 disables the limit
+}
+
+// Reminder holds configuration for dispatching reminders from the database.
+type Reminder struct {
+	PollInterval  time.Duration `yaml:"pollInterval"`  // how often due reminders are claimed
+	BatchSize     int           `yaml:"batchSize"`     // maximum number of reminders claimed per poll
+	LeaseDuration time.Duration `yaml:"leaseDuration"` // how long a claimed reminder is reserved for one instance
+	MaxAttempts   int           `yaml:"maxAttempts"`   // delivery attempts before a reminder is marked failed
+	RetryDelay    time.Duration `yaml:"retryDelay"`    // base delay between delivery attempts
+
+	HistoryRetention time.Duration `yaml:"historyRetention"` // how long sent and failed reminders are kept; 0 keeps them
+	TestCooldown     time.Duration `yaml:"testCooldown"`     // minimum time between test notifications of a user; 0 disables the limit
+}
+
+// Import holds limits for calendar archive imports.
+type Import struct {
+	MaxArchiveSize   int64 `yaml:"maxArchiveSize"`   // maximum size of an uploaded archive in bytes
+	MaxExtractedSize int64 `yaml:"maxExtractedSize"` // maximum total size of the calendar files in an archive in bytes
+}
+
+// Job holds configuration for the background job worker pool.
+type Job struct {
+	Workers           int           `yaml:"workers"`           // jobs run concurrently per instance
+	PollInterval      time.Duration `yaml:"pollInterval"`      // how often idle workers look for queued jobs
+	LeaseDuration     time.Duration `yaml:"leaseDuration"`     // how long a running job stays reserved without a heartbeat
+	HeartbeatInterval time.Duration `yaml:"heartbeatInterval"` // how often progress is stored and the lease extended
+	UndoWindow        time.Duration `yaml:"undoWindow"`        // how long after finishing a bulk operation can be rolled back
+}
+
+// Export holds limits for PDF agenda exports.
+type Export struct {
+	MaxSyncDays int `yaml:"maxSyncDays"` // longest range rendered while the request waits; longer ranges run as a job
+	MaxDays     int `yaml:"maxDays"`     // longest range that can be exported
+}
+
+// Suggestion holds configuration for the keyword models tags and projects of new events are suggested from.
+type Suggestion struct {
+	Interval      time.Duration `yaml:"interval"`      // how often outdated models are recomputed
+	BatchSize     int           `yaml:"batchSize"`     // maximum models recomputed per tenant and run
+	MaxEvents     int           `yaml:"maxEvents"`     // most recent events of a user a model is computed from
+	MinSupport    int           `yaml:"minSupport"`    // events a keyword must appear in before it suggests anything
+	MinConfidence float64       `yaml:"minConfidence"` // share of those events that must have a tag or project for it to be suggested
+}
+
+// Report holds configuration for the meeting load reports of attendee group owners, aggregated periodically.
+type Report struct {
+	Interval     time.Duration `yaml:"interval"`     // how often the meeting load of a group member is aggregated
+	BatchSize    int           `yaml:"batchSize"`    // maximum members aggregated per tenant and run
+	Weeks        int           `yaml:"weeks"`        // weeks aggregated per member, the current one included
+	WorkdayStart time.Duration `yaml:"workdayStart"` // start of the workday after midnight, in the time zone of the member
+	WorkdayEnd   time.Duration `yaml:"workdayEnd"`   // end of the workday after midnight; later meetings are after hours
+}
+
+// Feed holds the range and refresh interval of ICS subscription feeds.
+type Feed struct {
+	PastDays        int           `yaml:"pastDays"`        // days before today a feed includes
+	FutureDays      int           `yaml:"futureDays"`      // days after today a feed includes
+	RefreshInterval time.Duration `yaml:"refreshInterval"` // how often subscribed clients are asked to refresh a feed
+}
+
+// Embed holds limits and caching of calendars embedded into websites.
+type Embed struct {
+	MaxDays     int           `yaml:"maxDays"`     // longest range an embed can be requested for
+	CacheMaxAge time.Duration `yaml:"cacheMaxAge"` // how long browsers and CDNs may cache an embedded calendar
+}
+
+// WebUI holds the settings of the embedded calendar web UI.
+type WebUI struct {
+	Enabled bool `yaml:"enabled"` // serve the calendar UI at /; disable when a separate frontend is deployed
+}
+
+// Demo holds the settings of the public demo mode, for hosting an instance anyone can try.
+type Demo struct {
+	Enabled         bool          `yaml:"enabled"`         // register throwaway accounts with sample data and disable global admin changes
+	Retention       time.Duration `yaml:"retention"`       // how long a demo account lives before it is deleted with its data
+	CleanupInterval time.Duration `yaml:"cleanupInterval"` // how often expired demo accounts are deleted
+}
+
+// ShortLink holds the expiry limits of short links to events.
+type ShortLink struct {
+	DefaultTTL time.Duration `yaml:"defaultTTL"` // lifetime of links created without an expiry
+	MaxTTL     time.Duration `yaml:"maxTTL"`     // longest lifetime a link can be created with
+}
+
+// Unsubscribe holds the settings of the signed unsubscribe links in notification emails.
+type Unsubscribe struct {
+	BaseURL string `yaml:"baseURL"` // public URL of the service the links point to, e.g. https://calendar.example.com; no links when empty
+	Secret  string // key signing the links; the JWT secret when empty
+}
+
+// Invitation holds the settings of the invitations emailed to people invited to events without an account,
+// and of the RSVP links emailed to the guests of events shared by short links.
+type Invitation struct {
+	BaseURL       string        `yaml:"baseURL"`       // public URL of the service RSVP links point to; only registered users can be invited when empty
+	GuestTTL      time.Duration `yaml:"guestTTL"`      // lifetime of the RSVP links emailed to guests of shared events; 24h when not set
+	GuestCooldown time.Duration `yaml:"guestCooldown"` // shortest time between two RSVP links emailed to a guest of an event; 1m when not set
 }
 
 // Archiver holds configuration for the archiver service.
 type Archiver struct {
-	Interval time.Duration `yaml:"interval"` // Interval for running the archiver task
+	Interval   time.Duration `yaml:"interval"`   // Interval for running the archiver task
+	BatchSize  int           `yaml:"batchSize"`  // maximum events archived per transaction
+	Pause      time.Duration `yaml:"pause"`      // pause between batches to limit load and replication lag
+	MaxBatches int           `yaml:"maxBatches"` // maximum batches per tenant and run; 0 archives until done
+	DualWrite  bool          `yaml:"dualWrite"`  // also write reminders in the new archive format, archived_events.reminders
+
+	TrashRetention time.Duration `yaml:"trashRetention"` // time deleted events stay in the trash before they are purged; 0 keeps them
+	TrashNotice    time.Duration `yaml:"trashNotice"`    // time before the purge owners are notified; 0 purges without a notice
+
+	ColdStorage ColdStorage `yaml:"coldStorage"` // export of long-archived events to S3
+}
+
+// ColdStorage holds configuration for exporting archived events to S3 or an S3-compatible object store.
+// Once enabled, events archived for longer than the retention are written to the bucket as gzipped
+// newline-delimited JSON and deleted from the database; an admin job restores them into the archive.
+type ColdStorage struct {
+	Enabled         bool          `yaml:"enabled"`   // whether the archiver exports archived events
+	Retention       time.Duration `yaml:"retention"` // time events stay archived in the database before they are exported
+	Bucket          string        `yaml:"bucket"`    // bucket the exports are written to
+	Prefix          string        `yaml:"prefix"`    // key prefix of the exports, e.g. "calendar/"
+	Region          string        `yaml:"region"`    // AWS region of the bucket, e.g. eu-west-1
+	Endpoint        string        `yaml:"endpoint"`  // endpoint of an S3-compatible store, e.g. MinIO; AWS S3 when empty
+	AccessKeyID     string        // AWS access key ID
+	SecretAccessKey string        // AWS secret access key
 }
 
 // DatabaseURL builds a PostgreSQL connection string based on the Database configuration.
@@ -94,6 +454,22 @@ func Must() *Config {
 		log.Panicf("fatal error config file: %s \n", err)
 	}
 
+	// Override log level with environment variable, e.g. LOG_LEVEL=debug for development.
+	if level := os.Getenv("LOG_LEVEL"); level != "" {
+		cfg.Logger.Level = level
+	}
+
+	// Override TLS certificate paths with environment variables, if set.
+	if certFile := os.Getenv("TLS_CERT_FILE"); certFile != "" {
+		cfg.Server.TLS.CertFile = certFile
+	}
+	if keyFile := os.Getenv("TLS_KEY_FILE"); keyFile != "" {
+		cfg.Server.TLS.KeyFile = keyFile
+	}
+
+	// Override error tracker DSN with environment variable.
+	cfg.Reporting.DSN = os.Getenv("SENTRY_DSN")
+
 	// Override database configuration with environment variables.
 	cfg.Database.Host = os.Getenv("DB_HOST")
 	cfg.Database.Port = os.Getenv("DB_PORT")
@@ -101,9 +477,21 @@ func Must() *Config {
 	cfg.Database.Password = os.Getenv("DB_PASSWORD")
 	cfg.Database.Name = os.Getenv("DB_NAME")
 
+	// Override encryption master key with environment variable.
+	cfg.Encryption.MasterKey = os.Getenv("ENCRYPTION_MASTER_KEY")
+
 	// Override JWT secret with environment variable.
 	cfg.JWT.Secret = os.Getenv("JWT_SECRET")
 
+	// Override unsubscribe link secret with environment variable, falling back to the JWT secret.
+	cfg.Unsubscribe.Secret = os.Getenv("UNSUBSCRIBE_SECRET")
+	if cfg.Unsubscribe.Secret == "" {
+		cfg.Unsubscribe.Secret = cfg.JWT.Secret
+	}
+
+	// Override CAPTCHA secret with environment variable.
+	cfg.Captcha.Secret = os.Getenv("CAPTCHA_SECRET")
+
 	// Override email configuration with environment variables.
 	cfg.Email.SMTPHost = os.Getenv("SMTP_HOST")
 	cfg.Email.SMTPPort = os.Getenv("SMTP_PORT")
@@ -111,5 +499,18 @@ func Must() *Config {
 	cfg.Email.Password = os.Getenv("SMTP_PASS")
 	cfg.Email.From = os.Getenv("SMTP_FROM")
 
+	// Override email provider credentials with environment variables.
+	cfg.Email.SES.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+	cfg.Email.SES.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	cfg.Email.SES.WebhookToken = os.Getenv("SES_WEBHOOK_TOKEN")
+	cfg.Email.SendGrid.APIKey = os.Getenv("SENDGRID_API_KEY")
+	cfg.Email.SendGrid.WebhookPublicKey = os.Getenv("SENDGRID_WEBHOOK_PUBLIC_KEY")
+	cfg.Email.Mailgun.APIKey = os.Getenv("MAILGUN_API_KEY")
+	cfg.Email.Mailgun.WebhookSigningKey = os.Getenv("MAILGUN_WEBHOOK_SIGNING_KEY")
+
+	// Override cold storage credentials with environment variables, shared with SES.
+	cfg.Archiver.ColdStorage.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+	cfg.Archiver.ColdStorage.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+
 	return &cfg
 }
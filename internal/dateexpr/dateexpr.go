@@ -0,0 +1,163 @@
+package dateexpr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidExpression = errors.New("invalid date expression")
+	ErrInvalidTimezone   = errors.New("invalid time zone")
+)
+
+// maxOffsetDigits caps the number of an offset, so it cannot overflow.
+const maxOffsetDigits = 5
+
+// weekdays maps weekday names to their time.Weekday value.
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Parse resolves a date expression of a query parameter into a calendar date.
+// Relative expressions are resolved against the date of now in its time zone, so "today" is the
+// user's today when now is in the user's zone. Supported expressions:
+//   - an absolute date: 2025-10-15
+//   - today, tomorrow, yesterday
+//   - next-<weekday> and last-<weekday>: the closest such weekday after or before today, e.g. next-monday
+//   - an offset from today in days, weeks, months or years: -7d, 2w, +1m, -1y; a missing sign means the future.
+//     Month and year offsets keep the day of the month, clamped to the last day of shorter months.
+//
+// Expressions are case-insensitive. An offset's "+" may arrive as a space when it is not URL-encoded,
+// so surrounding spaces are ignored.
+//
+// Parameters:
+//   - expr: The date expression.
+//   - now: The current time in the time zone relative expressions are resolved in.
+//
+// Returns:
+//   - The date at midnight UTC, like time.Parse(time.DateOnly, ...) returns it.
+//   - ErrInvalidExpression if the expression is empty or not supported.
+func Parse(expr string, now time.Time) (time.Time, error) {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	if expr == "" {
+		return time.Time{}, ErrInvalidExpression
+	}
+
+	if d, err := time.Parse(time.DateOnly, expr); err == nil {
+		return d, nil
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch expr {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if name, ok := strings.CutPrefix(expr, "next-"); ok {
+		if wd, ok := weekdays[name]; ok {
+			return today.AddDate(0, 0, 7-(int(today.Weekday())-int(wd)+7)%7), nil
+		}
+	}
+	if name, ok := strings.CutPrefix(expr, "last-"); ok {
+		if wd, ok := weekdays[name]; ok {
+			return today.AddDate(0, 0, -(7 - (int(wd)-int(today.Weekday())+7)%7)), nil
+		}
+	}
+
+	return parseOffset(expr, today)
+}
+
+// Parsed resolves an optional date expression; an empty expression is nil.
+//
+// Parameters:
+//   - expr: The date expression, or an empty string.
+//   - now: The current time in the time zone relative expressions are resolved in.
+//
+// Returns:
+//   - The date at midnight UTC, or nil for an empty expression.
+//   - ErrInvalidExpression if the expression is not supported.
+func Parsed(expr string, now time.Time) (*time.Time, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+
+	d, err := Parse(expr, now)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Location loads the time zone relative expressions are resolved in.
+//
+// Parameters:
+//   - name: The IANA time zone name; empty means UTC.
+//
+// Returns:
+//   - The time zone.
+//   - ErrInvalidTimezone if the zone is unknown.
+func Location(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || loc == time.Local {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+
+	return loc, nil
+}
+
+// parseOffset resolves an offset such as -7d, 2w, +1m or -1y from today.
+func parseOffset(expr string, today time.Time) (time.Time, error) {
+	sign := 1
+	switch expr[0] {
+	case '-':
+		sign, expr = -1, expr[1:]
+	case '+':
+		expr = expr[1:]
+	}
+
+	digits := expr[:max(len(expr)-1, 0)]
+	if digits == "" || strings.Trim(digits, "0123456789") != "" || len(digits) > maxOffsetDigits {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidExpression, expr)
+	}
+
+	n, _ := strconv.Atoi(digits)
+	n *= sign
+
+	switch expr[len(expr)-1] {
+	case 'd':
+		return today.AddDate(0, 0, n), nil
+	case 'w':
+		return today.AddDate(0, 0, 7*n), nil
+	case 'm':
+		return addMonths(today, n), nil
+	case 'y':
+		return addMonths(today, 12*n), nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidExpression, expr)
+	}
+}
+
+// addMonths adds n months to a date, clamping the day to the last day of the resulting month.
+func addMonths(d time.Time, n int) time.Time {
+	first := time.Date(d.Year(), d.Month()+time.Month(n), 1, 0, 0, 0, 0, d.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(d.Day(), last)-1)
+}
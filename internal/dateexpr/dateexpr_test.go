@@ -0,0 +1,75 @@
+package dateexpr
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	// Wednesday, 2025-10-15 in UTC, already Thursday in Tokyo.
+	now := time.Date(2025, 10, 15, 20, 0, 0, 0, time.UTC)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		expr string
+		now  time.Time
+		want time.Time
+	}{
+		{"2024-02-29", now, date(2024, 2, 29)},
+		{"today", now, date(2025, 10, 15)},
+		{"Today", now.In(tokyo), date(2025, 10, 16)},
+		{"tomorrow", now, date(2025, 10, 16)},
+		{"yesterday", now, date(2025, 10, 14)},
+		{"next-monday", now, date(2025, 10, 20)},
+		{"next-wednesday", now, date(2025, 10, 22)},
+		{"next-thursday", now.In(tokyo), date(2025, 10, 23)},
+		{"last-friday", now, date(2025, 10, 10)},
+		{"last-wednesday", now, date(2025, 10, 8)},
+		{"-7d", now, date(2025, 10, 8)},
+		{"7d", now, date(2025, 10, 22)},
+		{" 7d", now, date(2025, 10, 22)},
+		{"+2w", now, date(2025, 10, 29)},
+		{"-1y", now, date(2024, 10, 15)},
+		{"1m", time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC), date(2025, 2, 28)},
+		{"-1y", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC), date(2023, 2, 28)},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.expr, tt.now)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.expr, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Fatalf("%q: expected %s, got %s", tt.expr, tt.want.Format(time.DateOnly), got.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "soon", "next-someday", "d", "-d", "7", "7h", "--7d", "+-7d", "1000000d", "2025-13-01"} {
+		if _, err := Parse(expr, time.Now()); !errors.Is(err, ErrInvalidExpression) {
+			t.Fatalf("%q: expected ErrInvalidExpression, got %v", expr, err)
+		}
+	}
+}
+
+func TestParsed_Empty(t *testing.T) {
+	d, err := Parsed("", time.Now())
+	if err != nil || d != nil {
+		t.Fatalf("expected nil for an empty expression, got %v, %v", d, err)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	if loc, err := Location(""); err != nil || loc != time.UTC {
+		t.Fatalf("expected UTC by default, got %v, %v", loc, err)
+	}
+	if _, err := Location("Mars/Olympus"); !errors.Is(err, ErrInvalidTimezone) {
+		t.Fatalf("expected ErrInvalidTimezone, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package dbtrace
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/metrics"
+)
+
+// unknownQuery names queries run by the pools themselves, e.g. replica health checks.
+const unknownQuery = "unknown"
+
+// queryKey is the context key of the query being traced.
+type queryKey struct{}
+
+// query is a query being traced, between its start and its end.
+type query struct {
+	name  string        // name of the query, see Name
+	sql   string        // SQL of the query
+	args  []interface{} // bound parameters, never logged
+	start time.Time     // time the query started
+}
+
+// Tracer is a pgx.QueryTracer recording the duration, rows and errors of every query in metrics.Queries,
+// per query name, and logging queries slower than a threshold.
+type Tracer struct {
+	slow   time.Duration // queries taking longer are logged; 0 disables the log
+	logger *zap.Logger   // logger of slow queries
+}
+
+// New creates a new Tracer.
+//
+// Parameters:
+//   - slow: The duration above which queries are logged as slow; 0 disables the log.
+//   - l: The logger for slow queries.
+//
+// Returns:
+//   - A pointer to the initialized Tracer.
+func New(slow time.Duration, l *zap.Logger) *Tracer {
+	return &Tracer{
+		slow:   slow,
+		logger: l,
+	}
+}
+
+// TraceQueryStart records the start of a query and names it after the repository method running it.
+func (t *Tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryKey{}, &query{
+		name:  Name(),
+		sql:   data.SQL,
+		args:  data.Args,
+		start: time.Now(),
+	})
+}
+
+// TraceQueryEnd records the duration, rows and error of a query, and logs it if it was slow.
+// The SQL is logged with the types of its parameters only, as their values may be personal data.
+func (t *Tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	q, ok := ctx.Value(queryKey{}).(*query)
+	if !ok {
+		return
+	}
+
+	d := time.Since(q.start)
+	rows := data.CommandTag.RowsAffected()
+	metrics.ObserveQuery(q.name, d, rows, data.Err != nil)
+
+	if t.slow <= 0 || d <= t.slow {
+		return
+	}
+
+	metrics.SlowQueries.Add(1)
+	t.logger.Warn("slow query",
+		zap.String("query", q.name),
+		zap.Duration("duration", d),
+		zap.Int64("rows", rows),
+		zap.String("sql", strings.Join(strings.Fields(q.sql), " ")),
+		zap.Strings("params", Redact(q.args)),
+		zap.Error(data.Err),
+	)
+}
+
+// Name names the query run by the caller after the first function on the stack outside pgx and the
+// connection pools, usually a repository method: event.GetEvent for (*event.Repository).GetEvent.
+//
+// Returns:
+//   - The name of the query; "unknown" if no such function is found.
+func Name() string {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !skipFrame(frame.Function) {
+			return shortName(frame.Function)
+		}
+		if !more {
+			return unknownQuery
+		}
+	}
+}
+
+// Redact replaces the values of bound parameters by their types.
+//
+// Parameters:
+//   - args: The bound parameters of a query.
+//
+// Returns:
+//   - The types of the parameters, e.g. [uuid.UUID time.Time].
+func Redact(args []interface{}) []string {
+	redacted := make([]string, len(args))
+	for i, a := range args {
+		redacted[i] = fmt.Sprintf("%T", a)
+	}
+	return redacted
+}
+
+// skipFrame reports whether a function belongs to the query machinery rather than to the code running the query.
+func skipFrame(function string) bool {
+	for _, prefix := range []string{
+		"runtime.",
+		"github.com/jackc/pgx/",
+		"github.com/aliskhannn/calendar-service/internal/dbtrace.",
+		"github.com/aliskhannn/calendar-service/internal/tenancy.",
+	} {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortName shortens a function name to its package and method, dropping the receiver of repository methods
+// and the numbering of closures, so all queries of a method share a name.
+func shortName(function string) string {
+	name := function[strings.LastIndex(function, "/")+1:]
+	name = strings.Replace(name, "(*Repository).", "", 1)
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
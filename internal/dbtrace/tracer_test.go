@@ -0,0 +1,78 @@
+package dbtrace_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/aliskhannn/calendar-service/internal/dbtrace"
+	"github.com/aliskhannn/calendar-service/internal/metrics"
+)
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "dbtrace_test.TestName", dbtrace.Name())
+
+	// Closures share the name of the function they are declared in.
+	var name string
+	func() { name = dbtrace.Name() }()
+	assert.Equal(t, "dbtrace_test.TestName", name)
+}
+
+func TestTracer_SlowQuery(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	tracer := dbtrace.New(time.Millisecond, zap.New(core))
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+		SQL:  "SELECT id\n\t\tFROM events\n\t\tWHERE user_id = $1",
+		Args: []interface{}{uuid.New(), "alice@example.com"},
+	})
+	time.Sleep(2 * time.Millisecond)
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")})
+
+	entries := logs.All()
+	assert.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "dbtrace_test.TestTracer_SlowQuery", fields["query"])
+	assert.Equal(t, "SELECT id FROM events WHERE user_id = $1", fields["sql"])
+	assert.Equal(t, []interface{}{"uuid.UUID", "string"}, fields["params"])
+	assert.Equal(t, int64(3), fields["rows"])
+
+	var stats struct {
+		Count  int64 `json:"count"`
+		Errors int64 `json:"errors"`
+		Rows   int64 `json:"rows"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(metrics.Queries.Get("dbtrace_test.TestTracer_SlowQuery").String()), &stats))
+	assert.Equal(t, int64(1), stats.Count)
+	assert.Equal(t, int64(3), stats.Rows)
+}
+
+func TestTracer_FastQueryFails(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	tracer := dbtrace.New(time.Minute, zap.New(core))
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("connection reset")})
+
+	assert.Empty(t, logs.All(), "only slow queries are logged")
+
+	var stats struct {
+		Count     int64            `json:"count"`
+		Errors    int64            `json:"errors"`
+		Histogram map[string]int64 `json:"duration_ms_bucket"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(metrics.Queries.Get("dbtrace_test.TestTracer_FastQueryFails").String()), &stats))
+	assert.Equal(t, int64(1), stats.Count)
+	assert.Equal(t, int64(1), stats.Errors)
+	assert.Equal(t, int64(1), stats.Histogram["+Inf"])
+}
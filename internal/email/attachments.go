@@ -0,0 +1,87 @@
+package email
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/ical"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// attachmentsKey is the context key of the attachments of a message.
+type attachmentsKey struct{}
+
+// uidDomain is appended to event IDs to form the UIDs of attached events, as in published feeds.
+const uidDomain = "@calendar-service"
+
+// Attachment is a file attached to a message, e.g. an iCalendar invitation.
+type Attachment struct {
+	Filename    string // file name shown by mail clients
+	ContentType string // MIME type, e.g. text/calendar; charset=utf-8
+	Content     []byte // file content
+}
+
+// WithAttachments returns a copy of ctx whose messages carry attachments, in addition to any attachments already in ctx.
+// Providers send the message as multipart MIME with the plain text body first, the way their API allows.
+//
+// Parameters:
+//   - ctx: The parent context.
+//   - attachments: The attachments, in the order they are attached.
+//
+// Returns:
+//   - The context carrying the attachments.
+func WithAttachments(ctx context.Context, attachments ...Attachment) context.Context {
+	existing := AttachmentsFromContext(ctx)
+	merged := make([]Attachment, 0, len(existing)+len(attachments))
+	merged = append(merged, existing...)
+	merged = append(merged, attachments...)
+	return context.WithValue(ctx, attachmentsKey{}, merged)
+}
+
+// AttachmentsFromContext returns the attachments of the messages sent with ctx.
+func AttachmentsFromContext(ctx context.Context) []Attachment {
+	attachments, _ := ctx.Value(attachmentsKey{}).([]Attachment)
+	return attachments
+}
+
+// EventAttachment returns an event as an iCalendar file for invitations and change notifications.
+// The reminder of the event becomes a display alarm, relative to the start for reminders with a lead time,
+// so calendar clients importing the file remind the recipient when the service does.
+// Recurring events keep their rule and exceptions; overridden occurrences are not included.
+//
+// Parameters:
+//   - e: The event, decrypted; an empty description is left out.
+//
+// Returns:
+//   - The attachment, named invite.ics.
+//   - An error if the calendar cannot be written.
+func EventAttachment(e model.Event) (Attachment, error) {
+	event := ical.Event{
+		UID:         e.ID.String() + uidDomain,
+		Summary:     e.Title,
+		Description: e.Description,
+		Start:       e.EventDate,
+		Rule:        e.RecurrenceRule,
+		Exceptions:  e.RecurrenceExceptions,
+		Updated:     e.UpdatedAt,
+	}
+	if e.EndDate != nil {
+		event.End = *e.EndDate
+	}
+	if n := e.Notifications; n != nil && n.LeadTime > 0 {
+		event.Alarms = []ical.Alarm{{Offset: -n.LeadTime}}
+	} else if e.ReminderAt != nil {
+		event.Alarms = []ical.Alarm{{At: e.ReminderAt}}
+	}
+
+	var b strings.Builder
+	if err := ical.Write(&b, ical.Calendar{Events: []ical.Event{event}}, 0); err != nil {
+		return Attachment{}, err
+	}
+
+	return Attachment{
+		Filename:    "invite.ics",
+		ContentType: "text/calendar; charset=utf-8",
+		Content:     []byte(b.String()),
+	}, nil
+}
@@ -0,0 +1,145 @@
+package email
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var invite = Attachment{Filename: "invite.ics", ContentType: "text/calendar; charset=utf-8", Content: []byte("BEGIN:VCALENDAR\r\n")}
+
+func TestWithAttachments(t *testing.T) {
+	other := Attachment{Filename: "notes.txt", ContentType: "text/plain", Content: []byte("notes")}
+	ctx := WithAttachments(context.Background(), invite)
+
+	assert.Equal(t, []Attachment{invite, other}, AttachmentsFromContext(WithAttachments(ctx, other)))
+	assert.Equal(t, []Attachment{invite}, AttachmentsFromContext(ctx), "the parent context keeps its attachments")
+	assert.Empty(t, AttachmentsFromContext(context.Background()))
+}
+
+func TestEventAttachment(t *testing.T) {
+	start := time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	remindAt := start.Add(-time.Hour)
+	event := model.Event{ID: uuid.New(), Title: "Planning", EventDate: start, EndDate: &end, ReminderAt: &remindAt, UpdatedAt: start}
+
+	a, err := EventAttachment(event)
+	require.NoError(t, err)
+	assert.Equal(t, "invite.ics", a.Filename)
+	assert.Equal(t, "text/calendar; charset=utf-8", a.ContentType)
+	assert.Contains(t, string(a.Content), "UID:"+event.ID.String()+"@calendar-service\r\n")
+	assert.Contains(t, string(a.Content), "TRIGGER;VALUE=DATE-TIME:20300107T090000Z\r\n")
+
+	// A lead time keeps the alarm relative to the start, like the reminder.
+	event.Notifications = &model.EventNotifications{LeadTime: 15 * time.Minute}
+	a, err = EventAttachment(event)
+	require.NoError(t, err)
+	assert.Contains(t, string(a.Content), "TRIGGER:-PT15M\r\n")
+
+	event.Notifications, event.ReminderAt = nil, nil
+	a, err = EventAttachment(event)
+	require.NoError(t, err)
+	assert.NotContains(t, string(a.Content), "VALARM")
+}
+
+func TestSMTP_MessageWithAttachment(t *testing.T) {
+	p, err := NewSMTP(config.Email{SMTPPort: "587", From: "calendar@example.com"}, nil)
+	require.NoError(t, err)
+
+	msg, err := p.message(WithAttachments(context.Background(), invite), "user@example.org", "Invitation", "Join us")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(msg), "Content-Type: multipart/mixed;")
+	assert.Contains(t, string(msg), "Content-Type: text/calendar; charset=utf-8\r\n")
+	assert.Contains(t, string(msg), `filename="invite.ics"`)
+}
+
+func TestSES_SendWithAttachment(t *testing.T) {
+	var got sesRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := newSES("")
+	p.baseURL = srv.URL
+
+	require.NoError(t, p.Send(WithAttachments(context.Background(), invite), "user@example.com", "Invitation", "Join us"))
+	assert.Equal(t, []sesAttachment{{
+		FileName:           "invite.ics",
+		ContentType:        "text/calendar; charset=utf-8",
+		ContentDisposition: "ATTACHMENT",
+		RawContent:         invite.Content,
+	}}, got.Content.Simple.Attachments)
+}
+
+func TestSendGrid_SendWithAttachment(t *testing.T) {
+	var got sendGridRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	p, _ := newSendGrid(t)
+	p.baseURL = srv.URL
+
+	require.NoError(t, p.Send(WithAttachments(context.Background(), invite), "user@example.com", "Invitation", "Join us"))
+	assert.Equal(t, []sendGridAttachment{{
+		Content:     base64.StdEncoding.EncodeToString(invite.Content),
+		Type:        "text/calendar; charset=utf-8",
+		Filename:    "invite.ics",
+		Disposition: "attachment",
+	}}, got.Attachments)
+}
+
+func TestMailgun_SendWithAttachment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/form-data", mediaType)
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "Invitation", r.FormValue("subject"))
+		assert.Equal(t, "Join us", r.FormValue("text"))
+		require.Len(t, r.MultipartForm.File["attachment"], 1)
+
+		file := r.MultipartForm.File["attachment"][0]
+		assert.Equal(t, "invite.ics", file.Filename)
+		assert.Equal(t, "text/calendar; charset=utf-8", file.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	require.NoError(t, newMailgun(srv.URL).Send(WithAttachments(context.Background(), invite), "user@example.com", "Invitation", "Join us"))
+}
+
+// The fields of a message are kept next to its attachments.
+func TestMultipartForm(t *testing.T) {
+	payload, contentType, err := multipartForm(map[string][]string{"to": {"user@example.com"}}, []Attachment{invite})
+	require.NoError(t, err)
+
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	form, err := multipart.NewReader(strings.NewReader(string(payload)), params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user@example.com"}, form.Value["to"])
+}
@@ -0,0 +1,209 @@
+package email
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// ErrInvalidDKIMKey is returned when a configured DKIM private key cannot be used.
+var ErrInvalidDKIMKey = errors.New("invalid dkim key")
+
+// dkimHeaders lists the headers covered by DKIM signatures, if present in the message.
+var dkimHeaders = []string{
+	"from", "sender", "to", "cc", "subject", "date", "message-id", "reply-to",
+	"mime-version", "content-type", "content-transfer-encoding", "list-unsubscribe", "list-unsubscribe-post",
+}
+
+// dkimKey is the signing key of one sending domain.
+type dkimKey struct {
+	domain   string          // signing domain, the d= tag
+	selector string          // selector of the public key in DNS, the s= tag
+	key      *rsa.PrivateKey // private key
+}
+
+// DKIMSigner signs outgoing messages with the DKIM key of their sending domain (RFC 6376),
+// using rsa-sha256 and relaxed canonicalization of the header and body.
+type DKIMSigner struct {
+	keys  map[string]dkimKey // keys by lower-case sending domain
+	clock clock.Clock        // source of the signature timestamp
+}
+
+// NewDKIMSigner loads the DKIM keys of the configuration.
+//
+// Parameters:
+//   - keys: The signing keys, one per sending domain.
+//   - clk: The clock stamping the signatures.
+//
+// Returns:
+//   - The signer, or nil if no key is configured.
+//   - An error if a key cannot be read or is not an RSA private key.
+func NewDKIMSigner(keys []config.DKIMKey, clk clock.Clock) (*DKIMSigner, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	s := &DKIMSigner{keys: make(map[string]dkimKey, len(keys)), clock: clk}
+	for _, k := range keys {
+		pemData, err := os.ReadFile(k.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read dkim key of %s: %w", k.Domain, err)
+		}
+
+		key, err := parseRSAKey(pemData)
+		if err != nil {
+			return nil, fmt.Errorf("%w of %s: %v", ErrInvalidDKIMKey, k.Domain, err)
+		}
+
+		domain := strings.ToLower(k.Domain)
+		s.keys[domain] = dkimKey{domain: domain, selector: k.Selector, key: key}
+	}
+
+	return s, nil
+}
+
+// Sign adds a DKIM-Signature header to a message signed with the key of the domain of its From address.
+// Messages from a domain without a key are returned unchanged.
+//
+// Parameters:
+//   - msg: The message with CRLF line endings, headers first.
+//
+// Returns:
+//   - The signed message.
+//   - An error if the message has no header section or signing fails.
+func (s *DKIMSigner) Sign(msg []byte) ([]byte, error) {
+	header, body, ok := bytes.Cut(msg, []byte("\r\n\r\n"))
+	if !ok {
+		return nil, fmt.Errorf("dkim: message has no body separator")
+	}
+
+	fields := splitHeader(string(header) + "\r\n")
+	key, ok := s.keys[domainOf(fieldValue(fields, "from"))]
+	if !ok {
+		return msg, nil
+	}
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+
+	var names []string
+	var signed strings.Builder
+	for _, name := range dkimHeaders {
+		if field, ok := findField(fields, name); ok {
+			names = append(names, name)
+			signed.WriteString(relaxedHeader(field))
+		}
+	}
+
+	value := fmt.Sprintf("v=1; a=rsa-sha256; c=relaxed/relaxed; d=%s; s=%s; t=%s; h=%s; bh=%s; b=",
+		key.domain, key.selector, strconv.FormatInt(s.clock.Now().Unix(), 10),
+		strings.Join(names, ":"), base64.StdEncoding.EncodeToString(bodyHash[:]))
+	signed.WriteString(strings.TrimSuffix(relaxedHeader("DKIM-Signature: "+value), "\r\n"))
+
+	digest := sha256.Sum256([]byte(signed.String()))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("dkim: sign: %w", err)
+	}
+
+	out := make([]byte, 0, len(msg)+512)
+	out = append(out, "DKIM-Signature: "+value+base64.StdEncoding.EncodeToString(signature)+"\r\n"...)
+	return append(out, msg...), nil
+}
+
+// splitHeader splits a header section into its fields, keeping folded continuation lines with their field.
+func splitHeader(header string) []string {
+	var fields []string
+	for _, line := range strings.SplitAfter(header, "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1] += line
+			continue
+		}
+		fields = append(fields, line)
+	}
+	return fields
+}
+
+// findField returns the last field of the given lower-case name, the one a verifier picks first.
+func findField(fields []string, name string) (string, bool) {
+	for i := len(fields) - 1; i >= 0; i-- {
+		if n, _, ok := strings.Cut(fields[i], ":"); ok && strings.EqualFold(strings.TrimSpace(n), name) {
+			return fields[i], true
+		}
+	}
+	return "", false
+}
+
+// fieldValue returns the unfolded value of the last field of the given name.
+func fieldValue(fields []string, name string) string {
+	field, _ := findField(fields, name)
+	_, value, _ := strings.Cut(field, ":")
+	return strings.TrimSpace(strings.NewReplacer("\r\n", "").Replace(value))
+}
+
+// relaxedHeader canonicalizes a header field with the relaxed algorithm: lower-case name,
+// unfolded value with runs of whitespace reduced to one space and no leading or trailing whitespace.
+func relaxedHeader(field string) string {
+	name, value, _ := strings.Cut(field, ":")
+	value = strings.Join(strings.Fields(strings.ReplaceAll(value, "\r\n", "")), " ")
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + value + "\r\n"
+}
+
+// relaxedBody canonicalizes a message body with the relaxed algorithm: runs of whitespace within lines
+// are reduced to one space, trailing whitespace and trailing empty lines are removed.
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		lines[i] = strings.Join(strings.FieldsFunc(line, func(r rune) bool { return r == ' ' || r == '\t' }), " ")
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			lines[i] = " " + lines[i]
+		}
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+// parseRSAKey parses a PEM encoded RSA private key in PKCS #1 or PKCS #8 form.
+func parseRSAKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an RSA key")
+	}
+
+	return rsaKey, nil
+}
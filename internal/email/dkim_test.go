@@ -0,0 +1,130 @@
+package email
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// writeKey stores a new RSA key as a PKCS #1 PEM file and returns the key and the file's path.
+func writeKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "dkim.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return key, path
+}
+
+// dkimTags parses the tags of a DKIM-Signature header value.
+func dkimTags(value string) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(value, ";") {
+		name, v, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		tags[name] = v
+	}
+	return tags
+}
+
+func TestRelaxedCanonicalization(t *testing.T) {
+	// The example of RFC 6376, section 3.4.5.
+	assert.Equal(t, "a:X\r\n", relaxedHeader("A: X\r\n"))
+	assert.Equal(t, "b:Y Z\r\n", relaxedHeader("B : Y\t\r\n\tZ  \r\n"))
+	assert.Equal(t, []byte(" C\r\nD E\r\n"), relaxedBody([]byte(" C \r\nD \t E\r\n\r\n\r\n")))
+	assert.Nil(t, relaxedBody([]byte("\r\n\r\n")))
+}
+
+func TestDKIMSigner_Sign(t *testing.T) {
+	key, path := writeKey(t)
+	signer, err := NewDKIMSigner([]config.DKIMKey{{Domain: "Example.com", Selector: "mail", PrivateKeyFile: path}}, clock.NewFake(epoch))
+	require.NoError(t, err)
+
+	msg := "From: Calendar <calendar@example.com>\r\nTo: user@example.org\r\nSubject: Reminder:\r\n  Standup\r\n" +
+		"X-Mailer: test\r\n\r\nSoon  \r\n\r\n"
+	signed, err := signer.Sign([]byte(msg))
+	require.NoError(t, err)
+
+	header, rest, _ := strings.Cut(string(signed), "\r\n")
+	assert.Equal(t, msg, rest, "the message follows the signature unchanged")
+
+	value, ok := strings.CutPrefix(header, "DKIM-Signature: ")
+	require.True(t, ok)
+	tags := dkimTags(value)
+	assert.Equal(t, "example.com", tags["d"])
+	assert.Equal(t, "mail", tags["s"])
+	assert.Equal(t, "relaxed/relaxed", tags["c"])
+	assert.Equal(t, "from:to:subject", tags["h"])
+
+	bodyHash := sha256.Sum256([]byte("Soon\r\n"))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(bodyHash[:]), tags["bh"])
+
+	// Verify as a receiver would: the signed headers, then the signature header without the b= value.
+	data := "from:Calendar <calendar@example.com>\r\nto:user@example.org\r\nsubject:Reminder: Standup\r\n" +
+		"dkim-signature:" + strings.TrimSuffix(value, tags["b"])
+	digest := sha256.Sum256([]byte(data))
+	signature, err := base64.StdEncoding.DecodeString(tags["b"])
+	require.NoError(t, err)
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+}
+
+func TestDKIMSigner_OtherDomain(t *testing.T) {
+	_, path := writeKey(t)
+	signer, err := NewDKIMSigner([]config.DKIMKey{{Domain: "example.com", Selector: "mail", PrivateKeyFile: path}}, clock.NewFake(epoch))
+	require.NoError(t, err)
+
+	msg := []byte("From: calendar@example.net\r\nSubject: Hi\r\n\r\nBody\r\n")
+	signed, err := signer.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, msg, signed)
+}
+
+func TestNewDKIMSigner_Errors(t *testing.T) {
+	signer, err := NewDKIMSigner(nil, clock.NewFake(epoch))
+	assert.NoError(t, err)
+	assert.Nil(t, signer)
+
+	_, err = NewDKIMSigner([]config.DKIMKey{{Domain: "example.com", PrivateKeyFile: filepath.Join(t.TempDir(), "missing.pem")}}, clock.NewFake(epoch))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "garbage.pem")
+	require.NoError(t, os.WriteFile(path, []byte("not a key"), 0o600))
+	_, err = NewDKIMSigner([]config.DKIMKey{{Domain: "example.com", PrivateKeyFile: path}}, clock.NewFake(epoch))
+	assert.ErrorIs(t, err, ErrInvalidDKIMKey)
+}
+
+func TestSMTP_Message(t *testing.T) {
+	_, path := writeKey(t)
+	signer, err := NewDKIMSigner([]config.DKIMKey{{Domain: "example.com", Selector: "mail", PrivateKeyFile: path}}, clock.NewFake(epoch))
+	require.NoError(t, err)
+
+	p, err := NewSMTP(config.Email{SMTPPort: "587", From: "calendar@example.com", BounceAddress: "bounces@example.com"}, signer)
+	require.NoError(t, err)
+
+	ctx := WithHeaders(context.Background(), map[string]string{"List-Unsubscribe": "<mailto:unsubscribe@example.com>"})
+	msg, err := p.message(ctx, "user@example.org", "Reminder", "Soon")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(string(msg), "DKIM-Signature: "))
+	assert.Contains(t, string(msg), "List-Unsubscribe: <mailto:unsubscribe@example.com>\r\n")
+	assert.Contains(t, string(msg), "h=from:to:subject:date:mime-version:content-type:content-transfer-encoding:list-unsubscribe;")
+	assert.Equal(t, "bounces@example.com", p.envelopeFrom())
+}
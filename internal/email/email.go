@@ -0,0 +1,101 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrUnknownProvider    = errors.New("unknown email provider")
+	ErrWebhookUnsupported = errors.New("email provider does not report feedback")
+	ErrInvalidSignature   = errors.New("invalid webhook signature")
+)
+
+// tenantTag names the custom tag attached to every sent message with the tenant it was sent for.
+// Providers echo it back in their webhooks, so feedback can be recorded for the right tenant.
+const tenantTag = "tenant"
+
+// maxWebhookBody limits the size of a webhook payload read into memory.
+const maxWebhookBody = 1 << 20
+
+// Provider sends emails through a delivery service and parses the bounces and complaints it reports back.
+type Provider interface {
+	// Name returns the name of the provider as configured, e.g. "ses".
+	Name() string
+
+	// Send sends a plain text email. The tenant in ctx, if any, is attached to the message.
+	Send(ctx context.Context, to, subject, body string) error
+
+	// Feedback verifies a webhook request of the provider and returns the bounces and complaints it reports.
+	Feedback(r *http.Request) ([]model.NotificationLogEntry, error)
+}
+
+// New creates the provider selected in the configuration.
+//
+// Parameters:
+//   - cfg: The email configuration.
+//   - clk: The clock used to sign API requests and DKIM signatures.
+//
+// Returns:
+//   - The provider.
+//   - ErrUnknownProvider if the provider is not supported, or another error if its settings are invalid.
+func New(cfg config.Email, clk clock.Clock) (Provider, error) {
+	p, err := newProvider(cfg, clk)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ListUnsubscribe != "" {
+		p = &listUnsubscribe{Provider: p, headers: ListUnsubscribeHeaders(cfg.ListUnsubscribe)}
+	}
+
+	return p, nil
+}
+
+// newProvider creates the delivery provider selected in the configuration.
+func newProvider(cfg config.Email, clk clock.Clock) (Provider, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "", "smtp":
+		signer, err := NewDKIMSigner(cfg.DKIM, clk)
+		if err != nil {
+			return nil, err
+		}
+		return NewSMTP(cfg, signer)
+	case "ses":
+		return NewSES(cfg.SES, cfg.From, cfg.BounceAddress, clk), nil
+	case "sendgrid":
+		return NewSendGrid(cfg.SendGrid, cfg.From)
+	case "mailgun":
+		return NewMailgun(cfg.Mailgun, cfg.From), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, cfg.Provider)
+	}
+}
+
+// readBody reads a webhook payload of at most maxWebhookBody bytes.
+func readBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		return nil, fmt.Errorf("read webhook body: %w", err)
+	}
+
+	return body, nil
+}
+
+// checkStatus returns an error describing an unsuccessful API response.
+func checkStatus(resp *http.Response, op string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s: unexpected status %d: %s", op, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,57 @@
+package email
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+var epoch = time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)
+
+func TestNew(t *testing.T) {
+	clk := clock.NewFake(epoch)
+
+	tests := []struct {
+		provider string
+		want     string
+	}{
+		{"", "smtp"},
+		{"SMTP", "smtp"},
+		{"ses", "ses"},
+		{"sendgrid", "sendgrid"},
+		{"mailgun", "mailgun"},
+	}
+
+	for _, tt := range tests {
+		p, err := New(config.Email{Provider: tt.provider, SMTPPort: "587"}, clk)
+		require.NoError(t, err, tt.provider)
+		assert.Equal(t, tt.want, p.Name())
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	clk := clock.NewFake(epoch)
+
+	_, err := New(config.Email{Provider: "postmark"}, clk)
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+
+	_, err = New(config.Email{SMTPPort: "smtp"}, clk)
+	assert.Error(t, err)
+
+	_, err = New(config.Email{Provider: "sendgrid", SendGrid: config.SendGrid{WebhookPublicKey: "not base64"}}, clk)
+	assert.Error(t, err)
+}
+
+func TestSMTP_Feedback(t *testing.T) {
+	p, err := NewSMTP(config.Email{SMTPPort: "587"}, nil)
+	require.NoError(t, err)
+
+	_, err = p.Feedback(httptest.NewRequest("POST", "/webhooks/email", nil))
+	assert.ErrorIs(t, err, ErrWebhookUnsupported)
+}
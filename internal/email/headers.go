@@ -0,0 +1,82 @@
+package email
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// headersKey is the context key of the extra headers of a message.
+type headersKey struct{}
+
+// Header is an extra header of a message, e.g. List-Unsubscribe.
+type Header struct {
+	Name  string // header name
+	Value string // header value
+}
+
+// WithHeaders returns a copy of ctx whose messages carry extra headers, in addition to any headers already in ctx.
+// Providers attach them the way their API allows, like the tenant of ctx.
+//
+// Parameters:
+//   - ctx: The parent context.
+//   - headers: The headers by name; empty values are skipped.
+//
+// Returns:
+//   - The context carrying the headers.
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	for name, value := range headersMap(ctx) {
+		merged[name] = value
+	}
+	for name, value := range headers {
+		if value != "" {
+			merged[name] = value
+		}
+	}
+
+	return context.WithValue(ctx, headersKey{}, merged)
+}
+
+// HeadersFromContext returns the extra headers of the messages sent with ctx, sorted by name.
+func HeadersFromContext(ctx context.Context) []Header {
+	m := headersMap(ctx)
+
+	headers := make([]Header, 0, len(m))
+	for name, value := range m {
+		headers = append(headers, Header{Name: name, Value: value})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+
+	return headers
+}
+
+// headersMap returns the extra headers stored in ctx.
+func headersMap(ctx context.Context) map[string]string {
+	m, _ := ctx.Value(headersKey{}).(map[string]string)
+	return m
+}
+
+// listUnsubscribe adds the configured List-Unsubscribe header to every message that does not carry one of its own.
+type listUnsubscribe struct {
+	Provider                   // provider sending the messages
+	headers  map[string]string // List-Unsubscribe and, for HTTPS addresses, List-Unsubscribe-Post
+}
+
+// Send sends a message with the List-Unsubscribe headers.
+func (l *listUnsubscribe) Send(ctx context.Context, to, subject, body string) error {
+	if _, ok := headersMap(ctx)["List-Unsubscribe"]; !ok {
+		ctx = WithHeaders(ctx, l.headers)
+	}
+	return l.Provider.Send(ctx, to, subject, body)
+}
+
+// ListUnsubscribeHeaders returns the List-Unsubscribe headers of an unsubscribe address (RFC 2369),
+// for WithHeaders. HTTPS addresses also announce one-click unsubscription (RFC 8058).
+func ListUnsubscribeHeaders(address string) map[string]string {
+	headers := map[string]string{"List-Unsubscribe": "<" + address + ">"}
+	if strings.HasPrefix(address, "https:") {
+		headers["List-Unsubscribe-Post"] = "List-Unsubscribe=One-Click"
+	}
+	return headers
+}
@@ -0,0 +1,47 @@
+package email
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headerProvider records the extra headers of the last message it sends.
+type headerProvider struct {
+	recordingProvider
+	headers []Header
+}
+
+func (p *headerProvider) Send(ctx context.Context, to, subject, body string) error {
+	p.headers = HeadersFromContext(ctx)
+	return p.recordingProvider.Send(ctx, to, subject, body)
+}
+
+func TestWithHeaders(t *testing.T) {
+	ctx := WithHeaders(context.Background(), map[string]string{"X-B": "1", "X-A": "2"})
+	ctx = WithHeaders(ctx, map[string]string{"X-B": "3", "X-C": ""})
+
+	assert.Equal(t, []Header{{Name: "X-A", Value: "2"}, {Name: "X-B", Value: "3"}}, HeadersFromContext(ctx))
+	assert.Empty(t, HeadersFromContext(context.Background()))
+}
+
+func TestListUnsubscribe(t *testing.T) {
+	p := &headerProvider{}
+	l := &listUnsubscribe{Provider: p, headers: ListUnsubscribeHeaders("https://calendar.example.com/unsubscribe")}
+
+	require.NoError(t, l.Send(context.Background(), "user@example.com", "Reminder", "Soon"))
+	assert.Equal(t, []Header{
+		{Name: "List-Unsubscribe", Value: "<https://calendar.example.com/unsubscribe>"},
+		{Name: "List-Unsubscribe-Post", Value: "List-Unsubscribe=One-Click"},
+	}, p.headers)
+
+	// A message with its own unsubscribe address keeps it.
+	ctx := WithHeaders(context.Background(), map[string]string{"List-Unsubscribe": "<mailto:u@example.com>"})
+	require.NoError(t, l.Send(ctx, "user@example.com", "Reminder", "Soon"))
+	assert.Equal(t, []Header{{Name: "List-Unsubscribe", Value: "<mailto:u@example.com>"}}, p.headers)
+
+	assert.Equal(t, map[string]string{"List-Unsubscribe": "<mailto:unsubscribe@example.com>"},
+		ListUnsubscribeHeaders("mailto:unsubscribe@example.com"))
+}
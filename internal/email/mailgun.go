@@ -0,0 +1,240 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// Mailgun sends emails through the Mailgun messages API. Permanent failures and complaints
+// are delivered by signed webhooks.
+type Mailgun struct {
+	baseURL    string       // Mailgun API base URL
+	domain     string       // sending domain
+	apiKey     string       // private API key
+	signingKey string       // key verifying webhook signatures; empty disables the webhook
+	from       string       // sender email address
+	client     *http.Client // HTTP client for API requests
+}
+
+// NewMailgun creates a Mailgun provider.
+//
+// Parameters:
+//   - cfg: The Mailgun configuration.
+//   - from: The sender email address.
+//
+// Returns:
+//   - A pointer to the initialized Mailgun provider.
+func NewMailgun(cfg config.Mailgun, from string) *Mailgun {
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = "https://api.mailgun.net"
+	}
+
+	return &Mailgun{
+		baseURL:    baseURL,
+		domain:     cfg.Domain,
+		apiKey:     cfg.APIKey,
+		signingKey: cfg.WebhookSigningKey,
+		from:       from,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns "mailgun".
+func (m *Mailgun) Name() string {
+	return "mailgun"
+}
+
+// Send sends a plain text email with the Mailgun messages API.
+// The tenant in ctx is attached as a user variable and the extra headers of ctx as message headers.
+// Messages with attachments are posted as a multipart form with an attachment part per file.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - to: The recipient address.
+//   - subject: The subject line.
+//   - body: The plain text body.
+//
+// Returns:
+//   - An error if Mailgun rejects the message or cannot be reached.
+func (m *Mailgun) Send(ctx context.Context, to, subject, body string) error {
+	form := url.Values{
+		"from":    {m.from},
+		"to":      {to},
+		"subject": {subject},
+		"text":    {body},
+	}
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		form.Set("v:"+tenantTag, tenantID)
+	}
+	for _, h := range HeadersFromContext(ctx) {
+		form.Set("h:"+h.Name, h.Value)
+	}
+
+	payload, contentType := []byte(form.Encode()), "application/x-www-form-urlencoded"
+	if attachments := AttachmentsFromContext(ctx); len(attachments) > 0 {
+		var err error
+		if payload, contentType, err = multipartForm(form, attachments); err != nil {
+			return fmt.Errorf("encode mailgun message: %w", err)
+		}
+	}
+
+	endpoint := m.baseURL + "/v3/" + url.PathEscape(m.domain) + "/messages"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("create mailgun request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.SetBasicAuth("api", m.apiKey)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send mailgun message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkStatus(resp, "send mailgun message")
+}
+
+// mailgunWebhook is the payload of a Mailgun webhook.
+type mailgunWebhook struct {
+	Signature struct {
+		Timestamp string `json:"timestamp"`
+		Token     string `json:"token"`
+		Signature string `json:"signature"`
+	} `json:"signature"`
+	EventData struct {
+		Event          string            `json:"event"`
+		Severity       string            `json:"severity"`
+		Recipient      string            `json:"recipient"`
+		Reason         string            `json:"reason"`
+		Timestamp      float64           `json:"timestamp"`
+		UserVariables  map[string]string `json:"user-variables"`
+		DeliveryStatus struct {
+			Description string `json:"description"`
+			Message     string `json:"message"`
+		} `json:"delivery-status"`
+	} `json:"event-data"`
+}
+
+// Feedback verifies the HMAC signature of a webhook request and returns the permanent failure
+// or complaint it reports.
+//
+// Parameters:
+//   - r: The webhook request.
+//
+// Returns:
+//   - The feedback entries.
+//   - ErrWebhookUnsupported if no signing key is configured, ErrInvalidSignature if the
+//     signature does not match, or another error if the payload is malformed.
+func (m *Mailgun) Feedback(r *http.Request) ([]model.NotificationLogEntry, error) {
+	if m.signingKey == "" {
+		return nil, ErrWebhookUnsupported
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload mailgunWebhook
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode mailgun webhook: %w", err)
+	}
+
+	sig := payload.Signature
+	expected := hex.EncodeToString(hmacSHA256([]byte(m.signingKey), sig.Timestamp+sig.Token))
+	if !hmac.Equal([]byte(expected), []byte(sig.Signature)) {
+		return nil, ErrInvalidSignature
+	}
+
+	event := payload.EventData
+	entry := model.NotificationLogEntry{
+		Tenant:     event.UserVariables[tenantTag],
+		Provider:   m.Name(),
+		Recipient:  event.Recipient,
+		OccurredAt: unixFloat(event.Timestamp),
+	}
+
+	switch {
+	case event.Event == "failed" && event.Severity == "permanent":
+		entry.Type = model.FeedbackBounce
+		entry.Reason = event.DeliveryStatus.Description
+		if entry.Reason == "" {
+			entry.Reason = event.DeliveryStatus.Message
+		}
+		if entry.Reason == "" {
+			entry.Reason = event.Reason
+		}
+	case event.Event == "complained":
+		entry.Type = model.FeedbackComplaint
+	default:
+		return nil, nil
+	}
+
+	return []model.NotificationLogEntry{entry}, nil
+}
+
+// multipartForm encodes the fields of a message and its attachments as multipart/form-data.
+func multipartForm(form url.Values, attachments []Attachment) ([]byte, string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(form)) {
+		for _, v := range form[name] {
+			if err := w.WriteField(name, v); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	for _, a := range attachments {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "attachment", "filename": a.Filename}))
+		header.Set("Content-Type", a.ContentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := part.Write(a.Content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), w.FormDataContentType(), nil
+}
+
+// unixFloat converts a Unix timestamp with fractional seconds to a UTC time.
+func unixFloat(ts float64) time.Time {
+	sec, frac := math.Modf(ts)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with the given key.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,111 @@
+package email
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+func newMailgun(baseURL string) *Mailgun {
+	return NewMailgun(config.Mailgun{
+		Domain:            "mg.example.com",
+		BaseURL:           baseURL,
+		APIKey:            "key",
+		WebhookSigningKey: "signing-key",
+	}, "calendar@example.com")
+}
+
+// mailgunRequest creates a webhook request for the event, signed with signingKey.
+func mailgunRequest(t *testing.T, signingKey string, event map[string]interface{}) *http.Request {
+	t.Helper()
+
+	const timestamp, token = "1760529540", "random-token"
+	body, err := json.Marshal(map[string]interface{}{
+		"signature": map[string]string{
+			"timestamp": timestamp,
+			"token":     token,
+			"signature": hex.EncodeToString(hmacSHA256([]byte(signingKey), timestamp+token)),
+		},
+		"event-data": event,
+	})
+	require.NoError(t, err)
+
+	return httptest.NewRequest(http.MethodPost, "/webhooks/email", strings.NewReader(string(body)))
+}
+
+func TestMailgun_Send(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mg.example.com/messages", r.URL.Path)
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "api", user)
+		assert.Equal(t, "key", pass)
+
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "calendar@example.com", r.PostForm.Get("from"))
+		assert.Equal(t, "user@example.com", r.PostForm.Get("to"))
+		assert.Equal(t, "Reminder", r.PostForm.Get("subject"))
+		assert.Equal(t, "Soon", r.PostForm.Get("text"))
+		assert.Equal(t, "acme", r.PostForm.Get("v:tenant"))
+	}))
+	defer srv.Close()
+
+	err := newMailgun(srv.URL+"/").Send(tenancy.WithTenant(context.Background(), "acme"), "user@example.com", "Reminder", "Soon")
+	require.NoError(t, err)
+}
+
+func TestMailgun_Feedback(t *testing.T) {
+	p := newMailgun("")
+
+	entries, err := p.Feedback(mailgunRequest(t, "signing-key", map[string]interface{}{
+		"event":           "failed",
+		"severity":        "permanent",
+		"recipient":       "gone@example.com",
+		"timestamp":       1760529540.5,
+		"user-variables":  map[string]string{"tenant": "acme"},
+		"delivery-status": map[string]string{"message": "550 user unknown"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []model.NotificationLogEntry{{
+		Tenant:     "acme",
+		Provider:   "mailgun",
+		Type:       model.FeedbackBounce,
+		Recipient:  "gone@example.com",
+		Reason:     "550 user unknown",
+		OccurredAt: time.Unix(1760529540, 5e8).UTC(),
+	}}, entries)
+
+	entries, err = p.Feedback(mailgunRequest(t, "signing-key", map[string]interface{}{
+		"event":     "complained",
+		"recipient": "angry@example.com",
+	}))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, model.FeedbackComplaint, entries[0].Type)
+
+	entries, err = p.Feedback(mailgunRequest(t, "signing-key", map[string]interface{}{
+		"event":    "failed",
+		"severity": "temporary",
+	}))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestMailgun_Feedback_Errors(t *testing.T) {
+	_, err := newMailgun("").Feedback(mailgunRequest(t, "other-key", map[string]interface{}{"event": "complained"}))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = NewMailgun(config.Mailgun{}, "").Feedback(mailgunRequest(t, "", map[string]interface{}{}))
+	assert.ErrorIs(t, err, ErrWebhookUnsupported)
+}
@@ -0,0 +1,239 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// Headers of a signed SendGrid event webhook.
+const (
+	sendGridSignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+	sendGridTimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+)
+
+// SendGrid sends emails through the SendGrid v3 API. Bounces and spam reports are delivered
+// by the signed event webhook.
+type SendGrid struct {
+	baseURL   string           // SendGrid API base URL
+	apiKey    string           // API key with the mail send permission
+	publicKey *ecdsa.PublicKey // key verifying event webhook signatures; nil disables the webhook
+	from      string           // sender email address
+	client    *http.Client     // HTTP client for API requests
+}
+
+// NewSendGrid creates a SendGrid provider.
+//
+// Parameters:
+//   - cfg: The SendGrid configuration.
+//   - from: The sender email address.
+//
+// Returns:
+//   - A pointer to the initialized SendGrid provider.
+//   - An error if the webhook public key is set but invalid.
+func NewSendGrid(cfg config.SendGrid, from string) (*SendGrid, error) {
+	s := &SendGrid{
+		baseURL: "https://api.sendgrid.com",
+		apiKey:  cfg.APIKey,
+		from:    from,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+
+	if cfg.WebhookPublicKey != "" {
+		der, err := base64.StdEncoding.DecodeString(cfg.WebhookPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("decode sendgrid webhook public key: %w", err)
+		}
+		key, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("parse sendgrid webhook public key: %w", err)
+		}
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("parse sendgrid webhook public key: not an ECDSA key")
+		}
+		s.publicKey = ecKey
+	}
+
+	return s, nil
+}
+
+// Name returns "sendgrid".
+func (s *SendGrid) Name() string {
+	return "sendgrid"
+}
+
+// sendGridAddress is an email address of a SendGrid message.
+type sendGridAddress struct {
+	Email string `json:"email"`
+}
+
+// sendGridContent is a body of a SendGrid message.
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// sendGridPersonalization holds the recipients of a SendGrid message and its custom arguments.
+type sendGridPersonalization struct {
+	To         []sendGridAddress `json:"to"`
+	CustomArgs map[string]string `json:"custom_args,omitempty"`
+}
+
+// sendGridAttachment is a file attached to a SendGrid message.
+type sendGridAttachment struct {
+	Content     string `json:"content"` // base64 encoded
+	Type        string `json:"type"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition"`
+}
+
+// sendGridRequest is the body of a SendGrid mail send request.
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Headers          map[string]string         `json:"headers,omitempty"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+}
+
+// Send sends a plain text email with the SendGrid mail send API.
+// The tenant in ctx is attached as a custom argument, the extra headers of ctx as message headers,
+// and the attachments of ctx as attachments.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - to: The recipient address.
+//   - subject: The subject line.
+//   - body: The plain text body.
+//
+// Returns:
+//   - An error if SendGrid rejects the message or cannot be reached.
+func (s *SendGrid) Send(ctx context.Context, to, subject, body string) error {
+	p := sendGridPersonalization{To: []sendGridAddress{{Email: to}}}
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		p.CustomArgs = map[string]string{tenantTag: tenantID}
+	}
+
+	msg := sendGridRequest{
+		Personalizations: []sendGridPersonalization{p},
+		From:             sendGridAddress{Email: s.from},
+		Subject:          subject,
+		Content:          []sendGridContent{{Type: "text/plain", Value: body}},
+	}
+	for _, h := range HeadersFromContext(ctx) {
+		if msg.Headers == nil {
+			msg.Headers = make(map[string]string)
+		}
+		msg.Headers[h.Name] = h.Value
+	}
+
+	for _, a := range AttachmentsFromContext(ctx) {
+		msg.Attachments = append(msg.Attachments, sendGridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(a.Content),
+			Type:        a.ContentType,
+			Filename:    a.Filename,
+			Disposition: "attachment",
+		})
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("encode sendgrid message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/v3/mail/send", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("create sendgrid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send sendgrid message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkStatus(resp, "send sendgrid message")
+}
+
+// sendGridEvent is an event of the SendGrid event webhook. Custom arguments are top-level fields.
+type sendGridEvent struct {
+	Event     string `json:"event"`
+	Email     string `json:"email"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+	Tenant    string `json:"tenant"`
+}
+
+// Feedback verifies the ECDSA signature of an event webhook request and returns the bounces
+// and spam reports among its events.
+//
+// Parameters:
+//   - r: The webhook request.
+//
+// Returns:
+//   - The feedback entries.
+//   - ErrWebhookUnsupported if no webhook public key is configured, ErrInvalidSignature if the
+//     signature does not match, or another error if the payload is malformed.
+func (s *SendGrid) Feedback(r *http.Request) ([]model.NotificationLogEntry, error) {
+	if s.publicKey == nil {
+		return nil, ErrWebhookUnsupported
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(r.Header.Get(sendGridSignatureHeader))
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	digest := sha256.Sum256(append([]byte(r.Header.Get(sendGridTimestampHeader)), body...))
+	if !ecdsa.VerifyASN1(s.publicKey, digest[:], signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var events []sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("decode sendgrid events: %w", err)
+	}
+
+	var entries []model.NotificationLogEntry
+	for _, e := range events {
+		var kind string
+		switch e.Event {
+		case "bounce":
+			kind = model.FeedbackBounce
+		case "spamreport":
+			kind = model.FeedbackComplaint
+		default:
+			continue
+		}
+
+		entries = append(entries, model.NotificationLogEntry{
+			Tenant:     e.Tenant,
+			Provider:   s.Name(),
+			Type:       kind,
+			Recipient:  e.Email,
+			Reason:     e.Reason,
+			OccurredAt: time.Unix(e.Timestamp, 0).UTC(),
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,126 @@
+package email
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// newSendGrid creates a SendGrid provider with a fresh webhook key pair and returns the private key.
+func newSendGrid(t *testing.T) (*SendGrid, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	p, err := NewSendGrid(config.SendGrid{
+		APIKey:           "SG.key",
+		WebhookPublicKey: base64.StdEncoding.EncodeToString(der),
+	}, "calendar@example.com")
+	require.NoError(t, err)
+
+	return p, key
+}
+
+// signedSendGridRequest creates an event webhook request signed with key.
+func signedSendGridRequest(t *testing.T, key *ecdsa.PrivateKey, body string) *http.Request {
+	t.Helper()
+
+	const timestamp = "1760529540"
+	digest := sha256.Sum256([]byte(timestamp + body))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/email", strings.NewReader(body))
+	r.Header.Set(sendGridSignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	r.Header.Set(sendGridTimestampHeader, timestamp)
+	return r
+}
+
+func TestSendGrid_Send(t *testing.T) {
+	var got sendGridRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mail/send", r.URL.Path)
+		assert.Equal(t, "Bearer SG.key", r.Header.Get("Authorization"))
+
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	p, _ := newSendGrid(t)
+	p.baseURL = srv.URL
+
+	err := p.Send(tenancy.WithTenant(context.Background(), "acme"), "user@example.com", "Reminder", "Soon")
+	require.NoError(t, err)
+
+	assert.Equal(t, "calendar@example.com", got.From.Email)
+	assert.Equal(t, "Reminder", got.Subject)
+	require.Len(t, got.Personalizations, 1)
+	assert.Equal(t, []sendGridAddress{{Email: "user@example.com"}}, got.Personalizations[0].To)
+	assert.Equal(t, map[string]string{"tenant": "acme"}, got.Personalizations[0].CustomArgs)
+}
+
+func TestSendGrid_Feedback(t *testing.T) {
+	p, key := newSendGrid(t)
+
+	body := `[
+		{"event":"delivered","email":"ok@example.com","timestamp":1760529540},
+		{"event":"bounce","email":"gone@example.com","reason":"550 user unknown","timestamp":1760529540,"tenant":"acme"},
+		{"event":"spamreport","email":"angry@example.com","timestamp":1760529541}
+	]`
+
+	entries, err := p.Feedback(signedSendGridRequest(t, key, body))
+	require.NoError(t, err)
+	assert.Equal(t, []model.NotificationLogEntry{
+		{
+			Tenant:     "acme",
+			Provider:   "sendgrid",
+			Type:       model.FeedbackBounce,
+			Recipient:  "gone@example.com",
+			Reason:     "550 user unknown",
+			OccurredAt: time.Unix(1760529540, 0).UTC(),
+		},
+		{
+			Provider:   "sendgrid",
+			Type:       model.FeedbackComplaint,
+			Recipient:  "angry@example.com",
+			OccurredAt: time.Unix(1760529541, 0).UTC(),
+		},
+	}, entries)
+}
+
+func TestSendGrid_Feedback_Errors(t *testing.T) {
+	p, key := newSendGrid(t)
+
+	r := signedSendGridRequest(t, key, `[]`)
+	r.Body = io.NopCloser(strings.NewReader(`[{"event":"bounce"}]`))
+	_, err := p.Feedback(r)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	unsigned, err := NewSendGrid(config.SendGrid{}, "calendar@example.com")
+	require.NoError(t, err)
+	_, err = unsigned.Feedback(signedSendGridRequest(t, key, `[]`))
+	assert.ErrorIs(t, err, ErrWebhookUnsupported)
+}
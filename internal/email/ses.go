@@ -0,0 +1,305 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/awsv4"
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// SES sends emails through the AWS SES v2 API. Bounces and complaints are delivered by
+// Amazon SNS to an HTTPS subscription whose URL carries the configured webhook token.
+type SES struct {
+	baseURL      string       // SES API endpoint of the region
+	region       string       // AWS region, part of the request signature
+	accessKeyID  string       // AWS access key ID
+	secretKey    string       // AWS secret access key
+	webhookToken string       // token expected in the query of SNS notifications
+	from         string       // sender email address
+	bounce       string       // address bounces and complaints are forwarded to; from when empty
+	client       *http.Client // HTTP client for API requests and subscription confirmations
+	clock        clock.Clock  // source of the signing time
+}
+
+// NewSES creates an SES provider.
+//
+// Parameters:
+//   - cfg: The SES configuration.
+//   - from: The sender email address.
+//   - bounce: The address bounces and complaints are forwarded to; from when empty.
+//   - clk: The clock used to sign API requests.
+//
+// Returns:
+//   - A pointer to the initialized SES provider.
+func NewSES(cfg config.SES, from, bounce string, clk clock.Clock) *SES {
+	return &SES{
+		baseURL:      "https://email." + cfg.Region + ".amazonaws.com",
+		region:       cfg.Region,
+		accessKeyID:  cfg.AccessKeyID,
+		secretKey:    cfg.SecretAccessKey,
+		webhookToken: cfg.WebhookToken,
+		from:         from,
+		bounce:       bounce,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		clock:        clk,
+	}
+}
+
+// Name returns "ses".
+func (s *SES) Name() string {
+	return "ses"
+}
+
+// sesTag is a message tag of SES.
+type sesTag struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// sesHeader is an extra header of an SES message.
+type sesHeader struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// sesContent is a text of an SES message.
+type sesContent struct {
+	Data string `json:"Data"`
+}
+
+// sesAttachment is a file attached to an SES message; RawContent is base64 encoded in JSON.
+type sesAttachment struct {
+	FileName           string `json:"FileName"`
+	ContentType        string `json:"ContentType"`
+	ContentDisposition string `json:"ContentDisposition"`
+	RawContent         []byte `json:"RawContent"`
+}
+
+// sesRequest is the body of an SES v2 SendEmail request with simple content.
+type sesRequest struct {
+	FromEmailAddress               string `json:"FromEmailAddress"`
+	FeedbackForwardingEmailAddress string `json:"FeedbackForwardingEmailAddress,omitempty"`
+	Destination                    struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Text sesContent `json:"Text"`
+			} `json:"Body"`
+			Headers     []sesHeader     `json:"Headers,omitempty"`
+			Attachments []sesAttachment `json:"Attachments,omitempty"`
+		} `json:"Simple"`
+	} `json:"Content"`
+	EmailTags []sesTag `json:"EmailTags,omitempty"`
+}
+
+// Send sends a plain text email with the SES v2 SendEmail API.
+// The tenant in ctx is attached as a message tag, the extra headers of ctx as message headers,
+// and the attachments of ctx as attachments of the simple content.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - to: The recipient address.
+//   - subject: The subject line.
+//   - body: The plain text body.
+//
+// Returns:
+//   - An error if SES rejects the message or cannot be reached.
+func (s *SES) Send(ctx context.Context, to, subject, body string) error {
+	var msg sesRequest
+	msg.FromEmailAddress = s.from
+	msg.FeedbackForwardingEmailAddress = s.bounce
+	msg.Destination.ToAddresses = []string{to}
+	msg.Content.Simple.Subject.Data = subject
+	msg.Content.Simple.Body.Text.Data = body
+	for _, h := range HeadersFromContext(ctx) {
+		msg.Content.Simple.Headers = append(msg.Content.Simple.Headers, sesHeader{Name: h.Name, Value: h.Value})
+	}
+	for _, a := range AttachmentsFromContext(ctx) {
+		msg.Content.Simple.Attachments = append(msg.Content.Simple.Attachments, sesAttachment{
+			FileName:           a.Filename,
+			ContentType:        a.ContentType,
+			ContentDisposition: "ATTACHMENT",
+			RawContent:         a.Content,
+		})
+	}
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		msg.EmailTags = []sesTag{{Name: tenantTag, Value: tenantID}}
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("encode ses message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/v2/email/outbound-emails", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("create ses request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	awsv4.Sign(req, payload, "ses", s.region, s.accessKeyID, s.secretKey, s.clock.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send ses message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkStatus(resp, "send ses message")
+}
+
+// snsMessage is an Amazon SNS HTTP(S) delivery.
+type snsMessage struct {
+	Type         string `json:"Type"`         // Notification or SubscriptionConfirmation
+	Message      string `json:"Message"`      // JSON encoded SES notification
+	SubscribeURL string `json:"SubscribeURL"` // URL confirming a subscription
+}
+
+// sesNotification is a bounce or complaint notification of SES, as published by
+// feedback notifications (notificationType) or configuration set event publishing (eventType).
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Mail             struct {
+		Tags map[string][]string `json:"tags"`
+	} `json:"mail"`
+	Bounce struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"complaint"`
+}
+
+// Feedback authenticates an SNS delivery by the token query parameter and returns the permanent
+// bounces and complaints it reports. Subscription confirmations are confirmed and report nothing.
+//
+// Parameters:
+//   - r: The webhook request.
+//
+// Returns:
+//   - The feedback entries.
+//   - ErrWebhookUnsupported if no webhook token is configured, ErrInvalidSignature if the token
+//     does not match, or another error if the payload is malformed or the confirmation fails.
+func (s *SES) Feedback(r *http.Request) ([]model.NotificationLogEntry, error) {
+	if s.webhookToken == "" {
+		return nil, ErrWebhookUnsupported
+	}
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(s.webhookToken)) != 1 {
+		return nil, ErrInvalidSignature
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg snsMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("decode sns message: %w", err)
+	}
+
+	switch msg.Type {
+	case "SubscriptionConfirmation":
+		return nil, s.confirm(r.Context(), msg.SubscribeURL)
+	case "Notification":
+	default:
+		return nil, nil
+	}
+
+	var n sesNotification
+	if err := json.Unmarshal([]byte(msg.Message), &n); err != nil {
+		return nil, fmt.Errorf("decode ses notification: %w", err)
+	}
+
+	var tenantID string
+	if tags := n.Mail.Tags[tenantTag]; len(tags) > 0 {
+		tenantID = tags[0]
+	}
+
+	kind := n.NotificationType
+	if kind == "" {
+		kind = n.EventType
+	}
+
+	var entries []model.NotificationLogEntry
+	switch kind {
+	case "Bounce":
+		if n.Bounce.BounceType != "Permanent" {
+			return nil, nil
+		}
+		for _, rcpt := range n.Bounce.BouncedRecipients {
+			reason := rcpt.DiagnosticCode
+			if reason == "" {
+				reason = n.Bounce.BounceSubType
+			}
+			entries = append(entries, model.NotificationLogEntry{
+				Tenant:     tenantID,
+				Provider:   s.Name(),
+				Type:       model.FeedbackBounce,
+				Recipient:  rcpt.EmailAddress,
+				Reason:     reason,
+				OccurredAt: n.Bounce.Timestamp,
+			})
+		}
+	case "Complaint":
+		for _, rcpt := range n.Complaint.ComplainedRecipients {
+			entries = append(entries, model.NotificationLogEntry{
+				Tenant:     tenantID,
+				Provider:   s.Name(),
+				Type:       model.FeedbackComplaint,
+				Recipient:  rcpt.EmailAddress,
+				Reason:     n.Complaint.ComplaintFeedbackType,
+				OccurredAt: n.Complaint.Timestamp,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// confirm confirms an SNS subscription by visiting its SubscribeURL.
+// Only HTTPS URLs of SNS endpoints are visited, so the webhook cannot be used to make arbitrary requests.
+func (s *SES) confirm(ctx context.Context, subscribeURL string) error {
+	u, err := url.Parse(subscribeURL)
+	if err != nil || u.Scheme != "https" || !strings.HasPrefix(u.Hostname(), "sns.") ||
+		!strings.HasSuffix(u.Hostname(), ".amazonaws.com") {
+		return fmt.Errorf("confirm sns subscription: unexpected url %q", subscribeURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("create sns confirmation request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("confirm sns subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkStatus(resp, "confirm sns subscription")
+}
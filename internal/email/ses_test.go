@@ -0,0 +1,143 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+func newSES(token string) *SES {
+	return NewSES(config.SES{
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		WebhookToken:    token,
+	}, "calendar@example.com", "bounces@example.com", clock.NewFake(epoch))
+}
+
+// snsRequest wraps an SES notification into an SNS delivery.
+func snsRequest(t *testing.T, token string, notification interface{}) *http.Request {
+	t.Helper()
+
+	message, err := json.Marshal(notification)
+	require.NoError(t, err)
+	body, err := json.Marshal(map[string]string{"Type": "Notification", "Message": string(message)})
+	require.NoError(t, err)
+
+	return httptest.NewRequest(http.MethodPost, "/webhooks/email?token="+token, strings.NewReader(string(body)))
+}
+
+func TestSES_Send(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/email/outbound-emails", r.URL.Path)
+		assert.Equal(t, "20251015T120000Z", r.Header.Get("X-Amz-Date"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential=AKID/20251015/eu-west-1/ses/aws4_request, "))
+
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := newSES("")
+	p.baseURL = srv.URL
+
+	ctx := WithHeaders(tenancy.WithTenant(context.Background(), "acme"), map[string]string{"List-Unsubscribe": "<mailto:unsubscribe@example.com>"})
+	err := p.Send(ctx, "user@example.com", "Reminder", "Soon")
+	require.NoError(t, err)
+
+	assert.Equal(t, "calendar@example.com", got["FromEmailAddress"])
+	assert.Equal(t, "bounces@example.com", got["FeedbackForwardingEmailAddress"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"Name": "List-Unsubscribe", "Value": "<mailto:unsubscribe@example.com>"}},
+		got["Content"].(map[string]interface{})["Simple"].(map[string]interface{})["Headers"])
+	assert.Equal(t, []interface{}{"user@example.com"}, got["Destination"].(map[string]interface{})["ToAddresses"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"Name": "tenant", "Value": "acme"}}, got["EmailTags"])
+}
+
+func TestSES_Send_Rejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Email address is not verified."}`, http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	p := newSES("")
+	p.baseURL = srv.URL
+
+	err := p.Send(context.Background(), "user@example.com", "Reminder", "Soon")
+	assert.ErrorContains(t, err, "not verified")
+}
+
+func TestSES_Feedback(t *testing.T) {
+	p := newSES("hook-token")
+
+	bounce := map[string]interface{}{
+		"notificationType": "Bounce",
+		"mail":             map[string]interface{}{"tags": map[string][]string{"tenant": {"acme"}}},
+		"bounce": map[string]interface{}{
+			"bounceType":        "Permanent",
+			"bounceSubType":     "General",
+			"bouncedRecipients": []map[string]string{{"emailAddress": "gone@example.com", "diagnosticCode": "550 5.1.1 user unknown"}},
+			"timestamp":         "2025-10-15T11:59:00.000Z",
+		},
+	}
+
+	entries, err := p.Feedback(snsRequest(t, "hook-token", bounce))
+	require.NoError(t, err)
+	assert.Equal(t, []model.NotificationLogEntry{{
+		Tenant:     "acme",
+		Provider:   "ses",
+		Type:       model.FeedbackBounce,
+		Recipient:  "gone@example.com",
+		Reason:     "550 5.1.1 user unknown",
+		OccurredAt: time.Date(2025, 10, 15, 11, 59, 0, 0, time.UTC),
+	}}, entries)
+
+	complaint := map[string]interface{}{
+		"eventType": "Complaint",
+		"complaint": map[string]interface{}{
+			"complaintFeedbackType": "abuse",
+			"complainedRecipients":  []map[string]string{{"emailAddress": "angry@example.com"}},
+			"timestamp":             "2025-10-15T11:59:00Z",
+		},
+	}
+
+	entries, err = p.Feedback(snsRequest(t, "hook-token", complaint))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, model.FeedbackComplaint, entries[0].Type)
+	assert.Equal(t, "abuse", entries[0].Reason)
+	assert.Empty(t, entries[0].Tenant)
+
+	bounce["bounce"].(map[string]interface{})["bounceType"] = "Transient"
+	entries, err = p.Feedback(snsRequest(t, "hook-token", bounce))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSES_Feedback_Errors(t *testing.T) {
+	_, err := newSES("").Feedback(snsRequest(t, "", map[string]string{}))
+	assert.ErrorIs(t, err, ErrWebhookUnsupported)
+
+	_, err = newSES("hook-token").Feedback(snsRequest(t, "wrong", map[string]string{}))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	confirmation := `{"Type":"SubscriptionConfirmation","SubscribeURL":"http://169.254.169.254/latest"}`
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/email?token=hook-token", strings.NewReader(confirmation))
+	_, err = newSES("hook-token").Feedback(r)
+	assert.ErrorContains(t, err, "unexpected url")
+}
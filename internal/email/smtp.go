@@ -0,0 +1,141 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"gopkg.in/mail.v2"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// SMTP sends emails through an SMTP server. Plain SMTP offers no feedback webhook;
+// bounces arrive as messages in the mailbox of the bounce address instead.
+type SMTP struct {
+	dialer *mail.Dialer // dialer of the SMTP server
+	from   string       // sender email address
+	bounce string       // envelope sender receiving bounces; from when empty
+	signer *DKIMSigner  // DKIM signer of outgoing messages; nil disables signing
+}
+
+// NewSMTP creates an SMTP provider.
+//
+// Parameters:
+//   - cfg: The email configuration with the SMTP server settings and the bounce address.
+//   - signer: The DKIM signer of outgoing messages; nil disables signing.
+//
+// Returns:
+//   - A pointer to the initialized SMTP provider.
+//   - An error if the port is not a number.
+func NewSMTP(cfg config.Email, signer *DKIMSigner) (*SMTP, error) {
+	port, err := strconv.Atoi(cfg.SMTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("parse smtp port: %w", err)
+	}
+
+	return &SMTP{
+		dialer: mail.NewDialer(cfg.SMTPHost, port, cfg.Username, cfg.Password),
+		from:   cfg.From,
+		bounce: cfg.BounceAddress,
+		signer: signer,
+	}, nil
+}
+
+// Name returns "smtp".
+func (s *SMTP) Name() string {
+	return "smtp"
+}
+
+// Send sends a plain text email through the SMTP server, with the extra headers and attachments of ctx.
+// The message is DKIM signed if the signer has a key for the sending domain, and bounces
+// go to the bounce address.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - to: The recipient address.
+//   - subject: The subject line.
+//   - body: The plain text body.
+//
+// Returns:
+//   - An error if the server rejects the message or cannot be reached.
+func (s *SMTP) Send(ctx context.Context, to, subject, body string) error {
+	msg, err := s.message(ctx, to, subject, body)
+	if err != nil {
+		return err
+	}
+
+	sc, err := s.dialer.Dial()
+	if err != nil {
+		return fmt.Errorf("send smtp message: %w", err)
+	}
+	defer sc.Close()
+
+	if err := sc.Send(s.envelopeFrom(), []string{to}, rawMessage(msg)); err != nil {
+		return fmt.Errorf("send smtp message: %w", err)
+	}
+
+	return nil
+}
+
+// message renders a plain text email with its attachments, DKIM signed if the signer has a key for the sending domain.
+func (s *SMTP) message(ctx context.Context, to, subject, body string) ([]byte, error) {
+	m := mail.NewMessage()
+	m.SetHeader("From", s.from)
+	m.SetHeader("To", to)
+	m.SetHeader("Subject", subject)
+	for _, h := range HeadersFromContext(ctx) {
+		m.SetHeader(h.Name, h.Value)
+	}
+	m.SetBody("text/plain", body)
+	for _, a := range AttachmentsFromContext(ctx) {
+		m.Attach(a.Filename,
+			mail.SetHeader(map[string][]string{"Content-Type": {a.ContentType}}),
+			mail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(a.Content)
+				return err
+			}),
+		)
+	}
+
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("render smtp message: %w", err)
+	}
+	if s.signer == nil {
+		return buf.Bytes(), nil
+	}
+
+	signed, err := s.signer.Sign(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("sign smtp message: %w", err)
+	}
+
+	return signed, nil
+}
+
+// envelopeFrom returns the envelope sender, which receives bounces.
+func (s *SMTP) envelopeFrom() string {
+	if s.bounce != "" {
+		return s.bounce
+	}
+	return s.from
+}
+
+// rawMessage writes a rendered message as is.
+type rawMessage []byte
+
+// WriteTo writes the message to w.
+func (m rawMessage) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(m)
+	return int64(n), err
+}
+
+// Feedback always returns ErrWebhookUnsupported.
+func (s *SMTP) Feedback(*http.Request) ([]model.NotificationLogEntry, error) {
+	return nil, ErrWebhookUnsupported
+}
@@ -0,0 +1,143 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// ErrThrottled is returned when the rate limit of a recipient domain leaves no slot within the maximum wait.
+var ErrThrottled = errors.New("recipient domain throttled")
+
+// ThrottledError reports a message deferred because its recipient domain is over its rate limit.
+type ThrottledError struct {
+	Domain  string    // recipient domain over its limit
+	RetryAt time.Time // earliest time the message can be sent without waiting
+}
+
+// Error describes the throttled domain.
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%s: %s until %s", ErrThrottled, e.Domain, e.RetryAt.Format(time.RFC3339))
+}
+
+// Unwrap returns ErrThrottled, so errors.Is matches it.
+func (e *ThrottledError) Unwrap() error {
+	return ErrThrottled
+}
+
+// Throttled wraps a provider with a soft rate limit per recipient domain, so bursts of reminders to one
+// mailbox provider, e.g. gmail.com, are spread out instead of being flagged as spam.
+// Messages over the limit wait for the next free slot of their domain; a message that would wait longer
+// than the maximum wait is not sent and fails with a ThrottledError, so the caller can queue it for later.
+type Throttled struct {
+	Provider // provider sending the messages
+
+	perMinute int            // messages per minute to a domain without its own limit; 0 leaves them unlimited
+	domains   map[string]int // messages per minute to specific domains
+	maxWait   time.Duration  // longest a message waits for a slot of its domain
+	clock     clock.Clock    // source of the current time and the wait timers
+
+	mu   sync.Mutex           // guards next
+	next map[string]time.Time // earliest time the next message to a domain may be sent
+}
+
+// NewThrottled wraps a provider with the per-domain rate limits of the configuration.
+// The provider is returned as is when no limit is configured.
+//
+// Parameters:
+//   - p: The provider sending the messages.
+//   - cfg: The throttling configuration.
+//   - clk: The clock spacing the messages.
+//
+// Returns:
+//   - The throttled provider.
+func NewThrottled(p Provider, cfg config.EmailThrottle, clk clock.Clock) Provider {
+	if cfg.PerMinute <= 0 && len(cfg.Domains) == 0 {
+		return p
+	}
+
+	domains := make(map[string]int, len(cfg.Domains))
+	for _, d := range cfg.Domains {
+		domains[strings.ToLower(d.Domain)] = d.PerMinute
+	}
+
+	return &Throttled{
+		Provider:  p,
+		perMinute: cfg.PerMinute,
+		domains:   domains,
+		maxWait:   cfg.MaxWait,
+		clock:     clk,
+		next:      make(map[string]time.Time),
+	}
+}
+
+// Send sends a message once its recipient domain has a free slot.
+//
+// Parameters:
+//   - ctx: The context of the message; canceling it gives up the wait.
+//   - to: The recipient address.
+//   - subject: The subject of the message.
+//   - body: The plain text body.
+//
+// Returns:
+//   - A ThrottledError if the domain has no slot within the maximum wait, ctx.Err() if the wait is canceled,
+//     or the error of the provider.
+func (t *Throttled) Send(ctx context.Context, to, subject, body string) error {
+	wait, err := t.reserve(domainOf(to))
+	if err != nil {
+		return err
+	}
+
+	if wait > 0 {
+		select {
+		case <-t.clock.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return t.Provider.Send(ctx, to, subject, body)
+}
+
+// reserve takes the next slot of a domain and returns the time until it starts.
+// Slots are spaced evenly over the minute, so a domain receives at most its limit of messages per minute.
+func (t *Throttled) reserve(domain string) (time.Duration, error) {
+	limit, ok := t.domains[domain]
+	if !ok {
+		limit = t.perMinute
+	}
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	slot := t.next[domain]
+	if slot.Before(now) {
+		slot = now
+	}
+
+	wait := slot.Sub(now)
+	if wait > t.maxWait {
+		return 0, &ThrottledError{Domain: domain, RetryAt: slot}
+	}
+
+	t.next[domain] = slot.Add(time.Minute / time.Duration(limit))
+	return wait, nil
+}
+
+// domainOf returns the lower-case domain of an email address, or the whole address if it has none.
+func domainOf(address string) string {
+	if i := strings.LastIndexByte(address, '@'); i >= 0 {
+		address = address[i+1:]
+	}
+	return strings.ToLower(strings.TrimSuffix(address, ">"))
+}
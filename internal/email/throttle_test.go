@@ -0,0 +1,94 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// recordingProvider records the recipients of the messages it sends.
+type recordingProvider struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (p *recordingProvider) Name() string { return "recording" }
+
+func (p *recordingProvider) Send(_ context.Context, to, _, _ string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sent = append(p.sent, to)
+	return nil
+}
+
+func (p *recordingProvider) Feedback(*http.Request) ([]model.NotificationLogEntry, error) {
+	return nil, ErrWebhookUnsupported
+}
+
+func (p *recordingProvider) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.sent)
+}
+
+func TestNewThrottled_Disabled(t *testing.T) {
+	p := &recordingProvider{}
+	assert.Same(t, Provider(p), NewThrottled(p, config.EmailThrottle{}, clock.NewFake(epoch)))
+}
+
+func TestThrottled_Send(t *testing.T) {
+	clk := clock.NewFake(epoch)
+	p := &recordingProvider{}
+	throttled := NewThrottled(p, config.EmailThrottle{
+		Domains: []config.DomainLimit{{Domain: "Gmail.com", PerMinute: 2}},
+		MaxWait: 30 * time.Second,
+	}, clk)
+	ctx := context.Background()
+
+	// Domains without a limit are not throttled.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, throttled.Send(ctx, "a@example.com", "s", "b"))
+	}
+
+	// The first message to a limited domain goes out right away, the second waits for the next slot.
+	require.NoError(t, throttled.Send(ctx, "a@gmail.com", "s", "b"))
+	done := make(chan error, 1)
+	go func() { done <- throttled.Send(ctx, "b@GMAIL.com", "s", "b") }()
+	clk.BlockUntil(1)
+	assert.Equal(t, 6, p.count())
+
+	// A third message would wait a full minute, longer than the maximum wait, so it is deferred.
+	err := throttled.Send(ctx, "c@gmail.com", "s", "b")
+	var throttledErr *ThrottledError
+	require.True(t, errors.As(err, &throttledErr))
+	assert.ErrorIs(t, err, ErrThrottled)
+	assert.Equal(t, "gmail.com", throttledErr.Domain)
+	assert.Equal(t, epoch.Add(time.Minute), throttledErr.RetryAt)
+
+	clk.Advance(30 * time.Second)
+	require.NoError(t, <-done)
+	assert.Equal(t, 7, p.count())
+}
+
+func TestThrottled_SendCanceled(t *testing.T) {
+	clk := clock.NewFake(epoch)
+	throttled := NewThrottled(&recordingProvider{}, config.EmailThrottle{PerMinute: 1, MaxWait: time.Minute}, clk)
+
+	require.NoError(t, throttled.Send(context.Background(), "a@example.com", "s", "b"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, throttled.Send(ctx, "b@example.com", "s", "b"), context.Canceled)
+}
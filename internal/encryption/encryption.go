@@ -0,0 +1,208 @@
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/repository/datakey"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var ErrMalformed = errors.New("malformed or tampered ciphertext")
+
+//go:generate mockgen -source=encryption.go -destination=../mocks/encryption/mock_encryption.go -package=mocks
+
+// keyStore defines the storage of wrapped per-user data keys.
+type keyStore interface {
+	// GetKey retrieves the wrapped data key of a user.
+	GetKey(ctx context.Context, userID uuid.UUID) ([]byte, error)
+
+	// CreateKey stores the wrapped data key of a user unless one exists and returns the stored key.
+	CreateKey(ctx context.Context, userID uuid.UUID, wrapped []byte) ([]byte, error)
+}
+
+const (
+	// prefix marks encrypted values, so plaintext written before encryption was enabled stays readable.
+	prefix = "enc:v1:"
+
+	// keySize is the size of master and data keys (AES-256).
+	keySize = 32
+)
+
+// Cipher encrypts user content with per-user data keys wrapped by a master key (envelope encryption).
+// Data keys are created on first use and cached unwrapped in memory.
+// A disabled Cipher passes values through unchanged; decryption always accepts plaintext values.
+// It is safe for concurrent use.
+type Cipher struct {
+	enabled bool       // whether new values are encrypted
+	kms     KeyWrapper // kms wraps and unwraps data keys
+	keys    keyStore   // keys stores wrapped data keys
+	cache   sync.Map   // cache maps tenant/user to the user's cipher.AEAD
+}
+
+// New creates a Cipher from the configuration.
+//
+// Parameters:
+//   - cfg: The encryption configuration.
+//   - keys: The storage of wrapped data keys.
+//
+// Returns:
+//   - A pointer to the initialized Cipher.
+//   - An error if encryption is enabled with an invalid master key.
+func New(cfg config.Encryption, keys keyStore) (*Cipher, error) {
+	if !cfg.Enabled {
+		return Disabled(), nil
+	}
+
+	kms, err := NewLocalKMS(cfg.MasterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithKMS(kms, keys), nil
+}
+
+// NewWithKMS creates an enabled Cipher that wraps data keys with the given key wrapper.
+//
+// Parameters:
+//   - kms: The key wrapper holding the master key.
+//   - keys: The storage of wrapped data keys.
+//
+// Returns:
+//   - A pointer to the initialized Cipher.
+func NewWithKMS(kms KeyWrapper, keys keyStore) *Cipher {
+	return &Cipher{
+		enabled: true,
+		kms:     kms,
+		keys:    keys,
+	}
+}
+
+// Disabled returns a Cipher that stores new values in plaintext.
+func Disabled() *Cipher {
+	return &Cipher{}
+}
+
+// Encrypt encrypts a value with the data key of its owner.
+// Empty values are kept empty. The ciphertext is bound to the user and cannot be moved to another user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the value.
+//   - plaintext: The value to encrypt.
+//
+// Returns:
+//   - The encrypted value, or the plaintext if encryption is disabled.
+//   - An error if the data key cannot be loaded or encryption fails.
+func (c *Cipher) Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error) {
+	if !c.enabled || plaintext == "" {
+		return plaintext, nil
+	}
+
+	aead, err := c.dataKey(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := seal(aead, []byte(plaintext), userID[:])
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted by Encrypt. Values without the encryption prefix are returned as they are.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the value.
+//   - value: The stored value.
+//
+// Returns:
+//   - The plaintext.
+//   - An error if the data key cannot be loaded or the value is malformed.
+func (c *Cipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+
+	if c.kms == nil {
+		return "", fmt.Errorf("decrypt: encryption is not configured")
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrMalformed
+	}
+
+	aead, err := c.dataKey(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(aead, sealed, userID[:])
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// dataKey returns the cipher of a user's data key, creating the key on first use.
+func (c *Cipher) dataKey(ctx context.Context, userID uuid.UUID) (cipher.AEAD, error) {
+	tenantID, _ := tenancy.FromContext(ctx)
+	cacheKey := tenantID + "/" + userID.String()
+
+	if aead, ok := c.cache.Load(cacheKey); ok {
+		return aead.(cipher.AEAD), nil
+	}
+
+	wrapped, err := c.keys.GetKey(ctx, userID)
+	if errors.Is(err, datakey.ErrKeyNotFound) {
+		wrapped, err = c.createKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load data key: %w", err)
+	}
+
+	key, err := c.kms.Unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Store(cacheKey, aead)
+
+	return aead, nil
+}
+
+// createKey generates a data key for a user and stores it wrapped.
+// If another instance created a key first, that key is returned instead.
+func (c *Cipher) createKey(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+
+	wrapped, err := c.kms.Wrap(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+
+	return c.keys.CreateKey(ctx, userID, wrapped)
+}
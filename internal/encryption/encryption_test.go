@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	mocks "github.com/aliskhannn/calendar-service/internal/mocks/encryption"
+	"github.com/aliskhannn/calendar-service/internal/repository/datakey"
+)
+
+func newMasterKey(t *testing.T) string {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := mocks.NewMockkeyStore(ctrl)
+	c, err := New(config.Encryption{Enabled: true, MasterKey: newMasterKey(t)}, keys)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	var stored []byte
+
+	// The data key is created once and cached afterwards.
+	keys.EXPECT().GetKey(gomock.Any(), userID).Return(nil, datakey.ErrKeyNotFound)
+	keys.EXPECT().CreateKey(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, wrapped []byte) ([]byte, error) {
+			stored = wrapped
+			return wrapped, nil
+		})
+
+	ciphertext, err := c.Encrypt(context.Background(), userID, "Doctor appointment")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, prefix))
+	assert.NotContains(t, ciphertext, "Doctor")
+	assert.NotEmpty(t, stored)
+
+	plaintext, err := c.Decrypt(context.Background(), userID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "Doctor appointment", plaintext)
+}
+
+func TestCipher_BoundToUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kms, err := NewLocalKMS(newMasterKey(t))
+	require.NoError(t, err)
+
+	wrapped, err := kms.Wrap(context.Background(), make([]byte, keySize))
+	require.NoError(t, err)
+
+	keys := mocks.NewMockkeyStore(ctrl)
+	keys.EXPECT().GetKey(gomock.Any(), gomock.Any()).Return(wrapped, nil).Times(2)
+	c := NewWithKMS(kms, keys)
+
+	ciphertext, err := c.Encrypt(context.Background(), uuid.New(), "secret")
+	require.NoError(t, err)
+
+	// Same data key, different owner: the ciphertext must not open.
+	_, err = c.Decrypt(context.Background(), uuid.New(), ciphertext)
+	assert.ErrorIs(t, err, ErrMalformed)
+}
+
+func TestCipher_PlaintextPassThrough(t *testing.T) {
+	c := Disabled()
+
+	value, err := c.Encrypt(context.Background(), uuid.New(), "Standup")
+	require.NoError(t, err)
+	assert.Equal(t, "Standup", value)
+
+	value, err = c.Decrypt(context.Background(), uuid.New(), "Standup")
+	require.NoError(t, err)
+	assert.Equal(t, "Standup", value)
+}
+
+func TestNewLocalKMS_InvalidKey(t *testing.T) {
+	_, err := NewLocalKMS("too-short")
+	assert.ErrorIs(t, err, ErrInvalidMasterKey)
+}
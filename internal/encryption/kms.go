@@ -0,0 +1,95 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidMasterKey = errors.New("master key must be 32 bytes, base64-encoded")
+
+// KeyWrapper wraps and unwraps data keys with a master key that never leaves it.
+// LocalKMS implements it with a key from the configuration; a cloud KMS client can be plugged in instead.
+type KeyWrapper interface {
+	// Wrap encrypts a data key with the master key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// Unwrap decrypts a data key wrapped by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// LocalKMS wraps data keys with an AES-256-GCM master key held in memory.
+type LocalKMS struct {
+	aead cipher.AEAD // aead encrypts data keys with the master key
+}
+
+// NewLocalKMS creates a LocalKMS from a base64-encoded 256-bit master key.
+//
+// Parameters:
+//   - masterKey: The base64-encoded master key.
+//
+// Returns:
+//   - A pointer to the initialized LocalKMS.
+//   - ErrInvalidMasterKey if the key is not 32 bytes of base64.
+func NewLocalKMS(masterKey string) (*LocalKMS, error) {
+	key, err := base64.StdEncoding.DecodeString(masterKey)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidMasterKey
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalKMS{aead: aead}, nil
+}
+
+// Wrap encrypts a data key with the master key.
+func (k *LocalKMS) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(k.aead, dataKey, nil)
+}
+
+// Unwrap decrypts a data key wrapped by Wrap.
+func (k *LocalKMS) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	return open(k.aead, wrapped, nil)
+}
+
+// newAEAD creates an AES-GCM cipher for the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce and returns nonce and ciphertext together.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the output of seal.
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,393 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/timezone"
+)
+
+// ErrNotCalendar is returned when the input is not an iCalendar object.
+var ErrNotCalendar = errors.New("not an iCalendar object")
+
+// maxLineLength caps the length of an unfolded content line, so a malformed file cannot exhaust memory.
+const maxLineLength = 1 << 20
+
+// Calendar is a parsed VCALENDAR object.
+type Calendar struct {
+	Name   string  // display name from X-WR-CALNAME; empty if not set
+	Events []Event // events of the calendar in file order
+}
+
+// Event is a parsed VEVENT component.
+// Only the properties the calendar service can represent are kept.
+type Event struct {
+	UID          string      // unique identifier of the event
+	Summary      string      // title of the event
+	Description  string      // description of the event
+	Color        string      // CSS color name of the event (COLOR, RFC 7986); empty if none
+	Priority     int         // PRIORITY from 1 (highest) to 9 (lowest), 0 if undefined; written by Write, not read by Parse
+	Start        time.Time   // start of the event; midnight UTC for all-day events
+	End          time.Time   // end of the event (DTEND); zero if none; written by Write, not read by Parse
+	AllDay       bool        // whether the start is a date without a time
+	TZID         string      // IANA time zone of the start; empty for UTC, floating and all-day starts
+	Recurring    bool        // whether the event has an RRULE or RDATE
+	Rule         string      // RRULE value repeating the event, e.g. FREQ=WEEKLY; written by Write, not read by Parse
+	Exceptions   []time.Time // occurrences excluded from Rule (EXDATE); written by Write, not read by Parse
+	Override     bool        // whether the event overrides a single occurrence (RECURRENCE-ID)
+	RecurrenceID time.Time   // start of the occurrence the event overrides (RECURRENCE-ID); written by Write, not read by Parse
+	Cancelled    bool        // whether the event has STATUS:CANCELLED
+	Alarms       []Alarm     // display and email alarms of the event
+	Updated      time.Time   // last modification, written as DTSTAMP by Write; not read by Parse
+}
+
+// Alarm is the trigger of a VALARM component.
+type Alarm struct {
+	Offset time.Duration // trigger relative to the start of the event; negative means before
+	At     *time.Time    // absolute trigger time; when set, Offset is ignored
+}
+
+// Time returns the instant the alarm of an event fires at.
+//
+// Parameters:
+//   - e: The event the alarm belongs to.
+//
+// Returns:
+//   - The trigger time.
+func (a Alarm) Time(e Event) time.Time {
+	if a.At != nil {
+		return *a.At
+	}
+	return e.Start.Add(a.Offset)
+}
+
+// property is a single unfolded content line.
+type property struct {
+	name   string            // upper-cased property name
+	params map[string]string // upper-cased parameter names with unquoted values
+	value  string            // raw value, still escaped
+}
+
+// Parse reads an iCalendar stream (RFC 5545) and returns its calendars.
+// Components other than VEVENT and VALARM are skipped; time zones are taken from the
+// IANA database by TZID instead of from VTIMEZONE definitions.
+//
+// Parameters:
+//   - r: The iCalendar stream.
+//
+// Returns:
+//   - The calendars of the stream, usually exactly one.
+//   - ErrNotCalendar if the stream contains no VCALENDAR, or another error if a line cannot be read.
+func Parse(r io.Reader) ([]Calendar, error) {
+	props, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		calendars []Calendar
+		cal       *Calendar
+		event     *Event
+		alarm     *Alarm
+		alarmOK   bool
+		stack     []string // names of the open components
+	)
+
+	for _, p := range props {
+		switch p.name {
+		case "BEGIN":
+			name := strings.ToUpper(p.value)
+			stack = append(stack, name)
+			switch {
+			case name == "VCALENDAR" && len(stack) == 1:
+				calendars = append(calendars, Calendar{})
+				cal = &calendars[len(calendars)-1]
+			case name == "VEVENT" && cal != nil && len(stack) == 2:
+				event = &Event{}
+			case name == "VALARM" && event != nil && len(stack) == 3:
+				alarm, alarmOK = &Alarm{}, false
+			}
+			continue
+		case "END":
+			if len(stack) == 0 {
+				continue
+			}
+			name := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			switch {
+			case name == "VALARM" && alarm != nil:
+				if alarmOK {
+					event.Alarms = append(event.Alarms, *alarm)
+				}
+				alarm = nil
+			case name == "VEVENT" && event != nil:
+				cal.Events = append(cal.Events, *event)
+				event = nil
+			case name == "VCALENDAR":
+				cal = nil
+			}
+			continue
+		}
+
+		switch {
+		case alarm != nil:
+			if p.name == "TRIGGER" {
+				alarmOK = parseTrigger(p, alarm)
+			}
+		case event != nil:
+			parseEventProperty(p, event)
+		case cal != nil && len(stack) == 1:
+			if p.name == "X-WR-CALNAME" {
+				cal.Name = unescape(p.value)
+			}
+		}
+	}
+
+	if len(calendars) == 0 {
+		return nil, ErrNotCalendar
+	}
+
+	return calendars, nil
+}
+
+// parseEventProperty applies a property of a VEVENT to the event. Malformed values are ignored.
+func parseEventProperty(p property, e *Event) {
+	switch p.name {
+	case "UID":
+		e.UID = p.value
+	case "SUMMARY":
+		e.Summary = unescape(p.value)
+	case "DESCRIPTION":
+		e.Description = unescape(p.value)
+	case "COLOR":
+		e.Color = strings.ToLower(p.value)
+	case "DTSTART":
+		if t, allDay, tzid, err := parseDateTime(p); err == nil {
+			e.Start, e.AllDay, e.TZID = t, allDay, tzid
+		}
+	case "RRULE", "RDATE":
+		e.Recurring = true
+	case "RECURRENCE-ID":
+		e.Override = true
+	case "STATUS":
+		e.Cancelled = strings.EqualFold(p.value, "CANCELLED")
+	}
+}
+
+// parseTrigger reads the TRIGGER of a VALARM. Triggers relative to the end of the event are not supported.
+func parseTrigger(p property, a *Alarm) bool {
+	if strings.EqualFold(p.params["VALUE"], "DATE-TIME") {
+		t, _, _, err := parseDateTime(p)
+		if err != nil {
+			return false
+		}
+		a.At = &t
+		return true
+	}
+
+	if strings.EqualFold(p.params["RELATED"], "END") {
+		return false
+	}
+
+	d, err := parseDuration(p.value)
+	if err != nil {
+		return false
+	}
+	a.Offset = d
+	return true
+}
+
+// parseDateTime parses a DATE or DATE-TIME value.
+// UTC values end with Z, zoned values carry a TZID parameter and are resolved in that zone,
+// and floating values as well as values in unknown zones are read as UTC.
+func parseDateTime(p property) (t time.Time, allDay bool, tzid string, err error) {
+	value := p.value
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err = time.Parse("20060102", value)
+		return t, true, "", err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse("20060102T150405Z", value)
+		return t, false, "", err
+	}
+
+	t, err = time.Parse("20060102T150405", value)
+	if err != nil {
+		return time.Time{}, false, "", err
+	}
+
+	if name := p.params["TZID"]; name != "" {
+		if loc, err := time.LoadLocation(name); err == nil && loc != time.Local {
+			return timezone.Resolve(t, loc), false, name, nil
+		}
+	}
+
+	return t, false, "", nil
+}
+
+// parseDuration parses an RFC 5545 duration such as -PT15M or P1DT12H.
+func parseDuration(value string) (time.Duration, error) {
+	s := value
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	num := ""
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			num += string(c)
+			continue
+		case c == 'T' && num == "" && !inTime:
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		num = ""
+
+		switch {
+		case c == 'W' && !inTime:
+			d += time.Duration(n) * 7 * 24 * time.Hour
+		case c == 'D' && !inTime:
+			d += time.Duration(n) * 24 * time.Hour
+		case c == 'H' && inTime:
+			d += time.Duration(n) * time.Hour
+		case c == 'M' && inTime:
+			d += time.Duration(n) * time.Minute
+		case c == 'S' && inTime:
+			d += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+
+	if num != "" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return sign * d, nil
+}
+
+// unfold reads the content lines of a stream, joining folded lines.
+func unfold(r io.Reader) ([]property, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	var (
+		props   []property
+		current strings.Builder
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			if p, ok := parseLine(current.String()); ok {
+				props = append(props, p)
+			}
+			current.Reset()
+		}
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			if current.Len()+len(line) > maxLineLength {
+				return nil, fmt.Errorf("content line too long")
+			}
+			current.WriteString(line[1:])
+			continue
+		}
+		flush()
+		current.WriteString(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	flush()
+
+	return props, nil
+}
+
+// parseLine splits a content line into its name, parameters and value.
+func parseLine(line string) (property, bool) {
+	// The value starts at the first colon outside a quoted parameter value.
+	colon := -1
+	quoted := false
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return property{}, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	p := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[colon+1:],
+	}
+	for _, param := range parts[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		p.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return p, true
+}
+
+// unescape decodes a TEXT value.
+func unescape(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+	escaped := false
+	for _, c := range value {
+		if !escaped {
+			if c == '\\' {
+				escaped = true
+			} else {
+				b.WriteRune(c)
+			}
+			continue
+		}
+
+		escaped = false
+		switch c {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteRune(c) // \\, \; and \,
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,237 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const takeoutCalendar = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:-//Google Inc//Google Calendar 70.9054//EN\r\n" +
+	"VERSION:2.0\r\n" +
+	"X-WR-CALNAME:Work\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Europe/Berlin\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19701025T030000\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20301104T090000\r\n" +
+	"DTEND;TZID=Europe/Berlin:20301104T100000\r\n" +
+	"UID:standup@google.com\r\n" +
+	"SUMMARY:Standup\\, daily\r\n" +
+	"DESCRIPTION:Line one\\nLine two that is folded over\r\n" +
+	"  two lines\r\n" +
+	"RRULE:FREQ=DAILY\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER;RELATED=END:PT0S\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20301225\r\n" +
+	"UID:holiday@google.com\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"COLOR:Teal\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"TRIGGER;VALUE=DATE-TIME:20301224T170000Z\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20301105T080000Z\r\n" +
+	"RECURRENCE-ID;TZID=Europe/Berlin:20301105T090000\r\n" +
+	"UID:standup@google.com\r\n" +
+	"SUMMARY:Standup (moved)\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	calendars, err := Parse(strings.NewReader(takeoutCalendar))
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+
+	cal := calendars[0]
+	assert.Equal(t, "Work", cal.Name)
+	require.Len(t, cal.Events, 3)
+
+	standup := cal.Events[0]
+	assert.Equal(t, "standup@google.com", standup.UID)
+	assert.Equal(t, "Standup, daily", standup.Summary)
+	assert.Equal(t, "Line one\nLine two that is folded over two lines", standup.Description)
+	assert.True(t, standup.Start.Equal(time.Date(2030, 11, 4, 8, 0, 0, 0, time.UTC)), "09:00 CET is 08:00 UTC")
+	assert.Equal(t, "Europe/Berlin", standup.TZID)
+	assert.True(t, standup.Recurring)
+	require.Len(t, standup.Alarms, 1, "alarms relative to the end are dropped")
+	assert.Equal(t, time.Date(2030, 11, 4, 7, 45, 0, 0, time.UTC), standup.Alarms[0].Time(standup).UTC())
+
+	holiday := cal.Events[1]
+	assert.True(t, holiday.AllDay)
+	assert.Equal(t, time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC), holiday.Start)
+	assert.Equal(t, "teal", holiday.Color)
+	require.Len(t, holiday.Alarms, 1)
+	assert.Equal(t, time.Date(2030, 12, 24, 17, 0, 0, 0, time.UTC), holiday.Alarms[0].Time(holiday))
+
+	moved := cal.Events[2]
+	assert.True(t, moved.Override)
+	assert.True(t, moved.Cancelled)
+}
+
+func TestParse_NotCalendar(t *testing.T) {
+	_, err := Parse(strings.NewReader("BEGIN:VCARD\r\nFN:Jane\r\nEND:VCARD\r\n"))
+	assert.ErrorIs(t, err, ErrNotCalendar)
+}
+
+func TestParse_FloatingAndUnknownZone(t *testing.T) {
+	calendars, err := Parse(strings.NewReader("BEGIN:VCALENDAR\n" +
+		"BEGIN:VEVENT\nDTSTART:20300101T090000\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nDTSTART;TZID=\"W. Europe Standard Time\":20300101T090000\nEND:VEVENT\n" +
+		"END:VCALENDAR\n"))
+	require.NoError(t, err)
+
+	for _, e := range calendars[0].Events {
+		assert.Equal(t, time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC), e.Start)
+		assert.Empty(t, e.TZID)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"-PT15M", -15 * time.Minute},
+		{"PT1H30M", 90 * time.Minute},
+		{"-P1DT12H", -36 * time.Hour},
+		{"+P1W", 7 * 24 * time.Hour},
+		{"PT0S", 0},
+	}
+	for _, tt := range tests {
+		got, err := parseDuration(tt.value)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+
+	for _, value := range []string{"", "P", "PT", "15M", "P1H", "PT1D", "PT15"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	updated := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	cal := Calendar{
+		Name: "Work; Team",
+		Events: []Event{
+			{
+				UID:         "1@calendar-service",
+				Summary:     "Planning, Q1",
+				Description: "Agenda:\n" + strings.Repeat("é", 60),
+				Color:       "purple",
+				Priority:    1,
+				Start:       time.Date(2030, 1, 7, 10, 0, 0, 0, time.FixedZone("CET", 3600)),
+				Alarms:      []Alarm{{Offset: -15 * time.Minute}},
+				Updated:     updated,
+			},
+			{
+				UID:     "2@calendar-service",
+				Summary: "Holiday",
+				Start:   time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC),
+				AllDay:  true,
+				Alarms:  []Alarm{{At: &updated}},
+				Updated: updated,
+			},
+			{
+				UID:        "3@calendar-service",
+				Summary:    "Standup",
+				Start:      time.Date(2030, 1, 6, 9, 0, 0, 0, time.UTC),
+				End:        time.Date(2030, 1, 6, 9, 15, 0, 0, time.UTC),
+				Rule:       "FREQ=WEEKLY;UNTIL=20300331T090000Z",
+				Exceptions: []time.Time{time.Date(2030, 1, 13, 9, 0, 0, 0, time.UTC), time.Date(2030, 1, 20, 9, 0, 0, 0, time.UTC)},
+				Updated:    updated,
+			},
+			{
+				UID:          "3@calendar-service",
+				Summary:      "Standup (moved)",
+				Start:        time.Date(2030, 1, 27, 10, 0, 0, 0, time.UTC),
+				End:          time.Date(2030, 1, 27, 10, 15, 0, 0, time.UTC),
+				RecurrenceID: time.Date(2030, 1, 27, 9, 0, 0, 0, time.UTC),
+				Updated:      updated,
+			},
+		},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Write(&b, cal, time.Hour))
+	out := b.String()
+
+	assert.Contains(t, out, "REFRESH-INTERVAL;VALUE=DURATION:PT1H\r\n")
+	assert.Contains(t, out, "X-PUBLISHED-TTL:PT1H\r\n")
+	assert.Contains(t, out, "DTSTAMP:20300102T030405Z\r\n")
+	assert.Equal(t, 1, strings.Count(out, "PRIORITY:"), "undefined priorities are left out")
+	assert.Contains(t, out, "PRIORITY:1\r\n")
+	assert.Contains(t, out, "DTEND:20300106T091500Z\r\n")
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;UNTIL=20300331T090000Z\r\n")
+	assert.Contains(t, out, "EXDATE:20300113T090000Z,20300120T090000Z\r\n")
+	assert.Contains(t, out, "RECURRENCE-ID:20300127T090000Z\r\nDTEND:20300127T101500Z\r\n")
+	assert.Equal(t, 1, strings.Count(out, "RRULE"), "overridden occurrences have no rule")
+	assert.Equal(t, 2, strings.Count(out, "DTEND"), "events without an end have no DTEND")
+	assert.Contains(t, out, "BEGIN:VALARM\r\nACTION:DISPLAY\r\nDESCRIPTION:Planning\\, Q1\r\nTRIGGER:-PT15M\r\nEND:VALARM\r\n")
+	assert.Contains(t, out, "TRIGGER;VALUE=DATE-TIME:20300102T030405Z\r\n")
+	for _, line := range strings.Split(out, "\r\n") {
+		assert.LessOrEqual(t, len(line), foldLength, line)
+	}
+
+	parsed, err := Parse(strings.NewReader(out))
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+	assert.Equal(t, "Work; Team", parsed[0].Name)
+	require.Len(t, parsed[0].Events, 4)
+
+	planning := parsed[0].Events[0]
+	assert.Equal(t, "1@calendar-service", planning.UID)
+	assert.Equal(t, "Planning, Q1", planning.Summary)
+	assert.Equal(t, cal.Events[0].Description, planning.Description)
+	assert.True(t, planning.Start.Equal(cal.Events[0].Start))
+	assert.Equal(t, "purple", planning.Color)
+	require.Len(t, planning.Alarms, 1)
+	assert.Equal(t, -15*time.Minute, planning.Alarms[0].Offset)
+
+	holiday := parsed[0].Events[1]
+	assert.True(t, holiday.AllDay)
+	assert.Equal(t, cal.Events[1].Start, holiday.Start)
+	require.Len(t, holiday.Alarms, 1)
+	assert.True(t, holiday.Alarms[0].Time(holiday).Equal(updated))
+
+	assert.True(t, parsed[0].Events[2].Recurring)
+	assert.True(t, parsed[0].Events[3].Override)
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{time.Hour, "PT1H"},
+		{90 * time.Minute, "PT1H30M"},
+		{24 * time.Hour, "P1D"},
+		{36 * time.Hour, "P1DT12H"},
+		{45 * time.Second, "PT45S"},
+		{0, "PT0S"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatDuration(tt.d), tt.d.String())
+
+		parsed, err := parseDuration(tt.want)
+		require.NoError(t, err, tt.want)
+		assert.Equal(t, tt.d, parsed, tt.want)
+	}
+}
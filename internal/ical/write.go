@@ -0,0 +1,183 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// foldLength is the maximum length of a content line in octets before it is folded.
+const foldLength = 75
+
+// Write encodes a calendar as an iCalendar stream (RFC 5545) for subscription by calendar clients.
+// Starts, ends and excluded occurrences are written in UTC, or as dates for all-day events.
+// Alarms are written as display alarms, relative to the start or at an absolute time in UTC.
+// Recurring events are written once, with their RRULE and EXDATE, and expanded by the clients; an overridden
+// occurrence is written as another event with the same UID and the RECURRENCE-ID of the occurrence it replaces.
+// Colors are written as COLOR (RFC 7986), which only takes CSS color names; undefined priorities are left out.
+// REFRESH-INTERVAL (RFC 7986) and X-PUBLISHED-TTL ask clients to poll the calendar at the given interval.
+//
+// Parameters:
+//   - w: The writer the stream is written to.
+//   - cal: The calendar; Name becomes the calendar title.
+//   - refresh: The interval clients should refresh the calendar at; none is announced if not positive.
+//
+// Returns:
+//   - An error if the stream cannot be written.
+func Write(w io.Writer, cal Calendar, refresh time.Duration) error {
+	var b strings.Builder
+
+	line := func(name, value string) {
+		writeLine(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//calendar-service//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		line("NAME", escape(cal.Name))
+		line("X-WR-CALNAME", escape(cal.Name))
+	}
+	if refresh > 0 {
+		line("REFRESH-INTERVAL;VALUE=DURATION", formatDuration(refresh))
+		line("X-PUBLISHED-TTL", formatDuration(refresh))
+	}
+
+	for _, e := range cal.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", e.UID)
+		line("DTSTAMP", e.Updated.UTC().Format("20060102T150405Z"))
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE", e.Start.Format("20060102"))
+		} else {
+			line("DTSTART", e.Start.UTC().Format("20060102T150405Z"))
+		}
+		if !e.RecurrenceID.IsZero() {
+			if e.AllDay {
+				line("RECURRENCE-ID;VALUE=DATE", e.RecurrenceID.Format("20060102"))
+			} else {
+				line("RECURRENCE-ID", e.RecurrenceID.UTC().Format("20060102T150405Z"))
+			}
+		}
+		if !e.End.IsZero() {
+			if e.AllDay {
+				line("DTEND;VALUE=DATE", e.End.Format("20060102"))
+			} else {
+				line("DTEND", e.End.UTC().Format("20060102T150405Z"))
+			}
+		}
+		if e.Rule != "" {
+			line("RRULE", e.Rule)
+			if len(e.Exceptions) > 0 {
+				dates := make([]string, len(e.Exceptions))
+				for i, at := range e.Exceptions {
+					if e.AllDay {
+						dates[i] = at.Format("20060102")
+					} else {
+						dates[i] = at.UTC().Format("20060102T150405Z")
+					}
+				}
+				if e.AllDay {
+					line("EXDATE;VALUE=DATE", strings.Join(dates, ","))
+				} else {
+					line("EXDATE", strings.Join(dates, ","))
+				}
+			}
+		}
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Color != "" {
+			line("COLOR", e.Color)
+		}
+		if e.Priority > 0 {
+			line("PRIORITY", strconv.Itoa(e.Priority))
+		}
+		for _, a := range e.Alarms {
+			line("BEGIN", "VALARM")
+			line("ACTION", "DISPLAY")
+			line("DESCRIPTION", escape(e.Summary))
+			if a.At != nil {
+				line("TRIGGER;VALUE=DATE-TIME", a.At.UTC().Format("20060102T150405Z"))
+			} else {
+				line("TRIGGER", formatTrigger(a.Offset))
+			}
+			line("END", "VALARM")
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+
+	return nil
+}
+
+// writeLine writes a content line terminated by CRLF, folding it after every 75 octets
+// without splitting a UTF-8 sequence.
+func writeLine(b *strings.Builder, line string) {
+	n := 0
+	for _, c := range line {
+		size := len(string(c))
+		if n+size > foldLength {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(c)
+		n += size
+	}
+	b.WriteString("\r\n")
+}
+
+// escape encodes a TEXT value.
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// formatTrigger formats the offset of an alarm from the start of its event, e.g. -PT15M for 15 minutes before.
+func formatTrigger(offset time.Duration) string {
+	if offset < 0 {
+		return "-" + formatDuration(-offset)
+	}
+	return formatDuration(offset)
+}
+
+// formatDuration formats a positive duration as an RFC 5545 duration such as PT1H30M or P1D.
+// Fractions of a second are dropped.
+func formatDuration(d time.Duration) string {
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+
+	var b strings.Builder
+	b.WriteString("P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 || days == 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds > 0 || (hours == 0 && minutes == 0) {
+			fmt.Fprintf(&b, "%dS", seconds)
+		}
+	}
+
+	return b.String()
+}
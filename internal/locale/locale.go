@@ -0,0 +1,184 @@
+package locale
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedLocale is returned when a requested locale has no translations.
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// Locale holds the names and formats used to render dates for one language and region.
+type Locale struct {
+	Tag        string       // BCP 47 tag of the locale, e.g. "de" or "en-US"
+	FirstDay   time.Weekday // first day of the week
+	Months     [12]string   // month names, January first, as used standalone
+	MonthsIn   [12]string   // month names as used inside a date, if they differ from Months, e.g. genitive forms
+	Weekdays   [7]string    // weekday names, Sunday first
+	DateFormat string       // long date pattern with {weekday}, {day}, {month} and {year} placeholders
+	TimeFormat string       // time layout in Go reference time notation
+}
+
+// locales lists the supported locales by lower-case tag. A language without a region maps to its main region.
+var locales = map[string]Locale{
+	"en-us": {
+		Tag:        "en-US",
+		FirstDay:   time.Sunday,
+		Months:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays:   [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		DateFormat: "{weekday}, {month} {day}, {year}",
+		TimeFormat: "3:04 PM",
+	},
+	"en-gb": {
+		Tag:        "en-GB",
+		FirstDay:   time.Monday,
+		Months:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays:   [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		DateFormat: "{weekday} {day} {month} {year}",
+		TimeFormat: "15:04",
+	},
+	"de-de": {
+		Tag:        "de-DE",
+		FirstDay:   time.Monday,
+		Months:     [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		Weekdays:   [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		DateFormat: "{weekday}, {day}. {month} {year}",
+		TimeFormat: "15:04",
+	},
+	"fr-fr": {
+		Tag:        "fr-FR",
+		FirstDay:   time.Monday,
+		Months:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		Weekdays:   [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		DateFormat: "{weekday} {day} {month} {year}",
+		TimeFormat: "15:04",
+	},
+	"es-es": {
+		Tag:        "es-ES",
+		FirstDay:   time.Monday,
+		Months:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		Weekdays:   [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		DateFormat: "{weekday}, {day} de {month} de {year}",
+		TimeFormat: "15:04",
+	},
+	"ru-ru": {
+		Tag:        "ru-RU",
+		FirstDay:   time.Monday,
+		Months:     [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+		MonthsIn:   [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		Weekdays:   [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		DateFormat: "{weekday}, {day} {month} {year} г.",
+		TimeFormat: "15:04",
+	},
+}
+
+// defaultRegions maps languages to the region used when a tag has no region or an unsupported one.
+var defaultRegions = map[string]string{
+	"en": "en-us",
+	"de": "de-de",
+	"fr": "fr-fr",
+	"es": "es-es",
+	"ru": "ru-ru",
+}
+
+// Lookup returns the locale for a BCP 47 tag such as "de", "en-GB" or "de_AT".
+// Tags of a supported language with an unsupported region fall back to the language's main region.
+//
+// Parameters:
+//   - tag: The locale tag, case-insensitive; "_" is accepted as separator.
+//
+// Returns:
+//   - The locale.
+//   - ErrUnsupportedLocale if the language is not supported.
+func Lookup(tag string) (Locale, error) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if l, ok := locales[tag]; ok {
+		return l, nil
+	}
+
+	language, _, _ := strings.Cut(tag, "-")
+	if l, ok := locales[defaultRegions[language]]; ok {
+		return l, nil
+	}
+
+	return Locale{}, ErrUnsupportedLocale
+}
+
+// FromAcceptLanguage returns the supported locale the client prefers most,
+// following the quality values of an Accept-Language header.
+//
+// Parameters:
+//   - header: The Accept-Language header, e.g. "de-CH, de;q=0.9, en;q=0.8".
+//
+// Returns:
+//   - The preferred supported locale.
+//   - False if the header names no supported locale.
+func FromAcceptLanguage(header string) (Locale, bool) {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if tag != "" && tag != "*" && q > 0 {
+			candidates = append(candidates, candidate{tag: tag, q: q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	for _, c := range candidates {
+		if l, err := Lookup(c.tag); err == nil {
+			return l, true
+		}
+	}
+
+	return Locale{}, false
+}
+
+// MonthName returns the standalone name of a month, e.g. for a calendar heading.
+func (l Locale) MonthName(m time.Month) string {
+	return l.Months[m-1]
+}
+
+// WeekdayName returns the name of a weekday.
+func (l Locale) WeekdayName(d time.Weekday) string {
+	return l.Weekdays[d]
+}
+
+// FormatDate returns the long form of the date of t, e.g. "Montag, 8. September 2025".
+//
+// Parameters:
+//   - t: The instant whose date is formatted, in the location it should be shown in.
+//
+// Returns:
+//   - The formatted date.
+func (l Locale) FormatDate(t time.Time) string {
+	month := l.MonthsIn[t.Month()-1]
+	if month == "" {
+		month = l.Months[t.Month()-1]
+	}
+
+	return strings.NewReplacer(
+		"{weekday}", l.Weekdays[t.Weekday()],
+		"{day}", strconv.Itoa(t.Day()),
+		"{month}", month,
+		"{year}", strconv.Itoa(t.Year()),
+	).Replace(l.DateFormat)
+}
+
+// FormatTime returns the time of day of t, e.g. "9:00 AM" or "09:00".
+func (l Locale) FormatTime(t time.Time) string {
+	return t.Format(l.TimeFormat)
+}
@@ -0,0 +1,91 @@
+package locale
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"de", "de-DE"},
+		{"de_AT", "de-DE"},
+		{"EN-gb", "en-GB"},
+		{"en", "en-US"},
+		{"en-AU", "en-US"},
+		{" ru ", "ru-RU"},
+	}
+	for _, tt := range tests {
+		l, err := Lookup(tt.tag)
+		if err != nil {
+			t.Fatalf("Lookup(%q) failed: %v", tt.tag, err)
+		}
+		if l.Tag != tt.want {
+			t.Errorf("Lookup(%q) = %s, want %s", tt.tag, l.Tag, tt.want)
+		}
+	}
+
+	for _, tag := range []string{"", "xx", "zh-CN"} {
+		if _, err := Lookup(tag); !errors.Is(err, ErrUnsupportedLocale) {
+			t.Errorf("Lookup(%q) error = %v, want ErrUnsupportedLocale", tag, err)
+		}
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+		ok     bool
+	}{
+		{"fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5", "fr-FR", true},
+		{"ja, en-GB;q=0.5", "en-GB", true},
+		{"en;q=0.2, de;q=0.7", "de-DE", true},
+		{"de;q=0, es", "es-ES", true},
+		{"ja, *", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		l, ok := FromAcceptLanguage(tt.header)
+		if ok != tt.ok || l.Tag != tt.want {
+			t.Errorf("FromAcceptLanguage(%q) = %q, %v; want %q, %v", tt.header, l.Tag, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLocale_Format(t *testing.T) {
+	at := time.Date(2025, time.September, 8, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag  string
+		date string
+		time string
+	}{
+		{"en-US", "Monday, September 8, 2025", "2:05 PM"},
+		{"en-GB", "Monday 8 September 2025", "14:05"},
+		{"de", "Montag, 8. September 2025", "14:05"},
+		{"fr", "lundi 8 septembre 2025", "14:05"},
+		{"es", "lunes, 8 de septiembre de 2025", "14:05"},
+		{"ru", "понедельник, 8 сентября 2025 г.", "14:05"},
+	}
+	for _, tt := range tests {
+		l, err := Lookup(tt.tag)
+		if err != nil {
+			t.Fatalf("Lookup(%q) failed: %v", tt.tag, err)
+		}
+		if got := l.FormatDate(at); got != tt.date {
+			t.Errorf("%s: FormatDate() = %q, want %q", tt.tag, got, tt.date)
+		}
+		if got := l.FormatTime(at); got != tt.time {
+			t.Errorf("%s: FormatTime() = %q, want %q", tt.tag, got, tt.time)
+		}
+	}
+
+	ru, _ := Lookup("ru")
+	if got := ru.MonthName(time.September); got != "сентябрь" {
+		t.Errorf("MonthName() = %q, want the standalone form", got)
+	}
+}
@@ -5,36 +5,64 @@ import (
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
 )
 
 // CreateLogger initializes and configures a new Zap logger instance.
-// It sets up a production-ready JSON logger with an Info level, ISO8601 timestamp format,
-// and includes the process ID in log entries. Logs are output to stdout, with errors to stderr.
+// It sets up a logger with the level and encoding (json or console) from the configuration,
+// ISO8601 timestamps, and the process ID in log entries. Logs are written to stdout and,
+// if a file path is configured, to a size-rotated log file. Internal logger errors go to stderr.
+// Emails, tokens, and event content are redacted from log fields (see Redact).
+//
+// Parameters:
+//   - cfg: The logger configuration.
 //
 // Returns:
 //   - A pointer to the configured Zap logger.
-func CreateLogger() *zap.Logger {
-	// Configure encoder settings for JSON output.
+//   - The atomic level of the logger, which can be changed at runtime.
+func CreateLogger(cfg config.Logger) (*zap.Logger, zap.AtomicLevel) {
+	// Parse the minimum log level, defaulting to Info.
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+	if cfg.Level != "" {
+		if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+			panic(err)
+		}
+	}
+
+	// Configure encoder settings.
 	encoderCfg := zap.NewProductionEncoderConfig()
 	encoderCfg.TimeKey = "timestamp"                   // key for timestamp field in logs
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder // format timestamps in ISO8601
 
-	// Configure logger settings.
-	config := zap.Config{
-		Level:             zap.NewAtomicLevelAt(zap.InfoLevel), // set minimum log level to Info
-		Development:       false,                               // disable development mode
-		DisableCaller:     false,                               // include caller information
-		DisableStacktrace: false,                               // include stacktraces for errors
-		Sampling:          nil,                                 // disable sampling
-		Encoding:          "json",                              // use JSON encoding for logs
-		EncoderConfig:     encoderCfg,                          // apply encoder configuration
-		OutputPaths:       []string{"stdout"},                  // output logs to stdout
-		ErrorOutputPaths:  []string{"stderr"},                  // output errors to stderr
-		InitialFields: map[string]interface{}{
-			"pid": os.Getpid(), // include process ID in all log entries
-		},
+	var encoder zapcore.Encoder
+	switch cfg.Encoding {
+	case "console":
+		encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder // colored levels for terminals
+		encoder = zapcore.NewConsoleEncoder(encoderCfg)
+	default:
+		encoder = zapcore.NewJSONEncoder(encoderCfg)
 	}
 
-	// Build and return the logger, panicking on failure.
-	return zap.Must(config.Build())
+	// Always write to stdout, optionally also to a rotated file.
+	sinks := []zapcore.WriteSyncer{zapcore.Lock(os.Stdout)}
+	if cfg.File.Path != "" {
+		sinks = append(sinks, zapcore.AddSync(&lumberjack.Logger{
+			Filename:   cfg.File.Path,       // log file location
+			MaxSize:    cfg.File.MaxSize,    // megabytes before rotation
+			MaxBackups: cfg.File.MaxBackups, // number of rotated files to keep
+			MaxAge:     cfg.File.MaxAge,     // days to keep rotated files
+			Compress:   cfg.File.Compress,   // gzip rotated files
+		}))
+	}
+
+	core := Redact(zapcore.NewCore(encoder, zapcore.NewMultiWriteSyncer(sinks...), level), cfg.StrictRedaction)
+
+	return zap.New(core,
+		zap.AddCaller(),                          // include caller information
+		zap.AddStacktrace(zap.ErrorLevel),        // include stacktraces for errors
+		zap.ErrorOutput(zapcore.Lock(os.Stderr)), // output internal errors to stderr
+		zap.Fields(zap.Int("pid", os.Getpid())),  // include process ID in all log entries
+	), level
 }
@@ -0,0 +1,146 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted replaces values that must not be logged.
+const redacted = "[REDACTED]"
+
+// contentKeys lists field keys carrying user content or secrets; their values are always redacted.
+var contentKeys = map[string]bool{
+	"title":         true,
+	"description":   true,
+	"event":         true,
+	"message":       true,
+	"token":         true,
+	"password":      true,
+	"secret":        true,
+	"authorization": true,
+}
+
+// emailKeys lists field keys carrying email addresses; their values are masked, or redacted in strict mode.
+var emailKeys = map[string]bool{
+	"email": true,
+	"to":    true,
+}
+
+// strictKeys lists field keys that are only redacted in strict mode.
+var strictKeys = map[string]bool{
+	"request_body":  true,
+	"response_body": true,
+}
+
+var (
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+\S+`)
+	// tokenPattern matches token query parameters, e.g. of one-click unsubscribe links, keeping the parameter name.
+	tokenPattern = regexp.MustCompile(`(?i)([?&][a-z_]*token=)[^&#\s]+`)
+)
+
+// redactingCore is a zapcore.Core that removes personal data and secrets from log fields
+// before passing entries to the wrapped core.
+type redactingCore struct {
+	zapcore.Core
+	strict bool // strict also scrubs messages, errors and arbitrary values, and redacts emails fully
+}
+
+// Redact wraps a core so that emails, tokens, and event content are removed from log fields.
+// Fields are redacted by key (e.g. "email", "title") and string values are scanned for emails and tokens.
+// In strict mode emails are redacted instead of masked, and log messages, errors, and other values
+// are scrubbed as well, at the cost of less useful error details.
+//
+// Parameters:
+//   - core: The core to wrap.
+//   - strict: Whether to use strict redaction.
+//
+// Returns:
+//   - The redacting core.
+func Redact(core zapcore.Core, strict bool) zapcore.Core {
+	return &redactingCore{Core: core, strict: strict}
+}
+
+// With returns a copy of the core with additional redacted fields.
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactFields(fields)), strict: c.strict}
+}
+
+// Check adds the core to the checked entry if the wrapped core accepts the level.
+func (c *redactingCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+// Write redacts the entry and passes it to the wrapped core.
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if c.strict {
+		entry.Message = c.scrub(entry.Message)
+	}
+
+	return c.Core.Write(entry, c.redactFields(fields))
+}
+
+// redactFields returns a redacted copy of the fields.
+func (c *redactingCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		out[i] = c.redactField(f)
+	}
+	return out
+}
+
+// redactField redacts a single field by its key and value.
+func (c *redactingCore) redactField(f zapcore.Field) zapcore.Field {
+	key := strings.ToLower(f.Key)
+
+	switch {
+	case contentKeys[key] || (c.strict && strictKeys[key]):
+		return zap.String(f.Key, redacted)
+	case emailKeys[key] && f.Type == zapcore.StringType:
+		return zap.String(f.Key, c.maskEmail(f.String))
+	}
+
+	switch f.Type {
+	case zapcore.StringType:
+		return zap.String(f.Key, c.scrub(f.String))
+	case zapcore.ErrorType:
+		if c.strict {
+			if err, ok := f.Interface.(error); ok {
+				return zap.String(f.Key, c.scrub(err.Error()))
+			}
+		}
+	case zapcore.StringerType, zapcore.ReflectType:
+		if c.strict {
+			return zap.String(f.Key, c.scrub(fmt.Sprint(f.Interface)))
+		}
+	}
+
+	return f
+}
+
+// scrub removes email addresses and tokens from free text.
+func (c *redactingCore) scrub(s string) string {
+	s = bearerPattern.ReplaceAllString(s, "Bearer "+redacted)
+	s = tokenPattern.ReplaceAllString(s, "${1}"+redacted)
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	return emailPattern.ReplaceAllStringFunc(s, c.maskEmail)
+}
+
+// maskEmail keeps the first character and the domain of an email address, e.g. "j***@example.com".
+// In strict mode the whole address is redacted.
+func (c *redactingCore) maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if c.strict || at < 1 {
+		return redacted
+	}
+
+	return email[:1] + "***" + email[at:]
+}
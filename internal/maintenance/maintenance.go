@@ -0,0 +1,67 @@
+package maintenance
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// Settings describe the maintenance mode of the service.
+type Settings struct {
+	Enabled    bool          // whether the service is in maintenance mode
+	RetryAfter time.Duration // how long clients are told to wait before retrying
+	Message    string        // optional message returned to clients
+}
+
+// Mode holds the runtime-toggleable maintenance mode of one service instance.
+// While enabled, the API rejects non-admin requests and background workers pause.
+// It is safe for concurrent use.
+type Mode struct {
+	mu       sync.RWMutex // mu guards settings
+	settings Settings     // settings are the current maintenance settings
+}
+
+// New creates a Mode initialized from the configuration.
+//
+// Parameters:
+//   - cfg: The maintenance configuration.
+//
+// Returns:
+//   - A pointer to the initialized Mode.
+func New(cfg config.Maintenance) *Mode {
+	return &Mode{
+		settings: Settings{
+			Enabled:    cfg.Enabled,
+			RetryAfter: cfg.RetryAfter,
+			Message:    cfg.Message,
+		},
+	}
+}
+
+// Enabled reports whether maintenance mode is on.
+func (m *Mode) Enabled() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.settings.Enabled
+}
+
+// Settings returns the current maintenance settings.
+func (m *Mode) Settings() Settings {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.settings
+}
+
+// Set replaces the maintenance settings. A zero RetryAfter keeps the current one.
+func (m *Mode) Set(s Settings) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s.RetryAfter <= 0 {
+		s.RetryAfter = m.settings.RetryAfter
+	}
+	m.settings = s
+}
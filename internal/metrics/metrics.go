@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Process-wide counters, published in the expvar format together with the runtime memory statistics.
+var (
+	// LogEntriesDropped counts request log entries dropped because the async log buffer was full.
+	LogEntriesDropped = expvar.NewInt("log_entries_dropped_total")
+
+	// LogEntriesWritten counts request log entries written by the async logger.
+	LogEntriesWritten = expvar.NewInt("log_entries_written_total")
+
+	// SlowQueries counts database queries that took longer than the slow query threshold.
+	SlowQueries = expvar.NewInt("db_slow_queries_total")
+
+	// Queries holds a QueryStats per query name, e.g. event.GetEvent.
+	Queries = expvar.NewMap("db_queries")
+)
+
+// queryBuckets are the upper bounds of the query duration histogram buckets.
+var queryBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// QueryStats records the executions of a database query: their number, errors, rows and a duration histogram.
+// It is published as an expvar.Var.
+type QueryStats struct {
+	mu       sync.Mutex
+	count    int64         // executions of the query
+	errors   int64         // executions that failed
+	rows     int64         // rows returned or affected by all executions
+	duration time.Duration // total duration of all executions
+	buckets  []int64       // executions per bucket of queryBuckets; the last one counts slower executions
+}
+
+// ObserveQuery records an execution of a query under its name.
+//
+// Parameters:
+//   - name: The name of the query.
+//   - d: The duration of the execution.
+//   - rows: The rows returned or affected.
+//   - failed: Whether the execution failed.
+func ObserveQuery(name string, d time.Duration, rows int64, failed bool) {
+	stats, ok := Queries.Get(name).(*QueryStats)
+	if !ok {
+		stats = &QueryStats{buckets: make([]int64, len(queryBuckets)+1)}
+		// Another goroutine may have added the query meanwhile; keep its stats.
+		if existing, loaded := addQuery(name, stats); loaded {
+			stats = existing
+		}
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.count++
+	stats.rows += rows
+	stats.duration += d
+	if failed {
+		stats.errors++
+	}
+
+	i := 0
+	for i < len(queryBuckets) && d > queryBuckets[i] {
+		i++
+	}
+	stats.buckets[i]++
+}
+
+// queriesMu serializes the registration of new query names.
+var queriesMu sync.Mutex
+
+// addQuery registers the stats of a query name unless it already has some, which it returns instead.
+func addQuery(name string, stats *QueryStats) (*QueryStats, bool) {
+	queriesMu.Lock()
+	defer queriesMu.Unlock()
+
+	if existing, ok := Queries.Get(name).(*QueryStats); ok {
+		return existing, true
+	}
+	Queries.Set(name, stats)
+	return stats, false
+}
+
+// String returns the stats as JSON, with the histogram as cumulative counts per upper bound in milliseconds.
+func (s *QueryStats) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	histogram := make(map[string]int64, len(s.buckets))
+	var cumulative int64
+	for i, n := range s.buckets {
+		cumulative += n
+		le := "+Inf"
+		if i < len(queryBuckets) {
+			le = strconv.FormatFloat(float64(queryBuckets[i])/float64(time.Millisecond), 'f', -1, 64)
+		}
+		histogram[le] = cumulative
+	}
+
+	b, _ := json.Marshal(struct {
+		Count      int64            `json:"count"`
+		Errors     int64            `json:"errors"`
+		Rows       int64            `json:"rows"`
+		DurationMS float64          `json:"duration_ms_sum"`
+		Histogram  map[string]int64 `json:"duration_ms_bucket"`
+	}{
+		Count:      s.count,
+		Errors:     s.errors,
+		Rows:       s.rows,
+		DurationMS: float64(s.duration) / float64(time.Millisecond),
+		Histogram:  histogram,
+	})
+	return string(b)
+}
+
+// Handler returns an HTTP handler serving all published metrics as JSON.
+//
+// Returns:
+//   - The metrics handler.
+func Handler() http.Handler {
+	return expvar.Handler()
+}
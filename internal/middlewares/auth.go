@@ -11,6 +11,8 @@ import (
 
 	"github.com/aliskhannn/calendar-service/internal/api/response"
 	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
 )
 
 var (
@@ -18,99 +20,193 @@ var (
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrInvalidTokenFormat = errors.New("invalid token format")
 	ErrExpiredToken       = errors.New("token had expired")
+	ErrForbidden          = errors.New("forbidden")
 )
 
 // contextKey is a custom type to avoid collisions when storing values in context.
 type contextKey string
 
-// UserIDKey is the key used to store and retrieve the authenticated user's ID from the request context.
-const UserIDKey contextKey = "user_id"
+const (
+	// UserIDKey is the key used to store and retrieve the authenticated user's ID from the request context.
+	UserIDKey contextKey = "user_id"
+
+	// RoleKey is the key used to store and retrieve the authenticated user's role from the request context.
+	RoleKey contextKey = "role"
+)
 
 // Auth creates an HTTP middleware that enforces JWT authentication.
 // It extracts and validates a JWT token from the Authorization header, verifies it using the provided secret,
-// and stores the authenticated user ID in the request context if valid.
+// and stores the authenticated user ID and role in the request context if valid.
+// Tokens issued for a tenant bind the request to that tenant; a tenant header naming another tenant is rejected.
+// With an issuer or audience configured, tokens must carry them, and expiry and issue times are checked
+// with the configured clock skew tolerance.
 // If the token is missing, invalid, or expired, it returns an unauthorized response.
 //
 // Parameters:
-//   - jwtCfg: The JWT configuration containing the secret key for token validation.
+//   - jwtCfg: The JWT configuration containing the secret key, issuer, audience and leeway for token validation.
 //
 // Returns:
 //   - An HTTP middleware handler that wraps the next handler in the chain.
 func Auth(jwtCfg config.JWT) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Extract Authorization header.
-			tokenStr := r.Header.Get("Authorization")
-			if tokenStr == "" {
-				response.Fail(w, http.StatusUnauthorized, ErrNoToken)
-				return
-			}
-
-			// Validate Bearer token format.
-			parts := strings.Split(tokenStr, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				response.Fail(w, http.StatusUnauthorized, ErrInvalidTokenFormat)
+			tokenStr, err := bearerToken(r)
+			if err != nil {
+				response.Fail(w, http.StatusUnauthorized, err)
 				return
 			}
 
-			// Validate the JWT token and extract user ID.
-			userID, err := validateToken(parts[1], jwtCfg.Secret)
+			// Validate the JWT token and extract user ID, role, and tenant.
+			userID, role, tenantID, err := validateToken(tokenStr, jwtCfg)
 			if err != nil {
 				response.Fail(w, http.StatusUnauthorized, ErrInvalidToken)
 				return
 			}
 
-			// Add user ID to request context and proceed to next handler.
-			ctx := context.WithValue(r.Context(), UserIDKey, userID)
+			// A token is only valid within the tenant it was issued for.
+			ctx := r.Context()
+			if tenantID != "" {
+				if current, ok := tenancy.FromContext(ctx); ok && current != tenantID {
+					response.Fail(w, http.StatusUnauthorized, ErrInvalidToken)
+					return
+				}
+				ctx = tenancy.WithTenant(ctx, tenantID)
+			}
+
+			// Add user ID and role to request context and proceed to next handler.
+			ctx = context.WithValue(ctx, UserIDKey, userID)
+			ctx = context.WithValue(ctx, RoleKey, role)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
-// validateToken verifies a JWT token and extracts the user ID from its claims.
-// It checks the token's signing method, validity, and expiration, and parses the user ID from the claims.
+// RequireAdmin creates an HTTP middleware that only lets requests from admin users through.
+// It must be used after Auth, which stores the user's role in the request context.
+// Requests from non-admin users receive a forbidden response.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func RequireAdmin() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if role, _ := r.Context().Value(RoleKey).(string); role != model.RoleAdmin {
+				response.Fail(w, http.StatusForbidden, ErrForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken extracts the token of the Bearer Authorization header of a request.
 //
 // Parameters:
-//   - tokenStr: The JWT token string to validate.
-//   - secret: The secret key used to verify the token's signature.
+//   - r: The HTTP request.
 //
 // Returns:
-//   - The user ID (UUID) extracted from the token claims.
-//   - An error if the token is invalid, expired, or contains an invalid user ID.
-func validateToken(tokenStr string, secret string) (uuid.UUID, error) {
+//   - The token.
+//   - ErrNoToken if the header is missing, or ErrInvalidTokenFormat if it is not a Bearer token.
+func bearerToken(r *http.Request) (string, error) {
+	// Extract Authorization header.
+	tokenStr := r.Header.Get("Authorization")
+	if tokenStr == "" {
+		return "", ErrNoToken
+	}
+
+	// Validate Bearer token format.
+	parts := strings.Split(tokenStr, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return "", ErrInvalidTokenFormat
+	}
+
+	return parts[1], nil
+}
+
+// parseToken verifies a JWT token and returns its claims.
+// It checks the token's signing method, validity, expiration and issue time, as well as the issuer and audience
+// if configured.
+//
+// Parameters:
+//   - tokenStr: The JWT token string to verify.
+//   - jwtCfg: The JWT configuration with the secret key used to verify the token's signature and the expected claims.
+//
+// Returns:
+//   - The claims of the token.
+//   - ErrExpiredToken if the token has expired, or an error if it is otherwise invalid.
+func parseToken(tokenStr string, jwtCfg config.JWT) (jwt.MapClaims, error) {
+	opts := []jwt.ParserOption{jwt.WithIssuedAt(), jwt.WithLeeway(jwtCfg.Leeway)}
+	if jwtCfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(jwtCfg.Issuer))
+	}
+	if jwtCfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(jwtCfg.Audience))
+	}
+
 	// Parse the token with the provided secret.
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		// Validate the signing method is HMAC.
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrInvalidToken
 		}
-		return []byte(secret), nil
-	})
+		return []byte(jwtCfg.Secret), nil
+	}, opts...)
 	if err != nil {
 		// Handle expired token specifically.
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return uuid.Nil, ErrExpiredToken
+			return nil, ErrExpiredToken
 		}
-		return uuid.Nil, err
+		return nil, err
 	}
 
 	// Validate token and extract claims.
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return uuid.Nil, ErrInvalidToken
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// validateToken verifies a JWT token and extracts the user ID, role, and tenant from its claims.
+// The token is verified by parseToken; the user ID is parsed from the claims.
+// Tokens without a role claim are treated as regular users; tokens without a tenant claim belong to no tenant.
+//
+// Parameters:
+//   - tokenStr: The JWT token string to validate.
+//   - jwtCfg: The JWT configuration with the secret key used to verify the token's signature and the expected claims.
+//
+// Returns:
+//   - The user ID (UUID) extracted from the token claims.
+//   - The user role extracted from the token claims.
+//   - The tenant ID extracted from the token claims, empty if absent.
+//   - An error if the token is invalid, expired, or contains an invalid user ID.
+func validateToken(tokenStr string, jwtCfg config.JWT) (uuid.UUID, string, string, error) {
+	claims, err := parseToken(tokenStr, jwtCfg)
+	if err != nil {
+		return uuid.Nil, "", "", err
 	}
 
 	// Extract and validate user ID from claims.
 	userIDStr, ok := claims["user_id"].(string)
 	if !ok {
-		return uuid.Nil, ErrInvalidToken
+		return uuid.Nil, "", "", ErrInvalidToken
 	}
 
 	// Parse user ID into UUID.
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		return uuid.Nil, ErrInvalidToken
+		return uuid.Nil, "", "", ErrInvalidToken
 	}
 
-	return userID, nil
+	// Extract role, defaulting to a regular user.
+	role, ok := claims["role"].(string)
+	if !ok || role == "" {
+		role = model.RoleUser
+	}
+
+	tenantID, _ := claims["tenant"].(string)
+
+	return userID, role, tenantID, nil
 }
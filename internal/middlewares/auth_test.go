@@ -0,0 +1,79 @@
+package middlewares
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func signToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestValidateToken_IssuerAndAudience(t *testing.T) {
+	cfg := config.JWT{Secret: "secret", Issuer: "calendar-service", Audience: "calendar-api"}
+	userID := uuid.New()
+	now := time.Now()
+
+	claims := func(iss, aud string) jwt.MapClaims {
+		c := jwt.MapClaims{"user_id": userID.String(), "exp": now.Add(time.Hour).Unix(), "iat": now.Unix()}
+		if iss != "" {
+			c["iss"] = iss
+		}
+		if aud != "" {
+			c["aud"] = aud
+		}
+		return c
+	}
+
+	got, _, _, err := validateToken(signToken(t, claims("calendar-service", "calendar-api")), cfg)
+	if err != nil || got != userID {
+		t.Fatalf("expected a valid token, got %v, %v", got, err)
+	}
+
+	for name, c := range map[string]jwt.MapClaims{
+		"other issuer":     claims("staging", "calendar-api"),
+		"other audience":   claims("calendar-service", "billing-api"),
+		"missing issuer":   claims("", "calendar-api"),
+		"missing audience": claims("calendar-service", ""),
+	} {
+		if _, _, _, err := validateToken(signToken(t, c), cfg); err == nil {
+			t.Fatalf("%s: expected the token to be rejected", name)
+		}
+	}
+
+	// Without configured values the claims are not checked.
+	if _, _, _, err := validateToken(signToken(t, claims("", "")), config.JWT{Secret: "secret"}); err != nil {
+		t.Fatalf("expected a valid token without issuer and audience, got %v", err)
+	}
+}
+
+func TestValidateToken_Leeway(t *testing.T) {
+	now := time.Now()
+	expired := signToken(t, jwt.MapClaims{"user_id": uuid.NewString(), "exp": now.Add(-10 * time.Second).Unix(), "iat": now.Add(-time.Hour).Unix()})
+	future := signToken(t, jwt.MapClaims{"user_id": uuid.NewString(), "exp": now.Add(time.Hour).Unix(), "iat": now.Add(10 * time.Second).Unix()})
+
+	if _, _, _, err := validateToken(expired, config.JWT{Secret: "secret"}); !errors.Is(err, ErrExpiredToken) {
+		t.Fatalf("expected ErrExpiredToken without leeway, got %v", err)
+	}
+	if _, _, _, err := validateToken(future, config.JWT{Secret: "secret"}); err == nil {
+		t.Fatal("expected a token issued in the future to be rejected without leeway")
+	}
+
+	cfg := config.JWT{Secret: "secret", Leeway: 30 * time.Second}
+	for _, token := range []string{expired, future} {
+		if _, _, _, err := validateToken(token, cfg); err != nil {
+			t.Fatalf("expected the clock skew to be tolerated, got %v", err)
+		}
+	}
+}
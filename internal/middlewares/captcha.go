@@ -0,0 +1,104 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/captcha"
+)
+
+// captchaTracker defines the tracking of failed attempts per client IP.
+type captchaTracker interface {
+	Required(ip string) bool
+	Fail(ip string)
+	Reset(ip string)
+}
+
+// captchaVerifier defines the verification of solved CAPTCHA tokens.
+type captchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// Captcha creates an HTTP middleware that protects authentication endpoints against brute force.
+// Failed attempts (4xx responses) are counted per client IP; once a client reaches the threshold,
+// its requests must carry a solved CAPTCHA token in the given header. A successful attempt resets the count.
+// It must be used after the RealIP middleware.
+//
+// Parameters:
+//   - t: The tracker of failed attempts.
+//   - v: The CAPTCHA verifier.
+//   - header: The name of the request header carrying the CAPTCHA token.
+//   - l: The logger for verification errors.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Captcha(t captchaTracker, v captchaVerifier, header string, l *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := clientIP(r)
+
+			if t.Required(ip) {
+				token := r.Header.Get(header)
+				if token == "" {
+					response.Fail(w, http.StatusForbidden, captcha.ErrRequired)
+					return
+				}
+
+				if err := v.Verify(r.Context(), token, ip); err != nil {
+					if errors.Is(err, captcha.ErrInvalid) {
+						t.Fail(ip)
+						response.Fail(w, http.StatusForbidden, err)
+						return
+					}
+
+					l.Error("failed to verify captcha", zap.Error(err))
+					response.Fail(w, http.StatusServiceUnavailable, fmt.Errorf("captcha verification unavailable"))
+					return
+				}
+			}
+
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			switch {
+			case rec.status >= 200 && rec.status < 300:
+				t.Reset(ip)
+			case rec.status >= 400 && rec.status < 500:
+				t.Fail(ip)
+			}
+		})
+	}
+}
+
+// statusRecorder is an http.ResponseWriter that captures the status code.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// Unwrap returns the underlying response writer, so that http.ResponseController can
+// flush streaming responses and set per-request deadlines through the recorder.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// WriteHeader records the status code and forwards it.
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// clientIP returns the IP address of the client, without the port.
+func clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return ip
+}
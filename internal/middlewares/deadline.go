@@ -0,0 +1,181 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+)
+
+const (
+	DeadlineHeader    = "X-Request-Deadline" // time a client gives a request, as a Go duration or in milliseconds
+	GRPCTimeoutHeader = "Grpc-Timeout"       // the same in the gRPC format, e.g. 500m for 500 milliseconds
+)
+
+// grpcTimeoutUnits maps the unit suffixes of the gRPC timeout format to their durations.
+var grpcTimeoutUnits = map[byte]time.Duration{
+	'H': time.Hour,
+	'M': time.Minute,
+	'S': time.Second,
+	'm': time.Millisecond,
+	'u': time.Microsecond,
+	'n': time.Nanosecond,
+}
+
+// Deadline creates an HTTP middleware that honors the deadline a client gives a request in the
+// X-Request-Deadline or Grpc-Timeout header. The request context gets a deadline of that length, so
+// repository queries are cancelled once it passes; deadlines longer than limit are cut to limit.
+//
+// Requests that exceed their deadline receive 504 Gateway Timeout with a TimeoutError instead of the
+// error the handler responded with. Invalid headers are rejected with 400 Bad Request.
+//
+// Parameters:
+//   - limit: The longest deadline clients can ask for, usually the global request timeout.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Deadline(limit time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout, ok, err := requestTimeout(r)
+			if err != nil {
+				response.Fail(w, http.StatusBadRequest, err)
+				return
+			}
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			timeout = min(timeout, limit)
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			dw := &deadlineWriter{ResponseWriter: w, ctx: ctx, timeout: timeout}
+			next.ServeHTTP(dw, r.WithContext(ctx))
+
+			if !dw.wroteHeader && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				dw.fail()
+			}
+		})
+	}
+}
+
+// requestTimeout reads the deadline of a request from its headers; X-Request-Deadline takes precedence.
+// It reports false if the request has none.
+func requestTimeout(r *http.Request) (time.Duration, bool, error) {
+	if v := r.Header.Get(DeadlineHeader); v != "" {
+		d, err := parseDeadline(v)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %s header: %w", DeadlineHeader, err)
+		}
+		return d, true, nil
+	}
+
+	if v := r.Header.Get(GRPCTimeoutHeader); v != "" {
+		d, err := parseGRPCTimeout(v)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %s header: %w", GRPCTimeoutHeader, err)
+		}
+		return d, true, nil
+	}
+
+	return 0, false, nil
+}
+
+// parseDeadline parses a deadline given as a Go duration such as 1.5s or 250ms, or as a number of milliseconds.
+func parseDeadline(v string) (time.Duration, error) {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		ms, convErr := strconv.ParseInt(v, 10, 64)
+		if convErr != nil {
+			return 0, errors.New("must be a duration such as 250ms or a number of milliseconds")
+		}
+		d = time.Duration(ms) * time.Millisecond
+	}
+	if d <= 0 {
+		return 0, errors.New("must be positive")
+	}
+
+	return d, nil
+}
+
+// parseGRPCTimeout parses a timeout in the gRPC format: at most 8 digits followed by one of the units
+// H, M, S, m, u or n.
+func parseGRPCTimeout(v string) (time.Duration, error) {
+	if len(v) < 2 || len(v) > 9 {
+		return 0, errors.New("must be at most 8 digits followed by a unit")
+	}
+
+	unit, ok := grpcTimeoutUnits[v[len(v)-1]]
+	digits := v[:len(v)-1]
+	if !ok || strings.TrimLeft(digits, "0123456789") != "" {
+		return 0, errors.New("must be at most 8 digits followed by a unit")
+	}
+
+	n, _ := strconv.ParseInt(digits, 10, 64)
+	if n == 0 {
+		return 0, errors.New("must be positive")
+	}
+
+	return time.Duration(n) * unit, nil
+}
+
+// deadlineWriter replaces the error response of a request that exceeded its deadline with a TimeoutError.
+// Handlers see the cancelled queries as internal errors; the client should learn that it ran out of time.
+type deadlineWriter struct {
+	http.ResponseWriter
+	ctx         context.Context // context carrying the deadline of the request
+	timeout     time.Duration   // time the request was given
+	wroteHeader bool            // whether the status has been written
+	timedOut    bool            // whether the timeout error was written instead of the handler's response
+}
+
+// WriteHeader writes the status of the handler's response, or the timeout error if the handler failed
+// after the deadline passed.
+func (w *deadlineWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	if status >= http.StatusInternalServerError && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		w.fail()
+		return
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write writes the body of the handler's response; it is discarded once the timeout error was written.
+func (w *deadlineWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.timedOut {
+		return len(p), nil
+	}
+
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the underlying response writer, for http.ResponseController.
+func (w *deadlineWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// fail writes the timeout error to the underlying response writer.
+func (w *deadlineWriter) fail() {
+	w.wroteHeader = true
+	response.JSON(w.ResponseWriter, http.StatusGatewayTimeout, response.TimeoutError{
+		Message:   "request deadline exceeded",
+		Code:      "deadline_exceeded",
+		TimeoutMS: w.timeout.Milliseconds(),
+	})
+}
@@ -0,0 +1,97 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+)
+
+func TestDeadline(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		want   time.Duration // deadline of the request context; 0 if none
+		status int
+	}{
+		{"no header", "", "", 0, http.StatusOK},
+		{"duration", DeadlineHeader, "250ms", 250 * time.Millisecond, http.StatusOK},
+		{"milliseconds", DeadlineHeader, "1500", 1500 * time.Millisecond, http.StatusOK},
+		{"grpc timeout", GRPCTimeoutHeader, "2S", 2 * time.Second, http.StatusOK},
+		{"capped", DeadlineHeader, "1m", 15 * time.Second, http.StatusOK},
+		{"invalid", DeadlineHeader, "soon", 0, http.StatusBadRequest},
+		{"negative", DeadlineHeader, "-1s", 0, http.StatusBadRequest},
+		{"invalid grpc unit", GRPCTimeoutHeader, "5x", 0, http.StatusBadRequest},
+		{"grpc too many digits", GRPCTimeoutHeader, "123456789m", 0, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var remaining time.Duration
+			h := Deadline(15 * time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if deadline, ok := r.Context().Deadline(); ok {
+					remaining = time.Until(deadline)
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/events/day", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, w.Code)
+			}
+			if remaining > tt.want || remaining < tt.want-time.Second {
+				t.Fatalf("expected a deadline of %s, got %s", tt.want, remaining)
+			}
+		})
+	}
+}
+
+func TestDeadline_Exceeded(t *testing.T) {
+	h := Deadline(15 * time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A query cancelled by the deadline surfaces as an internal error in the handler.
+		<-r.Context().Done()
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/day", nil)
+	req.Header.Set(DeadlineHeader, "10ms")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected status %d, got %d", http.StatusGatewayTimeout, w.Code)
+	}
+
+	var body response.TimeoutError
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected a JSON body, got %q", w.Body.String())
+	}
+	if body.Code != "deadline_exceeded" || body.TimeoutMS != 10 {
+		t.Fatalf("unexpected timeout error %+v", body)
+	}
+}
+
+func TestDeadline_HandlerErrorsBeforeDeadline(t *testing.T) {
+	h := Deadline(15 * time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response.Fail(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/day", nil)
+	req.Header.Set(DeadlineHeader, "5s")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
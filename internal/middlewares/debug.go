@@ -0,0 +1,265 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+)
+
+const (
+	// maxDebugBody is the maximum length of a redacted body written to debug logs.
+	maxDebugBody = 4 << 10
+
+	// maxDebugCapture is the maximum number of body bytes buffered for redaction.
+	// Larger bodies are logged by length and content type only.
+	maxDebugCapture = 1 << 20
+)
+
+// errDebugBodyTooLarge is returned for request bodies above maxDebugCapture
+// that cannot be buffered while debug logging matches the request.
+var errDebugBodyTooLarge = errors.New("request body too large")
+
+// redactedKeys lists JSON keys whose values are never written to debug logs.
+var redactedKeys = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"secret":        true,
+	"authorization": true,
+	"api_key":       true,
+}
+
+// DebugSettings describes which requests are logged in debug mode.
+// When enabled, a request is logged if it matches any route prefix or user ID;
+// with no routes and no users configured, all requests are logged.
+type DebugSettings struct {
+	Enabled bool        `json:"enabled"`  // whether debug logging is on
+	Routes  []string    `json:"routes"`   // URL path prefixes to log, e.g. /api/events
+	UserIDs []uuid.UUID `json:"user_ids"` // users whose requests are logged
+}
+
+// DebugLog holds the runtime-toggleable debug logging settings and the logger debug entries are written to.
+// It is safe for concurrent use.
+type DebugLog struct {
+	mu       sync.RWMutex
+	settings DebugSettings
+	logger   *zap.Logger
+}
+
+// NewDebugLog creates a new DebugLog with debug logging disabled.
+func NewDebugLog(l *zap.Logger) *DebugLog {
+	return &DebugLog{logger: l}
+}
+
+// Settings returns the current debug logging settings.
+func (d *DebugLog) Settings() DebugSettings {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.settings
+}
+
+// Set replaces the debug logging settings.
+func (d *DebugLog) Set(settings DebugSettings) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.settings = settings
+}
+
+// matches reports whether the request should be logged under the current settings.
+func (d *DebugLog) matches(r *http.Request) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if !d.settings.Enabled {
+		return false
+	}
+	if len(d.settings.Routes) == 0 && len(d.settings.UserIDs) == 0 {
+		return true
+	}
+
+	for _, prefix := range d.settings.Routes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+
+	userID, _ := r.Context().Value(UserIDKey).(uuid.UUID)
+	for _, id := range d.settings.UserIDs {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Debug returns a middleware that logs sanitized request and response bodies
+// for requests matching the debug settings. Sensitive JSON fields are redacted;
+// bodies that are not complete JSON are logged by length and content type only.
+// Request bodies declared larger than maxDebugCapture are passed through without buffering,
+// and chunked bodies exceeding it are rejected with 413.
+// To match by user, it must be used after Auth.
+//
+// Parameters:
+//   - d: The runtime debug logging settings.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Debug(d *DebugLog) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !d.matches(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Read the request body and restore it for the next handler.
+			// Bodies too large to buffer are passed on unread and logged by length only.
+			var reqBody []byte
+			reqComplete := r.ContentLength <= maxDebugCapture
+			if r.Body != nil && reqComplete {
+				var err error
+				reqBody, err = io.ReadAll(http.MaxBytesReader(w, r.Body, maxDebugCapture))
+				if err != nil {
+					var maxErr *http.MaxBytesError
+					if errors.As(err, &maxErr) {
+						response.Fail(w, http.StatusRequestEntityTooLarge, errDebugBodyTooLarge)
+						return
+					}
+
+					response.Fail(w, http.StatusBadRequest, fmt.Errorf("invalid request body"))
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(reqBody))
+			}
+			reqLen := int64(len(reqBody))
+			if !reqComplete {
+				reqLen = r.ContentLength
+			}
+
+			rec := &bodyRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			userID, _ := r.Context().Value(UserIDKey).(uuid.UUID)
+			d.logger.Info("debug request",
+				zap.String("method", r.Method),
+				zap.String("url", r.URL.String()),
+				zap.String("user_id", userID.String()),
+				zap.String("request_body", sanitizeBody(reqBody, reqLen, reqComplete, r.Header.Get("Content-Type"))),
+				zap.Int("status", rec.status),
+				zap.String("response_body", sanitizeBody(rec.body.Bytes(), rec.size, !rec.truncated, rec.Header().Get("Content-Type"))),
+			)
+		})
+	}
+}
+
+// bodyRecorder is an http.ResponseWriter that captures the status code and the
+// response body up to maxDebugCapture bytes. Longer bodies are marked truncated
+// and only their size is kept.
+type bodyRecorder struct {
+	http.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	size      int64
+	truncated bool
+}
+
+// Unwrap returns the underlying response writer, so that http.ResponseController can
+// flush streaming responses and set per-request deadlines through the recorder.
+func (b *bodyRecorder) Unwrap() http.ResponseWriter {
+	return b.ResponseWriter
+}
+
+// WriteHeader records the status code and forwards it.
+func (b *bodyRecorder) WriteHeader(status int) {
+	b.status = status
+	b.ResponseWriter.WriteHeader(status)
+}
+
+// Write captures the body up to maxDebugCapture bytes and forwards it.
+// Once the body outgrows the limit, the captured bytes are dropped.
+func (b *bodyRecorder) Write(p []byte) (int, error) {
+	b.size += int64(len(p))
+	if !b.truncated {
+		if b.size > maxDebugCapture {
+			b.truncated = true
+			b.body = bytes.Buffer{}
+		} else {
+			b.body.Write(p)
+		}
+	}
+	return b.ResponseWriter.Write(p)
+}
+
+// sanitizeBody returns a loggable representation of a body.
+// A complete JSON body is redacted first and then truncated to maxDebugBody bytes.
+// Any other body, including one that was not captured in full, is described by
+// its length and content type, so raw bytes never reach the logs.
+//
+// Parameters:
+//   - body: The captured body bytes.
+//   - size: The full length of the body in bytes.
+//   - complete: Whether body holds the whole body.
+//   - contentType: The Content-Type of the body.
+//
+// Returns:
+//   - The string to write to the debug log.
+func sanitizeBody(body []byte, size int64, complete bool, contentType string) string {
+	if size == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if !complete || json.Unmarshal(body, &v) != nil {
+		return omittedBody(size, contentType)
+	}
+
+	data, err := json.Marshal(redact(v))
+	if err != nil {
+		return omittedBody(size, contentType)
+	}
+	if len(data) > maxDebugBody {
+		data = data[:maxDebugBody]
+	}
+
+	return string(data)
+}
+
+// omittedBody describes a body that is not logged.
+func omittedBody(size int64, contentType string) string {
+	if contentType == "" {
+		contentType = "unknown content type"
+	}
+	return fmt.Sprintf("[omitted: %d bytes, %s]", size, contentType)
+}
+
+// redact recursively replaces the values of sensitive keys in decoded JSON.
+func redact(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range val {
+			if redactedKeys[strings.ToLower(k)] {
+				val[k] = "[REDACTED]"
+				continue
+			}
+			val[k] = redact(inner)
+		}
+	case []interface{}:
+		for i, inner := range val {
+			val[i] = redact(inner)
+		}
+	}
+
+	return v
+}
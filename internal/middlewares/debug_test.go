@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDebug_RedactsJSON(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	d := NewDebugLog(zap.New(core))
+	d.Set(DebugSettings{Enabled: true})
+
+	h := Debug(d)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"token":"resp-secret","ok":true}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/user/login", strings.NewReader(`{"email":"a@b.c","password":"hunter2"}`))
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, `{"email":"a@b.c","password":"[REDACTED]"}`, fields["request_body"])
+	assert.Equal(t, `{"ok":true,"token":"[REDACTED]"}`, fields["response_body"])
+}
+
+func TestDebug_OmitsNonJSONAndTruncatedBodies(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	d := NewDebugLog(zap.New(core))
+	d.Set(DebugSettings{Enabled: true})
+
+	// A JSON response larger than the capture limit is no longer valid once cut off.
+	large := `{"password":"hunter2","pad":"` + strings.Repeat("x", maxDebugCapture) + `"}`
+	h := Debug(d)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(large[:10]))
+		_, _ = w.Write([]byte(large[10:]))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events", strings.NewReader("password=hunter2"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, len(large), rec.Body.Len())
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "[omitted: 16 bytes, application/x-www-form-urlencoded]", fields["request_body"])
+	assert.Equal(t, fmt.Sprintf("[omitted: %d bytes, application/json]", len(large)), fields["response_body"])
+	assert.NotContains(t, fields["request_body"], "hunter2")
+	assert.NotContains(t, fields["response_body"], "hunter2")
+}
+
+func TestDebug_RejectsOversizedChunkedBody(t *testing.T) {
+	core, _ := observer.New(zapcore.InfoLevel)
+	d := NewDebugLog(zap.New(core))
+	d.Set(DebugSettings{Enabled: true})
+
+	called := false
+	h := Debug(d)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events", strings.NewReader(strings.Repeat("x", maxDebugCapture+1)))
+	req.ContentLength = -1
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
@@ -0,0 +1,31 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+)
+
+var ErrDemoMode = errors.New("disabled in demo mode")
+
+// DisabledInDemo creates an HTTP middleware that rejects requests with 403 Forbidden while the service runs
+// in demo mode. It guards operations that affect the whole instance rather than the caller's own data,
+// so that visitors of a public demo cannot take it down for everyone else.
+//
+// Parameters:
+//   - demo: Whether the service runs in demo mode; the middleware is a no-op otherwise.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func DisabledInDemo(demo bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !demo {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			response.Fail(w, http.StatusForbidden, ErrDemoMode)
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package middlewares
 
 import (
+	"context"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/metrics"
 )
 
 // LogEntry defines a single log record for async logging.
+// URL is the route pattern of the request, e.g. /feeds/{file}, so tokens and codes in paths are never logged.
 type LogEntry struct {
 	Method   string
 	URL      string
@@ -15,39 +23,179 @@ type LogEntry struct {
 	Time     time.Time
 }
 
-// StartAsyncLogger starts a background goroutine that reads from logCh
-// and writes to the provided zap.Logger.
-func StartAsyncLogger(logCh <-chan LogEntry, logger *zap.Logger) {
-	go func() {
-		for entry := range logCh {
-			logger.Info("request",
-				zap.String("method", entry.Method),
-				zap.String("url", entry.URL),
-				zap.Duration("duration", entry.Duration),
-				zap.Time("time", entry.Time),
-			)
+// AsyncLogger writes request log entries in batches from a background goroutine,
+// so that handlers never block on log output.
+// Entries are dropped, and counted, when the buffer is full.
+type AsyncLogger struct {
+	logger        *zap.Logger   // logger entries are written to
+	entries       chan LogEntry // buffered entries waiting to be written
+	batchSize     int           // maximum entries written per batch
+	flushInterval time.Duration // maximum time an entry waits for its batch to fill
+	dropped       atomic.Int64  // entries dropped by this logger
+	mu            sync.RWMutex  // guards closed against concurrent Log calls
+	closed        bool          // whether Close was called; later entries are dropped
+	done          chan struct{} // closed once all entries are written
+}
+
+// NewAsyncLogger creates an AsyncLogger and starts its background goroutine.
+// Non-positive sizes fall back to a buffer of 1024 and batches of 64 entries,
+// and a non-positive flush interval to one second.
+//
+// Parameters:
+//   - cfg: The buffer size, batch size, and flush interval.
+//   - logger: The logger entries are written to.
+//
+// Returns:
+//   - The started AsyncLogger; call Close to flush it.
+func NewAsyncLogger(cfg config.AsyncLog, logger *zap.Logger) *AsyncLogger {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 1024
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 64
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+
+	l := &AsyncLogger{
+		logger:        logger,
+		entries:       make(chan LogEntry, cfg.BufferSize),
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		done:          make(chan struct{}),
+	}
+	go l.run()
+
+	return l
+}
+
+// Log queues an entry without blocking.
+//
+// Parameters:
+//   - entry: The entry to write.
+//
+// Returns:
+//   - false if the entry was dropped because the buffer is full or the logger is closed.
+func (l *AsyncLogger) Log(entry LogEntry) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if !l.closed {
+		select {
+		case l.entries <- entry:
+			return true
+		default:
 		}
-	}()
+	}
+
+	l.dropped.Add(1)
+	metrics.LogEntriesDropped.Add(1)
+	return false
+}
+
+// Dropped returns the number of entries dropped so far.
+func (l *AsyncLogger) Dropped() int64 {
+	return l.dropped.Load()
+}
+
+// Close stops accepting entries and waits until the buffered ones are written.
+// It is safe to call more than once.
+//
+// Parameters:
+//   - ctx: The context bounding the wait.
+//
+// Returns:
+//   - An error if ctx is done before all entries are written.
+func (l *AsyncLogger) Close(ctx context.Context) error {
+	l.mu.Lock()
+	if !l.closed {
+		l.closed = true
+		close(l.entries)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-// Logger returns a middleware that sends log entries to logCh asynchronously.
-func Logger(logCh chan<- LogEntry) func(handler http.Handler) http.Handler {
+// run collects entries into batches and writes a batch once it is full,
+// the flush interval has passed, or the logger is closed.
+func (l *AsyncLogger) run() {
+	defer close(l.done)
+
+	batch := make([]LogEntry, 0, l.batchSize)
+	ticker := time.NewTicker(l.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case entry, ok := <-l.entries:
+			if !ok {
+				l.write(batch)
+				return
+			}
+			if batch = append(batch, entry); len(batch) == l.batchSize {
+				batch = l.write(batch)
+			}
+		case <-ticker.C:
+			batch = l.write(batch)
+		}
+	}
+}
+
+// write logs the batch, syncs the logger, and returns the emptied batch for reuse.
+func (l *AsyncLogger) write(batch []LogEntry) []LogEntry {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	for _, entry := range batch {
+		l.logger.Info("request",
+			zap.String("method", entry.Method),
+			zap.String("url", entry.URL),
+			zap.Duration("duration", entry.Duration),
+			zap.Time("time", entry.Time),
+		)
+	}
+	_ = l.logger.Sync()
+	metrics.LogEntriesWritten.Add(int64(len(batch)))
+
+	return batch[:0]
+}
+
+// Logger returns a middleware that sends log entries to the async logger.
+// Entries are dropped instead of blocking the request when the logger is full.
+// Requests are logged with their route pattern rather than their URL, since feed, embed and unsubscribe tokens
+// and short-link codes are bearer secrets carried in paths and query strings.
+func Logger(l *AsyncLogger) func(handler http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 			next.ServeHTTP(w, r)
 
-			// Send log entry to the channel.
-			select {
-			case logCh <- LogEntry{
+			l.Log(LogEntry{
 				Method:   r.Method,
-				URL:      r.URL.String(),
+				URL:      loggedURL(r),
 				Duration: time.Since(start),
 				Time:     start,
-			}:
-			default:
-				// If the channel is full, we drop the log to avoid blocking.
-			}
+			})
 		})
 	}
 }
+
+// loggedURL returns the route pattern the request matched, which is known once it has been served.
+// Requests matching no route are logged with their path only, without the query string.
+func loggedURL(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+
+	return r.URL.Path
+}
@@ -0,0 +1,88 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestAsyncLogger_CloseDrains(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	l := NewAsyncLogger(config.AsyncLog{BufferSize: 100, BatchSize: 8, FlushInterval: time.Hour}, zap.New(core))
+
+	for i := 0; i < 50; i++ {
+		require.True(t, l.Log(LogEntry{Method: "GET", URL: "/api/events/day"}))
+	}
+
+	require.NoError(t, l.Close(context.Background()))
+	assert.Equal(t, 50, logs.Len())
+	assert.Zero(t, l.Dropped())
+
+	// Entries logged after Close are dropped instead of panicking on the closed buffer.
+	assert.False(t, l.Log(LogEntry{Method: "GET"}))
+	assert.EqualValues(t, 1, l.Dropped())
+	assert.NoError(t, l.Close(context.Background()))
+}
+
+func TestAsyncLogger_DropsWhenFull(t *testing.T) {
+	l := &AsyncLogger{entries: make(chan LogEntry, 2), done: make(chan struct{})}
+
+	assert.True(t, l.Log(LogEntry{}))
+	assert.True(t, l.Log(LogEntry{}))
+	assert.False(t, l.Log(LogEntry{}))
+	assert.EqualValues(t, 1, l.Dropped())
+}
+
+func TestAsyncLogger_FlushInterval(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	l := NewAsyncLogger(config.AsyncLog{BufferSize: 10, BatchSize: 10, FlushInterval: 10 * time.Millisecond}, zap.New(core))
+	defer l.Close(context.Background())
+
+	l.Log(LogEntry{Method: "GET"})
+
+	assert.Eventually(t, func() bool { return logs.Len() == 1 }, time.Second, 5*time.Millisecond)
+}
+
+func TestLogger_LogsRoutePattern(t *testing.T) {
+	// Tokens and codes in paths and query strings are secrets, so only route patterns are logged.
+	tests := []struct {
+		route  string
+		target string
+		want   string
+	}{
+		{route: "/feeds/{file}", target: "/feeds/acme.s3cr3t.ics", want: "/feeds/{file}"},
+		{route: "/unsubscribe", target: "/unsubscribe?token=s3cr3t", want: "/unsubscribe"},
+		{route: "/embed/{token}", target: "/embed/acme.s3cr3t?view=month", want: "/embed/{token}"},
+		{route: "/e/{code}", target: "/e/Xk3p9Qa", want: "/e/{code}"},
+		// Unknown routes are logged by path, without the query string.
+		{route: "/known", target: "/missing/s3cr3t?token=s3cr3t", want: "/missing/s3cr3t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			core, logs := observer.New(zapcore.InfoLevel)
+			l := NewAsyncLogger(config.AsyncLog{FlushInterval: time.Hour}, zap.New(core))
+
+			r := chi.NewRouter()
+			r.Use(Logger(l))
+			r.Get(tt.route, func(w http.ResponseWriter, r *http.Request) {})
+
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+			require.NoError(t, l.Close(context.Background()))
+
+			require.Equal(t, 1, logs.Len())
+			assert.Equal(t, tt.want, logs.All()[0].ContextMap()["url"])
+		})
+	}
+}
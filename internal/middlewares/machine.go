@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// ClientIDKey is the key used to store and retrieve the authenticated machine client's ID from the request context.
+const ClientIDKey contextKey = "client_id"
+
+// MachineAuth creates an HTTP middleware that authenticates internal services by their machine tokens.
+// It accepts only tokens issued to machine clients, never the tokens of users, and requires the token
+// to carry the given scope. The ID of the client is stored in the request context.
+// Missing or invalid tokens receive an unauthorized response, tokens without the scope a forbidden one.
+//
+// Parameters:
+//   - jwtCfg: The JWT configuration containing the secret key, issuer, audience and leeway for token validation.
+//   - scope: The scope the token must carry, e.g. model.ScopeEventsRead.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func MachineAuth(jwtCfg config.JWT, scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenStr, err := bearerToken(r)
+			if err != nil {
+				response.Fail(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			clientID, scopes, err := validateMachineToken(tokenStr, jwtCfg)
+			if err != nil {
+				response.Fail(w, http.StatusUnauthorized, ErrInvalidToken)
+				return
+			}
+
+			if !slices.Contains(scopes, scope) {
+				response.Fail(w, http.StatusForbidden, ErrForbidden)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), ClientIDKey, clientID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// validateMachineToken verifies a machine token and extracts the client ID and scopes from its claims.
+// The token is verified by parseToken and must be marked as a machine token.
+//
+// Parameters:
+//   - tokenStr: The JWT token string to validate.
+//   - jwtCfg: The JWT configuration with the secret key used to verify the token's signature and the expected claims.
+//
+// Returns:
+//   - The client ID extracted from the token claims.
+//   - The scopes of the token.
+//   - An error if the token is invalid, expired, or not a machine token.
+func validateMachineToken(tokenStr string, jwtCfg config.JWT) (string, []string, error) {
+	claims, err := parseToken(tokenStr, jwtCfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if use, _ := claims["token_use"].(string); use != model.TokenUseMachine {
+		return "", nil, ErrInvalidToken
+	}
+
+	clientID, _ := claims["client_id"].(string)
+	if clientID == "" {
+		return "", nil, ErrInvalidToken
+	}
+
+	scope, _ := claims["scope"].(string)
+
+	return clientID, strings.Fields(scope), nil
+}
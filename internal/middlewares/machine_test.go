@@ -0,0 +1,88 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestMachineAuth(t *testing.T) {
+	cfg := config.JWT{Secret: "secret"}
+	now := time.Now()
+
+	machineToken := func(scope string) string {
+		return signToken(t, jwt.MapClaims{
+			"client_id": "notifier",
+			"token_use": model.TokenUseMachine,
+			"scope":     scope,
+			"exp":       now.Add(time.Hour).Unix(),
+			"iat":       now.Unix(),
+		})
+	}
+	userToken := signToken(t, jwt.MapClaims{"user_id": uuid.New().String(), "exp": now.Add(time.Hour).Unix(), "iat": now.Unix()})
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{"scope granted", machineToken(model.ScopeRemindersDispatch + " " + model.ScopeEventsRead), http.StatusOK},
+		{"scope missing", machineToken(model.ScopeRemindersDispatch), http.StatusForbidden},
+		{"user token", userToken, http.StatusUnauthorized},
+		{"no token", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clientID string
+			h := MachineAuth(cfg, model.ScopeEventsRead)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				clientID, _ = r.Context().Value(ClientIDKey).(string)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/internal/users/1/events", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+			if tt.want == http.StatusOK && clientID != "notifier" {
+				t.Fatalf("expected client notifier in context, got %q", clientID)
+			}
+		})
+	}
+}
+
+func TestAuth_RejectsMachineToken(t *testing.T) {
+	now := time.Now()
+	token := signToken(t, jwt.MapClaims{
+		"client_id": "notifier",
+		"token_use": model.TokenUseMachine,
+		"scope":     model.ScopeEventsRead,
+		"exp":       now.Add(time.Hour).Unix(),
+		"iat":       now.Unix(),
+	})
+
+	h := Auth(config.JWT{Secret: "secret"})(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Fatal("expected the machine token to be rejected")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/events/day", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/maintenance"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var ErrMaintenance = errors.New("service is under maintenance")
+
+// Maintenance creates an HTTP middleware that rejects requests while maintenance mode is on.
+// Requests receive 503 Service Unavailable with a Retry-After header; requests from admins
+// (as set by Auth in the request context) are let through so operators can keep working
+// and switch maintenance mode off.
+//
+// Parameters:
+//   - m: The maintenance mode of the service.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Maintenance(m *maintenance.Mode) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			settings := m.Settings()
+			if !settings.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if role, _ := r.Context().Value(RoleKey).(string); role == model.RoleAdmin {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err := ErrMaintenance
+			if settings.Message != "" {
+				err = errors.New(settings.Message)
+			}
+
+			w.Header().Set("Retry-After", strconv.Itoa(int(settings.RetryAfter.Seconds())))
+			response.Fail(w, http.StatusServiceUnavailable, err)
+		})
+	}
+}
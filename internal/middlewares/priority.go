@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/priority"
+)
+
+var ErrOverCapacity = errors.New("server is over capacity, retry later")
+
+// Priority creates an HTTP middleware that limits the concurrent requests of each class,
+// so bulk imports and exports cannot starve interactive requests under load.
+// Requests without a free slot receive 503 Service Unavailable with a Retry-After header.
+//
+// Parameters:
+//   - l: The limiter of the request classes.
+//   - retryAfter: The delay clients are asked to wait before retrying.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Priority(l *priority.Limiter, retryAfter time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			release, ok := l.Acquire(r.Context(), priority.Classify(r))
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+				response.Fail(w, http.StatusServiceUnavailable, ErrOverCapacity)
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
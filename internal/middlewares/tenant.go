@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// tenantRegistry defines the tenant lookups needed by the tenant middleware.
+type tenantRegistry interface {
+	Enabled() bool
+	Has(tenantID string) bool
+}
+
+// Tenant creates an HTTP middleware that resolves the tenant of a request from the tenant header
+// and stores it in the request context, where the tenant-aware pool picks it up.
+// Requests with a bearer token may omit the header: Auth binds them to the tenant in their token.
+// With tenancy disabled the middleware is a no-op.
+//
+// Parameters:
+//   - t: The tenant registry.
+//   - header: The name of the request header carrying the tenant ID.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Tenant(t tenantRegistry, header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !t.Enabled() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tenantID := r.Header.Get(header)
+			if tenantID == "" {
+				if r.Header.Get("Authorization") != "" {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				response.Fail(w, http.StatusBadRequest, tenancy.ErrNoTenant)
+				return
+			}
+
+			if !t.Has(tenantID) {
+				response.Fail(w, http.StatusBadRequest, tenancy.ErrUnknownTenant)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(tenancy.WithTenant(r.Context(), tenantID)))
+		})
+	}
+}
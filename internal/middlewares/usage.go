@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/api/response"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var ErrQuotaExceeded = errors.New("monthly API quota exceeded")
+
+// usageRecorder counts API calls of a user.
+type usageRecorder interface {
+	// Record counts one API call of a user and returns the usage in the current period.
+	Record(ctx context.Context, userID uuid.UUID) (model.Usage, error)
+}
+
+// Usage creates an HTTP middleware that meters API calls per user and enforces the monthly quota.
+// It must be used after Auth. Every request is counted; once the quota is exceeded, requests
+// receive 429 Too Many Requests with a Retry-After header until the next period.
+// If usage cannot be recorded, the request is let through and the error is logged.
+//
+// Parameters:
+//   - u: The usage recorder.
+//   - l: The logger for metering failures.
+//
+// Returns:
+//   - An HTTP middleware handler that wraps the next handler in the chain.
+func Usage(u usageRecorder, l *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := r.Context().Value(UserIDKey).(uuid.UUID)
+			if !ok || userID == uuid.Nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			usage, err := u.Record(r.Context(), userID)
+			if err != nil {
+				l.Error("failed to record api usage", zap.String("user_id", userID.String()), zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if usage.Limit > 0 {
+				w.Header().Set("X-Quota-Limit", strconv.FormatInt(usage.Limit, 10))
+				w.Header().Set("X-Quota-Remaining", strconv.FormatInt(usage.Remaining(), 10))
+				w.Header().Set("X-Quota-Reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+			}
+
+			if usage.Exceeded() {
+				retryAfter := int64(time.Until(usage.ResetsAt).Seconds()) + 1
+				w.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+				response.Fail(w, http.StatusTooManyRequests, ErrQuotaExceeded)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
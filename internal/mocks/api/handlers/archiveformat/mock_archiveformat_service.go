@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockverificationService is a mock of verificationService interface.
+type MockverificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockverificationServiceMockRecorder
+}
+
+// MockverificationServiceMockRecorder is the mock recorder for MockverificationService.
+type MockverificationServiceMockRecorder struct {
+	mock *MockverificationService
+}
+
+// NewMockverificationService creates a new mock instance.
+func NewMockverificationService(ctrl *gomock.Controller) *MockverificationService {
+	mock := &MockverificationService{ctrl: ctrl}
+	mock.recorder = &MockverificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverificationService) EXPECT() *MockverificationServiceMockRecorder {
+	return m.recorder
+}
+
+// Start mocks base method.
+func (m *MockverificationService) Start(ctx context.Context, userID uuid.UUID, req model.ArchiveVerification) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, userID, req)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockverificationServiceMockRecorder) Start(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockverificationService)(nil).Start), ctx, userID, req)
+}
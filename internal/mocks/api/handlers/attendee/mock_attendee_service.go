@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockattendeeService is a mock of attendeeService interface.
+type MockattendeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockattendeeServiceMockRecorder
+}
+
+// MockattendeeServiceMockRecorder is the mock recorder for MockattendeeService.
+type MockattendeeServiceMockRecorder struct {
+	mock *MockattendeeService
+}
+
+// NewMockattendeeService creates a new mock instance.
+func NewMockattendeeService(ctrl *gomock.Controller) *MockattendeeService {
+	mock := &MockattendeeService{ctrl: ctrl}
+	mock.recorder = &MockattendeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattendeeService) EXPECT() *MockattendeeServiceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockattendeeService) Accept(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockattendeeServiceMockRecorder) Accept(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockattendeeService)(nil).Accept), ctx, eventID, userID)
+}
+
+// Decline mocks base method.
+func (m *MockattendeeService) Decline(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockattendeeServiceMockRecorder) Decline(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockattendeeService)(nil).Decline), ctx, eventID, userID)
+}
+
+// GuestRSVP mocks base method.
+func (m *MockattendeeService) GuestRSVP(ctx context.Context, token, response string) (model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestRSVP", ctx, token, response)
+	ret0, _ := ret[0].(model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestRSVP indicates an expected call of GuestRSVP.
+func (mr *MockattendeeServiceMockRecorder) GuestRSVP(ctx, token, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestRSVP", reflect.TypeOf((*MockattendeeService)(nil).GuestRSVP), ctx, token, response)
+}
+
+// Invite mocks base method.
+func (m *MockattendeeService) Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, eventID, ownerID, email)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockattendeeServiceMockRecorder) Invite(ctx, eventID, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockattendeeService)(nil).Invite), ctx, eventID, ownerID, email)
+}
+
+// ListAttendees mocks base method.
+func (m *MockattendeeService) ListAttendees(ctx context.Context, eventID, userID uuid.UUID) ([]model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendees", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendees indicates an expected call of ListAttendees.
+func (mr *MockattendeeServiceMockRecorder) ListAttendees(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendees", reflect.TypeOf((*MockattendeeService)(nil).ListAttendees), ctx, eventID, userID)
+}
+
+// ListGuests mocks base method.
+func (m *MockattendeeService) ListGuests(ctx context.Context, eventID, userID uuid.UUID) ([]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuests", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuests indicates an expected call of ListGuests.
+func (mr *MockattendeeServiceMockRecorder) ListGuests(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuests", reflect.TypeOf((*MockattendeeService)(nil).ListGuests), ctx, eventID, userID)
+}
+
+// RSVP mocks base method.
+func (m *MockattendeeService) RSVP(ctx context.Context, token, response string) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RSVP", ctx, token, response)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RSVP indicates an expected call of RSVP.
+func (mr *MockattendeeServiceMockRecorder) RSVP(ctx, token, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RSVP", reflect.TypeOf((*MockattendeeService)(nil).RSVP), ctx, token, response)
+}
+
+// RemoveAttendee mocks base method.
+func (m *MockattendeeService) RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttendee", ctx, eventID, ownerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttendee indicates an expected call of RemoveAttendee.
+func (mr *MockattendeeServiceMockRecorder) RemoveAttendee(ctx, eventID, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttendee", reflect.TypeOf((*MockattendeeService)(nil).RemoveAttendee), ctx, eventID, ownerID, userID)
+}
+
+// RequestGuestRSVP mocks base method.
+func (m *MockattendeeService) RequestGuestRSVP(ctx context.Context, code, email string) (model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGuestRSVP", ctx, code, email)
+	ret0, _ := ret[0].(model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestGuestRSVP indicates an expected call of RequestGuestRSVP.
+func (mr *MockattendeeServiceMockRecorder) RequestGuestRSVP(ctx, code, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGuestRSVP", reflect.TypeOf((*MockattendeeService)(nil).RequestGuestRSVP), ctx, code, email)
+}
+
+// RunningLate mocks base method.
+func (m *MockattendeeService) RunningLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningLate", ctx, eventID, userID, eta)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningLate indicates an expected call of RunningLate.
+func (mr *MockattendeeServiceMockRecorder) RunningLate(ctx, eventID, userID, eta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningLate", reflect.TypeOf((*MockattendeeService)(nil).RunningLate), ctx, eventID, userID, eta)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockcalendarService is a mock of calendarService interface.
+type MockcalendarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcalendarServiceMockRecorder
+}
+
+// MockcalendarServiceMockRecorder is the mock recorder for MockcalendarService.
+type MockcalendarServiceMockRecorder struct {
+	mock *MockcalendarService
+}
+
+// NewMockcalendarService creates a new mock instance.
+func NewMockcalendarService(ctrl *gomock.Controller) *MockcalendarService {
+	mock := &MockcalendarService{ctrl: ctrl}
+	mock.recorder = &MockcalendarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcalendarService) EXPECT() *MockcalendarServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendar mocks base method.
+func (m *MockcalendarService) CreateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockcalendarServiceMockRecorder) CreateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockcalendarService)(nil).CreateCalendar), ctx, calendar)
+}
+
+// DeleteCalendar mocks base method.
+func (m *MockcalendarService) DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendar", ctx, calendarID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendar indicates an expected call of DeleteCalendar.
+func (mr *MockcalendarServiceMockRecorder) DeleteCalendar(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendar", reflect.TypeOf((*MockcalendarService)(nil).DeleteCalendar), ctx, calendarID, userID)
+}
+
+// GetCalendar mocks base method.
+func (m *MockcalendarService) GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, calendarID, userID)
+	ret0, _ := ret[0].(model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockcalendarServiceMockRecorder) GetCalendar(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockcalendarService)(nil).GetCalendar), ctx, calendarID, userID)
+}
+
+// ListCalendars mocks base method.
+func (m *MockcalendarService) ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendars", ctx, userID)
+	ret0, _ := ret[0].([]model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendars indicates an expected call of ListCalendars.
+func (mr *MockcalendarServiceMockRecorder) ListCalendars(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendars", reflect.TypeOf((*MockcalendarService)(nil).ListCalendars), ctx, userID)
+}
+
+// UpdateCalendar mocks base method.
+func (m *MockcalendarService) UpdateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCalendar indicates an expected call of UpdateCalendar.
+func (mr *MockcalendarServiceMockRecorder) UpdateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendar", reflect.TypeOf((*MockcalendarService)(nil).UpdateCalendar), ctx, calendar)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockrestoreService is a mock of restoreService interface.
+type MockrestoreService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrestoreServiceMockRecorder
+}
+
+// MockrestoreServiceMockRecorder is the mock recorder for MockrestoreService.
+type MockrestoreServiceMockRecorder struct {
+	mock *MockrestoreService
+}
+
+// NewMockrestoreService creates a new mock instance.
+func NewMockrestoreService(ctrl *gomock.Controller) *MockrestoreService {
+	mock := &MockrestoreService{ctrl: ctrl}
+	mock.recorder = &MockrestoreServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrestoreService) EXPECT() *MockrestoreServiceMockRecorder {
+	return m.recorder
+}
+
+// StartRestore mocks base method.
+func (m *MockrestoreService) StartRestore(ctx context.Context, userID uuid.UUID, req model.ColdStorageRestore) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRestore", ctx, userID, req)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRestore indicates an expected call of StartRestore.
+func (mr *MockrestoreServiceMockRecorder) StartRestore(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRestore", reflect.TypeOf((*MockrestoreService)(nil).StartRestore), ctx, userID, req)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockcontactService is a mock of contactService interface.
+type MockcontactService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontactServiceMockRecorder
+}
+
+// MockcontactServiceMockRecorder is the mock recorder for MockcontactService.
+type MockcontactServiceMockRecorder struct {
+	mock *MockcontactService
+}
+
+// NewMockcontactService creates a new mock instance.
+func NewMockcontactService(ctrl *gomock.Controller) *MockcontactService {
+	mock := &MockcontactService{ctrl: ctrl}
+	mock.recorder = &MockcontactServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontactService) EXPECT() *MockcontactServiceMockRecorder {
+	return m.recorder
+}
+
+// AddContact mocks base method.
+func (m *MockcontactService) AddContact(ctx context.Context, c model.Contact) (model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddContact", ctx, c)
+	ret0, _ := ret[0].(model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddContact indicates an expected call of AddContact.
+func (mr *MockcontactServiceMockRecorder) AddContact(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContact", reflect.TypeOf((*MockcontactService)(nil).AddContact), ctx, c)
+}
+
+// ListContacts mocks base method.
+func (m *MockcontactService) ListContacts(ctx context.Context, userID uuid.UUID, query string, limit int) ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, userID, query, limit)
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockcontactServiceMockRecorder) ListContacts(ctx, userID, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockcontactService)(nil).ListContacts), ctx, userID, query, limit)
+}
+
+// RemoveContact mocks base method.
+func (m *MockcontactService) RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContact", ctx, contactID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContact indicates an expected call of RemoveContact.
+func (mr *MockcontactServiceMockRecorder) RemoveContact(ctx, contactID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContact", reflect.TypeOf((*MockcontactService)(nil).RemoveContact), ctx, contactID, userID)
+}
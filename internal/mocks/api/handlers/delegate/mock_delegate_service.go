@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockdelegateService is a mock of delegateService interface.
+type MockdelegateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdelegateServiceMockRecorder
+}
+
+// MockdelegateServiceMockRecorder is the mock recorder for MockdelegateService.
+type MockdelegateServiceMockRecorder struct {
+	mock *MockdelegateService
+}
+
+// NewMockdelegateService creates a new mock instance.
+func NewMockdelegateService(ctrl *gomock.Controller) *MockdelegateService {
+	mock := &MockdelegateService{ctrl: ctrl}
+	mock.recorder = &MockdelegateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdelegateService) EXPECT() *MockdelegateServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDelegate mocks base method.
+func (m *MockdelegateService) AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelegate", ctx, ownerID, email)
+	ret0, _ := ret[0].(model.Delegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDelegate indicates an expected call of AddDelegate.
+func (mr *MockdelegateServiceMockRecorder) AddDelegate(ctx, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegate", reflect.TypeOf((*MockdelegateService)(nil).AddDelegate), ctx, ownerID, email)
+}
+
+// ListDelegates mocks base method.
+func (m *MockdelegateService) ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelegates", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Delegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelegates indicates an expected call of ListDelegates.
+func (mr *MockdelegateServiceMockRecorder) ListDelegates(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelegates", reflect.TypeOf((*MockdelegateService)(nil).ListDelegates), ctx, ownerID)
+}
+
+// RemoveDelegate mocks base method.
+func (m *MockdelegateService) RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDelegate", ctx, ownerID, delegateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDelegate indicates an expected call of RemoveDelegate.
+func (mr *MockdelegateServiceMockRecorder) RemoveDelegate(ctx, ownerID, delegateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDelegate", reflect.TypeOf((*MockdelegateService)(nil).RemoveDelegate), ctx, ownerID, delegateID)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockuserService is a mock of userService interface.
+type MockuserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserServiceMockRecorder
+}
+
+// MockuserServiceMockRecorder is the mock recorder for MockuserService.
+type MockuserServiceMockRecorder struct {
+	mock *MockuserService
+}
+
+// NewMockuserService creates a new mock instance.
+func NewMockuserService(ctrl *gomock.Controller) *MockuserService {
+	mock := &MockuserService{ctrl: ctrl}
+	mock.recorder = &MockuserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserService) EXPECT() *MockuserServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDemo mocks base method.
+func (m *MockuserService) CreateDemo(ctx context.Context, name string) (model.DemoAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDemo", ctx, name)
+	ret0, _ := ret[0].(model.DemoAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDemo indicates an expected call of CreateDemo.
+func (mr *MockuserServiceMockRecorder) CreateDemo(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDemo", reflect.TypeOf((*MockuserService)(nil).CreateDemo), ctx, name)
+}
+
+// MockonboardingService is a mock of onboardingService interface.
+type MockonboardingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockonboardingServiceMockRecorder
+}
+
+// MockonboardingServiceMockRecorder is the mock recorder for MockonboardingService.
+type MockonboardingServiceMockRecorder struct {
+	mock *MockonboardingService
+}
+
+// NewMockonboardingService creates a new mock instance.
+func NewMockonboardingService(ctrl *gomock.Controller) *MockonboardingService {
+	mock := &MockonboardingService{ctrl: ctrl}
+	mock.recorder = &MockonboardingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockonboardingService) EXPECT() *MockonboardingServiceMockRecorder {
+	return m.recorder
+}
+
+// Seed mocks base method.
+func (m *MockonboardingService) Seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed", ctx, userID, loc)
+	ret0, _ := ret[0].(model.OnboardingSeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seed indicates an expected call of Seed.
+func (mr *MockonboardingServiceMockRecorder) Seed(ctx, userID, loc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockonboardingService)(nil).Seed), ctx, userID, loc)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockembedService is a mock of embedService interface.
+type MockembedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockembedServiceMockRecorder
+}
+
+// MockembedServiceMockRecorder is the mock recorder for MockembedService.
+type MockembedServiceMockRecorder struct {
+	mock *MockembedService
+}
+
+// NewMockembedService creates a new mock instance.
+func NewMockembedService(ctrl *gomock.Controller) *MockembedService {
+	mock := &MockembedService{ctrl: ctrl}
+	mock.recorder = &MockembedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockembedService) EXPECT() *MockembedServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateEmbed mocks base method.
+func (m *MockembedService) CreateEmbed(ctx context.Context, embed model.Embed) (model.Embed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmbed", ctx, embed)
+	ret0, _ := ret[0].(model.Embed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmbed indicates an expected call of CreateEmbed.
+func (mr *MockembedServiceMockRecorder) CreateEmbed(ctx, embed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmbed", reflect.TypeOf((*MockembedService)(nil).CreateEmbed), ctx, embed)
+}
+
+// DeleteEmbed mocks base method.
+func (m *MockembedService) DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmbed", ctx, embedID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmbed indicates an expected call of DeleteEmbed.
+func (mr *MockembedServiceMockRecorder) DeleteEmbed(ctx, embedID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmbed", reflect.TypeOf((*MockembedService)(nil).DeleteEmbed), ctx, embedID, userID)
+}
+
+// GetCalendar mocks base method.
+func (m *MockembedService) GetCalendar(ctx context.Context, token string, from, to *time.Time) (model.EmbedCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, token, from, to)
+	ret0, _ := ret[0].(model.EmbedCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockembedServiceMockRecorder) GetCalendar(ctx, token, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockembedService)(nil).GetCalendar), ctx, token, from, to)
+}
+
+// ListEmbeds mocks base method.
+func (m *MockembedService) ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmbeds", ctx, userID)
+	ret0, _ := ret[0].([]model.Embed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmbeds indicates an expected call of ListEmbeds.
+func (mr *MockembedServiceMockRecorder) ListEmbeds(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmbeds", reflect.TypeOf((*MockembedService)(nil).ListEmbeds), ctx, userID)
+}
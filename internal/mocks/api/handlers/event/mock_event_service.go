@@ -9,9 +9,10 @@ import (
 	reflect "reflect"
 	time "time"
 
-	model "github.com/aliskhannn/calendar-service/internal/model"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
 )
 
 // MockeventService is a mock of eventService interface.
@@ -37,19 +38,34 @@ func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
 	return m.recorder
 }
 
+// CountSearchResults mocks base method.
+func (m *MockeventService) CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchResults", ctx, userID, search, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchResults indicates an expected call of CountSearchResults.
+func (mr *MockeventServiceMockRecorder) CountSearchResults(ctx, userID, search, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchResults", reflect.TypeOf((*MockeventService)(nil).CountSearchResults), ctx, userID, search, mode)
+}
+
 // CreateEvent mocks base method.
-func (m *MockeventService) CreateEvent(ctx context.Context, userID uuid.UUID, title, description string, date time.Time) (uuid.UUID, error) {
+func (m *MockeventService) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEvent", ctx, userID, title, description, date)
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateEvent indicates an expected call of CreateEvent.
-func (mr *MockeventServiceMockRecorder) CreateEvent(ctx, userID, title, description, date interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) CreateEvent(ctx, event interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventService)(nil).CreateEvent), ctx, userID, title, description, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventService)(nil).CreateEvent), ctx, event)
 }
 
 // DeleteEvent mocks base method.
@@ -66,61 +82,509 @@ func (mr *MockeventServiceMockRecorder) DeleteEvent(ctx, eventID, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventService)(nil).DeleteEvent), ctx, eventID, userID)
 }
 
+// DeleteEvents mocks base method.
+func (m *MockeventService) DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvents", ctx, userID, eventIDs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvents indicates an expected call of DeleteEvents.
+func (mr *MockeventServiceMockRecorder) DeleteEvents(ctx, userID, eventIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockeventService)(nil).DeleteEvents), ctx, userID, eventIDs)
+}
+
+// DeleteEventsInRange mocks base method.
+func (m *MockeventService) DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventsInRange", ctx, userID, from, to, calendarID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventsInRange indicates an expected call of DeleteEventsInRange.
+func (mr *MockeventServiceMockRecorder) DeleteEventsInRange(ctx, userID, from, to, calendarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventsInRange", reflect.TypeOf((*MockeventService)(nil).DeleteEventsInRange), ctx, userID, from, to, calendarID)
+}
+
+// DeleteOccurrence mocks base method.
+func (m *MockeventService) DeleteOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOccurrence", ctx, eventID, userID, occurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOccurrence indicates an expected call of DeleteOccurrence.
+func (mr *MockeventServiceMockRecorder) DeleteOccurrence(ctx, eventID, userID, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOccurrence", reflect.TypeOf((*MockeventService)(nil).DeleteOccurrence), ctx, eventID, userID, occurrence)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockeventService) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockeventServiceMockRecorder) EmptyTrash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockeventService)(nil).EmptyTrash), ctx, userID)
+}
+
+// EventExists mocks base method.
+func (m *MockeventService) EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockeventServiceMockRecorder) EventExists(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockeventService)(nil).EventExists), ctx, eventID, userID)
+}
+
+// GetAvailability mocks base method.
+func (m *MockeventService) GetAvailability(ctx context.Context, event model.Event, attendees []model.Attendee) ([]model.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailability", ctx, event, attendees)
+	ret0, _ := ret[0].([]model.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailability indicates an expected call of GetAvailability.
+func (mr *MockeventServiceMockRecorder) GetAvailability(ctx, event, attendees interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailability", reflect.TypeOf((*MockeventService)(nil).GetAvailability), ctx, event, attendees)
+}
+
+// GetEvent mocks base method.
+func (m *MockeventService) GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, []model.RelatedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].([]model.RelatedEvent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockeventServiceMockRecorder) GetEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockeventService)(nil).GetEvent), ctx, eventID, userID)
+}
+
+// GetEventSummary mocks base method.
+func (m *MockeventService) GetEventSummary(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) (model.EventSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSummary", ctx, userID, from, to, opts)
+	ret0, _ := ret[0].(model.EventSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSummary indicates an expected call of GetEventSummary.
+func (mr *MockeventServiceMockRecorder) GetEventSummary(ctx, userID, from, to, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSummary", reflect.TypeOf((*MockeventService)(nil).GetEventSummary), ctx, userID, from, to, opts)
+}
+
 // GetEventsForDay mocks base method.
-func (m *MockeventService) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventService) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForDay", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForDay", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForDay indicates an expected call of GetEventsForDay.
-func (mr *MockeventServiceMockRecorder) GetEventsForDay(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) GetEventsForDay(ctx, userID, date, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForDay", reflect.TypeOf((*MockeventService)(nil).GetEventsForDay), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForDay", reflect.TypeOf((*MockeventService)(nil).GetEventsForDay), ctx, userID, date, opts)
 }
 
 // GetEventsForMonth mocks base method.
-func (m *MockeventService) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventService) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForMonth", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForMonth", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForMonth indicates an expected call of GetEventsForMonth.
-func (mr *MockeventServiceMockRecorder) GetEventsForMonth(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) GetEventsForMonth(ctx, userID, date, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForMonth", reflect.TypeOf((*MockeventService)(nil).GetEventsForMonth), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForMonth", reflect.TypeOf((*MockeventService)(nil).GetEventsForMonth), ctx, userID, date, opts)
 }
 
 // GetEventsForWeek mocks base method.
-func (m *MockeventService) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventService) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForWeek", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForWeek", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForWeek indicates an expected call of GetEventsForWeek.
-func (mr *MockeventServiceMockRecorder) GetEventsForWeek(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) GetEventsForWeek(ctx, userID, date, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForWeek", reflect.TypeOf((*MockeventService)(nil).GetEventsForWeek), ctx, userID, date, opts)
+}
+
+// GetMonthGrid mocks base method.
+func (m *MockeventService) GetMonthGrid(ctx context.Context, userID uuid.UUID, date time.Time, weekStart time.Weekday, opts model.EventListOptions) (model.MonthGrid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthGrid", ctx, userID, date, weekStart, opts)
+	ret0, _ := ret[0].(model.MonthGrid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthGrid indicates an expected call of GetMonthGrid.
+func (mr *MockeventServiceMockRecorder) GetMonthGrid(ctx, userID, date, weekStart, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthGrid", reflect.TypeOf((*MockeventService)(nil).GetMonthGrid), ctx, userID, date, weekStart, opts)
+}
+
+// LinkEvents mocks base method.
+func (m *MockeventService) LinkEvents(ctx context.Context, link model.EventLink, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkEvents", ctx, link, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkEvents indicates an expected call of LinkEvents.
+func (mr *MockeventServiceMockRecorder) LinkEvents(ctx, link, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkEvents", reflect.TypeOf((*MockeventService)(nil).LinkEvents), ctx, link, userID)
+}
+
+// ListOverrides mocks base method.
+func (m *MockeventService) ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverrides", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.OccurrenceOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverrides indicates an expected call of ListOverrides.
+func (mr *MockeventServiceMockRecorder) ListOverrides(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverrides", reflect.TypeOf((*MockeventService)(nil).ListOverrides), ctx, eventID, userID)
+}
+
+// ListTrash mocks base method.
+func (m *MockeventService) ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, userID, page)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockeventServiceMockRecorder) ListTrash(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockeventService)(nil).ListTrash), ctx, userID, page)
+}
+
+// OverrideOccurrence mocks base method.
+func (m *MockeventService) OverrideOccurrence(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideOccurrence", ctx, eventID, userID, override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverrideOccurrence indicates an expected call of OverrideOccurrence.
+func (mr *MockeventServiceMockRecorder) OverrideOccurrence(ctx, eventID, userID, override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideOccurrence", reflect.TypeOf((*MockeventService)(nil).OverrideOccurrence), ctx, eventID, userID, override)
+}
+
+// RestoreEvent mocks base method.
+func (m *MockeventService) RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEvent indicates an expected call of RestoreEvent.
+func (mr *MockeventServiceMockRecorder) RestoreEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEvent", reflect.TypeOf((*MockeventService)(nil).RestoreEvent), ctx, eventID, userID)
+}
+
+// RestoreTrash mocks base method.
+func (m *MockeventService) RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTrash", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTrash indicates an expected call of RestoreTrash.
+func (mr *MockeventServiceMockRecorder) RestoreTrash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrash", reflect.TypeOf((*MockeventService)(nil).RestoreTrash), ctx, userID)
+}
+
+// RevertOccurrence mocks base method.
+func (m *MockeventService) RevertOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertOccurrence", ctx, eventID, userID, occurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevertOccurrence indicates an expected call of RevertOccurrence.
+func (mr *MockeventServiceMockRecorder) RevertOccurrence(ctx, eventID, userID, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertOccurrence", reflect.TypeOf((*MockeventService)(nil).RevertOccurrence), ctx, eventID, userID, occurrence)
+}
+
+// SearchEvents mocks base method.
+func (m *MockeventService) SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", ctx, userID, search, page)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockeventServiceMockRecorder) SearchEvents(ctx, userID, search, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockeventService)(nil).SearchEvents), ctx, userID, search, page)
+}
+
+// SuggestTitles mocks base method.
+func (m *MockeventService) SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTitles", ctx, userID, prefix, strict, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTitles indicates an expected call of SuggestTitles.
+func (mr *MockeventServiceMockRecorder) SuggestTitles(ctx, userID, prefix, strict, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForWeek", reflect.TypeOf((*MockeventService)(nil).GetEventsForWeek), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTitles", reflect.TypeOf((*MockeventService)(nil).SuggestTitles), ctx, userID, prefix, strict, limit)
+}
+
+// UnlinkEvents mocks base method.
+func (m *MockeventService) UnlinkEvents(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkEvents", ctx, eventID, relatedEventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkEvents indicates an expected call of UnlinkEvents.
+func (mr *MockeventServiceMockRecorder) UnlinkEvents(ctx, eventID, relatedEventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkEvents", reflect.TypeOf((*MockeventService)(nil).UnlinkEvents), ctx, eventID, relatedEventID, userID)
 }
 
 // UpdateEvent mocks base method.
-func (m *MockeventService) UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, title, description string, date time.Time) error {
+func (m *MockeventService) UpdateEvent(ctx context.Context, event model.Event) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEvent", ctx, eventID, userID, title, description, date)
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, event)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEvent indicates an expected call of UpdateEvent.
-func (mr *MockeventServiceMockRecorder) UpdateEvent(ctx, eventID, userID, title, description, date interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) UpdateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventService)(nil).UpdateEvent), ctx, event)
+}
+
+// UpdateOccurrence mocks base method.
+func (m *MockeventService) UpdateOccurrence(ctx context.Context, event model.Event, occurrence time.Time) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOccurrence", ctx, event, occurrence)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOccurrence indicates an expected call of UpdateOccurrence.
+func (mr *MockeventServiceMockRecorder) UpdateOccurrence(ctx, event, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOccurrence", reflect.TypeOf((*MockeventService)(nil).UpdateOccurrence), ctx, event, occurrence)
+}
+
+// MocksuggestionService is a mock of suggestionService interface.
+type MocksuggestionService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksuggestionServiceMockRecorder
+}
+
+// MocksuggestionServiceMockRecorder is the mock recorder for MocksuggestionService.
+type MocksuggestionServiceMockRecorder struct {
+	mock *MocksuggestionService
+}
+
+// NewMocksuggestionService creates a new mock instance.
+func NewMocksuggestionService(ctrl *gomock.Controller) *MocksuggestionService {
+	mock := &MocksuggestionService{ctrl: ctrl}
+	mock.recorder = &MocksuggestionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksuggestionService) EXPECT() *MocksuggestionServiceMockRecorder {
+	return m.recorder
+}
+
+// Suggest mocks base method.
+func (m *MocksuggestionService) Suggest(ctx context.Context, event model.Event) (model.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, event)
+	ret0, _ := ret[0].(model.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MocksuggestionServiceMockRecorder) Suggest(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MocksuggestionService)(nil).Suggest), ctx, event)
+}
+
+// MockdelegateService is a mock of delegateService interface.
+type MockdelegateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdelegateServiceMockRecorder
+}
+
+// MockdelegateServiceMockRecorder is the mock recorder for MockdelegateService.
+type MockdelegateServiceMockRecorder struct {
+	mock *MockdelegateService
+}
+
+// NewMockdelegateService creates a new mock instance.
+func NewMockdelegateService(ctrl *gomock.Controller) *MockdelegateService {
+	mock := &MockdelegateService{ctrl: ctrl}
+	mock.recorder = &MockdelegateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdelegateService) EXPECT() *MockdelegateServiceMockRecorder {
+	return m.recorder
+}
+
+// CanCreateFor mocks base method.
+func (m *MockdelegateService) CanCreateFor(ctx context.Context, ownerID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanCreateFor", ctx, ownerID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanCreateFor indicates an expected call of CanCreateFor.
+func (mr *MockdelegateServiceMockRecorder) CanCreateFor(ctx, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanCreateFor", reflect.TypeOf((*MockdelegateService)(nil).CanCreateFor), ctx, ownerID, userID)
+}
+
+// MockprofileService is a mock of profileService interface.
+type MockprofileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockprofileServiceMockRecorder
+}
+
+// MockprofileServiceMockRecorder is the mock recorder for MockprofileService.
+type MockprofileServiceMockRecorder struct {
+	mock *MockprofileService
+}
+
+// NewMockprofileService creates a new mock instance.
+func NewMockprofileService(ctrl *gomock.Controller) *MockprofileService {
+	mock := &MockprofileService{ctrl: ctrl}
+	mock.recorder = &MockprofileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprofileService) EXPECT() *MockprofileServiceMockRecorder {
+	return m.recorder
+}
+
+// Location mocks base method.
+func (m *MockprofileService) Location(ctx context.Context, userID uuid.UUID) (*time.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Location", ctx, userID)
+	ret0, _ := ret[0].(*time.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Location indicates an expected call of Location.
+func (mr *MockprofileServiceMockRecorder) Location(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Location", reflect.TypeOf((*MockprofileService)(nil).Location), ctx, userID)
+}
+
+// MockattendeeService is a mock of attendeeService interface.
+type MockattendeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockattendeeServiceMockRecorder
+}
+
+// MockattendeeServiceMockRecorder is the mock recorder for MockattendeeService.
+type MockattendeeServiceMockRecorder struct {
+	mock *MockattendeeService
+}
+
+// NewMockattendeeService creates a new mock instance.
+func NewMockattendeeService(ctrl *gomock.Controller) *MockattendeeService {
+	mock := &MockattendeeService{ctrl: ctrl}
+	mock.recorder = &MockattendeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattendeeService) EXPECT() *MockattendeeServiceMockRecorder {
+	return m.recorder
+}
+
+// ListAttendees mocks base method.
+func (m *MockattendeeService) ListAttendees(ctx context.Context, eventID, userID uuid.UUID) ([]model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendees", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendees indicates an expected call of ListAttendees.
+func (mr *MockattendeeServiceMockRecorder) ListAttendees(ctx, eventID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventService)(nil).UpdateEvent), ctx, eventID, userID, title, description, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendees", reflect.TypeOf((*MockattendeeService)(nil).ListAttendees), ctx, eventID, userID)
 }
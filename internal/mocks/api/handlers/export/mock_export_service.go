@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockexportService is a mock of exportService interface.
+type MockexportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockexportServiceMockRecorder
+}
+
+// MockexportServiceMockRecorder is the mock recorder for MockexportService.
+type MockexportServiceMockRecorder struct {
+	mock *MockexportService
+}
+
+// NewMockexportService creates a new mock instance.
+func NewMockexportService(ctrl *gomock.Controller) *MockexportService {
+	mock := &MockexportService{ctrl: ctrl}
+	mock.recorder = &MockexportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexportService) EXPECT() *MockexportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportPDF mocks base method.
+func (m *MockexportService) ExportPDF(ctx context.Context, userID uuid.UUID, req model.PDFExport) ([]byte, *model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPDF", ctx, userID, req)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*model.Job)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExportPDF indicates an expected call of ExportPDF.
+func (mr *MockexportServiceMockRecorder) ExportPDF(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPDF", reflect.TypeOf((*MockexportService)(nil).ExportPDF), ctx, userID, req)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockfeedService is a mock of feedService interface.
+type MockfeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedServiceMockRecorder
+}
+
+// MockfeedServiceMockRecorder is the mock recorder for MockfeedService.
+type MockfeedServiceMockRecorder struct {
+	mock *MockfeedService
+}
+
+// NewMockfeedService creates a new mock instance.
+func NewMockfeedService(ctrl *gomock.Controller) *MockfeedService {
+	mock := &MockfeedService{ctrl: ctrl}
+	mock.recorder = &MockfeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedService) EXPECT() *MockfeedServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateFeed mocks base method.
+func (m *MockfeedService) CreateFeed(ctx context.Context, feed model.Feed) (model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeed", ctx, feed)
+	ret0, _ := ret[0].(model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeed indicates an expected call of CreateFeed.
+func (mr *MockfeedServiceMockRecorder) CreateFeed(ctx, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeed", reflect.TypeOf((*MockfeedService)(nil).CreateFeed), ctx, feed)
+}
+
+// DeleteFeed mocks base method.
+func (m *MockfeedService) DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeed", ctx, feedID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockfeedServiceMockRecorder) DeleteFeed(ctx, feedID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockfeedService)(nil).DeleteFeed), ctx, feedID, userID)
+}
+
+// GetFeedCalendar mocks base method.
+func (m *MockfeedService) GetFeedCalendar(ctx context.Context, token string) (model.FeedCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedCalendar", ctx, token)
+	ret0, _ := ret[0].(model.FeedCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedCalendar indicates an expected call of GetFeedCalendar.
+func (mr *MockfeedServiceMockRecorder) GetFeedCalendar(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedCalendar", reflect.TypeOf((*MockfeedService)(nil).GetFeedCalendar), ctx, token)
+}
+
+// ListFeeds mocks base method.
+func (m *MockfeedService) ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeds", ctx, userID)
+	ret0, _ := ret[0].([]model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeds indicates an expected call of ListFeeds.
+func (mr *MockfeedServiceMockRecorder) ListFeeds(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockfeedService)(nil).ListFeeds), ctx, userID)
+}
+
+// RegenerateFeed mocks base method.
+func (m *MockfeedService) RegenerateFeed(ctx context.Context, feedID, userID uuid.UUID) (model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateFeed", ctx, feedID, userID)
+	ret0, _ := ret[0].(model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateFeed indicates an expected call of RegenerateFeed.
+func (mr *MockfeedServiceMockRecorder) RegenerateFeed(ctx, feedID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateFeed", reflect.TypeOf((*MockfeedService)(nil).RegenerateFeed), ctx, feedID, userID)
+}
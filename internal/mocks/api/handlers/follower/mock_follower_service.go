@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockfollowerService is a mock of followerService interface.
+type MockfollowerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockfollowerServiceMockRecorder
+}
+
+// MockfollowerServiceMockRecorder is the mock recorder for MockfollowerService.
+type MockfollowerServiceMockRecorder struct {
+	mock *MockfollowerService
+}
+
+// NewMockfollowerService creates a new mock instance.
+func NewMockfollowerService(ctrl *gomock.Controller) *MockfollowerService {
+	mock := &MockfollowerService{ctrl: ctrl}
+	mock.recorder = &MockfollowerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfollowerService) EXPECT() *MockfollowerServiceMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockfollowerService) Follow(ctx context.Context, eventID, userID uuid.UUID) (model.Follower, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Follower)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockfollowerServiceMockRecorder) Follow(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockfollowerService)(nil).Follow), ctx, eventID, userID)
+}
+
+// Unfollow mocks base method.
+func (m *MockfollowerService) Unfollow(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockfollowerServiceMockRecorder) Unfollow(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockfollowerService)(nil).Unfollow), ctx, eventID, userID)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockgroupService is a mock of groupService interface.
+type MockgroupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockgroupServiceMockRecorder
+}
+
+// MockgroupServiceMockRecorder is the mock recorder for MockgroupService.
+type MockgroupServiceMockRecorder struct {
+	mock *MockgroupService
+}
+
+// NewMockgroupService creates a new mock instance.
+func NewMockgroupService(ctrl *gomock.Controller) *MockgroupService {
+	mock := &MockgroupService{ctrl: ctrl}
+	mock.recorder = &MockgroupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgroupService) EXPECT() *MockgroupServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockgroupService) AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, groupID, ownerID, email)
+	ret0, _ := ret[0].(model.GroupMember)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockgroupServiceMockRecorder) AddMember(ctx, groupID, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockgroupService)(nil).AddMember), ctx, groupID, ownerID, email)
+}
+
+// CreateGroup mocks base method.
+func (m *MockgroupService) CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, userID, name)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockgroupServiceMockRecorder) CreateGroup(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockgroupService)(nil).CreateGroup), ctx, userID, name)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockgroupService) DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockgroupServiceMockRecorder) DeleteGroup(ctx, groupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockgroupService)(nil).DeleteGroup), ctx, groupID, userID)
+}
+
+// GetGroup mocks base method.
+func (m *MockgroupService) GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockgroupServiceMockRecorder) GetGroup(ctx, groupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockgroupService)(nil).GetGroup), ctx, groupID, userID)
+}
+
+// InviteGroup mocks base method.
+func (m *MockgroupService) InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteGroup", ctx, eventID, ownerID, groupID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteGroup indicates an expected call of InviteGroup.
+func (mr *MockgroupServiceMockRecorder) InviteGroup(ctx, eventID, ownerID, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteGroup", reflect.TypeOf((*MockgroupService)(nil).InviteGroup), ctx, eventID, ownerID, groupID)
+}
+
+// ListGroups mocks base method.
+func (m *MockgroupService) ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, userID)
+	ret0, _ := ret[0].([]model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockgroupServiceMockRecorder) ListGroups(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockgroupService)(nil).ListGroups), ctx, userID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockgroupService) RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, groupID, ownerID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockgroupServiceMockRecorder) RemoveMember(ctx, groupID, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockgroupService)(nil).RemoveMember), ctx, groupID, ownerID, userID)
+}
+
+// RenameGroup mocks base method.
+func (m *MockgroupService) RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGroup", ctx, groupID, userID, name)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameGroup indicates an expected call of RenameGroup.
+func (mr *MockgroupServiceMockRecorder) RenameGroup(ctx, groupID, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockgroupService)(nil).RenameGroup), ctx, groupID, userID, name)
+}
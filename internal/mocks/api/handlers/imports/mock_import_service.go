@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockimportService is a mock of importService interface.
+type MockimportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockimportServiceMockRecorder
+}
+
+// MockimportServiceMockRecorder is the mock recorder for MockimportService.
+type MockimportServiceMockRecorder struct {
+	mock *MockimportService
+}
+
+// NewMockimportService creates a new mock instance.
+func NewMockimportService(ctrl *gomock.Controller) *MockimportService {
+	mock := &MockimportService{ctrl: ctrl}
+	mock.recorder = &MockimportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimportService) EXPECT() *MockimportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetImport mocks base method.
+func (m *MockimportService) GetImport(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImport", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImport indicates an expected call of GetImport.
+func (mr *MockimportServiceMockRecorder) GetImport(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImport", reflect.TypeOf((*MockimportService)(nil).GetImport), ctx, jobID, userID)
+}
+
+// StartImport mocks base method.
+func (m *MockimportService) StartImport(ctx context.Context, userID uuid.UUID, archive []byte) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImport", ctx, userID, archive)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImport indicates an expected call of StartImport.
+func (mr *MockimportServiceMockRecorder) StartImport(ctx, userID, archive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImport", reflect.TypeOf((*MockimportService)(nil).StartImport), ctx, userID, archive)
+}
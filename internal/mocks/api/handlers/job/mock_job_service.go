@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockjobService) CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockjobServiceMockRecorder) CancelJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockjobService)(nil).CancelJob), ctx, jobID, userID)
+}
+
+// GetJob mocks base method.
+func (m *MockjobService) GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockjobServiceMockRecorder) GetJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockjobService)(nil).GetJob), ctx, jobID, userID)
+}
+
+// GetOutput mocks base method.
+func (m *MockjobService) GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutput", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutput indicates an expected call of GetOutput.
+func (mr *MockjobServiceMockRecorder) GetOutput(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutput", reflect.TypeOf((*MockjobService)(nil).GetOutput), ctx, jobID, userID)
+}
+
+// ListJobs mocks base method.
+func (m *MockjobService) ListJobs(ctx context.Context, userID uuid.UUID) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx, userID)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockjobServiceMockRecorder) ListJobs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockjobService)(nil).ListJobs), ctx, userID)
+}
+
+// RollbackJob mocks base method.
+func (m *MockjobService) RollbackJob(ctx context.Context, jobID, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackJob indicates an expected call of RollbackJob.
+func (mr *MockjobServiceMockRecorder) RollbackJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackJob", reflect.TypeOf((*MockjobService)(nil).RollbackJob), ctx, jobID, userID)
+}
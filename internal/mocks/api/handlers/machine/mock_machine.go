@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocktokenIssuer is a mock of tokenIssuer interface.
+type MocktokenIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenIssuerMockRecorder
+}
+
+// MocktokenIssuerMockRecorder is the mock recorder for MocktokenIssuer.
+type MocktokenIssuerMockRecorder struct {
+	mock *MocktokenIssuer
+}
+
+// NewMocktokenIssuer creates a new mock instance.
+func NewMocktokenIssuer(ctrl *gomock.Controller) *MocktokenIssuer {
+	mock := &MocktokenIssuer{ctrl: ctrl}
+	mock.recorder = &MocktokenIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenIssuer) EXPECT() *MocktokenIssuerMockRecorder {
+	return m.recorder
+}
+
+// IssueToken mocks base method.
+func (m *MocktokenIssuer) IssueToken(clientID, secret string, scopes []string) (model.MachineToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", clientID, secret, scopes)
+	ret0, _ := ret[0].(model.MachineToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MocktokenIssuerMockRecorder) IssueToken(clientID, secret, scopes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MocktokenIssuer)(nil).IssueToken), clientID, secret, scopes)
+}
+
+// MockeventService is a mock of eventService interface.
+type MockeventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventServiceMockRecorder
+}
+
+// MockeventServiceMockRecorder is the mock recorder for MockeventService.
+type MockeventServiceMockRecorder struct {
+	mock *MockeventService
+}
+
+// NewMockeventService creates a new mock instance.
+func NewMockeventService(ctrl *gomock.Controller) *MockeventService {
+	mock := &MockeventService{ctrl: ctrl}
+	mock.recorder = &MockeventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
+	return m.recorder
+}
+
+// GetEventsInRange mocks base method.
+func (m *MockeventService) GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsInRange", ctx, userID, from, to)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsInRange indicates an expected call of GetEventsInRange.
+func (mr *MockeventServiceMockRecorder) GetEventsInRange(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsInRange", reflect.TypeOf((*MockeventService)(nil).GetEventsInRange), ctx, userID, from, to)
+}
+
+// MockreminderDispatcher is a mock of reminderDispatcher interface.
+type MockreminderDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockreminderDispatcherMockRecorder
+}
+
+// MockreminderDispatcherMockRecorder is the mock recorder for MockreminderDispatcher.
+type MockreminderDispatcherMockRecorder struct {
+	mock *MockreminderDispatcher
+}
+
+// NewMockreminderDispatcher creates a new mock instance.
+func NewMockreminderDispatcher(ctrl *gomock.Controller) *MockreminderDispatcher {
+	mock := &MockreminderDispatcher{ctrl: ctrl}
+	mock.recorder = &MockreminderDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreminderDispatcher) EXPECT() *MockreminderDispatcherMockRecorder {
+	return m.recorder
+}
+
+// Poll mocks base method.
+func (m *MockreminderDispatcher) Poll(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Poll", ctx)
+}
+
+// Poll indicates an expected call of Poll.
+func (mr *MockreminderDispatcherMockRecorder) Poll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Poll", reflect.TypeOf((*MockreminderDispatcher)(nil).Poll), ctx)
+}
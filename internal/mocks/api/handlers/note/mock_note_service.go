@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocknoteService is a mock of noteService interface.
+type MocknoteService struct {
+	ctrl     *gomock.Controller
+	recorder *MocknoteServiceMockRecorder
+}
+
+// MocknoteServiceMockRecorder is the mock recorder for MocknoteService.
+type MocknoteServiceMockRecorder struct {
+	mock *MocknoteService
+}
+
+// NewMocknoteService creates a new mock instance.
+func NewMocknoteService(ctrl *gomock.Controller) *MocknoteService {
+	mock := &MocknoteService{ctrl: ctrl}
+	mock.recorder = &MocknoteServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknoteService) EXPECT() *MocknoteServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteNote mocks base method.
+func (m *MocknoteService) DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MocknoteServiceMockRecorder) DeleteNote(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MocknoteService)(nil).DeleteNote), ctx, eventID, userID)
+}
+
+// GetNote mocks base method.
+func (m *MocknoteService) GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNote", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNote indicates an expected call of GetNote.
+func (mr *MocknoteServiceMockRecorder) GetNote(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MocknoteService)(nil).GetNote), ctx, eventID, userID)
+}
+
+// SetNote mocks base method.
+func (m *MocknoteService) SetNote(ctx context.Context, n model.Note) (model.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNote", ctx, n)
+	ret0, _ := ret[0].(model.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNote indicates an expected call of SetNote.
+func (mr *MocknoteServiceMockRecorder) SetNote(ctx, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNote", reflect.TypeOf((*MocknoteService)(nil).SetNote), ctx, n)
+}
@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocknotificationService is a mock of notificationService interface.
+type MocknotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationServiceMockRecorder
+}
+
+// MocknotificationServiceMockRecorder is the mock recorder for MocknotificationService.
+type MocknotificationServiceMockRecorder struct {
+	mock *MocknotificationService
+}
+
+// NewMocknotificationService creates a new mock instance.
+func NewMocknotificationService(ctrl *gomock.Controller) *MocknotificationService {
+	mock := &MocknotificationService{ctrl: ctrl}
+	mock.recorder = &MocknotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationService) EXPECT() *MocknotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// CountEntries mocks base method.
+func (m *MocknotificationService) CountEntries(ctx context.Context, recipient, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEntries", ctx, recipient, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEntries indicates an expected call of CountEntries.
+func (mr *MocknotificationServiceMockRecorder) CountEntries(ctx, recipient, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEntries", reflect.TypeOf((*MocknotificationService)(nil).CountEntries), ctx, recipient, mode)
+}
+
+// ListEntries mocks base method.
+func (m *MocknotificationService) ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, recipient, page)
+	ret0, _ := ret[0].([]model.NotificationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MocknotificationServiceMockRecorder) ListEntries(ctx, recipient, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MocknotificationService)(nil).ListEntries), ctx, recipient, page)
+}
+
+// RecordFeedback mocks base method.
+func (m *MocknotificationService) RecordFeedback(ctx context.Context, entries []model.NotificationLogEntry) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFeedback", ctx, entries)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFeedback indicates an expected call of RecordFeedback.
+func (mr *MocknotificationServiceMockRecorder) RecordFeedback(ctx, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFeedback", reflect.TypeOf((*MocknotificationService)(nil).RecordFeedback), ctx, entries)
+}
+
+// MockfeedbackSource is a mock of feedbackSource interface.
+type MockfeedbackSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedbackSourceMockRecorder
+}
+
+// MockfeedbackSourceMockRecorder is the mock recorder for MockfeedbackSource.
+type MockfeedbackSourceMockRecorder struct {
+	mock *MockfeedbackSource
+}
+
+// NewMockfeedbackSource creates a new mock instance.
+func NewMockfeedbackSource(ctrl *gomock.Controller) *MockfeedbackSource {
+	mock := &MockfeedbackSource{ctrl: ctrl}
+	mock.recorder = &MockfeedbackSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedbackSource) EXPECT() *MockfeedbackSourceMockRecorder {
+	return m.recorder
+}
+
+// Feedback mocks base method.
+func (m *MockfeedbackSource) Feedback(r *http.Request) ([]model.NotificationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feedback", r)
+	ret0, _ := ret[0].([]model.NotificationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feedback indicates an expected call of Feedback.
+func (mr *MockfeedbackSourceMockRecorder) Feedback(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feedback", reflect.TypeOf((*MockfeedbackSource)(nil).Feedback), r)
+}
+
+// Name mocks base method.
+func (m *MockfeedbackSource) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockfeedbackSourceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockfeedbackSource)(nil).Name))
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockonboardingService is a mock of onboardingService interface.
+type MockonboardingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockonboardingServiceMockRecorder
+}
+
+// MockonboardingServiceMockRecorder is the mock recorder for MockonboardingService.
+type MockonboardingServiceMockRecorder struct {
+	mock *MockonboardingService
+}
+
+// NewMockonboardingService creates a new mock instance.
+func NewMockonboardingService(ctrl *gomock.Controller) *MockonboardingService {
+	mock := &MockonboardingService{ctrl: ctrl}
+	mock.recorder = &MockonboardingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockonboardingService) EXPECT() *MockonboardingServiceMockRecorder {
+	return m.recorder
+}
+
+// Seed mocks base method.
+func (m *MockonboardingService) Seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed", ctx, userID, loc)
+	ret0, _ := ret[0].(model.OnboardingSeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seed indicates an expected call of Seed.
+func (mr *MockonboardingServiceMockRecorder) Seed(ctx, userID, loc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockonboardingService)(nil).Seed), ctx, userID, loc)
+}
+
+// Status mocks base method.
+func (m *MockonboardingService) Status(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, userID)
+	ret0, _ := ret[0].(model.OnboardingStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockonboardingServiceMockRecorder) Status(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockonboardingService)(nil).Status), ctx, userID)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockpreferenceService is a mock of preferenceService interface.
+type MockpreferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockpreferenceServiceMockRecorder
+}
+
+// MockpreferenceServiceMockRecorder is the mock recorder for MockpreferenceService.
+type MockpreferenceServiceMockRecorder struct {
+	mock *MockpreferenceService
+}
+
+// NewMockpreferenceService creates a new mock instance.
+func NewMockpreferenceService(ctrl *gomock.Controller) *MockpreferenceService {
+	mock := &MockpreferenceService{ctrl: ctrl}
+	mock.recorder = &MockpreferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpreferenceService) EXPECT() *MockpreferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// Preferences mocks base method.
+func (m *MockpreferenceService) Preferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preferences", ctx, userID)
+	ret0, _ := ret[0].([]model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preferences indicates an expected call of Preferences.
+func (mr *MockpreferenceServiceMockRecorder) Preferences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preferences", reflect.TypeOf((*MockpreferenceService)(nil).Preferences), ctx, userID)
+}
+
+// SetSubscribed mocks base method.
+func (m *MockpreferenceService) SetSubscribed(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSubscribed", ctx, userID, category, subscribed)
+	ret0, _ := ret[0].(model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSubscribed indicates an expected call of SetSubscribed.
+func (mr *MockpreferenceServiceMockRecorder) SetSubscribed(ctx, userID, category, subscribed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscribed", reflect.TypeOf((*MockpreferenceService)(nil).SetSubscribed), ctx, userID, category, subscribed)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockpreferenceService) Unsubscribe(ctx context.Context, token string) (model.Unsubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, token)
+	ret0, _ := ret[0].(model.Unsubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockpreferenceServiceMockRecorder) Unsubscribe(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockpreferenceService)(nil).Unsubscribe), ctx, token)
+}
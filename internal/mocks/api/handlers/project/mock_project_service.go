@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockprojectService is a mock of projectService interface.
+type MockprojectService struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectServiceMockRecorder
+}
+
+// MockprojectServiceMockRecorder is the mock recorder for MockprojectService.
+type MockprojectServiceMockRecorder struct {
+	mock *MockprojectService
+}
+
+// NewMockprojectService creates a new mock instance.
+func NewMockprojectService(ctrl *gomock.Controller) *MockprojectService {
+	mock := &MockprojectService{ctrl: ctrl}
+	mock.recorder = &MockprojectServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectService) EXPECT() *MockprojectServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockprojectService) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockprojectServiceMockRecorder) CreateProject(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectService)(nil).CreateProject), ctx, project)
+}
+
+// CreateTask mocks base method.
+func (m *MockprojectService) CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, task, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockprojectServiceMockRecorder) CreateTask(ctx, task, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockprojectService)(nil).CreateTask), ctx, task, userID)
+}
+
+// DeleteProject mocks base method.
+func (m *MockprojectService) DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockprojectServiceMockRecorder) DeleteProject(ctx, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockprojectService)(nil).DeleteProject), ctx, projectID, userID)
+}
+
+// GetTimeline mocks base method.
+func (m *MockprojectService) GetTimeline(ctx context.Context, projectID, userID uuid.UUID) (model.ProjectTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, projectID, userID)
+	ret0, _ := ret[0].(model.ProjectTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockprojectServiceMockRecorder) GetTimeline(ctx, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockprojectService)(nil).GetTimeline), ctx, projectID, userID)
+}
+
+// ListProjects mocks base method.
+func (m *MockprojectService) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, userID)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockprojectServiceMockRecorder) ListProjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockprojectService)(nil).ListProjects), ctx, userID)
+}
+
+// SetTaskDone mocks base method.
+func (m *MockprojectService) SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskDone", ctx, projectID, taskID, userID, done)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskDone indicates an expected call of SetTaskDone.
+func (mr *MockprojectServiceMockRecorder) SetTaskDone(ctx, projectID, taskID, userID, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskDone", reflect.TypeOf((*MockprojectService)(nil).SetTaskDone), ctx, projectID, taskID, userID, done)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockproposalService is a mock of proposalService interface.
+type MockproposalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockproposalServiceMockRecorder
+}
+
+// MockproposalServiceMockRecorder is the mock recorder for MockproposalService.
+type MockproposalServiceMockRecorder struct {
+	mock *MockproposalService
+}
+
+// NewMockproposalService creates a new mock instance.
+func NewMockproposalService(ctrl *gomock.Controller) *MockproposalService {
+	mock := &MockproposalService{ctrl: ctrl}
+	mock.recorder = &MockproposalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproposalService) EXPECT() *MockproposalServiceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockproposalService) Accept(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, eventID, proposalID, ownerID)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockproposalServiceMockRecorder) Accept(ctx, eventID, proposalID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockproposalService)(nil).Accept), ctx, eventID, proposalID, ownerID)
+}
+
+// Decline mocks base method.
+func (m *MockproposalService) Decline(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, eventID, proposalID, ownerID)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockproposalServiceMockRecorder) Decline(ctx, eventID, proposalID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockproposalService)(nil).Decline), ctx, eventID, proposalID, ownerID)
+}
+
+// ListProposals mocks base method.
+func (m *MockproposalService) ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProposals", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProposals indicates an expected call of ListProposals.
+func (mr *MockproposalServiceMockRecorder) ListProposals(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProposals", reflect.TypeOf((*MockproposalService)(nil).ListProposals), ctx, eventID, userID)
+}
+
+// Propose mocks base method.
+func (m *MockproposalService) Propose(ctx context.Context, p model.Proposal) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Propose", ctx, p)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Propose indicates an expected call of Propose.
+func (mr *MockproposalServiceMockRecorder) Propose(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Propose", reflect.TypeOf((*MockproposalService)(nil).Propose), ctx, p)
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockreminderService is a mock of reminderService interface.
+type MockreminderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreminderServiceMockRecorder
+}
+
+// MockreminderServiceMockRecorder is the mock recorder for MockreminderService.
+type MockreminderServiceMockRecorder struct {
+	mock *MockreminderService
+}
+
+// NewMockreminderService creates a new mock instance.
+func NewMockreminderService(ctrl *gomock.Controller) *MockreminderService {
+	mock := &MockreminderService{ctrl: ctrl}
+	mock.recorder = &MockreminderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreminderService) EXPECT() *MockreminderServiceMockRecorder {
+	return m.recorder
+}
+
+// CountHistory mocks base method.
+func (m *MockreminderService) CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHistory", ctx, userID, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountHistory indicates an expected call of CountHistory.
+func (mr *MockreminderServiceMockRecorder) CountHistory(ctx, userID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHistory", reflect.TypeOf((*MockreminderService)(nil).CountHistory), ctx, userID, mode)
+}
+
+// DeleteHistory mocks base method.
+func (m *MockreminderService) DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistory", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHistory indicates an expected call of DeleteHistory.
+func (mr *MockreminderServiceMockRecorder) DeleteHistory(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistory", reflect.TypeOf((*MockreminderService)(nil).DeleteHistory), ctx, userID)
+}
+
+// DeleteHistoryEntry mocks base method.
+func (m *MockreminderService) DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistoryEntry", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistoryEntry indicates an expected call of DeleteHistoryEntry.
+func (mr *MockreminderServiceMockRecorder) DeleteHistoryEntry(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryEntry", reflect.TypeOf((*MockreminderService)(nil).DeleteHistoryEntry), ctx, id, userID)
+}
+
+// ListHistory mocks base method.
+func (m *MockreminderService) ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", ctx, userID, page)
+	ret0, _ := ret[0].([]model.ReminderHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockreminderServiceMockRecorder) ListHistory(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockreminderService)(nil).ListHistory), ctx, userID, page)
+}
+
+// SendTest mocks base method.
+func (m *MockreminderService) SendTest(ctx context.Context, userID uuid.UUID, channel string) (model.TestNotification, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTest", ctx, userID, channel)
+	ret0, _ := ret[0].(model.TestNotification)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SendTest indicates an expected call of SendTest.
+func (mr *MockreminderServiceMockRecorder) SendTest(ctx, userID, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTest", reflect.TypeOf((*MockreminderService)(nil).SendTest), ctx, userID, channel)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockreportService is a mock of reportService interface.
+type MockreportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreportServiceMockRecorder
+}
+
+// MockreportServiceMockRecorder is the mock recorder for MockreportService.
+type MockreportServiceMockRecorder struct {
+	mock *MockreportService
+}
+
+// NewMockreportService creates a new mock instance.
+func NewMockreportService(ctrl *gomock.Controller) *MockreportService {
+	mock := &MockreportService{ctrl: ctrl}
+	mock.recorder = &MockreportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreportService) EXPECT() *MockreportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMeetingReport mocks base method.
+func (m *MockreportService) GetMeetingReport(ctx context.Context, groupID, ownerID uuid.UUID, weeks int) (model.MeetingReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetingReport", ctx, groupID, ownerID, weeks)
+	ret0, _ := ret[0].(model.MeetingReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetingReport indicates an expected call of GetMeetingReport.
+func (mr *MockreportServiceMockRecorder) GetMeetingReport(ctx, groupID, ownerID, weeks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetingReport", reflect.TypeOf((*MockreportService)(nil).GetMeetingReport), ctx, groupID, ownerID, weeks)
+}
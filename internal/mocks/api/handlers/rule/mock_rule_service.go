@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockruleService is a mock of ruleService interface.
+type MockruleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockruleServiceMockRecorder
+}
+
+// MockruleServiceMockRecorder is the mock recorder for MockruleService.
+type MockruleServiceMockRecorder struct {
+	mock *MockruleService
+}
+
+// NewMockruleService creates a new mock instance.
+func NewMockruleService(ctrl *gomock.Controller) *MockruleService {
+	mock := &MockruleService{ctrl: ctrl}
+	mock.recorder = &MockruleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockruleService) EXPECT() *MockruleServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRule mocks base method.
+func (m *MockruleService) CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, rule)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockruleServiceMockRecorder) CreateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockruleService)(nil).CreateRule), ctx, rule)
+}
+
+// DeleteRule mocks base method.
+func (m *MockruleService) DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, ruleID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockruleServiceMockRecorder) DeleteRule(ctx, ruleID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockruleService)(nil).DeleteRule), ctx, ruleID, userID)
+}
+
+// ListRules mocks base method.
+func (m *MockruleService) ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, userID)
+	ret0, _ := ret[0].([]model.EventRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockruleServiceMockRecorder) ListRules(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockruleService)(nil).ListRules), ctx, userID)
+}
+
+// Preview mocks base method.
+func (m *MockruleService) Preview(ctx context.Context, rule model.EventRule, from, to *time.Time) ([]model.RulePreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx, rule, from, to)
+	ret0, _ := ret[0].([]model.RulePreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockruleServiceMockRecorder) Preview(ctx, rule, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockruleService)(nil).Preview), ctx, rule, from, to)
+}
+
+// UpdateRule mocks base method.
+func (m *MockruleService) UpdateRule(ctx context.Context, rule model.EventRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockruleServiceMockRecorder) UpdateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockruleService)(nil).UpdateRule), ctx, rule)
+}
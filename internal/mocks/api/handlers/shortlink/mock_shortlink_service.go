@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockshortLinkService is a mock of shortLinkService interface.
+type MockshortLinkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortLinkServiceMockRecorder
+}
+
+// MockshortLinkServiceMockRecorder is the mock recorder for MockshortLinkService.
+type MockshortLinkServiceMockRecorder struct {
+	mock *MockshortLinkService
+}
+
+// NewMockshortLinkService creates a new mock instance.
+func NewMockshortLinkService(ctrl *gomock.Controller) *MockshortLinkService {
+	mock := &MockshortLinkService{ctrl: ctrl}
+	mock.recorder = &MockshortLinkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortLinkService) EXPECT() *MockshortLinkServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateShortLink mocks base method.
+func (m *MockshortLinkService) CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShortLink", ctx, link)
+	ret0, _ := ret[0].(model.ShortLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShortLink indicates an expected call of CreateShortLink.
+func (mr *MockshortLinkServiceMockRecorder) CreateShortLink(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShortLink", reflect.TypeOf((*MockshortLinkService)(nil).CreateShortLink), ctx, link)
+}
+
+// ListShortLinks mocks base method.
+func (m *MockshortLinkService) ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShortLinks", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.ShortLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShortLinks indicates an expected call of ListShortLinks.
+func (mr *MockshortLinkServiceMockRecorder) ListShortLinks(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShortLinks", reflect.TypeOf((*MockshortLinkService)(nil).ListShortLinks), ctx, eventID, userID)
+}
+
+// Resolve mocks base method.
+func (m *MockshortLinkService) Resolve(ctx context.Context, code string) (model.SharedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, code)
+	ret0, _ := ret[0].(model.SharedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockshortLinkServiceMockRecorder) Resolve(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockshortLinkService)(nil).Resolve), ctx, code)
+}
+
+// RevokeShortLink mocks base method.
+func (m *MockshortLinkService) RevokeShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShortLink", ctx, code, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShortLink indicates an expected call of RevokeShortLink.
+func (mr *MockshortLinkServiceMockRecorder) RevokeShortLink(ctx, code, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShortLink", reflect.TypeOf((*MockshortLinkService)(nil).RevokeShortLink), ctx, code, eventID, userID)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockmigrationService is a mock of migrationService interface.
+type MockmigrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmigrationServiceMockRecorder
+}
+
+// MockmigrationServiceMockRecorder is the mock recorder for MockmigrationService.
+type MockmigrationServiceMockRecorder struct {
+	mock *MockmigrationService
+}
+
+// NewMockmigrationService creates a new mock instance.
+func NewMockmigrationService(ctrl *gomock.Controller) *MockmigrationService {
+	mock := &MockmigrationService{ctrl: ctrl}
+	mock.recorder = &MockmigrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmigrationService) EXPECT() *MockmigrationServiceMockRecorder {
+	return m.recorder
+}
+
+// Start mocks base method.
+func (m *MockmigrationService) Start(ctx context.Context, userID uuid.UUID, req model.TimezoneMigration) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, userID, req)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockmigrationServiceMockRecorder) Start(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockmigrationService)(nil).Start), ctx, userID, req)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockusageService is a mock of usageService interface.
+type MockusageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockusageServiceMockRecorder
+}
+
+// MockusageServiceMockRecorder is the mock recorder for MockusageService.
+type MockusageServiceMockRecorder struct {
+	mock *MockusageService
+}
+
+// NewMockusageService creates a new mock instance.
+func NewMockusageService(ctrl *gomock.Controller) *MockusageService {
+	mock := &MockusageService{ctrl: ctrl}
+	mock.recorder = &MockusageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusageService) EXPECT() *MockusageServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockusageService) Get(ctx context.Context, userID uuid.UUID) (model.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(model.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockusageServiceMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockusageService)(nil).Get), ctx, userID)
+}
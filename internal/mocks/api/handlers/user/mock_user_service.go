@@ -10,6 +10,8 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
 )
 
 // MockuserService is a mock of userService interface.
@@ -35,6 +37,21 @@ func (m *MockuserService) EXPECT() *MockuserServiceMockRecorder {
 	return m.recorder
 }
 
+// CountSecurityEvents mocks base method.
+func (m *MockuserService) CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSecurityEvents", ctx, userID, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSecurityEvents indicates an expected call of CountSecurityEvents.
+func (mr *MockuserServiceMockRecorder) CountSecurityEvents(ctx, userID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecurityEvents", reflect.TypeOf((*MockuserService)(nil).CountSecurityEvents), ctx, userID, mode)
+}
+
 // Create mocks base method.
 func (m *MockuserService) Create(ctx context.Context, email, name, password string) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -51,16 +68,74 @@ func (mr *MockuserServiceMockRecorder) Create(ctx, email, name, password interfa
 }
 
 // GetByEmail mocks base method.
-func (m *MockuserService) GetByEmail(ctx context.Context, email, password string) (string, error) {
+func (m *MockuserService) GetByEmail(ctx context.Context, email, password string, client model.ClientInfo) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByEmail", ctx, email, password)
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email, password, client)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByEmail indicates an expected call of GetByEmail.
-func (mr *MockuserServiceMockRecorder) GetByEmail(ctx, email, password interface{}) *gomock.Call {
+func (mr *MockuserServiceMockRecorder) GetByEmail(ctx, email, password, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockuserService)(nil).GetByEmail), ctx, email, password, client)
+}
+
+// GetByID mocks base method.
+func (m *MockuserService) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserService)(nil).GetByID), ctx, id)
+}
+
+// ListSecurityEvents mocks base method.
+func (m *MockuserService) ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityEvents", ctx, userID, page)
+	ret0, _ := ret[0].([]model.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityEvents indicates an expected call of ListSecurityEvents.
+func (mr *MockuserServiceMockRecorder) ListSecurityEvents(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityEvents", reflect.TypeOf((*MockuserService)(nil).ListSecurityEvents), ctx, userID, page)
+}
+
+// SetSearchLanguage mocks base method.
+func (m *MockuserService) SetSearchLanguage(ctx context.Context, id uuid.UUID, language string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSearchLanguage", ctx, id, language)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSearchLanguage indicates an expected call of SetSearchLanguage.
+func (mr *MockuserServiceMockRecorder) SetSearchLanguage(ctx, id, language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSearchLanguage", reflect.TypeOf((*MockuserService)(nil).SetSearchLanguage), ctx, id, language)
+}
+
+// SetTimezone mocks base method.
+func (m *MockuserService) SetTimezone(ctx context.Context, id uuid.UUID, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimezone", ctx, id, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTimezone indicates an expected call of SetTimezone.
+func (mr *MockuserServiceMockRecorder) SetTimezone(ctx, id, timezone interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockuserService)(nil).GetByEmail), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimezone", reflect.TypeOf((*MockuserService)(nil).SetTimezone), ctx, id, timezone)
 }
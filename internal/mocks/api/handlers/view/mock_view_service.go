@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockviewService is a mock of viewService interface.
+type MockviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockviewServiceMockRecorder
+}
+
+// MockviewServiceMockRecorder is the mock recorder for MockviewService.
+type MockviewServiceMockRecorder struct {
+	mock *MockviewService
+}
+
+// NewMockviewService creates a new mock instance.
+func NewMockviewService(ctrl *gomock.Controller) *MockviewService {
+	mock := &MockviewService{ctrl: ctrl}
+	mock.recorder = &MockviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockviewService) EXPECT() *MockviewServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateView mocks base method.
+func (m *MockviewService) CreateView(ctx context.Context, view model.View) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *MockviewServiceMockRecorder) CreateView(ctx, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*MockviewService)(nil).CreateView), ctx, view)
+}
+
+// DeleteView mocks base method.
+func (m *MockviewService) DeleteView(ctx context.Context, viewID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteView", ctx, viewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteView indicates an expected call of DeleteView.
+func (mr *MockviewServiceMockRecorder) DeleteView(ctx, viewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*MockviewService)(nil).DeleteView), ctx, viewID, userID)
+}
+
+// GetViewEvents mocks base method.
+func (m *MockviewService) GetViewEvents(ctx context.Context, viewID, userID uuid.UUID) (model.View, []model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewEvents", ctx, viewID, userID)
+	ret0, _ := ret[0].(model.View)
+	ret1, _ := ret[1].([]model.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetViewEvents indicates an expected call of GetViewEvents.
+func (mr *MockviewServiceMockRecorder) GetViewEvents(ctx, viewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewEvents", reflect.TypeOf((*MockviewService)(nil).GetViewEvents), ctx, viewID, userID)
+}
+
+// ListViews mocks base method.
+func (m *MockviewService) ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListViews", ctx, userID)
+	ret0, _ := ret[0].([]model.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListViews indicates an expected call of ListViews.
+func (mr *MockviewServiceMockRecorder) ListViews(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListViews", reflect.TypeOf((*MockviewService)(nil).ListViews), ctx, userID)
+}
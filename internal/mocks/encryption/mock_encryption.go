@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: encryption.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockkeyStore is a mock of keyStore interface.
+type MockkeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyStoreMockRecorder
+}
+
+// MockkeyStoreMockRecorder is the mock recorder for MockkeyStore.
+type MockkeyStoreMockRecorder struct {
+	mock *MockkeyStore
+}
+
+// NewMockkeyStore creates a new mock instance.
+func NewMockkeyStore(ctrl *gomock.Controller) *MockkeyStore {
+	mock := &MockkeyStore{ctrl: ctrl}
+	mock.recorder = &MockkeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyStore) EXPECT() *MockkeyStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockkeyStore) CreateKey(ctx context.Context, userID uuid.UUID, wrapped []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, userID, wrapped)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockkeyStoreMockRecorder) CreateKey(ctx, userID, wrapped interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockkeyStore)(nil).CreateKey), ctx, userID, wrapped)
+}
+
+// GetKey mocks base method.
+func (m *MockkeyStore) GetKey(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", ctx, userID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockkeyStoreMockRecorder) GetKey(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockkeyStore)(nil).GetKey), ctx, userID)
+}
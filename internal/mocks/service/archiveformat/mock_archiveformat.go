@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockarchiveRepo is a mock of archiveRepo interface.
+type MockarchiveRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockarchiveRepoMockRecorder
+}
+
+// MockarchiveRepoMockRecorder is the mock recorder for MockarchiveRepo.
+type MockarchiveRepoMockRecorder struct {
+	mock *MockarchiveRepo
+}
+
+// NewMockarchiveRepo creates a new mock instance.
+func NewMockarchiveRepo(ctrl *gomock.Controller) *MockarchiveRepo {
+	mock := &MockarchiveRepo{ctrl: ctrl}
+	mock.recorder = &MockarchiveRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarchiveRepo) EXPECT() *MockarchiveRepoMockRecorder {
+	return m.recorder
+}
+
+// BackfillArchivedReminders mocks base method.
+func (m *MockarchiveRepo) BackfillArchivedReminders(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillArchivedReminders", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillArchivedReminders indicates an expected call of BackfillArchivedReminders.
+func (mr *MockarchiveRepoMockRecorder) BackfillArchivedReminders(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillArchivedReminders", reflect.TypeOf((*MockarchiveRepo)(nil).BackfillArchivedReminders), ctx, limit)
+}
+
+// CompareArchivedReminders mocks base method.
+func (m *MockarchiveRepo) CompareArchivedReminders(ctx context.Context, after uuid.UUID, limit int) ([]model.ArchiveFormatCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareArchivedReminders", ctx, after, limit)
+	ret0, _ := ret[0].([]model.ArchiveFormatCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareArchivedReminders indicates an expected call of CompareArchivedReminders.
+func (mr *MockarchiveRepoMockRecorder) CompareArchivedReminders(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareArchivedReminders", reflect.TypeOf((*MockarchiveRepo)(nil).CompareArchivedReminders), ctx, after, limit)
+}
+
+// CountArchivedEvents mocks base method.
+func (m *MockarchiveRepo) CountArchivedEvents(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountArchivedEvents", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountArchivedEvents indicates an expected call of CountArchivedEvents.
+func (mr *MockarchiveRepoMockRecorder) CountArchivedEvents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountArchivedEvents", reflect.TypeOf((*MockarchiveRepo)(nil).CountArchivedEvents), ctx)
+}
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockjobService) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockjobServiceMockRecorder) Enqueue(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockjobService)(nil).Enqueue), ctx, job)
+}
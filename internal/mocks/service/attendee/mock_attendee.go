@@ -0,0 +1,327 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockattendeeRepo is a mock of attendeeRepo interface.
+type MockattendeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockattendeeRepoMockRecorder
+}
+
+// MockattendeeRepoMockRecorder is the mock recorder for MockattendeeRepo.
+type MockattendeeRepoMockRecorder struct {
+	mock *MockattendeeRepo
+}
+
+// NewMockattendeeRepo creates a new mock instance.
+func NewMockattendeeRepo(ctrl *gomock.Controller) *MockattendeeRepo {
+	mock := &MockattendeeRepo{ctrl: ctrl}
+	mock.recorder = &MockattendeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattendeeRepo) EXPECT() *MockattendeeRepoMockRecorder {
+	return m.recorder
+}
+
+// CanView mocks base method.
+func (m *MockattendeeRepo) CanView(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanView", ctx, eventID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanView indicates an expected call of CanView.
+func (mr *MockattendeeRepoMockRecorder) CanView(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanView", reflect.TypeOf((*MockattendeeRepo)(nil).CanView), ctx, eventID, userID)
+}
+
+// Invite mocks base method.
+func (m *MockattendeeRepo) Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, eventID, ownerID, email)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockattendeeRepoMockRecorder) Invite(ctx, eventID, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockattendeeRepo)(nil).Invite), ctx, eventID, ownerID, email)
+}
+
+// InviteExternal mocks base method.
+func (m *MockattendeeRepo) InviteExternal(ctx context.Context, eventID, ownerID uuid.UUID, email, tokenHash string) (model.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteExternal", ctx, eventID, ownerID, email, tokenHash)
+	ret0, _ := ret[0].(model.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteExternal indicates an expected call of InviteExternal.
+func (mr *MockattendeeRepoMockRecorder) InviteExternal(ctx, eventID, ownerID, email, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteExternal", reflect.TypeOf((*MockattendeeRepo)(nil).InviteExternal), ctx, eventID, ownerID, email, tokenHash)
+}
+
+// ListAttendees mocks base method.
+func (m *MockattendeeRepo) ListAttendees(ctx context.Context, eventID uuid.UUID) ([]model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendees", ctx, eventID)
+	ret0, _ := ret[0].([]model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendees indicates an expected call of ListAttendees.
+func (mr *MockattendeeRepoMockRecorder) ListAttendees(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendees", reflect.TypeOf((*MockattendeeRepo)(nil).ListAttendees), ctx, eventID)
+}
+
+// ListGuests mocks base method.
+func (m *MockattendeeRepo) ListGuests(ctx context.Context, eventID uuid.UUID) ([]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuests", ctx, eventID)
+	ret0, _ := ret[0].([]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuests indicates an expected call of ListGuests.
+func (mr *MockattendeeRepoMockRecorder) ListGuests(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuests", reflect.TypeOf((*MockattendeeRepo)(nil).ListGuests), ctx, eventID)
+}
+
+// MarkLate mocks base method.
+func (m *MockattendeeRepo) MarkLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.LateNotice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLate", ctx, eventID, userID, eta)
+	ret0, _ := ret[0].(model.LateNotice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLate indicates an expected call of MarkLate.
+func (mr *MockattendeeRepoMockRecorder) MarkLate(ctx, eventID, userID, eta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLate", reflect.TypeOf((*MockattendeeRepo)(nil).MarkLate), ctx, eventID, userID, eta)
+}
+
+// RemoveAttendee mocks base method.
+func (m *MockattendeeRepo) RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttendee", ctx, eventID, ownerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttendee indicates an expected call of RemoveAttendee.
+func (mr *MockattendeeRepoMockRecorder) RemoveAttendee(ctx, eventID, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttendee", reflect.TypeOf((*MockattendeeRepo)(nil).RemoveAttendee), ctx, eventID, ownerID, userID)
+}
+
+// RequestGuestRSVP mocks base method.
+func (m *MockattendeeRepo) RequestGuestRSVP(ctx context.Context, code, email, tokenHash string, expiresAt, now, resendAfter time.Time) (model.GuestRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGuestRSVP", ctx, code, email, tokenHash, expiresAt, now, resendAfter)
+	ret0, _ := ret[0].(model.GuestRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestGuestRSVP indicates an expected call of RequestGuestRSVP.
+func (mr *MockattendeeRepoMockRecorder) RequestGuestRSVP(ctx, code, email, tokenHash, expiresAt, now, resendAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGuestRSVP", reflect.TypeOf((*MockattendeeRepo)(nil).RequestGuestRSVP), ctx, code, email, tokenHash, expiresAt, now, resendAfter)
+}
+
+// Respond mocks base method.
+func (m *MockattendeeRepo) Respond(ctx context.Context, eventID, userID uuid.UUID, status string) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", ctx, eventID, userID, status)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockattendeeRepoMockRecorder) Respond(ctx, eventID, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockattendeeRepo)(nil).Respond), ctx, eventID, userID, status)
+}
+
+// RespondExternal mocks base method.
+func (m *MockattendeeRepo) RespondExternal(ctx context.Context, tokenHash, status string) (model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondExternal", ctx, tokenHash, status)
+	ret0, _ := ret[0].(model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondExternal indicates an expected call of RespondExternal.
+func (mr *MockattendeeRepoMockRecorder) RespondExternal(ctx, tokenHash, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondExternal", reflect.TypeOf((*MockattendeeRepo)(nil).RespondExternal), ctx, tokenHash, status)
+}
+
+// RespondGuest mocks base method.
+func (m *MockattendeeRepo) RespondGuest(ctx context.Context, tokenHash, status string, now time.Time) (model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondGuest", ctx, tokenHash, status, now)
+	ret0, _ := ret[0].(model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondGuest indicates an expected call of RespondGuest.
+func (mr *MockattendeeRepoMockRecorder) RespondGuest(ctx, tokenHash, status, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondGuest", reflect.TypeOf((*MockattendeeRepo)(nil).RespondGuest), ctx, tokenHash, status, now)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// MocklateNotifier is a mock of lateNotifier interface.
+type MocklateNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MocklateNotifierMockRecorder
+}
+
+// MocklateNotifierMockRecorder is the mock recorder for MocklateNotifier.
+type MocklateNotifierMockRecorder struct {
+	mock *MocklateNotifier
+}
+
+// NewMocklateNotifier creates a new mock instance.
+func NewMocklateNotifier(ctrl *gomock.Controller) *MocklateNotifier {
+	mock := &MocklateNotifier{ctrl: ctrl}
+	mock.recorder = &MocklateNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklateNotifier) EXPECT() *MocklateNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyLate mocks base method.
+func (m *MocklateNotifier) NotifyLate(ctx context.Context, recipients []model.Attendee, notice model.LateNotice) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyLate", ctx, recipients, notice)
+}
+
+// NotifyLate indicates an expected call of NotifyLate.
+func (mr *MocklateNotifierMockRecorder) NotifyLate(ctx, recipients, notice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyLate", reflect.TypeOf((*MocklateNotifier)(nil).NotifyLate), ctx, recipients, notice)
+}
+
+// Recipients mocks base method.
+func (m *MocklateNotifier) Recipients(ctx context.Context, eventID uuid.UUID) []model.Attendee {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recipients", ctx, eventID)
+	ret0, _ := ret[0].([]model.Attendee)
+	return ret0
+}
+
+// Recipients indicates an expected call of Recipients.
+func (mr *MocklateNotifierMockRecorder) Recipients(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recipients", reflect.TypeOf((*MocklateNotifier)(nil).Recipients), ctx, eventID)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, to, subject, body)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockcalendarRepo is a mock of calendarRepo interface.
+type MockcalendarRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcalendarRepoMockRecorder
+}
+
+// MockcalendarRepoMockRecorder is the mock recorder for MockcalendarRepo.
+type MockcalendarRepoMockRecorder struct {
+	mock *MockcalendarRepo
+}
+
+// NewMockcalendarRepo creates a new mock instance.
+func NewMockcalendarRepo(ctrl *gomock.Controller) *MockcalendarRepo {
+	mock := &MockcalendarRepo{ctrl: ctrl}
+	mock.recorder = &MockcalendarRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcalendarRepo) EXPECT() *MockcalendarRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendar mocks base method.
+func (m *MockcalendarRepo) CreateCalendar(ctx context.Context, calendar model.Calendar) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockcalendarRepoMockRecorder) CreateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockcalendarRepo)(nil).CreateCalendar), ctx, calendar)
+}
+
+// DeleteCalendar mocks base method.
+func (m *MockcalendarRepo) DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendar", ctx, calendarID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendar indicates an expected call of DeleteCalendar.
+func (mr *MockcalendarRepoMockRecorder) DeleteCalendar(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendar", reflect.TypeOf((*MockcalendarRepo)(nil).DeleteCalendar), ctx, calendarID, userID)
+}
+
+// GetCalendar mocks base method.
+func (m *MockcalendarRepo) GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, calendarID, userID)
+	ret0, _ := ret[0].(model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockcalendarRepoMockRecorder) GetCalendar(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockcalendarRepo)(nil).GetCalendar), ctx, calendarID, userID)
+}
+
+// ListCalendars mocks base method.
+func (m *MockcalendarRepo) ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendars", ctx, userID)
+	ret0, _ := ret[0].([]model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendars indicates an expected call of ListCalendars.
+func (mr *MockcalendarRepoMockRecorder) ListCalendars(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendars", reflect.TypeOf((*MockcalendarRepo)(nil).ListCalendars), ctx, userID)
+}
+
+// UpdateCalendar mocks base method.
+func (m *MockcalendarRepo) UpdateCalendar(ctx context.Context, calendar model.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCalendar indicates an expected call of UpdateCalendar.
+func (mr *MockcalendarRepoMockRecorder) UpdateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendar", reflect.TypeOf((*MockcalendarRepo)(nil).UpdateCalendar), ctx, calendar)
+}
@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	json "encoding/json"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockcoldStorageRepo is a mock of coldStorageRepo interface.
+type MockcoldStorageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoldStorageRepoMockRecorder
+}
+
+// MockcoldStorageRepoMockRecorder is the mock recorder for MockcoldStorageRepo.
+type MockcoldStorageRepoMockRecorder struct {
+	mock *MockcoldStorageRepo
+}
+
+// NewMockcoldStorageRepo creates a new mock instance.
+func NewMockcoldStorageRepo(ctrl *gomock.Controller) *MockcoldStorageRepo {
+	mock := &MockcoldStorageRepo{ctrl: ctrl}
+	mock.recorder = &MockcoldStorageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoldStorageRepo) EXPECT() *MockcoldStorageRepoMockRecorder {
+	return m.recorder
+}
+
+// ListExportable mocks base method.
+func (m *MockcoldStorageRepo) ListExportable(ctx context.Context, before time.Time, limit int) ([]model.ColdEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExportable", ctx, before, limit)
+	ret0, _ := ret[0].([]model.ColdEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExportable indicates an expected call of ListExportable.
+func (mr *MockcoldStorageRepoMockRecorder) ListExportable(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExportable", reflect.TypeOf((*MockcoldStorageRepo)(nil).ListExportable), ctx, before, limit)
+}
+
+// ListObjects mocks base method.
+func (m *MockcoldStorageRepo) ListObjects(ctx context.Context, userID *uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockcoldStorageRepoMockRecorder) ListObjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockcoldStorageRepo)(nil).ListObjects), ctx, userID)
+}
+
+// MarkExported mocks base method.
+func (m *MockcoldStorageRepo) MarkExported(ctx context.Context, key string, ids []uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkExported", ctx, key, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkExported indicates an expected call of MarkExported.
+func (mr *MockcoldStorageRepoMockRecorder) MarkExported(ctx, key, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkExported", reflect.TypeOf((*MockcoldStorageRepo)(nil).MarkExported), ctx, key, ids)
+}
+
+// RestoreEvents mocks base method.
+func (m *MockcoldStorageRepo) RestoreEvents(ctx context.Context, key string, records []json.RawMessage, userID *uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEvents", ctx, key, records, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEvents indicates an expected call of RestoreEvents.
+func (mr *MockcoldStorageRepoMockRecorder) RestoreEvents(ctx, key, records, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEvents", reflect.TypeOf((*MockcoldStorageRepo)(nil).RestoreEvents), ctx, key, records, userID)
+}
+
+// MockobjectStore is a mock of objectStore interface.
+type MockobjectStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockobjectStoreMockRecorder
+}
+
+// MockobjectStoreMockRecorder is the mock recorder for MockobjectStore.
+type MockobjectStoreMockRecorder struct {
+	mock *MockobjectStore
+}
+
+// NewMockobjectStore creates a new mock instance.
+func NewMockobjectStore(ctrl *gomock.Controller) *MockobjectStore {
+	mock := &MockobjectStore{ctrl: ctrl}
+	mock.recorder = &MockobjectStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockobjectStore) EXPECT() *MockobjectStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockobjectStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockobjectStoreMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockobjectStore)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockobjectStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, data, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockobjectStoreMockRecorder) Put(ctx, key, data, contentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockobjectStore)(nil).Put), ctx, key, data, contentType)
+}
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockjobService) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockjobServiceMockRecorder) Enqueue(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockjobService)(nil).Enqueue), ctx, job)
+}
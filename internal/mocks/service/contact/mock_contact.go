@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockcontactRepo is a mock of contactRepo interface.
+type MockcontactRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontactRepoMockRecorder
+}
+
+// MockcontactRepoMockRecorder is the mock recorder for MockcontactRepo.
+type MockcontactRepoMockRecorder struct {
+	mock *MockcontactRepo
+}
+
+// NewMockcontactRepo creates a new mock instance.
+func NewMockcontactRepo(ctrl *gomock.Controller) *MockcontactRepo {
+	mock := &MockcontactRepo{ctrl: ctrl}
+	mock.recorder = &MockcontactRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontactRepo) EXPECT() *MockcontactRepoMockRecorder {
+	return m.recorder
+}
+
+// AddContact mocks base method.
+func (m *MockcontactRepo) AddContact(ctx context.Context, c model.Contact) (model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddContact", ctx, c)
+	ret0, _ := ret[0].(model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddContact indicates an expected call of AddContact.
+func (mr *MockcontactRepoMockRecorder) AddContact(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContact", reflect.TypeOf((*MockcontactRepo)(nil).AddContact), ctx, c)
+}
+
+// ListContacts mocks base method.
+func (m *MockcontactRepo) ListContacts(ctx context.Context, userID uuid.UUID, prefix string, limit int) ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, userID, prefix, limit)
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockcontactRepoMockRecorder) ListContacts(ctx, userID, prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockcontactRepo)(nil).ListContacts), ctx, userID, prefix, limit)
+}
+
+// RemoveContact mocks base method.
+func (m *MockcontactRepo) RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContact", ctx, contactID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContact indicates an expected call of RemoveContact.
+func (mr *MockcontactRepoMockRecorder) RemoveContact(ctx, contactID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContact", reflect.TypeOf((*MockcontactRepo)(nil).RemoveContact), ctx, contactID, userID)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockdelegateRepo is a mock of delegateRepo interface.
+type MockdelegateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockdelegateRepoMockRecorder
+}
+
+// MockdelegateRepoMockRecorder is the mock recorder for MockdelegateRepo.
+type MockdelegateRepoMockRecorder struct {
+	mock *MockdelegateRepo
+}
+
+// NewMockdelegateRepo creates a new mock instance.
+func NewMockdelegateRepo(ctrl *gomock.Controller) *MockdelegateRepo {
+	mock := &MockdelegateRepo{ctrl: ctrl}
+	mock.recorder = &MockdelegateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdelegateRepo) EXPECT() *MockdelegateRepoMockRecorder {
+	return m.recorder
+}
+
+// AddDelegate mocks base method.
+func (m *MockdelegateRepo) AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelegate", ctx, ownerID, email)
+	ret0, _ := ret[0].(model.Delegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDelegate indicates an expected call of AddDelegate.
+func (mr *MockdelegateRepoMockRecorder) AddDelegate(ctx, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegate", reflect.TypeOf((*MockdelegateRepo)(nil).AddDelegate), ctx, ownerID, email)
+}
+
+// IsDelegate mocks base method.
+func (m *MockdelegateRepo) IsDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDelegate", ctx, ownerID, delegateID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDelegate indicates an expected call of IsDelegate.
+func (mr *MockdelegateRepoMockRecorder) IsDelegate(ctx, ownerID, delegateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDelegate", reflect.TypeOf((*MockdelegateRepo)(nil).IsDelegate), ctx, ownerID, delegateID)
+}
+
+// ListDelegates mocks base method.
+func (m *MockdelegateRepo) ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelegates", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Delegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelegates indicates an expected call of ListDelegates.
+func (mr *MockdelegateRepoMockRecorder) ListDelegates(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelegates", reflect.TypeOf((*MockdelegateRepo)(nil).ListDelegates), ctx, ownerID)
+}
+
+// RemoveDelegate mocks base method.
+func (m *MockdelegateRepo) RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDelegate", ctx, ownerID, delegateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDelegate indicates an expected call of RemoveDelegate.
+func (mr *MockdelegateRepoMockRecorder) RemoveDelegate(ctx, ownerID, delegateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDelegate", reflect.TypeOf((*MockdelegateRepo)(nil).RemoveDelegate), ctx, ownerID, delegateID)
+}
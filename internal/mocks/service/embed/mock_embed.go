@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockembedRepo is a mock of embedRepo interface.
+type MockembedRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockembedRepoMockRecorder
+}
+
+// MockembedRepoMockRecorder is the mock recorder for MockembedRepo.
+type MockembedRepoMockRecorder struct {
+	mock *MockembedRepo
+}
+
+// NewMockembedRepo creates a new mock instance.
+func NewMockembedRepo(ctrl *gomock.Controller) *MockembedRepo {
+	mock := &MockembedRepo{ctrl: ctrl}
+	mock.recorder = &MockembedRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockembedRepo) EXPECT() *MockembedRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateEmbed mocks base method.
+func (m *MockembedRepo) CreateEmbed(ctx context.Context, embed model.Embed, tokenHash string) (model.Embed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmbed", ctx, embed, tokenHash)
+	ret0, _ := ret[0].(model.Embed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmbed indicates an expected call of CreateEmbed.
+func (mr *MockembedRepoMockRecorder) CreateEmbed(ctx, embed, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmbed", reflect.TypeOf((*MockembedRepo)(nil).CreateEmbed), ctx, embed, tokenHash)
+}
+
+// DeleteEmbed mocks base method.
+func (m *MockembedRepo) DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmbed", ctx, embedID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmbed indicates an expected call of DeleteEmbed.
+func (mr *MockembedRepoMockRecorder) DeleteEmbed(ctx, embedID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmbed", reflect.TypeOf((*MockembedRepo)(nil).DeleteEmbed), ctx, embedID, userID)
+}
+
+// GetEmbedByTokenHash mocks base method.
+func (m *MockembedRepo) GetEmbedByTokenHash(ctx context.Context, tokenHash string) (model.Embed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmbedByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(model.Embed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmbedByTokenHash indicates an expected call of GetEmbedByTokenHash.
+func (mr *MockembedRepoMockRecorder) GetEmbedByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmbedByTokenHash", reflect.TypeOf((*MockembedRepo)(nil).GetEmbedByTokenHash), ctx, tokenHash)
+}
+
+// ListEmbeds mocks base method.
+func (m *MockembedRepo) ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmbeds", ctx, userID)
+	ret0, _ := ret[0].([]model.Embed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmbeds indicates an expected call of ListEmbeds.
+func (mr *MockembedRepoMockRecorder) ListEmbeds(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmbeds", reflect.TypeOf((*MockembedRepo)(nil).ListEmbeds), ctx, userID)
+}
+
+// MockeventLister is a mock of eventLister interface.
+type MockeventLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventListerMockRecorder
+}
+
+// MockeventListerMockRecorder is the mock recorder for MockeventLister.
+type MockeventListerMockRecorder struct {
+	mock *MockeventLister
+}
+
+// NewMockeventLister creates a new mock instance.
+func NewMockeventLister(ctrl *gomock.Controller) *MockeventLister {
+	mock := &MockeventLister{ctrl: ctrl}
+	mock.recorder = &MockeventListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventLister) EXPECT() *MockeventListerMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockeventLister) ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, filter)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockeventListerMockRecorder) ListEvents(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockeventLister)(nil).ListEvents), ctx, userID, filter)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
@@ -38,6 +38,82 @@ func (m *MockeventRepo) EXPECT() *MockeventRepoMockRecorder {
 	return m.recorder
 }
 
+// ArchiveOldEvents mocks base method.
+func (m *MockeventRepo) ArchiveOldEvents(ctx context.Context, limit int, dualWrite bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveOldEvents", ctx, limit, dualWrite)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveOldEvents indicates an expected call of ArchiveOldEvents.
+func (mr *MockeventRepoMockRecorder) ArchiveOldEvents(ctx, limit, dualWrite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveOldEvents", reflect.TypeOf((*MockeventRepo)(nil).ArchiveOldEvents), ctx, limit, dualWrite)
+}
+
+// ClaimTrashNotices mocks base method.
+func (m *MockeventRepo) ClaimTrashNotices(ctx context.Context, retention, notice time.Duration, limit int) ([]model.TrashNotice, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTrashNotices", ctx, retention, notice, limit)
+	ret0, _ := ret[0].([]model.TrashNotice)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ClaimTrashNotices indicates an expected call of ClaimTrashNotices.
+func (mr *MockeventRepoMockRecorder) ClaimTrashNotices(ctx, retention, notice, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTrashNotices", reflect.TypeOf((*MockeventRepo)(nil).ClaimTrashNotices), ctx, retention, notice, limit)
+}
+
+// CountEvents mocks base method.
+func (m *MockeventRepo) CountEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEvents", ctx, userID, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEvents indicates an expected call of CountEvents.
+func (mr *MockeventRepoMockRecorder) CountEvents(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEvents", reflect.TypeOf((*MockeventRepo)(nil).CountEvents), ctx, userID, filter)
+}
+
+// CountLinkOrderViolations mocks base method.
+func (m *MockeventRepo) CountLinkOrderViolations(ctx context.Context, eventID uuid.UUID, date time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLinkOrderViolations", ctx, eventID, date)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLinkOrderViolations indicates an expected call of CountLinkOrderViolations.
+func (mr *MockeventRepoMockRecorder) CountLinkOrderViolations(ctx, eventID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLinkOrderViolations", reflect.TypeOf((*MockeventRepo)(nil).CountLinkOrderViolations), ctx, eventID, date)
+}
+
+// CountSearchResults mocks base method.
+func (m *MockeventRepo) CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchResults", ctx, userID, search, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchResults indicates an expected call of CountSearchResults.
+func (mr *MockeventRepoMockRecorder) CountSearchResults(ctx, userID, search, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchResults", reflect.TypeOf((*MockeventRepo)(nil).CountSearchResults), ctx, userID, search, mode)
+}
+
 // CreateEvent mocks base method.
 func (m *MockeventRepo) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +129,20 @@ func (mr *MockeventRepoMockRecorder) CreateEvent(ctx, event interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventRepo)(nil).CreateEvent), ctx, event)
 }
 
+// CreateLink mocks base method.
+func (m *MockeventRepo) CreateLink(ctx context.Context, link model.EventLink, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLink", ctx, link, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLink indicates an expected call of CreateLink.
+func (mr *MockeventRepoMockRecorder) CreateLink(ctx, link, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*MockeventRepo)(nil).CreateLink), ctx, link, userID)
+}
+
 // DeleteEvent mocks base method.
 func (m *MockeventRepo) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -67,49 +157,345 @@ func (mr *MockeventRepoMockRecorder) DeleteEvent(ctx, eventID, userID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventRepo)(nil).DeleteEvent), ctx, eventID, userID)
 }
 
+// DeleteEvents mocks base method.
+func (m *MockeventRepo) DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvents", ctx, userID, eventIDs)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvents indicates an expected call of DeleteEvents.
+func (mr *MockeventRepoMockRecorder) DeleteEvents(ctx, userID, eventIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockeventRepo)(nil).DeleteEvents), ctx, userID, eventIDs)
+}
+
+// DeleteEventsInRange mocks base method.
+func (m *MockeventRepo) DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventsInRange", ctx, userID, from, to, calendarID)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventsInRange indicates an expected call of DeleteEventsInRange.
+func (mr *MockeventRepoMockRecorder) DeleteEventsInRange(ctx, userID, from, to, calendarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventsInRange", reflect.TypeOf((*MockeventRepo)(nil).DeleteEventsInRange), ctx, userID, from, to, calendarID)
+}
+
+// DeleteLink mocks base method.
+func (m *MockeventRepo) DeleteLink(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLink", ctx, eventID, relatedEventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLink indicates an expected call of DeleteLink.
+func (mr *MockeventRepoMockRecorder) DeleteLink(ctx, eventID, relatedEventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLink", reflect.TypeOf((*MockeventRepo)(nil).DeleteLink), ctx, eventID, relatedEventID, userID)
+}
+
+// DeleteOverride mocks base method.
+func (m *MockeventRepo) DeleteOverride(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOverride", ctx, eventID, userID, occurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOverride indicates an expected call of DeleteOverride.
+func (mr *MockeventRepoMockRecorder) DeleteOverride(ctx, eventID, userID, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOverride", reflect.TypeOf((*MockeventRepo)(nil).DeleteOverride), ctx, eventID, userID, occurrence)
+}
+
+// DetachOccurrence mocks base method.
+func (m *MockeventRepo) DetachOccurrence(ctx context.Context, seriesID uuid.UUID, occurrence time.Time, event model.Event) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachOccurrence", ctx, seriesID, occurrence, event)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachOccurrence indicates an expected call of DetachOccurrence.
+func (mr *MockeventRepoMockRecorder) DetachOccurrence(ctx, seriesID, occurrence, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachOccurrence", reflect.TypeOf((*MockeventRepo)(nil).DetachOccurrence), ctx, seriesID, occurrence, event)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockeventRepo) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockeventRepoMockRecorder) EmptyTrash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockeventRepo)(nil).EmptyTrash), ctx, userID)
+}
+
+// EventExists mocks base method.
+func (m *MockeventRepo) EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockeventRepoMockRecorder) EventExists(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockeventRepo)(nil).EventExists), ctx, eventID, userID)
+}
+
+// ExcludeOccurrence mocks base method.
+func (m *MockeventRepo) ExcludeOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExcludeOccurrence", ctx, eventID, userID, occurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExcludeOccurrence indicates an expected call of ExcludeOccurrence.
+func (mr *MockeventRepoMockRecorder) ExcludeOccurrence(ctx, eventID, userID, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExcludeOccurrence", reflect.TypeOf((*MockeventRepo)(nil).ExcludeOccurrence), ctx, eventID, userID, occurrence)
+}
+
+// GetAttendeeEvents mocks base method.
+func (m *MockeventRepo) GetAttendeeEvents(ctx context.Context, eventID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendeeEvents", ctx, eventID, from, to)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendeeEvents indicates an expected call of GetAttendeeEvents.
+func (mr *MockeventRepoMockRecorder) GetAttendeeEvents(ctx, eventID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendeeEvents", reflect.TypeOf((*MockeventRepo)(nil).GetAttendeeEvents), ctx, eventID, from, to)
+}
+
+// GetEvent mocks base method.
+func (m *MockeventRepo) GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockeventRepoMockRecorder) GetEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockeventRepo)(nil).GetEvent), ctx, eventID, userID)
+}
+
 // GetEventsForDay mocks base method.
-func (m *MockeventRepo) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventRepo) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForDay", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForDay", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForDay indicates an expected call of GetEventsForDay.
-func (mr *MockeventRepoMockRecorder) GetEventsForDay(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventRepoMockRecorder) GetEventsForDay(ctx, userID, date, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForDay", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForDay), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForDay", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForDay), ctx, userID, date, opts)
 }
 
 // GetEventsForMonth mocks base method.
-func (m *MockeventRepo) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventRepo) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForMonth", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForMonth", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForMonth indicates an expected call of GetEventsForMonth.
-func (mr *MockeventRepoMockRecorder) GetEventsForMonth(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventRepoMockRecorder) GetEventsForMonth(ctx, userID, date, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForMonth", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForMonth), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForMonth", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForMonth), ctx, userID, date, opts)
 }
 
 // GetEventsForWeek mocks base method.
-func (m *MockeventRepo) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+func (m *MockeventRepo) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsForWeek", ctx, userID, date)
+	ret := m.ctrl.Call(m, "GetEventsForWeek", ctx, userID, date, opts)
 	ret0, _ := ret[0].([]model.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsForWeek indicates an expected call of GetEventsForWeek.
-func (mr *MockeventRepoMockRecorder) GetEventsForWeek(ctx, userID, date interface{}) *gomock.Call {
+func (mr *MockeventRepoMockRecorder) GetEventsForWeek(ctx, userID, date, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForWeek", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForWeek), ctx, userID, date, opts)
+}
+
+// GetEventsInRange mocks base method.
+func (m *MockeventRepo) GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsInRange", ctx, userID, from, to, opts)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsInRange indicates an expected call of GetEventsInRange.
+func (mr *MockeventRepoMockRecorder) GetEventsInRange(ctx, userID, from, to, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsInRange", reflect.TypeOf((*MockeventRepo)(nil).GetEventsInRange), ctx, userID, from, to, opts)
+}
+
+// GetRelatedEvents mocks base method.
+func (m *MockeventRepo) GetRelatedEvents(ctx context.Context, eventID uuid.UUID) ([]model.RelatedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedEvents", ctx, eventID)
+	ret0, _ := ret[0].([]model.RelatedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedEvents indicates an expected call of GetRelatedEvents.
+func (mr *MockeventRepoMockRecorder) GetRelatedEvents(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedEvents", reflect.TypeOf((*MockeventRepo)(nil).GetRelatedEvents), ctx, eventID)
+}
+
+// ListOverrides mocks base method.
+func (m *MockeventRepo) ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverrides", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.OccurrenceOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverrides indicates an expected call of ListOverrides.
+func (mr *MockeventRepoMockRecorder) ListOverrides(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverrides", reflect.TypeOf((*MockeventRepo)(nil).ListOverrides), ctx, eventID, userID)
+}
+
+// ListTrash mocks base method.
+func (m *MockeventRepo) ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, userID, page)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockeventRepoMockRecorder) ListTrash(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockeventRepo)(nil).ListTrash), ctx, userID, page)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockeventRepo) PurgeTrash(ctx context.Context, retention, notice time.Duration, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx, retention, notice, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockeventRepoMockRecorder) PurgeTrash(ctx, retention, notice, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForWeek", reflect.TypeOf((*MockeventRepo)(nil).GetEventsForWeek), ctx, userID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockeventRepo)(nil).PurgeTrash), ctx, retention, notice, limit)
+}
+
+// RestoreEvent mocks base method.
+func (m *MockeventRepo) RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEvent indicates an expected call of RestoreEvent.
+func (mr *MockeventRepoMockRecorder) RestoreEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEvent", reflect.TypeOf((*MockeventRepo)(nil).RestoreEvent), ctx, eventID, userID)
+}
+
+// RestoreTrash mocks base method.
+func (m *MockeventRepo) RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTrash", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTrash indicates an expected call of RestoreTrash.
+func (mr *MockeventRepoMockRecorder) RestoreTrash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrash", reflect.TypeOf((*MockeventRepo)(nil).RestoreTrash), ctx, userID)
+}
+
+// SearchEvents mocks base method.
+func (m *MockeventRepo) SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", ctx, userID, search, page)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockeventRepoMockRecorder) SearchEvents(ctx, userID, search, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockeventRepo)(nil).SearchEvents), ctx, userID, search, page)
+}
+
+// SetOverride mocks base method.
+func (m *MockeventRepo) SetOverride(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverride", ctx, eventID, userID, override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOverride indicates an expected call of SetOverride.
+func (mr *MockeventRepoMockRecorder) SetOverride(ctx, eventID, userID, override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverride", reflect.TypeOf((*MockeventRepo)(nil).SetOverride), ctx, eventID, userID, override)
+}
+
+// SuggestTitles mocks base method.
+func (m *MockeventRepo) SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTitles", ctx, userID, prefix, strict, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTitles indicates an expected call of SuggestTitles.
+func (mr *MockeventRepoMockRecorder) SuggestTitles(ctx, userID, prefix, strict, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTitles", reflect.TypeOf((*MockeventRepo)(nil).SuggestTitles), ctx, userID, prefix, strict, limit)
 }
 
 // UpdateEvent mocks base method.
@@ -125,3 +511,166 @@ func (mr *MockeventRepoMockRecorder) UpdateEvent(ctx, event interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventRepo)(nil).UpdateEvent), ctx, event)
 }
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Encrypt mocks base method.
+func (m *MockcontentCipher) Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, userID, plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockcontentCipherMockRecorder) Encrypt(ctx, userID, plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockcontentCipher)(nil).Encrypt), ctx, userID, plaintext)
+}
+
+// MockruleEngine is a mock of ruleEngine interface.
+type MockruleEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockruleEngineMockRecorder
+}
+
+// MockruleEngineMockRecorder is the mock recorder for MockruleEngine.
+type MockruleEngineMockRecorder struct {
+	mock *MockruleEngine
+}
+
+// NewMockruleEngine creates a new mock instance.
+func NewMockruleEngine(ctrl *gomock.Controller) *MockruleEngine {
+	mock := &MockruleEngine{ctrl: ctrl}
+	mock.recorder = &MockruleEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockruleEngine) EXPECT() *MockruleEngineMockRecorder {
+	return m.recorder
+}
+
+// ApplyRules mocks base method.
+func (m *MockruleEngine) ApplyRules(ctx context.Context, event *model.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyRules", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyRules indicates an expected call of ApplyRules.
+func (mr *MockruleEngineMockRecorder) ApplyRules(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRules", reflect.TypeOf((*MockruleEngine)(nil).ApplyRules), ctx, event)
+}
+
+// MockchangeNotifier is a mock of changeNotifier interface.
+type MockchangeNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockchangeNotifierMockRecorder
+}
+
+// MockchangeNotifierMockRecorder is the mock recorder for MockchangeNotifier.
+type MockchangeNotifierMockRecorder struct {
+	mock *MockchangeNotifier
+}
+
+// NewMockchangeNotifier creates a new mock instance.
+func NewMockchangeNotifier(ctrl *gomock.Controller) *MockchangeNotifier {
+	mock := &MockchangeNotifier{ctrl: ctrl}
+	mock.recorder = &MockchangeNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchangeNotifier) EXPECT() *MockchangeNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyCancelled mocks base method.
+func (m *MockchangeNotifier) NotifyCancelled(ctx context.Context, recipients []model.Attendee, event model.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyCancelled", ctx, recipients, event)
+}
+
+// NotifyCancelled indicates an expected call of NotifyCancelled.
+func (mr *MockchangeNotifierMockRecorder) NotifyCancelled(ctx, recipients, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyCancelled", reflect.TypeOf((*MockchangeNotifier)(nil).NotifyCancelled), ctx, recipients, event)
+}
+
+// NotifyChanged mocks base method.
+func (m *MockchangeNotifier) NotifyChanged(ctx context.Context, recipients []model.Attendee, before, after model.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyChanged", ctx, recipients, before, after)
+}
+
+// NotifyChanged indicates an expected call of NotifyChanged.
+func (mr *MockchangeNotifierMockRecorder) NotifyChanged(ctx, recipients, before, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyChanged", reflect.TypeOf((*MockchangeNotifier)(nil).NotifyChanged), ctx, recipients, before, after)
+}
+
+// NotifyTrashExpiring mocks base method.
+func (m *MockchangeNotifier) NotifyTrashExpiring(ctx context.Context, notice model.TrashNotice) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyTrashExpiring", ctx, notice)
+}
+
+// NotifyTrashExpiring indicates an expected call of NotifyTrashExpiring.
+func (mr *MockchangeNotifierMockRecorder) NotifyTrashExpiring(ctx, notice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTrashExpiring", reflect.TypeOf((*MockchangeNotifier)(nil).NotifyTrashExpiring), ctx, notice)
+}
+
+// Recipients mocks base method.
+func (m *MockchangeNotifier) Recipients(ctx context.Context, eventID uuid.UUID) []model.Attendee {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recipients", ctx, eventID)
+	ret0, _ := ret[0].([]model.Attendee)
+	return ret0
+}
+
+// Recipients indicates an expected call of Recipients.
+func (mr *MockchangeNotifierMockRecorder) Recipients(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recipients", reflect.TypeOf((*MockchangeNotifier)(nil).Recipients), ctx, eventID)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockattendeeRepo is a mock of attendeeRepo interface.
+type MockattendeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockattendeeRepoMockRecorder
+}
+
+// MockattendeeRepoMockRecorder is the mock recorder for MockattendeeRepo.
+type MockattendeeRepoMockRecorder struct {
+	mock *MockattendeeRepo
+}
+
+// NewMockattendeeRepo creates a new mock instance.
+func NewMockattendeeRepo(ctrl *gomock.Controller) *MockattendeeRepo {
+	mock := &MockattendeeRepo{ctrl: ctrl}
+	mock.recorder = &MockattendeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattendeeRepo) EXPECT() *MockattendeeRepoMockRecorder {
+	return m.recorder
+}
+
+// ListAttendees mocks base method.
+func (m *MockattendeeRepo) ListAttendees(ctx context.Context, eventID uuid.UUID) ([]model.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendees", ctx, eventID)
+	ret0, _ := ret[0].([]model.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendees indicates an expected call of ListAttendees.
+func (mr *MockattendeeRepoMockRecorder) ListAttendees(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendees", reflect.TypeOf((*MockattendeeRepo)(nil).ListAttendees), ctx, eventID)
+}
+
+// MockpreferenceService is a mock of preferenceService interface.
+type MockpreferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockpreferenceServiceMockRecorder
+}
+
+// MockpreferenceServiceMockRecorder is the mock recorder for MockpreferenceService.
+type MockpreferenceServiceMockRecorder struct {
+	mock *MockpreferenceService
+}
+
+// NewMockpreferenceService creates a new mock instance.
+func NewMockpreferenceService(ctrl *gomock.Controller) *MockpreferenceService {
+	mock := &MockpreferenceService{ctrl: ctrl}
+	mock.recorder = &MockpreferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpreferenceService) EXPECT() *MockpreferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// Subscribed mocks base method.
+func (m *MockpreferenceService) Subscribed(ctx context.Context, userID uuid.UUID, category string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribed", ctx, userID, category)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribed indicates an expected call of Subscribed.
+func (mr *MockpreferenceServiceMockRecorder) Subscribed(ctx, userID, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribed", reflect.TypeOf((*MockpreferenceService)(nil).Subscribed), ctx, userID, category)
+}
+
+// UnsubscribeURL mocks base method.
+func (m *MockpreferenceService) UnsubscribeURL(ctx context.Context, userID uuid.UUID, category string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeURL", ctx, userID, category)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// UnsubscribeURL indicates an expected call of UnsubscribeURL.
+func (mr *MockpreferenceServiceMockRecorder) UnsubscribeURL(ctx, userID, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeURL", reflect.TypeOf((*MockpreferenceService)(nil).UnsubscribeURL), ctx, userID, category)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, to, subject, body)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockeventService is a mock of eventService interface.
+type MockeventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventServiceMockRecorder
+}
+
+// MockeventServiceMockRecorder is the mock recorder for MockeventService.
+type MockeventServiceMockRecorder struct {
+	mock *MockeventService
+}
+
+// NewMockeventService creates a new mock instance.
+func NewMockeventService(ctrl *gomock.Controller) *MockeventService {
+	mock := &MockeventService{ctrl: ctrl}
+	mock.recorder = &MockeventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
+	return m.recorder
+}
+
+// GetEventsInRange mocks base method.
+func (m *MockeventService) GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsInRange", ctx, userID, from, to)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsInRange indicates an expected call of GetEventsInRange.
+func (mr *MockeventServiceMockRecorder) GetEventsInRange(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsInRange", reflect.TypeOf((*MockeventService)(nil).GetEventsInRange), ctx, userID, from, to)
+}
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockjobService) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockjobServiceMockRecorder) Enqueue(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockjobService)(nil).Enqueue), ctx, job)
+}
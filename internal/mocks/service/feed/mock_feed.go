@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockfeedRepo is a mock of feedRepo interface.
+type MockfeedRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedRepoMockRecorder
+}
+
+// MockfeedRepoMockRecorder is the mock recorder for MockfeedRepo.
+type MockfeedRepoMockRecorder struct {
+	mock *MockfeedRepo
+}
+
+// NewMockfeedRepo creates a new mock instance.
+func NewMockfeedRepo(ctrl *gomock.Controller) *MockfeedRepo {
+	mock := &MockfeedRepo{ctrl: ctrl}
+	mock.recorder = &MockfeedRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedRepo) EXPECT() *MockfeedRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateFeed mocks base method.
+func (m *MockfeedRepo) CreateFeed(ctx context.Context, feed model.Feed, tokenHash string) (model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeed", ctx, feed, tokenHash)
+	ret0, _ := ret[0].(model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeed indicates an expected call of CreateFeed.
+func (mr *MockfeedRepoMockRecorder) CreateFeed(ctx, feed, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeed", reflect.TypeOf((*MockfeedRepo)(nil).CreateFeed), ctx, feed, tokenHash)
+}
+
+// DeleteFeed mocks base method.
+func (m *MockfeedRepo) DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeed", ctx, feedID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockfeedRepoMockRecorder) DeleteFeed(ctx, feedID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockfeedRepo)(nil).DeleteFeed), ctx, feedID, userID)
+}
+
+// GetFeedByTokenHash mocks base method.
+func (m *MockfeedRepo) GetFeedByTokenHash(ctx context.Context, tokenHash string) (model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedByTokenHash indicates an expected call of GetFeedByTokenHash.
+func (mr *MockfeedRepoMockRecorder) GetFeedByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedByTokenHash", reflect.TypeOf((*MockfeedRepo)(nil).GetFeedByTokenHash), ctx, tokenHash)
+}
+
+// ListFeeds mocks base method.
+func (m *MockfeedRepo) ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeds", ctx, userID)
+	ret0, _ := ret[0].([]model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeds indicates an expected call of ListFeeds.
+func (mr *MockfeedRepoMockRecorder) ListFeeds(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockfeedRepo)(nil).ListFeeds), ctx, userID)
+}
+
+// UpdateToken mocks base method.
+func (m *MockfeedRepo) UpdateToken(ctx context.Context, feedID, userID uuid.UUID, tokenHash string) (model.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateToken", ctx, feedID, userID, tokenHash)
+	ret0, _ := ret[0].(model.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateToken indicates an expected call of UpdateToken.
+func (mr *MockfeedRepoMockRecorder) UpdateToken(ctx, feedID, userID, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToken", reflect.TypeOf((*MockfeedRepo)(nil).UpdateToken), ctx, feedID, userID, tokenHash)
+}
+
+// MockeventLister is a mock of eventLister interface.
+type MockeventLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventListerMockRecorder
+}
+
+// MockeventListerMockRecorder is the mock recorder for MockeventLister.
+type MockeventListerMockRecorder struct {
+	mock *MockeventLister
+}
+
+// NewMockeventLister creates a new mock instance.
+func NewMockeventLister(ctrl *gomock.Controller) *MockeventLister {
+	mock := &MockeventLister{ctrl: ctrl}
+	mock.recorder = &MockeventListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventLister) EXPECT() *MockeventListerMockRecorder {
+	return m.recorder
+}
+
+// GetEventsForFeed mocks base method.
+func (m *MockeventLister) GetEventsForFeed(ctx context.Context, userID uuid.UUID, from, to time.Time, projectID *uuid.UUID) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsForFeed", ctx, userID, from, to, projectID)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsForFeed indicates an expected call of GetEventsForFeed.
+func (mr *MockeventListerMockRecorder) GetEventsForFeed(ctx, userID, from, to, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForFeed", reflect.TypeOf((*MockeventLister)(nil).GetEventsForFeed), ctx, userID, from, to, projectID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
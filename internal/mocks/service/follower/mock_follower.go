@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockfollowerRepo is a mock of followerRepo interface.
+type MockfollowerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockfollowerRepoMockRecorder
+}
+
+// MockfollowerRepoMockRecorder is the mock recorder for MockfollowerRepo.
+type MockfollowerRepoMockRecorder struct {
+	mock *MockfollowerRepo
+}
+
+// NewMockfollowerRepo creates a new mock instance.
+func NewMockfollowerRepo(ctrl *gomock.Controller) *MockfollowerRepo {
+	mock := &MockfollowerRepo{ctrl: ctrl}
+	mock.recorder = &MockfollowerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfollowerRepo) EXPECT() *MockfollowerRepoMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockfollowerRepo) Follow(ctx context.Context, eventID, userID uuid.UUID, message string) (model.Follower, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, eventID, userID, message)
+	ret0, _ := ret[0].(model.Follower)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockfollowerRepoMockRecorder) Follow(ctx, eventID, userID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockfollowerRepo)(nil).Follow), ctx, eventID, userID, message)
+}
+
+// GetSharedEvent mocks base method.
+func (m *MockfollowerRepo) GetSharedEvent(ctx context.Context, eventID uuid.UUID) (model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedEvent", ctx, eventID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedEvent indicates an expected call of GetSharedEvent.
+func (mr *MockfollowerRepoMockRecorder) GetSharedEvent(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedEvent", reflect.TypeOf((*MockfollowerRepo)(nil).GetSharedEvent), ctx, eventID)
+}
+
+// Unfollow mocks base method.
+func (m *MockfollowerRepo) Unfollow(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockfollowerRepoMockRecorder) Unfollow(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockfollowerRepo)(nil).Unfollow), ctx, eventID, userID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Encrypt mocks base method.
+func (m *MockcontentCipher) Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, userID, plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockcontentCipherMockRecorder) Encrypt(ctx, userID, plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockcontentCipher)(nil).Encrypt), ctx, userID, plaintext)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockgroupRepo is a mock of groupRepo interface.
+type MockgroupRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockgroupRepoMockRecorder
+}
+
+// MockgroupRepoMockRecorder is the mock recorder for MockgroupRepo.
+type MockgroupRepoMockRecorder struct {
+	mock *MockgroupRepo
+}
+
+// NewMockgroupRepo creates a new mock instance.
+func NewMockgroupRepo(ctrl *gomock.Controller) *MockgroupRepo {
+	mock := &MockgroupRepo{ctrl: ctrl}
+	mock.recorder = &MockgroupRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgroupRepo) EXPECT() *MockgroupRepoMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockgroupRepo) AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, groupID, ownerID, email)
+	ret0, _ := ret[0].(model.GroupMember)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockgroupRepoMockRecorder) AddMember(ctx, groupID, ownerID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockgroupRepo)(nil).AddMember), ctx, groupID, ownerID, email)
+}
+
+// CreateGroup mocks base method.
+func (m *MockgroupRepo) CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, userID, name)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockgroupRepoMockRecorder) CreateGroup(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockgroupRepo)(nil).CreateGroup), ctx, userID, name)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockgroupRepo) DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockgroupRepoMockRecorder) DeleteGroup(ctx, groupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockgroupRepo)(nil).DeleteGroup), ctx, groupID, userID)
+}
+
+// GetGroup mocks base method.
+func (m *MockgroupRepo) GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockgroupRepoMockRecorder) GetGroup(ctx, groupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockgroupRepo)(nil).GetGroup), ctx, groupID, userID)
+}
+
+// InviteGroup mocks base method.
+func (m *MockgroupRepo) InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteGroup", ctx, eventID, ownerID, groupID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteGroup indicates an expected call of InviteGroup.
+func (mr *MockgroupRepoMockRecorder) InviteGroup(ctx, eventID, ownerID, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteGroup", reflect.TypeOf((*MockgroupRepo)(nil).InviteGroup), ctx, eventID, ownerID, groupID)
+}
+
+// ListGroups mocks base method.
+func (m *MockgroupRepo) ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, userID)
+	ret0, _ := ret[0].([]model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockgroupRepoMockRecorder) ListGroups(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockgroupRepo)(nil).ListGroups), ctx, userID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockgroupRepo) RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, groupID, ownerID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockgroupRepoMockRecorder) RemoveMember(ctx, groupID, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockgroupRepo)(nil).RemoveMember), ctx, groupID, ownerID, userID)
+}
+
+// RenameGroup mocks base method.
+func (m *MockgroupRepo) RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGroup", ctx, groupID, userID, name)
+	ret0, _ := ret[0].(model.AttendeeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameGroup indicates an expected call of RenameGroup.
+func (mr *MockgroupRepoMockRecorder) RenameGroup(ctx, groupID, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockgroupRepo)(nil).RenameGroup), ctx, groupID, userID, name)
+}
@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockjobService) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockjobServiceMockRecorder) Enqueue(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockjobService)(nil).Enqueue), ctx, job)
+}
+
+// GetJob mocks base method.
+func (m *MockjobService) GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockjobServiceMockRecorder) GetJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockjobService)(nil).GetJob), ctx, jobID, userID)
+}
+
+// RecordEvents mocks base method.
+func (m *MockjobService) RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEvents", ctx, jobID, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEvents indicates an expected call of RecordEvents.
+func (mr *MockjobServiceMockRecorder) RecordEvents(ctx, jobID, eventIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEvents", reflect.TypeOf((*MockjobService)(nil).RecordEvents), ctx, jobID, eventIDs)
+}
+
+// MockeventService is a mock of eventService interface.
+type MockeventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventServiceMockRecorder
+}
+
+// MockeventServiceMockRecorder is the mock recorder for MockeventService.
+type MockeventServiceMockRecorder struct {
+	mock *MockeventService
+}
+
+// NewMockeventService creates a new mock instance.
+func NewMockeventService(ctrl *gomock.Controller) *MockeventService {
+	mock := &MockeventService{ctrl: ctrl}
+	mock.recorder = &MockeventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockeventService) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockeventServiceMockRecorder) CreateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventService)(nil).CreateEvent), ctx, event)
+}
+
+// MockprojectService is a mock of projectService interface.
+type MockprojectService struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectServiceMockRecorder
+}
+
+// MockprojectServiceMockRecorder is the mock recorder for MockprojectService.
+type MockprojectServiceMockRecorder struct {
+	mock *MockprojectService
+}
+
+// NewMockprojectService creates a new mock instance.
+func NewMockprojectService(ctrl *gomock.Controller) *MockprojectService {
+	mock := &MockprojectService{ctrl: ctrl}
+	mock.recorder = &MockprojectServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectService) EXPECT() *MockprojectServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockprojectService) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockprojectServiceMockRecorder) CreateProject(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectService)(nil).CreateProject), ctx, project)
+}
+
+// ListProjects mocks base method.
+func (m *MockprojectService) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, userID)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockprojectServiceMockRecorder) ListProjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockprojectService)(nil).ListProjects), ctx, userID)
+}
@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockjobRepo is a mock of jobRepo interface.
+type MockjobRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobRepoMockRecorder
+}
+
+// MockjobRepoMockRecorder is the mock recorder for MockjobRepo.
+type MockjobRepoMockRecorder struct {
+	mock *MockjobRepo
+}
+
+// NewMockjobRepo creates a new mock instance.
+func NewMockjobRepo(ctrl *gomock.Controller) *MockjobRepo {
+	mock := &MockjobRepo{ctrl: ctrl}
+	mock.recorder = &MockjobRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobRepo) EXPECT() *MockjobRepoMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockjobRepo) CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockjobRepoMockRecorder) CancelJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockjobRepo)(nil).CancelJob), ctx, jobID, userID)
+}
+
+// ClaimNext mocks base method.
+func (m *MockjobRepo) ClaimNext(ctx context.Context, lease time.Duration, owner string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNext", ctx, lease, owner)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNext indicates an expected call of ClaimNext.
+func (mr *MockjobRepoMockRecorder) ClaimNext(ctx, lease, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNext", reflect.TypeOf((*MockjobRepo)(nil).ClaimNext), ctx, lease, owner)
+}
+
+// CreateJob mocks base method.
+func (m *MockjobRepo) CreateJob(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockjobRepoMockRecorder) CreateJob(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockjobRepo)(nil).CreateJob), ctx, job)
+}
+
+// DeleteJobEvents mocks base method.
+func (m *MockjobRepo) DeleteJobEvents(ctx context.Context, jobID, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobEvents", ctx, jobID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobEvents indicates an expected call of DeleteJobEvents.
+func (mr *MockjobRepoMockRecorder) DeleteJobEvents(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobEvents", reflect.TypeOf((*MockjobRepo)(nil).DeleteJobEvents), ctx, jobID, userID)
+}
+
+// FailExpired mocks base method.
+func (m *MockjobRepo) FailExpired(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailExpired", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailExpired indicates an expected call of FailExpired.
+func (mr *MockjobRepoMockRecorder) FailExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailExpired", reflect.TypeOf((*MockjobRepo)(nil).FailExpired), ctx)
+}
+
+// FinishJob mocks base method.
+func (m *MockjobRepo) FinishJob(ctx context.Context, job model.Job, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishJob", ctx, job, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishJob indicates an expected call of FinishJob.
+func (mr *MockjobRepoMockRecorder) FinishJob(ctx, job, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishJob", reflect.TypeOf((*MockjobRepo)(nil).FinishJob), ctx, job, owner)
+}
+
+// GetJob mocks base method.
+func (m *MockjobRepo) GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockjobRepoMockRecorder) GetJob(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockjobRepo)(nil).GetJob), ctx, jobID, userID)
+}
+
+// GetOutput mocks base method.
+func (m *MockjobRepo) GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutput", ctx, jobID, userID)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutput indicates an expected call of GetOutput.
+func (mr *MockjobRepoMockRecorder) GetOutput(ctx, jobID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutput", reflect.TypeOf((*MockjobRepo)(nil).GetOutput), ctx, jobID, userID)
+}
+
+// Heartbeat mocks base method.
+func (m *MockjobRepo) Heartbeat(ctx context.Context, job model.Job, lease time.Duration, owner string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, job, lease, owner)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockjobRepoMockRecorder) Heartbeat(ctx, job, lease, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockjobRepo)(nil).Heartbeat), ctx, job, lease, owner)
+}
+
+// ListJobs mocks base method.
+func (m *MockjobRepo) ListJobs(ctx context.Context, userID uuid.UUID, limit int) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx, userID, limit)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockjobRepoMockRecorder) ListJobs(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockjobRepo)(nil).ListJobs), ctx, userID, limit)
+}
+
+// RecordEvents mocks base method.
+func (m *MockjobRepo) RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEvents", ctx, jobID, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEvents indicates an expected call of RecordEvents.
+func (mr *MockjobRepoMockRecorder) RecordEvents(ctx, jobID, eventIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEvents", reflect.TypeOf((*MockjobRepo)(nil).RecordEvents), ctx, jobID, eventIDs)
+}
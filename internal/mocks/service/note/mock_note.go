@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocknoteRepo is a mock of noteRepo interface.
+type MocknoteRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocknoteRepoMockRecorder
+}
+
+// MocknoteRepoMockRecorder is the mock recorder for MocknoteRepo.
+type MocknoteRepoMockRecorder struct {
+	mock *MocknoteRepo
+}
+
+// NewMocknoteRepo creates a new mock instance.
+func NewMocknoteRepo(ctrl *gomock.Controller) *MocknoteRepo {
+	mock := &MocknoteRepo{ctrl: ctrl}
+	mock.recorder = &MocknoteRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknoteRepo) EXPECT() *MocknoteRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteNote mocks base method.
+func (m *MocknoteRepo) DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MocknoteRepoMockRecorder) DeleteNote(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MocknoteRepo)(nil).DeleteNote), ctx, eventID, userID)
+}
+
+// GetNote mocks base method.
+func (m *MocknoteRepo) GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNote", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNote indicates an expected call of GetNote.
+func (mr *MocknoteRepoMockRecorder) GetNote(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MocknoteRepo)(nil).GetNote), ctx, eventID, userID)
+}
+
+// SetNote mocks base method.
+func (m *MocknoteRepo) SetNote(ctx context.Context, n model.Note) (model.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNote", ctx, n)
+	ret0, _ := ret[0].(model.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNote indicates an expected call of SetNote.
+func (mr *MocknoteRepoMockRecorder) SetNote(ctx, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNote", reflect.TypeOf((*MocknoteRepo)(nil).SetNote), ctx, n)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Encrypt mocks base method.
+func (m *MockcontentCipher) Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, userID, plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockcontentCipherMockRecorder) Encrypt(ctx, userID, plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockcontentCipher)(nil).Encrypt), ctx, userID, plaintext)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocknotificationRepo is a mock of notificationRepo interface.
+type MocknotificationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationRepoMockRecorder
+}
+
+// MocknotificationRepoMockRecorder is the mock recorder for MocknotificationRepo.
+type MocknotificationRepoMockRecorder struct {
+	mock *MocknotificationRepo
+}
+
+// NewMocknotificationRepo creates a new mock instance.
+func NewMocknotificationRepo(ctrl *gomock.Controller) *MocknotificationRepo {
+	mock := &MocknotificationRepo{ctrl: ctrl}
+	mock.recorder = &MocknotificationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationRepo) EXPECT() *MocknotificationRepoMockRecorder {
+	return m.recorder
+}
+
+// CountEntries mocks base method.
+func (m *MocknotificationRepo) CountEntries(ctx context.Context, recipient, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEntries", ctx, recipient, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEntries indicates an expected call of CountEntries.
+func (mr *MocknotificationRepoMockRecorder) CountEntries(ctx, recipient, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEntries", reflect.TypeOf((*MocknotificationRepo)(nil).CountEntries), ctx, recipient, mode)
+}
+
+// CreateEntry mocks base method.
+func (m *MocknotificationRepo) CreateEntry(ctx context.Context, entry model.NotificationLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MocknotificationRepoMockRecorder) CreateEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MocknotificationRepo)(nil).CreateEntry), ctx, entry)
+}
+
+// ListEntries mocks base method.
+func (m *MocknotificationRepo) ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, recipient, page)
+	ret0, _ := ret[0].([]model.NotificationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MocknotificationRepoMockRecorder) ListEntries(ctx, recipient, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MocknotificationRepo)(nil).ListEntries), ctx, recipient, page)
+}
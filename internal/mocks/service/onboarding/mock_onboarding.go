@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockonboardingRepo is a mock of onboardingRepo interface.
+type MockonboardingRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockonboardingRepoMockRecorder
+}
+
+// MockonboardingRepoMockRecorder is the mock recorder for MockonboardingRepo.
+type MockonboardingRepoMockRecorder struct {
+	mock *MockonboardingRepo
+}
+
+// NewMockonboardingRepo creates a new mock instance.
+func NewMockonboardingRepo(ctrl *gomock.Controller) *MockonboardingRepo {
+	mock := &MockonboardingRepo{ctrl: ctrl}
+	mock.recorder = &MockonboardingRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockonboardingRepo) EXPECT() *MockonboardingRepoMockRecorder {
+	return m.recorder
+}
+
+// ClearSeed mocks base method.
+func (m *MockonboardingRepo) ClearSeed(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSeed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearSeed indicates an expected call of ClearSeed.
+func (mr *MockonboardingRepoMockRecorder) ClearSeed(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSeed", reflect.TypeOf((*MockonboardingRepo)(nil).ClearSeed), ctx, userID)
+}
+
+// CompleteSeed mocks base method.
+func (m *MockonboardingRepo) CompleteSeed(ctx context.Context, userID uuid.UUID, seed model.OnboardingSeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSeed", ctx, userID, seed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteSeed indicates an expected call of CompleteSeed.
+func (mr *MockonboardingRepoMockRecorder) CompleteSeed(ctx, userID, seed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSeed", reflect.TypeOf((*MockonboardingRepo)(nil).CompleteSeed), ctx, userID, seed)
+}
+
+// GetStatus mocks base method.
+func (m *MockonboardingRepo) GetStatus(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, userID)
+	ret0, _ := ret[0].(model.OnboardingStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockonboardingRepoMockRecorder) GetStatus(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockonboardingRepo)(nil).GetStatus), ctx, userID)
+}
+
+// StartSeed mocks base method.
+func (m *MockonboardingRepo) StartSeed(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSeed", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSeed indicates an expected call of StartSeed.
+func (mr *MockonboardingRepoMockRecorder) StartSeed(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSeed", reflect.TypeOf((*MockonboardingRepo)(nil).StartSeed), ctx, userID)
+}
+
+// MockprojectService is a mock of projectService interface.
+type MockprojectService struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectServiceMockRecorder
+}
+
+// MockprojectServiceMockRecorder is the mock recorder for MockprojectService.
+type MockprojectServiceMockRecorder struct {
+	mock *MockprojectService
+}
+
+// NewMockprojectService creates a new mock instance.
+func NewMockprojectService(ctrl *gomock.Controller) *MockprojectService {
+	mock := &MockprojectService{ctrl: ctrl}
+	mock.recorder = &MockprojectServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectService) EXPECT() *MockprojectServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockprojectService) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockprojectServiceMockRecorder) CreateProject(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectService)(nil).CreateProject), ctx, project)
+}
+
+// MockeventService is a mock of eventService interface.
+type MockeventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventServiceMockRecorder
+}
+
+// MockeventServiceMockRecorder is the mock recorder for MockeventService.
+type MockeventServiceMockRecorder struct {
+	mock *MockeventService
+}
+
+// NewMockeventService creates a new mock instance.
+func NewMockeventService(ctrl *gomock.Controller) *MockeventService {
+	mock := &MockeventService{ctrl: ctrl}
+	mock.recorder = &MockeventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockeventService) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockeventServiceMockRecorder) CreateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventService)(nil).CreateEvent), ctx, event)
+}
+
+// MockviewService is a mock of viewService interface.
+type MockviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockviewServiceMockRecorder
+}
+
+// MockviewServiceMockRecorder is the mock recorder for MockviewService.
+type MockviewServiceMockRecorder struct {
+	mock *MockviewService
+}
+
+// NewMockviewService creates a new mock instance.
+func NewMockviewService(ctrl *gomock.Controller) *MockviewService {
+	mock := &MockviewService{ctrl: ctrl}
+	mock.recorder = &MockviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockviewService) EXPECT() *MockviewServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateView mocks base method.
+func (m *MockviewService) CreateView(ctx context.Context, view model.View) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *MockviewServiceMockRecorder) CreateView(ctx, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*MockviewService)(nil).CreateView), ctx, view)
+}
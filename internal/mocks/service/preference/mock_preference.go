@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockpreferenceRepo is a mock of preferenceRepo interface.
+type MockpreferenceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockpreferenceRepoMockRecorder
+}
+
+// MockpreferenceRepoMockRecorder is the mock recorder for MockpreferenceRepo.
+type MockpreferenceRepoMockRecorder struct {
+	mock *MockpreferenceRepo
+}
+
+// NewMockpreferenceRepo creates a new mock instance.
+func NewMockpreferenceRepo(ctrl *gomock.Controller) *MockpreferenceRepo {
+	mock := &MockpreferenceRepo{ctrl: ctrl}
+	mock.recorder = &MockpreferenceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpreferenceRepo) EXPECT() *MockpreferenceRepoMockRecorder {
+	return m.recorder
+}
+
+// ListPreferences mocks base method.
+func (m *MockpreferenceRepo) ListPreferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPreferences", ctx, userID)
+	ret0, _ := ret[0].([]model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreferences indicates an expected call of ListPreferences.
+func (mr *MockpreferenceRepoMockRecorder) ListPreferences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreferences", reflect.TypeOf((*MockpreferenceRepo)(nil).ListPreferences), ctx, userID)
+}
+
+// SetPreference mocks base method.
+func (m *MockpreferenceRepo) SetPreference(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", ctx, userID, category, subscribed)
+	ret0, _ := ret[0].(model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPreference indicates an expected call of SetPreference.
+func (mr *MockpreferenceRepoMockRecorder) SetPreference(ctx, userID, category, subscribed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockpreferenceRepo)(nil).SetPreference), ctx, userID, category, subscribed)
+}
@@ -0,0 +1,209 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockprojectRepo is a mock of projectRepo interface.
+type MockprojectRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepoMockRecorder
+}
+
+// MockprojectRepoMockRecorder is the mock recorder for MockprojectRepo.
+type MockprojectRepoMockRecorder struct {
+	mock *MockprojectRepo
+}
+
+// NewMockprojectRepo creates a new mock instance.
+func NewMockprojectRepo(ctrl *gomock.Controller) *MockprojectRepo {
+	mock := &MockprojectRepo{ctrl: ctrl}
+	mock.recorder = &MockprojectRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepo) EXPECT() *MockprojectRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockprojectRepo) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockprojectRepoMockRecorder) CreateProject(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectRepo)(nil).CreateProject), ctx, project)
+}
+
+// CreateTask mocks base method.
+func (m *MockprojectRepo) CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, task, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockprojectRepoMockRecorder) CreateTask(ctx, task, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockprojectRepo)(nil).CreateTask), ctx, task, userID)
+}
+
+// DeleteProject mocks base method.
+func (m *MockprojectRepo) DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockprojectRepoMockRecorder) DeleteProject(ctx, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockprojectRepo)(nil).DeleteProject), ctx, projectID, userID)
+}
+
+// GetProject mocks base method.
+func (m *MockprojectRepo) GetProject(ctx context.Context, projectID, userID uuid.UUID) (model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, projectID, userID)
+	ret0, _ := ret[0].(model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockprojectRepoMockRecorder) GetProject(ctx, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockprojectRepo)(nil).GetProject), ctx, projectID, userID)
+}
+
+// ListEventLinks mocks base method.
+func (m *MockprojectRepo) ListEventLinks(ctx context.Context, projectID uuid.UUID) ([]model.EventLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventLinks", ctx, projectID)
+	ret0, _ := ret[0].([]model.EventLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventLinks indicates an expected call of ListEventLinks.
+func (mr *MockprojectRepoMockRecorder) ListEventLinks(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventLinks", reflect.TypeOf((*MockprojectRepo)(nil).ListEventLinks), ctx, projectID)
+}
+
+// ListEvents mocks base method.
+func (m *MockprojectRepo) ListEvents(ctx context.Context, projectID uuid.UUID) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, projectID)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockprojectRepoMockRecorder) ListEvents(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockprojectRepo)(nil).ListEvents), ctx, projectID)
+}
+
+// ListProjects mocks base method.
+func (m *MockprojectRepo) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, userID)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockprojectRepoMockRecorder) ListProjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockprojectRepo)(nil).ListProjects), ctx, userID)
+}
+
+// ListTasks mocks base method.
+func (m *MockprojectRepo) ListTasks(ctx context.Context, projectID uuid.UUID) ([]model.ProjectTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", ctx, projectID)
+	ret0, _ := ret[0].([]model.ProjectTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockprojectRepoMockRecorder) ListTasks(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockprojectRepo)(nil).ListTasks), ctx, projectID)
+}
+
+// SetTaskDone mocks base method.
+func (m *MockprojectRepo) SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskDone", ctx, projectID, taskID, userID, done)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskDone indicates an expected call of SetTaskDone.
+func (mr *MockprojectRepoMockRecorder) SetTaskDone(ctx, projectID, taskID, userID, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskDone", reflect.TypeOf((*MockprojectRepo)(nil).SetTaskDone), ctx, projectID, taskID, userID, done)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
@@ -0,0 +1,257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockproposalRepo is a mock of proposalRepo interface.
+type MockproposalRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproposalRepoMockRecorder
+}
+
+// MockproposalRepoMockRecorder is the mock recorder for MockproposalRepo.
+type MockproposalRepoMockRecorder struct {
+	mock *MockproposalRepo
+}
+
+// NewMockproposalRepo creates a new mock instance.
+func NewMockproposalRepo(ctrl *gomock.Controller) *MockproposalRepo {
+	mock := &MockproposalRepo{ctrl: ctrl}
+	mock.recorder = &MockproposalRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproposalRepo) EXPECT() *MockproposalRepoMockRecorder {
+	return m.recorder
+}
+
+// AcceptProposal mocks base method.
+func (m *MockproposalRepo) AcceptProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptProposal", ctx, proposalID)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptProposal indicates an expected call of AcceptProposal.
+func (mr *MockproposalRepoMockRecorder) AcceptProposal(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptProposal", reflect.TypeOf((*MockproposalRepo)(nil).AcceptProposal), ctx, proposalID)
+}
+
+// AttendeeEmails mocks base method.
+func (m *MockproposalRepo) AttendeeEmails(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttendeeEmails", ctx, eventID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttendeeEmails indicates an expected call of AttendeeEmails.
+func (mr *MockproposalRepoMockRecorder) AttendeeEmails(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttendeeEmails", reflect.TypeOf((*MockproposalRepo)(nil).AttendeeEmails), ctx, eventID)
+}
+
+// CreateProposal mocks base method.
+func (m *MockproposalRepo) CreateProposal(ctx context.Context, p model.Proposal) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProposal", ctx, p)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProposal indicates an expected call of CreateProposal.
+func (mr *MockproposalRepoMockRecorder) CreateProposal(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProposal", reflect.TypeOf((*MockproposalRepo)(nil).CreateProposal), ctx, p)
+}
+
+// DeclineProposal mocks base method.
+func (m *MockproposalRepo) DeclineProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineProposal", ctx, proposalID)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineProposal indicates an expected call of DeclineProposal.
+func (mr *MockproposalRepoMockRecorder) DeclineProposal(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineProposal", reflect.TypeOf((*MockproposalRepo)(nil).DeclineProposal), ctx, proposalID)
+}
+
+// GetAttendedEvent mocks base method.
+func (m *MockproposalRepo) GetAttendedEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendedEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendedEvent indicates an expected call of GetAttendedEvent.
+func (mr *MockproposalRepoMockRecorder) GetAttendedEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendedEvent", reflect.TypeOf((*MockproposalRepo)(nil).GetAttendedEvent), ctx, eventID, userID)
+}
+
+// GetProposal mocks base method.
+func (m *MockproposalRepo) GetProposal(ctx context.Context, proposalID, eventID, ownerID uuid.UUID) (model.Proposal, model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", ctx, proposalID, eventID, ownerID)
+	ret0, _ := ret[0].(model.Proposal)
+	ret1, _ := ret[1].(model.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockproposalRepoMockRecorder) GetProposal(ctx, proposalID, eventID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockproposalRepo)(nil).GetProposal), ctx, proposalID, eventID, ownerID)
+}
+
+// ListProposals mocks base method.
+func (m *MockproposalRepo) ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProposals", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProposals indicates an expected call of ListProposals.
+func (mr *MockproposalRepoMockRecorder) ListProposals(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProposals", reflect.TypeOf((*MockproposalRepo)(nil).ListProposals), ctx, eventID, userID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, to, subject, body)
+}
+
+// MockuserService is a mock of userService interface.
+type MockuserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserServiceMockRecorder
+}
+
+// MockuserServiceMockRecorder is the mock recorder for MockuserService.
+type MockuserServiceMockRecorder struct {
+	mock *MockuserService
+}
+
+// NewMockuserService creates a new mock instance.
+func NewMockuserService(ctrl *gomock.Controller) *MockuserService {
+	mock := &MockuserService{ctrl: ctrl}
+	mock.recorder = &MockuserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserService) EXPECT() *MockuserServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockuserService) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserService)(nil).GetByID), ctx, id)
+}
@@ -0,0 +1,355 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockreminderRepo is a mock of reminderRepo interface.
+type MockreminderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreminderRepoMockRecorder
+}
+
+// MockreminderRepoMockRecorder is the mock recorder for MockreminderRepo.
+type MockreminderRepoMockRecorder struct {
+	mock *MockreminderRepo
+}
+
+// NewMockreminderRepo creates a new mock instance.
+func NewMockreminderRepo(ctrl *gomock.Controller) *MockreminderRepo {
+	mock := &MockreminderRepo{ctrl: ctrl}
+	mock.recorder = &MockreminderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreminderRepo) EXPECT() *MockreminderRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockreminderRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration, owner string) ([]model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease, owner)
+	ret0, _ := ret[0].([]model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockreminderRepoMockRecorder) ClaimDue(ctx, limit, lease, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockreminderRepo)(nil).ClaimDue), ctx, limit, lease, owner)
+}
+
+// CountHistory mocks base method.
+func (m *MockreminderRepo) CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHistory", ctx, userID, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountHistory indicates an expected call of CountHistory.
+func (mr *MockreminderRepoMockRecorder) CountHistory(ctx, userID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHistory", reflect.TypeOf((*MockreminderRepo)(nil).CountHistory), ctx, userID, mode)
+}
+
+// Defer mocks base method.
+func (m *MockreminderRepo) Defer(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Defer", ctx, id, owner, retryAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Defer indicates an expected call of Defer.
+func (mr *MockreminderRepoMockRecorder) Defer(ctx, id, owner, retryAt, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Defer", reflect.TypeOf((*MockreminderRepo)(nil).Defer), ctx, id, owner, retryAt, reason)
+}
+
+// DeleteHistory mocks base method.
+func (m *MockreminderRepo) DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistory", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHistory indicates an expected call of DeleteHistory.
+func (mr *MockreminderRepoMockRecorder) DeleteHistory(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistory", reflect.TypeOf((*MockreminderRepo)(nil).DeleteHistory), ctx, userID)
+}
+
+// DeleteHistoryEntry mocks base method.
+func (m *MockreminderRepo) DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistoryEntry", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistoryEntry indicates an expected call of DeleteHistoryEntry.
+func (mr *MockreminderRepoMockRecorder) DeleteHistoryEntry(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryEntry", reflect.TypeOf((*MockreminderRepo)(nil).DeleteHistoryEntry), ctx, id, userID)
+}
+
+// ListHistory mocks base method.
+func (m *MockreminderRepo) ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", ctx, userID, page)
+	ret0, _ := ret[0].([]model.ReminderHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockreminderRepoMockRecorder) ListHistory(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockreminderRepo)(nil).ListHistory), ctx, userID, page)
+}
+
+// ListZoned mocks base method.
+func (m *MockreminderRepo) ListZoned(ctx context.Context) ([]model.ZonedReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZoned", ctx)
+	ret0, _ := ret[0].([]model.ZonedReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZoned indicates an expected call of ListZoned.
+func (mr *MockreminderRepoMockRecorder) ListZoned(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZoned", reflect.TypeOf((*MockreminderRepo)(nil).ListZoned), ctx)
+}
+
+// MarkFailed mocks base method.
+func (m *MockreminderRepo) MarkFailed(ctx context.Context, id uuid.UUID, owner, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, owner, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockreminderRepoMockRecorder) MarkFailed(ctx, id, owner, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockreminderRepo)(nil).MarkFailed), ctx, id, owner, reason)
+}
+
+// MarkSent mocks base method.
+func (m *MockreminderRepo) MarkSent(ctx context.Context, id uuid.UUID, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", ctx, id, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockreminderRepoMockRecorder) MarkSent(ctx, id, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockreminderRepo)(nil).MarkSent), ctx, id, owner)
+}
+
+// MarkSkipped mocks base method.
+func (m *MockreminderRepo) MarkSkipped(ctx context.Context, id uuid.UUID, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSkipped", ctx, id, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSkipped indicates an expected call of MarkSkipped.
+func (mr *MockreminderRepoMockRecorder) MarkSkipped(ctx, id, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSkipped", reflect.TypeOf((*MockreminderRepo)(nil).MarkSkipped), ctx, id, owner)
+}
+
+// PurgeHistory mocks base method.
+func (m *MockreminderRepo) PurgeHistory(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeHistory", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeHistory indicates an expected call of PurgeHistory.
+func (mr *MockreminderRepoMockRecorder) PurgeHistory(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistory", reflect.TypeOf((*MockreminderRepo)(nil).PurgeHistory), ctx, before)
+}
+
+// QueueStats mocks base method.
+func (m *MockreminderRepo) QueueStats(ctx context.Context) (model.ReminderQueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueStats", ctx)
+	ret0, _ := ret[0].(model.ReminderQueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueStats indicates an expected call of QueueStats.
+func (mr *MockreminderRepoMockRecorder) QueueStats(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueStats", reflect.TypeOf((*MockreminderRepo)(nil).QueueStats), ctx)
+}
+
+// Reschedule mocks base method.
+func (m *MockreminderRepo) Reschedule(ctx context.Context, id uuid.UUID, remindAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, remindAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockreminderRepoMockRecorder) Reschedule(ctx, id, remindAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockreminderRepo)(nil).Reschedule), ctx, id, remindAt)
+}
+
+// Retry mocks base method.
+func (m *MockreminderRepo) Retry(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, id, owner, retryAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockreminderRepoMockRecorder) Retry(ctx, id, owner, retryAt, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockreminderRepo)(nil).Retry), ctx, id, owner, retryAt, reason)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, to, subject, body)
+}
+
+// MockuserService is a mock of userService interface.
+type MockuserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserServiceMockRecorder
+}
+
+// MockuserServiceMockRecorder is the mock recorder for MockuserService.
+type MockuserServiceMockRecorder struct {
+	mock *MockuserService
+}
+
+// NewMockuserService creates a new mock instance.
+func NewMockuserService(ctrl *gomock.Controller) *MockuserService {
+	mock := &MockuserService{ctrl: ctrl}
+	mock.recorder = &MockuserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserService) EXPECT() *MockuserServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockuserService) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserService)(nil).GetByID), ctx, id)
+}
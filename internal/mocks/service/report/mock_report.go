@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockreportRepo is a mock of reportRepo interface.
+type MockreportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreportRepoMockRecorder
+}
+
+// MockreportRepoMockRecorder is the mock recorder for MockreportRepo.
+type MockreportRepoMockRecorder struct {
+	mock *MockreportRepo
+}
+
+// NewMockreportRepo creates a new mock instance.
+func NewMockreportRepo(ctrl *gomock.Controller) *MockreportRepo {
+	mock := &MockreportRepo{ctrl: ctrl}
+	mock.recorder = &MockreportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreportRepo) EXPECT() *MockreportRepoMockRecorder {
+	return m.recorder
+}
+
+// GetReport mocks base method.
+func (m *MockreportRepo) GetReport(ctx context.Context, groupID, ownerID uuid.UUID) ([]model.MemberMeetingLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, groupID, ownerID)
+	ret0, _ := ret[0].([]model.MemberMeetingLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockreportRepoMockRecorder) GetReport(ctx, groupID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockreportRepo)(nil).GetReport), ctx, groupID, ownerID)
+}
+
+// ListMeetings mocks base method.
+func (m *MockreportRepo) ListMeetings(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMeetings", ctx, userID, from, to)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMeetings indicates an expected call of ListMeetings.
+func (mr *MockreportRepoMockRecorder) ListMeetings(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMeetings", reflect.TypeOf((*MockreportRepo)(nil).ListMeetings), ctx, userID, from, to)
+}
+
+// ListOutdatedMembers mocks base method.
+func (m *MockreportRepo) ListOutdatedMembers(ctx context.Context, before time.Time, limit int) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutdatedMembers", ctx, before, limit)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdatedMembers indicates an expected call of ListOutdatedMembers.
+func (mr *MockreportRepoMockRecorder) ListOutdatedMembers(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdatedMembers", reflect.TypeOf((*MockreportRepo)(nil).ListOutdatedMembers), ctx, before, limit)
+}
+
+// SaveLoad mocks base method.
+func (m *MockreportRepo) SaveLoad(ctx context.Context, userID uuid.UUID, weeks []model.MeetingLoadWeek) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLoad", ctx, userID, weeks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLoad indicates an expected call of SaveLoad.
+func (mr *MockreportRepoMockRecorder) SaveLoad(ctx, userID, weeks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoad", reflect.TypeOf((*MockreportRepo)(nil).SaveLoad), ctx, userID, weeks)
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockruleRepo is a mock of ruleRepo interface.
+type MockruleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockruleRepoMockRecorder
+}
+
+// MockruleRepoMockRecorder is the mock recorder for MockruleRepo.
+type MockruleRepoMockRecorder struct {
+	mock *MockruleRepo
+}
+
+// NewMockruleRepo creates a new mock instance.
+func NewMockruleRepo(ctrl *gomock.Controller) *MockruleRepo {
+	mock := &MockruleRepo{ctrl: ctrl}
+	mock.recorder = &MockruleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockruleRepo) EXPECT() *MockruleRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateRule mocks base method.
+func (m *MockruleRepo) CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, rule)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockruleRepoMockRecorder) CreateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockruleRepo)(nil).CreateRule), ctx, rule)
+}
+
+// DeleteRule mocks base method.
+func (m *MockruleRepo) DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, ruleID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockruleRepoMockRecorder) DeleteRule(ctx, ruleID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockruleRepo)(nil).DeleteRule), ctx, ruleID, userID)
+}
+
+// ListRules mocks base method.
+func (m *MockruleRepo) ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, userID)
+	ret0, _ := ret[0].([]model.EventRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockruleRepoMockRecorder) ListRules(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockruleRepo)(nil).ListRules), ctx, userID)
+}
+
+// UpdateRule mocks base method.
+func (m *MockruleRepo) UpdateRule(ctx context.Context, rule model.EventRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockruleRepoMockRecorder) UpdateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockruleRepo)(nil).UpdateRule), ctx, rule)
+}
+
+// MockeventLister is a mock of eventLister interface.
+type MockeventLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventListerMockRecorder
+}
+
+// MockeventListerMockRecorder is the mock recorder for MockeventLister.
+type MockeventListerMockRecorder struct {
+	mock *MockeventLister
+}
+
+// NewMockeventLister creates a new mock instance.
+func NewMockeventLister(ctrl *gomock.Controller) *MockeventLister {
+	mock := &MockeventLister{ctrl: ctrl}
+	mock.recorder = &MockeventListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventLister) EXPECT() *MockeventListerMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockeventLister) ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, filter)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockeventListerMockRecorder) ListEvents(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockeventLister)(nil).ListEvents), ctx, userID, filter)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
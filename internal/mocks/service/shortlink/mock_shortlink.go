@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockshortLinkRepo is a mock of shortLinkRepo interface.
+type MockshortLinkRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortLinkRepoMockRecorder
+}
+
+// MockshortLinkRepoMockRecorder is the mock recorder for MockshortLinkRepo.
+type MockshortLinkRepoMockRecorder struct {
+	mock *MockshortLinkRepo
+}
+
+// NewMockshortLinkRepo creates a new mock instance.
+func NewMockshortLinkRepo(ctrl *gomock.Controller) *MockshortLinkRepo {
+	mock := &MockshortLinkRepo{ctrl: ctrl}
+	mock.recorder = &MockshortLinkRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortLinkRepo) EXPECT() *MockshortLinkRepoMockRecorder {
+	return m.recorder
+}
+
+// CountAttending mocks base method.
+func (m *MockshortLinkRepo) CountAttending(ctx context.Context, eventID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAttending", ctx, eventID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAttending indicates an expected call of CountAttending.
+func (mr *MockshortLinkRepoMockRecorder) CountAttending(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttending", reflect.TypeOf((*MockshortLinkRepo)(nil).CountAttending), ctx, eventID)
+}
+
+// CreateShortLink mocks base method.
+func (m *MockshortLinkRepo) CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShortLink", ctx, link)
+	ret0, _ := ret[0].(model.ShortLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShortLink indicates an expected call of CreateShortLink.
+func (mr *MockshortLinkRepoMockRecorder) CreateShortLink(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShortLink", reflect.TypeOf((*MockshortLinkRepo)(nil).CreateShortLink), ctx, link)
+}
+
+// DeleteShortLink mocks base method.
+func (m *MockshortLinkRepo) DeleteShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShortLink", ctx, code, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShortLink indicates an expected call of DeleteShortLink.
+func (mr *MockshortLinkRepoMockRecorder) DeleteShortLink(ctx, code, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShortLink", reflect.TypeOf((*MockshortLinkRepo)(nil).DeleteShortLink), ctx, code, eventID, userID)
+}
+
+// GetLinkedEvent mocks base method.
+func (m *MockshortLinkRepo) GetLinkedEvent(ctx context.Context, code string) (model.ShortLink, model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkedEvent", ctx, code)
+	ret0, _ := ret[0].(model.ShortLink)
+	ret1, _ := ret[1].(model.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLinkedEvent indicates an expected call of GetLinkedEvent.
+func (mr *MockshortLinkRepoMockRecorder) GetLinkedEvent(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkedEvent", reflect.TypeOf((*MockshortLinkRepo)(nil).GetLinkedEvent), ctx, code)
+}
+
+// ListShortLinks mocks base method.
+func (m *MockshortLinkRepo) ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShortLinks", ctx, eventID, userID)
+	ret0, _ := ret[0].([]model.ShortLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShortLinks indicates an expected call of ListShortLinks.
+func (mr *MockshortLinkRepoMockRecorder) ListShortLinks(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShortLinks", reflect.TypeOf((*MockshortLinkRepo)(nil).ListShortLinks), ctx, eventID, userID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
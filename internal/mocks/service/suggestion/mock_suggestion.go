@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocksuggestionRepo is a mock of suggestionRepo interface.
+type MocksuggestionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocksuggestionRepoMockRecorder
+}
+
+// MocksuggestionRepoMockRecorder is the mock recorder for MocksuggestionRepo.
+type MocksuggestionRepoMockRecorder struct {
+	mock *MocksuggestionRepo
+}
+
+// NewMocksuggestionRepo creates a new mock instance.
+func NewMocksuggestionRepo(ctrl *gomock.Controller) *MocksuggestionRepo {
+	mock := &MocksuggestionRepo{ctrl: ctrl}
+	mock.recorder = &MocksuggestionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksuggestionRepo) EXPECT() *MocksuggestionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetModel mocks base method.
+func (m *MocksuggestionRepo) GetModel(ctx context.Context, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModel", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModel indicates an expected call of GetModel.
+func (mr *MocksuggestionRepoMockRecorder) GetModel(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModel", reflect.TypeOf((*MocksuggestionRepo)(nil).GetModel), ctx, userID)
+}
+
+// ListOutdatedUsers mocks base method.
+func (m *MocksuggestionRepo) ListOutdatedUsers(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutdatedUsers", ctx, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdatedUsers indicates an expected call of ListOutdatedUsers.
+func (mr *MocksuggestionRepoMockRecorder) ListOutdatedUsers(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdatedUsers", reflect.TypeOf((*MocksuggestionRepo)(nil).ListOutdatedUsers), ctx, limit)
+}
+
+// ListRecentEvents mocks base method.
+func (m *MocksuggestionRepo) ListRecentEvents(ctx context.Context, userID uuid.UUID, limit int) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentEvents indicates an expected call of ListRecentEvents.
+func (mr *MocksuggestionRepoMockRecorder) ListRecentEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentEvents", reflect.TypeOf((*MocksuggestionRepo)(nil).ListRecentEvents), ctx, userID, limit)
+}
+
+// SaveModel mocks base method.
+func (m *MocksuggestionRepo) SaveModel(ctx context.Context, userID uuid.UUID, data string, events int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveModel", ctx, userID, data, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveModel indicates an expected call of SaveModel.
+func (mr *MocksuggestionRepoMockRecorder) SaveModel(ctx, userID, data, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveModel", reflect.TypeOf((*MocksuggestionRepo)(nil).SaveModel), ctx, userID, data, events)
+}
+
+// MockprojectLister is a mock of projectLister interface.
+type MockprojectLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectListerMockRecorder
+}
+
+// MockprojectListerMockRecorder is the mock recorder for MockprojectLister.
+type MockprojectListerMockRecorder struct {
+	mock *MockprojectLister
+}
+
+// NewMockprojectLister creates a new mock instance.
+func NewMockprojectLister(ctrl *gomock.Controller) *MockprojectLister {
+	mock := &MockprojectLister{ctrl: ctrl}
+	mock.recorder = &MockprojectListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectLister) EXPECT() *MockprojectListerMockRecorder {
+	return m.recorder
+}
+
+// ListProjects mocks base method.
+func (m *MockprojectLister) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, userID)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockprojectListerMockRecorder) ListProjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockprojectLister)(nil).ListProjects), ctx, userID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
+
+// Encrypt mocks base method.
+func (m *MockcontentCipher) Encrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockcontentCipherMockRecorder) Encrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockcontentCipher)(nil).Encrypt), ctx, userID, value)
+}
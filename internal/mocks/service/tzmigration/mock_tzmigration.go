@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MocklegacyDateRepo is a mock of legacyDateRepo interface.
+type MocklegacyDateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocklegacyDateRepoMockRecorder
+}
+
+// MocklegacyDateRepoMockRecorder is the mock recorder for MocklegacyDateRepo.
+type MocklegacyDateRepoMockRecorder struct {
+	mock *MocklegacyDateRepo
+}
+
+// NewMocklegacyDateRepo creates a new mock instance.
+func NewMocklegacyDateRepo(ctrl *gomock.Controller) *MocklegacyDateRepo {
+	mock := &MocklegacyDateRepo{ctrl: ctrl}
+	mock.recorder = &MocklegacyDateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklegacyDateRepo) EXPECT() *MocklegacyDateRepoMockRecorder {
+	return m.recorder
+}
+
+// CountLegacyDates mocks base method.
+func (m *MocklegacyDateRepo) CountLegacyDates(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLegacyDates", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLegacyDates indicates an expected call of CountLegacyDates.
+func (mr *MocklegacyDateRepoMockRecorder) CountLegacyDates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLegacyDates", reflect.TypeOf((*MocklegacyDateRepo)(nil).CountLegacyDates), ctx)
+}
+
+// ListLegacyDates mocks base method.
+func (m *MocklegacyDateRepo) ListLegacyDates(ctx context.Context, after uuid.UUID, limit int) ([]model.LegacyEventDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLegacyDates", ctx, after, limit)
+	ret0, _ := ret[0].([]model.LegacyEventDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLegacyDates indicates an expected call of ListLegacyDates.
+func (mr *MocklegacyDateRepoMockRecorder) ListLegacyDates(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegacyDates", reflect.TypeOf((*MocklegacyDateRepo)(nil).ListLegacyDates), ctx, after, limit)
+}
+
+// MigrateDates mocks base method.
+func (m *MocklegacyDateRepo) MigrateDates(ctx context.Context, dates []model.LegacyEventDate, newDates []time.Time, done []uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDates", ctx, dates, newDates, done)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateDates indicates an expected call of MigrateDates.
+func (mr *MocklegacyDateRepoMockRecorder) MigrateDates(ctx, dates, newDates, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDates", reflect.TypeOf((*MocklegacyDateRepo)(nil).MigrateDates), ctx, dates, newDates, done)
+}
+
+// MockjobService is a mock of jobService interface.
+type MockjobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobServiceMockRecorder
+}
+
+// MockjobServiceMockRecorder is the mock recorder for MockjobService.
+type MockjobServiceMockRecorder struct {
+	mock *MockjobService
+}
+
+// NewMockjobService creates a new mock instance.
+func NewMockjobService(ctrl *gomock.Controller) *MockjobService {
+	mock := &MockjobService{ctrl: ctrl}
+	mock.recorder = &MockjobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobService) EXPECT() *MockjobServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockjobService) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockjobServiceMockRecorder) Enqueue(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockjobService)(nil).Enqueue), ctx, job)
+}
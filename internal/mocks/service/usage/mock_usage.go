@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockusageRepo is a mock of usageRepo interface.
+type MockusageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockusageRepoMockRecorder
+}
+
+// MockusageRepoMockRecorder is the mock recorder for MockusageRepo.
+type MockusageRepoMockRecorder struct {
+	mock *MockusageRepo
+}
+
+// NewMockusageRepo creates a new mock instance.
+func NewMockusageRepo(ctrl *gomock.Controller) *MockusageRepo {
+	mock := &MockusageRepo{ctrl: ctrl}
+	mock.recorder = &MockusageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusageRepo) EXPECT() *MockusageRepoMockRecorder {
+	return m.recorder
+}
+
+// GetCalls mocks base method.
+func (m *MockusageRepo) GetCalls(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalls", ctx, userID, period)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalls indicates an expected call of GetCalls.
+func (mr *MockusageRepoMockRecorder) GetCalls(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalls", reflect.TypeOf((*MockusageRepo)(nil).GetCalls), ctx, userID, period)
+}
+
+// Increment mocks base method.
+func (m *MockusageRepo) Increment(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, userID, period)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockusageRepoMockRecorder) Increment(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockusageRepo)(nil).Increment), ctx, userID, period)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,21 @@ func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountUsers mocks base method.
+func (m *MockuserRepository) CountUsers(ctx context.Context, email, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", ctx, email, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockuserRepositoryMockRecorder) CountUsers(ctx, email, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockuserRepository)(nil).CountUsers), ctx, email, mode)
+}
+
 // CreateUser mocks base method.
 func (m *MockuserRepository) CreateUser(ctx context.Context, user model.User) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +68,36 @@ func (mr *MockuserRepositoryMockRecorder) CreateUser(ctx, user interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockuserRepository)(nil).CreateUser), ctx, user)
 }
 
+// DeleteDemoUsers mocks base method.
+func (m *MockuserRepository) DeleteDemoUsers(ctx context.Context, createdBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDemoUsers", ctx, createdBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDemoUsers indicates an expected call of DeleteDemoUsers.
+func (mr *MockuserRepositoryMockRecorder) DeleteDemoUsers(ctx, createdBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDemoUsers", reflect.TypeOf((*MockuserRepository)(nil).DeleteDemoUsers), ctx, createdBefore)
+}
+
+// GetTimezone mocks base method.
+func (m *MockuserRepository) GetTimezone(ctx context.Context, id uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimezone", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimezone indicates an expected call of GetTimezone.
+func (mr *MockuserRepositoryMockRecorder) GetTimezone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimezone", reflect.TypeOf((*MockuserRepository)(nil).GetTimezone), ctx, id)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockuserRepository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -66,3 +112,142 @@ func (mr *MockuserRepositoryMockRecorder) GetUserByEmail(ctx, email interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockuserRepository)(nil).GetUserByEmail), ctx, email)
 }
+
+// GetUserByID mocks base method.
+func (m *MockuserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockuserRepositoryMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockuserRepository)(nil).GetUserByID), ctx, id)
+}
+
+// ListUsers mocks base method.
+func (m *MockuserRepository) ListUsers(ctx context.Context, email string, page model.Page) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, email, page)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockuserRepositoryMockRecorder) ListUsers(ctx, email, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockuserRepository)(nil).ListUsers), ctx, email, page)
+}
+
+// UpdateRole mocks base method.
+func (m *MockuserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, id, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockuserRepositoryMockRecorder) UpdateRole(ctx, id, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockuserRepository)(nil).UpdateRole), ctx, id, role)
+}
+
+// UpdateSearchLanguage mocks base method.
+func (m *MockuserRepository) UpdateSearchLanguage(ctx context.Context, id uuid.UUID, language string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchLanguage", ctx, id, language)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSearchLanguage indicates an expected call of UpdateSearchLanguage.
+func (mr *MockuserRepositoryMockRecorder) UpdateSearchLanguage(ctx, id, language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchLanguage", reflect.TypeOf((*MockuserRepository)(nil).UpdateSearchLanguage), ctx, id, language)
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockuserRepository) UpdateTimezone(ctx context.Context, id uuid.UUID, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, id, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockuserRepositoryMockRecorder) UpdateTimezone(ctx, id, timezone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockuserRepository)(nil).UpdateTimezone), ctx, id, timezone)
+}
+
+// MocksecurityRepository is a mock of securityRepository interface.
+type MocksecurityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecurityRepositoryMockRecorder
+}
+
+// MocksecurityRepositoryMockRecorder is the mock recorder for MocksecurityRepository.
+type MocksecurityRepositoryMockRecorder struct {
+	mock *MocksecurityRepository
+}
+
+// NewMocksecurityRepository creates a new mock instance.
+func NewMocksecurityRepository(ctrl *gomock.Controller) *MocksecurityRepository {
+	mock := &MocksecurityRepository{ctrl: ctrl}
+	mock.recorder = &MocksecurityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecurityRepository) EXPECT() *MocksecurityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountSecurityEvents mocks base method.
+func (m *MocksecurityRepository) CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSecurityEvents", ctx, userID, mode)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSecurityEvents indicates an expected call of CountSecurityEvents.
+func (mr *MocksecurityRepositoryMockRecorder) CountSecurityEvents(ctx, userID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecurityEvents", reflect.TypeOf((*MocksecurityRepository)(nil).CountSecurityEvents), ctx, userID, mode)
+}
+
+// CreateSecurityEvent mocks base method.
+func (m *MocksecurityRepository) CreateSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecurityEvent indicates an expected call of CreateSecurityEvent.
+func (mr *MocksecurityRepositoryMockRecorder) CreateSecurityEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityEvent", reflect.TypeOf((*MocksecurityRepository)(nil).CreateSecurityEvent), ctx, event)
+}
+
+// ListSecurityEvents mocks base method.
+func (m *MocksecurityRepository) ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityEvents", ctx, userID, page)
+	ret0, _ := ret[0].([]model.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityEvents indicates an expected call of ListSecurityEvents.
+func (mr *MocksecurityRepositoryMockRecorder) ListSecurityEvents(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityEvents", reflect.TypeOf((*MocksecurityRepository)(nil).ListSecurityEvents), ctx, userID, page)
+}
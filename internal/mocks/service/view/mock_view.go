@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+
+	model "github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// MockviewRepo is a mock of viewRepo interface.
+type MockviewRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockviewRepoMockRecorder
+}
+
+// MockviewRepoMockRecorder is the mock recorder for MockviewRepo.
+type MockviewRepoMockRecorder struct {
+	mock *MockviewRepo
+}
+
+// NewMockviewRepo creates a new mock instance.
+func NewMockviewRepo(ctrl *gomock.Controller) *MockviewRepo {
+	mock := &MockviewRepo{ctrl: ctrl}
+	mock.recorder = &MockviewRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockviewRepo) EXPECT() *MockviewRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateView mocks base method.
+func (m *MockviewRepo) CreateView(ctx context.Context, view model.View) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *MockviewRepoMockRecorder) CreateView(ctx, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*MockviewRepo)(nil).CreateView), ctx, view)
+}
+
+// DeleteView mocks base method.
+func (m *MockviewRepo) DeleteView(ctx context.Context, viewID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteView", ctx, viewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteView indicates an expected call of DeleteView.
+func (mr *MockviewRepoMockRecorder) DeleteView(ctx, viewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*MockviewRepo)(nil).DeleteView), ctx, viewID, userID)
+}
+
+// GetView mocks base method.
+func (m *MockviewRepo) GetView(ctx context.Context, viewID, userID uuid.UUID) (model.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetView", ctx, viewID, userID)
+	ret0, _ := ret[0].(model.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetView indicates an expected call of GetView.
+func (mr *MockviewRepoMockRecorder) GetView(ctx, viewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*MockviewRepo)(nil).GetView), ctx, viewID, userID)
+}
+
+// ListEvents mocks base method.
+func (m *MockviewRepo) ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, filter)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockviewRepoMockRecorder) ListEvents(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockviewRepo)(nil).ListEvents), ctx, userID, filter)
+}
+
+// ListViews mocks base method.
+func (m *MockviewRepo) ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListViews", ctx, userID)
+	ret0, _ := ret[0].([]model.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListViews indicates an expected call of ListViews.
+func (mr *MockviewRepoMockRecorder) ListViews(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListViews", reflect.TypeOf((*MockviewRepo)(nil).ListViews), ctx, userID)
+}
+
+// MockcontentCipher is a mock of contentCipher interface.
+type MockcontentCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentCipherMockRecorder
+}
+
+// MockcontentCipherMockRecorder is the mock recorder for MockcontentCipher.
+type MockcontentCipherMockRecorder struct {
+	mock *MockcontentCipher
+}
+
+// NewMockcontentCipher creates a new mock instance.
+func NewMockcontentCipher(ctrl *gomock.Controller) *MockcontentCipher {
+	mock := &MockcontentCipher{ctrl: ctrl}
+	mock.recorder = &MockcontentCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentCipher) EXPECT() *MockcontentCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockcontentCipher) Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, userID, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockcontentCipherMockRecorder) Decrypt(ctx, userID, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockcontentCipher)(nil).Decrypt), ctx, userID, value)
+}
@@ -0,0 +1,25 @@
+package model
+
+import "github.com/google/uuid"
+
+// ArchiveVerification is the request of an archive verification job, which compares the reminders of archived
+// events in archived_reminders with the copy stored with the events in the new archive format.
+type ArchiveVerification struct {
+	Backfill bool `json:"backfill"` // whether events not yet written in the new format are backfilled first
+}
+
+// ArchiveVerificationResult is the result of an archive verification job.
+type ArchiveVerificationResult struct {
+	Backfilled    int         `json:"backfilled"`     // events written in the new format by the backfill
+	Checked       int         `json:"checked"`        // archived events compared
+	Missing       int         `json:"missing"`        // events not written in the new format
+	Mismatched    int         `json:"mismatched"`     // events whose formats differ
+	MismatchedIDs []uuid.UUID `json:"mismatched_ids"` // first mismatched events, at most 100
+}
+
+// ArchiveFormatCheck is the comparison of the old and new archive format of an archived event.
+type ArchiveFormatCheck struct {
+	EventID uuid.UUID // identifier of the archived event
+	Written bool      // whether the event has been written in the new format
+	Matches bool      // whether both formats hold the same reminders; false if the new one is not written
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attendee statuses, the response of an invited user.
+const (
+	AttendeeInvited  = "invited"  // no response yet
+	AttendeeAccepted = "accepted" // the user takes part in the event
+	AttendeeDeclined = "declined" // the user does not take part; the event is hidden from their calendar
+)
+
+// Attendee is a user invited to the event of another user.
+// Attendees see the event in their calendar but cannot change or delete it.
+// People without an account are invited by email address as external attendees; they have no user ID
+// and answer through the RSVP link of their invitation until they register with the address.
+type Attendee struct {
+	EventID     uuid.UUID  `json:"event_id"`     // identifier of the event
+	UserID      uuid.UUID  `json:"user_id"`      // identifier of the invited user
+	Email       string     `json:"email"`        // email address of the invited user
+	Name        string     `json:"name"`         // name of the invited user
+	Status      string     `json:"status"`       // response to the invitation: invited, accepted or declined
+	InvitedAt   time.Time  `json:"invited_at"`   // timestamp when the user was invited
+	RespondedAt *time.Time `json:"responded_at"` // timestamp of the last response; nil before the first one
+	GroupID     *uuid.UUID `json:"group_id"`     // group the user was invited through; nil for individual invitations
+	External    bool       `json:"external"`     // invited by email address without an account; UserID is uuid.Nil
+	LateETA     *time.Time `json:"late_eta"`     // time the attendee expects to arrive if they reported running late; nil otherwise
+}
+
+// Invitation is the invitation of an external attendee, with the event its email shows and attaches.
+type Invitation struct {
+	Attendee  Attendee // the external attendee
+	Event     Event    // the event, with its title encrypted as stored and without description
+	Organizer string   // name of the owner of the event
+}
+
+// LateNotice is the report of an attendee running late for an event, sent to its organizer and the other attendees.
+type LateNotice struct {
+	Attendee       Attendee // the late attendee, with their expected arrival
+	Event          Event    // the event, with its title encrypted as stored
+	OrganizerEmail string   // email address of the owner of the event
+}
+
+// Availability statuses of an attendee at the time of an event.
+const (
+	AvailabilityFree    = "free"    // no other event of the attendee overlaps the event
+	AvailabilityBusy    = "busy"    // another event of the attendee overlaps the event
+	AvailabilityUnknown = "unknown" // the attendee has no calendar to check: they are external or declined
+)
+
+// BusyPeriod is a period an attendee has other events. It tells nothing about the events: overlapping and adjacent
+// events are merged into one period.
+type BusyPeriod struct {
+	Start time.Time // start of the period
+	End   time.Time // end of the period
+}
+
+// Availability is the free/busy status of an attendee around the time of an event, shown to its organizer.
+type Availability struct {
+	UserID uuid.UUID    // identifier of the attendee; uuid.Nil for external attendees
+	Email  string       // email address of the attendee
+	Status string       // free, busy or unknown at the time of the event
+	Busy   []BusyPeriod // busy periods around the event, ordered by start; nil if the status is unknown
+}
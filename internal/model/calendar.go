@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultCalendarName is the name of the calendar created for every user at registration.
+const DefaultCalendarName = "Personal"
+
+// Calendar is a named collection of events of a user, e.g. "Work" or "Personal".
+// Every event belongs to exactly one calendar of its owner; events created without one go to the default calendar.
+type Calendar struct {
+	ID        uuid.UUID `json:"id"`         // unique identifier for the calendar
+	UserID    uuid.UUID `json:"user_id"`    // identifier of the user who owns the calendar
+	Name      string    `json:"name"`       // name of the calendar
+	Color     string    `json:"color"`      // optional display color, a palette name or #rrggbb
+	IsDefault bool      `json:"is_default"` // whether events without a calendar go to this calendar
+	CreatedAt time.Time `json:"created_at"` // timestamp when the calendar was created
+	UpdatedAt time.Time `json:"updated_at"` // timestamp when the calendar was last updated
+}
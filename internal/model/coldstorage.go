@@ -0,0 +1,25 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// ColdEvent is an archived event ready to be exported to cold storage.
+type ColdEvent struct {
+	ID     uuid.UUID       // identifier of the event
+	UserID uuid.UUID       // identifier of the user who owns the event
+	Record json.RawMessage // the archived event and its reminders as a JSON object, one line of an export
+}
+
+// ColdStorageRestore is a request to restore events exported to cold storage back into the archive.
+type ColdStorageRestore struct {
+	UserID *uuid.UUID `json:"user_id"` // restore only the events of this user; all events when nil
+}
+
+// ColdStorageRestoreResult reports the outcome of a cold storage restore job.
+type ColdStorageRestoreResult struct {
+	Objects  int `json:"objects"`  // exports read from the object store
+	Restored int `json:"restored"` // events restored into the archive
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Contact is a person a user invites to events, suggested when the user types an attendee.
+// Contacts are ranked by how often, then how recently, the user invited them.
+type Contact struct {
+	ID            uuid.UUID  `json:"id"`              // unique identifier for the contact
+	UserID        uuid.UUID  `json:"user_id"`         // identifier of the user the contact belongs to
+	Email         string     `json:"email"`           // email address of the contact, lowercased
+	Name          string     `json:"name"`            // display name of the contact; may be empty
+	ContactUserID *uuid.UUID `json:"contact_user_id"` // registered user with the email address, if any
+	InviteCount   int        `json:"invite_count"`    // number of invitations the user sent to the contact
+	LastInvitedAt *time.Time `json:"last_invited_at"` // timestamp of the latest invitation; nil if never invited
+	CreatedAt     time.Time  `json:"created_at"`      // timestamp when the contact was added
+}
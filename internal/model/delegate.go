@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delegate is a user allowed to create events in the calendar of another user.
+// Delegates cannot read, change or delete the owner's events.
+type Delegate struct {
+	OwnerID    uuid.UUID `json:"owner_id"`    // identifier of the user whose calendar the delegate creates events in
+	DelegateID uuid.UUID `json:"delegate_id"` // identifier of the delegate
+	Email      string    `json:"email"`       // email address of the delegate
+	CreatedAt  time.Time `json:"created_at"`  // timestamp when the delegate was added
+}
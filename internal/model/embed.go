@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Embed publishes the events of a user, or of one of their projects, under a share token
+// so they can be embedded into websites without authentication.
+type Embed struct {
+	ID             uuid.UUID  `json:"id"`              // unique identifier for the embed
+	UserID         uuid.UUID  `json:"user_id"`         // identifier of the user whose events are published
+	Name           string     `json:"name"`            // name of the embed, shown as the calendar title
+	ProjectID      *uuid.UUID `json:"project_id"`      // only events of this project; all events of the user when nil
+	AllowedDomains []string   `json:"allowed_domains"` // hosts allowed to embed the calendar, subdomains included; any when empty
+	RangeDays      int        `json:"range_days"`      // days from today shown when no range is requested
+	Token          string     `json:"token,omitempty"` // share token; only known right after creation, since just its hash is stored
+	CreatedAt      time.Time  `json:"created_at"`      // timestamp when the embed was created
+}
+
+// EmbedCalendar is the content of an embedded calendar for a date range.
+type EmbedCalendar struct {
+	Embed  Embed     // the embed the calendar is published by
+	From   time.Time // first day of the range, inclusive
+	To     time.Time // end of the range, exclusive
+	Events []Event   // published events ordered by date
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,14 +9,188 @@ import (
 
 // Event represents an event in the calendar service.
 // It contains details about the event, including its unique ID, associated user,
-// date, title, description, optional reminder time, and timestamps for creation and updates.
+// date, optional end, title, description, location, priority, color and tags, optional reminder time and notification overrides,
+// optional recurrence, and timestamps for creation and updates.
+// A recurring event is stored once; list queries return one event per occurrence, with EventDate set to the occurrence
+// and EndDate moved along by the same amount.
 type Event struct {
-	ID          uuid.UUID  `json:"id"`          // unique identifier for the event
-	UserID      uuid.UUID  `json:"user_id"`     // identifier of the user who owns the event
-	EventDate   time.Time  `json:"event_date"`  // date and time when the event occurs
-	Title       string     `json:"title"`       // title of the event
-	Description string     `json:"description"` // optional description of the event
-	ReminderAt  *time.Time `json:"reminder_at"` // optional time for sending a reminder
-	CreatedAt   time.Time  `json:"created_at"`  // timestamp when the event was created
-	UpdatedAt   time.Time  `json:"updated_at"`  // timestamp when the event was last updated
+	ID                   uuid.UUID            `json:"id"`                    // unique identifier for the event
+	UserID               uuid.UUID            `json:"user_id"`               // identifier of the user who owns the event
+	EventDate            time.Time            `json:"event_date"`            // date and time when the event occurs
+	EndDate              *time.Time           `json:"end_date"`              // optional end of the event, after EventDate; nil for events without a duration
+	Title                string               `json:"title"`                 // title of the event
+	Description          string               `json:"description"`           // optional description of the event
+	Location             string               `json:"location"`              // optional free-text place of the event, e.g. an address or a room
+	Latitude             *float64             `json:"latitude"`              // optional latitude of the location in degrees; set together with Longitude
+	Longitude            *float64             `json:"longitude"`             // optional longitude of the location in degrees; set together with Latitude
+	Priority             string               `json:"priority"`              // priority of the event (low, normal, high, critical)
+	ProjectID            *uuid.UUID           `json:"project_id"`            // optional project the event belongs to
+	CalendarID           *uuid.UUID           `json:"calendar_id"`           // calendar of the owner the event belongs to; nil on create for their default calendar
+	Color                string               `json:"color"`                 // optional display color, a palette name or #rrggbb
+	Tags                 []string             `json:"tags"`                  // labels of the event, set by the user or by rules
+	ReminderAt           *time.Time           `json:"reminder_at"`           // optional time for sending a reminder
+	ReminderTimezone     string               `json:"reminder_timezone"`     // optional IANA time zone ReminderAt is a wall-clock time in
+	Notifications        *EventNotifications  `json:"notifications"`         // optional overrides of the owner's notification preferences
+	RecurrenceRule       string               `json:"recurrence_rule"`       // optional RFC 5545 RRULE repeating the event from EventDate
+	RecurrenceExceptions []time.Time          `json:"recurrence_exceptions"` // occurrences of a recurring event that were deleted or detached
+	Overrides            []OccurrenceOverride `json:"overrides,omitempty"`   // changed occurrences of a recurring event, ordered by occurrence; only set by list queries
+	CreatedAt            time.Time            `json:"created_at"`            // timestamp when the event was created
+	UpdatedAt            time.Time            `json:"updated_at"`            // timestamp when the event was last updated
+	AttendeeStatus       string               `json:"attendee_status"`       // invitation status of the user listing the event; empty for their own events
+	FollowerCount        int                  `json:"follower_count"`        // number of users following the event
+	DeletedAt            *time.Time           `json:"deleted_at,omitempty"`  // time the event was moved to the trash; only set in trash listings
+}
+
+// EventNotifications overrides the notification preferences of the owner of an event for its reminders.
+// Unset fields follow the user's preferences. Followers and attendees keep their own preferences.
+type EventNotifications struct {
+	Channel   string        `json:"channel,omitempty"`   // channel reminders are sent through; empty for email
+	LeadTime  time.Duration `json:"lead_time,omitempty"` // time before the start the reminder is sent at, replacing ReminderAt; 0 for none
+	Reminders *bool         `json:"reminders,omitempty"` // whether reminders are sent regardless of the user's subscription; nil follows it
+}
+
+// Event priorities.
+const (
+	PriorityLow      = "low"      // informational events
+	PriorityNormal   = "normal"   // default priority
+	PriorityHigh     = "high"     // important events
+	PriorityCritical = "critical" // events that must not be missed; reminded earlier by default
+)
+
+// ColorPalette lists the named colors of events and calendars, in the order clients present them.
+// Colors can also be given as #rrggbb; the names are CSS color names, so they are understood everywhere.
+var ColorPalette = []string{"red", "orange", "yellow", "green", "teal", "blue", "purple", "pink", "gray"}
+
+// MaxEventDuration is the longest time an event may last, from its date to its end.
+// It bounds how far back range queries look for events that started before the range.
+const MaxEventDuration = 366 * 24 * time.Hour
+
+// attendeeOnlyFields lists the JSON names of the fields of an event only its owner and the attendees who accepted it
+// see. Invitees who have not answered see the other fields, such as its title and time.
+var attendeeOnlyFields = []string{"description"}
+
+// Visible reports whether the user the event was read for sees a field of it, by their relationship to the event:
+// its owner, whose AttendeeStatus is empty, and attendees who accepted it see every field, other invitees only
+// the fields not restricted to attendees.
+//
+// Parameters:
+//   - field: The JSON name of the field, e.g. "description".
+//
+// Returns:
+//   - True if the field may be shown to the user.
+func (e Event) Visible(field string) bool {
+	if e.AttendeeStatus == "" || e.AttendeeStatus == AttendeeAccepted {
+		return true
+	}
+	return !slices.Contains(attendeeOnlyFields, field)
+}
+
+// End returns the time the event is over: its end if it has one, otherwise its date.
+func (e Event) End() time.Time {
+	if e.EndDate != nil {
+		return *e.EndDate
+	}
+	return e.EventDate
+}
+
+// TrashNotice tells a user that events in their trash are about to be purged.
+type TrashNotice struct {
+	UserID  uuid.UUID     // owner of the trashed events
+	Email   string        // address the notice is sent to
+	Events  int           // number of trashed events about to be purged
+	PurgeIn time.Duration // time left until they are purged, the notice period
+}
+
+// OccurrenceOverride changes the time or title of a single occurrence of a recurring event.
+// Unlike a detached occurrence, it stays part of the series and is listed, published and reminded of with it.
+type OccurrenceOverride struct {
+	Occurrence time.Time  `json:"occurrence"` // start of the occurrence as generated by the rule
+	EventDate  time.Time  `json:"event_date"` // start of the occurrence instead
+	EndDate    *time.Time `json:"end_date"`   // end of the occurrence; nil keeps the duration of the series
+	Title      string     `json:"title"`      // title of the occurrence; empty keeps the title of the series
+}
+
+// Override returns a recurring event moved to an overridden occurrence: with the date, end and title of the override,
+// and the reminder moved along with the date. The end of an event without one is only set by an override that has one.
+//
+// Parameters:
+//   - o: The override of one of the event's occurrences.
+//
+// Returns:
+//   - The overridden occurrence; Overrides is kept.
+func (e Event) Override(o OccurrenceOverride) Event {
+	shift := o.EventDate.Sub(o.Occurrence)
+	if o.EndDate != nil {
+		end := *o.EndDate
+		e.EndDate = &end
+	} else if e.EndDate != nil {
+		end := o.EventDate.Add(e.EndDate.Sub(e.EventDate))
+		e.EndDate = &end
+	}
+	if e.ReminderAt != nil {
+		at := e.ReminderAt.Add(shift)
+		e.ReminderAt = &at
+	}
+	if o.Title != "" {
+		e.Title = o.Title
+	}
+	e.EventDate = o.EventDate
+
+	return e
+}
+
+// EventChange is a change to a field of an event its attendees are notified of.
+type EventChange struct {
+	Field  string // JSON name of the changed field, e.g. event_date
+	Before string // value before the change, formatted for notifications; empty if unset
+	After  string // value after the change, formatted for notifications; empty if unset
+}
+
+// EventListOptions holds optional parameters for event list queries.
+type EventListOptions struct {
+	Fields     []string       // subset of event fields to return; all fields when empty
+	Location   *time.Location // time zone the boundaries of days, weeks and months are computed in; UTC when nil
+	CalendarID *uuid.UUID     // only events of this calendar; events of all calendars when nil
+}
+
+// EventSummary holds the number of events of a user in a date range, without the events themselves.
+// Every occurrence of a recurring event counts as an event.
+type EventSummary struct {
+	Total     int             // number of events in the range
+	Days      []DayCount      // number of events per day, only days with events, ordered by date
+	Calendars []CalendarCount // number of events per calendar, events without a calendar have a nil CalendarID
+	Tags      []TagCount      // number of events per tag, ordered by tag; an event counts once for each of its tags
+}
+
+// DayCount holds the number of events on a single day.
+type DayCount struct {
+	Date  time.Time // the day
+	Count int       // number of events on the day
+}
+
+// CalendarCount holds the number of events of a single calendar.
+type CalendarCount struct {
+	CalendarID *uuid.UUID // the calendar; nil for events without a calendar
+	Count      int        // number of events of the calendar
+}
+
+// TagCount holds the number of events with a single tag.
+type TagCount struct {
+	Tag   string // the tag
+	Count int    // number of events with the tag
+}
+
+// MonthGrid holds the weeks a calendar UI renders for a month, including the leading and trailing
+// days of the adjacent months, with the events of every day.
+type MonthGrid struct {
+	Month     time.Time      // first day of the month
+	WeekStart time.Weekday   // first day of every week row
+	Days      []MonthGridDay // all days of the grid in order, a multiple of seven
+}
+
+// MonthGridDay holds a single day of a month grid.
+type MonthGridDay struct {
+	Date    time.Time // the day
+	InMonth bool      // whether the day belongs to the month, as opposed to an adjacent month
+	Events  []Event   // events of the day ordered by date, never nil
 }
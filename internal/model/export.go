@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Layouts of a PDF agenda.
+const (
+	LayoutWeek  = "week"  // one page per week listing the events of every day
+	LayoutMonth = "month" // one page per month with a grid of days
+)
+
+// PDFExport describes a printable agenda of a user's events. It is the payload of a PDF export job.
+type PDFExport struct {
+	From      time.Time    `json:"from"`       // first day of the range, midnight UTC
+	To        time.Time    `json:"to"`         // last day of the range, inclusive, midnight UTC
+	Layout    string       `json:"layout"`     // week or month
+	Timezone  string       `json:"timezone"`   // IANA time zone days and times are shown in; empty means UTC
+	WeekStart time.Weekday `json:"week_start"` // first day of every week
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Feed publishes the events of a user, or of one of their projects, as an ICS file under a secret token,
+// so calendar clients such as Google Calendar or Outlook can subscribe to it.
+type Feed struct {
+	ID        uuid.UUID  `json:"id"`              // unique identifier for the feed
+	UserID    uuid.UUID  `json:"user_id"`         // identifier of the user whose events are published
+	Name      string     `json:"name"`            // name of the feed, shown as the calendar name by clients
+	ProjectID *uuid.UUID `json:"project_id"`      // only events of this project; all events of the user when nil
+	Token     string     `json:"token,omitempty"` // secret token; only known right after creation or regeneration
+	CreatedAt time.Time  `json:"created_at"`      // timestamp when the feed was created
+}
+
+// FeedCalendar is the content of a feed.
+type FeedCalendar struct {
+	Feed   Feed    // the feed
+	Events []Event // published events ordered by date
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Follower is a user watching an event on a shared or public calendar of another user, without attending it.
+// Followers receive the reminders of the event but are not listed as attendees.
+type Follower struct {
+	EventID    uuid.UUID `json:"event_id"`    // identifier of the followed event
+	UserID     uuid.UUID `json:"user_id"`     // identifier of the following user
+	FollowedAt time.Time `json:"followed_at"` // timestamp when the user started following the event
+}
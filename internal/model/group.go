@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendeeGroup is a named list of registered users, e.g. "Backend team", that its owner invites to events in one
+// step. Events keep following the membership of the groups invited to them until they start.
+type AttendeeGroup struct {
+	ID          uuid.UUID     `json:"id"`                // unique identifier for the group
+	UserID      uuid.UUID     `json:"user_id"`           // identifier of the user who owns the group
+	Name        string        `json:"name"`              // name of the group, unique per user
+	MemberCount int           `json:"member_count"`      // number of members of the group
+	Members     []GroupMember `json:"members,omitempty"` // members of the group, only when a single group is retrieved
+	CreatedAt   time.Time     `json:"created_at"`        // timestamp when the group was created
+	UpdatedAt   time.Time     `json:"updated_at"`        // timestamp when the group was last renamed
+}
+
+// GroupMember is a registered user who belongs to an attendee group.
+type GroupMember struct {
+	GroupID uuid.UUID `json:"group_id"` // identifier of the group
+	UserID  uuid.UUID `json:"user_id"`  // identifier of the member
+	Email   string    `json:"email"`    // email address of the member
+	Name    string    `json:"name"`     // name of the member
+	AddedAt time.Time `json:"added_at"` // timestamp when the user was added to the group
+}
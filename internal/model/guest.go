@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestPending is the status of a guest who asked for an RSVP link and has not opened it yet.
+// Opening the link sets their status to AttendeeAccepted or AttendeeDeclined.
+const GuestPending = "pending"
+
+// Guest is a person without an account answering the invitation of an event shared by a short link.
+// Guests are kept apart from attendees: they do not see the event in a calendar and are not notified of changes,
+// but the guests who accepted count towards its attendance.
+type Guest struct {
+	EventID     uuid.UUID  `json:"event_id"`     // identifier of the event
+	Email       string     `json:"email"`        // email address of the guest, in lower case
+	Status      string     `json:"status"`       // pending until the guest opens their RSVP link, then accepted or declined
+	RequestedAt time.Time  `json:"requested_at"` // time the latest RSVP link was emailed
+	RespondedAt *time.Time `json:"responded_at"` // time of the last response; nil before the first one
+}
+
+// GuestRequest is the request of a guest for an RSVP link, with the shared event its email names.
+type GuestRequest struct {
+	Guest      Guest  // the guest
+	Event      Event  // the event, with its title encrypted as stored and its date
+	Visibility string // visibility of the short link; busy links do not reveal the title
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job statuses.
+const (
+	JobQueued    = "queued"    // waiting for a worker
+	JobRunning   = "running"   // being executed by a worker
+	JobCompleted = "completed" // finished successfully
+	JobFailed    = "failed"    // stopped with an error or interrupted
+	JobCancelled = "cancelled" // cancelled by the user
+)
+
+// Job kinds.
+const (
+	JobCalendarImport      = "calendar_import"      // import of a Google Takeout or Apple Calendar archive
+	JobPDFExport           = "pdf_export"           // printable PDF agenda of a date range
+	JobTimezoneMigration   = "timezone_migration"   // backfill of event dates stored without a time zone
+	JobColdStorageRestore  = "cold_storage_restore" // restore of events exported to cold storage into the archive
+	JobArchiveVerification = "archive_verification" // backfill and comparison of the new archive format with the old one
+)
+
+// Job is a long-running operation executed in the background by the job worker pool,
+// such as a calendar import. Its progress is stored while it runs, so it can be polled.
+type Job struct {
+	ID              uuid.UUID       // unique identifier for the job
+	UserID          uuid.UUID       // identifier of the user who started the job
+	Kind            string          // kind of operation; selects the runner
+	Status          string          // execution status (queued, running, completed, failed, cancelled)
+	Payload         []byte          // input of the job, e.g. an uploaded archive; dropped once the job ends
+	Total           int             // units of work, e.g. events to import; 0 if unknown
+	Processed       int             // units of work done so far
+	Result          json.RawMessage // kind-specific outcome, e.g. import counters; nil until reported
+	Error           string          // reason of a failed job; empty otherwise
+	CancelRequested bool            // whether the user asked to cancel the running job
+	Output          []byte          // file produced by the job, e.g. a PDF; only loaded for downloads
+	OutputType      string          // media type of the output; empty if the job produced no file
+	CreatedAt       time.Time       // timestamp when the job was queued
+	StartedAt       *time.Time      // timestamp when a worker picked up the job; nil while queued
+	UpdatedAt       time.Time       // timestamp of the last progress update
+	FinishedAt      *time.Time      // timestamp when the job ended; nil while queued or running
+}
+
+// Finished reports whether the job has ended.
+//
+// Returns:
+//   - true if the job completed, failed or was cancelled.
+func (j Job) Finished() bool {
+	return j.Status == JobCompleted || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// JobWorkerStatus describes the activity of the job worker pool of this instance since it started.
+type JobWorkerStatus struct {
+	Workers    int        // size of the pool
+	Running    int64      // jobs being executed right now
+	Completed  int64      // jobs that completed
+	Failed     int64      // jobs that failed, including interrupted ones
+	Cancelled  int64      // jobs cancelled while running
+	Errors     int64      // failures to claim jobs or to record their outcome
+	LastPollAt *time.Time // time of the last poll; nil before the first one
+}
+
+// Import sources, detected from the layout of the uploaded archive.
+const (
+	ImportGoogleTakeout = "google_takeout" // Google Takeout archive with Takeout/Calendar/*.ics
+	ImportAppleCalendar = "apple_calendar" // Apple Calendar export with *.ics files or a .icbu calendar archive
+)
+
+// ImportResult is the result of a calendar import job.
+type ImportResult struct {
+	Source    string `json:"source"`    // kind of archive (google_takeout, apple_calendar)
+	Calendars int    `json:"calendars"` // calendars found in the archive
+	Imported  int    `json:"imported"`  // events created
+	Skipped   int    `json:"skipped"`   // cancelled events, single-occurrence overrides and events that could not be created
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event link types, describing how an event depends on a related event.
+const (
+	LinkFollowUpOf = "follow_up_of" // the event is a follow-up of the related event
+	LinkBlockedBy  = "blocked_by"   // the event cannot happen before the related event
+)
+
+// Inverse relations, as seen from the related event.
+const (
+	RelationFollowedBy = "followed_by" // the related event is a follow-up of the event
+	RelationBlocks     = "blocks"      // the related event is blocked by the event
+)
+
+// EventLink represents a dependency of an event on another event.
+// In both link types the event is expected to take place no earlier than the related event.
+type EventLink struct {
+	EventID        uuid.UUID `json:"event_id"`         // identifier of the dependent event
+	RelatedEventID uuid.UUID `json:"related_event_id"` // identifier of the event it depends on
+	Type           string    `json:"type"`             // link type (follow_up_of, blocked_by)
+	CreatedAt      time.Time `json:"created_at"`       // timestamp when the link was created
+}
+
+// RelatedEvent is an event linked to another event, together with the relation between them.
+type RelatedEvent struct {
+	ID        uuid.UUID `json:"id"`         // identifier of the related event
+	Title     string    `json:"title"`      // title of the related event
+	EventDate time.Time `json:"event_date"` // date and time of the related event
+	Relation  string    `json:"relation"`   // relation from the event's point of view (follow_up_of, blocked_by, followed_by, blocks)
+}
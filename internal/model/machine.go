@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// MachineToken is a token issued to an internal service, authorizing it for some scopes of the internal API.
+type MachineToken struct {
+	Token     string        // signed JWT of the client
+	Scopes    []string      // scopes granted to the token
+	ExpiresIn time.Duration // lifetime of the token
+}
+
+// TokenUseMachine is the token_use claim of machine tokens, telling them apart from the tokens of users.
+const TokenUseMachine = "machine"
+
+// Scopes of machine tokens.
+const (
+	ScopeEventsRead        = "events:read"        // read the events of any user
+	ScopeRemindersDispatch = "reminders:dispatch" // trigger the delivery of due reminders
+)
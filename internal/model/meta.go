@@ -0,0 +1,33 @@
+package model
+
+// BuildInfo describes the build of the running service.
+type BuildInfo struct {
+	Commit    string // commit the binary was built from; empty if unknown
+	BuildTime string // time the binary was built, in RFC 3339; empty if unknown
+	GoVersion string // version of the Go toolchain, e.g. go1.24.0
+	Modified  bool   // whether the working tree had uncommitted changes when the binary was built
+}
+
+// Kinds of API changes.
+const (
+	APIChangeAdded      = "added"      // a new endpoint, field or header
+	APIChangeChanged    = "changed"    // a change in the behavior of an existing endpoint
+	APIChangeDeprecated = "deprecated" // an endpoint, field or header that will be removed
+	APIChangeRemoved    = "removed"    // an endpoint, field or header that is no longer served
+)
+
+// APIChange is a single change of the API, as published in its changelog.
+type APIChange struct {
+	Type        string // kind of change, one of the APIChange constants
+	Endpoint    string // method and path of the affected endpoint, e.g. POST /api/events/; empty if all endpoints
+	Field       string // affected request field or header, if any
+	Description string // human-readable description of the change
+	Replacement string // what to use instead of a deprecated endpoint or field, if anything
+	Sunset      string // date after which a deprecated endpoint or field may be removed, YYYY-MM-DD; empty if not planned
+}
+
+// ChangelogEntry groups the API changes released on the same date.
+type ChangelogEntry struct {
+	Date    string      // release date, YYYY-MM-DD; it doubles as the API version
+	Changes []APIChange // changes released on that date
+}
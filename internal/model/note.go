@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Note is a private note of a user on an event they can see: their own, one they are invited to, or one they follow.
+// It is stored apart from the description of the event and shown to its author only.
+type Note struct {
+	EventID   uuid.UUID `json:"event_id"`   // identifier of the event the note is attached to
+	UserID    uuid.UUID `json:"user_id"`    // identifier of the author of the note
+	Text      string    `json:"text"`       // text of the note
+	CreatedAt time.Time `json:"created_at"` // timestamp when the note was first written
+	UpdatedAt time.Time `json:"updated_at"` // timestamp when the note was last changed
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Email feedback types reported by delivery providers.
+const (
+	FeedbackBounce    = "bounce"    // the message was rejected permanently by the recipient's server
+	FeedbackComplaint = "complaint" // the recipient marked the message as spam
+)
+
+// NotificationLogEntry represents delivery feedback for a sent email, such as a bounce or a spam complaint,
+// as reported by the delivery provider.
+type NotificationLogEntry struct {
+	ID         uuid.UUID // unique identifier for the entry
+	Tenant     string    // tenant the email was sent for; routes the entry and is not stored
+	Provider   string    // delivery provider that reported the feedback (ses, sendgrid, mailgun)
+	Type       string    // feedback type (bounce, complaint)
+	Recipient  string    // email address the feedback is about
+	Reason     string    // provider's description, e.g. the SMTP diagnostic of a bounce
+	OccurredAt time.Time // time the provider observed the feedback
+	CreatedAt  time.Time // time the entry was recorded
+}
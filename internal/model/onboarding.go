@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Steps of the guided setup, in the order they are suggested to new users.
+const (
+	StepCreateProject = "create_project" // the user created a project
+	StepCreateEvent   = "create_event"   // the user created an event
+	StepSetReminder   = "set_reminder"   // the user scheduled a reminder
+	StepSaveView      = "save_view"      // the user saved a view
+	StepAddRule       = "add_rule"       // the user added an event rule
+	StepShareCalendar = "share_calendar" // the user created an ICS feed or an embed
+)
+
+// OnboardingSeed holds the sample data created for a new user.
+type OnboardingSeed struct {
+	ProjectID uuid.UUID   `json:"project_id"` // the "Getting started" project
+	EventIDs  []uuid.UUID `json:"event_ids"`  // the example events
+	ViewIDs   []uuid.UUID `json:"view_ids"`   // the default saved views
+}
+
+// OnboardingStep is a step of the guided setup.
+type OnboardingStep struct {
+	Name      string `json:"name"`      // name of the step, e.g. "create_event"
+	Completed bool   `json:"completed"` // whether the user completed the step
+}
+
+// OnboardingStatus is the progress of a user through the guided setup.
+// Sample data does not complete any step.
+type OnboardingStatus struct {
+	SeededAt *time.Time       `json:"seeded_at"` // when the sample data was created; nil if it never was
+	Steps    []OnboardingStep `json:"steps"`     // the steps in suggested order
+}
@@ -0,0 +1,16 @@
+package model
+
+// Total modes select whether and how the total number of items of a paginated list is counted.
+const (
+	TotalNone      = ""          // no total is computed
+	TotalExact     = "exact"     // the items are counted
+	TotalEstimated = "estimated" // the row estimate of the query planner is used, cheap for large tables
+)
+
+// Page selects a window of a list, as requested through the query parameters of a /api/v2 list endpoint.
+// Repositories paginating a list read one item more than the limit, to tell whether a next page exists.
+type Page struct {
+	Offset int    // number of items skipped, decoded from the cursor
+	Limit  int    // maximum number of items returned
+	Total  string // how the total is counted: TotalNone, TotalExact or TotalEstimated
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Notification categories users can unsubscribe from.
+const (
+	NotificationReminders    = "reminders"     // event reminders sent by the reminder worker
+	NotificationEventChanges = "event_changes" // changes and cancellations of events the user accepted
+)
+
+// NotificationCategories lists the notification categories in the order they are presented to users.
+var NotificationCategories = []string{NotificationReminders, NotificationEventChanges}
+
+// NotificationPreference is the subscription of a user to a notification category.
+// Users are subscribed to every category until they unsubscribe.
+type NotificationPreference struct {
+	Category   string     `json:"category"`   // notification category
+	Subscribed bool       `json:"subscribed"` // whether notifications of the category are sent
+	UpdatedAt  *time.Time `json:"updated_at"` // time of the last change; nil while the default applies
+}
+
+// Unsubscription is the outcome of following an unsubscribe link: the category the user unsubscribed from
+// and all their preferences, so the landing page can show them.
+type Unsubscription struct {
+	Category    string                   `json:"category"`    // category the link unsubscribed from
+	Preferences []NotificationPreference `json:"preferences"` // subscriptions of the user to every category
+}
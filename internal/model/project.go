@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Project groups events and tasks of a user towards a milestone.
+type Project struct {
+	ID            uuid.UUID  `json:"id"`             // unique identifier for the project
+	UserID        uuid.UUID  `json:"user_id"`        // identifier of the user who owns the project
+	Name          string     `json:"name"`           // name of the project
+	MilestoneDate *time.Time `json:"milestone_date"` // optional date the project is due
+	CreatedAt     time.Time  `json:"created_at"`     // timestamp when the project was created
+	UpdatedAt     time.Time  `json:"updated_at"`     // timestamp when the project was last updated
+}
+
+// ProjectTask is a to-do item of a project.
+type ProjectTask struct {
+	ID        uuid.UUID  `json:"id"`         // unique identifier for the task
+	ProjectID uuid.UUID  `json:"project_id"` // identifier of the project the task belongs to
+	Title     string     `json:"title"`      // title of the task
+	DueDate   *time.Time `json:"due_date"`   // optional due date of the task
+	Done      bool       `json:"done"`       // whether the task is completed
+	CreatedAt time.Time  `json:"created_at"` // timestamp when the task was created
+	UpdatedAt time.Time  `json:"updated_at"` // timestamp when the task was last updated
+}
+
+// Timeline item kinds.
+const (
+	TimelineEvent     = "event"     // an event of the project
+	TimelineTask      = "task"      // a task of the project
+	TimelineMilestone = "milestone" // the project milestone
+)
+
+// TimelineItem is a single bar or marker of a project timeline.
+type TimelineItem struct {
+	ID        uuid.UUID   `json:"id"`         // identifier of the event, task or project (for the milestone)
+	Kind      string      `json:"kind"`       // item kind (event, task, milestone)
+	Title     string      `json:"title"`      // title of the item
+	Start     *time.Time  `json:"start"`      // date of the item; nil for tasks without a due date
+	Done      bool        `json:"done"`       // whether the item is completed
+	DependsOn []uuid.UUID `json:"depends_on"` // items that must happen first (from event links)
+}
+
+// ProjectTimeline is a Gantt-friendly representation of a project.
+type ProjectTimeline struct {
+	Project  Project        `json:"project"`  // the project
+	Progress float64        `json:"progress"` // share of completed events and tasks (0.0-1.0)
+	Items    []TimelineItem `json:"items"`    // timeline items ordered by date
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Proposal statuses. A pending proposal is accepted or declined by the owner of the event, once.
+const (
+	ProposalPending  = "pending"  // waiting for the owner's decision
+	ProposalAccepted = "accepted" // the event was moved to the proposed time
+	ProposalDeclined = "declined" // the owner declined it, or accepted another proposal of the event
+)
+
+// Proposal is a new time an attendee proposes for an event of another user.
+type Proposal struct {
+	ID        uuid.UUID  `json:"id"`         // unique identifier for the proposal
+	EventID   uuid.UUID  `json:"event_id"`   // identifier of the event
+	UserID    uuid.UUID  `json:"user_id"`    // identifier of the attendee who made the proposal
+	Email     string     `json:"email"`      // email address of the attendee who made the proposal
+	EventDate time.Time  `json:"event_date"` // proposed start of the event
+	EndDate   *time.Time `json:"end_date"`   // proposed end; nil keeps the duration of the event
+	Status    string     `json:"status"`     // pending, accepted or declined
+	CreatedAt time.Time  `json:"created_at"` // timestamp when the proposal was made
+	DecidedAt *time.Time `json:"decided_at"` // timestamp of the owner's decision; nil while pending
+}
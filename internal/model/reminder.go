@@ -6,11 +6,89 @@ import (
 	"github.com/google/uuid"
 )
 
+// Reminder statuses.
+const (
+	ReminderPending = "pending" // waiting to be sent or retried
+	ReminderSent    = "sent"    // delivered successfully
+	ReminderFailed  = "failed"  // gave up after the maximum number of attempts
+	ReminderSkipped = "skipped" // not sent because the user unsubscribed from reminders or muted those of the event
+)
+
 // Reminder represents a notification for an event.
-// It includes the user and event IDs, the message (event title), and the time to send the reminder.
+// It includes the user and event IDs, the message (event title), the time to send the reminder,
+// and its delivery state.
 type Reminder struct {
-	UserID   uuid.UUID // identifier of the user to receive the reminder
-	EventID  uuid.UUID // identifier of the associated event
-	Message  string    // message content, typically the event title
-	RemindAt time.Time // time when the reminder should be sent
+	ID            uuid.UUID           // unique identifier for the reminder
+	UserID        uuid.UUID           // identifier of the user to receive the reminder
+	EventID       uuid.UUID           // identifier of the associated event
+	Message       string              // message content, typically the event title
+	RemindAt      time.Time           // time when the reminder should be sent
+	Status        string              // delivery status (pending, sent, failed, skipped)
+	Attempts      int                 // number of delivery attempts so far
+	Location      string              // location of the event when the reminder is claimed; empty if none
+	Notifications *EventNotifications // overrides of the event's owner when claimed; nil without overrides and for other users
+}
+
+// Notification channels reminders can be delivered through.
+const (
+	ChannelEmail = "email" // email to the user's address through the configured provider
+)
+
+// TestNotification is a sample notification sent on request, so users can verify their notification setup.
+type TestNotification struct {
+	Channel   string    `json:"channel"`   // channel the notification was sent through
+	Recipient string    `json:"recipient"` // address the notification was sent to
+	SentAt    time.Time `json:"sent_at"`   // time the notification was handed to the provider
+}
+
+// ReminderHistoryEntry is a sent or failed reminder in the notification history of its user.
+type ReminderHistoryEntry struct {
+	ID        uuid.UUID  // identifier of the reminder
+	EventID   uuid.UUID  // identifier of the event the reminder was for
+	Message   string     // message content, typically the event title
+	RemindAt  time.Time  // time the reminder was due
+	Status    string     // delivery status (sent, failed, skipped)
+	SentAt    *time.Time // time of the delivery; nil for failed reminders
+	LastError string     // error of the last failed attempt; empty if none
+	Archived  bool       // whether the event has been archived since
+}
+
+// ZonedReminder is a pending reminder set as a wall-clock time in a time zone.
+// Its instant is derived from the local time with the zone's rules and is recomputed when they change.
+type ZonedReminder struct {
+	ID        uuid.UUID // identifier of the reminder
+	Timezone  string    // IANA time zone, e.g. Europe/Berlin
+	LocalTime time.Time // wall-clock time in Timezone; its location is meaningless
+	RemindAt  time.Time // instant the reminder is currently scheduled for
+}
+
+// ReminderQueueStats describes the backlog of pending reminders.
+type ReminderQueueStats struct {
+	Pending     int        // pending reminders, including future and leased ones
+	Due         int        // pending reminders whose time has come and that are not leased
+	Leased      int        // pending reminders currently leased to a worker instance
+	OldestDueAt *time.Time // remind_at of the oldest due reminder; nil when none is due
+}
+
+// ReminderWorkerStatus describes the activity of the reminder worker of this instance since it started.
+type ReminderWorkerStatus struct {
+	InFlight   int64      // reminders being sent right now
+	Sent       int64      // reminders delivered
+	Failed     int64      // failed delivery attempts
+	Deferred   int64      // reminders deferred because their recipient domain was throttled
+	Skipped    int64      // reminders not sent because their user unsubscribed from reminders
+	Errors     int64      // failures to claim reminders or to record their outcome
+	LastPollAt *time.Time // time of the last poll; nil before the first one
+}
+
+// ArchiverStatus describes the activity of the archiver worker of this instance since it started.
+type ArchiverStatus struct {
+	Runs            int64         // archiving passes started
+	Errors          int64         // tenant passes that failed
+	LastRunAt       *time.Time    // start of the last pass; nil before the first one
+	LastRunDuration time.Duration // duration of the last pass
+	LastRunArchived int           // events archived by the last pass
+	LastRunExported int           // archived events exported to cold storage by the last pass
+	LastRunPurged   int           // trashed events permanently deleted by the last pass
+	LastError       string        // error of the last failed tenant pass; empty if none failed yet
 }
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MeetingLoadWeek is the meeting load of a user in a week, aggregated periodically by the report worker.
+// Meetings are events with an end that the user organizes with attendees who did not decline,
+// or that the user accepted as an attendee.
+type MeetingLoadWeek struct {
+	Week           time.Time // Monday the week starts on, in the time zone of the user
+	Meetings       int       // meetings starting in the week
+	MeetingMinutes int       // total length of those meetings
+	WorkdayMinutes int       // part of that length within the workday, Monday to Friday
+	AfterHours     int       // meetings not entirely within the workday, including weekend meetings
+	DayPercent     float64   // share of the workdays of the week spent in meetings, in percent
+	ComputedAt     time.Time // time the week was last aggregated
+}
+
+// MemberMeetingLoad is the weekly meeting load of a member of an attendee group.
+type MemberMeetingLoad struct {
+	UserID uuid.UUID         // identifier of the member
+	Email  string            // email address of the member
+	Name   string            // name of the member
+	Weeks  []MeetingLoadWeek // aggregated weeks, the most recent first; empty until the member is aggregated
+}
+
+// MeetingReport is the meeting load report of an organization, the members of an attendee group,
+// which only the owner of the group may read.
+type MeetingReport struct {
+	GroupID      uuid.UUID           // identifier of the group
+	WorkdayStart time.Duration       // start of the workday, after midnight in the time zone of each member
+	WorkdayEnd   time.Duration       // end of the workday
+	Members      []MemberMeetingLoad // members of the group, ordered by email
+}
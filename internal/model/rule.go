@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event fields a rule condition can test.
+const (
+	RuleFieldTitle       = "title"       // title of the event
+	RuleFieldDescription = "description" // description of the event
+	RuleFieldPriority    = "priority"    // priority of the event
+)
+
+// Operators of rule conditions; text is compared case-insensitively.
+const (
+	RuleOpContains   = "contains"    // the field contains the value
+	RuleOpEquals     = "equals"      // the field equals the value
+	RuleOpStartsWith = "starts_with" // the field starts with the value
+)
+
+// EventRule colors and tags the events of a user matching all of its conditions
+// when they are created or imported.
+type EventRule struct {
+	ID         uuid.UUID       `json:"id"`         // unique identifier for the rule
+	UserID     uuid.UUID       `json:"user_id"`    // identifier of the user who owns the rule
+	Name       string          `json:"name"`       // name of the rule
+	Position   int             `json:"position"`   // rules are evaluated in ascending position
+	Conditions []RuleCondition `json:"conditions"` // conditions an event must all match; stored as JSON
+	Color      string          `json:"color"`      // color given to matching events; empty leaves the color unchanged
+	Tags       []string        `json:"tags"`       // tags added to matching events
+	Enabled    bool            `json:"enabled"`    // whether the rule is applied
+	CreatedAt  time.Time       `json:"created_at"` // timestamp when the rule was created
+	UpdatedAt  time.Time       `json:"updated_at"` // timestamp when the rule was last updated
+}
+
+// RuleCondition is a single test of an event field.
+type RuleCondition struct {
+	Field    string `json:"field"`    // event field, e.g. "title"
+	Operator string `json:"operator"` // comparison, e.g. "contains"
+	Value    string `json:"value"`    // value the field is compared with
+}
+
+// RulePreview is an existing event a rule matches, with the color and tags the rule would give it.
+type RulePreview struct {
+	Event Event    // the matching event as stored
+	Color string   // color of the event after applying the rule
+	Tags  []string // tags of the event after applying the rule
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventSearch holds the criteria of a full-text search over the titles and descriptions of events.
+type EventSearch struct {
+	Query      string     // search terms in web search syntax: quoted phrases, "or", and "-" to exclude a term
+	From       *time.Time // start of the date range, inclusive; unbounded when nil
+	To         *time.Time // end of the date range, exclusive; unbounded when nil
+	Strict     bool       // match Query as it is, with its case and accents, as part of the title or description
+	CalendarID *uuid.UUID // only events of this calendar; events of all calendars when nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Security event types.
+const (
+	SecurityLogin           = "login"              // successful login
+	SecurityLoginFailed     = "login_failed"       // login with a wrong password
+	SecurityPasswordChanged = "password_changed"   // password changed
+	SecurityTwoFactor       = "two_factor_changed" // two-factor authentication enabled or disabled
+	SecurityAPIKeyCreated   = "api_key_created"    // API key created
+	SecuritySessionRevoked  = "session_revoked"    // session revoked
+	SecurityRoleChanged     = "role_changed"       // role changed by an admin
+)
+
+// SecurityEvent represents a security-relevant action on a user account.
+type SecurityEvent struct {
+	ID        uuid.UUID // unique identifier of the event
+	UserID    uuid.UUID // user whose account is affected
+	Type      string    // event type, one of the Security* constants
+	IP        string    // client IP address
+	UserAgent string    // client user agent
+	CreatedAt time.Time // time of the event
+}
+
+// ClientInfo identifies the client performing a request.
+type ClientInfo struct {
+	IP        string // client IP address
+	UserAgent string // client user agent
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Visibility levels of short links, deciding what invitees see of the event.
+const (
+	ShortLinkBusy    = "busy"    // only the date and time
+	ShortLinkBasic   = "basic"   // the title, date, time and color
+	ShortLinkDetails = "details" // the basic information and the description
+)
+
+// ShortLink is a short code sharing an event with invitees who have no account.
+type ShortLink struct {
+	Code       string    `json:"code"`       // short code the event is resolved by
+	EventID    uuid.UUID `json:"event_id"`   // identifier of the shared event
+	UserID     uuid.UUID `json:"user_id"`    // identifier of the user who owns the event
+	Visibility string    `json:"visibility"` // what invitees see of the event
+	ExpiresAt  time.Time `json:"expires_at"` // time from which the code no longer resolves
+	CreatedAt  time.Time `json:"created_at"` // timestamp when the link was created
+}
+
+// SharedEvent is what a short link shows of its event, limited to the link's visibility.
+type SharedEvent struct {
+	EventID     uuid.UUID // identifier of the event
+	Visibility  string    // visibility of the link
+	Title       string    // title; empty for busy links
+	Description string    // description; only set for details links
+	EventDate   time.Time // date and time of the event
+	Color       string    // color; empty for busy links
+	ExpiresAt   time.Time // time from which the link no longer resolves
+	Attending   int       // number of attendees and guests who accepted
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SuggestionModel records how the keywords of a user's event titles go together with tags and projects.
+// It is computed periodically from the user's most recent events.
+type SuggestionModel struct {
+	UserID     uuid.UUID               `json:"-"`        // identifier of the user the model belongs to
+	Events     int                     `json:"events"`   // number of events the model was computed from
+	Keywords   map[string]KeywordStats `json:"keywords"` // statistics per lower-cased title keyword
+	ComputedAt time.Time               `json:"-"`        // timestamp when the model was computed
+}
+
+// KeywordStats counts the events whose title contains a keyword, in total and per tag and project.
+type KeywordStats struct {
+	Events   int               `json:"events"`             // events whose title contains the keyword
+	Tags     map[string]int    `json:"tags,omitempty"`     // of those, the events with each tag
+	Projects map[uuid.UUID]int `json:"projects,omitempty"` // of those, the events in each project
+}
+
+// Suggestion holds the tags and project proposed for a new event.
+type Suggestion struct {
+	Tags      []string   `json:"tags"`       // proposed tags the event does not have yet, most likely first
+	ProjectID *uuid.UUID `json:"project_id"` // proposed project; nil if there is none or the event already has one
+}
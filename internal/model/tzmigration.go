@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimezoneMigration is the request of a timezone migration job, which reinterprets the event dates stored
+// before events had a time of day as midnight in the time zone they were entered in.
+type TimezoneMigration struct {
+	Timezone string `json:"timezone"` // IANA time zone of all dates; with PerUser, of the users without a time zone
+	PerUser  bool   `json:"per_user"` // whether the dates of a user are read in the user's own time zone
+	DryRun   bool   `json:"dry_run"`  // whether the outcome is only reported, without changing events
+}
+
+// TimezoneMigrationResult is the result of a timezone migration job.
+type TimezoneMigrationResult struct {
+	DryRun    bool           `json:"dry_run"`   // whether events were left unchanged
+	Converted int            `json:"converted"` // dates moved to midnight in their source time zone
+	Unchanged int            `json:"unchanged"` // dates whose source time zone is UTC, which were already correct
+	Skipped   int            `json:"skipped"`   // dates changed since the upgrade, which are left as they are
+	Zones     map[string]int `json:"zones"`     // converted and unchanged dates by source time zone
+}
+
+// LegacyEventDate is the date of an event stored before event dates became instants.
+type LegacyEventDate struct {
+	EventID      uuid.UUID // identifier of the event
+	UserID       uuid.UUID // identifier of the owner of the event
+	UserTimezone string    // IANA time zone of the owner; empty for UTC
+	OriginalDate time.Time // stored calendar date, as UTC midnight
+	EventDate    time.Time // current date of the event
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Usage represents the API usage of a user in the current metering period.
+type Usage struct {
+	Period   time.Time `json:"period"`    // start of the metering period (first day of the month, UTC)
+	ResetsAt time.Time `json:"resets_at"` // start of the next metering period
+	Calls    int64     `json:"calls"`     // number of API calls made in the period
+	Limit    int64     `json:"limit"`     // monthly quota; 0 means unlimited
+}
+
+// Remaining returns the number of calls left in the period, or -1 if usage is unlimited.
+func (u Usage) Remaining() int64 {
+	if u.Limit <= 0 {
+		return -1
+	}
+	return max(u.Limit-u.Calls, 0)
+}
+
+// Exceeded reports whether the calls in the period are over the quota.
+func (u Usage) Exceeded() bool {
+	return u.Limit > 0 && u.Calls > u.Limit
+}
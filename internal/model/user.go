@@ -7,13 +7,41 @@ import (
 )
 
 // User represents a user in the calendar service.
-// It contains the user's unique ID, email, name, password (excluded from JSON),
+// It contains the user's unique ID, email, name, password (excluded from JSON), role,
+// whether it is a throwaway demo account, the time zone of its calendar, the language its events are searched in,
 // and timestamps for creation and updates.
 type User struct {
-	ID        uuid.UUID `json:"id"`         // unique identifier for the user
-	Email     string    `json:"email"`      // user's email address
-	Name      string    `json:"name"`       // user's name
-	Password  string    `json:"-"`          // user's password (not serialized to JSON)
-	CreatedAt time.Time `json:"created_at"` // timestamp when the user was created
-	UpdatedAt time.Time `json:"updated_at"` // timestamp when the user was last updated
+	ID             uuid.UUID `json:"id"`              // unique identifier for the user
+	Email          string    `json:"email"`           // user's email address
+	Name           string    `json:"name"`            // user's name
+	Password       string    `json:"-"`               // user's password (not serialized to JSON)
+	Role           string    `json:"role"`            // user's role (user or admin)
+	Demo           bool      `json:"demo"`            // whether the account was created in demo mode and is deleted once it expires
+	Timezone       string    `json:"timezone"`        // IANA time zone calendar days are computed in; empty for UTC
+	SearchLanguage string    `json:"search_language"` // language words are stemmed in by event search; empty for no stemming
+	CreatedAt      time.Time `json:"created_at"`      // timestamp when the user was created
+	UpdatedAt      time.Time `json:"updated_at"`      // timestamp when the user was last updated
+}
+
+// User roles.
+const (
+	RoleUser  = "user"  // regular user
+	RoleAdmin = "admin" // operator with access to admin endpoints
+)
+
+// DemoAccount holds the generated credentials of a throwaway account created in demo mode.
+type DemoAccount struct {
+	UserID    uuid.UUID // identifier of the account
+	Email     string    // generated email address under the reserved .invalid domain
+	Password  string    // generated password, returned once
+	Token     string    // JWT of the account, so clients need not log in
+	ExpiresAt time.Time // time after which the account and its data are deleted
+}
+
+// SearchLanguages lists the search languages of users, named after the PostgreSQL text search configurations
+// that stem their words. The empty language matches words whole, ignoring case and accents.
+var SearchLanguages = []string{
+	"simple", "arabic", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hungarian", "indonesian",
+	"irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "spanish", "swedish",
+	"tamil", "turkish",
 }
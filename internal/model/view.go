@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Relative date ranges of saved views; they are resolved when the view is queried.
+// Weeks start on Monday.
+const (
+	RangeToday      = "today"        // the current day
+	RangeTomorrow   = "tomorrow"     // the next day
+	RangeThisWeek   = "this_week"    // the current week
+	RangeNextWeek   = "next_week"    // the week after the current one
+	RangeThisMonth  = "this_month"   // the current month
+	RangeNextMonth  = "next_month"   // the month after the current one
+	RangeNext7Days  = "next_7_days"  // today and the following six days
+	RangeNext30Days = "next_30_days" // today and the following 29 days
+	RangePast7Days  = "past_7_days"  // the seven days before today
+)
+
+// View is a named, saved filter of a user's events ("smart view").
+type View struct {
+	ID        uuid.UUID  `json:"id"`         // unique identifier for the view
+	UserID    uuid.UUID  `json:"user_id"`    // identifier of the user who owns the view
+	Name      string     `json:"name"`       // name of the view, unique per user
+	Filter    ViewFilter `json:"filter"`     // criteria the events must match
+	CreatedAt time.Time  `json:"created_at"` // timestamp when the view was created
+	UpdatedAt time.Time  `json:"updated_at"` // timestamp when the view was last updated
+}
+
+// ViewFilter holds the criteria of a saved view; empty criteria match all events.
+// It is stored as JSON.
+type ViewFilter struct {
+	Text           string     `json:"text,omitempty"`            // case-insensitive text in the title or description
+	Priorities     []string   `json:"priorities,omitempty"`      // event priorities to include
+	ProjectID      *uuid.UUID `json:"project_id,omitempty"`      // only events of this project
+	WithoutProject bool       `json:"without_project,omitempty"` // only events without a project
+	Range          string     `json:"range,omitempty"`           // relative date range, e.g. "next_week"
+}
+
+// EventFilter holds the resolved criteria of an event query.
+type EventFilter struct {
+	From           *time.Time // first day of the range, inclusive
+	To             *time.Time // end of the range, exclusive
+	Priorities     []string   // event priorities to include; all when empty
+	ProjectID      *uuid.UUID // only events of this project
+	WithoutProject bool       // only events without a project
+	Limit          int        // maximum number of events; unlimited when 0
+}
@@ -0,0 +1,238 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Page sizes in points (1/72 inch).
+const (
+	A4Width  = 595.0
+	A4Height = 842.0
+)
+
+// Font is one of the standard Type 1 fonts every PDF reader provides.
+type Font int
+
+const (
+	Helvetica     Font = iota // regular text
+	HelveticaBold             // headings
+)
+
+// fontNames are the base font names and resource names of the fonts.
+var fontNames = [...]struct{ base, resource string }{
+	Helvetica:     {"Helvetica", "F1"},
+	HelveticaBold: {"Helvetica-Bold", "F2"},
+}
+
+// Document is a PDF document with pages of a fixed size.
+// Only what printed agendas need is supported: text in the standard fonts, lines and rectangles.
+// Text is encoded in WinAnsiEncoding; characters outside of it are printed as '?'.
+type Document struct {
+	width, height float64 // page size in points
+	pages         []*Page // pages in order
+}
+
+// Page is a page of a document. Coordinates are in points from the top-left corner.
+type Page struct {
+	height  float64      // page height, to flip coordinates to the PDF origin at the bottom-left
+	content bytes.Buffer // content stream
+}
+
+// New creates an empty document.
+//
+// Parameters:
+//   - width: The page width in points.
+//   - height: The page height in points.
+//
+// Returns:
+//   - A pointer to the document.
+func New(width, height float64) *Document {
+	return &Document{width: width, height: height}
+}
+
+// AddPage appends a blank page.
+//
+// Returns:
+//   - The new page.
+func (d *Document) AddPage() *Page {
+	p := &Page{height: d.height}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Pages returns the number of pages.
+//
+// Returns:
+//   - The number of pages.
+func (d *Document) Pages() int {
+	return len(d.pages)
+}
+
+// Text draws a single line of text with its baseline at y.
+//
+// Parameters:
+//   - x, y: The start of the baseline.
+//   - font: The font.
+//   - size: The font size in points.
+//   - s: The text.
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		fontNames[font].resource, num(size), num(x), num(p.height-y), escape(s))
+}
+
+// Line draws a straight line.
+//
+// Parameters:
+//   - x1, y1: The start of the line.
+//   - x2, y2: The end of the line.
+//   - width: The line width in points.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		num(width), num(x1), num(p.height-y1), num(x2), num(p.height-y2))
+}
+
+// Rect draws the outline of a rectangle.
+//
+// Parameters:
+//   - x, y: The top-left corner.
+//   - w, h: The width and height.
+//   - width: The line width in points.
+func (p *Page) Rect(x, y, w, h, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s %s %s re S\n", num(width), num(x), num(p.height-y-h), num(w), num(h))
+}
+
+// FillRect fills a rectangle with a shade of gray.
+//
+// Parameters:
+//   - x, y: The top-left corner.
+//   - w, h: The width and height.
+//   - gray: The shade from 0 (black) to 1 (white).
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "q %s g %s %s %s %s re f Q\n", num(gray), num(x), num(p.height-y-h), num(w), num(h))
+}
+
+// WriteTo writes the document in PDF 1.4 format.
+//
+// Parameters:
+//   - w: The destination.
+//
+// Returns:
+//   - The number of bytes written.
+//   - An error if writing fails.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	var offsets []int
+
+	// Object numbers: 1 catalog, 2 page tree, 3-4 fonts, then a page and its content stream per page.
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>",
+		strings.Join(kids, " "), len(d.pages), num(d.width), num(d.height)))
+	for _, f := range fontNames {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", f.base))
+	}
+
+	for i, p := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.content.Len(), p.content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// Bytes returns the document in PDF 1.4 format.
+//
+// Returns:
+//   - The encoded document.
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	_, _ = d.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// Fit shortens s with an ellipsis so that it fits into width at the given font size.
+// Widths are estimated from the average character width of Helvetica, which is
+// good enough for titles in table cells.
+//
+// Parameters:
+//   - s: The text.
+//   - size: The font size in points.
+//   - width: The available width in points.
+//
+// Returns:
+//   - s, or its prefix followed by "...".
+func Fit(s string, size, width float64) string {
+	maxChars := int(width / (size * averageCharWidth))
+	runes := []rune(s)
+	if len(runes) <= maxChars {
+		return s
+	}
+	if maxChars <= 3 {
+		return strings.Repeat(".", max(maxChars, 0))
+	}
+	return string(runes[:maxChars-3]) + "..."
+}
+
+// averageCharWidth is the average width of a Helvetica character relative to the font size.
+const averageCharWidth = 0.52
+
+// escape encodes a string as the content of a PDF literal string in WinAnsiEncoding.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			// WinAnsiEncoding matches Latin-1 in this range.
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			if c, ok := winAnsiExtra[r]; ok {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte('?')
+			}
+		}
+	}
+	return b.String()
+}
+
+// winAnsiExtra maps the characters of WinAnsiEncoding between 0x80 and 0x9f to their codes.
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88, '‰': 0x89,
+	'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// num formats a coordinate with at most two decimals.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
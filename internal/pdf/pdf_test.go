@@ -0,0 +1,52 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_WriteTo(t *testing.T) {
+	doc := New(A4Height, A4Width)
+	page := doc.AddPage()
+	page.Text(40, 50, HelveticaBold, 18, "Week of 4 Nov (2030)")
+	page.Line(40, 60, 800, 60, 0.5)
+	doc.AddPage().Rect(40, 40, 100, 50, 1)
+
+	data := doc.Bytes()
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+	assert.Contains(t, string(data), "/Count 2 /MediaBox [0 0 842 595]")
+	assert.Contains(t, string(data), `(Week of 4 Nov \(2030\)) Tj`)
+	assert.Contains(t, string(data), "BT /F2 18 Tf 40 545 Td", "y is flipped to the bottom-left origin")
+
+	// Every xref entry must point at the start of its object.
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	require.NotNil(t, m)
+	xref, _ := strconv.Atoi(string(m[1]))
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n0 9\n")), "7 objects for two pages plus the free entry")
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(data[xref:], -1)
+	require.Len(t, entries, 8)
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		assert.True(t, bytes.HasPrefix(data[off:], []byte(fmt.Sprintf("%d 0 obj", i+1))), "object %d", i+1)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\(b\)c\\`, escape(`a(b)c\`))
+	assert.Equal(t, `Caf\351 \200 ?`, escape("Café € 日"))
+	assert.Equal(t, "line one two", escape("line one\ntwo"))
+}
+
+func TestFit(t *testing.T) {
+	assert.Equal(t, "Standup", Fit("Standup", 10, 100))
+	assert.Equal(t, "Quarterly plann...", Fit("Quarterly planning with the whole team", 10, 95))
+	assert.Equal(t, "..", Fit("Standup", 10, 12))
+}
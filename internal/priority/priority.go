@@ -0,0 +1,122 @@
+package priority
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// Request classes.
+const (
+	Interactive = "interactive" // requests a user waits for, such as reading and editing events
+	Bulk        = "bulk"        // imports, exports and file downloads, which are heavy but can be retried later
+)
+
+// Limiter caps the concurrent requests of each class, so bulk requests cannot starve interactive ones.
+// Interactive requests wait a short time for a free slot; bulk requests never wait and are refused
+// while interactive requests are waiting, giving the remaining capacity to the interactive ones.
+// Limits apply per instance. It is safe for concurrent use.
+type Limiter struct {
+	slots   map[string]chan struct{} // semaphores of the classes
+	waiting atomic.Int64             // interactive requests waiting for a slot
+	maxWait time.Duration            // how long an interactive request waits for a slot
+}
+
+// New creates a Limiter with the limits of the configuration.
+// Non-positive limits fall back to 100 interactive and 4 bulk requests.
+//
+// Parameters:
+//   - cfg: The concurrency limits and the maximum wait.
+//
+// Returns:
+//   - A pointer to the initialized Limiter.
+func New(cfg config.Priority) *Limiter {
+	if cfg.Interactive <= 0 {
+		cfg.Interactive = 100
+	}
+	if cfg.Bulk <= 0 {
+		cfg.Bulk = 4
+	}
+
+	return &Limiter{
+		slots: map[string]chan struct{}{
+			Interactive: make(chan struct{}, cfg.Interactive),
+			Bulk:        make(chan struct{}, cfg.Bulk),
+		},
+		maxWait: cfg.MaxWait,
+	}
+}
+
+// Acquire reserves a slot of the class for a request.
+//
+// Parameters:
+//   - ctx: The context of the request; waiting stops when it is done.
+//   - class: The class of the request, Interactive or Bulk.
+//
+// Returns:
+//   - A function releasing the slot, to be called once the request is done.
+//   - false if no slot is available, in which case nothing needs to be released.
+func (l *Limiter) Acquire(ctx context.Context, class string) (func(), bool) {
+	slots := l.slots[class]
+	release := func() { <-slots }
+
+	if class == Bulk && l.waiting.Load() > 0 {
+		return nil, false
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return release, true
+	default:
+	}
+
+	if class == Bulk || l.maxWait <= 0 {
+		return nil, false
+	}
+
+	l.waiting.Add(1)
+	defer l.waiting.Add(-1)
+
+	timer := time.NewTimer(l.maxWait)
+	defer timer.Stop()
+
+	select {
+	case slots <- struct{}{}:
+		return release, true
+	case <-timer.C:
+		return nil, false
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// Classify returns the class of an API request.
+// Calendar imports, PDF exports and downloads of job output are bulk; everything else is interactive.
+// Both API versions are classified alike.
+//
+// Parameters:
+//   - r: The request.
+//
+// Returns:
+//   - Bulk or Interactive.
+func Classify(r *http.Request) string {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if rest, ok := strings.CutPrefix(path, "/api/v2/"); ok {
+		path = "/api/" + rest
+	}
+
+	switch {
+	case r.Method == http.MethodPost && path == "/api/imports":
+		return Bulk
+	case r.Method == http.MethodGet && path == "/api/events/export.pdf":
+		return Bulk
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/api/jobs/") && strings.HasSuffix(path, "/output"):
+		return Bulk
+	}
+
+	return Interactive
+}
@@ -0,0 +1,88 @@
+package priority
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestLimiter_BulkLimit(t *testing.T) {
+	l := New(config.Priority{Interactive: 1, Bulk: 1, MaxWait: time.Second})
+
+	release, ok := l.Acquire(context.Background(), Bulk)
+	require.True(t, ok)
+
+	_, ok = l.Acquire(context.Background(), Bulk)
+	assert.False(t, ok, "bulk requests do not wait for a slot")
+
+	// Bulk requests do not take interactive slots.
+	releaseInteractive, ok := l.Acquire(context.Background(), Interactive)
+	require.True(t, ok)
+	releaseInteractive()
+
+	release()
+	release, ok = l.Acquire(context.Background(), Bulk)
+	assert.True(t, ok)
+	release()
+}
+
+func TestLimiter_InteractiveWaits(t *testing.T) {
+	l := New(config.Priority{Interactive: 1, Bulk: 1, MaxWait: time.Second})
+
+	release, ok := l.Acquire(context.Background(), Interactive)
+	require.True(t, ok)
+
+	acquired := make(chan bool)
+	go func() {
+		r, ok := l.Acquire(context.Background(), Interactive)
+		if ok {
+			r()
+		}
+		acquired <- ok
+	}()
+
+	// While an interactive request waits, bulk requests are shed even with free bulk slots.
+	require.Eventually(t, func() bool { return l.waiting.Load() == 1 }, time.Second, time.Millisecond)
+	_, ok = l.Acquire(context.Background(), Bulk)
+	assert.False(t, ok)
+
+	release()
+	assert.True(t, <-acquired)
+}
+
+func TestLimiter_InteractiveTimesOut(t *testing.T) {
+	l := New(config.Priority{Interactive: 1, Bulk: 1, MaxWait: 10 * time.Millisecond})
+
+	release, ok := l.Acquire(context.Background(), Interactive)
+	require.True(t, ok)
+	defer release()
+
+	_, ok = l.Acquire(context.Background(), Interactive)
+	assert.False(t, ok)
+	assert.Zero(t, l.waiting.Load())
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		method, path string
+		want         string
+	}{
+		{http.MethodPost, "/api/imports/", Bulk},
+		{http.MethodGet, "/api/imports/7f8c", Interactive},
+		{http.MethodGet, "/api/events/export.pdf", Bulk},
+		{http.MethodGet, "/api/jobs/7f8c/output", Bulk},
+		{http.MethodGet, "/api/v2/jobs/7f8c/output", Bulk},
+		{http.MethodGet, "/api/jobs/7f8c", Interactive},
+		{http.MethodGet, "/api/events/day", Interactive},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Classify(httptest.NewRequest(tt.method, tt.path, nil)), tt.method+" "+tt.path)
+	}
+}
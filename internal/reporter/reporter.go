@@ -0,0 +1,164 @@
+package reporter
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	sentryhttp "github.com/getsentry/sentry-go/http"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/middlewares"
+)
+
+// Reporter sends panics and errors to a Sentry-compatible error tracker.
+// When no DSN is configured, all of its methods are no-ops.
+type Reporter struct {
+	enabled bool // whether an error tracker is configured
+}
+
+// New creates a new Reporter and initializes the error tracking client from the configuration.
+//
+// Parameters:
+//   - cfg: The error reporting configuration.
+//
+// Returns:
+//   - A pointer to the initialized Reporter.
+//   - An error if the DSN is invalid.
+func New(cfg config.Reporting) (*Reporter, error) {
+	if cfg.DSN == "" {
+		return &Reporter{}, nil
+	}
+
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:              cfg.DSN,
+		Environment:      cfg.Environment,
+		SampleRate:       cfg.SampleRate,
+		AttachStacktrace: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("init sentry: %w", err)
+	}
+
+	return &Reporter{enabled: true}, nil
+}
+
+// Middleware returns a middleware that reports panics with the request context.
+// It attaches a request-scoped hub carrying the request and its ID, then re-panics
+// so the outer Recoverer still responds with 500.
+func (r *Reporter) Middleware() func(http.Handler) http.Handler {
+	handler := sentryhttp.New(sentryhttp.Options{Repanic: true})
+
+	return func(next http.Handler) http.Handler {
+		if !r.enabled {
+			return next
+		}
+
+		return handler.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if hub := sentry.GetHubFromContext(req.Context()); hub != nil {
+				hub.Scope().SetTag("request_id", middleware.GetReqID(req.Context()))
+			}
+			next.ServeHTTP(w, req)
+		}))
+	}
+}
+
+// UserMiddleware returns a middleware that adds the authenticated user to the request-scoped hub.
+// It must be used after Auth and Middleware.
+func (r *Reporter) UserMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !r.enabled {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			userID, _ := req.Context().Value(middlewares.UserIDKey).(uuid.UUID)
+			if hub := sentry.GetHubFromContext(req.Context()); hub != nil && userID != uuid.Nil {
+				hub.Scope().SetUser(sentry.User{ID: userID.String(), IPAddress: req.RemoteAddr})
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// Core returns a zap core that reports entries logged at Error level or above,
+// including their fields and a stack trace. It is meant to be teed with the application core
+// so that handlers, services, and workers are instrumented through their existing logging.
+func (r *Reporter) Core() zapcore.Core {
+	if !r.enabled {
+		return zapcore.NewNopCore()
+	}
+
+	return &core{}
+}
+
+// Flush waits until buffered events are sent or the timeout expires.
+func (r *Reporter) Flush(timeout time.Duration) {
+	if r.enabled {
+		sentry.Flush(timeout)
+	}
+}
+
+// core is a zapcore.Core that turns error log entries into error tracker events.
+type core struct {
+	fields []zapcore.Field // fields added with With
+}
+
+// Enabled reports whether the level is reported.
+func (c *core) Enabled(level zapcore.Level) bool {
+	return level >= zapcore.ErrorLevel
+}
+
+// With returns a copy of the core with additional fields.
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	return &core{fields: append(c.fields[:len(c.fields):len(c.fields)], fields...)}
+}
+
+// Check adds the core to the checked entry if the level is reported.
+func (c *core) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+// Write converts the entry and its fields into an event and captures it.
+func (c *core) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+
+	var errValue string
+	for _, f := range append(c.fields, fields...) {
+		if f.Type == zapcore.ErrorType {
+			if err, ok := f.Interface.(error); ok {
+				errValue = err.Error()
+			}
+		}
+		f.AddTo(enc)
+	}
+
+	event := sentry.NewEvent()
+	event.Level = sentry.LevelError
+	event.Message = entry.Message
+	event.Logger = entry.LoggerName
+	event.Extra = enc.Fields
+	event.Exception = []sentry.Exception{{
+		Type:       entry.Message,
+		Value:      errValue,
+		Stacktrace: sentry.NewStacktrace(),
+	}}
+	if entry.Level > zapcore.ErrorLevel {
+		event.Level = sentry.LevelFatal
+	}
+
+	sentry.CaptureEvent(event)
+	return nil
+}
+
+// Sync is a no-op; events are flushed with Reporter.Flush.
+func (c *core) Sync() error {
+	return nil
+}
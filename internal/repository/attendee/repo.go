@@ -0,0 +1,539 @@
+package attendee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrUserNotFound        = errors.New("user not found")
+	ErrEventNotFound       = errors.New("event not found")
+	ErrAttendeeNotFound    = errors.New("attendee not found")
+	ErrSelfInvite          = errors.New("cannot invite yourself to your own event")
+	ErrInvitationNotFound  = errors.New("invitation not found")
+	ErrSharedEventNotFound = errors.New("shared event not found")
+	ErrGuestNotFound       = errors.New("rsvp link not found")
+	ErrGuestLinkExpired    = errors.New("rsvp link expired")
+	ErrGuestCooldown       = errors.New("an rsvp link was sent recently, try again later")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages interactions with the event_attendees table in the PostgreSQL database.
+// It provides methods for inviting users to events, listing attendees and recording their responses,
+// and for the guests of events shared by short links.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Invite invites the user with the given email address to an event of the owner.
+// Inviting an attendee again keeps their original invitation and response, but no longer ties it to a group,
+// so removing them from the group does not withdraw it.
+// New invitations update the owner's contacts, which rank attendee suggestions.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//   - email: The email address of the invited user.
+//
+// Returns:
+//   - The attendee.
+//   - ErrUserNotFound if no user has the email address, ErrSelfInvite if it is the owner's own,
+//     ErrEventNotFound if the owner has no such event.
+//   - An error if the insertion fails.
+func (r *Repository) Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error) {
+	a := model.Attendee{EventID: eventID}
+	err := r.db.QueryRow(ctx, `SELECT id, email, name FROM users WHERE email = $1`, email).Scan(&a.UserID, &a.Email, &a.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attendee{}, ErrUserNotFound
+		}
+		return model.Attendee{}, fmt.Errorf("failed to find invited user: %w", err)
+	}
+
+	if a.UserID == ownerID {
+		return model.Attendee{}, ErrSelfInvite
+	}
+
+	// A new invitation also adds the attendee to the owner's contacts or moves them up in their ranking,
+	// in the same statement; xmax is 0 for inserted rows, so repeated invitations are not counted.
+	query := `
+		WITH invited AS (
+		    INSERT INTO event_attendees (event_id, user_id)
+		    SELECT $1, $2
+		    WHERE EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $3 AND deleted_at IS NULL)
+		    ON CONFLICT (event_id, user_id) DO UPDATE SET group_id = NULL
+		    RETURNING status, invited_at, responded_at, group_id, xmax = 0 AS inserted
+		), contact AS (
+		    INSERT INTO contacts (user_id, email, name, contact_user_id, invite_count, last_invited_at)
+		    SELECT $3, lower($4), $5, $2, 1, invited_at FROM invited WHERE inserted
+		    ON CONFLICT (user_id, email) DO UPDATE
+		        SET invite_count = contacts.invite_count + 1,
+		            last_invited_at = EXCLUDED.last_invited_at,
+		            contact_user_id = EXCLUDED.contact_user_id,
+		            name = COALESCE(NULLIF(contacts.name, ''), EXCLUDED.name)
+		)
+		SELECT status, invited_at, responded_at, group_id FROM invited;
+	`
+
+	err = r.db.QueryRow(ctx, query, eventID, a.UserID, ownerID, a.Email, a.Name).
+		Scan(&a.Status, &a.InvitedAt, &a.RespondedAt, &a.GroupID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attendee{}, ErrEventNotFound
+		}
+		return model.Attendee{}, fmt.Errorf("failed to invite attendee: %w", err)
+	}
+
+	return a, nil
+}
+
+// InviteExternal invites a person without an account, identified by email address, to an event of the owner.
+// Inviting them again replaces the token of their invitation, so only the latest RSVP link works,
+// but keeps their response. Like Invite, new invitations update the owner's contacts.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//   - email: The email address of the invited person; it is stored in lower case.
+//   - tokenHash: The hash of the token of the RSVP link.
+//
+// Returns:
+//   - The invitation, with the attendee and the event its email shows.
+//   - ErrEventNotFound if the owner has no such event.
+//   - An error if the insertion fails.
+func (r *Repository) InviteExternal(ctx context.Context, eventID, ownerID uuid.UUID, email, tokenHash string) (model.Invitation, error) {
+	query := `
+		WITH event AS (
+		    SELECT e.title, e.event_date, e.end_date, e.reminder_at, e.notifications,
+		           e.recurrence_rule, e.recurrence_exceptions, e.updated_at, u.name
+		    FROM events e
+		    JOIN users u ON u.id = e.user_id
+		    WHERE e.id = $1 AND e.user_id = $2 AND e.deleted_at IS NULL
+		), invited AS (
+		    INSERT INTO external_attendees (event_id, email, token_hash)
+		    SELECT $1, lower($3), $4 FROM event
+		    ON CONFLICT (event_id, email) DO UPDATE SET token_hash = EXCLUDED.token_hash
+		    RETURNING email, status, invited_at, responded_at, xmax = 0 AS inserted
+		), contact AS (
+		    INSERT INTO contacts (user_id, email, invite_count, last_invited_at)
+		    SELECT $2, email, 1, invited_at FROM invited WHERE inserted
+		    ON CONFLICT (user_id, email) DO UPDATE
+		        SET invite_count = contacts.invite_count + 1,
+		            last_invited_at = EXCLUDED.last_invited_at
+		)
+		SELECT i.email, i.status, i.invited_at, i.responded_at,
+		       e.title, e.event_date, e.end_date, e.reminder_at, e.notifications,
+		       e.recurrence_rule, e.recurrence_exceptions, e.updated_at, e.name
+		FROM invited i, event e;
+	`
+
+	inv := model.Invitation{
+		Attendee: model.Attendee{EventID: eventID, External: true},
+		Event:    model.Event{ID: eventID, UserID: ownerID},
+	}
+	a, e := &inv.Attendee, &inv.Event
+	err := r.db.QueryRow(ctx, query, eventID, ownerID, email, tokenHash).Scan(
+		&a.Email, &a.Status, &a.InvitedAt, &a.RespondedAt,
+		&e.Title, &e.EventDate, &e.EndDate, &e.ReminderAt, &e.Notifications,
+		&e.RecurrenceRule, &e.RecurrenceExceptions, &e.UpdatedAt, &inv.Organizer,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Invitation{}, ErrEventNotFound
+		}
+		return model.Invitation{}, fmt.Errorf("failed to invite external attendee: %w", err)
+	}
+
+	return inv, nil
+}
+
+// RespondExternal records the response of an external attendee, identified by the token of their RSVP link.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - tokenHash: The hash of the token of the RSVP link.
+//   - status: The response, model.AttendeeAccepted or model.AttendeeDeclined.
+//
+// Returns:
+//   - The updated attendee.
+//   - ErrInvitationNotFound if no invitation has the token, or its event is in the trash.
+//   - An error if the update fails.
+func (r *Repository) RespondExternal(ctx context.Context, tokenHash, status string) (model.Attendee, error) {
+	query := `
+		UPDATE external_attendees x
+		SET status = $2, responded_at = now()
+		WHERE token_hash = $1
+		  AND EXISTS (SELECT 1 FROM events WHERE id = x.event_id AND deleted_at IS NULL)
+		RETURNING event_id, email, status, invited_at, responded_at;
+	`
+
+	a := model.Attendee{External: true}
+	err := r.db.QueryRow(ctx, query, tokenHash, status).Scan(&a.EventID, &a.Email, &a.Status, &a.InvitedAt, &a.RespondedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attendee{}, ErrInvitationNotFound
+		}
+		return model.Attendee{}, fmt.Errorf("failed to respond to invitation: %w", err)
+	}
+
+	return a, nil
+}
+
+// ListAttendees retrieves the attendees of an event, in the order they were invited.
+// External attendees are listed with them, without user ID and name.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - A slice of attendees.
+//   - An error if the query fails.
+func (r *Repository) ListAttendees(ctx context.Context, eventID uuid.UUID) ([]model.Attendee, error) {
+	query := `
+		SELECT a.event_id, a.user_id, u.email, u.name, a.status, a.invited_at, a.responded_at, a.group_id,
+		       false AS external, a.late_eta
+		FROM event_attendees a
+		JOIN users u ON u.id = a.user_id
+		WHERE a.event_id = $1
+		UNION ALL
+		SELECT event_id, uuid_nil(), email, '', status, invited_at, responded_at, NULL, true, NULL
+		FROM external_attendees
+		WHERE event_id = $1
+		ORDER BY invited_at, email;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendees: %w", err)
+	}
+	defer rows.Close()
+
+	var attendees []model.Attendee
+	for rows.Next() {
+		var a model.Attendee
+		if err := rows.Scan(
+			&a.EventID, &a.UserID, &a.Email, &a.Name, &a.Status, &a.InvitedAt, &a.RespondedAt, &a.GroupID, &a.External, &a.LateETA,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan attendee: %w", err)
+		}
+		attendees = append(attendees, a)
+	}
+
+	return attendees, rows.Err()
+}
+
+// CanView reports whether a user owns an event or is invited to it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - True if the user is the owner or an attendee of the event.
+//   - An error if the query fails.
+func (r *Repository) CanView(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+		    SELECT 1 FROM events
+		    WHERE id = $1 AND deleted_at IS NULL
+		      AND (user_id = $2 OR EXISTS (SELECT 1 FROM event_attendees WHERE event_id = $1 AND user_id = $2))
+		);
+	`
+
+	var ok bool
+	if err := r.db.QueryRow(ctx, query, eventID, userID).Scan(&ok); err != nil {
+		return false, fmt.Errorf("failed to check event access: %w", err)
+	}
+
+	return ok, nil
+}
+
+// Respond records the response of an attendee to their invitation.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the attendee.
+//   - status: The response, model.AttendeeAccepted or model.AttendeeDeclined.
+//
+// Returns:
+//   - The updated attendee, without email and name.
+//   - ErrAttendeeNotFound if the user is not invited to the event.
+//   - An error if the update fails.
+func (r *Repository) Respond(ctx context.Context, eventID, userID uuid.UUID, status string) (model.Attendee, error) {
+	query := `
+		UPDATE event_attendees
+		SET status = $3, responded_at = now()
+		WHERE event_id = $1 AND user_id = $2
+		RETURNING status, invited_at, responded_at, group_id;
+	`
+
+	a := model.Attendee{EventID: eventID, UserID: userID}
+	err := r.db.QueryRow(ctx, query, eventID, userID, status).Scan(&a.Status, &a.InvitedAt, &a.RespondedAt, &a.GroupID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attendee{}, ErrAttendeeNotFound
+		}
+		return model.Attendee{}, fmt.Errorf("failed to respond to invitation: %w", err)
+	}
+
+	return a, nil
+}
+
+// MarkLate records that an attendee who accepted an event is running late, replacing an earlier report.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the attendee.
+//   - eta: The time the attendee expects to arrive.
+//
+// Returns:
+//   - The notice of the late attendee, with the event and the email address of its organizer.
+//   - ErrAttendeeNotFound if the user has not accepted the event, or the event is in the trash.
+//   - An error if the update fails.
+func (r *Repository) MarkLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.LateNotice, error) {
+	query := `
+		WITH late AS (
+		    UPDATE event_attendees a
+		    SET late_eta = $3
+		    FROM events e
+		    WHERE a.event_id = $1 AND a.user_id = $2 AND a.status = 'accepted'
+		      AND e.id = a.event_id AND e.deleted_at IS NULL
+		    RETURNING a.status, a.invited_at, a.responded_at, a.group_id, a.late_eta, e.user_id, e.title, e.event_date
+		)
+		SELECT l.status, l.invited_at, l.responded_at, l.group_id, l.late_eta, u.email, u.name,
+		       l.user_id, l.title, l.event_date, o.email
+		FROM late l
+		JOIN users u ON u.id = $2
+		JOIN users o ON o.id = l.user_id;
+	`
+
+	n := model.LateNotice{
+		Attendee: model.Attendee{EventID: eventID, UserID: userID},
+		Event:    model.Event{ID: eventID},
+	}
+	a, e := &n.Attendee, &n.Event
+	err := r.db.QueryRow(ctx, query, eventID, userID, eta).Scan(
+		&a.Status, &a.InvitedAt, &a.RespondedAt, &a.GroupID, &a.LateETA, &a.Email, &a.Name,
+		&e.UserID, &e.Title, &e.EventDate, &n.OrganizerEmail,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.LateNotice{}, ErrAttendeeNotFound
+		}
+		return model.LateNotice{}, fmt.Errorf("failed to mark attendee late: %w", err)
+	}
+
+	return n, nil
+}
+
+// RemoveAttendee withdraws the invitation of a user to an event of the owner.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//   - userID: The UUID of the attendee.
+//
+// Returns:
+//   - ErrAttendeeNotFound if the user is not invited to an event of the owner, or the event is in the trash.
+//   - An error if the deletion fails.
+func (r *Repository) RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error {
+	query := `
+		DELETE FROM event_attendees
+		WHERE event_id = $1 AND user_id = $3
+		  AND EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL);
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, eventID, ownerID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove attendee: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrAttendeeNotFound
+	}
+
+	return nil
+}
+
+// RequestGuestRSVP stores a new RSVP link for a guest of an event shared by a short link, replacing their earlier link.
+// A guest's response is kept until they open the new link, and a new link is only stored once the cooldown since
+// the previous one has passed, so the address is not flooded with emails.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - code: The short code of the link sharing the event.
+//   - email: The email address of the guest; it is stored in lower case.
+//   - tokenHash: The hash of the token of the RSVP link.
+//   - expiresAt: The time the RSVP link expires.
+//   - now: The current time; links expired by then do not resolve.
+//   - resendAfter: The time a previous RSVP link must have been sent before to be replaced.
+//
+// Returns:
+//   - The request, with the guest and the event the email names.
+//   - ErrSharedEventNotFound if no unexpired link has the code or its event is in the trash,
+//     ErrGuestCooldown if the previous RSVP link was sent after resendAfter.
+//   - An error if the insertion fails.
+func (r *Repository) RequestGuestRSVP(
+	ctx context.Context, code, email, tokenHash string, expiresAt, now, resendAfter time.Time,
+) (model.GuestRequest, error) {
+	query := `
+		WITH link AS (
+		    SELECT s.event_id, s.user_id, s.visibility, e.title, e.event_date
+		    FROM short_links s
+		    JOIN events e ON e.id = s.event_id AND e.deleted_at IS NULL
+		    WHERE s.code = $1 AND s.expires_at > $5
+		), guest AS (
+		    INSERT INTO event_guests (event_id, email, token_hash, token_expires_at, requested_at)
+		    SELECT event_id, lower($2), $3, $4, $5 FROM link
+		    ON CONFLICT (event_id, email) DO UPDATE
+		        SET token_hash = EXCLUDED.token_hash,
+		            token_expires_at = EXCLUDED.token_expires_at,
+		            requested_at = EXCLUDED.requested_at
+		        WHERE event_guests.requested_at <= $6
+		    RETURNING email, status, requested_at, responded_at
+		)
+		SELECT l.event_id, l.user_id, l.visibility, l.title, l.event_date,
+		       g.email, g.status, g.requested_at, g.responded_at
+		FROM link l
+		LEFT JOIN guest g ON true;
+	`
+
+	var (
+		req         model.GuestRequest
+		guestEmail  *string
+		status      *string
+		requestedAt *time.Time
+	)
+	err := r.db.QueryRow(ctx, query, code, email, tokenHash, expiresAt, now, resendAfter).Scan(
+		&req.Event.ID, &req.Event.UserID, &req.Visibility, &req.Event.Title, &req.Event.EventDate,
+		&guestEmail, &status, &requestedAt, &req.Guest.RespondedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GuestRequest{}, ErrSharedEventNotFound
+		}
+		return model.GuestRequest{}, fmt.Errorf("failed to request guest rsvp: %w", err)
+	}
+
+	// The shared event exists, but the guest was not updated: their previous link is too recent.
+	if guestEmail == nil {
+		return model.GuestRequest{}, ErrGuestCooldown
+	}
+
+	req.Guest.EventID, req.Guest.Email, req.Guest.Status, req.Guest.RequestedAt = req.Event.ID, *guestEmail, *status, *requestedAt
+
+	return req, nil
+}
+
+// RespondGuest records the response of a guest, identified by the token of their RSVP link.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - tokenHash: The hash of the token of the RSVP link.
+//   - status: The response, model.AttendeeAccepted or model.AttendeeDeclined.
+//   - now: The current time; links expired by then are rejected.
+//
+// Returns:
+//   - The updated guest.
+//   - ErrGuestNotFound if no guest has the token, or its event is in the trash; ErrGuestLinkExpired if the link expired.
+//   - An error if the update fails.
+func (r *Repository) RespondGuest(ctx context.Context, tokenHash, status string, now time.Time) (model.Guest, error) {
+	query := `
+		UPDATE event_guests g
+		SET status = $2, responded_at = $3
+		WHERE token_hash = $1 AND token_expires_at > $3
+		  AND EXISTS (SELECT 1 FROM events WHERE id = g.event_id AND deleted_at IS NULL)
+		RETURNING event_id, email, status, requested_at, responded_at;
+	`
+
+	var g model.Guest
+	err := r.db.QueryRow(ctx, query, tokenHash, status, now).Scan(&g.EventID, &g.Email, &g.Status, &g.RequestedAt, &g.RespondedAt)
+	if err == nil {
+		return g, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.Guest{}, fmt.Errorf("failed to respond as guest: %w", err)
+	}
+
+	// Tell expired links apart, so the guest knows to ask for a new one.
+	var expired bool
+	err = r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM event_guests WHERE token_hash = $1);`, tokenHash).Scan(&expired)
+	if err != nil {
+		return model.Guest{}, fmt.Errorf("failed to check rsvp link: %w", err)
+	}
+	if expired {
+		return model.Guest{}, ErrGuestLinkExpired
+	}
+
+	return model.Guest{}, ErrGuestNotFound
+}
+
+// ListGuests retrieves the guests of an event, in the order they last asked for an RSVP link.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - A slice of guests.
+//   - An error if the query fails.
+func (r *Repository) ListGuests(ctx context.Context, eventID uuid.UUID) ([]model.Guest, error) {
+	query := `
+		SELECT event_id, email, status, requested_at, responded_at
+		FROM event_guests
+		WHERE event_id = $1
+		ORDER BY requested_at, email;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guests: %w", err)
+	}
+	defer rows.Close()
+
+	var guests []model.Guest
+	for rows.Next() {
+		var g model.Guest
+		if err := rows.Scan(&g.EventID, &g.Email, &g.Status, &g.RequestedAt, &g.RespondedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan guest: %w", err)
+		}
+		guests = append(guests, g)
+	}
+
+	return guests, rows.Err()
+}
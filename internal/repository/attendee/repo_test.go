@@ -0,0 +1,322 @@
+package attendee
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_Invite(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID, userID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT id, email, name FROM users WHERE email = \\$1").
+		WithArgs("guest@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(userID, "guest@example.com", "Guest"))
+	mock.ExpectQuery("INSERT INTO event_attendees(.|\\s)+WHERE EXISTS \\(SELECT 1 FROM events WHERE id = \\$1 AND user_id = \\$3 AND deleted_at IS NULL\\)"+
+		"(.|\\s)+INSERT INTO contacts(.|\\s)+WHERE inserted").
+		WithArgs(eventID, userID, ownerID, "guest@example.com", "Guest").
+		WillReturnRows(pgxmock.NewRows([]string{"status", "invited_at", "responded_at", "group_id"}).AddRow(model.AttendeeInvited, now, (*time.Time)(nil), (*uuid.UUID)(nil)))
+
+	a, err := repo.Invite(context.Background(), eventID, ownerID, "guest@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, userID, a.UserID)
+	assert.Equal(t, eventID, a.EventID)
+	assert.Equal(t, "Guest", a.Name)
+	assert.Equal(t, model.AttendeeInvited, a.Status)
+	assert.Nil(t, a.RespondedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Invite_Errors(t *testing.T) {
+	eventID, ownerID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("unknown email", func(t *testing.T) {
+		repo, mock := newTestRepo(t)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT id, email, name FROM users").WithArgs("nobody@example.com").WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.Invite(context.Background(), eventID, ownerID, "nobody@example.com")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("owner's email", func(t *testing.T) {
+		repo, mock := newTestRepo(t)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT id, email, name FROM users").
+			WithArgs("owner@example.com").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(ownerID, "owner@example.com", "Owner"))
+
+		_, err := repo.Invite(context.Background(), eventID, ownerID, "owner@example.com")
+		assert.ErrorIs(t, err, ErrSelfInvite)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("event of another user", func(t *testing.T) {
+		repo, mock := newTestRepo(t)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT id, email, name FROM users").
+			WithArgs("guest@example.com").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(userID, "guest@example.com", "Guest"))
+		mock.ExpectQuery("INSERT INTO event_attendees").
+			WithArgs(eventID, userID, ownerID, "guest@example.com", "Guest").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.Invite(context.Background(), eventID, ownerID, "guest@example.com")
+		assert.ErrorIs(t, err, ErrEventNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Respond(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("UPDATE event_attendees\\s+SET status = \\$3, responded_at = now\\(\\)").
+		WithArgs(eventID, userID, model.AttendeeAccepted).
+		WillReturnRows(pgxmock.NewRows([]string{"status", "invited_at", "responded_at", "group_id"}).AddRow(model.AttendeeAccepted, now, &now, (*uuid.UUID)(nil)))
+
+	a, err := repo.Respond(context.Background(), eventID, userID, model.AttendeeAccepted)
+	assert.NoError(t, err)
+	assert.Equal(t, model.AttendeeAccepted, a.Status)
+	assert.NotNil(t, a.RespondedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Respond_NotInvited(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("UPDATE event_attendees").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), model.AttendeeDeclined).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.Respond(context.Background(), uuid.New(), uuid.New(), model.AttendeeDeclined)
+	assert.ErrorIs(t, err, ErrAttendeeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkLate(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID, ownerID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+	eta := now.Add(15 * time.Minute)
+
+	mock.ExpectQuery("UPDATE event_attendees a\\s+SET late_eta = \\$3(.|\\s)+a.status = 'accepted'").
+		WithArgs(eventID, userID, eta).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"status", "invited_at", "responded_at", "group_id", "late_eta", "email", "name",
+			"user_id", "title", "event_date", "email",
+		}).AddRow(model.AttendeeAccepted, now, &now, (*uuid.UUID)(nil), &eta, "bob@example.com", "Bob",
+			ownerID, "encrypted", now, "alice@example.com"))
+
+	n, err := repo.MarkLate(context.Background(), eventID, userID, eta)
+	assert.NoError(t, err)
+	assert.Equal(t, &eta, n.Attendee.LateETA)
+	assert.Equal(t, "Bob", n.Attendee.Name)
+	assert.Equal(t, ownerID, n.Event.UserID)
+	assert.Equal(t, "alice@example.com", n.OrganizerEmail)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkLate_NotAttending(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("UPDATE event_attendees a").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.MarkLate(context.Background(), uuid.New(), uuid.New(), time.Now())
+	assert.ErrorIs(t, err, ErrAttendeeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_InviteExternal(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO external_attendees(.|\\s)+SELECT \\$1, lower\\(\\$3\\), \\$4 FROM event"+
+		"(.|\\s)+ON CONFLICT \\(event_id, email\\) DO UPDATE SET token_hash = EXCLUDED.token_hash(.|\\s)+INSERT INTO contacts").
+		WithArgs(eventID, ownerID, "Guest@example.com", "hash").
+		WillReturnRows(pgxmock.NewRows([]string{
+			"email", "status", "invited_at", "responded_at",
+			"title", "event_date", "end_date", "reminder_at", "notifications",
+			"recurrence_rule", "recurrence_exceptions", "updated_at", "name",
+		}).AddRow(
+			"guest@example.com", model.AttendeeInvited, now, (*time.Time)(nil),
+			"enc", now, (*time.Time)(nil), &now, &model.EventNotifications{LeadTime: time.Hour},
+			"", []time.Time(nil), now, "Owner",
+		))
+
+	inv, err := repo.InviteExternal(context.Background(), eventID, ownerID, "Guest@example.com", "hash")
+	assert.NoError(t, err)
+	assert.True(t, inv.Attendee.External)
+	assert.Equal(t, uuid.Nil, inv.Attendee.UserID)
+	assert.Equal(t, "guest@example.com", inv.Attendee.Email)
+	assert.Equal(t, "enc", inv.Event.Title)
+	assert.Equal(t, eventID, inv.Event.ID)
+	assert.Equal(t, time.Hour, inv.Event.Notifications.LeadTime)
+	assert.Equal(t, "Owner", inv.Organizer)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_InviteExternal_EventNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO external_attendees").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), "guest@example.com", "hash").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.InviteExternal(context.Background(), uuid.New(), uuid.New(), "guest@example.com", "hash")
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RespondExternal(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("UPDATE external_attendees x\\s+SET status = \\$2, responded_at = now\\(\\)\\s+WHERE token_hash = \\$1").
+		WithArgs("hash", model.AttendeeDeclined).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "email", "status", "invited_at", "responded_at"}).
+			AddRow(eventID, "guest@example.com", model.AttendeeDeclined, now, &now))
+
+	a, err := repo.RespondExternal(context.Background(), "hash", model.AttendeeDeclined)
+	assert.NoError(t, err)
+	assert.Equal(t, eventID, a.EventID)
+	assert.True(t, a.External)
+	assert.Equal(t, model.AttendeeDeclined, a.Status)
+
+	mock.ExpectQuery("UPDATE external_attendees").WithArgs("unknown", model.AttendeeAccepted).WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.RespondExternal(context.Background(), "unknown", model.AttendeeAccepted)
+	assert.ErrorIs(t, err, ErrInvitationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RemoveAttendee_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	// The event was trashed or belongs to another user.
+	mock.ExpectExec("DELETE FROM event_attendees(.|\\s)+user_id = \\$2 AND deleted_at IS NULL").
+		WithArgs(eventID, ownerID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.RemoveAttendee(context.Background(), eventID, ownerID, userID)
+	assert.ErrorIs(t, err, ErrAttendeeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RequestGuestRSVP(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	now := time.Now()
+	expiresAt, resendAfter := now.Add(24*time.Hour), now.Add(-time.Minute)
+	columns := []string{"event_id", "user_id", "visibility", "title", "event_date", "email", "status", "requested_at", "responded_at"}
+
+	mock.ExpectQuery("INSERT INTO event_guests(.|\\s)+WHERE event_guests.requested_at <= \\$6(.|\\s)+LEFT JOIN guest g ON true").
+		WithArgs("AbCd1234", "Guest@example.com", "hash", expiresAt, now, resendAfter).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(eventID, ownerID, model.ShortLinkBasic, "encrypted", now, ptr("guest@example.com"), ptr(model.GuestPending), &now, (*time.Time)(nil)))
+
+	req, err := repo.RequestGuestRSVP(context.Background(), "AbCd1234", "Guest@example.com", "hash", expiresAt, now, resendAfter)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Guest{EventID: eventID, Email: "guest@example.com", Status: model.GuestPending, RequestedAt: now}, req.Guest)
+	assert.Equal(t, ownerID, req.Event.UserID)
+	assert.Equal(t, model.ShortLinkBasic, req.Visibility)
+
+	// The link resolves, but the previous RSVP link of the guest is too recent to be replaced.
+	mock.ExpectQuery("INSERT INTO event_guests").
+		WithArgs("AbCd1234", "guest@example.com", "hash", expiresAt, now, resendAfter).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(eventID, ownerID, model.ShortLinkBasic, "encrypted", now, (*string)(nil), (*string)(nil), (*time.Time)(nil), (*time.Time)(nil)))
+
+	_, err = repo.RequestGuestRSVP(context.Background(), "AbCd1234", "guest@example.com", "hash", expiresAt, now, resendAfter)
+	assert.ErrorIs(t, err, ErrGuestCooldown)
+
+	mock.ExpectQuery("INSERT INTO event_guests").
+		WithArgs("expired", "guest@example.com", "hash", expiresAt, now, resendAfter).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.RequestGuestRSVP(context.Background(), "expired", "guest@example.com", "hash", expiresAt, now, resendAfter)
+	assert.ErrorIs(t, err, ErrSharedEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RespondGuest(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("UPDATE event_guests g\\s+SET status = \\$2, responded_at = \\$3\\s+WHERE token_hash = \\$1 AND token_expires_at > \\$3").
+		WithArgs("hash", model.AttendeeAccepted, now).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "email", "status", "requested_at", "responded_at"}).
+			AddRow(eventID, "guest@example.com", model.AttendeeAccepted, now, &now))
+
+	g, err := repo.RespondGuest(context.Background(), "hash", model.AttendeeAccepted, now)
+	assert.NoError(t, err)
+	assert.Equal(t, model.AttendeeAccepted, g.Status)
+
+	// Expired links are told apart from unknown ones.
+	for _, exists := range []bool{true, false} {
+		mock.ExpectQuery("UPDATE event_guests g").
+			WithArgs("hash", model.AttendeeDeclined, now).
+			WillReturnError(pgx.ErrNoRows)
+		mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM event_guests WHERE token_hash = \\$1\\)").
+			WithArgs("hash").
+			WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(exists))
+	}
+
+	_, err = repo.RespondGuest(context.Background(), "hash", model.AttendeeDeclined, now)
+	assert.ErrorIs(t, err, ErrGuestLinkExpired)
+	_, err = repo.RespondGuest(context.Background(), "hash", model.AttendeeDeclined, now)
+	assert.ErrorIs(t, err, ErrGuestNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// ptr returns a pointer to a copy of v.
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,220 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrCalendarNotFound = errors.New("calendar not found")
+	ErrDefaultCalendar  = errors.New("the default calendar cannot be deleted")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages interactions with the calendars table in the PostgreSQL database.
+// It provides methods for creating, reading, renaming and deleting the calendars of users.
+// The default calendar of a user is created together with the user by the user repository.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateCalendar inserts a new calendar into the calendars table and returns its ID.
+// The calendar is never the default calendar of the user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - calendar: The calendar data to be inserted.
+//
+// Returns:
+//   - The UUID of the created calendar.
+//   - An error if the insertion fails.
+func (r *Repository) CreateCalendar(ctx context.Context, calendar model.Calendar) (uuid.UUID, error) {
+	query := `
+		INSERT INTO calendars (user_id, name, color)
+		VALUES ($1, $2, $3)
+		RETURNING id;
+	`
+
+	err := r.db.QueryRow(ctx, query, calendar.UserID, calendar.Name, calendar.Color).Scan(&calendar.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create calendar: %w", err)
+	}
+
+	return calendar.ID, nil
+}
+
+// GetCalendar retrieves a calendar by its ID for the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - calendarID: The UUID of the calendar.
+//   - userID: The UUID of the user who owns the calendar.
+//
+// Returns:
+//   - The calendar.
+//   - An error if the query fails or if the calendar is not found.
+func (r *Repository) GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error) {
+	query := `
+		SELECT id, user_id, name, color, is_default, created_at, updated_at
+		FROM calendars
+		WHERE id = $1 AND user_id = $2;
+	`
+
+	var c model.Calendar
+	err := r.db.QueryRow(ctx, query, calendarID, userID).Scan(
+		&c.ID, &c.UserID, &c.Name, &c.Color, &c.IsDefault, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Calendar{}, ErrCalendarNotFound
+		}
+		return model.Calendar{}, fmt.Errorf("failed to get calendar: %w", err)
+	}
+
+	return c, nil
+}
+
+// ListCalendars retrieves all calendars of a user, the default calendar first, then by name.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose calendars are retrieved.
+//
+// Returns:
+//   - A slice of calendars.
+//   - An error if the query fails.
+func (r *Repository) ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error) {
+	query := `
+		SELECT id, user_id, name, color, is_default, created_at, updated_at
+		FROM calendars
+		WHERE user_id = $1
+		ORDER BY is_default DESC, name, created_at;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calendars: %w", err)
+	}
+	defer rows.Close()
+
+	var calendars []model.Calendar
+	for rows.Next() {
+		var c model.Calendar
+		if err := rows.Scan(&c.ID, &c.UserID, &c.Name, &c.Color, &c.IsDefault, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar: %w", err)
+		}
+		calendars = append(calendars, c)
+	}
+
+	return calendars, rows.Err()
+}
+
+// UpdateCalendar renames and recolors a calendar of the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - calendar: The calendar with its ID, owner and new name and color.
+//
+// Returns:
+//   - An error if the update fails or if the calendar is not found.
+func (r *Repository) UpdateCalendar(ctx context.Context, calendar model.Calendar) error {
+	query := `
+		UPDATE calendars
+		SET name = $1, color = $2, updated_at = now()
+		WHERE id = $3 AND user_id = $4;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, calendar.Name, calendar.Color, calendar.ID, calendar.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update calendar: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrCalendarNotFound
+	}
+
+	return nil
+}
+
+// DeleteCalendar deletes a calendar of the specified user. Its events are kept and move to the
+// default calendar of the user, which itself cannot be deleted.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - calendarID: The UUID of the calendar to delete.
+//   - userID: The UUID of the user who owns the calendar.
+//
+// Returns:
+//   - ErrCalendarNotFound if the user has no such calendar, ErrDefaultCalendar if it is their default calendar,
+//     or another error if the deletion fails.
+func (r *Repository) DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the calendar so that no events are added to it while they are moved.
+	var isDefault bool
+	err = tx.QueryRow(ctx, `SELECT is_default FROM calendars WHERE id = $1 AND user_id = $2 FOR UPDATE`, calendarID, userID).
+		Scan(&isDefault)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCalendarNotFound
+		}
+		return fmt.Errorf("failed to get calendar: %w", err)
+	}
+
+	if isDefault {
+		return ErrDefaultCalendar
+	}
+
+	// Move the events to the default calendar.
+	_, err = tx.Exec(ctx, `
+		UPDATE events
+		SET calendar_id = (SELECT id FROM calendars WHERE user_id = $2 AND is_default)
+		WHERE calendar_id = $1
+	`, calendarID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to move calendar events: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM calendars WHERE id = $1`, calendarID); err != nil {
+		return fmt.Errorf("failed to delete calendar: %w", err)
+	}
+
+	// Commit the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateCalendar(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	calendar := model.Calendar{UserID: uuid.New(), Name: "Work", Color: "blue"}
+
+	mock.ExpectQuery("INSERT INTO calendars").
+		WithArgs(calendar.UserID, calendar.Name, calendar.Color).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+
+	gotID, err := repo.CreateCalendar(context.Background(), calendar)
+	assert.NoError(t, err)
+	assert.Equal(t, id, gotID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListCalendars(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	now := time.Now()
+	columns := []string{"id", "user_id", "name", "color", "is_default", "created_at", "updated_at"}
+
+	mock.ExpectQuery("SELECT id, user_id, name, color, is_default, created_at, updated_at\\s+FROM calendars\\s+WHERE user_id = \\$1\\s+ORDER BY is_default DESC").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(uuid.New(), userID, "Personal", "", true, now, now).
+			AddRow(uuid.New(), userID, "Work", "blue", false, now, now))
+
+	calendars, err := repo.ListCalendars(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Len(t, calendars, 2)
+	assert.True(t, calendars[0].IsDefault)
+	assert.Equal(t, "Work", calendars[1].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetCalendar_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	calendarID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, name, color, is_default, created_at, updated_at\\s+FROM calendars").
+		WithArgs(calendarID, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetCalendar(context.Background(), calendarID, userID)
+	assert.ErrorIs(t, err, ErrCalendarNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateCalendar_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	calendar := model.Calendar{ID: uuid.New(), UserID: uuid.New(), Name: "Work"}
+
+	mock.ExpectExec("UPDATE calendars").
+		WithArgs(calendar.Name, calendar.Color, calendar.ID, calendar.UserID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.UpdateCalendar(context.Background(), calendar)
+	assert.ErrorIs(t, err, ErrCalendarNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteCalendar(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	calendarID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT is_default FROM calendars").
+		WithArgs(calendarID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"is_default"}).AddRow(false))
+	mock.ExpectExec("UPDATE events\\s+SET calendar_id = \\(SELECT id FROM calendars WHERE user_id = \\$2 AND is_default\\)").
+		WithArgs(calendarID, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+	mock.ExpectExec("DELETE FROM calendars").
+		WithArgs(calendarID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteCalendar(context.Background(), calendarID, userID)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteCalendar_Default(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	calendarID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT is_default FROM calendars").
+		WithArgs(calendarID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"is_default"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := repo.DeleteCalendar(context.Background(), calendarID, userID)
+	assert.ErrorIs(t, err, ErrDefaultCalendar)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
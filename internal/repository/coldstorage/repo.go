@@ -0,0 +1,218 @@
+package coldstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages the export of archived events to cold storage and their restore, using the archived_events
+// and archived_reminders tables and the cold_storage_events table, which records the object holding each exported event.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListExportable retrieves a batch of the events archived before a cutoff, oldest first.
+// Each event is returned as a JSON object with all columns of the archived event under "event" and
+// its archived reminders under "reminders", so exports keep every field without listing them.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - before: The cutoff; events archived at or after it are kept in the database.
+//   - limit: The maximum number of events retrieved.
+//
+// Returns:
+//   - The events to export; fewer than limit means no other events are due.
+//   - An error if the query fails.
+func (r *Repository) ListExportable(ctx context.Context, before time.Time, limit int) ([]model.ColdEvent, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT a.id, a.user_id, jsonb_build_object(
+			'event', to_jsonb(a),
+			'reminders', COALESCE((SELECT jsonb_agg(to_jsonb(r)) FROM archived_reminders r WHERE r.event_id = a.id), '[]'::jsonb)
+		)
+		FROM archived_events a
+		WHERE a.archived_at < $1
+		ORDER BY a.archived_at, a.id
+		LIMIT $2
+	`, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select exportable events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.ColdEvent
+	for rows.Next() {
+		var e model.ColdEvent
+		var record []byte
+		if err := rows.Scan(&e.ID, &e.UserID, &record); err != nil {
+			return nil, fmt.Errorf("failed to scan exportable event: %w", err)
+		}
+		e.Record = record
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read exportable events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkExported deletes exported events from the archive, with their reminders, and records the object holding them.
+// Events restored from the archive since they were listed are not deleted and stay out of the record;
+// events already recorded in another object by a concurrent export keep that object.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - key: The key of the object the events were written to.
+//   - ids: The IDs of the exported events.
+//
+// Returns:
+//   - The number of events deleted from the archive.
+//   - An error if the deletion fails.
+func (r *Repository) MarkExported(ctx context.Context, key string, ids []uuid.UUID) (int, error) {
+	cmdTag, err := r.db.Exec(ctx, `
+		WITH exported AS (
+			DELETE FROM archived_events WHERE id = ANY($1)
+			RETURNING id, user_id, archived_at
+		)
+		INSERT INTO cold_storage_events (event_id, user_id, object_key, archived_at)
+		SELECT id, user_id, $2, archived_at FROM exported
+		ON CONFLICT (event_id) DO NOTHING
+	`, ids, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark events exported: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// ListObjects retrieves the keys of the objects holding exported events, optionally of one user only.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are looked up; all events when nil.
+//
+// Returns:
+//   - The object keys in the order they were exported.
+//   - An error if the query fails.
+func (r *Repository) ListObjects(ctx context.Context, userID *uuid.UUID) ([]string, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT object_key
+		FROM cold_storage_events
+		WHERE $1::uuid IS NULL OR user_id = $1
+		GROUP BY object_key
+		ORDER BY MIN(exported_at), object_key
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select cold storage objects: %w", err)
+	}
+
+	keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan cold storage objects: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RestoreEvents moves the events of an object back into the archive, with their reminders.
+// Only events still recorded in the object are restored, optionally of one user only; their archive time is reset,
+// so they stay in the database for another retention period. Fields missing from records exported before
+// a schema change are restored as NULL.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - key: The key of the object the records were read from.
+//   - records: The records of the object, as written by the export.
+//   - userID: The UUID of the user whose events are restored; all events of the object when nil.
+//
+// Returns:
+//   - The number of restored events.
+//   - An error if the restore fails or the transaction cannot be committed.
+func (r *Repository) RestoreEvents(ctx context.Context, key string, records []json.RawMessage, userID *uuid.UUID) (int, error) {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode records: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Claim the events of the object; a concurrent restore of the same object waits and then finds none.
+	rows, err := tx.Query(ctx, `
+		DELETE FROM cold_storage_events
+		WHERE object_key = $1 AND ($2::uuid IS NULL OR user_id = $2)
+		RETURNING event_id
+	`, key, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete cold storage events: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan cold storage events: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		INSERT INTO archived_events
+		SELECT e.*
+		FROM jsonb_array_elements($1::jsonb) rec,
+			jsonb_populate_record(NULL::archived_events, (rec->'event') || jsonb_build_object('archived_at', now())) e
+		WHERE e.id = ANY($2)
+		ON CONFLICT (id) DO NOTHING
+	`, data, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore events: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO archived_reminders
+		SELECT rem.*
+		FROM jsonb_array_elements($1::jsonb) rec,
+			jsonb_populate_recordset(NULL::archived_reminders, rec->'reminders') rem
+		WHERE (rec->'event'->>'id')::uuid = ANY($2)
+		ON CONFLICT (id) DO NOTHING
+	`, data, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore reminders: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
@@ -0,0 +1,98 @@
+package coldstorage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListExportable(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := []byte(`{"event":{"id":"` + eventID.String() + `"},"reminders":[]}`)
+
+	mock.ExpectQuery("FROM archived_events a\\s+WHERE a.archived_at < \\$1\\s+ORDER BY a.archived_at, a.id\\s+LIMIT \\$2").
+		WithArgs(before, 100).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "record"}).AddRow(eventID, userID, record))
+
+	events, err := repo.ListExportable(context.Background(), before, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ColdEvent{{ID: eventID, UserID: userID, Record: record}}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkExported(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectExec("DELETE FROM archived_events WHERE id = ANY\\(\\$1\\)(.|\\s)+INSERT INTO cold_storage_events").
+		WithArgs(ids, "archive/2025/01/01/export.ndjson.gz").
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	n, err := repo.MarkExported(context.Background(), "archive/2025/01/01/export.ndjson.gz", ids)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	records := []json.RawMessage{json.RawMessage(`{"event":{"id":"` + eventID.String() + `"},"reminders":[]}`)}
+	data, _ := json.Marshal(records)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM cold_storage_events\\s+WHERE object_key = \\$1 AND \\(\\$2::uuid IS NULL OR user_id = \\$2\\)").
+		WithArgs("export.ndjson.gz", &userID).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id"}).AddRow(eventID))
+	mock.ExpectExec("INSERT INTO archived_events(.|\\s)+jsonb_populate_record\\(NULL::archived_events").
+		WithArgs(data, []uuid.UUID{eventID}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO archived_reminders(.|\\s)+jsonb_populate_recordset\\(NULL::archived_reminders").
+		WithArgs(data, []uuid.UUID{eventID}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mock.ExpectCommit()
+
+	n, err := repo.RestoreEvents(context.Background(), "export.ndjson.gz", records, &userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreEvents_NothingRecorded(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM cold_storage_events").
+		WithArgs("export.ndjson.gz", (*uuid.UUID)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id"}))
+	mock.ExpectRollback()
+
+	n, err := repo.RestoreEvents(context.Background(), "export.ndjson.gz", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
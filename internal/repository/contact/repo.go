@@ -0,0 +1,150 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var ErrContactNotFound = errors.New("contact not found")
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// contactColumns are the columns of a contact, in the order they are scanned.
+const contactColumns = `id, user_id, email, name, contact_user_id, invite_count, last_invited_at, created_at`
+
+// likeEscaper escapes the wildcards of LIKE patterns, so a prefix is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Repository manages interactions with the contacts table in the PostgreSQL database.
+// It provides methods for searching, adding and removing the contacts of users.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListContacts retrieves the contacts of a user whose email address or a word of whose name starts with a prefix,
+// the most frequently invited first and, among equally frequent contacts, the most recently invited.
+// Case and accents of names are ignored.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - prefix: The beginning of the email address or name; empty for all contacts.
+//   - limit: The maximum number of contacts returned.
+//
+// Returns:
+//   - A slice of contacts, empty if none match.
+//   - An error if the query fails.
+func (r *Repository) ListContacts(ctx context.Context, userID uuid.UUID, prefix string, limit int) ([]model.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE user_id = $1
+		  AND ($2 = ''
+		    OR email LIKE lower($2) || '%'
+		    OR normalize_text(name) LIKE normalize_text($2) || '%'
+		    OR normalize_text(name) LIKE '% ' || normalize_text($2) || '%')
+		ORDER BY invite_count DESC, last_invited_at DESC NULLS LAST, name, email
+		LIMIT $3;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, likeEscaper.Replace(prefix), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", err)
+	}
+	defer rows.Close()
+
+	contacts := []model.Contact{}
+	for rows.Next() {
+		c, err := scanContact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan contact: %w", err)
+		}
+		contacts = append(contacts, c)
+	}
+
+	return contacts, rows.Err()
+}
+
+// AddContact adds a contact to a user's contacts, linked to the registered user with its email address, if any.
+// Adding an existing contact keeps its ranking and replaces its name if a name is given.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - c: The contact to add; UserID, Email and the optional Name are used.
+//
+// Returns:
+//   - The stored contact.
+//   - An error if the insertion fails.
+func (r *Repository) AddContact(ctx context.Context, c model.Contact) (model.Contact, error) {
+	query := `
+		INSERT INTO contacts (user_id, email, name, contact_user_id)
+		VALUES ($1, $2, COALESCE(NULLIF($3, ''), (SELECT name FROM users WHERE lower(email) = $2 LIMIT 1), ''),
+		        (SELECT id FROM users WHERE lower(email) = $2 LIMIT 1))
+		ON CONFLICT (user_id, email) DO UPDATE
+		    SET name = COALESCE(NULLIF($3, ''), contacts.name)
+		RETURNING ` + contactColumns + `;
+	`
+
+	stored, err := scanContact(r.db.QueryRow(ctx, query, c.UserID, c.Email, c.Name))
+	if err != nil {
+		return model.Contact{}, fmt.Errorf("failed to add contact: %w", err)
+	}
+
+	return stored, nil
+}
+
+// RemoveContact removes a contact of a user. It is added again by the next invitation sent to it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - contactID: The UUID of the contact.
+//   - userID: The UUID of the user the contact belongs to.
+//
+// Returns:
+//   - ErrContactNotFound if the user has no such contact, or an error if the deletion fails.
+func (r *Repository) RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM contacts WHERE id = $1 AND user_id = $2`, contactID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove contact: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrContactNotFound
+	}
+
+	return nil
+}
+
+// scanContact scans a contact from a row with the contact columns.
+func scanContact(row pgx.Row) (model.Contact, error) {
+	var c model.Contact
+	err := row.Scan(&c.ID, &c.UserID, &c.Email, &c.Name, &c.ContactUserID, &c.InviteCount, &c.LastInvitedAt, &c.CreatedAt)
+	return c, err
+}
@@ -0,0 +1,78 @@
+package contact
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var contactRows = []string{"id", "user_id", "email", "name", "contact_user_id", "invite_count", "last_invited_at", "created_at"}
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListContacts(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, contactUserID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	// Wildcards typed by the user are matched literally.
+	mock.ExpectQuery("FROM contacts(.|\\s)+ORDER BY invite_count DESC, last_invited_at DESC NULLS LAST").
+		WithArgs(userID, `an\_`, 10).
+		WillReturnRows(pgxmock.NewRows(contactRows).
+			AddRow(uuid.New(), userID, "ana@example.com", "Ana", &contactUserID, 3, &now, now))
+
+	contacts, err := repo.ListContacts(context.Background(), userID, "an_", 10)
+	assert.NoError(t, err)
+	assert.Len(t, contacts, 1)
+	assert.Equal(t, "ana@example.com", contacts[0].Email)
+	assert.Equal(t, 3, contacts[0].InviteCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddContact(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO contacts(.|\\s)+ON CONFLICT \\(user_id, email\\) DO UPDATE").
+		WithArgs(userID, "bob@example.com", "").
+		WillReturnRows(pgxmock.NewRows(contactRows).
+			AddRow(uuid.New(), userID, "bob@example.com", "", (*uuid.UUID)(nil), 0, (*time.Time)(nil), now))
+
+	c, err := repo.AddContact(context.Background(), model.Contact{UserID: userID, Email: "bob@example.com"})
+	assert.NoError(t, err)
+	assert.Nil(t, c.ContactUserID)
+	assert.Zero(t, c.InviteCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RemoveContact_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	contactID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectExec("DELETE FROM contacts WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs(contactID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.RemoveContact(context.Background(), contactID, userID)
+	assert.ErrorIs(t, err, ErrContactNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,94 @@
+package datakey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrKeyNotFound = errors.New("data key not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the wrapped per-user data keys in the user_keys table.
+// Keys are stored wrapped by the master key and never in plaintext.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetKey retrieves the wrapped data key of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The wrapped data key.
+//   - ErrKeyNotFound if the user has no data key yet, or another error if the query fails.
+func (r *Repository) GetKey(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	query := `
+		SELECT wrapped_key
+		FROM user_keys
+		WHERE user_id = $1;
+	`
+
+	var wrapped []byte
+	err := r.db.QueryRow(ctx, query, userID).Scan(&wrapped)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	return wrapped, nil
+}
+
+// CreateKey stores the wrapped data key of a user unless the user already has one.
+// The key that ends up stored is returned, so concurrent callers agree on a single key.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - wrapped: The wrapped data key to store.
+//
+// Returns:
+//   - The stored wrapped data key.
+//   - An error if the insert fails.
+func (r *Repository) CreateKey(ctx context.Context, userID uuid.UUID, wrapped []byte) ([]byte, error) {
+	query := `
+		INSERT INTO user_keys (user_id, wrapped_key)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+		RETURNING wrapped_key;
+	`
+
+	var stored []byte
+	if err := r.db.QueryRow(ctx, query, userID, wrapped).Scan(&stored); err != nil {
+		return nil, fmt.Errorf("failed to create data key: %w", err)
+	}
+
+	return stored, nil
+}
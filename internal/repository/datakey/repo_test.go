@@ -0,0 +1,51 @@
+package datakey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_GetKey_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT wrapped_key\\s+FROM user_keys").
+		WithArgs(userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetKey(context.Background(), userID)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateKey_ReturnsStoredKey(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	existing := []byte("existing")
+
+	mock.ExpectQuery("INSERT INTO user_keys(.|\\s)+ON CONFLICT").
+		WithArgs(userID, []byte("new")).
+		WillReturnRows(pgxmock.NewRows([]string{"wrapped_key"}).AddRow(existing))
+
+	stored, err := repo.CreateKey(context.Background(), userID, []byte("new"))
+	assert.NoError(t, err)
+	assert.Equal(t, existing, stored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,169 @@
+package delegate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrDelegateNotFound = errors.New("delegate not found")
+	ErrSelfDelegation   = errors.New("cannot add yourself as a delegate")
+)
+
+// checkViolation is the PostgreSQL error code of a violated CHECK constraint.
+const checkViolation = "23514"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages interactions with the delegates table in the PostgreSQL database.
+// It provides methods for granting, listing, revoking and checking delegate permissions.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// AddDelegate allows the user with the given email address to create events for the owner.
+// Adding an existing delegate again keeps the original grant.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - ownerID: The UUID of the user whose calendar the delegate creates events in.
+//   - email: The email address of the delegate.
+//
+// Returns:
+//   - The delegate.
+//   - ErrUserNotFound if no user has the email address, ErrSelfDelegation if it is the owner's own.
+//   - An error if the insertion fails.
+func (r *Repository) AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error) {
+	query := `
+		INSERT INTO delegates (owner_id, delegate_id)
+		SELECT $1, id
+		FROM users
+		WHERE email = $2
+		ON CONFLICT (owner_id, delegate_id) DO UPDATE SET owner_id = EXCLUDED.owner_id
+		RETURNING delegate_id, created_at;
+	`
+
+	d := model.Delegate{OwnerID: ownerID, Email: email}
+	err := r.db.QueryRow(ctx, query, ownerID, email).Scan(&d.DelegateID, &d.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Delegate{}, ErrUserNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == checkViolation {
+			return model.Delegate{}, ErrSelfDelegation
+		}
+
+		return model.Delegate{}, fmt.Errorf("failed to add delegate: %w", err)
+	}
+
+	return d, nil
+}
+
+// ListDelegates retrieves the delegates of a user, oldest first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - ownerID: The UUID of the user whose delegates are retrieved.
+//
+// Returns:
+//   - A slice of delegates.
+//   - An error if the query fails.
+func (r *Repository) ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error) {
+	query := `
+		SELECT d.owner_id, d.delegate_id, u.email, d.created_at
+		FROM delegates d
+		JOIN users u ON u.id = d.delegate_id
+		WHERE d.owner_id = $1
+		ORDER BY d.created_at, u.email;
+	`
+
+	rows, err := r.db.Query(ctx, query, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delegates: %w", err)
+	}
+	defer rows.Close()
+
+	var delegates []model.Delegate
+	for rows.Next() {
+		var d model.Delegate
+		if err := rows.Scan(&d.OwnerID, &d.DelegateID, &d.Email, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan delegate: %w", err)
+		}
+		delegates = append(delegates, d)
+	}
+
+	return delegates, rows.Err()
+}
+
+// RemoveDelegate revokes the permission of a delegate to create events for the owner.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - ownerID: The UUID of the user who added the delegate.
+//   - delegateID: The UUID of the delegate.
+//
+// Returns:
+//   - ErrDelegateNotFound if the user is not a delegate of the owner.
+//   - An error if the deletion fails.
+func (r *Repository) RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM delegates WHERE owner_id = $1 AND delegate_id = $2`, ownerID, delegateID)
+	if err != nil {
+		return fmt.Errorf("failed to remove delegate: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrDelegateNotFound
+	}
+
+	return nil
+}
+
+// IsDelegate reports whether a user is a delegate of the owner.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - ownerID: The UUID of the owner.
+//   - delegateID: The UUID of the user acting for the owner.
+//
+// Returns:
+//   - True if the user may create events for the owner.
+//   - An error if the query fails.
+func (r *Repository) IsDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM delegates WHERE owner_id = $1 AND delegate_id = $2);`
+
+	var ok bool
+	if err := r.db.QueryRow(ctx, query, ownerID, delegateID).Scan(&ok); err != nil {
+		return false, fmt.Errorf("failed to check delegate: %w", err)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,96 @@
+package delegate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_AddDelegate(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	ownerID, delegateID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO delegates").
+		WithArgs(ownerID, "assistant@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"delegate_id", "created_at"}).AddRow(delegateID, now))
+
+	d, err := repo.AddDelegate(context.Background(), ownerID, "assistant@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, delegateID, d.DelegateID)
+	assert.Equal(t, ownerID, d.OwnerID)
+	assert.Equal(t, "assistant@example.com", d.Email)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddDelegate_Errors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want error
+	}{
+		"unknown email": {err: pgx.ErrNoRows, want: ErrUserNotFound},
+		"owner's email": {err: &pgconn.PgError{Code: checkViolation}, want: ErrSelfDelegation},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo, mock := newTestRepo(t)
+			defer mock.Close()
+
+			mock.ExpectQuery("INSERT INTO delegates").
+				WithArgs(pgxmock.AnyArg(), "someone@example.com").
+				WillReturnError(tt.err)
+
+			_, err := repo.AddDelegate(context.Background(), uuid.New(), "someone@example.com")
+			assert.ErrorIs(t, err, tt.want)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_RemoveDelegate_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	ownerID, delegateID := uuid.New(), uuid.New()
+
+	mock.ExpectExec("DELETE FROM delegates").
+		WithArgs(ownerID, delegateID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.RemoveDelegate(context.Background(), ownerID, delegateID)
+	assert.ErrorIs(t, err, ErrDelegateNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_IsDelegate(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	ownerID, delegateID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(ownerID, delegateID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	ok, err := repo.IsDelegate(context.Background(), ownerID, delegateID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,168 @@
+package embed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrEmbedNotFound   = errors.New("embed not found")
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+// embedColumns lists the columns of the embeds table returned to callers; the token hash is never read back.
+const embedColumns = "id, user_id, name, project_id, allowed_domains, range_days, created_at"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the embeds of users in the embeds table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateEmbed inserts a new embed with the hash of its share token.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - embed: The embed to be inserted.
+//   - tokenHash: The hash of the share token.
+//
+// Returns:
+//   - The created embed with ID and CreatedAt set.
+//   - ErrProjectNotFound if the user has no such project, or another error if the insertion fails.
+func (r *Repository) CreateEmbed(ctx context.Context, embed model.Embed, tokenHash string) (model.Embed, error) {
+	query := `
+		INSERT INTO embeds (user_id, name, token_hash, project_id, allowed_domains, range_days)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, embed.UserID, embed.Name, tokenHash, embed.ProjectID, domainsOf(embed),
+		embed.RangeDays).Scan(&embed.ID, &embed.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "embeds_project_fk" {
+			return model.Embed{}, ErrProjectNotFound
+		}
+		return model.Embed{}, fmt.Errorf("failed to create embed: %w", err)
+	}
+
+	return embed, nil
+}
+
+// ListEmbeds retrieves all embeds of a user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of embeds without tokens.
+//   - An error if the query fails.
+func (r *Repository) ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error) {
+	query := `SELECT ` + embedColumns + ` FROM embeds WHERE user_id = $1 ORDER BY created_at DESC, id;`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query embeds: %w", err)
+	}
+	defer rows.Close()
+
+	var embeds []model.Embed
+	for rows.Next() {
+		embed, err := scanEmbed(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan embed: %w", err)
+		}
+		embeds = append(embeds, embed)
+	}
+
+	return embeds, rows.Err()
+}
+
+// GetEmbedByTokenHash retrieves the embed a share token belongs to.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - tokenHash: The hash of the share token.
+//
+// Returns:
+//   - The embed without its token.
+//   - ErrEmbedNotFound if no embed has the token, or another error if the query fails.
+func (r *Repository) GetEmbedByTokenHash(ctx context.Context, tokenHash string) (model.Embed, error) {
+	query := `SELECT ` + embedColumns + ` FROM embeds WHERE token_hash = $1;`
+
+	embed, err := scanEmbed(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Embed{}, ErrEmbedNotFound
+		}
+		return model.Embed{}, fmt.Errorf("failed to get embed: %w", err)
+	}
+
+	return embed, nil
+}
+
+// DeleteEmbed deletes an embed of the specified user, revoking its share token.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - embedID: The UUID of the embed.
+//   - userID: The UUID of the user who owns the embed.
+//
+// Returns:
+//   - ErrEmbedNotFound if the user has no such embed, or another error if the deletion fails.
+func (r *Repository) DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM embeds WHERE id = $1 AND user_id = $2`, embedID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete embed: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrEmbedNotFound
+	}
+
+	return nil
+}
+
+// scanEmbed scans an embeds row selected with embedColumns.
+func scanEmbed(row pgx.Row) (model.Embed, error) {
+	var embed model.Embed
+	err := row.Scan(&embed.ID, &embed.UserID, &embed.Name, &embed.ProjectID, &embed.AllowedDomains,
+		&embed.RangeDays, &embed.CreatedAt)
+	return embed, err
+}
+
+// domainsOf returns the allowed domains of an embed, with nil as an empty list since the column is not nullable.
+func domainsOf(embed model.Embed) []string {
+	if embed.AllowedDomains == nil {
+		return []string{}
+	}
+	return embed.AllowedDomains
+}
@@ -0,0 +1,99 @@
+package embed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateEmbed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	embed := model.Embed{UserID: uuid.New(), Name: "Team calendar", RangeDays: 30}
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO embeds").
+		WithArgs(embed.UserID, "Team calendar", "hash", (*uuid.UUID)(nil), []string{}, 30).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(id, now))
+
+	got, err := repo.CreateEmbed(context.Background(), embed, "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, now, got.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateEmbed_UnknownProject(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	projectID := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO embeds").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "embeds_project_fk"})
+
+	_, err := repo.CreateEmbed(context.Background(), model.Embed{UserID: uuid.New(), ProjectID: &projectID}, "hash")
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestRepository_GetEmbedByTokenHash(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, name, project_id, allowed_domains, range_days, created_at FROM embeds WHERE token_hash = \\$1").
+		WithArgs("hash").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "project_id", "allowed_domains", "range_days", "created_at"}).
+			AddRow(id, uuid.New(), "Team calendar", nil, []string{"example.com"}, 14, time.Now()))
+
+	embed, err := repo.GetEmbedByTokenHash(context.Background(), "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, embed.ID)
+	assert.Equal(t, []string{"example.com"}, embed.AllowedDomains)
+	assert.Equal(t, 14, embed.RangeDays)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEmbedByTokenHash_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("FROM embeds WHERE token_hash").
+		WithArgs("unknown").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetEmbedByTokenHash(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrEmbedNotFound)
+}
+
+func TestRepository_DeleteEmbed_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM embeds").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteEmbed(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrEmbedNotFound)
+}
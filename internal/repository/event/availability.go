@@ -0,0 +1,69 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// attendeeCalendarInRange matches the events in the calendar of attendee a, their own and those they are invited to
+// and have not declined, that take place in the range [$2, $3) or recur, like recurringOrInRange for a listing user.
+var attendeeCalendarInRange = fmt.Sprintf("(e.user_id = a.user_id OR e.id IN ("+
+	"SELECT event_id FROM event_attendees WHERE user_id = a.user_id AND status <> 'declined')) AND e.event_date < $3 AND ("+
+	"e.event_date >= $2 OR "+
+	"e.end_date > $2 AND e.event_date > $2::date - %d OR "+
+	"e.recurrence_rule <> '')", int(model.MaxEventDuration.Hours()/24))
+
+// GetAttendeeEvents retrieves the times of the other events in the calendars of the attendees of an event who have
+// not declined it, for their free/busy status. Only the times and recurrence of the events are read; the UserID of
+// every event is the attendee whose calendar holds it, so an event in the calendars of several attendees is
+// returned once per attendee. Recurring events are returned whole, with their overrides, to be expanded.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event; it is left out of the calendars.
+//   - from: The start of the range.
+//   - to: The end of the range, exclusive.
+//
+// Returns:
+//   - A slice of events; empty if the attendees have no events in the range.
+//   - An error if the query fails.
+func (r *Repository) GetAttendeeEvents(ctx context.Context, eventID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	query := `
+		SELECT a.user_id, e.id, e.event_date, e.end_date, e.recurrence_rule, e.recurrence_exceptions
+		FROM event_attendees a
+		JOIN events e ON ` + attendeeCalendarInRange + `
+		WHERE a.event_id = $1 AND a.status <> 'declined' AND e.id <> $1 AND e.deleted_at IS NULL
+		ORDER BY e.event_date;
+	`
+
+	// Availability tolerates replication lag like calendar listings, so it may be served by a regional replica.
+	rows, err := r.db.Query(tenancy.ReadOnly(ctx), query, eventID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendee events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(&e.UserID, &e.ID, &e.EventDate, &e.EndDate, &e.RecurrenceRule, &e.RecurrenceExceptions); err != nil {
+			return nil, fmt.Errorf("failed to scan attendee event: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get attendee events: %w", err)
+	}
+
+	if err := r.attachOverrides(ctx, events); err != nil {
+		return nil, fmt.Errorf("failed to get attendee events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,164 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// CreateLink links an event to a related event it depends on.
+// Both events must belong to the given user. An existing link between the same events
+// is replaced, so the link type can be changed by linking again.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - link: The link to create.
+//   - userID: The UUID of the user who owns both events.
+//
+// Returns:
+//   - An error if the insertion fails or if either event is not found.
+func (r *Repository) CreateLink(ctx context.Context, link model.EventLink, userID uuid.UUID) error {
+	query := `
+		INSERT INTO event_links (event_id, related_event_id, type)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $4 AND deleted_at IS NULL)
+		  AND EXISTS (SELECT 1 FROM events WHERE id = $2 AND user_id = $4 AND deleted_at IS NULL)
+		ON CONFLICT (event_id, related_event_id) DO UPDATE SET type = EXCLUDED.type;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, link.EventID, link.RelatedEventID, link.Type, userID)
+	if err != nil {
+		return fmt.Errorf("failed to create event link: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrEventNotFound
+	}
+
+	return nil
+}
+
+// DeleteLink removes the link between an event and a related event.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the dependent event.
+//   - relatedEventID: The UUID of the event it depends on.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - An error if the deletion fails or if the link is not found.
+func (r *Repository) DeleteLink(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error {
+	query := `
+		DELETE FROM event_links l
+		USING events e
+		WHERE l.event_id = $1 AND l.related_event_id = $2
+		  AND e.id = l.event_id AND e.user_id = $3;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, eventID, relatedEventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete event link: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrLinkNotFound
+	}
+
+	return nil
+}
+
+// GetRelatedEvents retrieves the events linked to the given event in either direction.
+// Links pointing to the event are reported with their inverse relation (followed_by, blocks).
+// Trashed events are left out; their links come back when they are restored.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - A slice of related events, ordered by their event_date.
+//   - An error if the query fails.
+func (r *Repository) GetRelatedEvents(ctx context.Context, eventID uuid.UUID) ([]model.RelatedEvent, error) {
+	query := `
+		SELECT e.id, e.title, e.event_date, l.type, false AS inverse
+		FROM event_links l
+		JOIN events e ON e.id = l.related_event_id AND e.deleted_at IS NULL
+		WHERE l.event_id = $1
+		UNION ALL
+		SELECT e.id, e.title, e.event_date, l.type, true AS inverse
+		FROM event_links l
+		JOIN events e ON e.id = l.event_id AND e.deleted_at IS NULL
+		WHERE l.related_event_id = $1
+		ORDER BY event_date;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query related events: %w", err)
+	}
+	defer rows.Close()
+
+	var related []model.RelatedEvent
+	for rows.Next() {
+		var (
+			e       model.RelatedEvent
+			inverse bool
+		)
+		if err := rows.Scan(&e.ID, &e.Title, &e.EventDate, &e.Relation, &inverse); err != nil {
+			return nil, fmt.Errorf("failed to scan related event: %w", err)
+		}
+
+		if inverse {
+			e.Relation = inverseRelation(e.Relation)
+		}
+		related = append(related, e)
+	}
+
+	return related, rows.Err()
+}
+
+// CountLinkOrderViolations counts the links that would be violated if the event took place at the given date,
+// i.e. events it depends on that are later, and dependent events that are earlier. Links to trashed events are ignored.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event being rescheduled.
+//   - date: The new date and time of the event.
+//
+// Returns:
+//   - The number of violated links.
+//   - An error if the query fails.
+func (r *Repository) CountLinkOrderViolations(ctx context.Context, eventID uuid.UUID, date time.Time) (int, error) {
+	query := `
+		SELECT count(*)
+		FROM event_links l
+		JOIN events related ON related.id = l.related_event_id AND related.deleted_at IS NULL
+		JOIN events dependent ON dependent.id = l.event_id AND dependent.deleted_at IS NULL
+		WHERE (l.event_id = $1 AND related.event_date > $2)
+		   OR (l.related_event_id = $1 AND dependent.event_date < $2);
+	`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, eventID, date).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to check event link order: %w", err)
+	}
+
+	return count, nil
+}
+
+// inverseRelation returns the relation of a link type as seen from the related event.
+func inverseRelation(linkType string) string {
+	switch linkType {
+	case model.LinkFollowUpOf:
+		return model.RelationFollowedBy
+	case model.LinkBlockedBy:
+		return model.RelationBlocks
+	default:
+		return linkType
+	}
+}
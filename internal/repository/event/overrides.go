@@ -0,0 +1,234 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/schedule"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// SetOverride changes the time or title of a single occurrence of a recurring event, replacing an earlier override
+// of the same occurrence. Reminders are only sent for the first occurrence, so overriding it moves the pending
+// reminders of the event along with its date in the same transaction.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - override: The override; its title must already be encrypted for storage.
+//
+// Returns:
+//   - ErrNotRecurring if the user has no recurring event with this ID, or another error if the update fails.
+func (r *Repository) SetOverride(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	series, err := lockSeries(ctx, tx, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set override: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO event_overrides (event_id, occurrence, event_date, end_date, title)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (event_id, occurrence) DO UPDATE
+		SET event_date = EXCLUDED.event_date,
+		    end_date = EXCLUDED.end_date,
+		    title = EXCLUDED.title,
+		    updated_at = now();
+	`, eventID, override.Occurrence, override.EventDate, override.EndDate, override.Title)
+	if err != nil {
+		return fmt.Errorf("failed to set override: %w", err)
+	}
+
+	if override.Occurrence.Equal(series.EventDate) {
+		if err := schedule.Reminders(ctx, tx, series.Override(override), r.clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteOverride reverts a single occurrence of a recurring event to the time and title of the series.
+// Reverting the first occurrence moves the pending reminders of the event back in the same transaction.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - occurrence: The start of the occurrence as generated by the rule.
+//
+// Returns:
+//   - ErrNotRecurring if the user has no recurring event with this ID, ErrOverrideNotFound if the occurrence
+//     is not overridden, or another error if the deletion fails.
+func (r *Repository) DeleteOverride(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	series, err := lockSeries(ctx, tx, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete override: %w", err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		DELETE FROM event_overrides
+		WHERE event_id = $1 AND occurrence = $2;
+	`, eventID, occurrence)
+	if err != nil {
+		return fmt.Errorf("failed to delete override: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrOverrideNotFound
+	}
+
+	if occurrence.Equal(series.EventDate) {
+		if err := schedule.Reminders(ctx, tx, series, r.clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListOverrides retrieves the overridden occurrences of a recurring event, ordered by occurrence.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - A slice of overrides, empty if there are none; titles are returned as stored.
+//   - ErrNotRecurring if the user has no recurring event with this ID, or another error if the query fails.
+func (r *Repository) ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $2 AND recurrence_rule <> '' AND deleted_at IS NULL);
+	`, eventID, userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotRecurring
+	}
+
+	overrides, err := r.getOverrides(ctx, []uuid.UUID{eventID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides: %w", err)
+	}
+
+	list := overrides[eventID]
+	if list == nil {
+		list = []model.OccurrenceOverride{}
+	}
+
+	return list, nil
+}
+
+// attachOverrides sets the overridden occurrences of the recurring events of a listing, in one query.
+// Events without a rule, including events listed without the recurrence_rule column, are left alone.
+func (r *Repository) attachOverrides(ctx context.Context, events []model.Event) error {
+	var ids []uuid.UUID
+	for _, e := range events {
+		if e.RecurrenceRule != "" {
+			ids = append(ids, e.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Overrides are read like the listing, so they may be served by a regional replica.
+	overrides, err := r.getOverrides(tenancy.ReadOnly(ctx), ids)
+	if err != nil {
+		return err
+	}
+	for i := range events {
+		if events[i].RecurrenceRule != "" {
+			events[i].Overrides = overrides[events[i].ID]
+		}
+	}
+
+	return nil
+}
+
+// getOverrides retrieves the overrides of the given events, grouped by event and ordered by occurrence.
+func (r *Repository) getOverrides(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID][]model.OccurrenceOverride, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT event_id, occurrence, event_date, end_date, title
+		FROM event_overrides
+		WHERE event_id = ANY($1)
+		ORDER BY event_id, occurrence;
+	`, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := make(map[uuid.UUID][]model.OccurrenceOverride)
+	for rows.Next() {
+		var (
+			eventID uuid.UUID
+			o       model.OccurrenceOverride
+		)
+		if err := rows.Scan(&eventID, &o.Occurrence, &o.EventDate, &o.EndDate, &o.Title); err != nil {
+			return nil, err
+		}
+		overrides[eventID] = append(overrides[eventID], o)
+	}
+
+	return overrides, rows.Err()
+}
+
+// lockSeries reads the date, reminder and stored title of a recurring event of the user and locks it,
+// so its reminders are not rescheduled concurrently.
+func lockSeries(ctx context.Context, tx pgx.Tx, eventID, userID uuid.UUID) (model.Event, error) {
+	series := model.Event{ID: eventID, UserID: userID}
+	err := tx.QueryRow(ctx, `
+		SELECT event_date, end_date, title, reminder_at, reminder_timezone
+		FROM events
+		WHERE id = $1 AND user_id = $2 AND recurrence_rule <> '' AND deleted_at IS NULL
+		FOR UPDATE;
+	`, eventID, userID).Scan(&series.EventDate, &series.EndDate, &series.Title, &series.ReminderAt, &series.ReminderTimezone)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Event{}, ErrNotRecurring
+	}
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	// The wall-clock time of a zoned reminder is stored in its time zone.
+	if series.ReminderAt != nil && series.ReminderTimezone != "" {
+		loc, err := time.LoadLocation(series.ReminderTimezone)
+		if err != nil {
+			return model.Event{}, fmt.Errorf("failed to load reminder time zone: %w", err)
+		}
+		at := series.ReminderAt.In(loc)
+		series.ReminderAt = &at
+	}
+
+	return series, nil
+}
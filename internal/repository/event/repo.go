@@ -4,41 +4,104 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/google/uuid"
 
+	"github.com/aliskhannn/calendar-service/internal/clock"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/schedule"
+	"github.com/aliskhannn/calendar-service/internal/repository/total"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
 )
 
 var (
 	ErrEventNotFound = errors.New("event not found")
+	ErrInvalidField  = errors.New("invalid field")
+	ErrLinkNotFound  = errors.New("event link not found")
+	ErrNotRecurring  = errors.New("event is not recurring")
+
+	ErrOverrideNotFound = errors.New("occurrence is not overridden")
+
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrCalendarNotFound = errors.New("calendar not found")
 )
 
+// eventColumns lists the selectable columns of the events table in their canonical order.
+var eventColumns = []string{"id", "user_id", "event_date", "end_date", "title", "description", "location", "latitude", "longitude", "priority", "project_id", "calendar_id", "color", "tags", "reminder_at", "reminder_timezone", "notifications", "recurrence_rule", "recurrence_exceptions", "created_at", "updated_at"}
+
+// recurringOrInRange matches the events in the calendar of user $1 that take place from $2 up to $3, including events
+// that started before $2 and end after it, and the recurring events starting before $3, whose occurrences in the range
+// are expanded by the service. Events end at most model.MaxEventDuration after their date, which bounds the index scan.
+// The calendar holds the user's own events and the events they are invited to and have not declined.
+var recurringOrInRange = fmt.Sprintf("(user_id = $1 OR id IN ("+
+	"SELECT event_id FROM event_attendees WHERE user_id = $1 AND status <> 'declined')) AND event_date < $3 AND ("+
+	"event_date >= $2 OR "+
+	"end_date > $2 AND event_date > $2::date - %d OR "+
+	"recurrence_rule <> '')", int(model.MaxEventDuration.Hours()/24))
+
+// notTrashed excludes the events in the trash, which are only listed by ListTrash and restored by RestoreEvent.
+const notTrashed = "deleted_at IS NULL"
+
+// inProject restricts an event listing to the events of project $4, or to all projects when $4 is null.
+const inProject = "($4::uuid IS NULL OR project_id = $4)"
+
+// inCalendar restricts an event listing to the events of calendar $4, or to all calendars when $4 is null.
+// Events the user is invited to belong to calendars of their owners, so they are not listed with a calendar.
+const inCalendar = "($4::uuid IS NULL OR calendar_id = $4)"
+
+// attendeeStatus selects the invitation status of user $1 for the event of the current row; empty for their own events.
+const attendeeStatus = "COALESCE((SELECT a.status FROM event_attendees a WHERE a.event_id = events.id AND a.user_id = $1), '') AS attendee_status"
+
+// followerCount selects the number of users following the event of the current row.
+const followerCount = "(SELECT count(*) FROM event_followers f WHERE f.event_id = events.id) AS follower_count"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// execer is the subset of pgxPool and pgx.Tx used by statements that run both inside and outside a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+}
+
 // Repository manages interactions with the events table in the PostgreSQL database.
 // It provides methods for creating, updating, deleting, archiving, and retrieving events.
 type Repository struct {
-	db *pgxpool.Pool // Database connection pool
+	db    pgxPool     // Database connection pool
+	clock clock.Clock // Source of the current time for scheduling reminders and archiving
 }
 
-// New creates a new Repository instance with the provided database connection pool.
+// New creates a new Repository instance with the provided database connection pool and clock.
 //
 // Parameters:
 //   - db: The PostgreSQL connection pool for database operations.
+//   - clk: The clock deciding which reminders are in the future and which events are old.
 //
 // Returns:
 //   - A pointer to the initialized Repository.
-func New(db *pgxpool.Pool) *Repository {
+func New(db pgxPool, clk clock.Clock) *Repository {
 	return &Repository{
-		db: db,
+		db:    db,
+		clock: clk,
 	}
 }
 
 // CreateEvent inserts a new event into the events table and returns its ID.
-// It stores the user ID, event date, title, description, and optional reminder time.
+// It stores the user ID, event date, optional end, title, description, location and coordinates, priority,
+// optional project, color and tags, optional reminder time and notification overrides, and optional recurrence rule.
+// If the reminder time is in the future, a pending reminder is scheduled in the same transaction.
+// With a reminder time zone, ReminderAt must be in that zone; its wall-clock time is stored with the reminder.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -48,26 +111,73 @@ func New(db *pgxpool.Pool) *Repository {
 //   - The UUID of the created event.
 //   - An error if the insertion fails.
 func (r *Repository) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if event.ID, err = r.insertEvent(ctx, tx, event); err != nil {
+		return uuid.Nil, err
+	}
+
+	// Commit the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return event.ID, nil
+}
+
+// insertEvent inserts an event and schedules its reminder within the given transaction.
+func (r *Repository) insertEvent(ctx context.Context, tx pgx.Tx, event model.Event) (uuid.UUID, error) {
 	query := `
 		INSERT INTO events (
-		    user_id, event_date, title, description, reminder_at
-		) VALUES ($1, $2, $3, $4, $5)
+		    user_id, event_date, end_date, title, description, location, latitude, longitude, priority, project_id, calendar_id, color,
+		    tags, reminder_at, reminder_timezone, notifications, recurrence_rule
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		RETURNING id;
     `
 
-	err := r.db.QueryRow(
-		ctx, query, event.UserID, event.EventDate, event.Title, event.Description, event.ReminderAt,
+	err := tx.QueryRow(
+		ctx, query, event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude,
+		event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, tagsOf(event), event.ReminderAt,
+		event.ReminderTimezone, event.Notifications, event.RecurrenceRule,
 	).Scan(&event.ID)
 	if err != nil {
+		if isProjectViolation(err) {
+			return uuid.Nil, ErrProjectNotFound
+		}
+		if isCalendarViolation(err) {
+			return uuid.Nil, ErrCalendarNotFound
+		}
 		return uuid.Nil, fmt.Errorf("failed to create event: %w", err)
 	}
 
+	// Schedule the reminder for dispatch by the reminder workers.
+	if event.ReminderAt != nil && event.ReminderAt.After(r.clock.Now()) {
+		timezone, localTime := schedule.Zone(event)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO reminders (event_id, user_id, message, remind_at, timezone, local_time)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, event.ID, event.UserID, event.Title, *event.ReminderAt, timezone, localTime)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to schedule reminder: %w", err)
+		}
+	}
+
 	return event.ID, nil
 }
 
 // UpdateEvent updates an existing event in the events table.
-// It updates the event date, title, description, reminder time, and updated_at timestamp
-// for the specified event ID and user ID.
+// It updates the event date and end, title, description, location and coordinates, priority, project, calendar, color, tags,
+// reminder time and time zone, notification overrides,
+// recurrence rule, and updated_at timestamp for the specified event ID and user ID. The calendar is kept if the event has none set,
+// and so are the exceptions of a recurring event.
+// The pending reminders of the event, including the copies of its followers, are rescheduled in the same transaction:
+// they move to the new reminder time, or are cancelled if the reminder was removed or is no longer in the future.
+// A new pending reminder is scheduled for the owner if they had none, e.g. because the previous one was already sent.
+// The reminder of a series whose first occurrence is overridden follows the override.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -76,19 +186,45 @@ func (r *Repository) CreateEvent(ctx context.Context, event model.Event) (uuid.U
 // Returns:
 //   - An error if the update fails or if the event is not found.
 func (r *Repository) UpdateEvent(ctx context.Context, event model.Event) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE events
 		SET
 		    event_date = $1,
-			title = $2,
-			description = $3,
-			reminder_at = $4,
+			end_date = $2,
+			title = $3,
+			description = $4,
+			location = $5,
+			latitude = $6,
+			longitude = $7,
+			priority = $8,
+			project_id = $9,
+			calendar_id = COALESCE($10, calendar_id),
+			color = $11,
+			tags = $12,
+			reminder_at = $13,
+			reminder_timezone = $14,
+			notifications = $15,
+			recurrence_rule = $16,
 			updated_at = now()
-		WHERE id = $5 AND user_id = $6;
+		WHERE id = $17 AND user_id = $18 AND deleted_at IS NULL;
 	`
 
-	cmdTag, err := r.db.Exec(ctx, query, event.EventDate, event.Title, event.Description, event.ReminderAt, event.ID, event.UserID)
+	cmdTag, err := tx.Exec(ctx, query, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude,
+		event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, tagsOf(event), event.ReminderAt,
+		event.ReminderTimezone, event.Notifications, event.RecurrenceRule, event.ID, event.UserID)
 	if err != nil {
+		if isProjectViolation(err) {
+			return ErrProjectNotFound
+		}
+		if isCalendarViolation(err) {
+			return ErrCalendarNotFound
+		}
 		return fmt.Errorf("failed to update event: %w", err)
 	}
 
@@ -96,11 +232,35 @@ func (r *Repository) UpdateEvent(ctx context.Context, event model.Event) error {
 		return ErrEventNotFound
 	}
 
+	// The reminder of a series is sent for its first occurrence, which may be overridden.
+	reminded := event
+	if event.RecurrenceRule != "" {
+		o := model.OccurrenceOverride{Occurrence: event.EventDate}
+		err := tx.QueryRow(ctx, `
+			SELECT event_date, end_date, title FROM event_overrides WHERE event_id = $1 AND occurrence = $2;
+		`, event.ID, event.EventDate).Scan(&o.EventDate, &o.EndDate, &o.Title)
+		switch {
+		case err == nil:
+			reminded = event.Override(o)
+		case !errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("failed to get override: %w", err)
+		}
+	}
+
+	if err := schedule.Reminders(ctx, tx, reminded, r.clock.Now()); err != nil {
+		return err
+	}
+
+	// Commit the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
-// DeleteEvent deletes an event from the events table.
-// It removes the event with the specified ID and user ID.
+// DeleteEvent moves an event to the trash by setting its deleted_at. Trashed events are left out of all queries
+// but ListTrash, and their pending reminders are not sent; the archiver purges them after the trash retention.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -108,12 +268,13 @@ func (r *Repository) UpdateEvent(ctx context.Context, event model.Event) error {
 //   - userID: The UUID of the user who owns the event.
 //
 // Returns:
-//   - An error if the deletion fails or if the event is not found.
+//   - An error if the deletion fails or if the event is not found or already in the trash.
 func (r *Repository) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
 	query := `
-   		DELETE FROM events
-   		WHERE id = $1 AND user_id = $2;
-    `
+		UPDATE events
+		SET deleted_at = now()
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+	`
 
 	cmdTag, err := r.db.Exec(ctx, query, eventID, userID)
 	if err != nil {
@@ -127,171 +288,1258 @@ func (r *Repository) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID)
 	return nil
 }
 
-// ArchiveOldEvents moves events older than the current date to the archived_events table
-// and deletes them from the events table. It uses a transaction to ensure atomicity.
+// DeleteEvents moves the events of a user with the given IDs to the trash in a single statement.
+// IDs of events that do not exist, belong to another user or are already in the trash are skipped.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the events.
+//   - eventIDs: The UUIDs of the events to delete.
 //
 // Returns:
-//   - An error if the archiving or deletion fails, or if the transaction cannot be committed.
-func (r *Repository) ArchiveOldEvents(ctx context.Context) error {
+//   - The deleted events with their ID, owner, stored title and date, so their attendees can be notified.
+//   - An error if the deletion fails.
+func (r *Repository) DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]model.Event, error) {
+	query := `
+		UPDATE events
+		SET deleted_at = now()
+		WHERE user_id = $1 AND id = ANY($2) AND deleted_at IS NULL
+		RETURNING id, user_id, title, event_date;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete events: %w", err)
+	}
+
+	return collectDeleted(rows)
+}
+
+// DeleteEventsInRange moves the events of a user starting within a time range to the trash in a single statement.
+// A recurring event is deleted with all its occurrences if its first occurrence starts within the range.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the events.
+//   - from: The start of the range, inclusive.
+//   - to: The end of the range, exclusive.
+//   - calendarID: The calendar the deletion is limited to; nil for all calendars of the user.
+//
+// Returns:
+//   - The deleted events with their ID, owner, stored title and date, so their attendees can be notified.
+//   - An error if the deletion fails.
+func (r *Repository) DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) ([]model.Event, error) {
+	query := `
+		UPDATE events
+		SET deleted_at = now()
+		WHERE user_id = $1 AND event_date >= $2 AND event_date < $3
+		  AND ($4::uuid IS NULL OR calendar_id = $4) AND deleted_at IS NULL
+		RETURNING id, user_id, title, event_date;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, from, to, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete events in range: %w", err)
+	}
+
+	return collectDeleted(rows)
+}
+
+// collectDeleted scans the events returned by a bulk deletion.
+func collectDeleted(rows pgx.Rows) ([]model.Event, error) {
+	defer rows.Close()
+
+	events := []model.Event{}
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Title, &e.EventDate); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// ExcludeOccurrence removes a single occurrence from a recurring event by recording it as an exception.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - occurrence: The start of the occurrence to remove.
+//
+// Returns:
+//   - ErrNotRecurring if the user has no recurring event with this ID, or another error if the update fails.
+func (r *Repository) ExcludeOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	if err := excludeOccurrence(ctx, r.db, eventID, userID, occurrence); err != nil {
+		return fmt.Errorf("failed to exclude occurrence: %w", err)
+	}
+
+	return nil
+}
+
+// DetachOccurrence replaces a single occurrence of a recurring event with a standalone event.
+// The occurrence is recorded as an exception of the series and the event is created in the same transaction.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - seriesID: The UUID of the recurring event.
+//   - occurrence: The start of the replaced occurrence.
+//   - event: The standalone event; UserID must be the owner of the series.
+//
+// Returns:
+//   - The UUID of the created event.
+//   - ErrNotRecurring if the user has no recurring event with this ID, or another error if the replacement fails.
+func (r *Repository) DetachOccurrence(ctx context.Context, seriesID uuid.UUID, occurrence time.Time, event model.Event) (uuid.UUID, error) {
 	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	// Insert old events into archived_events table.
-	_, err = tx.Exec(ctx, `
-        INSERT INTO archived_events (id, user_id, event_date, title, description, created_at, updated_at)
-        SELECT id, user_id, event_date, title, description, created_at, updated_at
-        FROM events
-        WHERE event_date < CURRENT_DATE
-    `)
-	if err != nil {
-		return fmt.Errorf("failed to insert old events: %w", err)
+	if err := excludeOccurrence(ctx, tx, seriesID, event.UserID, occurrence); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to detach occurrence: %w", err)
 	}
 
-	// Delete old events from events table.
-	_, err = tx.Exec(ctx, `DELETE FROM events WHERE event_date < CURRENT_DATE`)
+	event.RecurrenceRule = ""
+	id, err := r.insertEvent(ctx, tx, event)
 	if err != nil {
-		return fmt.Errorf("failed to delete old events: %w", err)
+		return uuid.Nil, err
 	}
 
-	// Commit the transaction.
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+// excludeOccurrence appends an occurrence to the exceptions of a recurring event, once.
+func excludeOccurrence(ctx context.Context, db execer, eventID, userID uuid.UUID, occurrence time.Time) error {
+	query := `
+		UPDATE events
+		SET
+		    recurrence_exceptions = CASE
+		        WHEN $3 = ANY(recurrence_exceptions) THEN recurrence_exceptions
+		        ELSE array_append(recurrence_exceptions, $3)
+		    END,
+		    updated_at = now()
+		WHERE id = $1 AND user_id = $2 AND recurrence_rule <> '' AND deleted_at IS NULL;
+	`
+
+	cmdTag, err := db.Exec(ctx, query, eventID, userID, occurrence)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotRecurring
 	}
 
 	return nil
 }
 
-// GetEventsForDay retrieves all events for a specific user on a given day.
-// Events are ordered by their event_date.
+// GetEvent retrieves a single event by its ID for the specified user.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The date for which to retrieve events.
+//   - eventID: The UUID of the event to retrieve.
+//   - userID: The UUID of the user who owns the event.
 //
 // Returns:
-//   - A slice of events for the specified day.
-//   - An error if the query fails or if no events are found.
-func (r *Repository) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
+//   - The event.
+//   - An error if the query fails or if the event is not found.
+func (r *Repository) GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
 	query := `
-		SELECT id, user_id, event_date, title, description, reminder_at, created_at, updated_at
+		SELECT ` + strings.Join(eventColumns, ", ") + `
 		FROM events
-		WHERE user_id = $1 AND event_date = $2
-		ORDER BY event_date
-    `
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+	`
 
-	rows, err := r.db.Query(ctx, query, userID, date)
+	var e model.Event
+	err := r.db.QueryRow(ctx, query, eventID, userID).Scan(scanTargets(&e, eventColumns)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get events for day: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, ErrEventNotFound
+		}
+		return model.Event{}, fmt.Errorf("failed to get event: %w", err)
 	}
-	defer rows.Close()
 
-	var events []model.Event
-	for rows.Next() {
-		var e model.Event
-		if err := rows.Scan(&e.ID, &e.UserID, &e.EventDate, &e.Title, &e.Description, &e.ReminderAt, &e.CreatedAt, &e.UpdatedAt); err != nil {
-			return nil, err
-		}
-		events = append(events, e)
+	return e, nil
+}
+
+// EventExists reports whether a user has an event with the given ID, without reading the event.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - True if the event exists.
+//   - An error if the query fails.
+func (r *Repository) EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL);`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, eventID, userID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check event: %w", err)
 	}
 
-	if len(events) == 0 {
-		return nil, ErrEventNotFound
+	return exists, nil
+}
+
+// CountEvents counts the events of a user matching the filter, without reading them.
+// The filter applies as in view listings; its Limit is ignored. Archived and trashed events are not counted,
+// and a recurring event counts once however many occurrences it has.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are counted.
+//   - filter: The resolved criteria of the count.
+//
+// Returns:
+//   - The number of matching events.
+//   - An error if the query fails.
+func (r *Repository) CountEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) (int, error) {
+	conditions := []string{"user_id = $1", notTrashed}
+	args := []interface{}{userID}
+
+	// add appends a condition whose placeholder is bound to arg.
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
 	}
 
-	return events, nil
+	if filter.From != nil {
+		add("event_date >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("event_date < $%d", *filter.To)
+	}
+	if len(filter.Priorities) > 0 {
+		add("priority = ANY($%d)", filter.Priorities)
+	}
+	if filter.ProjectID != nil {
+		add("project_id = $%d", *filter.ProjectID)
+	}
+	if filter.WithoutProject {
+		conditions = append(conditions, "project_id IS NULL")
+	}
+
+	query := `SELECT COUNT(*) FROM events WHERE ` + strings.Join(conditions, " AND ")
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	return count, nil
 }
 
-// GetEventsForWeek retrieves all events for a specific user within a week starting from the given date.
-// The week is defined as 7 days before and 1 day after the specified date. Events are ordered by event_date.
+// archivedReminderColumns lists the reminder columns carried into archived_reminders.
+// Delivery locks are transient and not archived.
+var archivedReminderColumns = []string{"id", "event_id", "user_id", "message", "remind_at", "timezone", "local_time", "status", "attempts", "last_error", "sent_at", "created_at", "updated_at"}
+
+// archivedRemindersJSON is the SQL expression of the reminders of the archived event a in the new archive format,
+// archived_events.reminders: a JSON array of their archived_reminders rows ordered by ID, empty if it has none.
+var archivedRemindersJSON = func() string {
+	fields := make([]string, 0, len(archivedReminderColumns))
+	for _, c := range archivedReminderColumns {
+		fields = append(fields, "'"+c+"', r."+c)
+	}
+
+	return `COALESCE((
+		SELECT jsonb_agg(jsonb_build_object(` + strings.Join(fields, ", ") + `) ORDER BY r.id)
+		FROM archived_reminders r
+		WHERE r.event_id = a.id
+	), '[]'::jsonb)`
+}()
+
+// ArchiveOldEvents moves a batch of events that ended before the current UTC date, with all their fields and reminders,
+// to the archived_events and archived_reminders tables and deletes them from the events table.
+// Recurring events are never archived, since their later occurrences may still be ahead, and neither are trashed
+// events, which are purged instead. Each batch runs in its own short transaction; the events of the batch are locked, and events locked
+// by another archiver are skipped, so that large tables are archived without long-held locks.
+// In dual-write mode the reminders are also written in the new archive format, with the archived events.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The reference date for the week.
+//   - limit: The maximum number of events archived in this batch.
+//   - dualWrite: Whether the reminders are written in both archive formats.
 //
 // Returns:
-//   - A slice of events for the specified week.
-//   - An error if the query fails or if no events are found.
-func (r *Repository) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
-	start := date.AddDate(0, 0, -7)
-	end := date.AddDate(0, 0, 1)
+//   - The number of archived events; fewer than limit means no old events are left.
+//   - An error if the archiving or deletion fails, or if the transaction cannot be committed.
+func (r *Repository) ArchiveOldEvents(ctx context.Context, limit int, dualWrite bool) (int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
-	query := `
-		SELECT id, user_id, event_date, title, description, reminder_at, created_at, updated_at
+	now := r.clock.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Lock the next batch of old events.
+	rows, err := tx.Query(ctx, `
+		SELECT id
 		FROM events
-		WHERE user_id = $1 AND event_date >= $2 AND event_date < $3
-		ORDER BY event_date
-    `
+		WHERE event_date < $2 AND (end_date IS NULL OR end_date < $2) AND recurrence_rule = '' AND deleted_at IS NULL
+		ORDER BY event_date, id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit, today)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select old events: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan old events: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
 
-	rows, err := r.db.Query(ctx, query, userID, start, end)
+	// Insert the batch into archived_events table.
+	columns := strings.Join(eventColumns, ", ")
+	_, err = tx.Exec(ctx, `
+		INSERT INTO archived_events (`+columns+`)
+		SELECT `+columns+`
+		FROM events
+		WHERE id = ANY($1)
+	`, ids)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get events for week: %w", err)
+		return 0, fmt.Errorf("failed to insert old events: %w", err)
 	}
-	defer rows.Close()
 
-	var events []model.Event
-	for rows.Next() {
-		var e model.Event
-		if err := rows.Scan(&e.ID, &e.UserID, &e.EventDate, &e.Title, &e.Description, &e.ReminderAt, &e.CreatedAt, &e.UpdatedAt); err != nil {
-			return nil, err
+	// Insert the reminders of the batch into archived_reminders table.
+	columns = strings.Join(archivedReminderColumns, ", ")
+	_, err = tx.Exec(ctx, `
+		INSERT INTO archived_reminders (`+columns+`)
+		SELECT `+columns+`
+		FROM reminders
+		WHERE event_id = ANY($1)
+	`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert reminders of old events: %w", err)
+	}
+
+	// Copy the archived reminders into the new archive format.
+	if dualWrite {
+		_, err = tx.Exec(ctx, `
+			UPDATE archived_events a
+			SET reminders = `+archivedRemindersJSON+`
+			WHERE a.id = ANY($1)
+		`, ids)
+		if err != nil {
+			return 0, fmt.Errorf("failed to write reminders of old events in the new archive format: %w", err)
 		}
-		events = append(events, e)
 	}
 
-	if len(events) == 0 {
-		return nil, ErrEventNotFound
+	// Delete the batch from events table; their reminders are deleted by cascade.
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM events WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old events: %w", err)
 	}
 
-	return events, nil
+	// Commit the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
 }
 
-// GetEventsForMonth retrieves all events for a specific user within a month starting from the first day of the given date's month.
-// The month ends before the first day of the next month. Events are ordered by event_date.
+// BackfillArchivedReminders writes a batch of archived events that are not yet in the new archive format in it,
+// copying their reminders from archived_reminders. Rows locked by another backfill are skipped.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The reference date for the month.
+//   - limit: The maximum number of events written in this batch.
 //
 // Returns:
-//   - A slice of events for the specified month.
-//   - An error if the query fails or if no events are found.
-func (r *Repository) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
-	start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
-	end := date.AddDate(0, 1, 0)
+//   - The number of events written; fewer than limit means none are left.
+//   - An error if the update fails.
+func (r *Repository) BackfillArchivedReminders(ctx context.Context, limit int) (int, error) {
+	query := `
+		UPDATE archived_events a
+		SET reminders = ` + archivedRemindersJSON + `
+		WHERE a.id IN (
+		    SELECT id
+		    FROM archived_events
+		    WHERE reminders IS NULL
+		    ORDER BY id
+		    LIMIT $1
+		    FOR UPDATE SKIP LOCKED
+		)
+	`
 
+	cmdTag, err := r.db.Exec(ctx, query, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to backfill archived reminders: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// CountArchivedEvents counts the archived events of all users.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//
+// Returns:
+//   - The number of archived events.
+//   - An error if the query fails.
+func (r *Repository) CountArchivedEvents(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, `SELECT count(*) FROM archived_events`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count archived events: %w", err)
+	}
+
+	return count, nil
+}
+
+// CompareArchivedReminders compares the reminders of a batch of archived events in archived_reminders with
+// their copy in the new archive format.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - after: The ID after which the batch starts; uuid.Nil for the first batch.
+//   - limit: The maximum number of events compared.
+//
+// Returns:
+//   - The comparisons, ordered by event ID.
+//   - An error if the query fails.
+func (r *Repository) CompareArchivedReminders(ctx context.Context, after uuid.UUID, limit int) ([]model.ArchiveFormatCheck, error) {
 	query := `
-		SELECT id, user_id, event_date, title, description, reminder_at, created_at, updated_at
-		FROM events
-		WHERE user_id = $1 AND event_date >= $2 AND event_date < $3
-		ORDER BY event_date
-    `
+		SELECT a.id, a.reminders IS NOT NULL, a.reminders IS NOT NULL AND a.reminders = ` + archivedRemindersJSON + `
+		FROM archived_events a
+		WHERE a.id > $1
+		ORDER BY a.id
+		LIMIT $2
+	`
 
-	rows, err := r.db.Query(ctx, query, userID, start, end)
+	rows, err := r.db.Query(ctx, query, after, limit)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get events for month: %w", err)
+		return nil, fmt.Errorf("failed to compare archived reminders: %w", err)
 	}
 	defer rows.Close()
 
-	var events []model.Event
+	var checks []model.ArchiveFormatCheck
 	for rows.Next() {
-		var e model.Event
-		if err := rows.Scan(&e.ID, &e.UserID, &e.EventDate, &e.Title, &e.Description, &e.ReminderAt, &e.CreatedAt, &e.UpdatedAt); err != nil {
-			return nil, err
+		var c model.ArchiveFormatCheck
+		if err := rows.Scan(&c.EventID, &c.Written, &c.Matches); err != nil {
+			return nil, fmt.Errorf("failed to scan archive format check: %w", err)
 		}
-		events = append(events, e)
+		checks = append(checks, c)
 	}
 
-	if len(events) == 0 {
-		return nil, ErrEventNotFound
-	}
+	return checks, rows.Err()
+}
+
+// RestoreEvent takes an event of the user out of the trash, or moves an archived event back to the events table
+// together with its reminders. Pending reminders of a trashed event that fell due while it was in the trash are
+// skipped rather than sent late. An archived event keeps its project only if it still exists, and goes to
+// the default calendar if its calendar was deleted; delivery locks of reminders are not restored.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the trashed or archived event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The restored event.
+//   - ErrEventNotFound if the user has no trashed or archived event with this ID, or another error if the restore fails.
+func (r *Repository) RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return model.Event{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Take the event out of the trash if it is there.
+	e, err := restoreTrashed(ctx, tx, eventID, userID)
+	if err == nil {
+		if err := tx.Commit(ctx); err != nil {
+			return model.Event{}, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return e, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.Event{}, err
+	}
+
+	// Move the event back, dropping the project and the calendar if they were deleted in the meantime;
+	// events without a calendar are put in the default calendar on insert.
+	selected := make([]string, len(eventColumns))
+	for i, c := range eventColumns {
+		selected[i] = "a." + c
+		switch c {
+		case "project_id":
+			selected[i] = "(SELECT p.id FROM projects p WHERE p.id = a.project_id AND p.user_id = a.user_id)"
+		case "calendar_id":
+			selected[i] = "(SELECT c.id FROM calendars c WHERE c.id = a.calendar_id AND c.user_id = a.user_id)"
+		}
+	}
+	query := `
+		INSERT INTO events (` + strings.Join(eventColumns, ", ") + `)
+		SELECT ` + strings.Join(selected, ", ") + `
+		FROM archived_events a
+		WHERE a.id = $1 AND a.user_id = $2
+		RETURNING ` + strings.Join(eventColumns, ", ") + `;
+	`
+
+	err = tx.QueryRow(ctx, query, eventID, userID).Scan(scanTargets(&e, eventColumns)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, ErrEventNotFound
+		}
+		return model.Event{}, fmt.Errorf("failed to restore event: %w", err)
+	}
+
+	// Move the reminders back.
+	columns := strings.Join(archivedReminderColumns, ", ")
+	_, err = tx.Exec(ctx, `
+		INSERT INTO reminders (`+columns+`)
+		SELECT `+columns+`
+		FROM archived_reminders
+		WHERE event_id = $1
+	`, eventID)
+	if err != nil {
+		return model.Event{}, fmt.Errorf("failed to restore reminders: %w", err)
+	}
+
+	// Delete the archived event; its archived reminders are deleted by cascade.
+	_, err = tx.Exec(ctx, `DELETE FROM archived_events WHERE id = $1`, eventID)
+	if err != nil {
+		return model.Event{}, fmt.Errorf("failed to delete archived event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.Event{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return e, nil
+}
+
+// restoreTrashed clears the deleted_at of a trashed event of the user and skips its pending reminders that are
+// already due. It returns pgx.ErrNoRows if the user has no such event in the trash.
+func restoreTrashed(ctx context.Context, tx pgx.Tx, eventID, userID uuid.UUID) (model.Event, error) {
+	query := `
+		UPDATE events
+		SET deleted_at = NULL, trash_notified_at = NULL
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+		RETURNING ` + strings.Join(eventColumns, ", ") + `;
+	`
+
+	var e model.Event
+	if err := tx.QueryRow(ctx, query, eventID, userID).Scan(scanTargets(&e, eventColumns)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, err
+		}
+		return model.Event{}, fmt.Errorf("failed to restore trashed event: %w", err)
+	}
+
+	_, err := tx.Exec(ctx, `
+		UPDATE reminders
+		SET status = 'skipped', locked_by = NULL, locked_until = NULL, updated_at = now()
+		WHERE event_id = $1 AND status = 'pending' AND remind_at <= now()
+	`, eventID)
+	if err != nil {
+		return model.Event{}, fmt.Errorf("failed to skip due reminders: %w", err)
+	}
+
+	return e, nil
+}
+
+// ListTrash retrieves a page of the trashed events of a user, the most recently deleted first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the events.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of trashed events with their DeletedAt, empty if the trash is empty.
+//   - An error if the query fails.
+func (r *Repository) ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error) {
+	query := `
+		SELECT ` + strings.Join(eventColumns, ", ") + `, deleted_at
+		FROM events
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id
+		LIMIT $2 OFFSET $3;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+	defer rows.Close()
+
+	events := []model.Event{}
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(append(scanTargets(&e, eventColumns), &e.DeletedAt)...); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// PurgeTrash permanently deletes a batch of the events trashed longer than the retention, of all users.
+// With a notice period, an event is only deleted once its owner was notified at least that long before,
+// so events are never purged earlier than the notice announced. Their reminders are deleted by cascade.
+// Events locked by another purge, a notice, or a restore or emptying of the trash are skipped.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - retention: How long events stay in the trash.
+//   - notice: How long before the purge owners are notified; 0 purges without a notice.
+//   - limit: The maximum number of events deleted in this batch.
+//
+// Returns:
+//   - The number of deleted events; fewer than limit means none are left.
+//   - An error if the deletion fails.
+func (r *Repository) PurgeTrash(ctx context.Context, retention, notice time.Duration, limit int) (int, error) {
+	query := `
+		DELETE FROM events
+		WHERE id IN (
+		    SELECT id
+		    FROM events
+		    WHERE deleted_at < $1 AND ($3::timestamptz IS NULL OR trash_notified_at <= $3)
+		    ORDER BY deleted_at
+		    LIMIT $2
+		    FOR UPDATE SKIP LOCKED
+		);
+	`
+
+	now := r.clock.Now()
+	var notifiedBefore *time.Time
+	if notice > 0 {
+		t := now.Add(-notice)
+		notifiedBefore = &t
+	}
+
+	cmdTag, err := r.db.Exec(ctx, query, now.Add(-retention), limit, notifiedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// ClaimTrashNotices marks a batch of the trashed events of all users that are purged within the notice period
+// as notified, and returns a notice per owner. Events locked by a purge, another notice, or a restore or
+// emptying of the trash are skipped; the marks are committed before the notices are sent, so a notice is sent
+// at most once per trashed event.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - retention: How long events stay in the trash.
+//   - notice: How long before the purge owners are notified.
+//   - limit: The maximum number of events marked in this batch.
+//
+// Returns:
+//   - The notices, one per owner with the number of their marked events.
+//   - The number of marked events; fewer than limit means none are left.
+//   - An error if the query fails.
+func (r *Repository) ClaimTrashNotices(ctx context.Context, retention, notice time.Duration, limit int) ([]model.TrashNotice, int, error) {
+	query := `
+		WITH claimed AS (
+		    UPDATE events
+		    SET trash_notified_at = $3
+		    WHERE id IN (
+		        SELECT id
+		        FROM events
+		        WHERE deleted_at < $1 AND trash_notified_at IS NULL
+		        ORDER BY deleted_at
+		        LIMIT $2
+		        FOR UPDATE SKIP LOCKED
+		    )
+		    RETURNING user_id
+		)
+		SELECT c.user_id, u.email, count(*)
+		FROM claimed c
+		JOIN users u ON u.id = c.user_id
+		GROUP BY c.user_id, u.email
+		ORDER BY c.user_id;
+	`
+
+	now := r.clock.Now()
+	rows, err := r.db.Query(ctx, query, now.Add(notice-retention), limit, now)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to claim trash notices: %w", err)
+	}
+	defer rows.Close()
+
+	notices := []model.TrashNotice{}
+	claimed := 0
+	for rows.Next() {
+		// PurgeTrash waits the notice period after the notice, so the events are purged in exactly that time.
+		n := model.TrashNotice{PurgeIn: notice}
+		if err := rows.Scan(&n.UserID, &n.Email, &n.Events); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan trash notice: %w", err)
+		}
+		notices = append(notices, n)
+		claimed += n.Events
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to claim trash notices: %w", err)
+	}
+
+	return notices, claimed, nil
+}
+
+// RestoreTrash takes all the events of the user out of the trash in one transaction and skips their pending
+// reminders that are already due, like RestoreEvent. Events deleted by a concurrent purge are not restored;
+// a purge skips the events being restored.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the events.
+//
+// Returns:
+//   - The number of restored events; 0 if the trash is empty.
+//   - An error if the restore fails.
+func (r *Repository) RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		UPDATE events
+		SET deleted_at = NULL, trash_notified_at = NULL
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+		RETURNING id;
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore trash: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore trash: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE reminders
+		SET status = 'skipped', locked_by = NULL, locked_until = NULL, updated_at = now()
+		WHERE event_id = ANY($1) AND status = 'pending' AND remind_at <= now()
+	`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to skip due reminders: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+// EmptyTrash permanently deletes all the events of the user in the trash, with their reminders by cascade.
+// Events restored or purged concurrently are not deleted twice: the deletion waits for their lock and
+// skips them once they are out of the trash or gone.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the events.
+//
+// Returns:
+//   - The number of deleted events; 0 if the trash is empty.
+//   - An error if the deletion fails.
+func (r *Repository) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `
+		DELETE FROM events
+		WHERE user_id = $1 AND deleted_at IS NOT NULL;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to empty trash: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// searchQuery parses the web search query $2 with the text search configuration of the search language of user $1,
+// the configuration the user's events are indexed with. Like the index, the query is lowercased and unaccented.
+const searchQuery = `websearch_to_tsquery(text_search_config((SELECT search_language FROM users WHERE id = $1)), normalize_text($2))`
+
+// searchRange matches the events taking place from $3 up to $4 when those are not null.
+const searchRange = `($3::timestamptz IS NULL OR event_date >= $3) AND ($4::timestamptz IS NULL OR event_date < $4)`
+
+// searchCalendar matches the events of calendar $5 when it is not null.
+const searchCalendar = `($5::uuid IS NULL OR calendar_id = $5)`
+
+// searchCondition matches the events of user $1 whose title or description match the web search query $2,
+// in the searchRange and the searchCalendar. It is served by the GIN index on search_vector.
+const searchCondition = `user_id = $1 AND search_vector @@ ` + searchQuery + ` AND ` + searchRange + ` AND ` + searchCalendar +
+	` AND ` + notTrashed
+
+// strictSearchCondition matches the events of user $1 whose title or description contain $2 as it is,
+// with its case and accents, in the searchRange and the searchCalendar. It is served by the user_id index.
+const strictSearchCondition = `user_id = $1 AND (strpos(title, $2) > 0 OR strpos(description, $2) > 0) AND ` + searchRange +
+	` AND ` + searchCalendar + ` AND ` + notTrashed
+
+// searchFilter returns the condition and the order of the events matching a search.
+func searchFilter(search model.EventSearch) (string, string) {
+	if search.Strict {
+		return strictSearchCondition, "event_date DESC, id"
+	}
+	return searchCondition, "ts_rank(search_vector, " + searchQuery + ") DESC, event_date DESC, id"
+}
+
+// SearchEvents retrieves a page of the events of a user whose title or description match a full-text search,
+// the best matches first and, among equal matches, the latest first. Titles weigh more than descriptions,
+// and words are matched by their stem in the user's search language, ignoring case and accents, so in English
+// "dentist" also finds "Dentists" and "cafe" finds "Café"; without a search language, words are matched whole.
+// A strict search instead matches the query as it is, as part of the title or description, the latest first.
+// Recurring events are matched once, at the date of their series. Titles and descriptions encrypted at rest
+// are not searchable.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are searched.
+//   - search: The search query, the optional date range and the optional calendar.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of matching events, empty if there are none.
+//   - An error if the query fails.
+func (r *Repository) SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error) {
+	condition, order := searchFilter(search)
+	query := `
+		SELECT ` + strings.Join(eventColumns, ", ") + `, ` + followerCount + `
+		FROM events
+		WHERE ` + condition + `
+		ORDER BY ` + order + `
+		LIMIT $6 OFFSET $7;
+	`
+
+	// Search results tolerate replication lag, so they may be served by a regional replica.
+	rows, err := r.db.Query(tenancy.ReadOnly(ctx), query, userID, search.Query, search.From, search.To, search.CalendarID,
+		page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []model.Event{}
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(append(scanTargets(&e, eventColumns), &e.FollowerCount)...); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// CountSearchResults counts the events of a user matching a full-text search, as returned by SearchEvents.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are searched.
+//   - search: The search query, the optional date range and the optional calendar.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of matching events.
+//   - An error if the query fails.
+func (r *Repository) CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error) {
+	condition, _ := searchFilter(search)
+	return total.Count(tenancy.ReadOnly(ctx), r.db, mode, `SELECT 1 FROM events WHERE `+condition,
+		userID, search.Query, search.From, search.To, search.CalendarID)
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns, so a prefix is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestTitles retrieves the distinct titles of the user's events starting with a prefix, the most recently
+// scheduled first, to complete the title of a new event. Case and accents are ignored, so "cafe" completes
+// to "Café with Anna", unless strict is set. Titles encrypted at rest are not suggested.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose titles are suggested.
+//   - prefix: The beginning of the title.
+//   - strict: Whether the prefix must match with its case and accents.
+//   - limit: The maximum number of titles.
+//
+// Returns:
+//   - A slice of titles, empty if none matches.
+//   - An error if the query fails.
+func (r *Repository) SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error) {
+	// Both conditions are served by a text_pattern_ops index on the user and the (normalized) title.
+	match := `normalize_text(title) LIKE normalize_text($2) || '%'`
+	if strict {
+		match = `title LIKE $2 || '%'`
+	}
+	query := `
+		SELECT title
+		FROM events
+		WHERE user_id = $1 AND ` + match + ` AND title NOT LIKE 'enc:v1:%' AND deleted_at IS NULL
+		GROUP BY title
+		ORDER BY max(event_date) DESC, title
+		LIMIT $3;
+	`
+
+	rows, err := r.db.Query(tenancy.ReadOnly(ctx), query, userID, likeEscaper.Replace(prefix), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest titles: %w", err)
+	}
+	defer rows.Close()
+
+	titles := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, fmt.Errorf("failed to scan title: %w", err)
+		}
+		titles = append(titles, title)
+	}
+
+	return titles, rows.Err()
+}
+
+// GetEventsForDay retrieves all events for a specific user on a given day.
+// Events are ordered by their event_date. Only the fields requested in opts are selected.
+// Events spanning the day and recurring events starting before the end of the day are included,
+// so that the service can expand their occurrences.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The date for which to retrieve events.
+//   - opts: Optional list parameters such as the fields to select, the time zone of the boundaries and the calendar.
+//
+// Returns:
+//   - A slice of events for the specified day.
+//   - An error if the query fails or if no events are found.
+func (r *Repository) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	start, end := DayRange(date, opts.Location)
+	events, err := r.listEvents(ctx, opts.Fields, recurringOrInRange+" AND "+inCalendar, userID, start, end, opts.CalendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for day: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetEventsForWeek retrieves all events for a specific user within a week starting from the given date.
+// The week is defined as 7 days before and 1 day after the specified date. Events are ordered by event_date.
+// Only the fields requested in opts are selected. Events spanning into the week and recurring events starting
+// before the end of the week are included.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The reference date for the week.
+//   - opts: Optional list parameters such as the fields to select, the time zone of the boundaries and the calendar.
+//
+// Returns:
+//   - A slice of events for the specified week.
+//   - An error if the query fails or if no events are found.
+func (r *Repository) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	start, end := WeekRange(date, opts.Location)
+	events, err := r.listEvents(ctx, opts.Fields, recurringOrInRange+" AND "+inCalendar, userID, start, end, opts.CalendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for week: %w", err)
+	}
 
 	return events, nil
 }
+
+// GetEventsForMonth retrieves all events for a specific user within a month starting from the first day of the given date's month.
+// The month ends before the first day of the next month. Events are ordered by event_date.
+// Only the fields requested in opts are selected. Events spanning into the month and recurring events starting
+// before the end of the month are included.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The reference date for the month.
+//   - opts: Optional list parameters such as the fields to select, the time zone of the boundaries and the calendar.
+//
+// Returns:
+//   - A slice of events for the specified month.
+//   - An error if the query fails or if no events are found.
+func (r *Repository) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	start, end := MonthRange(date, opts.Location)
+	events, err := r.listEvents(ctx, opts.Fields, recurringOrInRange+" AND "+inCalendar, userID, start, end, opts.CalendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for month: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetEventsInRange retrieves all events for a specific user from the start of one day up to,
+// but not including, another. Events are ordered by event_date. Only the fields requested in opts are selected.
+// Events spanning into the range and recurring events starting before the end of the range are included.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - from: The first day of the range.
+//   - to: The day after the range.
+//   - opts: Optional list parameters such as the fields to select and the calendar.
+//
+// Returns:
+//   - A slice of events in the range.
+//   - An error if the query fails or if no events are found.
+func (r *Repository) GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	events, err := r.listEvents(ctx, opts.Fields, recurringOrInRange+" AND "+inCalendar, userID, from, to, opts.CalendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events in range: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetEventsForFeed retrieves the events an ICS feed publishes from the start of one day up to, but not including,
+// another, ordered by event_date. Recurring events starting before the end of the range are included once,
+// as a series, and events spanning into the range are included as well.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - from: The first day of the range.
+//   - to: The day after the range.
+//   - projectID: The project the feed is limited to; nil for all events.
+//
+// Returns:
+//   - A slice of events in the range.
+//   - An error if the query fails or if no events are found.
+func (r *Repository) GetEventsForFeed(ctx context.Context, userID uuid.UUID, from, to time.Time, projectID *uuid.UUID) ([]model.Event, error) {
+	events, err := r.listEvents(ctx, nil, recurringOrInRange+" AND "+inProject, userID, from, to, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for feed: %w", err)
+	}
+
+	return events, nil
+}
+
+// DayRange returns the bounds of the day listed by GetEventsForDay.
+//
+// Parameters:
+//   - date: The day; only its calendar date is used.
+//   - loc: The time zone the day is taken in; UTC when nil.
+//
+// Returns:
+//   - The start of the day and the start of the next day in loc.
+func DayRange(date time.Time, loc *time.Location) (time.Time, time.Time) {
+	start := startOfDay(date, loc)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// WeekRange returns the bounds of the week listed by GetEventsForWeek.
+//
+// Parameters:
+//   - date: The reference date for the week; only its calendar date is used.
+//   - loc: The time zone the days are taken in; UTC when nil.
+//
+// Returns:
+//   - The start of the day 7 days before the date and the start of the day after it in loc.
+func WeekRange(date time.Time, loc *time.Location) (time.Time, time.Time) {
+	day := startOfDay(date, loc)
+	return day.AddDate(0, 0, -7), day.AddDate(0, 0, 1)
+}
+
+// MonthRange returns the bounds of the month listed by GetEventsForMonth.
+//
+// Parameters:
+//   - date: The reference date for the month; only its calendar date is used.
+//   - loc: The time zone the days are taken in; UTC when nil.
+//
+// Returns:
+//   - The start of the first day of the date's month and of the date one month later in loc.
+func MonthRange(date time.Time, loc *time.Location) (time.Time, time.Time) {
+	day := startOfDay(date, loc)
+	return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location()), day.AddDate(0, 1, 0)
+}
+
+// startOfDay returns the midnight in loc of the calendar date of date, read in date's own location.
+// Days are computed with AddDate from there, so days around DST changes last 23 or 25 hours.
+func startOfDay(date time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+// listEvents selects the requested columns of the events matching the given condition, ordered by event_date,
+// along with the invitation status of the user listing them and the overrides of recurring events.
+// Trashed events are left out.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - fields: The event columns to select; all columns are selected when empty.
+//   - where: The SQL condition applied to the events table.
+//   - args: The arguments bound to the condition placeholders; the first one is the UUID of the listing user.
+//
+// Returns:
+//   - A slice of events with only the selected fields populated.
+//   - An error if a field is unknown, the query fails, or no events are found.
+func (r *Repository) listEvents(ctx context.Context, fields []string, where string, args ...interface{}) ([]model.Event, error) {
+	columns, err := selectColumns(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, %s, %s
+		FROM events
+		WHERE %s AND %s
+		ORDER BY event_date
+    `, strings.Join(columns, ", "), attendeeStatus, followerCount, where, notTrashed)
+
+	// Calendar listings tolerate replication lag, so they may be served by a regional replica.
+	rows, err := r.db.Query(tenancy.ReadOnly(ctx), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(append(scanTargets(&e, columns), &e.AttendeeStatus, &e.FollowerCount)...); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if len(events) == 0 {
+		return nil, ErrEventNotFound
+	}
+
+	if err := r.attachOverrides(ctx, events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// selectColumns validates the requested fields against the known event columns.
+// The result keeps the canonical column order and drops duplicates.
+// The attendee_status and follower_count fields are accepted and skipped, since listEvents always selects them.
+//
+// Parameters:
+//   - fields: The requested field names.
+//
+// Returns:
+//   - The columns to select; all event columns when fields is empty.
+//   - ErrInvalidField if a field is not an event column.
+func selectColumns(fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return eventColumns, nil
+	}
+
+	requested := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f == "attendee_status" || f == "follower_count" {
+			continue
+		}
+		if !slices.Contains(eventColumns, f) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidField, f)
+		}
+		requested[f] = true
+	}
+
+	columns := make([]string, 0, len(requested))
+	for _, c := range eventColumns {
+		if requested[c] {
+			columns = append(columns, c)
+		}
+	}
+
+	return columns, nil
+}
+
+// scanTargets returns pointers to the event fields matching the given columns, in the same order.
+func scanTargets(e *model.Event, columns []string) []interface{} {
+	targets := make([]interface{}, 0, len(columns))
+	for _, c := range columns {
+		switch c {
+		case "id":
+			targets = append(targets, &e.ID)
+		case "user_id":
+			targets = append(targets, &e.UserID)
+		case "event_date":
+			targets = append(targets, &e.EventDate)
+		case "end_date":
+			targets = append(targets, &e.EndDate)
+		case "title":
+			targets = append(targets, &e.Title)
+		case "description":
+			targets = append(targets, &e.Description)
+		case "location":
+			targets = append(targets, &e.Location)
+		case "latitude":
+			targets = append(targets, &e.Latitude)
+		case "longitude":
+			targets = append(targets, &e.Longitude)
+		case "priority":
+			targets = append(targets, &e.Priority)
+		case "project_id":
+			targets = append(targets, &e.ProjectID)
+		case "calendar_id":
+			targets = append(targets, &e.CalendarID)
+		case "color":
+			targets = append(targets, &e.Color)
+		case "tags":
+			targets = append(targets, &e.Tags)
+		case "reminder_at":
+			targets = append(targets, &e.ReminderAt)
+		case "reminder_timezone":
+			targets = append(targets, &e.ReminderTimezone)
+		case "notifications":
+			targets = append(targets, &e.Notifications)
+		case "recurrence_rule":
+			targets = append(targets, &e.RecurrenceRule)
+		case "recurrence_exceptions":
+			targets = append(targets, &e.RecurrenceExceptions)
+		case "created_at":
+			targets = append(targets, &e.CreatedAt)
+		case "updated_at":
+			targets = append(targets, &e.UpdatedAt)
+		}
+	}
+
+	return targets
+}
+
+// tagsOf returns the tags of an event, with nil as an empty list since the tags column is not nullable.
+func tagsOf(e model.Event) []string {
+	if e.Tags == nil {
+		return []string{}
+	}
+	return e.Tags
+}
+
+// isProjectViolation reports whether err is caused by assigning an event to a project
+// that does not exist or belongs to another user.
+func isProjectViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == "events_project_fk"
+}
+
+// isCalendarViolation reports whether err is caused by assigning an event to a calendar
+// that does not exist or belongs to another user.
+func isCalendarViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == "events_calendar_fk"
+}
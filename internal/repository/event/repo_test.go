@@ -2,13 +2,18 @@ package event
 
 import (
 	"context"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aliskhannn/calendar-service/internal/clock"
 	"github.com/aliskhannn/calendar-service/internal/model"
 )
 
@@ -17,7 +22,7 @@ func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
 	if err != nil {
 		t.Fatalf("failed to create pgxmock: %v", err)
 	}
-	return New(mock), mock
+	return New(mock, clock.Real()), mock
 }
 
 func TestRepository_CreateEvent(t *testing.T) {
@@ -32,9 +37,11 @@ func TestRepository_CreateEvent(t *testing.T) {
 		EventDate:   time.Now(),
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT INTO events").
-		WithArgs(event.UserID, event.EventDate, event.Title, event.Description).
+		WithArgs(event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule).
 		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+	mock.ExpectCommit()
 
 	gotID, err := repo.CreateEvent(context.Background(), event)
 	assert.NoError(t, err)
@@ -42,6 +49,94 @@ func TestRepository_CreateEvent(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRepository_CreateEvent_WithReminder(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	remindAt := time.Now().Add(time.Hour)
+	event := model.Event{
+		UserID:        uuid.New(),
+		Title:         "Test event",
+		EventDate:     time.Now().Add(2 * time.Hour),
+		ReminderAt:    &remindAt,
+		Notifications: &model.EventNotifications{LeadTime: time.Hour},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO events").
+		WithArgs(event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+	mock.ExpectExec("INSERT INTO reminders").
+		WithArgs(id, event.UserID, event.Title, remindAt, (*string)(nil), (*time.Time)(nil)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	gotID, err := repo.CreateEvent(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Equal(t, id, gotID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateEvent_ZonedReminder(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	id := uuid.New()
+	remindAt := time.Date(2099, 3, 30, 9, 0, 0, 0, berlin)
+	event := model.Event{
+		UserID:           uuid.New(),
+		Title:            "Test event",
+		EventDate:        time.Date(2099, 3, 30, 0, 0, 0, 0, time.UTC),
+		ReminderAt:       &remindAt,
+		ReminderTimezone: "Europe/Berlin",
+	}
+
+	timezone := "Europe/Berlin"
+	localTime := time.Date(2099, 3, 30, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO events").
+		WithArgs(event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+	mock.ExpectExec("INSERT INTO reminders").
+		WithArgs(id, event.UserID, event.Title, remindAt, &timezone, &localTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	_, err = repo.CreateEvent(context.Background(), event)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateEvent_PastReminder(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+
+	// The reminder is due a second before the clock's current time, so it is not scheduled.
+	now := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	repo := New(mock, clock.NewFake(now))
+
+	remindAt := now.Add(-time.Second)
+	event := model.Event{UserID: uuid.New(), Title: "Standup", EventDate: now.Add(time.Hour), ReminderAt: &remindAt}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO events").
+		WithArgs(event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	_, err = repo.CreateEvent(context.Background(), event)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_UpdateEvent(t *testing.T) {
 	repo, mock := newTestRepo(t)
 	defer mock.Close()
@@ -52,17 +147,72 @@ func TestRepository_UpdateEvent(t *testing.T) {
 		Title:       "Updated",
 		Description: "new desc",
 		EventDate:   time.Now(),
+		Color:       "blue",
+		Tags:        []string{"work"},
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectExec("UPDATE events").
-		WithArgs(event.EventDate, event.Title, event.Description, event.ID, event.UserID).
+		WithArgs(event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, event.Tags, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule, event.ID, event.UserID).
 		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	// Without a reminder time, the pending reminders of the event are cancelled.
+	mock.ExpectExec("DELETE FROM reminders\\s+WHERE event_id = \\$1 AND status = 'pending'").
+		WithArgs(event.ID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
 
 	err := repo.UpdateEvent(context.Background(), event)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRepository_UpdateEvent_ReschedulesReminders(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+
+	now := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	repo := New(mock, clock.NewFake(now))
+
+	remindAt := now.Add(2 * time.Hour)
+	event := model.Event{ID: uuid.New(), UserID: uuid.New(), Title: "Standup", EventDate: now.Add(3 * time.Hour), ReminderAt: &remindAt}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE events").
+		WithArgs(event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule, event.ID, event.UserID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("UPDATE reminders\\s+SET locked_by = CASE WHEN remind_at = \\$2 THEN locked_by END(.|\\s)+attempts = CASE WHEN remind_at = \\$2 THEN attempts ELSE 0 END,\\s+last_error = CASE WHEN remind_at = \\$2 THEN last_error END(.|\\s)+message = CASE WHEN user_id = \\$5 THEN \\$6 ELSE message END(.|\\s)+WHERE event_id = \\$1 AND status = 'pending'").
+		WithArgs(event.ID, remindAt, (*string)(nil), (*time.Time)(nil), event.UserID, event.Title).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	mock.ExpectExec("INSERT INTO reminders(.|\\s)+WHERE NOT EXISTS").
+		WithArgs(event.ID, event.UserID, event.Title, remindAt, (*string)(nil), (*time.Time)(nil)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mock.ExpectCommit()
+
+	err = repo.UpdateEvent(context.Background(), event)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateEvent_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	event := model.Event{ID: uuid.New(), UserID: uuid.New(), EventDate: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE events").
+		WithArgs(event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, event.RecurrenceRule, event.ID, event.UserID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectRollback()
+
+	err := repo.UpdateEvent(context.Background(), event)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_DeleteEvent_NotFound(t *testing.T) {
 	repo, mock := newTestRepo(t)
 	defer mock.Close()
@@ -70,33 +220,902 @@ func TestRepository_DeleteEvent_NotFound(t *testing.T) {
 	eventID := uuid.New()
 	userID := uuid.New()
 
-	mock.ExpectExec("DELETE FROM events").
+	mock.ExpectExec("UPDATE events\\s+SET deleted_at = now\\(\\)(.|\\s)+AND deleted_at IS NULL").
 		WithArgs(eventID, userID).
-		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
 
 	err := repo.DeleteEvent(context.Background(), eventID, userID)
 	assert.ErrorIs(t, err, ErrEventNotFound)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestRepository_GetEventsForDay(t *testing.T) {
+func TestRepository_DeleteEvents(t *testing.T) {
 	repo, mock := newTestRepo(t)
 	defer mock.Close()
 
 	userID := uuid.New()
-	date := time.Now()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = now\\(\\)\\s+WHERE user_id = \\$1 AND id = ANY\\(\\$2\\) AND deleted_at IS NULL\\s+RETURNING id, user_id, title, event_date").
+		WithArgs(userID, ids).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "title", "event_date"}).
+			AddRow(ids[0], userID, "Standup", date).
+			AddRow(ids[2], userID, "Review", date))
+
+	deleted, err := repo.DeleteEvents(context.Background(), userID, ids)
+	assert.NoError(t, err)
+	assert.Len(t, deleted, 2)
+	assert.Equal(t, ids[2], deleted[1].ID)
+	assert.Equal(t, "Review", deleted[1].Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteEventsInRange(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, calendarID := uuid.New(), uuid.New()
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = now\\(\\)\\s+WHERE user_id = \\$1 AND event_date >= \\$2 AND event_date < \\$3(.|\\s)+RETURNING id, user_id, title, event_date").
+		WithArgs(userID, from, to, &calendarID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "title", "event_date"}).
+			AddRow(uuid.New(), userID, "Standup", from))
+
+	deleted, err := repo.DeleteEventsInRange(context.Background(), userID, from, to, &calendarID)
+	assert.NoError(t, err)
+	assert.Len(t, deleted, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEvent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID, calendarID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM events\\s+WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs(eventID, userID).
+		WillReturnRows(
+			pgxmock.NewRows(eventColumns).
+				AddRow(eventID, userID, date, (*time.Time)(nil), "Retro", "", "", (*float64)(nil), (*float64)(nil), model.PriorityNormal, (*uuid.UUID)(nil), &calendarID, "", []string{}, (*time.Time)(nil), "", (*model.EventNotifications)(nil), "", []time.Time{}, time.Now(), time.Now()),
+		)
+	// Another user asking for the same event gets no row, since the query is filtered by the owner.
+	otherID := uuid.New()
+	mock.ExpectQuery("FROM events\\s+WHERE id = \\$1 AND user_id = \\$2 AND deleted_at IS NULL").
+		WithArgs(eventID, otherID).
+		WillReturnError(pgx.ErrNoRows)
+
+	e, err := repo.GetEvent(context.Background(), eventID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Retro", e.Title)
+	assert.Equal(t, userID, e.UserID)
+
+	_, err = repo.GetEvent(context.Background(), eventID, otherID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForDay(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, calendarID := uuid.New(), uuid.New()
+	date := time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC)
 	id := uuid.New()
 
-	mock.ExpectQuery("SELECT id, user_id, event_date, title, description, created_at, updated_at FROM events").
-		WithArgs(userID, date).
+	mock.ExpectQuery("SELECT id, user_id, event_date, end_date, title, description, location, latitude, longitude, priority, project_id, calendar_id, color, tags, reminder_at, reminder_timezone, notifications, recurrence_rule, recurrence_exceptions, created_at, updated_at, COALESCE\\(.+\\) AS attendee_status, \\(.+\\) AS follower_count\\s+FROM events").
+		WithArgs(userID, date, date.AddDate(0, 0, 1), &calendarID).
 		WillReturnRows(
-			pgxmock.NewRows([]string{"id", "user_id", "event_date", "title", "description", "created_at", "updated_at"}).
-				AddRow(id, userID, date, "Meeting", "Discuss", time.Now(), time.Now()),
+			pgxmock.NewRows(append(eventColumns, "attendee_status", "follower_count")).
+				AddRow(id, userID, date, (*time.Time)(nil), "Meeting", "Discuss", "", (*float64)(nil), (*float64)(nil), model.PriorityHigh, (*uuid.UUID)(nil), &calendarID, "blue", []string{"work"}, (*time.Time)(nil), "", (*model.EventNotifications)(nil), "", []time.Time{}, time.Now(), time.Now(), "", int64(0)),
 		)
 
-	events, err := repo.GetEventsForDay(context.Background(), userID, date)
+	events, err := repo.GetEventsForDay(context.Background(), userID, date, model.EventListOptions{CalendarID: &calendarID})
 	assert.NoError(t, err)
 	assert.Len(t, events, 1)
 	assert.Equal(t, "Meeting", events[0].Title)
+	assert.Equal(t, &calendarID, events[0].CalendarID)
+	assert.Equal(t, model.PriorityHigh, events[0].Priority)
+	assert.Equal(t, []string{"work"}, events[0].Tags)
+	assert.Empty(t, events[0].AttendeeStatus)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForDay_Invited(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, ownerID := uuid.New(), uuid.New()
+	date := time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("WHERE \\(user_id = \\$1 OR id IN \\(SELECT event_id FROM event_attendees WHERE user_id = \\$1 AND status <> 'declined'\\)\\)").
+		WithArgs(userID, date, date.AddDate(0, 0, 1), (*uuid.UUID)(nil)).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "user_id", "title", "attendee_status", "follower_count"}).
+				AddRow(uuid.New(), ownerID, "Planning", model.AttendeeAccepted, int64(2)),
+		)
+
+	events, err := repo.GetEventsForDay(context.Background(), userID, date, model.EventListOptions{
+		Fields: []string{"id", "user_id", "title"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, ownerID, events[0].UserID)
+	assert.Equal(t, model.AttendeeAccepted, events[0].AttendeeStatus)
+	assert.Equal(t, 2, events[0].FollowerCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForFeed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, projectID := uuid.New(), uuid.New()
+	from, to := time.Date(2030, 3, 3, 0, 0, 0, 0, time.UTC), time.Date(2030, 4, 10, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	occurrence := start.AddDate(0, 0, 63)
+
+	mock.ExpectQuery("event_date < \\$3 AND \\(event_date >= \\$2 OR .+ OR recurrence_rule <> ''\\) AND \\(\\$4::uuid IS NULL OR project_id = \\$4\\) AND deleted_at IS NULL").
+		WithArgs(userID, from, to, &projectID).
+		WillReturnRows(
+			pgxmock.NewRows(append(eventColumns, "attendee_status", "follower_count")).
+				AddRow(seriesID, userID, start, (*time.Time)(nil), "Standup", "", "", (*float64)(nil), (*float64)(nil), model.PriorityNormal, &projectID, (*uuid.UUID)(nil), "", []string{}, (*time.Time)(nil), "", (*model.EventNotifications)(nil), "FREQ=WEEKLY", []time.Time{start.AddDate(0, 0, 56)}, time.Now(), time.Now(), "", int64(0)),
+		)
+
+	// The overrides of the recurring events are attached in one query.
+	mock.ExpectQuery("FROM event_overrides\\s+WHERE event_id = ANY\\(\\$1\\)").
+		WithArgs([]uuid.UUID{seriesID}).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "occurrence", "event_date", "end_date", "title"}).
+			AddRow(seriesID, occurrence, occurrence.Add(time.Hour), (*time.Time)(nil), "Late standup"))
+
+	events, err := repo.GetEventsForFeed(context.Background(), userID, from, to, &projectID)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "FREQ=WEEKLY", events[0].RecurrenceRule)
+	assert.Len(t, events[0].RecurrenceExceptions, 1)
+	assert.Equal(t, []model.OccurrenceOverride{{Occurrence: occurrence, EventDate: occurrence.Add(time.Hour), Title: "Late standup"}}, events[0].Overrides)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetAttendeeEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, attendeeID, seriesID := uuid.New(), uuid.New(), uuid.New()
+	from, to := time.Date(2030, 3, 4, 8, 0, 0, 0, time.UTC), time.Date(2030, 3, 4, 11, 0, 0, 0, time.UTC)
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	end := start.Add(30 * time.Minute)
+
+	mock.ExpectQuery("FROM event_attendees a\\s+JOIN events e ON \\(e.user_id = a.user_id OR .+ OR e.recurrence_rule <> ''\\)\\s+"+
+		"WHERE a.event_id = \\$1 AND a.status <> 'declined' AND e.id <> \\$1 AND e.deleted_at IS NULL").
+		WithArgs(eventID, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"}).
+			AddRow(attendeeID, seriesID, start, &end, "FREQ=WEEKLY", []time.Time(nil)))
+	mock.ExpectQuery("FROM event_overrides\\s+WHERE event_id = ANY\\(\\$1\\)").
+		WithArgs([]uuid.UUID{seriesID}).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "occurrence", "event_date", "end_date", "title"}))
+
+	events, err := repo.GetAttendeeEvents(context.Background(), eventID, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, attendeeID, events[0].UserID)
+	assert.Equal(t, "FREQ=WEEKLY", events[0].RecurrenceRule)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForDay_Location(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	// The clocks go back on 26 October 2025, so the day starts at 22:00 UTC and lasts 25 hours.
+	userID := uuid.New()
+	date := time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)
+	from, to := DayRange(date, berlin)
+	assert.True(t, from.Equal(time.Date(2025, 10, 25, 22, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 25*time.Hour, to.Sub(from))
+
+	mock.ExpectQuery("FROM events").
+		WithArgs(userID, from, to, (*uuid.UUID)(nil)).
+		WillReturnRows(pgxmock.NewRows(eventColumns))
+
+	_, err = repo.GetEventsForDay(context.Background(), userID, date, model.EventListOptions{Location: berlin})
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForDay_Fields(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	date := time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC)
+	id := uuid.New()
+
+	mock.ExpectQuery("SELECT id, event_date, title, COALESCE\\(.+\\) AS attendee_status, \\(.+\\) AS follower_count\\s+FROM events").
+		WithArgs(userID, date, date.AddDate(0, 0, 1), (*uuid.UUID)(nil)).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "event_date", "title", "attendee_status", "follower_count"}).
+				AddRow(id, date, "Meeting", "", int64(0)),
+		)
+
+	events, err := repo.GetEventsForDay(context.Background(), userID, date, model.EventListOptions{
+		Fields: []string{"title", "id", "event_date", "title"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, id, events[0].ID)
+	assert.Empty(t, events[0].Description)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEventsForDay_InvalidField(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	_, err := repo.GetEventsForDay(context.Background(), uuid.New(), time.Now(), model.EventListOptions{
+		Fields: []string{"id", "password_hash"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidField)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EventExists(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM events WHERE id = \\$1 AND user_id = \\$2 AND deleted_at IS NULL\\)").
+		WithArgs(eventID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	exists, err := repo.EventExists(context.Background(), eventID, userID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, projectID := uuid.New(), uuid.New()
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM events WHERE user_id = \\$1 AND deleted_at IS NULL AND event_date >= \\$2 AND project_id = \\$3$").
+		WithArgs(userID, from, projectID).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := repo.CountEvents(context.Background(), userID, model.EventFilter{From: &from, ProjectID: &projectID, Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetEvent_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, event_date, end_date, title, description, location, latitude, longitude, priority, project_id, calendar_id, color, tags, reminder_at, reminder_timezone, notifications, recurrence_rule, recurrence_exceptions, created_at, updated_at\\s+FROM events\\s+WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs(eventID, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetEvent(context.Background(), eventID, userID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateLink_EventNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	link := model.EventLink{EventID: uuid.New(), RelatedEventID: uuid.New(), Type: model.LinkBlockedBy}
+	userID := uuid.New()
+
+	mock.ExpectExec("INSERT INTO event_links").
+		WithArgs(link.EventID, link.RelatedEventID, link.Type, userID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	err := repo.CreateLink(context.Background(), link, userID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetRelatedEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	date := time.Now()
+
+	mock.ExpectQuery("FROM event_links l(.|\\s)+UNION ALL").
+		WithArgs(eventID).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "title", "event_date", "type", "inverse"}).
+				AddRow(uuid.New(), "Kickoff", date, model.LinkFollowUpOf, false).
+				AddRow(uuid.New(), "Launch", date.Add(time.Hour), model.LinkBlockedBy, true),
+		)
+
+	related, err := repo.GetRelatedEvents(context.Background(), eventID)
+	assert.NoError(t, err)
+	assert.Len(t, related, 2)
+	assert.Equal(t, model.LinkFollowUpOf, related[0].Relation)
+	assert.Equal(t, model.RelationBlocks, related[1].Relation)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// insertColumns returns the column list of the INSERT INTO table statement among the recorded queries.
+func insertColumns(t *testing.T, queries []string, table string) []string {
+	t.Helper()
+
+	re := regexp.MustCompile(`INSERT INTO ` + table + ` \(([^)]*)\)`)
+	for _, q := range queries {
+		if m := re.FindStringSubmatch(q); m != nil {
+			return strings.Split(m[1], ", ")
+		}
+	}
+
+	t.Fatalf("no INSERT INTO %s among queries", table)
+	return nil
+}
+
+func TestRepository_ArchiveRestore_RoundTrip(t *testing.T) {
+	// Record every statement to compare what is archived with what is restored.
+	var queries []string
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expected, actual string) error {
+		queries = append(queries, actual)
+		return pgxmock.QueryMatcherRegexp.Match(expected, actual)
+	})))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+	repo := New(mock, clock.NewFake(time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)))
+
+	projectID, calendarID := uuid.New(), uuid.New()
+	reminderAt := time.Now().Add(-time.Hour)
+	latitude, longitude := 52.52, 13.405
+	archived := model.Event{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		EventDate:   time.Now().AddDate(0, 0, -2),
+		Title:       "Dentist",
+		Description: "Checkup",
+		Location:    "Main St 1",
+		Latitude:    &latitude,
+		Longitude:   &longitude,
+		Priority:    model.PriorityHigh,
+		ProjectID:   &projectID,
+		CalendarID:  &calendarID,
+		Color:       "#336699",
+		Tags:        []string{"health"},
+		ReminderAt:  &reminderAt,
+		CreatedAt:   time.Now().AddDate(0, -1, 0),
+		UpdatedAt:   time.Now().AddDate(0, 0, -3),
+	}
+
+	ids := []uuid.UUID{archived.ID}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id\\s+FROM events(.|\\s)+LIMIT \\$1\\s+FOR UPDATE SKIP LOCKED").
+		WithArgs(5000, time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(archived.ID))
+	mock.ExpectExec("INSERT INTO archived_events").WithArgs(ids).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO archived_reminders").WithArgs(ids).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec("DELETE FROM events WHERE id = ANY").WithArgs(ids).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	n, err := repo.ArchiveOldEvents(context.Background(), 5000, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = NULL").WithArgs(archived.ID, archived.UserID).WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("INSERT INTO events(.|\\s)+FROM archived_events a(.|\\s)+RETURNING").
+		WithArgs(archived.ID, archived.UserID).
+		WillReturnRows(pgxmock.NewRows(eventColumns).AddRow(
+			archived.ID, archived.UserID, archived.EventDate, archived.EndDate, archived.Title, archived.Description, archived.Location,
+			archived.Latitude, archived.Longitude, archived.Priority, archived.ProjectID, archived.CalendarID, archived.Color, archived.Tags, archived.ReminderAt, archived.ReminderTimezone, archived.Notifications, archived.RecurrenceRule,
+			archived.RecurrenceExceptions, archived.CreatedAt, archived.UpdatedAt,
+		))
+	mock.ExpectExec("INSERT INTO reminders(.|\\s)+FROM archived_reminders").
+		WithArgs(archived.ID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec("DELETE FROM archived_events WHERE id = \\$1").
+		WithArgs(archived.ID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	restored, err := repo.RestoreEvent(context.Background(), archived.ID, archived.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, archived, restored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Every event field is archived and restored; a new model field needs a column in both directions.
+	// AttendeeStatus and FollowerCount are not stored with the event, they are selected per listing;
+	// DeletedAt is only set in the trash, which is never archived, and Overrides only belong to recurring events,
+	// which are never archived either.
+	assert.Equal(t, reflect.TypeOf(model.Event{}).NumField()-4, len(eventColumns))
+	assert.Equal(t, eventColumns, insertColumns(t, queries, "archived_events"))
+	assert.Equal(t, eventColumns, insertColumns(t, queries, "events"))
+
+	// Reminders are archived and restored with their delivery state.
+	assert.Equal(t, insertColumns(t, queries, "archived_reminders"), insertColumns(t, queries, "reminders"))
+	assert.Contains(t, archivedReminderColumns, "status")
+	assert.Contains(t, archivedReminderColumns, "sent_at")
+}
+
+func TestRepository_ArchiveOldEvents_NothingLeft(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id\\s+FROM events").WithArgs(100, pgxmock.AnyArg()).WillReturnRows(pgxmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	n, err := repo.ArchiveOldEvents(context.Background(), 100, false)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ArchiveOldEvents_DualWrite(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	ids := []uuid.UUID{uuid.New()}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id\\s+FROM events").
+		WithArgs(100, pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(ids[0]))
+	mock.ExpectExec("INSERT INTO archived_events").WithArgs(ids).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO archived_reminders").WithArgs(ids).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE archived_events a\\s+SET reminders = COALESCE\\((.|\\s)+FROM archived_reminders r(.|\\s)+WHERE a.id = ANY\\(\\$1\\)").
+		WithArgs(ids).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("DELETE FROM events WHERE id = ANY").WithArgs(ids).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	n, err := repo.ArchiveOldEvents(context.Background(), 100, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_BackfillArchivedReminders(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("UPDATE archived_events a(.|\\s)+WHERE reminders IS NULL(.|\\s)+FOR UPDATE SKIP LOCKED").
+		WithArgs(500).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 42))
+
+	n, err := repo.BackfillArchivedReminders(context.Background(), 500)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CompareArchivedReminders(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	after, matching, missing := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT a.id, a.reminders IS NOT NULL(.|\\s)+FROM archived_events a\\s+WHERE a.id > \\$1").
+		WithArgs(after, 500).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "written", "matches"}).
+			AddRow(matching, true, true).
+			AddRow(missing, false, false))
+
+	checks, err := repo.CompareArchivedReminders(context.Background(), after, 500)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ArchiveFormatCheck{
+		{EventID: matching, Written: true, Matches: true},
+		{EventID: missing},
+	}, checks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestArchivedRemindersJSON(t *testing.T) {
+	// The new format holds every archived reminder column, so it can replace archived_reminders.
+	for _, c := range archivedReminderColumns {
+		assert.Contains(t, archivedRemindersJSON, "'"+c+"', r."+c)
+	}
+}
+
+func TestRepository_RestoreEvent_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = NULL").WithArgs(eventID, userID).WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("INSERT INTO events").WithArgs(eventID, userID).WillReturnError(pgx.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.RestoreEvent(context.Background(), eventID, userID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreEvent_FromTrash(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	trashed := model.Event{ID: uuid.New(), UserID: uuid.New(), EventDate: time.Now().AddDate(0, 0, 3), Title: "Dentist"}
+	values := []any{
+		trashed.ID, trashed.UserID, trashed.EventDate, trashed.EndDate, trashed.Title, trashed.Description, trashed.Location,
+		trashed.Latitude, trashed.Longitude, trashed.Priority, trashed.ProjectID, trashed.CalendarID, trashed.Color, trashed.Tags,
+		trashed.ReminderAt, trashed.ReminderTimezone, trashed.Notifications, trashed.RecurrenceRule, trashed.RecurrenceExceptions, trashed.CreatedAt, trashed.UpdatedAt,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = NULL, trash_notified_at = NULL\\s+WHERE id = \\$1 AND user_id = \\$2 AND deleted_at IS NOT NULL").
+		WithArgs(trashed.ID, trashed.UserID).
+		WillReturnRows(pgxmock.NewRows(eventColumns).AddRow(values...))
+	mock.ExpectExec("UPDATE reminders\\s+SET status = 'skipped'(.|\\s)+remind_at <= now\\(\\)").
+		WithArgs(trashed.ID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	restored, err := repo.RestoreEvent(context.Background(), trashed.ID, trashed.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, trashed, restored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListTrash(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	deletedAt := time.Date(2025, 10, 18, 9, 0, 0, 0, time.UTC)
+	e := model.Event{ID: uuid.New(), UserID: userID, EventDate: deletedAt.AddDate(0, 0, 1), Title: "Dentist"}
+
+	mock.ExpectQuery("FROM events\\s+WHERE user_id = \\$1 AND deleted_at IS NOT NULL\\s+ORDER BY deleted_at DESC, id").
+		WithArgs(userID, 11, 20).
+		WillReturnRows(pgxmock.NewRows(append(eventColumns, "deleted_at")).AddRow(
+			e.ID, e.UserID, e.EventDate, e.EndDate, e.Title, e.Description, e.Location, e.Latitude, e.Longitude, e.Priority,
+			e.ProjectID, e.CalendarID, e.Color, e.Tags, e.ReminderAt, e.ReminderTimezone, e.Notifications, e.RecurrenceRule, e.RecurrenceExceptions,
+			e.CreatedAt, e.UpdatedAt, &deletedAt,
+		))
+
+	events, err := repo.ListTrash(context.Background(), userID, model.Page{Limit: 10, Offset: 20})
+	assert.NoError(t, err)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, e.ID, events[0].ID)
+		assert.Equal(t, &deletedAt, events[0].DeletedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_PurgeTrash(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := New(mock, clock.NewFake(time.Date(2025, 10, 31, 9, 0, 0, 0, time.UTC)))
+
+	notifiedBefore := time.Date(2025, 10, 28, 9, 0, 0, 0, time.UTC)
+	mock.ExpectExec("DELETE FROM events\\s+WHERE id IN \\((.|\\s)+WHERE deleted_at < \\$1 AND \\(\\$3::timestamptz IS NULL OR trash_notified_at <= \\$3\\)(.|\\s)+FOR UPDATE SKIP LOCKED").
+		WithArgs(time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC), 500, &notifiedBefore).
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+
+	n, err := repo.PurgeTrash(context.Background(), 30*24*time.Hour, 3*24*time.Hour, 500)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Without a notice period, events are purged whether or not their owners were notified.
+	mock.ExpectExec("DELETE FROM events").
+		WithArgs(time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC), 500, (*time.Time)(nil)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	_, err = repo.PurgeTrash(context.Background(), 30*24*time.Hour, 0, 500)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ClaimTrashNotices(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+
+	now := time.Date(2025, 10, 31, 9, 0, 0, 0, time.UTC)
+	repo := New(mock, clock.NewFake(now))
+	userA, userB := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("UPDATE events\\s+SET trash_notified_at = \\$3(.|\\s)+WHERE deleted_at < \\$1 AND trash_notified_at IS NULL(.|\\s)+FOR UPDATE SKIP LOCKED(.|\\s)+JOIN users u").
+		WithArgs(time.Date(2025, 10, 4, 9, 0, 0, 0, time.UTC), 500, now).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "email", "count"}).
+			AddRow(userA, "a@example.com", 2).
+			AddRow(userB, "b@example.com", 1))
+
+	notices, n, err := repo.ClaimTrashNotices(context.Background(), 30*24*time.Hour, 3*24*time.Hour, 500)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []model.TrashNotice{
+		{UserID: userA, Email: "a@example.com", Events: 2, PurgeIn: 3 * 24 * time.Hour},
+		{UserID: userB, Email: "b@example.com", Events: 1, PurgeIn: 3 * 24 * time.Hour},
+	}, notices)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreTrash(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = NULL, trash_notified_at = NULL\\s+WHERE user_id = \\$1 AND deleted_at IS NOT NULL").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(ids[0]).AddRow(ids[1]))
+	mock.ExpectExec("UPDATE reminders\\s+SET status = 'skipped'(.|\\s)+event_id = ANY\\(\\$1\\)(.|\\s)+remind_at <= now\\(\\)").
+		WithArgs(ids).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	n, err := repo.RestoreTrash(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreTrash_Empty(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events\\s+SET deleted_at = NULL").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	n, err := repo.RestoreTrash(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EmptyTrash(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectExec("DELETE FROM events\\s+WHERE user_id = \\$1 AND deleted_at IS NOT NULL").
+		WithArgs(userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 4))
+
+	n, err := repo.EmptyTrash(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SearchEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, id, calendarID := uuid.New(), uuid.New(), uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	date := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+	search := model.EventSearch{Query: "dentist", From: &from}
+
+	mock.ExpectQuery("search_vector @@ websearch_to_tsquery\\(text_search_config\\(\\(SELECT search_language FROM users WHERE id = \\$1\\)\\), normalize_text\\(\\$2\\)\\)(.|\\s)+calendar_id = \\$5\\)(.|\\s)+ORDER BY ts_rank(.|\\s)+LIMIT \\$6 OFFSET \\$7").
+		WithArgs(userID, "dentist", &from, (*time.Time)(nil), (*uuid.UUID)(nil), 21, 20).
+		WillReturnRows(
+			pgxmock.NewRows(append(eventColumns, "follower_count")).
+				AddRow(id, userID, date, (*time.Time)(nil), "Dentist", "", "", (*float64)(nil), (*float64)(nil), model.PriorityNormal, (*uuid.UUID)(nil), &calendarID, "", []string{}, (*time.Time)(nil), "", (*model.EventNotifications)(nil), "", []time.Time{}, time.Now(), time.Now(), int64(0)),
+		)
+
+	events, err := repo.SearchEvents(context.Background(), userID, search, model.Page{Offset: 20, Limit: 20})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "Dentist", events[0].Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountSearchResults(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery("SELECT count\\(\\*\\) FROM \\(SELECT 1 FROM events WHERE user_id = \\$1 AND search_vector @@").
+		WithArgs(userID, "dentist", (*time.Time)(nil), (*time.Time)(nil), (*uuid.UUID)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(4))
+
+	n, err := repo.CountSearchResults(context.Background(), userID, model.EventSearch{Query: "dentist"}, model.TotalExact)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SearchEvents_Strict(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery("strpos\\(title, \\$2\\) > 0 OR strpos\\(description, \\$2\\) > 0(.|\\s)+ORDER BY event_date DESC, id").
+		WithArgs(userID, "Café", (*time.Time)(nil), (*time.Time)(nil), (*uuid.UUID)(nil), 21, 0).
+		WillReturnRows(pgxmock.NewRows(append(eventColumns, "follower_count")))
+
+	events, err := repo.SearchEvents(context.Background(), userID, model.EventSearch{Query: "Café", Strict: true}, model.Page{Limit: 20})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SuggestTitles(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery("normalize_text\\(title\\) LIKE normalize_text\\(\\$2\\) \\|\\| '%'(.|\\s)+ORDER BY max\\(event_date\\) DESC, title").
+		WithArgs(userID, "cafe", 10).
+		WillReturnRows(pgxmock.NewRows([]string{"title"}).AddRow("Café with Anna").AddRow("Cafeteria"))
+	mock.ExpectQuery("WHERE user_id = \\$1 AND title LIKE \\$2 \\|\\| '%'").
+		WithArgs(userID, `50\% off\_`, 5).
+		WillReturnRows(pgxmock.NewRows([]string{"title"}))
+
+	titles, err := repo.SuggestTitles(context.Background(), userID, "cafe", false, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Café with Anna", "Cafeteria"}, titles)
+
+	titles, err = repo.SuggestTitles(context.Background(), userID, "50% off_", true, 5)
+	assert.NoError(t, err)
+	assert.Empty(t, titles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ExcludeOccurrence(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE events(.|\\s)+array_append\\(recurrence_exceptions, \\$3\\)(.|\\s)+recurrence_rule <> ''").
+		WithArgs(eventID, userID, occurrence).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("UPDATE events").
+		WithArgs(eventID, userID, occurrence).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	assert.NoError(t, repo.ExcludeOccurrence(context.Background(), eventID, userID, occurrence))
+	assert.ErrorIs(t, repo.ExcludeOccurrence(context.Background(), eventID, userID, occurrence), ErrNotRecurring)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DetachOccurrence(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	seriesID, detachedID := uuid.New(), uuid.New()
+	occurrence := time.Date(2030, 1, 8, 9, 0, 0, 0, time.UTC)
+	event := model.Event{UserID: uuid.New(), Title: "Moved", EventDate: occurrence.Add(time.Hour), RecurrenceRule: "FREQ=DAILY"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE events").
+		WithArgs(seriesID, event.UserID, occurrence).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectQuery("INSERT INTO events").
+		WithArgs(event.UserID, event.EventDate, event.EndDate, event.Title, event.Description, event.Location, event.Latitude, event.Longitude, event.Priority, event.ProjectID, event.CalendarID, event.Color, []string{}, event.ReminderAt, event.ReminderTimezone, event.Notifications, "").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(detachedID))
+	mock.ExpectCommit()
+
+	id, err := repo.DetachOccurrence(context.Background(), seriesID, occurrence, event)
+	assert.NoError(t, err)
+	assert.Equal(t, detachedID, id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetOverride_MovesReminder(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	defer mock.Close()
+
+	now := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	repo := New(mock, clock.NewFake(now))
+
+	seriesID, userID := uuid.New(), uuid.New()
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	remindAt := start.Add(-15 * time.Minute)
+	override := model.OccurrenceOverride{Occurrence: start, EventDate: start.Add(2 * time.Hour), Title: "Late kickoff"}
+	movedReminder := remindAt.Add(2 * time.Hour)
+
+	// Overriding the first occurrence moves the reminder of the series with it.
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT event_date, end_date, title, reminder_at, reminder_timezone\\s+FROM events(.|\\s)+recurrence_rule <> ''(.|\\s)+FOR UPDATE").
+		WithArgs(seriesID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"event_date", "end_date", "title", "reminder_at", "reminder_timezone"}).
+			AddRow(start, (*time.Time)(nil), "Kickoff", &remindAt, ""))
+	mock.ExpectExec("INSERT INTO event_overrides(.|\\s)+ON CONFLICT \\(event_id, occurrence\\) DO UPDATE").
+		WithArgs(seriesID, start, override.EventDate, (*time.Time)(nil), "Late kickoff").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE reminders").
+		WithArgs(seriesID, movedReminder, (*string)(nil), (*time.Time)(nil), userID, "Late kickoff").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO reminders(.|\\s)+WHERE NOT EXISTS").
+		WithArgs(seriesID, userID, "Late kickoff", movedReminder, (*string)(nil), (*time.Time)(nil)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.SetOverride(context.Background(), seriesID, userID, override))
+
+	// Overriding a later occurrence leaves the reminders alone.
+	later := model.OccurrenceOverride{Occurrence: start.AddDate(0, 0, 7), EventDate: start.AddDate(0, 0, 8)}
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").
+		WithArgs(seriesID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"event_date", "end_date", "title", "reminder_at", "reminder_timezone"}).
+			AddRow(start, (*time.Time)(nil), "Kickoff", &remindAt, ""))
+	mock.ExpectExec("INSERT INTO event_overrides").
+		WithArgs(seriesID, later.Occurrence, later.EventDate, (*time.Time)(nil), "").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.SetOverride(context.Background(), seriesID, userID, later))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetOverride_NotRecurring(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	seriesID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").WithArgs(seriesID, userID).WillReturnError(pgx.ErrNoRows)
+	mock.ExpectRollback()
+
+	err := repo.SetOverride(context.Background(), seriesID, userID, model.OccurrenceOverride{})
+	assert.ErrorIs(t, err, ErrNotRecurring)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteOverride(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	seriesID, userID := uuid.New(), uuid.New()
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	occurrence := start.AddDate(0, 0, 7)
+	series := pgxmock.NewRows([]string{"event_date", "end_date", "title", "reminder_at", "reminder_timezone"}).
+		AddRow(start, (*time.Time)(nil), "Standup", (*time.Time)(nil), "")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").WithArgs(seriesID, userID).WillReturnRows(series)
+	mock.ExpectExec("DELETE FROM event_overrides\\s+WHERE event_id = \\$1 AND occurrence = \\$2").
+		WithArgs(seriesID, occurrence).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectRollback()
+
+	err := repo.DeleteOverride(context.Background(), seriesID, userID, occurrence)
+	assert.ErrorIs(t, err, ErrOverrideNotFound)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -0,0 +1,183 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrFeedNotFound    = errors.New("feed not found")
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+// feedColumns lists the columns of the feeds table returned to callers; the token hash is never read back.
+const feedColumns = "id, user_id, name, project_id, created_at"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the ICS feeds of users in the feeds table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateFeed inserts a new feed with the hash of its token.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - feed: The feed to be inserted.
+//   - tokenHash: The hash of the feed token.
+//
+// Returns:
+//   - The created feed with ID and CreatedAt set.
+//   - ErrProjectNotFound if the user has no such project, or another error if the insertion fails.
+func (r *Repository) CreateFeed(ctx context.Context, feed model.Feed, tokenHash string) (model.Feed, error) {
+	query := `
+		INSERT INTO feeds (user_id, name, token_hash, project_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, feed.UserID, feed.Name, tokenHash, feed.ProjectID).Scan(&feed.ID, &feed.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "feeds_project_fk" {
+			return model.Feed{}, ErrProjectNotFound
+		}
+		return model.Feed{}, fmt.Errorf("failed to create feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+// ListFeeds retrieves all feeds of a user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of feeds without tokens.
+//   - An error if the query fails.
+func (r *Repository) ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error) {
+	query := `SELECT ` + feedColumns + ` FROM feeds WHERE user_id = $1 ORDER BY created_at DESC, id;`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query feeds: %w", err)
+	}
+	defer rows.Close()
+
+	var feeds []model.Feed
+	for rows.Next() {
+		feed, err := scanFeed(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feed: %w", err)
+		}
+		feeds = append(feeds, feed)
+	}
+
+	return feeds, rows.Err()
+}
+
+// GetFeedByTokenHash retrieves the feed a token belongs to.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - tokenHash: The hash of the feed token.
+//
+// Returns:
+//   - The feed without its token.
+//   - ErrFeedNotFound if no feed has the token, or another error if the query fails.
+func (r *Repository) GetFeedByTokenHash(ctx context.Context, tokenHash string) (model.Feed, error) {
+	query := `SELECT ` + feedColumns + ` FROM feeds WHERE token_hash = $1;`
+
+	feed, err := scanFeed(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Feed{}, ErrFeedNotFound
+		}
+		return model.Feed{}, fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+// UpdateToken replaces the token hash of a feed of the specified user, revoking the previous token.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - feedID: The UUID of the feed.
+//   - userID: The UUID of the user who owns the feed.
+//   - tokenHash: The hash of the new token.
+//
+// Returns:
+//   - The feed without its token.
+//   - ErrFeedNotFound if the user has no such feed, or another error if the update fails.
+func (r *Repository) UpdateToken(ctx context.Context, feedID, userID uuid.UUID, tokenHash string) (model.Feed, error) {
+	query := `UPDATE feeds SET token_hash = $3 WHERE id = $1 AND user_id = $2 RETURNING ` + feedColumns + `;`
+
+	feed, err := scanFeed(r.db.QueryRow(ctx, query, feedID, userID, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Feed{}, ErrFeedNotFound
+		}
+		return model.Feed{}, fmt.Errorf("failed to update feed token: %w", err)
+	}
+
+	return feed, nil
+}
+
+// DeleteFeed deletes a feed of the specified user, revoking its token.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - feedID: The UUID of the feed.
+//   - userID: The UUID of the user who owns the feed.
+//
+// Returns:
+//   - ErrFeedNotFound if the user has no such feed, or another error if the deletion fails.
+func (r *Repository) DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM feeds WHERE id = $1 AND user_id = $2`, feedID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete feed: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrFeedNotFound
+	}
+
+	return nil
+}
+
+// scanFeed scans a feeds row selected with feedColumns.
+func scanFeed(row pgx.Row) (model.Feed, error) {
+	var feed model.Feed
+	err := row.Scan(&feed.ID, &feed.UserID, &feed.Name, &feed.ProjectID, &feed.CreatedAt)
+	return feed, err
+}
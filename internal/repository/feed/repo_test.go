@@ -0,0 +1,110 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateFeed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	feed := model.Feed{UserID: uuid.New(), Name: "Work"}
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO feeds").
+		WithArgs(feed.UserID, "Work", "hash", (*uuid.UUID)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(id, now))
+
+	got, err := repo.CreateFeed(context.Background(), feed, "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, now, got.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateFeed_UnknownProject(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	projectID := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO feeds").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "feeds_project_fk"})
+
+	_, err := repo.CreateFeed(context.Background(), model.Feed{UserID: uuid.New(), ProjectID: &projectID}, "hash")
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestRepository_GetFeedByTokenHash_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("FROM feeds WHERE token_hash").
+		WithArgs("unknown").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetFeedByTokenHash(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrFeedNotFound)
+}
+
+func TestRepository_UpdateToken(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	feedID, userID, projectID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("UPDATE feeds SET token_hash = \\$3 WHERE id = \\$1 AND user_id = \\$2 RETURNING id, user_id, name, project_id, created_at").
+		WithArgs(feedID, userID, "new-hash").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "project_id", "created_at"}).
+			AddRow(feedID, userID, "Work", &projectID, time.Now()))
+
+	feed, err := repo.UpdateToken(context.Background(), feedID, userID, "new-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, "Work", feed.Name)
+	assert.Equal(t, &projectID, feed.ProjectID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateToken_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("UPDATE feeds").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.UpdateToken(context.Background(), uuid.New(), uuid.New(), "hash")
+	assert.ErrorIs(t, err, ErrFeedNotFound)
+}
+
+func TestRepository_DeleteFeed_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM feeds").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteFeed(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrFeedNotFound)
+}
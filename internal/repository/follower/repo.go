@@ -0,0 +1,172 @@
+package follower
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrEventNotFound = errors.New("event not found")
+	ErrNotFollowing  = errors.New("not following the event")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages interactions with the event_followers table in the PostgreSQL database.
+// It provides methods for following and unfollowing the shared events of other users.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetSharedEvent retrieves an event that can be followed: one shared through a short link that has not expired,
+// or published by an embed of its owner.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - The event with its ID, owner and stored title.
+//   - ErrEventNotFound if the event does not exist or is not shared.
+//   - An error if the query fails.
+func (r *Repository) GetSharedEvent(ctx context.Context, eventID uuid.UUID) (model.Event, error) {
+	query := `
+		SELECT e.id, e.user_id, e.title
+		FROM events e
+		WHERE e.id = $1 AND e.deleted_at IS NULL
+		  AND (EXISTS (SELECT 1 FROM short_links s WHERE s.event_id = e.id AND s.expires_at > now())
+		    OR EXISTS (SELECT 1 FROM embeds m WHERE m.user_id = e.user_id AND (m.project_id IS NULL OR m.project_id = e.project_id)));
+	`
+
+	var e model.Event
+	if err := r.db.QueryRow(ctx, query, eventID).Scan(&e.ID, &e.UserID, &e.Title); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, ErrEventNotFound
+		}
+		return model.Event{}, fmt.Errorf("failed to get shared event: %w", err)
+	}
+
+	return e, nil
+}
+
+// Follow makes a user follow an event and copies the pending reminders of its owner for the user,
+// in the same transaction. Following an event again keeps the original follow and reminders.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the following user.
+//   - message: The message of the copied reminders, encrypted for the following user.
+//
+// Returns:
+//   - The follower.
+//   - An error if the insertion fails.
+func (r *Repository) Follow(ctx context.Context, eventID, userID uuid.UUID, message string) (model.Follower, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	f := model.Follower{EventID: eventID, UserID: userID}
+	err = tx.QueryRow(ctx, `
+		INSERT INTO event_followers (event_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (event_id, user_id) DO NOTHING
+		RETURNING followed_at;
+	`, eventID, userID).Scan(&f.FollowedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Already following; the reminders were copied when the user started following.
+		err = tx.QueryRow(ctx, `SELECT followed_at FROM event_followers WHERE event_id = $1 AND user_id = $2`, eventID, userID).
+			Scan(&f.FollowedAt)
+		if err != nil {
+			return model.Follower{}, fmt.Errorf("failed to get follower: %w", err)
+		}
+		return f, nil
+	}
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("failed to follow event: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO reminders (event_id, user_id, message, remind_at, timezone, local_time)
+		SELECT r.event_id, $2, $3, r.remind_at, r.timezone, r.local_time
+		FROM reminders r
+		JOIN events e ON e.id = r.event_id AND e.user_id = r.user_id AND e.deleted_at IS NULL
+		WHERE r.event_id = $1 AND r.status = 'pending';
+	`, eventID, userID, message)
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("failed to copy reminders: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.Follower{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return f, nil
+}
+
+// Unfollow stops a user from following an event and deletes their pending reminders of the event,
+// in the same transaction. Sent reminders stay in the user's notification history.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the following user.
+//
+// Returns:
+//   - ErrNotFollowing if the user does not follow the event.
+//   - An error if the deletion fails.
+func (r *Repository) Unfollow(ctx context.Context, eventID, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM event_followers WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to unfollow event: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFollowing
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM reminders WHERE event_id = $1 AND user_id = $2 AND status = 'pending'`, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminders: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package follower
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_GetSharedEvent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT e.id, e.user_id, e.title\\s+FROM events e(.|\\s)+short_links(.|\\s)+embeds").
+		WithArgs(eventID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "title"}).AddRow(eventID, ownerID, "Launch"))
+
+	e, err := repo.GetSharedEvent(context.Background(), eventID)
+	assert.NoError(t, err)
+	assert.Equal(t, ownerID, e.UserID)
+	assert.Equal(t, "Launch", e.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetSharedEvent_NotShared(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	mock.ExpectQuery("FROM events e").WithArgs(eventID).WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetSharedEvent(context.Background(), eventID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Follow(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO event_followers(.|\\s)+ON CONFLICT \\(event_id, user_id\\) DO NOTHING").
+		WithArgs(eventID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"followed_at"}).AddRow(now))
+	mock.ExpectExec("INSERT INTO reminders(.|\\s)+FROM reminders r(.|\\s)+e.deleted_at IS NULL(.|\\s)+r.status = 'pending'").
+		WithArgs(eventID, userID, "Launch").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	f, err := repo.Follow(context.Background(), eventID, userID, "Launch")
+	assert.NoError(t, err)
+	assert.Equal(t, now, f.FollowedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Follow_AlreadyFollowing(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	followedAt := time.Now().Add(-time.Hour)
+
+	// The reminders are not copied a second time.
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO event_followers").WithArgs(eventID, userID).WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("SELECT followed_at FROM event_followers").
+		WithArgs(eventID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"followed_at"}).AddRow(followedAt))
+	mock.ExpectRollback()
+
+	f, err := repo.Follow(context.Background(), eventID, userID, "Launch")
+	assert.NoError(t, err)
+	assert.Equal(t, followedAt, f.FollowedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Unfollow(t *testing.T) {
+	eventID, userID := uuid.New(), uuid.New()
+
+	t.Run("following", func(t *testing.T) {
+		repo, mock := newTestRepo(t)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM event_followers").WithArgs(eventID, userID).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		mock.ExpectExec("DELETE FROM reminders WHERE event_id = \\$1 AND user_id = \\$2 AND status = 'pending'").
+			WithArgs(eventID, userID).
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Unfollow(context.Background(), eventID, userID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not following", func(t *testing.T) {
+		repo, mock := newTestRepo(t)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM event_followers").WithArgs(eventID, userID).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.Unfollow(context.Background(), eventID, userID), ErrNotFollowing)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,384 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrGroupNotFound  = errors.New("group not found")
+	ErrGroupExists    = errors.New("group with this name already exists")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrMemberNotFound = errors.New("member not found")
+	ErrEventNotFound  = errors.New("event not found")
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// groupColumns are the columns of a group with its member count, in the order they are scanned.
+const groupColumns = `g.id, g.user_id, g.name,
+		(SELECT COUNT(*) FROM attendee_group_members m WHERE m.group_id = g.id), g.created_at, g.updated_at`
+
+// Repository manages interactions with the attendee_groups and attendee_group_members tables in the PostgreSQL
+// database, and the invitations groups send to events.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateGroup inserts a new, empty group of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user who owns the group.
+//   - name: The name of the group.
+//
+// Returns:
+//   - The created group.
+//   - ErrGroupExists if the user already has a group with this name, or another error if the insertion fails.
+func (r *Repository) CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	query := `
+		INSERT INTO attendee_groups (user_id, name)
+		VALUES ($1, $2)
+		RETURNING id, user_id, name, 0, created_at, updated_at;
+	`
+
+	g, err := scanGroup(r.db.QueryRow(ctx, query, userID, name))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.AttendeeGroup{}, ErrGroupExists
+		}
+		return model.AttendeeGroup{}, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	return g, nil
+}
+
+// ListGroups retrieves the groups of a user with their member counts, ordered by name.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of groups, without their members.
+//   - An error if the query fails.
+func (r *Repository) ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error) {
+	query := `
+		SELECT ` + groupColumns + `
+		FROM attendee_groups g
+		WHERE g.user_id = $1
+		ORDER BY g.name;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []model.AttendeeGroup{}
+	for rows.Next() {
+		g, err := scanGroup(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+
+	return groups, rows.Err()
+}
+
+// GetGroup retrieves a group of a user with its members, ordered by name and email address.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//
+// Returns:
+//   - The group with its members.
+//   - ErrGroupNotFound if the user has no such group, or another error if the query fails.
+func (r *Repository) GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error) {
+	query := `
+		SELECT ` + groupColumns + `
+		FROM attendee_groups g
+		WHERE g.id = $1 AND g.user_id = $2;
+	`
+
+	g, err := scanGroup(r.db.QueryRow(ctx, query, groupID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AttendeeGroup{}, ErrGroupNotFound
+		}
+		return model.AttendeeGroup{}, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT m.group_id, m.user_id, u.email, u.name, m.added_at
+		FROM attendee_group_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.group_id = $1
+		ORDER BY u.name, u.email;
+	`, groupID)
+	if err != nil {
+		return model.AttendeeGroup{}, fmt.Errorf("failed to list group members: %w", err)
+	}
+	defer rows.Close()
+
+	g.Members = []model.GroupMember{}
+	for rows.Next() {
+		var m model.GroupMember
+		if err := rows.Scan(&m.GroupID, &m.UserID, &m.Email, &m.Name, &m.AddedAt); err != nil {
+			return model.AttendeeGroup{}, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		g.Members = append(g.Members, m)
+	}
+
+	return g, rows.Err()
+}
+
+// RenameGroup changes the name of a group of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//   - name: The new name of the group.
+//
+// Returns:
+//   - The renamed group, without its members.
+//   - ErrGroupNotFound if the user has no such group, ErrGroupExists if another of their groups has the name,
+//     or another error if the update fails.
+func (r *Repository) RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	query := `
+		UPDATE attendee_groups g
+		SET name = $3, updated_at = now()
+		WHERE g.id = $1 AND g.user_id = $2
+		RETURNING ` + groupColumns + `;
+	`
+
+	g, err := scanGroup(r.db.QueryRow(ctx, query, groupID, userID, name))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return model.AttendeeGroup{}, ErrGroupNotFound
+		case errors.As(err, &pgErr) && pgErr.Code == uniqueViolation:
+			return model.AttendeeGroup{}, ErrGroupExists
+		}
+		return model.AttendeeGroup{}, fmt.Errorf("failed to rename group: %w", err)
+	}
+
+	return g, nil
+}
+
+// DeleteGroup deletes a group of a user. The invitations it sent are kept as individual invitations.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//
+// Returns:
+//   - ErrGroupNotFound if the user has no such group, or another error if the deletion fails.
+func (r *Repository) DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM attendee_groups WHERE id = $1 AND user_id = $2`, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrGroupNotFound
+	}
+
+	return nil
+}
+
+// AddMember adds the user with the given email address to a group of the owner and, in the same statement,
+// invites them to the events the group is invited to that have not started yet and are not in the trash.
+// Adding a member again changes nothing.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//   - email: The email address of the new member.
+//
+// Returns:
+//   - The member.
+//   - The number of events the member was invited to.
+//   - ErrUserNotFound if no user has the email address, ErrGroupNotFound if the owner has no such group,
+//     or another error if the insertion fails.
+func (r *Repository) AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error) {
+	m := model.GroupMember{GroupID: groupID}
+	err := r.db.QueryRow(ctx, `SELECT id, email, name FROM users WHERE email = $1`, email).Scan(&m.UserID, &m.Email, &m.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GroupMember{}, 0, ErrUserNotFound
+		}
+		return model.GroupMember{}, 0, fmt.Errorf("failed to find member: %w", err)
+	}
+
+	// The owner may be a member of their own group, but is never invited to their own events.
+	query := `
+		WITH member AS (
+		    INSERT INTO attendee_group_members (group_id, user_id)
+		    SELECT $1, $3
+		    WHERE EXISTS (SELECT 1 FROM attendee_groups WHERE id = $1 AND user_id = $2)
+		    ON CONFLICT (group_id, user_id) DO UPDATE SET group_id = EXCLUDED.group_id
+		    RETURNING user_id, added_at
+		), invited AS (
+		    INSERT INTO event_attendees (event_id, user_id, group_id)
+		    SELECT l.event_id, m.user_id, l.group_id
+		    FROM member m
+		    JOIN event_attendee_groups l ON l.group_id = $1
+		    JOIN events e ON e.id = l.event_id AND e.deleted_at IS NULL
+		    WHERE e.event_date > now() AND e.user_id <> m.user_id
+		    ON CONFLICT (event_id, user_id) DO NOTHING
+		    RETURNING event_id
+		)
+		SELECT m.added_at, (SELECT COUNT(*) FROM invited) FROM member m;
+	`
+
+	var invited int
+	if err := r.db.QueryRow(ctx, query, groupID, ownerID, m.UserID).Scan(&m.AddedAt, &invited); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GroupMember{}, 0, ErrGroupNotFound
+		}
+		return model.GroupMember{}, 0, fmt.Errorf("failed to add member: %w", err)
+	}
+
+	return m, invited, nil
+}
+
+// RemoveMember removes a user from a group of the owner and, in the same statement, withdraws the invitations the
+// group sent them to events that have not started yet. Individual invitations to the same events are kept.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//   - userID: The UUID of the member.
+//
+// Returns:
+//   - The number of withdrawn invitations.
+//   - ErrMemberNotFound if the user is not a member of a group of the owner, or another error if the deletion fails.
+func (r *Repository) RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error) {
+	query := `
+		WITH removed AS (
+		    DELETE FROM attendee_group_members m
+		    USING attendee_groups g
+		    WHERE m.group_id = $1 AND m.user_id = $3 AND g.id = m.group_id AND g.user_id = $2
+		    RETURNING m.user_id
+		), withdrawn AS (
+		    DELETE FROM event_attendees a
+		    USING events e, removed r
+		    WHERE a.user_id = r.user_id AND a.group_id = $1 AND e.id = a.event_id AND e.event_date > now()
+		    RETURNING a.event_id
+		)
+		SELECT (SELECT COUNT(*) FROM removed), (SELECT COUNT(*) FROM withdrawn);
+	`
+
+	var removed, withdrawn int
+	if err := r.db.QueryRow(ctx, query, groupID, ownerID, userID).Scan(&removed, &withdrawn); err != nil {
+		return 0, fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	if removed == 0 {
+		return 0, ErrMemberNotFound
+	}
+
+	return withdrawn, nil
+}
+
+// InviteGroup invites the members of a group to an event, both of the owner, in a single statement.
+// The group stays linked to the event, so later membership changes are applied to it until it starts.
+// Members who are already invited keep their invitation and response; the owner is skipped.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event and the group.
+//   - groupID: The UUID of the group.
+//
+// Returns:
+//   - The number of newly invited members.
+//   - ErrEventNotFound if the owner has no such event, ErrGroupNotFound if they have no such group,
+//     or another error if the insertion fails.
+func (r *Repository) InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error) {
+	query := `
+		WITH link AS (
+		    INSERT INTO event_attendee_groups (event_id, group_id)
+		    SELECT $1, $3
+		    WHERE EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)
+		      AND EXISTS (SELECT 1 FROM attendee_groups WHERE id = $3 AND user_id = $2)
+		    ON CONFLICT (event_id, group_id) DO UPDATE SET event_id = EXCLUDED.event_id
+		    RETURNING event_id, group_id
+		), invited AS (
+		    INSERT INTO event_attendees (event_id, user_id, group_id)
+		    SELECT l.event_id, m.user_id, l.group_id
+		    FROM link l
+		    JOIN attendee_group_members m ON m.group_id = l.group_id
+		    WHERE m.user_id <> $2
+		    ON CONFLICT (event_id, user_id) DO NOTHING
+		    RETURNING user_id
+		)
+		SELECT EXISTS (SELECT 1 FROM events WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL),
+		       EXISTS (SELECT 1 FROM attendee_groups WHERE id = $3 AND user_id = $2),
+		       (SELECT COUNT(*) FROM invited);
+	`
+
+	var (
+		eventFound, groupFound bool
+		invited                int
+	)
+	if err := r.db.QueryRow(ctx, query, eventID, ownerID, groupID).Scan(&eventFound, &groupFound, &invited); err != nil {
+		return 0, fmt.Errorf("failed to invite group: %w", err)
+	}
+
+	switch {
+	case !eventFound:
+		return 0, ErrEventNotFound
+	case !groupFound:
+		return 0, ErrGroupNotFound
+	}
+
+	return invited, nil
+}
+
+// scanGroup scans a group from a row with the group columns.
+func scanGroup(row pgx.Row) (model.AttendeeGroup, error) {
+	var g model.AttendeeGroup
+	err := row.Scan(&g.ID, &g.UserID, &g.Name, &g.MemberCount, &g.CreatedAt, &g.UpdatedAt)
+	return g, err
+}
@@ -0,0 +1,165 @@
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var groupRows = []string{"id", "user_id", "name", "member_count", "created_at", "updated_at"}
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateGroup_Exists(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO attendee_groups").
+		WithArgs(userID, "Backend team").
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+	_, err := repo.CreateGroup(context.Background(), userID, "Backend team")
+	assert.ErrorIs(t, err, ErrGroupExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetGroup(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, userID, memberID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("FROM attendee_groups g(.|\\s)+WHERE g.id = \\$1 AND g.user_id = \\$2").
+		WithArgs(groupID, userID).
+		WillReturnRows(pgxmock.NewRows(groupRows).AddRow(groupID, userID, "Backend team", 1, now, now))
+	mock.ExpectQuery("FROM attendee_group_members m(.|\\s)+JOIN users u").
+		WithArgs(groupID).
+		WillReturnRows(pgxmock.NewRows([]string{"group_id", "user_id", "email", "name", "added_at"}).
+			AddRow(groupID, memberID, "ana@example.com", "Ana", now))
+
+	g, err := repo.GetGroup(context.Background(), groupID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, g.MemberCount)
+	assert.Len(t, g.Members, 1)
+	assert.Equal(t, memberID, g.Members[0].UserID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddMember(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT id, email, name FROM users WHERE email = \\$1").
+		WithArgs("ana@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(memberID, "ana@example.com", "Ana"))
+	mock.ExpectQuery("INSERT INTO attendee_group_members(.|\\s)+INSERT INTO event_attendees(.|\\s)+e.event_date > now\\(\\)").
+		WithArgs(groupID, ownerID, memberID).
+		WillReturnRows(pgxmock.NewRows([]string{"added_at", "count"}).AddRow(now, 2))
+
+	m, invited, err := repo.AddMember(context.Background(), groupID, ownerID, "ana@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, memberID, m.UserID)
+	assert.Equal(t, 2, invited)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddMember_SkipsTrashedEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT id, email, name FROM users").
+		WithArgs("ana@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(memberID, "ana@example.com", "Ana"))
+	mock.ExpectQuery("INSERT INTO event_attendees(.|\\s)+JOIN events e ON e.id = l.event_id AND e.deleted_at IS NULL\\s+WHERE").
+		WithArgs(groupID, ownerID, memberID).
+		WillReturnRows(pgxmock.NewRows([]string{"added_at", "count"}).AddRow(time.Now(), 0))
+
+	_, invited, err := repo.AddMember(context.Background(), groupID, ownerID, "ana@example.com")
+	assert.NoError(t, err)
+	assert.Zero(t, invited, "the group's only event is in the trash")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddMember_GroupNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT id, email, name FROM users").
+		WithArgs("ana@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name"}).AddRow(memberID, "ana@example.com", "Ana"))
+	mock.ExpectQuery("INSERT INTO attendee_group_members").
+		WithArgs(groupID, ownerID, memberID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, _, err := repo.AddMember(context.Background(), groupID, ownerID, "ana@example.com")
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RemoveMember_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("DELETE FROM attendee_group_members(.|\\s)+DELETE FROM event_attendees").
+		WithArgs(groupID, ownerID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"removed", "withdrawn"}).AddRow(0, 0))
+
+	_, err := repo.RemoveMember(context.Background(), groupID, ownerID, userID)
+	assert.ErrorIs(t, err, ErrMemberNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_InviteGroup(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID, groupID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("INSERT INTO event_attendee_groups(.|\\s)+INSERT INTO event_attendees").
+		WithArgs(eventID, ownerID, groupID).
+		WillReturnRows(pgxmock.NewRows([]string{"event", "group", "invited"}).AddRow(true, true, 3))
+
+	invited, err := repo.InviteGroup(context.Background(), eventID, ownerID, groupID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, invited)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_InviteGroup_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, ownerID, groupID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery("INSERT INTO event_attendee_groups").
+		WithArgs(eventID, ownerID, groupID).
+		WillReturnRows(pgxmock.NewRows([]string{"event", "group", "invited"}).AddRow(true, false, 0))
+
+	_, err := repo.InviteGroup(context.Background(), eventID, ownerID, groupID)
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,418 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobFinished = errors.New("job has already finished")
+	ErrJobLost     = errors.New("job is no longer leased to this worker")
+	ErrNoOutput    = errors.New("job has no output")
+)
+
+// jobColumns are the columns of a job returned by the API; the payload is only read by the worker
+// and the output only when it is downloaded.
+const jobColumns = `id, user_id, kind, status, total, processed, result, error, cancel_requested, output_type,
+		       created_at, started_at, updated_at, finished_at`
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages background jobs in the jobs table.
+// Jobs are claimed with row-level locks and leases like reminders, so several service
+// instances can run the worker pool without executing a job twice.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateJob inserts a new queued job and returns it with its ID and timestamps.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - job: The job to insert; UserID, Kind and Payload are stored.
+//
+// Returns:
+//   - The created job.
+//   - An error if the insertion fails.
+func (r *Repository) CreateJob(ctx context.Context, job model.Job) (model.Job, error) {
+	query := `
+		INSERT INTO jobs (user_id, kind, payload, total)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, status, created_at, updated_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, job.UserID, job.Kind, job.Payload, job.Total).
+		Scan(&job.ID, &job.Status, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetJob retrieves a job by its ID for the specified user, without its payload.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job.
+//   - ErrJobNotFound if the user has no such job, or another error if the query fails.
+func (r *Repository) GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = $1 AND user_id = $2;`
+
+	job, err := scanJob(r.db.QueryRow(ctx, query, jobID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Job{}, ErrJobNotFound
+		}
+		return model.Job{}, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ListJobs retrieves the most recent jobs of a user, newest first, without their payloads.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - limit: The maximum number of jobs to return.
+//
+// Returns:
+//   - A slice of jobs.
+//   - An error if the query fails.
+func (r *Repository) ListJobs(ctx context.Context, userID uuid.UUID, limit int) ([]model.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE user_id = $1 ORDER BY created_at DESC, id LIMIT $2;`
+
+	rows, err := r.db.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []model.Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, rows.Err()
+}
+
+// ClaimNext locks the oldest queued job for the given owner until the lease expires and marks it as running.
+// Jobs locked by other transactions are skipped.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - lease: How long the job stays reserved for the owner without a heartbeat.
+//   - owner: The identifier of the claiming worker.
+//
+// Returns:
+//   - The claimed job with its payload, or nil if no job is queued.
+//   - An error if the query fails.
+func (r *Repository) ClaimNext(ctx context.Context, lease time.Duration, owner string) (*model.Job, error) {
+	query := `
+		UPDATE jobs
+		SET status = 'running',
+		    locked_by = $2,
+		    locked_until = now() + $1::interval,
+		    started_at = now(),
+		    updated_at = now()
+		WHERE id = (
+		    SELECT id
+		    FROM jobs
+		    WHERE status = 'queued'
+		    ORDER BY created_at
+		    LIMIT 1
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns + `, payload;
+	`
+
+	var job model.Job
+	err := r.db.QueryRow(ctx, query, lease.String(), owner).Scan(append(jobTargets(&job), &job.Payload)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim job: %w", err)
+	}
+
+	return &job, nil
+}
+
+// Heartbeat extends the lease of a running job and stores its progress.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - job: The running job with its current progress and result.
+//   - lease: The new lease duration, counted from now.
+//   - owner: The identifier of the worker running the job.
+//
+// Returns:
+//   - Whether the user asked to cancel the job.
+//   - ErrJobLost if the job is no longer leased to the owner, or another error if the update fails.
+func (r *Repository) Heartbeat(ctx context.Context, job model.Job, lease time.Duration, owner string) (bool, error) {
+	query := `
+		UPDATE jobs
+		SET locked_until = now() + $3::interval,
+		    total = $4,
+		    processed = $5,
+		    result = $6,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2 AND status = 'running'
+		RETURNING cancel_requested;
+	`
+
+	var cancelRequested bool
+	err := r.db.QueryRow(ctx, query, job.ID, owner, lease.String(), job.Total, job.Processed, []byte(job.Result)).
+		Scan(&cancelRequested)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrJobLost
+		}
+		return false, fmt.Errorf("failed to update job progress: %w", err)
+	}
+
+	return cancelRequested, nil
+}
+
+// FinishJob stores the final status, progress, result and output of a job, releases its lease and drops its payload.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - job: The job with its final status, progress, result, error and output.
+//   - owner: The identifier of the worker that ran the job.
+//
+// Returns:
+//   - ErrJobLost if the job is no longer leased to the owner, or another error if the update fails.
+func (r *Repository) FinishJob(ctx context.Context, job model.Job, owner string) error {
+	query := `
+		UPDATE jobs
+		SET status = $3,
+		    total = $4,
+		    processed = $5,
+		    result = $6,
+		    error = $7,
+		    output = $8,
+		    output_type = $9,
+		    payload = NULL,
+		    locked_by = NULL,
+		    locked_until = NULL,
+		    updated_at = now(),
+		    finished_at = now()
+		WHERE id = $1 AND locked_by = $2 AND status = 'running';
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, job.ID, owner, job.Status, job.Total, job.Processed, []byte(job.Result),
+		job.Error, job.Output, job.OutputType)
+	if err != nil {
+		return fmt.Errorf("failed to finish job: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrJobLost
+	}
+
+	return nil
+}
+
+// GetOutput retrieves the file produced by a completed job of the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job with its output.
+//   - ErrJobNotFound if the user has no such job, ErrNoOutput if the job has not completed or produced no file,
+//     or another error if the query fails.
+func (r *Repository) GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	query := `SELECT ` + jobColumns + `, output FROM jobs WHERE id = $1 AND user_id = $2;`
+
+	var job model.Job
+	err := r.db.QueryRow(ctx, query, jobID, userID).Scan(append(jobTargets(&job), &job.Output)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Job{}, ErrJobNotFound
+		}
+		return model.Job{}, fmt.Errorf("failed to get job output: %w", err)
+	}
+
+	if job.Status != model.JobCompleted || job.Output == nil {
+		return model.Job{}, ErrNoOutput
+	}
+
+	return job, nil
+}
+
+// CancelJob cancels a queued job right away and asks the worker of a running job to stop.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job after the update.
+//   - ErrJobNotFound if the user has no such job, ErrJobFinished if it has already ended,
+//     or another error if the update fails.
+func (r *Repository) CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	query := `
+		UPDATE jobs
+		SET status = CASE WHEN status = 'queued' THEN 'cancelled' ELSE status END,
+		    cancel_requested = TRUE,
+		    payload = CASE WHEN status = 'queued' THEN NULL ELSE payload END,
+		    finished_at = CASE WHEN status = 'queued' THEN now() ELSE finished_at END,
+		    updated_at = now()
+		WHERE id = $1 AND user_id = $2 AND status IN ('queued', 'running')
+		RETURNING ` + jobColumns + `;
+	`
+
+	job, err := scanJob(r.db.QueryRow(ctx, query, jobID, userID))
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.Job{}, fmt.Errorf("failed to cancel job: %w", err)
+	}
+
+	// Nothing was updated: the job does not exist or has already ended.
+	if _, err := r.GetJob(ctx, jobID, userID); err != nil {
+		return model.Job{}, err
+	}
+
+	return model.Job{}, ErrJobFinished
+}
+
+// RecordEvents stores the events created by a job, so they can be deleted if the job is rolled back.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - jobID: The UUID of the job.
+//   - eventIDs: The UUIDs of the created events.
+//
+// Returns:
+//   - An error if the insertion fails.
+func (r *Repository) RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error {
+	query := `
+		INSERT INTO job_events (job_id, event_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING;
+	`
+
+	if _, err := r.db.Exec(ctx, query, jobID, eventIDs); err != nil {
+		return fmt.Errorf("failed to record job events: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteJobEvents deletes the events created by a job of the user.
+// Events deleted since are skipped, so deleting twice deletes nothing the second time.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The number of deleted events.
+//   - An error if the deletion fails.
+func (r *Repository) DeleteJobEvents(ctx context.Context, jobID, userID uuid.UUID) (int, error) {
+	query := `
+		DELETE FROM events
+		WHERE user_id = $2 AND id IN (SELECT event_id FROM job_events WHERE job_id = $1);
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, jobID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete job events: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// FailExpired marks running jobs whose lease has expired as failed.
+// A job's lease expires when its worker stopped without finishing it, e.g. because the instance crashed;
+// such jobs are not restarted, since a partial run may already have had effects.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//
+// Returns:
+//   - The number of failed jobs.
+//   - An error if the update fails.
+func (r *Repository) FailExpired(ctx context.Context) (int, error) {
+	query := `
+		UPDATE jobs
+		SET status = 'failed',
+		    error = 'the worker running the job stopped unexpectedly',
+		    payload = NULL,
+		    locked_by = NULL,
+		    locked_until = NULL,
+		    updated_at = now(),
+		    finished_at = now()
+		WHERE status = 'running' AND locked_until < now();
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail expired jobs: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+// jobTargets returns the scan targets of jobColumns.
+func jobTargets(job *model.Job) []interface{} {
+	return []interface{}{
+		&job.ID, &job.UserID, &job.Kind, &job.Status, &job.Total, &job.Processed, &job.Result, &job.Error,
+		&job.CancelRequested, &job.OutputType, &job.CreatedAt, &job.StartedAt, &job.UpdatedAt, &job.FinishedAt,
+	}
+}
+
+// scanJob scans a row of jobColumns.
+func scanJob(row pgx.Row) (model.Job, error) {
+	var job model.Job
+	if err := row.Scan(jobTargets(&job)...); err != nil {
+		return model.Job{}, err
+	}
+
+	return job, nil
+}
@@ -0,0 +1,199 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var columns = []string{"id", "user_id", "kind", "status", "total", "processed", "result", "error", "cancel_requested",
+	"output_type", "created_at", "started_at", "updated_at", "finished_at"}
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateJob(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	job := model.Job{UserID: uuid.New(), Kind: model.JobCalendarImport, Payload: []byte("PK"), Total: 12}
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery("INSERT INTO jobs").
+		WithArgs(job.UserID, job.Kind, job.Payload, 12).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "status", "created_at", "updated_at"}).AddRow(id, model.JobQueued, now, now))
+
+	got, err := repo.CreateJob(context.Background(), job)
+	require.NoError(t, err)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, model.JobQueued, got.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ClaimNext(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id, userID, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(`UPDATE jobs\s+SET status = 'running'(.|\s)+FOR UPDATE SKIP LOCKED`).
+		WithArgs("1m0s", "host-1").
+		WillReturnRows(pgxmock.NewRows(append(columns, "payload")).
+			AddRow(id, userID, model.JobCalendarImport, model.JobRunning, 0, 0, json.RawMessage(nil), "", false, "",
+				now, &now, now, (*time.Time)(nil), []byte("PK")))
+
+	job, err := repo.ClaimNext(context.Background(), time.Minute, "host-1")
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, id, job.ID)
+	assert.Equal(t, []byte("PK"), job.Payload)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ClaimNext_Empty(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery(`UPDATE jobs`).WithArgs("1m0s", "host-1").WillReturnError(pgx.ErrNoRows)
+
+	job, err := repo.ClaimNext(context.Background(), time.Minute, "host-1")
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestRepository_Heartbeat(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	job := model.Job{ID: uuid.New(), Total: 10, Processed: 4, Result: json.RawMessage(`{"imported":4}`)}
+
+	mock.ExpectQuery(`UPDATE jobs\s+SET locked_until(.|\s)+RETURNING cancel_requested`).
+		WithArgs(job.ID, "host-1", "30s", 10, 4, []byte(`{"imported":4}`)).
+		WillReturnRows(pgxmock.NewRows([]string{"cancel_requested"}).AddRow(true))
+
+	cancel, err := repo.Heartbeat(context.Background(), job, 30*time.Second, "host-1")
+	require.NoError(t, err)
+	assert.True(t, cancel)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_FinishJob_Lost(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	job := model.Job{ID: uuid.New(), Status: model.JobCompleted}
+
+	mock.ExpectExec(`UPDATE jobs\s+SET status = \$3(.|\s)+payload = NULL`).
+		WithArgs(job.ID, "host-1", model.JobCompleted, 0, 0, []byte(nil), "", []byte(nil), "").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.FinishJob(context.Background(), job, "host-1")
+	assert.ErrorIs(t, err, ErrJobLost)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetOutput(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	jobID, userID, now := uuid.New(), uuid.New(), time.Now()
+	query := `SELECT (.|\s)+, output FROM jobs WHERE id = \$1 AND user_id = \$2`
+
+	mock.ExpectQuery(query).
+		WithArgs(jobID, userID).
+		WillReturnRows(pgxmock.NewRows(append(columns, "output")).
+			AddRow(jobID, userID, model.JobPDFExport, model.JobCompleted, 1, 1, json.RawMessage(nil), "", false,
+				"application/pdf", now, &now, now, &now, []byte("%PDF-1.4")))
+
+	job, err := repo.GetOutput(context.Background(), jobID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", job.OutputType)
+	assert.Equal(t, []byte("%PDF-1.4"), job.Output)
+
+	mock.ExpectQuery(query).
+		WithArgs(jobID, userID).
+		WillReturnRows(pgxmock.NewRows(append(columns, "output")).
+			AddRow(jobID, userID, model.JobPDFExport, model.JobRunning, 1, 0, json.RawMessage(nil), "", false,
+				"", now, &now, now, (*time.Time)(nil), []byte(nil)))
+
+	_, err = repo.GetOutput(context.Background(), jobID, userID)
+	assert.ErrorIs(t, err, ErrNoOutput)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CancelJob_Finished(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	jobID, userID, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(`UPDATE jobs\s+SET status = CASE`).
+		WithArgs(jobID, userID).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery(`SELECT (.|\s)+FROM jobs WHERE id = \$1 AND user_id = \$2`).
+		WithArgs(jobID, userID).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(jobID, userID, model.JobCalendarImport, model.JobCompleted, 3, 3, json.RawMessage(nil), "", false, "",
+				now, &now, now, &now))
+
+	_, err := repo.CancelJob(context.Background(), jobID, userID)
+	assert.ErrorIs(t, err, ErrJobFinished)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_FailExpired(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec(`UPDATE jobs\s+SET status = 'failed'(.|\s)+WHERE status = 'running' AND locked_until < now\(\)`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+	n, err := repo.FailExpired(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RecordEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	jobID, eventIDs := uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectExec(`INSERT INTO job_events(.|\s)+unnest\(\$2::uuid\[\]\)`).
+		WithArgs(jobID, eventIDs).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	require.NoError(t, repo.RecordEvents(context.Background(), jobID, eventIDs))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteJobEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	jobID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(`DELETE FROM events\s+WHERE user_id = \$2 AND id IN \(SELECT event_id FROM job_events WHERE job_id = \$1\)`).
+		WithArgs(jobID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+
+	n, err := repo.DeleteJobEvents(context.Background(), jobID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,135 @@
+package note
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrEventNotFound = errors.New("event not found")
+	ErrNoteNotFound  = errors.New("note not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// visibleEvent selects event $1 if user $2 can see it: they own it, are invited to it and have not declined,
+// or follow it.
+const visibleEvent = `
+		SELECT 1 FROM events e
+		WHERE e.id = $1 AND e.deleted_at IS NULL
+		  AND (e.user_id = $2
+		    OR EXISTS (SELECT 1 FROM event_attendees a WHERE a.event_id = e.id AND a.user_id = $2 AND a.status <> 'declined')
+		    OR EXISTS (SELECT 1 FROM event_followers f WHERE f.event_id = e.id AND f.user_id = $2))`
+
+// Repository manages interactions with the event_notes table in the PostgreSQL database.
+// It provides methods for reading, writing and deleting the private notes of users on events.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetNote retrieves the note of a user on an event they can still see.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the author of the note.
+//
+// Returns:
+//   - The note with its stored text.
+//   - ErrNoteNotFound if the user has no note on the event or can no longer see it.
+//   - An error if the query fails.
+func (r *Repository) GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error) {
+	query := `
+		SELECT n.text, n.created_at, n.updated_at
+		FROM event_notes n
+		WHERE n.event_id = $1 AND n.user_id = $2 AND EXISTS (` + visibleEvent + `);
+	`
+
+	n := model.Note{EventID: eventID, UserID: userID}
+	err := r.db.QueryRow(ctx, query, eventID, userID).Scan(&n.Text, &n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Note{}, ErrNoteNotFound
+		}
+		return model.Note{}, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	return n, nil
+}
+
+// SetNote writes the note of a user on an event they can see, replacing their previous note on it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - n: The note with the event, author and stored text.
+//
+// Returns:
+//   - The note with its creation and update times.
+//   - ErrEventNotFound if the event does not exist or the user cannot see it.
+//   - An error if the insertion fails.
+func (r *Repository) SetNote(ctx context.Context, n model.Note) (model.Note, error) {
+	query := `
+		INSERT INTO event_notes (event_id, user_id, text)
+		SELECT $1, $2, $3
+		WHERE EXISTS (` + visibleEvent + `)
+		ON CONFLICT (event_id, user_id) DO UPDATE SET text = EXCLUDED.text, updated_at = now()
+		RETURNING created_at, updated_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, n.EventID, n.UserID, n.Text).Scan(&n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Note{}, ErrEventNotFound
+		}
+		return model.Note{}, fmt.Errorf("failed to set note: %w", err)
+	}
+
+	return n, nil
+}
+
+// DeleteNote deletes the note of a user on an event.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the author of the note.
+//
+// Returns:
+//   - ErrNoteNotFound if the user has no note on the event, or another error if the deletion fails.
+func (r *Repository) DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM event_notes WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoteNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package note
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_GetNote(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("FROM event_notes n(.|\\s)+event_attendees(.|\\s)+event_followers").
+		WithArgs(eventID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"text", "created_at", "updated_at"}).AddRow("Bring the slides", now, now))
+	mock.ExpectQuery("FROM event_notes n").
+		WithArgs(eventID, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	n, err := repo.GetNote(context.Background(), eventID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Note{EventID: eventID, UserID: userID, Text: "Bring the slides", CreatedAt: now, UpdatedAt: now}, n)
+
+	_, err = repo.GetNote(context.Background(), eventID, userID)
+	assert.ErrorIs(t, err, ErrNoteNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetNote(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+	n := model.Note{EventID: eventID, UserID: userID, Text: "Bring the slides"}
+
+	mock.ExpectQuery("INSERT INTO event_notes(.|\\s)+WHERE EXISTS(.|\\s)+ON CONFLICT \\(event_id, user_id\\) DO UPDATE").
+		WithArgs(eventID, userID, "Bring the slides").
+		WillReturnRows(pgxmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+	mock.ExpectQuery("INSERT INTO event_notes").
+		WithArgs(eventID, userID, "Bring the slides").
+		WillReturnError(pgx.ErrNoRows)
+
+	got, err := repo.SetNote(context.Background(), n)
+	assert.NoError(t, err)
+	assert.Equal(t, now, got.UpdatedAt)
+
+	_, err = repo.SetNote(context.Background(), n)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteNote(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectExec("DELETE FROM event_notes").
+		WithArgs(eventID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM event_notes").
+		WithArgs(eventID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	assert.NoError(t, repo.DeleteNote(context.Background(), eventID, userID))
+	assert.ErrorIs(t, repo.DeleteNote(context.Background(), eventID, userID), ErrNoteNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/total"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the delivery feedback of sent emails in the notification_log table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateEntry appends a feedback entry to the notification log.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - entry: The feedback entry to store.
+//
+// Returns:
+//   - An error if the insertion fails.
+func (r *Repository) CreateEntry(ctx context.Context, entry model.NotificationLogEntry) error {
+	query := `
+		INSERT INTO notification_log (provider, type, recipient, reason, occurred_at)
+		VALUES ($1, $2, $3, $4, $5);
+	`
+
+	_, err := r.db.Exec(ctx, query, entry.Provider, entry.Type, entry.Recipient, entry.Reason, entry.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("failed to create notification log entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListEntries retrieves a page of the entries of the notification log, newest first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - recipient: The email address to filter by; empty returns entries of all recipients.
+//   - page: The page to return; one entry more than the limit is read.
+//
+// Returns:
+//   - A slice of notification log entries.
+//   - An error if the query fails.
+func (r *Repository) ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error) {
+	query := `
+		SELECT id, provider, type, recipient, reason, occurred_at, created_at
+		FROM notification_log
+		WHERE $1 = '' OR recipient = $1
+		ORDER BY occurred_at DESC, id
+		LIMIT $2 OFFSET $3;
+	`
+
+	rows, err := r.db.Query(ctx, query, recipient, page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.NotificationLogEntry
+	for rows.Next() {
+		var e model.NotificationLogEntry
+		if err := rows.Scan(&e.ID, &e.Provider, &e.Type, &e.Recipient, &e.Reason, &e.OccurredAt, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification log entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// CountEntries counts the entries of the notification log.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - recipient: The email address to filter by; empty counts entries of all recipients.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of entries.
+//   - An error if the query fails.
+func (r *Repository) CountEntries(ctx context.Context, recipient string, mode string) (int, error) {
+	return total.Count(ctx, r.db, mode, `SELECT 1 FROM notification_log WHERE $1 = '' OR recipient = $1`, recipient)
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateEntry(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	entry := model.NotificationLogEntry{
+		Provider:   "ses",
+		Type:       model.FeedbackBounce,
+		Recipient:  "gone@example.com",
+		Reason:     "550 5.1.1 user unknown",
+		OccurredAt: time.Now(),
+	}
+
+	mock.ExpectExec("INSERT INTO notification_log").
+		WithArgs(entry.Provider, entry.Type, entry.Recipient, entry.Reason, entry.OccurredAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err := repo.CreateEntry(context.Background(), entry)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListEntries(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT id, provider, type, recipient, reason, occurred_at, created_at\\s+FROM notification_log(.|\\s)+LIMIT \\$2 OFFSET \\$3").
+		WithArgs("gone@example.com", 51, 0).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "provider", "type", "recipient", "reason", "occurred_at", "created_at"}).
+				AddRow(uuid.New(), "mailgun", model.FeedbackComplaint, "gone@example.com", "", time.Now(), time.Now()),
+		)
+
+	entries, err := repo.ListEntries(context.Background(), "gone@example.com", model.Page{Limit: 50})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, model.FeedbackComplaint, entries[0].Type)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountEntries(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("EXPLAIN \\(FORMAT JSON\\) SELECT 1 FROM notification_log").
+		WithArgs("").
+		WillReturnRows(pgxmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan": {"Plan Rows": 48213}}]`))
+
+	n, err := repo.CountEntries(context.Background(), "", model.TotalEstimated)
+	assert.NoError(t, err)
+	assert.Equal(t, 48213, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
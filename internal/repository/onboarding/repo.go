@@ -0,0 +1,145 @@
+package onboarding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the sample data of new users in the user_onboarding table
+// and derives their progress through the guided setup from the tables the steps write to.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// StartSeed marks the sample data of a user as being created.
+// Only the first call for a user succeeds, so concurrent requests cannot seed twice.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - Whether the user was marked; false if the sample data was already created.
+//   - An error if the insertion fails.
+func (r *Repository) StartSeed(ctx context.Context, userID uuid.UUID) (bool, error) {
+	cmdTag, err := r.db.Exec(ctx, `INSERT INTO user_onboarding (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING`, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to start onboarding seed: %w", err)
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
+
+// CompleteSeed records the sample project and views of a user, so they do not count as completed steps.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - seed: The sample data.
+//
+// Returns:
+//   - An error if the update fails.
+func (r *Repository) CompleteSeed(ctx context.Context, userID uuid.UUID, seed model.OnboardingSeed) error {
+	query := `UPDATE user_onboarding SET project_id = $2, view_ids = $3 WHERE user_id = $1`
+
+	if _, err := r.db.Exec(ctx, query, userID, seed.ProjectID, seed.ViewIDs); err != nil {
+		return fmt.Errorf("failed to complete onboarding seed: %w", err)
+	}
+
+	return nil
+}
+
+// ClearSeed removes the mark of a user, so the sample data can be created again.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (r *Repository) ClearSeed(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM user_onboarding WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to clear onboarding seed: %w", err)
+	}
+
+	return nil
+}
+
+// GetStatus retrieves the progress of a user through the guided setup.
+// A step is completed once the user created the corresponding data outside the sample project and views.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The onboarding status with all steps in suggested order.
+//   - An error if the query fails.
+func (r *Repository) GetStatus(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error) {
+	query := `
+		SELECT o.seeded_at,
+		       EXISTS (SELECT 1 FROM projects p
+		               WHERE p.user_id = u.id AND p.id IS DISTINCT FROM o.project_id),
+		       EXISTS (SELECT 1 FROM events e
+		               WHERE e.user_id = u.id AND (o.project_id IS NULL OR e.project_id IS DISTINCT FROM o.project_id)),
+		       EXISTS (SELECT 1 FROM reminders r JOIN events e ON e.id = r.event_id
+		               WHERE r.user_id = u.id AND (o.project_id IS NULL OR e.project_id IS DISTINCT FROM o.project_id)),
+		       EXISTS (SELECT 1 FROM views v
+		               WHERE v.user_id = u.id AND v.id <> ALL (COALESCE(o.view_ids, '{}'))),
+		       EXISTS (SELECT 1 FROM event_rules WHERE user_id = u.id),
+		       EXISTS (SELECT 1 FROM feeds WHERE user_id = u.id) OR EXISTS (SELECT 1 FROM embeds WHERE user_id = u.id)
+		FROM (SELECT $1::uuid AS id) u
+		LEFT JOIN user_onboarding o ON o.user_id = u.id;
+	`
+
+	var (
+		status   model.OnboardingStatus
+		seededAt *time.Time
+		done     [6]bool
+	)
+	err := r.db.QueryRow(ctx, query, userID).Scan(&seededAt, &done[0], &done[1], &done[2], &done[3], &done[4], &done[5])
+	if err != nil {
+		return model.OnboardingStatus{}, fmt.Errorf("failed to get onboarding status: %w", err)
+	}
+
+	status.SeededAt = seededAt
+	for i, name := range []string{
+		model.StepCreateProject,
+		model.StepCreateEvent,
+		model.StepSetReminder,
+		model.StepSaveView,
+		model.StepAddRule,
+		model.StepShareCalendar,
+	} {
+		status.Steps = append(status.Steps, model.OnboardingStep{Name: name, Completed: done[i]})
+	}
+
+	return status, nil
+}
@@ -0,0 +1,97 @@
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_StartSeed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectExec("INSERT INTO user_onboarding").
+		WithArgs(userID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO user_onboarding").
+		WithArgs(userID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	started, err := repo.StartSeed(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.True(t, started)
+
+	started, err = repo.StartSeed(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.False(t, started, "the sample data is only created once")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CompleteSeed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	seed := model.OnboardingSeed{ProjectID: uuid.New(), ViewIDs: []uuid.UUID{uuid.New()}}
+
+	mock.ExpectExec("UPDATE user_onboarding").
+		WithArgs(userID, seed.ProjectID, seed.ViewIDs).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	assert.NoError(t, repo.CompleteSeed(context.Background(), userID, seed))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ClearSeed_Error(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM user_onboarding").
+		WithArgs(pgxmock.AnyArg()).
+		WillReturnError(errors.New("db error"))
+
+	assert.Error(t, repo.ClearSeed(context.Background(), uuid.New()))
+}
+
+func TestRepository_GetStatus(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	seededAt := time.Now()
+
+	mock.ExpectQuery("LEFT JOIN user_onboarding").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"seeded_at", "project", "event", "reminder", "view", "rule", "share"}).
+			AddRow(&seededAt, true, true, false, false, false, true))
+
+	status, err := repo.GetStatus(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, &seededAt, status.SeededAt)
+	assert.Equal(t, []model.OnboardingStep{
+		{Name: model.StepCreateProject, Completed: true},
+		{Name: model.StepCreateEvent, Completed: true},
+		{Name: model.StepSetReminder},
+		{Name: model.StepSaveView},
+		{Name: model.StepAddRule},
+		{Name: model.StepShareCalendar, Completed: true},
+	}, status.Steps)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,99 @@
+package preference
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages interactions with the notification_preferences table in the PostgreSQL database.
+// Only categories a user changed are stored; the service applies the defaults of the others.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListPreferences retrieves the stored notification preferences of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The preferences of the categories the user changed.
+//   - An error if the query fails.
+func (r *Repository) ListPreferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error) {
+	query := `
+		SELECT category, subscribed, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+		ORDER BY category;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification preferences: %w", err)
+	}
+	defer rows.Close()
+
+	var prefs []model.NotificationPreference
+	for rows.Next() {
+		var p model.NotificationPreference
+		if err := rows.Scan(&p.Category, &p.Subscribed, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification preference: %w", err)
+		}
+		prefs = append(prefs, p)
+	}
+
+	return prefs, rows.Err()
+}
+
+// SetPreference subscribes a user to a notification category or unsubscribes them from it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - category: The notification category.
+//   - subscribed: Whether notifications of the category are sent.
+//
+// Returns:
+//   - The stored preference.
+//   - An error if the upsert fails.
+func (r *Repository) SetPreference(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error) {
+	query := `
+		INSERT INTO notification_preferences (user_id, category, subscribed)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, category) DO UPDATE SET subscribed = EXCLUDED.subscribed, updated_at = now()
+		RETURNING category, subscribed, updated_at;
+	`
+
+	var p model.NotificationPreference
+	if err := r.db.QueryRow(ctx, query, userID, category, subscribed).Scan(&p.Category, &p.Subscribed, &p.UpdatedAt); err != nil {
+		return model.NotificationPreference{}, fmt.Errorf("failed to set notification preference: %w", err)
+	}
+
+	return p, nil
+}
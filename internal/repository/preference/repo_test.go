@@ -0,0 +1,58 @@
+package preference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListPreferences(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT category, subscribed, updated_at\\s+FROM notification_preferences\\s+WHERE user_id = \\$1").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"category", "subscribed", "updated_at"}).AddRow(model.NotificationReminders, false, &now))
+
+	prefs, err := repo.ListPreferences(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Len(t, prefs, 1)
+	assert.Equal(t, model.NotificationReminders, prefs[0].Category)
+	assert.False(t, prefs[0].Subscribed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetPreference(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO notification_preferences(.|\\s)+ON CONFLICT \\(user_id, category\\) DO UPDATE").
+		WithArgs(userID, model.NotificationReminders, false).
+		WillReturnRows(pgxmock.NewRows([]string{"category", "subscribed", "updated_at"}).AddRow(model.NotificationReminders, false, &now))
+
+	p, err := repo.SetPreference(context.Background(), userID, model.NotificationReminders, false)
+	assert.NoError(t, err)
+	assert.False(t, p.Subscribed)
+	assert.NotNil(t, p.UpdatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,345 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrTaskNotFound    = errors.New("task not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages interactions with the projects and project_tasks tables in the PostgreSQL database.
+// It provides methods for managing projects, their tasks, and reading the events assigned to them.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateProject inserts a new project into the projects table and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - project: The project data to be inserted.
+//
+// Returns:
+//   - The UUID of the created project.
+//   - An error if the insertion fails.
+func (r *Repository) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	query := `
+		INSERT INTO projects (user_id, name, milestone_date)
+		VALUES ($1, $2, $3)
+		RETURNING id;
+	`
+
+	err := r.db.QueryRow(ctx, query, project.UserID, project.Name, project.MilestoneDate).Scan(&project.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return project.ID, nil
+}
+
+// GetProject retrieves a project by its ID for the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - The project.
+//   - An error if the query fails or if the project is not found.
+func (r *Repository) GetProject(ctx context.Context, projectID, userID uuid.UUID) (model.Project, error) {
+	query := `
+		SELECT id, user_id, name, milestone_date, created_at, updated_at
+		FROM projects
+		WHERE id = $1 AND user_id = $2;
+	`
+
+	var p model.Project
+	err := r.db.QueryRow(ctx, query, projectID, userID).Scan(
+		&p.ID, &p.UserID, &p.Name, &p.MilestoneDate, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Project{}, ErrProjectNotFound
+		}
+		return model.Project{}, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return p, nil
+}
+
+// ListProjects retrieves all projects of a user, ordered by milestone date.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose projects are retrieved.
+//
+// Returns:
+//   - A slice of projects.
+//   - An error if the query fails.
+func (r *Repository) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	query := `
+		SELECT id, user_id, name, milestone_date, created_at, updated_at
+		FROM projects
+		WHERE user_id = $1
+		ORDER BY milestone_date NULLS LAST, created_at;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projects []model.Project
+	for rows.Next() {
+		var p model.Project
+		if err := rows.Scan(&p.ID, &p.UserID, &p.Name, &p.MilestoneDate, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
+		}
+		projects = append(projects, p)
+	}
+
+	return projects, rows.Err()
+}
+
+// DeleteProject deletes a project and its tasks. Events of the project are kept
+// and no longer belong to any project.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project to delete.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - An error if the deletion fails or if the project is not found.
+func (r *Repository) DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Detach events from the project.
+	_, err = tx.Exec(ctx, `UPDATE events SET project_id = NULL WHERE project_id = $1 AND user_id = $2`, projectID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to detach project events: %w", err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM projects WHERE id = $1 AND user_id = $2`, projectID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrProjectNotFound
+	}
+
+	// Commit the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTask inserts a new task into a project of the specified user and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - task: The task data to be inserted.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - The UUID of the created task.
+//   - An error if the insertion fails or if the project is not found.
+func (r *Repository) CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error) {
+	query := `
+		INSERT INTO project_tasks (project_id, title, due_date)
+		SELECT id, $2, $3
+		FROM projects
+		WHERE id = $1 AND user_id = $4
+		RETURNING id;
+	`
+
+	err := r.db.QueryRow(ctx, query, task.ProjectID, task.Title, task.DueDate, userID).Scan(&task.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrProjectNotFound
+		}
+		return uuid.Nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return task.ID, nil
+}
+
+// SetTaskDone marks a task of a project as completed or not completed.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project the task belongs to.
+//   - taskID: The UUID of the task.
+//   - userID: The UUID of the user who owns the project.
+//   - done: Whether the task is completed.
+//
+// Returns:
+//   - An error if the update fails or if the task is not found.
+func (r *Repository) SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error {
+	query := `
+		UPDATE project_tasks t
+		SET done = $4,
+		    updated_at = now()
+		FROM projects p
+		WHERE t.id = $2 AND t.project_id = $1
+		  AND p.id = t.project_id AND p.user_id = $3;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, projectID, taskID, userID, done)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrTaskNotFound
+	}
+
+	return nil
+}
+
+// ListTasks retrieves all tasks of a project, ordered by due date.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project.
+//
+// Returns:
+//   - A slice of tasks.
+//   - An error if the query fails.
+func (r *Repository) ListTasks(ctx context.Context, projectID uuid.UUID) ([]model.ProjectTask, error) {
+	query := `
+		SELECT id, project_id, title, due_date, done, created_at, updated_at
+		FROM project_tasks
+		WHERE project_id = $1
+		ORDER BY due_date NULLS LAST, created_at;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []model.ProjectTask
+	for rows.Next() {
+		var t model.ProjectTask
+		if err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.DueDate, &t.Done, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks, rows.Err()
+}
+
+// ListEvents retrieves the events assigned to a project, ordered by event date.
+// Only the fields needed for the timeline are selected.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project.
+//
+// Returns:
+//   - A slice of events with ID, title and event date set.
+//   - An error if the query fails.
+func (r *Repository) ListEvents(ctx context.Context, projectID uuid.UUID) ([]model.Event, error) {
+	query := `
+		SELECT id, title, event_date
+		FROM events
+		WHERE project_id = $1 AND deleted_at IS NULL
+		ORDER BY event_date;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query project events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(&e.ID, &e.Title, &e.EventDate); err != nil {
+			return nil, fmt.Errorf("failed to scan project event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// ListEventLinks retrieves the links of the events assigned to a project.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - projectID: The UUID of the project.
+//
+// Returns:
+//   - A slice of event links.
+//   - An error if the query fails.
+func (r *Repository) ListEventLinks(ctx context.Context, projectID uuid.UUID) ([]model.EventLink, error) {
+	query := `
+		SELECT l.event_id, l.related_event_id, l.type, l.created_at
+		FROM event_links l
+		JOIN events e ON e.id = l.event_id
+		WHERE e.project_id = $1 AND e.deleted_at IS NULL;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query project event links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []model.EventLink
+	for rows.Next() {
+		var l model.EventLink
+		if err := rows.Scan(&l.EventID, &l.RelatedEventID, &l.Type, &l.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event link: %w", err)
+		}
+		links = append(links, l)
+	}
+
+	return links, rows.Err()
+}
@@ -0,0 +1,106 @@
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateProject(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	milestone := time.Now().Add(30 * 24 * time.Hour)
+	project := model.Project{UserID: uuid.New(), Name: "Launch", MilestoneDate: &milestone}
+
+	mock.ExpectQuery("INSERT INTO projects").
+		WithArgs(project.UserID, project.Name, project.MilestoneDate).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+
+	gotID, err := repo.CreateProject(context.Background(), project)
+	assert.NoError(t, err)
+	assert.Equal(t, id, gotID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetProject_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	projectID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, name, milestone_date, created_at, updated_at\\s+FROM projects").
+		WithArgs(projectID, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetProject(context.Background(), projectID, userID)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteProject(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	projectID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE events SET project_id = NULL").
+		WithArgs(projectID, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	mock.ExpectExec("DELETE FROM projects").
+		WithArgs(projectID, userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteProject(context.Background(), projectID, userID)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateTask_ProjectNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	task := model.ProjectTask{ProjectID: uuid.New(), Title: "Write docs"}
+	userID := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO project_tasks").
+		WithArgs(task.ProjectID, task.Title, task.DueDate, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.CreateTask(context.Background(), task, userID)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetTaskDone_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	projectID, taskID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectExec("UPDATE project_tasks").
+		WithArgs(projectID, taskID, userID, true).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.SetTaskDone(context.Background(), projectID, taskID, userID, true)
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,349 @@
+package proposal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/schedule"
+)
+
+var (
+	ErrEventNotFound    = errors.New("event not found")
+	ErrProposalNotFound = errors.New("proposal not found")
+	ErrProposalDecided  = errors.New("proposal already accepted or declined")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// querier is the subset of pgxPool and pgx.Tx used by statements that run both inside and outside a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// proposalColumns lists the selected proposal columns in the order scanProposal reads them.
+const proposalColumns = "p.id, p.event_id, p.user_id, u.email, p.event_date, p.end_date, p.status, p.created_at, p.decided_at"
+
+// Repository manages interactions with the event_proposals table in the PostgreSQL database.
+// It provides methods for proposing new times for events and for deciding on the proposals.
+type Repository struct {
+	db    pgxPool     // Database connection pool
+	clock clock.Clock // Source of the current time for rescheduling reminders
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//   - clk: The clock reminders of moved events are rescheduled against.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool, clk clock.Clock) *Repository {
+	return &Repository{
+		db:    db,
+		clock: clk,
+	}
+}
+
+// GetAttendedEvent retrieves an event the user is invited to and has not declined.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the attendee.
+//
+// Returns:
+//   - The event with its ID, owner, stored title, date, end and recurrence rule.
+//   - ErrEventNotFound if the event does not exist or the user does not attend it.
+//   - An error if the query fails.
+func (r *Repository) GetAttendedEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	query := `
+		SELECT e.id, e.user_id, e.title, e.event_date, e.end_date, e.recurrence_rule
+		FROM events e
+		WHERE e.id = $1 AND e.deleted_at IS NULL
+		  AND EXISTS (SELECT 1 FROM event_attendees a WHERE a.event_id = e.id AND a.user_id = $2 AND a.status <> 'declined');
+	`
+
+	var e model.Event
+	err := r.db.QueryRow(ctx, query, eventID, userID).Scan(&e.ID, &e.UserID, &e.Title, &e.EventDate, &e.EndDate, &e.RecurrenceRule)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, ErrEventNotFound
+		}
+		return model.Event{}, fmt.Errorf("failed to get attended event: %w", err)
+	}
+
+	return e, nil
+}
+
+// CreateProposal stores a new time proposed by an attendee.
+// A pending proposal of the same attendee for the event is replaced.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - p: The proposal with the event, attendee, proposed date and optional end.
+//
+// Returns:
+//   - The proposal with its ID, status and creation time.
+//   - An error if the insertion fails.
+func (r *Repository) CreateProposal(ctx context.Context, p model.Proposal) (model.Proposal, error) {
+	query := `
+		INSERT INTO event_proposals (event_id, user_id, event_date, end_date)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_id, user_id) WHERE status = 'pending'
+		DO UPDATE SET event_date = EXCLUDED.event_date, end_date = EXCLUDED.end_date, created_at = now()
+		RETURNING id, status, created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, p.EventID, p.UserID, p.EventDate, p.EndDate).Scan(&p.ID, &p.Status, &p.CreatedAt)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("failed to create proposal: %w", err)
+	}
+
+	return p, nil
+}
+
+// ListProposals retrieves the proposals of an event, newest first: all of them for the owner of the event,
+// the user's own ones for an attendee. Proposals of trashed events are not listed.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user requesting the list.
+//
+// Returns:
+//   - A slice of proposals.
+//   - An error if the query fails.
+func (r *Repository) ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error) {
+	query := `
+		SELECT ` + proposalColumns + `
+		FROM event_proposals p
+		JOIN users u ON u.id = p.user_id
+		JOIN events e ON e.id = p.event_id AND e.deleted_at IS NULL
+		WHERE p.event_id = $1
+		  AND (p.user_id = $2 OR e.user_id = $2)
+		ORDER BY p.created_at DESC;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proposals: %w", err)
+	}
+	defer rows.Close()
+
+	var proposals []model.Proposal
+	for rows.Next() {
+		p, err := scanProposal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan proposal: %w", err)
+		}
+		proposals = append(proposals, p)
+	}
+
+	return proposals, rows.Err()
+}
+
+// GetProposal retrieves a proposal for an event of the owner, together with the event.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - proposalID: The UUID of the proposal.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The proposal.
+//   - The event with its ID, owner and stored title.
+//   - ErrProposalNotFound if the owner has no such proposal for the event.
+//   - An error if the query fails.
+func (r *Repository) GetProposal(ctx context.Context, proposalID, eventID, ownerID uuid.UUID) (model.Proposal, model.Event, error) {
+	query := `
+		SELECT ` + proposalColumns + `, e.id, e.user_id, e.title
+		FROM event_proposals p
+		JOIN users u ON u.id = p.user_id
+		JOIN events e ON e.id = p.event_id
+		WHERE p.id = $1 AND p.event_id = $2 AND e.user_id = $3 AND e.deleted_at IS NULL;
+	`
+
+	var p model.Proposal
+	var e model.Event
+	err := r.db.QueryRow(ctx, query, proposalID, eventID, ownerID).Scan(
+		&p.ID, &p.EventID, &p.UserID, &p.Email, &p.EventDate, &p.EndDate, &p.Status, &p.CreatedAt, &p.DecidedAt,
+		&e.ID, &e.UserID, &e.Title,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Proposal{}, model.Event{}, ErrProposalNotFound
+		}
+		return model.Proposal{}, model.Event{}, fmt.Errorf("failed to get proposal: %w", err)
+	}
+
+	return p, e, nil
+}
+
+// AcceptProposal accepts a pending proposal and moves its event to the proposed time, in one transaction.
+// Without a proposed end, the event keeps its duration. The reminder time moves by the same offset as the event,
+// and the pending reminders of the event, including those of its followers, move with it.
+// The other pending proposals of the event are declined.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - proposalID: The UUID of the proposal.
+//
+// Returns:
+//   - The accepted proposal, without the attendee's email.
+//   - ErrProposalDecided if the proposal is no longer pending.
+//   - ErrEventNotFound if the event was moved to the trash meanwhile.
+//   - An error if an update fails.
+func (r *Repository) AcceptProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	p, err := decide(ctx, tx, proposalID, model.ProposalAccepted)
+	if err != nil {
+		return model.Proposal{}, err
+	}
+
+	// The end and the reminder are computed from the old date, since SET expressions see the row before the update.
+	var event model.Event
+	err = tx.QueryRow(ctx, `
+		UPDATE events
+		SET end_date = COALESCE($3::timestamptz, end_date + ($2::timestamptz - event_date)),
+		    reminder_at = reminder_at + ($2::timestamptz - event_date),
+		    event_date = $2,
+		    updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, user_id, title, reminder_at, reminder_timezone;
+	`, p.EventID, p.EventDate, p.EndDate).Scan(&event.ID, &event.UserID, &event.Title, &event.ReminderAt, &event.ReminderTimezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Proposal{}, ErrEventNotFound
+		}
+		return model.Proposal{}, fmt.Errorf("failed to move event: %w", err)
+	}
+
+	// The wall-clock time of a zoned reminder is stored in its time zone.
+	if event.ReminderAt != nil && event.ReminderTimezone != "" {
+		loc, err := time.LoadLocation(event.ReminderTimezone)
+		if err != nil {
+			return model.Proposal{}, fmt.Errorf("failed to load reminder time zone: %w", err)
+		}
+		at := event.ReminderAt.In(loc)
+		event.ReminderAt = &at
+	}
+
+	if err := schedule.Reminders(ctx, tx, event, r.clock.Now()); err != nil {
+		return model.Proposal{}, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE event_proposals
+		SET status = 'declined', decided_at = now()
+		WHERE event_id = $1 AND status = 'pending';
+	`, p.EventID)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("failed to decline other proposals: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.Proposal{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return p, nil
+}
+
+// DeclineProposal declines a pending proposal; its event is not changed.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - proposalID: The UUID of the proposal.
+//
+// Returns:
+//   - The declined proposal, without the attendee's email.
+//   - ErrProposalDecided if the proposal is no longer pending.
+//   - An error if the update fails.
+func (r *Repository) DeclineProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error) {
+	return decide(ctx, r.db, proposalID, model.ProposalDeclined)
+}
+
+// AttendeeEmails retrieves the email addresses of the attendees of an event who have not declined it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - The email addresses, sorted.
+//   - An error if the query fails.
+func (r *Repository) AttendeeEmails(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	query := `
+		SELECT u.email
+		FROM event_attendees a
+		JOIN users u ON u.id = a.user_id
+		WHERE a.event_id = $1 AND a.status <> 'declined'
+		ORDER BY u.email;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendee emails: %w", err)
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("failed to scan attendee email: %w", err)
+		}
+		emails = append(emails, email)
+	}
+
+	return emails, rows.Err()
+}
+
+// decide moves a pending proposal to the given status.
+// The status condition makes concurrent decisions on the same proposal fail instead of both applying.
+func decide(ctx context.Context, tx querier, proposalID uuid.UUID, status string) (model.Proposal, error) {
+	query := `
+		UPDATE event_proposals
+		SET status = $2, decided_at = now()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING id, event_id, user_id, event_date, end_date, status, created_at, decided_at;
+	`
+
+	var p model.Proposal
+	err := tx.QueryRow(ctx, query, proposalID, status).
+		Scan(&p.ID, &p.EventID, &p.UserID, &p.EventDate, &p.EndDate, &p.Status, &p.CreatedAt, &p.DecidedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Proposal{}, ErrProposalDecided
+		}
+		return model.Proposal{}, fmt.Errorf("failed to update proposal: %w", err)
+	}
+
+	return p, nil
+}
+
+// scanProposal scans a row of proposalColumns.
+func scanProposal(row pgx.Row) (model.Proposal, error) {
+	var p model.Proposal
+	err := row.Scan(&p.ID, &p.EventID, &p.UserID, &p.Email, &p.EventDate, &p.EndDate, &p.Status, &p.CreatedAt, &p.DecidedAt)
+	return p, err
+}
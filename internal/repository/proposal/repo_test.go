@@ -0,0 +1,149 @@
+package proposal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var testNow = time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock, clock.NewFake(testNow)), mock
+}
+
+func TestRepository_GetAttendedEvent_NotAttending(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	mock.ExpectQuery("FROM events e(.|\\s)+event_attendees a(.|\\s)+a.status <> 'declined'").
+		WithArgs(eventID, userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetAttendedEvent(context.Background(), eventID, userID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateProposal(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	p := model.Proposal{EventID: uuid.New(), UserID: uuid.New(), EventDate: time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)}
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery("INSERT INTO event_proposals(.|\\s)+ON CONFLICT \\(event_id, user_id\\) WHERE status = 'pending'").
+		WithArgs(p.EventID, p.UserID, p.EventDate, p.EndDate).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "status", "created_at"}).AddRow(id, model.ProposalPending, now))
+
+	created, err := repo.CreateProposal(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, id, created.ID)
+	assert.Equal(t, model.ProposalPending, created.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListProposals(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id, eventID, userID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	mock.ExpectQuery("FROM event_proposals p(.|\\s)+JOIN events e ON e.id = p.event_id AND e.deleted_at IS NULL").
+		WithArgs(eventID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "event_id", "user_id", "email", "event_date", "end_date", "status", "created_at", "decided_at"}).
+			AddRow(id, eventID, userID, "ann@example.com", date, (*time.Time)(nil), model.ProposalPending, now, (*time.Time)(nil)))
+
+	proposals, err := repo.ListProposals(context.Background(), eventID, userID)
+	assert.NoError(t, err)
+	assert.Len(t, proposals, 1)
+	assert.Equal(t, "ann@example.com", proposals[0].Email)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AcceptProposal(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id, eventID, userID, ownerID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	remindAt := date.Add(-15 * time.Minute)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE event_proposals\\s+SET status = \\$2, decided_at = now\\(\\)\\s+WHERE id = \\$1 AND status = 'pending'").
+		WithArgs(id, model.ProposalAccepted).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "event_id", "user_id", "event_date", "end_date", "status", "created_at", "decided_at"}).
+			AddRow(id, eventID, userID, date, (*time.Time)(nil), model.ProposalAccepted, now, &now))
+	mock.ExpectQuery("UPDATE events\\s+SET end_date = COALESCE(.|\\s)+reminder_at = reminder_at \\+ (.|\\s)+event_date = \\$2(.|\\s)+WHERE id = \\$1 AND deleted_at IS NULL").
+		WithArgs(eventID, date, (*time.Time)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "title", "reminder_at", "reminder_timezone"}).
+			AddRow(eventID, ownerID, "Planning", &remindAt, ""))
+	// The pending reminders of the event move along, released from any worker sending them at the old time.
+	mock.ExpectExec("UPDATE reminders\\s+SET locked_by = CASE WHEN remind_at = \\$2 THEN locked_by END(.|\\s)+attempts = CASE WHEN remind_at = \\$2 THEN attempts ELSE 0 END,\\s+last_error = CASE WHEN remind_at = \\$2 THEN last_error END(.|\\s)+WHERE event_id = \\$1 AND status = 'pending'").
+		WithArgs(eventID, remindAt, (*string)(nil), (*time.Time)(nil), ownerID, "Planning").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	mock.ExpectExec("INSERT INTO reminders").
+		WithArgs(eventID, ownerID, "Planning", remindAt, (*string)(nil), (*time.Time)(nil)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mock.ExpectExec("UPDATE event_proposals\\s+SET status = 'declined'(.|\\s)+WHERE event_id = \\$1 AND status = 'pending'").
+		WithArgs(eventID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectCommit()
+
+	p, err := repo.AcceptProposal(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ProposalAccepted, p.Status)
+	assert.Equal(t, eventID, p.EventID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AcceptProposal_EventTrashed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id, eventID, userID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE event_proposals").
+		WithArgs(id, model.ProposalAccepted).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "event_id", "user_id", "event_date", "end_date", "status", "created_at", "decided_at"}).
+			AddRow(id, eventID, userID, date, (*time.Time)(nil), model.ProposalAccepted, now, &now))
+	mock.ExpectQuery("UPDATE events(.|\\s)+deleted_at IS NULL").
+		WithArgs(eventID, date, (*time.Time)(nil)).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.AcceptProposal(context.Background(), id)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeclineProposal_Decided(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	mock.ExpectQuery("UPDATE event_proposals").WithArgs(id, model.ProposalDeclined).WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.DeclineProposal(context.Background(), id)
+	assert.ErrorIs(t, err, ErrProposalDecided)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,517 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/total"
+)
+
+var (
+	ErrReminderNotFound = errors.New("reminder not found")
+	ErrReminderLost     = errors.New("reminder is no longer leased to this worker")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages interactions with the reminders table in the PostgreSQL database.
+// Reminders are claimed with row-level locks and leases, so several service instances
+// can dispatch them concurrently without sending duplicates.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ClaimDue locks a batch of due pending reminders for the given owner until the lease expires.
+// Rows locked by other transactions are skipped, and reminders whose lease has expired
+// (e.g. because their owner crashed) are claimed again. Reminders of trashed events are left pending.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - limit: The maximum number of reminders to claim.
+//   - lease: How long the claimed reminders stay reserved for the owner.
+//   - owner: The identifier of the claiming worker instance.
+//
+// Returns:
+//   - A slice of claimed reminders, ordered by remind_at, with the current location of their events and,
+//     for the reminders of the event owners, the notification overrides of the events.
+//   - An error if the query fails.
+func (r *Repository) ClaimDue(ctx context.Context, limit int, lease time.Duration, owner string) ([]model.Reminder, error) {
+	query := `
+		UPDATE reminders
+		SET locked_by = $3,
+		    locked_until = now() + $2::interval,
+		    attempts = attempts + 1,
+		    updated_at = now()
+		WHERE id IN (
+		    SELECT id
+		    FROM reminders
+		    WHERE status = 'pending'
+		      AND remind_at <= now()
+		      AND (locked_until IS NULL OR locked_until < now())
+		      AND NOT EXISTS (SELECT 1 FROM events e WHERE e.id = reminders.event_id AND e.deleted_at IS NOT NULL)
+		    ORDER BY remind_at
+		    LIMIT $1
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, event_id, message, remind_at, status, attempts,
+		          COALESCE((SELECT location FROM events WHERE events.id = reminders.event_id), ''),
+		          (SELECT notifications FROM events WHERE events.id = reminders.event_id AND events.user_id = reminders.user_id);
+	`
+
+	rows, err := r.db.Query(ctx, query, limit, lease.String(), owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []model.Reminder
+	for rows.Next() {
+		var rem model.Reminder
+		if err := rows.Scan(&rem.ID, &rem.UserID, &rem.EventID, &rem.Message, &rem.RemindAt, &rem.Status, &rem.Attempts,
+			&rem.Location, &rem.Notifications); err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, rem)
+	}
+
+	return reminders, rows.Err()
+}
+
+// MarkSent marks a reminder as delivered and releases its lease.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - owner: The identifier of the worker instance holding the lease.
+//
+// Returns:
+//   - ErrReminderLost if the reminder is no longer leased to the owner, e.g. because it was claimed again
+//     after the lease expired, moved or deleted with its event; or another error if the update fails.
+func (r *Repository) MarkSent(ctx context.Context, id uuid.UUID, owner string) error {
+	query := `
+		UPDATE reminders
+		SET status = 'sent',
+		    sent_at = now(),
+		    locked_by = NULL,
+		    locked_until = NULL,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, owner)
+	if err != nil {
+		return fmt.Errorf("failed to mark reminder sent: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderLost
+	}
+
+	return nil
+}
+
+// MarkSkipped marks a reminder that is not sent because its user unsubscribed from reminders,
+// and releases its lease.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - owner: The identifier of the worker instance holding the lease.
+//
+// Returns:
+//   - ErrReminderLost if the reminder is no longer leased to the owner, e.g. because it was claimed again
+//     after the lease expired, moved or deleted with its event; or another error if the update fails.
+func (r *Repository) MarkSkipped(ctx context.Context, id uuid.UUID, owner string) error {
+	query := `
+		UPDATE reminders
+		SET status = 'skipped',
+		    locked_by = NULL,
+		    locked_until = NULL,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, owner)
+	if err != nil {
+		return fmt.Errorf("failed to mark reminder skipped: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderLost
+	}
+
+	return nil
+}
+
+// Retry keeps a reminder pending after a failed attempt and delays its redelivery.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - owner: The identifier of the worker instance holding the lease.
+//   - retryAt: The earliest time the reminder may be claimed again.
+//   - reason: The error that caused the failed attempt.
+//
+// Returns:
+//   - ErrReminderLost if the reminder is no longer leased to the owner, e.g. because it was claimed again
+//     after the lease expired, moved or deleted with its event; or another error if the update fails.
+func (r *Repository) Retry(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error {
+	query := `
+		UPDATE reminders
+		SET locked_by = NULL,
+		    locked_until = $3,
+		    last_error = $4,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, owner, retryAt, reason)
+	if err != nil {
+		return fmt.Errorf("failed to retry reminder: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderLost
+	}
+
+	return nil
+}
+
+// Defer keeps a claimed reminder pending until retryAt without counting the claim as a delivery attempt,
+// for reminders that were not sent because their recipient is throttled.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - owner: The identifier of the worker instance holding the lease.
+//   - retryAt: The earliest time the reminder may be claimed again.
+//   - reason: Why the reminder was deferred.
+//
+// Returns:
+//   - ErrReminderLost if the reminder is no longer leased to the owner, e.g. because it was claimed again
+//     after the lease expired, moved or deleted with its event; or another error if the update fails.
+func (r *Repository) Defer(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error {
+	query := `
+		UPDATE reminders
+		SET locked_by = NULL,
+		    locked_until = $3,
+		    attempts = GREATEST(attempts - 1, 0),
+		    last_error = $4,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, owner, retryAt, reason)
+	if err != nil {
+		return fmt.Errorf("failed to defer reminder: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderLost
+	}
+
+	return nil
+}
+
+// MarkFailed marks a reminder as permanently failed and releases its lease.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - owner: The identifier of the worker instance holding the lease.
+//   - reason: The error that caused the last failed attempt.
+//
+// Returns:
+//   - ErrReminderLost if the reminder is no longer leased to the owner, e.g. because it was claimed again
+//     after the lease expired, moved or deleted with its event; or another error if the update fails.
+func (r *Repository) MarkFailed(ctx context.Context, id uuid.UUID, owner, reason string) error {
+	query := `
+		UPDATE reminders
+		SET status = 'failed',
+		    locked_by = NULL,
+		    locked_until = NULL,
+		    last_error = $3,
+		    updated_at = now()
+		WHERE id = $1 AND locked_by = $2;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, owner, reason)
+	if err != nil {
+		return fmt.Errorf("failed to mark reminder failed: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderLost
+	}
+
+	return nil
+}
+
+// QueueStats counts the pending reminders and finds the oldest one that is due.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//
+// Returns:
+//   - The queue statistics.
+//   - An error if the query fails.
+func (r *Repository) QueueStats(ctx context.Context) (model.ReminderQueueStats, error) {
+	query := `
+		SELECT count(*),
+		       count(*) FILTER (WHERE remind_at <= now() AND (locked_until IS NULL OR locked_until < now())),
+		       count(*) FILTER (WHERE locked_by IS NOT NULL AND locked_until >= now()),
+		       min(remind_at) FILTER (WHERE remind_at <= now() AND (locked_until IS NULL OR locked_until < now()))
+		FROM reminders
+		WHERE status = 'pending';
+	`
+
+	var stats model.ReminderQueueStats
+	err := r.db.QueryRow(ctx, query).Scan(&stats.Pending, &stats.Due, &stats.Leased, &stats.OldestDueAt)
+	if err != nil {
+		return model.ReminderQueueStats{}, fmt.Errorf("failed to get reminder queue stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ListZoned retrieves the pending reminders that were set as a wall-clock time in a time zone.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//
+// Returns:
+//   - A slice of zoned reminders.
+//   - An error if the query fails.
+func (r *Repository) ListZoned(ctx context.Context) ([]model.ZonedReminder, error) {
+	query := `
+		SELECT id, timezone, local_time, remind_at
+		FROM reminders
+		WHERE status = 'pending' AND timezone IS NOT NULL;
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query zoned reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []model.ZonedReminder
+	for rows.Next() {
+		var rem model.ZonedReminder
+		if err := rows.Scan(&rem.ID, &rem.Timezone, &rem.LocalTime, &rem.RemindAt); err != nil {
+			return nil, fmt.Errorf("failed to scan zoned reminder: %w", err)
+		}
+		reminders = append(reminders, rem)
+	}
+
+	return reminders, rows.Err()
+}
+
+// Reschedule moves a pending reminder to a new time.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - remindAt: The new time of the reminder.
+//
+// Returns:
+//   - An error if the update fails or if no pending reminder is found.
+func (r *Repository) Reschedule(ctx context.Context, id uuid.UUID, remindAt time.Time) error {
+	query := `
+		UPDATE reminders
+		SET remind_at = $2,
+		    updated_at = now()
+		WHERE id = $1 AND status = 'pending';
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, id, remindAt)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule reminder: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrReminderNotFound
+	}
+
+	return nil
+}
+
+// historyQuery selects the notification history of the user $1; see ListHistory.
+const historyQuery = `
+		SELECT id, event_id, message, remind_at, status, sent_at, COALESCE(last_error, ''), false
+		FROM reminders
+		WHERE user_id = $1 AND status <> 'pending'
+		UNION ALL
+		SELECT id, event_id, message, remind_at, status, sent_at, COALESCE(last_error, ''), true
+		FROM archived_reminders
+		WHERE user_id = $1 AND status <> 'pending'`
+
+// ListHistory retrieves a page of the sent and failed reminders of a user, including those of archived events,
+// most recently due first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - page: The page to return; one entry more than the limit is read.
+//
+// Returns:
+//   - A slice of history entries with their messages as stored, i.e. possibly encrypted.
+//   - An error if the query fails.
+func (r *Repository) ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error) {
+	query := historyQuery + `
+		ORDER BY remind_at DESC, id
+		LIMIT $2 OFFSET $3;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reminder history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.ReminderHistoryEntry
+	for rows.Next() {
+		var e model.ReminderHistoryEntry
+		if err := rows.Scan(&e.ID, &e.EventID, &e.Message, &e.RemindAt, &e.Status, &e.SentAt, &e.LastError,
+			&e.Archived); err != nil {
+			return nil, fmt.Errorf("failed to scan reminder history entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// CountHistory counts the sent and failed reminders of a user, including those of archived events.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of history entries.
+//   - An error if the query fails.
+func (r *Repository) CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	return total.Count(ctx, r.db, mode, historyQuery, userID)
+}
+
+// DeleteHistory deletes the notification history of a user: the sent and failed reminders, including those
+// of archived events, and the bounces and complaints recorded for the user's email address.
+// Pending reminders are kept, so reminders being delivered are never removed under the worker.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The number of deleted reminders.
+//   - An error if the deletion fails.
+func (r *Repository) DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error) {
+	// Data-modifying CTEs run to completion whether or not the final SELECT reads them.
+	query := `
+		WITH active AS (
+			DELETE FROM reminders WHERE user_id = $1 AND status <> 'pending' RETURNING 1
+		), archived AS (
+			DELETE FROM archived_reminders WHERE user_id = $1 AND status <> 'pending' RETURNING 1
+		), feedback AS (
+			DELETE FROM notification_log WHERE recipient = (SELECT email FROM users WHERE id = $1) RETURNING 1
+		)
+		SELECT (SELECT count(*) FROM active) + (SELECT count(*) FROM archived);
+	`
+
+	var deleted int
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&deleted); err != nil {
+		return 0, fmt.Errorf("failed to delete reminder history: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// DeleteHistoryEntry deletes a sent or failed reminder of a user from the notification history.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the reminder.
+//   - userID: The UUID of the user who owns the reminder.
+//
+// Returns:
+//   - ErrReminderNotFound if the user has no such sent or failed reminder, or another error if the deletion fails.
+func (r *Repository) DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error {
+	query := `
+		WITH active AS (
+			DELETE FROM reminders WHERE id = $1 AND user_id = $2 AND status <> 'pending' RETURNING 1
+		), archived AS (
+			DELETE FROM archived_reminders WHERE id = $1 AND user_id = $2 AND status <> 'pending' RETURNING 1
+		)
+		SELECT (SELECT count(*) FROM active) + (SELECT count(*) FROM archived);
+	`
+
+	var deleted int
+	if err := r.db.QueryRow(ctx, query, id, userID).Scan(&deleted); err != nil {
+		return fmt.Errorf("failed to delete reminder history entry: %w", err)
+	}
+
+	if deleted == 0 {
+		return ErrReminderNotFound
+	}
+
+	return nil
+}
+
+// PurgeHistory deletes the sent and failed reminders due before the given time, including those of
+// archived events, and the bounces and complaints that occurred before it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - before: The retention cutoff.
+//
+// Returns:
+//   - The number of deleted reminders.
+//   - An error if the deletion fails.
+func (r *Repository) PurgeHistory(ctx context.Context, before time.Time) (int, error) {
+	// Data-modifying CTEs run to completion whether or not the final SELECT reads them.
+	query := `
+		WITH active AS (
+			DELETE FROM reminders WHERE remind_at < $1 AND status <> 'pending' RETURNING 1
+		), archived AS (
+			DELETE FROM archived_reminders WHERE remind_at < $1 AND status <> 'pending' RETURNING 1
+		), feedback AS (
+			DELETE FROM notification_log WHERE occurred_at < $1 RETURNING 1
+		)
+		SELECT (SELECT count(*) FROM active) + (SELECT count(*) FROM archived);
+	`
+
+	var deleted int
+	if err := r.db.QueryRow(ctx, query, before).Scan(&deleted); err != nil {
+		return 0, fmt.Errorf("failed to purge reminder history: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,239 @@
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ClaimDue(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id, userID, eventID := uuid.New(), uuid.New(), uuid.New()
+	remindAt := time.Now().Add(-time.Minute)
+	overrides := &model.EventNotifications{LeadTime: 15 * time.Minute}
+
+	rows := pgxmock.NewRows([]string{"id", "user_id", "event_id", "message", "remind_at", "status", "attempts", "location", "notifications"}).
+		AddRow(id, userID, eventID, "Test event", remindAt, model.ReminderPending, 1, "Room 4", overrides)
+
+	mock.ExpectQuery(`UPDATE reminders(.|\s)+FOR UPDATE SKIP LOCKED(.|\s)+events.user_id = reminders.user_id`).
+		WithArgs(10, "30s", "worker-1").
+		WillReturnRows(rows)
+
+	reminders, err := repo.ClaimDue(context.Background(), 10, 30*time.Second, "worker-1")
+	assert.NoError(t, err)
+	assert.Len(t, reminders, 1)
+	assert.Equal(t, id, reminders[0].ID)
+	assert.Equal(t, 1, reminders[0].Attempts)
+	assert.Equal(t, "Room 4", reminders[0].Location)
+	assert.Equal(t, overrides, reminders[0].Notifications)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkSent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+
+	mock.ExpectExec(`UPDATE reminders\s+SET status = 'sent'(.|\s)+WHERE id = \$1 AND locked_by = \$2`).
+		WithArgs(id, "worker-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err := repo.MarkSent(context.Background(), id, "worker-1")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkSent_LeaseLost(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+
+	// The lease expired and another instance claimed the reminder again.
+	mock.ExpectExec(`UPDATE reminders\s+SET status = 'sent'`).
+		WithArgs(id, "worker-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.MarkSent(context.Background(), id, "worker-1")
+	assert.ErrorIs(t, err, ErrReminderLost)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Retry(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	retryAt := time.Now().Add(time.Minute)
+
+	mock.ExpectExec(`UPDATE reminders\s+SET locked_by = NULL`).
+		WithArgs(id, "worker-1", retryAt, "smtp down").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err := repo.Retry(context.Background(), id, "worker-1", retryAt, "smtp down")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Defer(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	retryAt := time.Now().Add(time.Minute)
+
+	mock.ExpectExec(`UPDATE reminders\s+SET locked_by = NULL(.|\s)+attempts = GREATEST\(attempts - 1, 0\)`).
+		WithArgs(id, "worker-1", retryAt, "recipient domain throttled").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err := repo.Defer(context.Background(), id, "worker-1", retryAt, "recipient domain throttled")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+
+	mock.ExpectExec(`UPDATE reminders\s+SET status = 'failed'`).
+		WithArgs(id, "worker-1", "smtp down").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err := repo.MarkFailed(context.Background(), id, "worker-1", "smtp down")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_QueueStats(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	oldest := time.Now().Add(-5 * time.Minute)
+
+	mock.ExpectQuery(`SELECT count\(\*\)(.|\s)+FROM reminders\s+WHERE status = 'pending'`).
+		WillReturnRows(pgxmock.NewRows([]string{"pending", "due", "leased", "oldest_due_at"}).
+			AddRow(12, 4, 2, &oldest))
+
+	stats, err := repo.QueueStats(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, model.ReminderQueueStats{Pending: 12, Due: 4, Leased: 2, OldestDueAt: &oldest}, stats)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListZoned(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	local := time.Date(2030, 11, 4, 9, 0, 0, 0, time.UTC)
+	at := time.Date(2030, 11, 4, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT id, timezone, local_time, remind_at\s+FROM reminders\s+WHERE status = 'pending' AND timezone IS NOT NULL`).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "timezone", "local_time", "remind_at"}).
+			AddRow(id, "Europe/Berlin", local, at))
+
+	reminders, err := repo.ListZoned(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ZonedReminder{{ID: id, Timezone: "Europe/Berlin", LocalTime: local, RemindAt: at}}, reminders)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Reschedule_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	id := uuid.New()
+	at := time.Now().Add(time.Hour)
+
+	mock.ExpectExec(`UPDATE reminders\s+SET remind_at = \$2(.|\s)+WHERE id = \$1 AND status = 'pending'`).
+		WithArgs(id, at).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.Reschedule(context.Background(), id, at)
+	assert.ErrorIs(t, err, ErrReminderNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListHistory(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	sentAt := time.Now()
+
+	rows := pgxmock.NewRows([]string{"id", "event_id", "message", "remind_at", "status", "sent_at", "last_error", "archived"}).
+		AddRow(uuid.New(), uuid.New(), "Standup", sentAt, model.ReminderSent, &sentAt, "", false).
+		AddRow(uuid.New(), uuid.New(), "Review", sentAt.Add(-time.Hour), model.ReminderFailed, nil, "smtp down", true)
+
+	mock.ExpectQuery(`FROM reminders\s+WHERE user_id = \$1 AND status <> 'pending'\s+UNION ALL(.|\s)+FROM archived_reminders`).
+		WithArgs(userID, 51, 20).
+		WillReturnRows(rows)
+
+	entries, err := repo.ListHistory(context.Background(), userID, model.Page{Limit: 50, Offset: 20})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Nil(t, entries[1].SentAt)
+	assert.True(t, entries[1].Archived)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountHistory(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery(`SELECT count\(\*\) FROM \((.|\s)+FROM archived_reminders(.|\s)+\) AS counted`).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(120))
+
+	n, err := repo.CountHistory(context.Background(), userID, model.TotalExact)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteHistory(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery(`DELETE FROM reminders WHERE user_id = \$1 AND status <> 'pending'(.|\s)+DELETE FROM notification_log`).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"deleted"}).AddRow(3))
+
+	deleted, err := repo.DeleteHistory(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteHistoryEntry_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery(`DELETE FROM reminders WHERE id = \$1 AND user_id = \$2 AND status <> 'pending'`).
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"deleted"}).AddRow(0))
+
+	err := repo.DeleteHistoryEntry(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrReminderNotFound)
+}
@@ -0,0 +1,296 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrGroupNotFound = errors.New("group not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages the weekly meeting load of the members of attendee groups in the meeting_load table
+// and reads the meetings it is aggregated from.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListOutdatedMembers retrieves the members of attendee groups whose meeting load was aggregated before the given
+// time, and members not aggregated yet.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - before: The time a member must have been aggregated since to be up to date.
+//   - limit: The maximum number of members to return.
+//
+// Returns:
+//   - The members with only ID and Timezone set, members not aggregated yet first.
+//   - An error if the query fails.
+func (r *Repository) ListOutdatedMembers(ctx context.Context, before time.Time, limit int) ([]model.User, error) {
+	query := `
+		SELECT u.id, u.timezone
+		FROM users u
+		LEFT JOIN meeting_load l ON l.user_id = u.id
+		WHERE EXISTS (SELECT 1 FROM attendee_group_members m WHERE m.user_id = u.id)
+		GROUP BY u.id, u.timezone
+		HAVING max(l.computed_at) IS NULL OR max(l.computed_at) < $1
+		ORDER BY max(l.computed_at) NULLS FIRST, u.id
+		LIMIT $2;
+	`
+
+	rows, err := r.db.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outdated members: %w", err)
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var u model.User
+		if err := rows.Scan(&u.ID, &u.Timezone); err != nil {
+			return nil, fmt.Errorf("failed to scan member: %w", err)
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+// ListMeetings retrieves the meetings of a user that may take place within a time range: the events with an end
+// that the user organizes with attendees who did not decline, or that the user accepted as an attendee.
+// Recurring events are returned once, with their overridden occurrences, if their series starts before the end
+// of the range. Trashed events are left out.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - from: The start of the range, inclusive.
+//   - to: The end of the range, exclusive.
+//
+// Returns:
+//   - The meetings with only ID, EventDate, EndDate, RecurrenceRule, RecurrenceExceptions and Overrides set.
+//   - An error if the query fails.
+func (r *Repository) ListMeetings(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	query := `
+		SELECT e.id, e.event_date, e.end_date, e.recurrence_rule, e.recurrence_exceptions
+		FROM events e
+		WHERE e.deleted_at IS NULL AND e.end_date IS NOT NULL
+		  AND e.event_date < $3 AND (e.recurrence_rule <> '' OR e.event_date >= $2)
+		  AND (
+		      (e.user_id = $1 AND EXISTS (
+		          SELECT 1 FROM event_attendees a WHERE a.event_id = e.id AND a.status <> 'declined'
+		      ))
+		      OR EXISTS (
+		          SELECT 1 FROM event_attendees a WHERE a.event_id = e.id AND a.user_id = $1 AND a.status = 'accepted'
+		      )
+		  )
+		ORDER BY e.event_date, e.id;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		meetings  []model.Event
+		recurring []uuid.UUID
+	)
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(&e.ID, &e.EventDate, &e.EndDate, &e.RecurrenceRule, &e.RecurrenceExceptions); err != nil {
+			return nil, fmt.Errorf("failed to scan meeting: %w", err)
+		}
+		meetings = append(meetings, e)
+		if e.RecurrenceRule != "" {
+			recurring = append(recurring, e.ID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	if len(recurring) == 0 {
+		return meetings, nil
+	}
+
+	overrides, err := r.getOverrides(ctx, recurring)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overrides: %w", err)
+	}
+	for i := range meetings {
+		meetings[i].Overrides = overrides[meetings[i].ID]
+	}
+
+	return meetings, nil
+}
+
+// getOverrides retrieves the times of the overridden occurrences of the given events, grouped by event.
+func (r *Repository) getOverrides(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID][]model.OccurrenceOverride, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT event_id, occurrence, event_date, end_date
+		FROM event_overrides
+		WHERE event_id = ANY($1)
+		ORDER BY event_id, occurrence;
+	`, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := make(map[uuid.UUID][]model.OccurrenceOverride)
+	for rows.Next() {
+		var (
+			eventID uuid.UUID
+			o       model.OccurrenceOverride
+		)
+		if err := rows.Scan(&eventID, &o.Occurrence, &o.EventDate, &o.EndDate); err != nil {
+			return nil, err
+		}
+		overrides[eventID] = append(overrides[eventID], o)
+	}
+
+	return overrides, rows.Err()
+}
+
+// SaveLoad replaces the weekly meeting load of a user in one transaction, so weeks that left the aggregated window
+// are dropped. The weeks are marked as computed now.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - weeks: The aggregated weeks; Week, Meetings, MeetingMinutes, WorkdayMinutes and AfterHours are stored.
+//
+// Returns:
+//   - An error if the replacement fails.
+func (r *Repository) SaveLoad(ctx context.Context, userID uuid.UUID, weeks []model.MeetingLoadWeek) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM meeting_load WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete meeting load: %w", err)
+	}
+
+	for _, w := range weeks {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO meeting_load (user_id, week, meetings, meeting_minutes, workday_minutes, after_hours, computed_at)
+			VALUES ($1, $2, $3, $4, $5, $6, now());
+		`, userID, w.Week, w.Meetings, w.MeetingMinutes, w.WorkdayMinutes, w.AfterHours)
+		if err != nil {
+			return fmt.Errorf("failed to save meeting load: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetReport retrieves the weekly meeting load of the members of a group of the owner.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//
+// Returns:
+//   - The members ordered by email, each with their aggregated weeks, the most recent first.
+//   - ErrGroupNotFound if the owner has no group with this ID, or another error if the query fails.
+func (r *Repository) GetReport(ctx context.Context, groupID, ownerID uuid.UUID) ([]model.MemberMeetingLoad, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM attendee_groups WHERE id = $1 AND user_id = $2);
+	`, groupID, ownerID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting report: %w", err)
+	}
+	if !exists {
+		return nil, ErrGroupNotFound
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT u.id, u.email, u.name, l.week, l.meetings, l.meeting_minutes, l.workday_minutes, l.after_hours, l.computed_at
+		FROM attendee_group_members m
+		JOIN users u ON u.id = m.user_id
+		LEFT JOIN meeting_load l ON l.user_id = m.user_id
+		WHERE m.group_id = $1
+		ORDER BY u.email, u.id, l.week DESC;
+	`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting report: %w", err)
+	}
+	defer rows.Close()
+
+	members := []model.MemberMeetingLoad{}
+	for rows.Next() {
+		var (
+			member model.MemberMeetingLoad
+			week   *time.Time
+			load   struct {
+				meetings, meetingMinutes, workdayMinutes, afterHours *int
+				computedAt                                           *time.Time
+			}
+		)
+		err := rows.Scan(&member.UserID, &member.Email, &member.Name, &week,
+			&load.meetings, &load.meetingMinutes, &load.workdayMinutes, &load.afterHours, &load.computedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan meeting load: %w", err)
+		}
+
+		if n := len(members); n == 0 || members[n-1].UserID != member.UserID {
+			member.Weeks = []model.MeetingLoadWeek{}
+			members = append(members, member)
+		}
+		if week == nil {
+			continue // not aggregated yet
+		}
+
+		last := &members[len(members)-1]
+		last.Weeks = append(last.Weeks, model.MeetingLoadWeek{
+			Week:           *week,
+			Meetings:       *load.meetings,
+			MeetingMinutes: *load.meetingMinutes,
+			WorkdayMinutes: *load.workdayMinutes,
+			AfterHours:     *load.afterHours,
+			ComputedAt:     *load.computedAt,
+		})
+	}
+
+	return members, rows.Err()
+}
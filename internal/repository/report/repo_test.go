@@ -0,0 +1,137 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListOutdatedMembers(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	before := time.Date(2025, 10, 22, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM users u\\s+LEFT JOIN meeting_load l(.|\\s)+attendee_group_members(.|\\s)+HAVING max\\(l.computed_at\\) IS NULL OR max\\(l.computed_at\\) < \\$1").
+		WithArgs(before, 100).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "timezone"}).AddRow(userID, "Europe/Berlin"))
+
+	users, err := repo.ListOutdatedMembers(context.Background(), before, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.User{{ID: userID, Timezone: "Europe/Berlin"}}, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListMeetings(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID, single, series := uuid.New(), uuid.New(), uuid.New()
+	from := time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+	start := from.Add(9 * time.Hour)
+	end := start.Add(time.Hour)
+
+	mock.ExpectQuery("FROM events e\\s+WHERE e.deleted_at IS NULL AND e.end_date IS NOT NULL(.|\\s)+a.status <> 'declined'(.|\\s)+a.status = 'accepted'").
+		WithArgs(userID, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"}).
+			AddRow(single, start, &end, "", []time.Time{}).
+			AddRow(series, start, &end, "FREQ=DAILY", []time.Time{}))
+	mock.ExpectQuery("FROM event_overrides\\s+WHERE event_id = ANY\\(\\$1\\)").
+		WithArgs([]uuid.UUID{series}).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "occurrence", "event_date", "end_date"}).
+			AddRow(series, start.AddDate(0, 0, 1), start.AddDate(0, 0, 1).Add(2*time.Hour), (*time.Time)(nil)))
+
+	meetings, err := repo.ListMeetings(context.Background(), userID, from, to)
+	assert.NoError(t, err)
+	if assert.Len(t, meetings, 2) {
+		assert.Empty(t, meetings[0].Overrides)
+		assert.Len(t, meetings[1].Overrides, 1)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SaveLoad(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM meeting_load WHERE user_id = \\$1").
+		WithArgs(userID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 12))
+	mock.ExpectExec("INSERT INTO meeting_load").
+		WithArgs(userID, week, 3, 150, 120, 1).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	err := repo.SaveLoad(context.Background(), userID, []model.MeetingLoadWeek{
+		{Week: week, Meetings: 3, MeetingMinutes: 150, WorkdayMinutes: 120, AfterHours: 1},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetReport(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID, alice, bob := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	computedAt := week.Add(time.Hour)
+	meetings, minutes, workday, afterHours := 3, 150, 120, 1
+
+	mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM attendee_groups WHERE id = \\$1 AND user_id = \\$2\\)").
+		WithArgs(groupID, ownerID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("FROM attendee_group_members m(.|\\s)+LEFT JOIN meeting_load l").
+		WithArgs(groupID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "email", "name", "week", "meetings", "meeting_minutes", "workday_minutes", "after_hours", "computed_at"}).
+			AddRow(alice, "alice@example.com", "Alice", &week, &meetings, &minutes, &workday, &afterHours, &computedAt).
+			AddRow(alice, "alice@example.com", "Alice", &week, &meetings, &minutes, &workday, &afterHours, &computedAt).
+			AddRow(bob, "bob@example.com", "Bob", (*time.Time)(nil), (*int)(nil), (*int)(nil), (*int)(nil), (*int)(nil), (*time.Time)(nil)))
+
+	members, err := repo.GetReport(context.Background(), groupID, ownerID)
+	assert.NoError(t, err)
+	if assert.Len(t, members, 2) {
+		assert.Len(t, members[0].Weeks, 2)
+		assert.Equal(t, model.MeetingLoadWeek{
+			Week: week, Meetings: 3, MeetingMinutes: 150, WorkdayMinutes: 120, AfterHours: 1, ComputedAt: computedAt,
+		}, members[0].Weeks[0])
+		assert.Equal(t, bob, members[1].UserID)
+		assert.Empty(t, members[1].Weeks, "members not aggregated yet have no weeks")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetReport_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	groupID, ownerID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(groupID, ownerID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := repo.GetReport(context.Background(), groupID, ownerID)
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,196 @@
+package rule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrRuleNotFound = errors.New("rule not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the event rules of users in the event_rules table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateRule inserts a new rule into the event_rules table and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - rule: The rule data to be inserted.
+//
+// Returns:
+//   - The UUID of the created rule.
+//   - An error if the insertion fails.
+func (r *Repository) CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error) {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to encode rule conditions: %w", err)
+	}
+
+	query := `
+		INSERT INTO event_rules (user_id, name, position, conditions, color, tags, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id;
+	`
+
+	err = r.db.QueryRow(ctx, query, rule.UserID, rule.Name, rule.Position, conditions, rule.Color, tagsOf(rule),
+		rule.Enabled).Scan(&rule.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create rule: %w", err)
+	}
+
+	return rule.ID, nil
+}
+
+// ListRules retrieves all rules of a user in the order they are evaluated:
+// by position, then by creation.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of rules.
+//   - An error if the query fails.
+func (r *Repository) ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error) {
+	query := `
+		SELECT id, user_id, name, position, conditions, color, tags, enabled, created_at, updated_at
+		FROM event_rules
+		WHERE user_id = $1
+		ORDER BY position, created_at, id;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []model.EventRule
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// UpdateRule replaces the name, position, conditions, actions and state of a rule.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - rule: The updated rule; ID and UserID identify the rule to update.
+//
+// Returns:
+//   - ErrRuleNotFound if the user has no such rule, or another error if the update fails.
+func (r *Repository) UpdateRule(ctx context.Context, rule model.EventRule) error {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return fmt.Errorf("failed to encode rule conditions: %w", err)
+	}
+
+	query := `
+		UPDATE event_rules
+		SET
+		    name = $1,
+		    position = $2,
+		    conditions = $3,
+		    color = $4,
+		    tags = $5,
+		    enabled = $6,
+		    updated_at = now()
+		WHERE id = $7 AND user_id = $8;
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, rule.Name, rule.Position, conditions, rule.Color, tagsOf(rule), rule.Enabled,
+		rule.ID, rule.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update rule: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrRuleNotFound
+	}
+
+	return nil
+}
+
+// DeleteRule deletes a rule of the specified user. Events it has colored or tagged keep their color and tags.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - ruleID: The UUID of the rule.
+//   - userID: The UUID of the user who owns the rule.
+//
+// Returns:
+//   - ErrRuleNotFound if the user has no such rule, or another error if the deletion fails.
+func (r *Repository) DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM event_rules WHERE id = $1 AND user_id = $2`, ruleID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete rule: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrRuleNotFound
+	}
+
+	return nil
+}
+
+// scanRule scans an event_rules row and decodes its conditions.
+func scanRule(row pgx.Row) (model.EventRule, error) {
+	var rule model.EventRule
+	var conditions []byte
+	if err := row.Scan(&rule.ID, &rule.UserID, &rule.Name, &rule.Position, &conditions, &rule.Color, &rule.Tags,
+		&rule.Enabled, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+		return model.EventRule{}, err
+	}
+
+	if err := json.Unmarshal(conditions, &rule.Conditions); err != nil {
+		return model.EventRule{}, fmt.Errorf("failed to decode rule conditions: %w", err)
+	}
+
+	return rule, nil
+}
+
+// tagsOf returns the tags of a rule, with nil as an empty list since the tags column is not nullable.
+func tagsOf(rule model.EventRule) []string {
+	if rule.Tags == nil {
+		return []string{}
+	}
+	return rule.Tags
+}
@@ -0,0 +1,90 @@
+package rule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateRule(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	rule := model.EventRule{
+		UserID:     uuid.New(),
+		Name:       "Standups",
+		Conditions: []model.RuleCondition{{Field: model.RuleFieldTitle, Operator: model.RuleOpContains, Value: "standup"}},
+		Color:      "blue",
+		Enabled:    true,
+	}
+	id := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO event_rules").
+		WithArgs(rule.UserID, rule.Name, 0, []byte(`[{"field":"title","operator":"contains","value":"standup"}]`),
+			"blue", []string{}, true).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+
+	got, err := repo.CreateRule(context.Background(), rule)
+	assert.NoError(t, err)
+	assert.Equal(t, id, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListRules(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, name, position, conditions, color, tags, enabled, created_at, updated_at\\s+FROM event_rules\\s+WHERE user_id = \\$1\\s+ORDER BY position, created_at, id").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "position", "conditions", "color", "tags", "enabled", "created_at", "updated_at"}).
+			AddRow(uuid.New(), userID, "Standups", 0, []byte(`[{"field":"title","operator":"contains","value":"standup"}]`),
+				"blue", []string{"work"}, true, time.Now(), time.Now()))
+
+	rules, err := repo.ListRules(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+	assert.Equal(t, []model.RuleCondition{{Field: "title", Operator: "contains", Value: "standup"}}, rules[0].Conditions)
+	assert.Equal(t, []string{"work"}, rules[0].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateRule_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("UPDATE event_rules").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+			pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.UpdateRule(context.Background(), model.EventRule{ID: uuid.New(), UserID: uuid.New(), Name: "Gone"})
+	assert.ErrorIs(t, err, ErrRuleNotFound)
+}
+
+func TestRepository_DeleteRule_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM event_rules").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteRule(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrRuleNotFound)
+}
@@ -0,0 +1,87 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// execer is the subset of a transaction used to schedule reminders.
+// It is satisfied by pgx.Tx and by pgxmock in tests.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+}
+
+// Reminders moves the pending reminders of an updated or moved event to its reminder time within the given
+// transaction, or cancels them if the event has no reminder time or it is not after now.
+// Sent and failed reminders are kept as history. The reminders of followers keep their message, which is encrypted
+// with the follower's key; the owner's reminder gets the title of the event as stored.
+// A reminder that is moved loses the lease of a worker sending it at the old time, so the worker cannot mark it
+// as sent and it fires again at the new time. Its failed attempts are reset, so it gets the full retries there.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - tx: The transaction the event is updated in.
+//   - event: The event with its ID, owner, stored title, reminder time and time zone.
+//   - now: The current time.
+//
+// Returns:
+//   - An error if a statement fails.
+func Reminders(ctx context.Context, tx execer, event model.Event, now time.Time) error {
+	if event.ReminderAt == nil || !event.ReminderAt.After(now) {
+		_, err := tx.Exec(ctx, `
+			DELETE FROM reminders
+			WHERE event_id = $1 AND status = 'pending';
+		`, event.ID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel reminders: %w", err)
+		}
+		return nil
+	}
+
+	timezone, localTime := Zone(event)
+	_, err := tx.Exec(ctx, `
+		UPDATE reminders
+		SET locked_by = CASE WHEN remind_at = $2 THEN locked_by END,
+		    locked_until = CASE WHEN remind_at = $2 THEN locked_until END,
+		    attempts = CASE WHEN remind_at = $2 THEN attempts ELSE 0 END,
+		    last_error = CASE WHEN remind_at = $2 THEN last_error END,
+		    remind_at = $2,
+		    timezone = $3,
+		    local_time = $4,
+		    message = CASE WHEN user_id = $5 THEN $6 ELSE message END,
+		    updated_at = now()
+		WHERE event_id = $1 AND status = 'pending';
+	`, event.ID, *event.ReminderAt, timezone, localTime, event.UserID, event.Title)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule reminders: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO reminders (event_id, user_id, message, remind_at, timezone, local_time)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE NOT EXISTS (SELECT 1 FROM reminders WHERE event_id = $1 AND user_id = $2 AND status = 'pending');
+	`, event.ID, event.UserID, event.Title, *event.ReminderAt, timezone, localTime)
+	if err != nil {
+		return fmt.Errorf("failed to schedule reminder: %w", err)
+	}
+
+	return nil
+}
+
+// Zone returns the time zone and wall-clock time stored with the reminder of an event set in a time zone,
+// so it can be recomputed if the zone's rules change. Both are nil for reminders without a time zone.
+// ReminderAt must be in the event's reminder time zone.
+func Zone(event model.Event) (*string, *time.Time) {
+	if event.ReminderTimezone == "" {
+		return nil, nil
+	}
+
+	at := *event.ReminderAt
+	local := time.Date(at.Year(), at.Month(), at.Day(), at.Hour(), at.Minute(), at.Second(), at.Nanosecond(), time.UTC)
+	return &event.ReminderTimezone, &local
+}
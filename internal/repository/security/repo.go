@@ -0,0 +1,112 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/total"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the security event log of user accounts in the security_events table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateSecurityEvent appends an event to the security event log.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - event: The security event to store.
+//
+// Returns:
+//   - An error if the insertion fails.
+func (r *Repository) CreateSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	query := `
+		INSERT INTO security_events (user_id, type, ip, user_agent)
+		VALUES ($1, $2, $3, $4);
+	`
+
+	_, err := r.db.Exec(ctx, query, event.UserID, event.Type, event.IP, event.UserAgent)
+	if err != nil {
+		return fmt.Errorf("failed to create security event: %w", err)
+	}
+
+	return nil
+}
+
+// ListSecurityEvents retrieves a page of the security events of a user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of security events.
+//   - An error if the query fails.
+func (r *Repository) ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error) {
+	query := `
+		SELECT id, user_id, type, ip, user_agent, created_at
+		FROM security_events
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query security events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.SecurityEvent
+	for rows.Next() {
+		var e model.SecurityEvent
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Type, &e.IP, &e.UserAgent, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan security event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// CountSecurityEvents counts the security events of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of security events.
+//   - An error if the query fails.
+func (r *Repository) CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	return total.Count(ctx, r.db, mode, `SELECT 1 FROM security_events WHERE user_id = $1`, userID)
+}
@@ -0,0 +1,56 @@
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateSecurityEvent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	event := model.SecurityEvent{UserID: uuid.New(), Type: model.SecurityLogin, IP: "203.0.113.7", UserAgent: "curl/8.0"}
+
+	mock.ExpectExec("INSERT INTO security_events").
+		WithArgs(event.UserID, event.Type, event.IP, event.UserAgent).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err := repo.CreateSecurityEvent(context.Background(), event)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListSecurityEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT id, user_id, type, ip, user_agent, created_at\\s+FROM security_events(.|\\s)+LIMIT \\$2 OFFSET \\$3").
+		WithArgs(userID, 51, 0).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "user_id", "type", "ip", "user_agent", "created_at"}).
+				AddRow(uuid.New(), userID, model.SecurityLoginFailed, "203.0.113.7", "curl/8.0", time.Now()),
+		)
+
+	events, err := repo.ListSecurityEvents(context.Background(), userID, model.Page{Limit: 50})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, model.SecurityLoginFailed, events[0].Type)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
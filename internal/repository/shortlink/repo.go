@@ -0,0 +1,205 @@
+package shortlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrShortLinkNotFound = errors.New("short link not found")
+	ErrEventNotFound     = errors.New("event not found")
+	ErrCodeTaken         = errors.New("short code already taken")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the short links of events in the short_links table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateShortLink inserts a short link to an event of the user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - link: The short link to insert; Code, EventID, UserID, Visibility and ExpiresAt must be set.
+//
+// Returns:
+//   - The creation time of the link.
+//   - ErrEventNotFound if the user has no such event, ErrCodeTaken if the code is in use,
+//     or another error if the insertion fails.
+func (r *Repository) CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error) {
+	query := `
+		INSERT INTO short_links (code, event_id, user_id, visibility, expires_at)
+		SELECT $1, id, user_id, $4, $5
+		FROM events
+		WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL
+		RETURNING created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, link.Code, link.EventID, link.UserID, link.Visibility, link.ExpiresAt).
+		Scan(&link.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ShortLink{}, ErrEventNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return model.ShortLink{}, ErrCodeTaken
+		}
+
+		return model.ShortLink{}, fmt.Errorf("failed to create short link: %w", err)
+	}
+
+	return link, nil
+}
+
+// ListShortLinks retrieves the short links of an event of the user, newest first, including expired ones.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - A slice of short links.
+//   - An error if the query fails.
+func (r *Repository) ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error) {
+	query := `
+		SELECT code, event_id, user_id, visibility, expires_at, created_at
+		FROM short_links
+		WHERE event_id = $1 AND user_id = $2
+		ORDER BY created_at DESC, code;
+	`
+
+	rows, err := r.db.Query(ctx, query, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query short links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []model.ShortLink
+	for rows.Next() {
+		var l model.ShortLink
+		if err := rows.Scan(&l.Code, &l.EventID, &l.UserID, &l.Visibility, &l.ExpiresAt, &l.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan short link: %w", err)
+		}
+		links = append(links, l)
+	}
+
+	return links, rows.Err()
+}
+
+// DeleteShortLink deletes a short link of an event of the user, revoking it.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - code: The short code.
+//   - eventID: The UUID of the event the link belongs to.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - ErrShortLinkNotFound if the event has no such link, or another error if the deletion fails.
+func (r *Repository) DeleteShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM short_links WHERE code = $1 AND event_id = $2 AND user_id = $3`,
+		code, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete short link: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrShortLinkNotFound
+	}
+
+	return nil
+}
+
+// GetLinkedEvent retrieves a short link together with the event it shares.
+// The title and description of the event are returned as stored, i.e. possibly encrypted.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - code: The short code.
+//
+// Returns:
+//   - The short link.
+//   - The event with its title, description, date and color.
+//   - ErrShortLinkNotFound if no link has the code, or another error if the query fails.
+func (r *Repository) GetLinkedEvent(ctx context.Context, code string) (model.ShortLink, model.Event, error) {
+	query := `
+		SELECT s.code, s.event_id, s.user_id, s.visibility, s.expires_at, s.created_at,
+		       e.title, COALESCE(e.description, ''), e.event_date, e.color
+		FROM short_links s
+		JOIN events e ON e.id = s.event_id AND e.deleted_at IS NULL
+		WHERE s.code = $1;
+	`
+
+	var (
+		l model.ShortLink
+		e model.Event
+	)
+	err := r.db.QueryRow(ctx, query, code).Scan(&l.Code, &l.EventID, &l.UserID, &l.Visibility, &l.ExpiresAt,
+		&l.CreatedAt, &e.Title, &e.Description, &e.EventDate, &e.Color)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ShortLink{}, model.Event{}, ErrShortLinkNotFound
+		}
+		return model.ShortLink{}, model.Event{}, fmt.Errorf("failed to get short link: %w", err)
+	}
+
+	e.ID, e.UserID = l.EventID, l.UserID
+
+	return l, e, nil
+}
+
+// CountAttending counts the people attending an event: the users and external attendees who accepted their
+// invitations and the guests who accepted through a shared link. The organizer is not counted.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - The number of attendees who accepted.
+//   - An error if the query fails.
+func (r *Repository) CountAttending(ctx context.Context, eventID uuid.UUID) (int, error) {
+	query := `
+		SELECT (SELECT count(*) FROM event_attendees WHERE event_id = $1 AND status = 'accepted')
+		     + (SELECT count(*) FROM external_attendees WHERE event_id = $1 AND status = 'accepted')
+		     + (SELECT count(*) FROM event_guests WHERE event_id = $1 AND status = 'accepted');
+	`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, eventID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count attendees: %w", err)
+	}
+
+	return count, nil
+}
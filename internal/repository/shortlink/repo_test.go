@@ -0,0 +1,105 @@
+package shortlink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateShortLink(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	link := model.ShortLink{Code: "Ab3dE9xY", EventID: uuid.New(), UserID: uuid.New(), Visibility: "basic",
+		ExpiresAt: time.Now().Add(time.Hour)}
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO short_links .+ FROM events\\s+WHERE id = \\$2 AND user_id = \\$3").
+		WithArgs(link.Code, link.EventID, link.UserID, link.Visibility, link.ExpiresAt).
+		WillReturnRows(pgxmock.NewRows([]string{"created_at"}).AddRow(now))
+
+	got, err := repo.CreateShortLink(context.Background(), link)
+	assert.NoError(t, err)
+	assert.Equal(t, now, got.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateShortLink_Errors(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	args := []interface{}{pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()}
+
+	mock.ExpectQuery("INSERT INTO short_links").WithArgs(args...).WillReturnError(pgx.ErrNoRows)
+	_, err := repo.CreateShortLink(context.Background(), model.ShortLink{})
+	assert.ErrorIs(t, err, ErrEventNotFound)
+
+	mock.ExpectQuery("INSERT INTO short_links").WithArgs(args...).WillReturnError(&pgconn.PgError{Code: "23505"})
+	_, err = repo.CreateShortLink(context.Background(), model.ShortLink{})
+	assert.ErrorIs(t, err, ErrCodeTaken)
+}
+
+func TestRepository_GetLinkedEvent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID, userID := uuid.New(), uuid.New()
+	date := time.Date(2030, 5, 1, 18, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM short_links s\\s+JOIN events e ON e.id = s.event_id AND e.deleted_at IS NULL\\s+WHERE s.code = \\$1").
+		WithArgs("Ab3dE9xY").
+		WillReturnRows(pgxmock.NewRows([]string{"code", "event_id", "user_id", "visibility", "expires_at", "created_at",
+			"title", "description", "event_date", "color"}).
+			AddRow("Ab3dE9xY", eventID, userID, "details", date, date, "Dinner", "Bring wine", date, "red"))
+
+	link, event, err := repo.GetLinkedEvent(context.Background(), "Ab3dE9xY")
+	assert.NoError(t, err)
+	assert.Equal(t, "details", link.Visibility)
+	assert.Equal(t, eventID, event.ID)
+	assert.Equal(t, userID, event.UserID)
+	assert.Equal(t, "Bring wine", event.Description)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountAttending(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uuid.New()
+	mock.ExpectQuery("FROM event_attendees .+ FROM external_attendees .+ FROM event_guests").
+		WithArgs(eventID).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(4))
+
+	count, err := repo.CountAttending(context.Background(), eventID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteShortLink_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM short_links").
+		WithArgs("gone", pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteShortLink(context.Background(), "gone", uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrShortLinkNotFound)
+}
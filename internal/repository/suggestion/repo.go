@@ -0,0 +1,169 @@
+package suggestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrModelNotFound = errors.New("suggestion model not found")
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the suggestion models of users in the suggestion_models table
+// and reads the events they are computed from.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListOutdatedUsers retrieves users whose events changed after their suggestion model was computed,
+// and users with events but no model yet.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - limit: The maximum number of users to return.
+//
+// Returns:
+//   - A slice of user UUIDs, users without a model first.
+//   - An error if the query fails.
+func (r *Repository) ListOutdatedUsers(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT e.user_id
+		FROM events e
+		LEFT JOIN suggestion_models m ON m.user_id = e.user_id
+		GROUP BY e.user_id, m.computed_at
+		HAVING m.computed_at IS NULL OR max(e.updated_at) > m.computed_at
+		ORDER BY m.computed_at NULLS FIRST
+		LIMIT $1;
+	`
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outdated suggestion models: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	return userIDs, rows.Err()
+}
+
+// ListRecentEvents retrieves the title, tags and project of the most recently created events of a user.
+// Titles are returned as stored, i.e. possibly encrypted.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - limit: The maximum number of events to return.
+//
+// Returns:
+//   - A slice of events with only UserID, Title, Tags and ProjectID set, newest first.
+//   - An error if the query fails.
+func (r *Repository) ListRecentEvents(ctx context.Context, userID uuid.UUID, limit int) ([]model.Event, error) {
+	query := `
+		SELECT title, tags, project_id
+		FROM events
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id
+		LIMIT $2;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recent events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		e := model.Event{UserID: userID}
+		if err := rows.Scan(&e.Title, &e.Tags, &e.ProjectID); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// SaveModel stores the suggestion model of a user, replacing the previous one.
+// The model is marked as computed now, so events changed from now on make it outdated.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - data: The encoded, possibly encrypted model.
+//   - events: The number of events the model was computed from.
+//
+// Returns:
+//   - An error if the upsert fails.
+func (r *Repository) SaveModel(ctx context.Context, userID uuid.UUID, data string, events int) error {
+	query := `
+		INSERT INTO suggestion_models (user_id, model, events, computed_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET model = EXCLUDED.model, events = EXCLUDED.events, computed_at = EXCLUDED.computed_at;
+	`
+
+	if _, err := r.db.Exec(ctx, query, userID, data, events); err != nil {
+		return fmt.Errorf("failed to save suggestion model: %w", err)
+	}
+
+	return nil
+}
+
+// GetModel retrieves the stored suggestion model of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The encoded, possibly encrypted model.
+//   - ErrModelNotFound if no model was computed for the user yet, or another error if the query fails.
+func (r *Repository) GetModel(ctx context.Context, userID uuid.UUID) (string, error) {
+	var data string
+	err := r.db.QueryRow(ctx, `SELECT model FROM suggestion_models WHERE user_id = $1`, userID).Scan(&data)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrModelNotFound
+		}
+		return "", fmt.Errorf("failed to get suggestion model: %w", err)
+	}
+
+	return data, nil
+}
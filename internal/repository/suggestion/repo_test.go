@@ -0,0 +1,87 @@
+package suggestion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListOutdatedUsers(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT e.user_id\\s+FROM events e\\s+LEFT JOIN suggestion_models m").
+		WithArgs(100).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(userID))
+
+	users, err := repo.ListOutdatedUsers(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{userID}, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListRecentEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	projectID := uuid.New()
+
+	mock.ExpectQuery("SELECT title, tags, project_id\\s+FROM events\\s+WHERE user_id = \\$1 AND deleted_at IS NULL\\s+ORDER BY created_at DESC, id").
+		WithArgs(userID, 1000).
+		WillReturnRows(pgxmock.NewRows([]string{"title", "tags", "project_id"}).
+			AddRow("Team standup", []string{"work"}, &projectID).
+			AddRow("Dentist", []string{}, nil))
+
+	events, err := repo.ListRecentEvents(context.Background(), userID, 1000)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, userID, events[0].UserID)
+	assert.Equal(t, []string{"work"}, events[0].Tags)
+	assert.Equal(t, &projectID, events[0].ProjectID)
+	assert.Nil(t, events[1].ProjectID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SaveModel(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectExec("INSERT INTO suggestion_models").
+		WithArgs(userID, `{"events":2}`, 2).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err := repo.SaveModel(context.Background(), userID, `{"events":2}`, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetModel_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT model FROM suggestion_models").
+		WithArgs(userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetModel(context.Background(), userID)
+	assert.ErrorIs(t, err, ErrModelNotFound)
+}
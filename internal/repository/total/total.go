@@ -0,0 +1,70 @@
+package total
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// ErrInvalidMode is returned for a total mode other than model.TotalExact and model.TotalEstimated.
+var ErrInvalidMode = errors.New("invalid total mode")
+
+// querier is the subset of the PostgreSQL connection pool used to count rows.
+// It is satisfied by *pgxpool.Pool, pgx.Tx and by pgxmock in tests.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// plan is the part of the JSON output of EXPLAIN read for estimates.
+type plan struct {
+	Plan struct {
+		Rows float64 `json:"Plan Rows"` // number of rows the planner expects the query to return
+	} `json:"Plan"`
+}
+
+// Count counts the rows a query returns, for the totals of paginated lists.
+// The query must not be limited or ordered. An exact count runs the query; an estimate only plans it
+// and reads the row estimate of the planner, which is as good as the table statistics and does not
+// grow with the table.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - db: The connection pool or transaction to count with.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//   - query: The SELECT statement whose rows are counted.
+//   - args: The arguments of the query.
+//
+// Returns:
+//   - The number of rows.
+//   - ErrInvalidMode for another mode, or an error if the query fails.
+func Count(ctx context.Context, db querier, mode, query string, args ...interface{}) (int, error) {
+	switch mode {
+	case model.TotalExact:
+		var n int
+		if err := db.QueryRow(ctx, "SELECT count(*) FROM ("+query+") AS counted", args...).Scan(&n); err != nil {
+			return 0, fmt.Errorf("failed to count rows: %w", err)
+		}
+		return n, nil
+	case model.TotalEstimated:
+		var raw string
+		if err := db.QueryRow(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&raw); err != nil {
+			return 0, fmt.Errorf("failed to estimate rows: %w", err)
+		}
+
+		var plans []plan
+		if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+			return 0, fmt.Errorf("failed to read query plan: %w", err)
+		}
+		if len(plans) == 0 {
+			return 0, errors.New("failed to read query plan: empty plan")
+		}
+		return int(plans[0].Plan.Rows), nil
+	default:
+		return 0, ErrInvalidMode
+	}
+}
@@ -0,0 +1,53 @@
+package total
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+const query = "SELECT id FROM users WHERE role = $1"
+
+func TestCount_Exact(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT count\\(\\*\\) FROM \\(SELECT id FROM users WHERE role = \\$1\\) AS counted").
+		WithArgs("admin").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+
+	n, err := Count(context.Background(), mock, model.TotalExact, query, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCount_Estimated(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery("EXPLAIN \\(FORMAT JSON\\) SELECT id FROM users WHERE role = \\$1").
+		WithArgs("admin").
+		WillReturnRows(pgxmock.NewRows([]string{"QUERY PLAN"}).
+			AddRow(`[{"Plan": {"Node Type": "Seq Scan", "Relation Name": "users", "Plan Rows": 1250}}]`))
+
+	n, err := Count(context.Background(), mock, model.TotalEstimated, query, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, 1250, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCount_InvalidMode(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	_, err = Count(context.Background(), mock, "approximate", query)
+	assert.ErrorIs(t, err, ErrInvalidMode)
+}
@@ -0,0 +1,166 @@
+package tzmigration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Repository manages interactions with the legacy_event_dates table in the PostgreSQL database,
+// which records the events whose dates were stored without a time zone.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CountLegacyDates counts the events whose dates have not been migrated yet.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//
+// Returns:
+//   - The number of legacy dates.
+//   - An error if the query fails.
+func (r *Repository) CountLegacyDates(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM legacy_event_dates`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count legacy dates: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListLegacyDates retrieves a batch of legacy dates with the time zones of their owners, ordered by event ID.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - after: The event ID the batch starts after; uuid.Nil for the first batch.
+//   - limit: The maximum number of dates to retrieve.
+//
+// Returns:
+//   - A slice of legacy dates.
+//   - An error if the query fails.
+func (r *Repository) ListLegacyDates(ctx context.Context, after uuid.UUID, limit int) ([]model.LegacyEventDate, error) {
+	query := `
+		SELECT l.event_id, e.user_id, u.timezone, l.original_date, e.event_date
+		FROM legacy_event_dates l
+		JOIN events e ON e.id = l.event_id
+		JOIN users u ON u.id = e.user_id
+		WHERE l.event_id > $1
+		ORDER BY l.event_id
+		LIMIT $2;
+	`
+
+	rows, err := r.db.Query(ctx, query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list legacy dates: %w", err)
+	}
+	defer rows.Close()
+
+	var dates []model.LegacyEventDate
+	for rows.Next() {
+		var d model.LegacyEventDate
+		if err := rows.Scan(&d.EventID, &d.UserID, &d.UserTimezone, &d.OriginalDate, &d.EventDate); err != nil {
+			return nil, fmt.Errorf("failed to scan legacy date: %w", err)
+		}
+		dates = append(dates, d)
+	}
+
+	return dates, rows.Err()
+}
+
+// MigrateDates moves events to their reinterpreted dates and removes a batch from the legacy dates, in one transaction.
+// An event is only moved if its date is still the one it was read with, so events changed in the meantime are kept.
+// The end, the reminder time and the pending reminders of a moved event are shifted along with its date, except for
+// reminders pinned to a wall-clock time in a time zone, whose instants are already right.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - dates: The legacy dates to move, with EventDate as read by ListLegacyDates.
+//   - newDates: The reinterpreted date of each entry of dates.
+//   - done: The event IDs of the batch, moved or not, to remove from the legacy dates.
+//
+// Returns:
+//   - The number of events moved.
+//   - An error if an update fails.
+func (r *Repository) MigrateDates(ctx context.Context, dates []model.LegacyEventDate, newDates []time.Time, done []uuid.UUID) (int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids := make([]uuid.UUID, len(dates))
+	oldDates := make([]time.Time, len(dates))
+	for i, d := range dates {
+		ids[i], oldDates[i] = d.EventID, d.EventDate
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE events e
+		SET event_date = v.new_date,
+		    end_date = e.end_date + (v.new_date - v.old_date),
+		    reminder_at = CASE WHEN e.reminder_timezone = '' THEN e.reminder_at + (v.new_date - v.old_date) ELSE e.reminder_at END,
+		    updated_at = now()
+		FROM unnest($1::uuid[], $2::timestamptz[], $3::timestamptz[]) AS v(id, old_date, new_date)
+		WHERE e.id = v.id AND e.event_date = v.old_date
+		RETURNING e.id;
+	`, ids, oldDates, newDates)
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate event dates: %w", err)
+	}
+
+	moved, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate event dates: %w", err)
+	}
+
+	if len(moved) > 0 {
+		// Reminders being sent are released like rescheduled ones, so they fire at the new time.
+		_, err = tx.Exec(ctx, `
+			UPDATE reminders r
+			SET remind_at = r.remind_at + (v.new_date - v.old_date),
+			    attempts = 0, last_error = NULL, locked_by = NULL, locked_until = NULL, updated_at = now()
+			FROM unnest($1::uuid[], $2::timestamptz[], $3::timestamptz[]) AS v(id, old_date, new_date)
+			WHERE r.event_id = v.id AND v.id = ANY($4) AND r.status = 'pending' AND r.timezone IS NULL;
+		`, ids, oldDates, newDates, moved)
+		if err != nil {
+			return 0, fmt.Errorf("failed to migrate reminders: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM legacy_event_dates WHERE event_id = ANY($1)`, done); err != nil {
+		return 0, fmt.Errorf("failed to delete legacy dates: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(moved), nil
+}
@@ -0,0 +1,66 @@
+package tzmigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_ListLegacyDates(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	after, eventID, userID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM legacy_event_dates l(.|\\s)+WHERE l.event_id > \\$1\\s+ORDER BY l.event_id\\s+LIMIT \\$2").
+		WithArgs(after, 100).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "user_id", "timezone", "original_date", "event_date"}).
+			AddRow(eventID, userID, "Europe/Berlin", date, date))
+
+	dates, err := repo.ListLegacyDates(context.Background(), after, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.LegacyEventDate{{EventID: eventID, UserID: userID, UserTimezone: "Europe/Berlin", OriginalDate: date, EventDate: date}}, dates)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MigrateDates(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	moved, kept := uuid.New(), uuid.New()
+	date := time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)
+	newDate := time.Date(2025, 3, 29, 23, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE events e(.|\\s)+end_date = e.end_date \\+ \\(v.new_date - v.old_date\\)(.|\\s)+reminder_at = CASE WHEN e.reminder_timezone = ''(.|\\s)+unnest\\(\\$1::uuid\\[\\], \\$2::timestamptz\\[\\], \\$3::timestamptz\\[\\]\\)(.|\\s)+e.event_date = v.old_date").
+		WithArgs([]uuid.UUID{moved}, []time.Time{date}, []time.Time{newDate}).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(moved))
+	// Pending reminders of the moved events move by the same amount, unless they are pinned to a time zone.
+	mock.ExpectExec("UPDATE reminders r(.|\\s)+remind_at = r.remind_at \\+ \\(v.new_date - v.old_date\\)(.|\\s)+v.id = ANY\\(\\$4\\) AND r.status = 'pending' AND r.timezone IS NULL").
+		WithArgs([]uuid.UUID{moved}, []time.Time{date}, []time.Time{newDate}, []uuid.UUID{moved}).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	mock.ExpectExec("DELETE FROM legacy_event_dates WHERE event_id = ANY\\(\\$1\\)").
+		WithArgs([]uuid.UUID{moved, kept}).
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	mock.ExpectCommit()
+
+	n, err := repo.MigrateDates(context.Background(), []model.LegacyEventDate{{EventID: moved, EventDate: date}}, []time.Time{newDate}, []uuid.UUID{moved, kept})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
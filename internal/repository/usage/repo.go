@@ -0,0 +1,93 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the per-user API call counters in the api_usage table.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Increment atomically counts one API call of a user in the given period and returns the new total.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user making the call.
+//   - period: The start of the metering period.
+//
+// Returns:
+//   - The number of calls in the period, including this one.
+//   - An error if the update fails.
+func (r *Repository) Increment(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error) {
+	query := `
+		INSERT INTO api_usage (user_id, period, calls)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (user_id, period)
+		DO UPDATE SET calls = api_usage.calls + 1, updated_at = now()
+		RETURNING calls;
+	`
+
+	var calls int64
+	if err := r.db.QueryRow(ctx, query, userID, period).Scan(&calls); err != nil {
+		return 0, fmt.Errorf("failed to increment api usage: %w", err)
+	}
+
+	return calls, nil
+}
+
+// GetCalls returns the number of API calls of a user in the given period.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//   - period: The start of the metering period.
+//
+// Returns:
+//   - The number of calls in the period; 0 if the user made none.
+//   - An error if the query fails.
+func (r *Repository) GetCalls(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error) {
+	query := `
+		SELECT calls
+		FROM api_usage
+		WHERE user_id = $1 AND period = $2;
+	`
+
+	var calls int64
+	err := r.db.QueryRow(ctx, query, userID, period).Scan(&calls)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get api usage: %w", err)
+	}
+
+	return calls, nil
+}
@@ -0,0 +1,54 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_Increment(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	period := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("INSERT INTO api_usage(.|\\s)+ON CONFLICT").
+		WithArgs(userID, period).
+		WillReturnRows(pgxmock.NewRows([]string{"calls"}).AddRow(int64(42)))
+
+	calls, err := repo.Increment(context.Background(), userID, period)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), calls)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetCalls_NoUsage(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	period := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT calls\\s+FROM api_usage").
+		WithArgs(userID, period).
+		WillReturnError(pgx.ErrNoRows)
+
+	calls, err := repo.GetCalls(context.Background(), userID, period)
+	assert.NoError(t, err)
+	assert.Zero(t, calls)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
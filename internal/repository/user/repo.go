@@ -4,22 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/repository/total"
 )
 
 var (
 	ErrUserNotFound = errors.New("user not found")
 )
 
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool and by the tenant-aware *tenancy.Pool.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
 // Repository manages interactions with the users table in the PostgreSQL database.
 // It provides methods for creating and retrieving user records.
 type Repository struct {
-	db *pgxpool.Pool // Database connection pool
+	db pgxPool // Database connection pool
 }
 
 // New creates a new Repository instance with the provided database connection pool.
@@ -29,14 +39,17 @@ type Repository struct {
 //
 // Returns:
 //   - A pointer to the initialized Repository.
-func New(db *pgxpool.Pool) *Repository {
+func New(db pgxPool) *Repository {
 	return &Repository{
 		db: db,
 	}
 }
 
 // CreateUser inserts a new user into the users table and returns their ID.
-// It stores the user's name, email, and password hash.
+// It stores the user's name, email, password hash, and whether it is a demo account,
+// and creates the user's default calendar in the same statement.
+// Invitations sent to the email address before the user registered become theirs with their responses,
+// and the contacts with the address are linked to the new user.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -47,14 +60,31 @@ func New(db *pgxpool.Pool) *Repository {
 //   - An error if the insertion fails.
 func (r *Repository) CreateUser(ctx context.Context, user model.User) (uuid.UUID, error) {
 	query := `
-		INSERT INTO users (
-		    name, email, password_hash
-		) VALUES ($1, $2, $3)
-		RETURNING id
+		WITH created AS (
+		    INSERT INTO users (
+		        name, email, password_hash, demo
+		    ) VALUES ($1, $2, $3, $4)
+		    RETURNING id
+		), calendar AS (
+		    INSERT INTO calendars (user_id, name, is_default)
+		    SELECT id, $5, true FROM created
+		), external AS (
+		    DELETE FROM external_attendees
+		    WHERE email = lower($2)
+		    RETURNING event_id, status, invited_at, responded_at
+		), linked AS (
+		    INSERT INTO event_attendees (event_id, user_id, status, invited_at, responded_at)
+		    SELECT x.event_id, c.id, x.status, x.invited_at, x.responded_at FROM external x, created c
+		), contact AS (
+		    UPDATE contacts SET contact_user_id = c.id, name = COALESCE(NULLIF(contacts.name, ''), $1)
+		    FROM created c
+		    WHERE contacts.email = lower($2) AND contacts.contact_user_id IS NULL
+		)
+		SELECT id FROM created
    `
 
 	err := r.db.QueryRow(
-		ctx, query, user.Name, user.Email, user.Password,
+		ctx, query, user.Name, user.Email, user.Password, user.Demo, model.DefaultCalendarName,
 	).Scan(&user.ID)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to create user: %w", err)
@@ -64,7 +94,7 @@ func (r *Repository) CreateUser(ctx context.Context, user model.User) (uuid.UUID
 }
 
 // GetUserByID retrieves a user from the users table by their ID.
-// It returns the user's details, including ID, email, name, password hash, and timestamps.
+// It returns the user's details, including ID, email, name, password hash, role, time zone, search language, and timestamps.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -75,7 +105,7 @@ func (r *Repository) CreateUser(ctx context.Context, user model.User) (uuid.UUID
 //   - An error if the query fails or if the user is not found.
 func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
 	query := `
-		SELECT id, email, name, password_hash, created_at, updated_at
+		SELECT id, email, name, password_hash, role, timezone, search_language, created_at, updated_at
 		FROM users
 		WHERE id = $1
    `
@@ -86,6 +116,9 @@ func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User
 		&user.Email,
 		&user.Name,
 		&user.Password,
+		&user.Role,
+		&user.Timezone,
+		&user.SearchLanguage,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -100,7 +133,7 @@ func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User
 }
 
 // GetUserByEmail retrieves a user from the users table by their email address.
-// It returns the user's details, including ID, email, name, password hash, and timestamps.
+// It returns the user's details, including ID, email, name, password hash, role, time zone, search language, and timestamps.
 //
 // Parameters:
 //   - ctx: The context for the database operation.
@@ -111,7 +144,7 @@ func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User
 //   - An error if the query fails or if the user is not found.
 func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	query := `
-		SELECT id, email, name, password_hash, created_at, updated_at
+		SELECT id, email, name, password_hash, role, timezone, search_language, created_at, updated_at
 		FROM users
 		WHERE email = $1
    `
@@ -122,6 +155,9 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*model.U
 		&user.Email,
 		&user.Name,
 		&user.Password,
+		&user.Role,
+		&user.Timezone,
+		&user.SearchLanguage,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -134,3 +170,175 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*model.U
 
 	return &user, nil
 }
+
+// userFilter is the condition of ListUsers and CountUsers on the email substring $1.
+const userFilter = `$1 = '' OR strpos(lower(email), lower($1)) > 0`
+
+// ListUsers retrieves a page of users from the users table, newest first.
+// Password hashes are not read.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - email: Optional case-insensitive substring the email address must contain; empty matches all users.
+//   - page: The page to return; one user more than the limit is read.
+//
+// Returns:
+//   - A slice of users.
+//   - An error if the query fails.
+func (r *Repository) ListUsers(ctx context.Context, email string, page model.Page) ([]model.User, error) {
+	query := `
+		SELECT id, email, name, role, created_at, updated_at
+		FROM users
+		WHERE ` + userFilter + `
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+   `
+
+	rows, err := r.db.Query(ctx, query, email, page.Limit+1, page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Email, &user.Name, &user.Role, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// CountUsers counts the users whose email address contains a substring.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - email: Optional case-insensitive substring the email address must contain; empty matches all users.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of users.
+//   - An error if the query fails.
+func (r *Repository) CountUsers(ctx context.Context, email string, mode string) (int, error) {
+	return total.Count(ctx, r.db, mode, `SELECT 1 FROM users WHERE `+userFilter, email)
+}
+
+// GetTimezone retrieves the time zone of a user without reading the rest of the user record.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the user.
+//
+// Returns:
+//   - The IANA time zone name; empty for UTC.
+//   - ErrUserNotFound if the user does not exist, or another error if the query fails.
+func (r *Repository) GetTimezone(ctx context.Context, id uuid.UUID) (string, error) {
+	var timezone string
+	err := r.db.QueryRow(ctx, `SELECT timezone FROM users WHERE id = $1`, id).Scan(&timezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		return "", fmt.Errorf("failed to get user time zone: %w", err)
+	}
+
+	return timezone, nil
+}
+
+// UpdateTimezone changes the time zone of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the user.
+//   - timezone: The IANA time zone name; empty for UTC.
+//
+// Returns:
+//   - ErrUserNotFound if the user does not exist, or another error if the update fails.
+func (r *Repository) UpdateTimezone(ctx context.Context, id uuid.UUID, timezone string) error {
+	cmdTag, err := r.db.Exec(ctx, `UPDATE users SET timezone = $2, updated_at = now() WHERE id = $1`, id, timezone)
+	if err != nil {
+		return fmt.Errorf("failed to update user time zone: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpdateSearchLanguage changes the search language of a user. The events of the user are reindexed
+// with the text search configuration of the language in the same statement, so searches never mix languages.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the user.
+//   - language: One of model.SearchLanguages, or empty for accent-insensitive matching without stemming.
+//
+// Returns:
+//   - ErrUserNotFound if the user does not exist, or another error if the update fails.
+func (r *Repository) UpdateSearchLanguage(ctx context.Context, id uuid.UUID, language string) error {
+	query := `
+		WITH updated AS (
+		    UPDATE users SET search_language = $2, updated_at = now() WHERE id = $1 RETURNING id
+		), reindexed AS (
+		    UPDATE events SET search_config = text_search_config($2) WHERE user_id IN (SELECT id FROM updated)
+		)
+		SELECT count(*) FROM updated
+   `
+
+	var n int
+	if err := r.db.QueryRow(ctx, query, id, language).Scan(&n); err != nil {
+		return fmt.Errorf("failed to update user search language: %w", err)
+	}
+
+	if n == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpdateRole changes the role of a user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - id: The UUID of the user.
+//   - role: The new role, one of the model.Role* constants.
+//
+// Returns:
+//   - ErrUserNotFound if the user does not exist, or another error if the update fails.
+func (r *Repository) UpdateRole(ctx context.Context, id uuid.UUID, role string) error {
+	cmdTag, err := r.db.Exec(ctx, `UPDATE users SET role = $2, updated_at = now() WHERE id = $1`, id, role)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// DeleteDemoUsers deletes the demo accounts created before the given time.
+// The data of the accounts is deleted with them by the foreign keys of the other tables.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - createdBefore: The time before which deleted accounts were created.
+//
+// Returns:
+//   - The number of deleted accounts.
+//   - An error if the deletion fails.
+func (r *Repository) DeleteDemoUsers(ctx context.Context, createdBefore time.Time) (int, error) {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM users WHERE demo AND created_at < $1`, createdBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete demo users: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -62,6 +63,16 @@ func TestCreateUser(t *testing.T) {
 	if id == uuid.Nil {
 		t.Fatal("expected valid UUID, got Nil")
 	}
+
+	var calendars int
+	err = testRepo.db.QueryRow(ctx, "SELECT count(*) FROM calendars WHERE user_id = $1 AND is_default", id).Scan(&calendars)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if calendars != 1 {
+		t.Fatalf("expected one default calendar, got %d", calendars)
+	}
 }
 
 func TestGetUserByEmail(t *testing.T) {
@@ -89,3 +100,157 @@ func TestGetUserByEmail_NotFound(t *testing.T) {
 		t.Fatalf("expected ErrUserNotFound, got %v", err)
 	}
 }
+
+func TestListUsers(t *testing.T) {
+	ctx := context.Background()
+
+	users, err := testRepo.ListUsers(ctx, "TEST@", model.Page{Limit: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(users) != 1 || users[0].Email != "test@example.com" || users[0].Password != "" {
+		t.Fatalf("unexpected users: %+v", users)
+	}
+}
+
+func TestUpdateTimezone(t *testing.T) {
+	ctx := context.Background()
+
+	u, err := testRepo.GetUserByEmail(ctx, "test@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := testRepo.UpdateTimezone(ctx, u.ID, "Europe/Berlin"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tz, err := testRepo.GetTimezone(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tz != "Europe/Berlin" {
+		t.Fatalf("expected time zone Europe/Berlin, got %q", tz)
+	}
+
+	if err := testRepo.UpdateTimezone(ctx, uuid.New(), "UTC"); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUpdateSearchLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	u, err := testRepo.GetUserByEmail(ctx, "test@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := testRepo.UpdateSearchLanguage(ctx, u.ID, "german"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	u, err = testRepo.GetUserByID(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if u.SearchLanguage != "german" {
+		t.Fatalf("expected search language german, got %q", u.SearchLanguage)
+	}
+
+	if err := testRepo.UpdateSearchLanguage(ctx, uuid.New(), ""); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	ctx := context.Background()
+
+	u, err := testRepo.GetUserByEmail(ctx, "test@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := testRepo.UpdateRole(ctx, u.ID, model.RoleAdmin); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	u, err = testRepo.GetUserByID(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if u.Role != model.RoleAdmin {
+		t.Fatalf("expected role %s, got %s", model.RoleAdmin, u.Role)
+	}
+
+	if err := testRepo.UpdateRole(ctx, uuid.New(), model.RoleAdmin); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestDeleteDemoUsers(t *testing.T) {
+	ctx := context.Background()
+
+	demo := model.User{Name: "Demo", Email: "demo-1@demo.invalid", Password: "secret123", Demo: true}
+	if _, err := testRepo.CreateUser(ctx, demo); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	deleted, err := testRepo.DeleteDemoUsers(ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted demo user, got %d", deleted)
+	}
+
+	// Regular accounts are kept.
+	if _, err := testRepo.GetUserByEmail(ctx, "test@example.com"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := testRepo.GetUserByEmail(ctx, demo.Email); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestCreateUser_LinksExternalAttendees(t *testing.T) {
+	ctx := context.Background()
+
+	ownerID, err := testRepo.CreateUser(ctx, model.User{Name: "Owner", Email: "owner@example.com", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var eventID uuid.UUID
+	err = testRepo.db.QueryRow(ctx, "INSERT INTO events (user_id, event_date, title) VALUES ($1, now(), 'Standup') RETURNING id", ownerID).Scan(&eventID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = testRepo.db.Exec(ctx, "INSERT INTO external_attendees (event_id, email, token_hash, status) VALUES ($1, 'guest@example.com', 'hash', 'accepted')", eventID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	guestID, err := testRepo.CreateUser(ctx, model.User{Name: "Guest", Email: "Guest@example.com", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var status string
+	err = testRepo.db.QueryRow(ctx, "SELECT status FROM event_attendees WHERE event_id = $1 AND user_id = $2", eventID, guestID).Scan(&status)
+	if err != nil {
+		t.Fatalf("expected the invitation to be linked, got %v", err)
+	}
+	if status != model.AttendeeAccepted {
+		t.Fatalf("expected the response to be kept, got %q", status)
+	}
+
+	var external int
+	if err := testRepo.db.QueryRow(ctx, "SELECT count(*) FROM external_attendees WHERE event_id = $1", eventID).Scan(&external); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if external != 0 {
+		t.Fatalf("expected the external invitation to be removed, got %d", external)
+	}
+}
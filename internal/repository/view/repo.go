@@ -0,0 +1,250 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var (
+	ErrViewNotFound = errors.New("view not found")
+	ErrViewExists   = errors.New("view with this name already exists")
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// pgxPool defines the subset of the PostgreSQL connection pool used by the repository.
+// It is satisfied by *pgxpool.Pool, the tenant-aware *tenancy.Pool, and pgxmock in tests.
+type pgxPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Repository manages the saved views of users in the views table
+// and queries the events matching them.
+type Repository struct {
+	db pgxPool // Database connection pool
+}
+
+// New creates a new Repository instance with the provided database connection pool.
+//
+// Parameters:
+//   - db: The PostgreSQL connection pool for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Repository.
+func New(db pgxPool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateView inserts a new view into the views table and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - view: The view data to be inserted.
+//
+// Returns:
+//   - The UUID of the created view.
+//   - ErrViewExists if the user already has a view with this name, or another error if the insertion fails.
+func (r *Repository) CreateView(ctx context.Context, view model.View) (uuid.UUID, error) {
+	filter, err := json.Marshal(view.Filter)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to encode view filter: %w", err)
+	}
+
+	query := `
+		INSERT INTO views (user_id, name, filter)
+		VALUES ($1, $2, $3)
+		RETURNING id;
+	`
+
+	err = r.db.QueryRow(ctx, query, view.UserID, view.Name, filter).Scan(&view.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return uuid.Nil, ErrViewExists
+		}
+		return uuid.Nil, fmt.Errorf("failed to create view: %w", err)
+	}
+
+	return view.ID, nil
+}
+
+// GetView retrieves a view by its ID for the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - viewID: The UUID of the view.
+//   - userID: The UUID of the user who owns the view.
+//
+// Returns:
+//   - The view.
+//   - ErrViewNotFound if the user has no such view, or another error if the query fails.
+func (r *Repository) GetView(ctx context.Context, viewID, userID uuid.UUID) (model.View, error) {
+	query := `
+		SELECT id, user_id, name, filter, created_at, updated_at
+		FROM views
+		WHERE id = $1 AND user_id = $2;
+	`
+
+	v, err := scanView(r.db.QueryRow(ctx, query, viewID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.View{}, ErrViewNotFound
+		}
+		return model.View{}, fmt.Errorf("failed to get view: %w", err)
+	}
+
+	return v, nil
+}
+
+// ListViews retrieves all views of a user ordered by name.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of views.
+//   - An error if the query fails.
+func (r *Repository) ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error) {
+	query := `
+		SELECT id, user_id, name, filter, created_at, updated_at
+		FROM views
+		WHERE user_id = $1
+		ORDER BY name;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []model.View
+	for rows.Next() {
+		v, err := scanView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan view: %w", err)
+		}
+		views = append(views, v)
+	}
+
+	return views, rows.Err()
+}
+
+// DeleteView deletes a view of the specified user.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - viewID: The UUID of the view.
+//   - userID: The UUID of the user who owns the view.
+//
+// Returns:
+//   - ErrViewNotFound if the user has no such view, or another error if the deletion fails.
+func (r *Repository) DeleteView(ctx context.Context, viewID, userID uuid.UUID) error {
+	cmdTag, err := r.db.Exec(ctx, `DELETE FROM views WHERE id = $1 AND user_id = $2`, viewID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete view: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrViewNotFound
+	}
+
+	return nil
+}
+
+// ListEvents retrieves the events of a user matching the filter, ordered by event_date.
+//
+// Parameters:
+//   - ctx: The context for the database operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - filter: The resolved criteria of the query.
+//
+// Returns:
+//   - A slice of matching events.
+//   - An error if the query fails.
+func (r *Repository) ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error) {
+	conditions := []string{"user_id = $1", "deleted_at IS NULL"}
+	args := []interface{}{userID}
+
+	// add appends a condition whose placeholder is bound to arg.
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.From != nil {
+		add("event_date >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("event_date < $%d", *filter.To)
+	}
+	if len(filter.Priorities) > 0 {
+		add("priority = ANY($%d)", filter.Priorities)
+	}
+	if filter.ProjectID != nil {
+		add("project_id = $%d", *filter.ProjectID)
+	}
+	if filter.WithoutProject {
+		conditions = append(conditions, "project_id IS NULL")
+	}
+
+	query := `
+		SELECT id, user_id, event_date, title, description, priority, project_id, color, tags, reminder_at, created_at, updated_at
+		FROM events
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY event_date, id`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	// View listings tolerate replication lag, so they may be served by a regional replica.
+	rows, err := r.db.Query(tenancy.ReadOnly(ctx), query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query view events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		var e model.Event
+		if err := rows.Scan(&e.ID, &e.UserID, &e.EventDate, &e.Title, &e.Description, &e.Priority,
+			&e.ProjectID, &e.Color, &e.Tags, &e.ReminderAt, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan view event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// scanView scans a views row and decodes its filter.
+func scanView(row pgx.Row) (model.View, error) {
+	var v model.View
+	var filter []byte
+	if err := row.Scan(&v.ID, &v.UserID, &v.Name, &filter, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		return model.View{}, err
+	}
+
+	if err := json.Unmarshal(filter, &v.Filter); err != nil {
+		return model.View{}, fmt.Errorf("failed to decode view filter: %w", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,108 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepository_CreateView(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	view := model.View{UserID: uuid.New(), Name: "Next week", Filter: model.ViewFilter{Range: model.RangeNextWeek}}
+	id := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO views").
+		WithArgs(view.UserID, view.Name, []byte(`{"range":"next_week"}`)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+
+	got, err := repo.CreateView(context.Background(), view)
+	assert.NoError(t, err)
+	assert.Equal(t, id, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateView_Duplicate(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO views").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "views_user_id_name_key"})
+
+	_, err := repo.CreateView(context.Background(), model.View{UserID: uuid.New(), Name: "Today"})
+	assert.ErrorIs(t, err, ErrViewExists)
+}
+
+func TestRepository_GetView(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	viewID, userID := uuid.New(), uuid.New()
+	filter, _ := json.Marshal(model.ViewFilter{Priorities: []string{"high"}, Range: model.RangeToday})
+
+	mock.ExpectQuery("SELECT id, user_id, name, filter, created_at, updated_at\\s+FROM views").
+		WithArgs(viewID, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "filter", "created_at", "updated_at"}).
+			AddRow(viewID, userID, "Today", filter, time.Now(), time.Now()))
+
+	view, err := repo.GetView(context.Background(), viewID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"high"}, view.Filter.Priorities)
+	assert.Equal(t, model.RangeToday, view.Filter.Range)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteView_NotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM views").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteView(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, ErrViewNotFound)
+}
+
+func TestRepository_ListEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	userID := uuid.New()
+	from := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	mock.ExpectQuery(`WHERE user_id = \$1 AND deleted_at IS NULL AND event_date >= \$2 AND event_date < \$3 AND priority = ANY\(\$4\) AND project_id IS NULL\s+ORDER BY event_date, id LIMIT \$5`).
+		WithArgs(userID, from, to, []string{"high"}, 500).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "event_date", "title", "description", "priority", "project_id", "color", "tags", "reminder_at", "created_at", "updated_at"}).
+			AddRow(uuid.New(), userID, from, "Review", "", "high", (*uuid.UUID)(nil), "", []string{}, (*time.Time)(nil), time.Now(), time.Now()))
+
+	events, err := repo.ListEvents(context.Background(), userID, model.EventFilter{
+		From:           &from,
+		To:             &to,
+		Priorities:     []string{"high"},
+		WithoutProject: true,
+		Limit:          500,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,406 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule is returned when a recurrence rule cannot be parsed or uses unsupported parts.
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Recurrence frequencies.
+const (
+	Daily   = "DAILY"   // every INTERVAL days
+	Weekly  = "WEEKLY"  // every INTERVAL weeks, on the BYDAY weekdays
+	Monthly = "MONTHLY" // every INTERVAL months, on the BYMONTHDAY days or BYDAY weekdays
+	Yearly  = "YEARLY"  // every INTERVAL years, on the date of the first occurrence
+)
+
+const (
+	// maxInterval and maxCount bound the INTERVAL and COUNT parts, so a series stays cheap to expand.
+	maxInterval = 999
+	maxCount    = 1000
+
+	// maxPeriods caps the number of periods scanned by a single expansion, so rules that rarely
+	// or never match, such as the 31st of every February, cannot loop for long.
+	maxPeriods = 10000
+)
+
+// weekdays maps the two-letter RFC 5545 weekday codes to weekdays.
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// WeekdayNum is a BYDAY entry: a weekday, optionally limited to its N-th occurrence within a month.
+type WeekdayNum struct {
+	N   int          // occurrence within the month, 1 to 5 or -1 to -5 from the end; 0 for every occurrence
+	Day time.Weekday // the weekday
+}
+
+// Rule is a parsed RFC 5545 recurrence rule. Only the parts a calendar UI usually offers are supported:
+// FREQ, INTERVAL, COUNT, UNTIL, BYDAY and BYMONTHDAY.
+type Rule struct {
+	Freq       string       // DAILY, WEEKLY, MONTHLY or YEARLY
+	Interval   int          // number of periods between occurrences, at least 1
+	Count      int          // number of occurrences including the first one; 0 for no limit
+	Until      *time.Time   // last instant an occurrence may start at; nil for no limit
+	ByDay      []WeekdayNum // weekdays the occurrences fall on; ordinals only with MONTHLY
+	ByMonthDay []int        // days of the month, negative from the end; only with MONTHLY
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;BYDAY=MO,WE" with an optional "RRULE:" prefix.
+// Parts are case-insensitive.
+//
+// Parameters:
+//   - s: The recurrence rule.
+//
+// Returns:
+//   - The parsed rule.
+//   - ErrInvalidRule if the rule is malformed, combines COUNT with UNTIL, or uses an unsupported part.
+func Parse(s string) (Rule, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "RRULE:")
+
+	r := Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[key] {
+			return Rule{}, fmt.Errorf("%w: repeated part %s", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			if value != Daily && value != Weekly && value != Monthly && value != Yearly {
+				return Rule{}, fmt.Errorf("%w: unsupported frequency %s", ErrInvalidRule, value)
+			}
+			r.Freq = value
+		case "INTERVAL":
+			r.Interval, err = parseInt(value, 1, maxInterval)
+		case "COUNT":
+			r.Count, err = parseInt(value, 1, maxCount)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseUntil(value)
+			r.Until = &until
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				var day int
+				if day, err = parseInt(v, -31, 31); err != nil || day == 0 {
+					return Rule{}, fmt.Errorf("%w: invalid BYMONTHDAY %s", ErrInvalidRule, v)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, day)
+			}
+		case "WKST":
+			// Weeks always start on Monday, the RFC 5545 default.
+			if value != "MO" {
+				return Rule{}, fmt.Errorf("%w: unsupported WKST %s", ErrInvalidRule, value)
+			}
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, key)
+		}
+		if err != nil {
+			return Rule{}, fmt.Errorf("%w: invalid %s %s", ErrInvalidRule, key, value)
+		}
+	}
+
+	switch {
+	case r.Freq == "":
+		return Rule{}, fmt.Errorf("%w: missing FREQ", ErrInvalidRule)
+	case r.Count > 0 && r.Until != nil:
+		return Rule{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	case len(r.ByMonthDay) > 0 && r.Freq != Monthly:
+		return Rule{}, fmt.Errorf("%w: BYMONTHDAY requires FREQ=MONTHLY", ErrInvalidRule)
+	case len(r.ByDay) > 0 && r.Freq == Yearly:
+		return Rule{}, fmt.Errorf("%w: BYDAY is not supported with FREQ=YEARLY", ErrInvalidRule)
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly {
+			return Rule{}, fmt.Errorf("%w: BYDAY ordinals require FREQ=MONTHLY", ErrInvalidRule)
+		}
+	}
+
+	return r, nil
+}
+
+// parseInt parses a decimal integer within [lower, upper].
+func parseInt(value string, lower, upper int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < lower || n > upper {
+		return 0, fmt.Errorf("%d out of range", n)
+	}
+	return n, nil
+}
+
+// parseUntil parses an UNTIL value. A date without a time includes the whole day;
+// times without a Z suffix are read as UTC.
+func parseUntil(value string) (time.Time, error) {
+	if len(value) == len("20060102") {
+		t, err := time.Parse("20060102", value)
+		return t.Add(24*time.Hour - time.Second), err
+	}
+	return time.Parse("20060102T150405", strings.TrimSuffix(value, "Z"))
+}
+
+// parseByDay parses a BYDAY list such as "MO,WE" or "-1FR".
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, v := range strings.Split(value, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", v)
+		}
+
+		day, ok := weekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", v)
+		}
+
+		n := 0
+		if ordinal := v[:len(v)-2]; ordinal != "" {
+			var err error
+			if n, err = parseInt(strings.TrimPrefix(ordinal, "+"), -5, 5); err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid weekday %q", v)
+			}
+		}
+
+		days = append(days, WeekdayNum{N: n, Day: day})
+	}
+
+	return days, nil
+}
+
+// String returns the canonical form of the rule, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10".
+func (r Rule) String() string {
+	var b strings.Builder
+	b.WriteString("FREQ=" + r.Freq)
+	if r.Interval > 1 {
+		b.WriteString(";INTERVAL=" + strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			code := strings.ToUpper(d.Day.String()[:2])
+			if d.N != 0 {
+				code = strconv.Itoa(d.N) + code
+			}
+			codes = append(codes, code)
+		}
+		b.WriteString(";BYDAY=" + strings.Join(codes, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		b.WriteString(";BYMONTHDAY=" + strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		b.WriteString(";COUNT=" + strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		b.WriteString(";UNTIL=" + r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return b.String()
+}
+
+// Between returns the occurrences of a series in the range [from, to), in order.
+// Occurrences are generated in the location of start and keep its wall-clock time of day.
+// As in RFC 5545, start is always the first occurrence, even if it does not match the rule.
+//
+// Parameters:
+//   - start: The first occurrence of the series.
+//   - from: The start of the range, inclusive.
+//   - to: The end of the range, exclusive.
+//
+// Returns:
+//   - The occurrences within the range.
+func (r Rule) Between(start, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+	if !start.Before(from) && start.Before(to) {
+		occurrences = append(occurrences, start)
+	}
+
+	// Without a COUNT, the periods before the range cannot matter and are skipped.
+	period := 0
+	if r.Count == 0 {
+		period = r.periodsBefore(start, from)
+	}
+
+	n := 1
+	for i := 0; i < maxPeriods; i, period = i+1, period+1 {
+		base := r.periodStart(start, period)
+		if !base.Before(to) {
+			break
+		}
+
+		for _, t := range r.candidates(start, base) {
+			if !t.After(start) {
+				continue
+			}
+			if r.Until != nil && t.After(*r.Until) {
+				return occurrences
+			}
+			if n++; r.Count > 0 && n > r.Count {
+				return occurrences
+			}
+			if !t.Before(to) {
+				return occurrences
+			}
+			if !t.Before(from) {
+				occurrences = append(occurrences, t)
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// Includes reports whether t is an occurrence of the series starting at start.
+//
+// Parameters:
+//   - start: The first occurrence of the series.
+//   - t: The instant to check.
+//
+// Returns:
+//   - True if the series has an occurrence at exactly t.
+func (r Rule) Includes(start, t time.Time) bool {
+	return len(r.Between(start, t, t.Add(time.Nanosecond))) == 1
+}
+
+// periodsBefore returns a number of whole periods of the series that certainly end before from.
+func (r Rule) periodsBefore(start, from time.Time) int {
+	if !from.After(start) {
+		return 0
+	}
+
+	var periods int
+	switch r.Freq {
+	case Daily:
+		periods = int(from.Sub(start).Hours() / 24)
+	case Weekly:
+		periods = int(from.Sub(start).Hours() / (24 * 7))
+	case Monthly:
+		periods = (from.Year()-start.Year())*12 + int(from.Month()) - int(start.Month())
+	case Yearly:
+		periods = from.Year() - start.Year()
+	}
+
+	// One period of slack covers DST shifts and partial periods.
+	return max(periods/r.Interval-1, 0)
+}
+
+// periodStart returns the midnight the k-th period of the series starts at, in the location of start.
+// Weeks start on Monday.
+func (r Rule) periodStart(start time.Time, k int) time.Time {
+	y, m, d := start.Date()
+	loc := start.Location()
+	step := k * r.Interval
+
+	switch r.Freq {
+	case Weekly:
+		monday := d - (int(start.Weekday())+6)%7
+		return time.Date(y, m, monday+7*step, 0, 0, 0, 0, loc)
+	case Monthly:
+		return time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+	case Yearly:
+		return time.Date(y+step, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(y, m, d+step, 0, 0, 0, 0, loc)
+	}
+}
+
+// candidates returns the instants of the period starting at base that match the rule, in order.
+func (r Rule) candidates(start, base time.Time) []time.Time {
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	}
+	y, m, d := base.Date()
+
+	var out []time.Time
+	switch r.Freq {
+	case Daily:
+		t := at(y, m, d)
+		if len(r.ByDay) == 0 || r.matchesWeekday(t, 0) {
+			out = append(out, t)
+		}
+	case Weekly:
+		for i := 0; i < 7; i++ {
+			t := at(y, m, d+i)
+			if (len(r.ByDay) == 0 && t.Weekday() == start.Weekday()) || r.matchesWeekday(t, 0) {
+				out = append(out, t)
+			}
+		}
+	case Monthly:
+		days := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		for day := 1; day <= days; day++ {
+			t := at(y, m, day)
+			if r.matchesMonthDay(t, start, days) {
+				out = append(out, t)
+			}
+		}
+	case Yearly:
+		// Anniversaries on February 29 only occur in leap years.
+		if t := at(y, start.Month(), start.Day()); t.Day() == start.Day() {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// matchesWeekday reports whether the weekday of t is in BYDAY. days is the length of the month
+// of t, used for ordinals counted from the end; it is 0 when ordinals do not apply.
+func (r Rule) matchesWeekday(t time.Time, days int) bool {
+	for _, d := range r.ByDay {
+		if d.Day != t.Weekday() {
+			continue
+		}
+		switch {
+		case d.N == 0:
+			return true
+		case d.N > 0 && (t.Day()-1)/7+1 == d.N:
+			return true
+		case d.N < 0 && days > 0 && -((days-t.Day())/7+1) == d.N:
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonthDay reports whether the day t of a month with the given number of days matches a MONTHLY rule.
+// Without BYMONTHDAY and BYDAY, the series repeats on the day of the month of start; months without that day are skipped.
+func (r Rule) matchesMonthDay(t, start time.Time, days int) bool {
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		return t.Day() == start.Day()
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		matched := false
+		for _, d := range r.ByMonthDay {
+			if d == t.Day() || (d < 0 && days+d+1 == t.Day()) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return len(r.ByDay) == 0 || r.matchesWeekday(t, days)
+}
@@ -0,0 +1,213 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:freq=weekly;byday=mo,we;interval=2", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12"},
+		{"FREQ=MONTHLY;BYDAY=+2TU", "FREQ=MONTHLY;BYDAY=2TU"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1", "FREQ=MONTHLY;BYMONTHDAY=1,-1"},
+		{"FREQ=YEARLY;UNTIL=20301231", "FREQ=YEARLY;UNTIL=20301231T235959Z"},
+		{"FREQ=WEEKLY;WKST=MO;UNTIL=20301231T120000Z", "FREQ=WEEKLY;UNTIL=20301231T120000Z"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.rule)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", tt.rule, err)
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.rule, got, tt.want)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=1001",
+		"FREQ=DAILY;COUNT=3;UNTIL=20301231",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=YEARLY;BYDAY=MO",
+		"FREQ=YEARLY;BYMONTH=1",
+		"FREQ=WEEKLY;WKST=SU",
+		"FREQ=DAILY;",
+	} {
+		if _, err := Parse(rule); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidRule", rule, err)
+		}
+	}
+}
+
+func TestRule_Between(t *testing.T) {
+	at := func(y int, m time.Month, d, h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		rule     string
+		start    time.Time
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY",
+			start: at(2030, 1, 1, 9),
+			from:  at(2030, 1, 1, 0), to: at(2030, 1, 4, 0),
+			want: []time.Time{at(2030, 1, 1, 9), at(2030, 1, 2, 9), at(2030, 1, 3, 9)},
+		},
+		{
+			name:  "daily far after the start",
+			rule:  "FREQ=DAILY;INTERVAL=3",
+			start: at(2000, 1, 1, 9),
+			from:  at(2030, 1, 1, 0), to: at(2030, 1, 7, 0),
+			want: []time.Time{at(2030, 1, 2, 9), at(2030, 1, 5, 9)},
+		},
+		{
+			name:  "weekdays",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: at(2030, 1, 3, 9), // Thursday
+			from:  at(2030, 1, 1, 0), to: at(2030, 1, 8, 0),
+			want: []time.Time{at(2030, 1, 3, 9), at(2030, 1, 4, 9), at(2030, 1, 7, 9)},
+		},
+		{
+			name:  "every other week on two days",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			start: at(2030, 1, 7, 10), // Monday
+			from:  at(2030, 1, 1, 0), to: at(2030, 2, 1, 0),
+			want: []time.Time{at(2030, 1, 7, 10), at(2030, 1, 9, 10), at(2030, 1, 21, 10), at(2030, 1, 23, 10)},
+		},
+		{
+			name:  "start does not match the rule",
+			rule:  "FREQ=WEEKLY;BYDAY=FR;COUNT=3",
+			start: at(2030, 1, 8, 10), // Tuesday
+			from:  at(2030, 1, 1, 0), to: at(2030, 3, 1, 0),
+			want: []time.Time{at(2030, 1, 8, 10), at(2030, 1, 11, 10), at(2030, 1, 18, 10)},
+		},
+		{
+			name:  "monthly skips months without the day",
+			rule:  "FREQ=MONTHLY",
+			start: at(2030, 1, 31, 8),
+			from:  at(2030, 1, 1, 0), to: at(2030, 6, 1, 0),
+			want: []time.Time{at(2030, 1, 31, 8), at(2030, 3, 31, 8), at(2030, 5, 31, 8)},
+		},
+		{
+			name:  "last friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: at(2030, 1, 25, 16),
+			from:  at(2030, 2, 1, 0), to: at(2030, 4, 1, 0),
+			want: []time.Time{at(2030, 2, 22, 16), at(2030, 3, 29, 16)},
+		},
+		{
+			name:  "second tuesday",
+			rule:  "FREQ=MONTHLY;BYDAY=2TU;COUNT=2",
+			start: at(2030, 1, 8, 12),
+			from:  at(2030, 1, 1, 0), to: at(2031, 1, 1, 0),
+			want: []time.Time{at(2030, 1, 8, 12), at(2030, 2, 12, 12)},
+		},
+		{
+			name:  "last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: at(2030, 1, 31, 18),
+			from:  at(2030, 2, 1, 0), to: at(2030, 4, 1, 0),
+			want: []time.Time{at(2030, 2, 28, 18), at(2030, 3, 31, 18)},
+		},
+		{
+			name:  "yearly on february 29",
+			rule:  "FREQ=YEARLY",
+			start: at(2028, 2, 29, 9),
+			from:  at(2029, 1, 1, 0), to: at(2033, 1, 1, 0),
+			want: []time.Time{at(2032, 2, 29, 9)},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  "FREQ=DAILY;UNTIL=20300103T090000Z",
+			start: at(2030, 1, 1, 9),
+			from:  at(2030, 1, 1, 0), to: at(2030, 2, 1, 0),
+			want: []time.Time{at(2030, 1, 1, 9), at(2030, 1, 2, 9), at(2030, 1, 3, 9)},
+		},
+		{
+			name:  "range before the start",
+			rule:  "FREQ=DAILY",
+			start: at(2030, 1, 1, 9),
+			from:  at(2029, 12, 1, 0), to: at(2030, 1, 1, 0),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.rule, err)
+			}
+
+			got := r.Between(tt.start, tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRule_Between_KeepsWallClockTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	r, err := Parse("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// The week after the switch to summer time, 09:00 in Berlin is an hour earlier in UTC.
+	start := time.Date(2030, 3, 25, 9, 0, 0, 0, berlin)
+	got := r.Between(start, start.AddDate(0, 0, 6), start.AddDate(0, 0, 8))
+	if len(got) != 1 || got[0].Hour() != 9 || got[0].UTC().Hour() != 7 {
+		t.Fatalf("Between() = %v, want 09:00 CEST", got)
+	}
+}
+
+func TestRule_Includes(t *testing.T) {
+	r, err := Parse("FREQ=WEEKLY;BYDAY=TU,TH")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC) // Tuesday
+	if !r.Includes(start, start) {
+		t.Error("the start is an occurrence")
+	}
+	if !r.Includes(start, time.Date(2030, 1, 3, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Thursday 09:00 is an occurrence")
+	}
+	if r.Includes(start, time.Date(2030, 1, 3, 10, 0, 0, 0, time.UTC)) {
+		t.Error("Thursday 10:00 is not an occurrence")
+	}
+	if r.Includes(start, time.Date(2029, 12, 27, 9, 0, 0, 0, time.UTC)) {
+		t.Error("days before the start are not occurrences")
+	}
+}
@@ -0,0 +1,167 @@
+package archiveformat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/archiveformat/mock_archiveformat.go -package=mocks
+
+const (
+	batchSize     = 500 // number of archived events backfilled or compared per query
+	maxMismatches = 100 // number of mismatched events listed in the result
+)
+
+// archiveRepo defines the database operations on the two formats of archived reminders.
+type archiveRepo interface {
+	// BackfillArchivedReminders writes a batch of archived events that are not yet in the new format in it.
+	BackfillArchivedReminders(ctx context.Context, limit int) (int, error)
+
+	// CountArchivedEvents counts the archived events of all users.
+	CountArchivedEvents(ctx context.Context) (int, error)
+
+	// CompareArchivedReminders compares both formats of a batch of archived events, ordered by event ID.
+	CompareArchivedReminders(ctx context.Context, after uuid.UUID, limit int) ([]model.ArchiveFormatCheck, error)
+}
+
+// jobService defines the background jobs verifications run as.
+type jobService interface {
+	// Enqueue queues a job for the worker pool.
+	Enqueue(ctx context.Context, job model.Job) (model.Job, error)
+}
+
+// Service manages the verification of the new archive format, which stores the reminders of archived events
+// with the events instead of in archived_reminders. While the archiver writes both formats, verification jobs
+// backfill the events archived before and compare the formats, so the old one can be dropped once they match.
+// Service is the runner of archive verification jobs.
+type Service struct {
+	repo archiveRepo // Repository of the archived events
+	jobs jobService  // Background jobs the verifications run as
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - r: The repository of the archived events.
+//   - j: The job service verifications run as.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r archiveRepo, j jobService) *Service {
+	return &Service{
+		repo: r,
+		jobs: j,
+	}
+}
+
+// Start queues a job verifying the new archive format.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the admin starting the verification.
+//   - req: Whether events not yet in the new format are backfilled first.
+//
+// Returns:
+//   - The queued job, whose progress and result are polled through the jobs API.
+//   - An error if the job cannot be queued.
+func (s *Service) Start(ctx context.Context, userID uuid.UUID, req model.ArchiveVerification) (model.Job, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start archive verification: %w", err)
+	}
+
+	job, err := s.jobs.Enqueue(ctx, model.Job{
+		UserID:  userID,
+		Kind:    model.JobArchiveVerification,
+		Payload: payload,
+	})
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start archive verification: %w", err)
+	}
+
+	return job, nil
+}
+
+// Run backfills the new archive format if requested, then compares both formats of every archived event
+// in batches, reporting a model.ArchiveVerificationResult after each batch. Nothing but the backfill is written.
+//
+// Parameters:
+//   - ctx: The context of the job; the verification stops between batches when it is done.
+//   - job: The verification job with the model.ArchiveVerification as payload.
+//   - p: The progress of the job; a unit of work is a compared event.
+//
+// Returns:
+//   - An error if the payload is invalid, a batch cannot be backfilled or compared, or ctx is done.
+//     Batches backfilled before the error are kept.
+func (s *Service) Run(ctx context.Context, job model.Job, p *jobsvc.Progress) error {
+	var req model.ArchiveVerification
+	if err := json.Unmarshal(job.Payload, &req); err != nil {
+		return fmt.Errorf("invalid archive verification request: %w", err)
+	}
+
+	result := model.ArchiveVerificationResult{MismatchedIDs: []uuid.UUID{}}
+	for req.Backfill {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, err := s.repo.BackfillArchivedReminders(ctx, batchSize)
+		if err != nil {
+			return err
+		}
+		result.Backfilled += n
+		if n < batchSize {
+			break
+		}
+	}
+
+	total, err := s.repo.CountArchivedEvents(ctx)
+	if err != nil {
+		return err
+	}
+	p.SetTotal(total)
+	p.SetResult(result)
+
+	after := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, err := s.repo.CompareArchivedReminders(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		for _, c := range batch {
+			result.Checked++
+			switch {
+			case !c.Written:
+				result.Missing++
+			case !c.Matches:
+				result.Mismatched++
+				if len(result.MismatchedIDs) < maxMismatches {
+					result.MismatchedIDs = append(result.MismatchedIDs, c.EventID)
+				}
+			}
+		}
+
+		after = batch[len(batch)-1].EventID
+		p.Add(len(batch))
+
+		// The result is stored with the progress, so it must not be modified after it has been set.
+		snapshot := result
+		snapshot.MismatchedIDs = slices.Clone(result.MismatchedIDs)
+		p.SetResult(snapshot)
+	}
+}
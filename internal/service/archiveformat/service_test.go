@@ -0,0 +1,86 @@
+package archiveformat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	archiveformatmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/archiveformat"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+func newJob(t *testing.T, req model.ArchiveVerification) model.Job {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+	return model.Job{ID: uuid.New(), Kind: model.JobArchiveVerification, Payload: payload}
+}
+
+func TestService_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jobs := archiveformatmocks.NewMockjobService(ctrl)
+	svc := New(archiveformatmocks.NewMockarchiveRepo(ctrl), jobs)
+
+	userID := uuid.New()
+	jobs.EXPECT().
+		Enqueue(gomock.Any(), model.Job{UserID: userID, Kind: model.JobArchiveVerification, Payload: []byte(`{"backfill":true}`)}).
+		Return(model.Job{ID: uuid.New(), Kind: model.JobArchiveVerification, Status: model.JobQueued}, nil)
+
+	if _, err := svc.Start(context.Background(), userID, model.ArchiveVerification{Backfill: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := archiveformatmocks.NewMockarchiveRepo(ctrl)
+	svc := New(repo, archiveformatmocks.NewMockjobService(ctrl))
+
+	matching := model.ArchiveFormatCheck{EventID: uuid.New(), Written: true, Matches: true}
+	missing := model.ArchiveFormatCheck{EventID: uuid.New()}
+	mismatched := model.ArchiveFormatCheck{EventID: uuid.New(), Written: true}
+
+	gomock.InOrder(
+		repo.EXPECT().BackfillArchivedReminders(gomock.Any(), batchSize).Return(batchSize, nil),
+		repo.EXPECT().BackfillArchivedReminders(gomock.Any(), batchSize).Return(7, nil),
+		repo.EXPECT().CountArchivedEvents(gomock.Any()).Return(3, nil),
+		repo.EXPECT().CompareArchivedReminders(gomock.Any(), uuid.Nil, batchSize).
+			Return([]model.ArchiveFormatCheck{matching, missing, mismatched}, nil),
+		repo.EXPECT().CompareArchivedReminders(gomock.Any(), mismatched.EventID, batchSize).Return(nil, nil),
+	)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), newJob(t, model.ArchiveVerification{Backfill: true}), p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed, total := p.Counts(); processed != 3 || total != 3 {
+		t.Fatalf("expected 3 of 3 events processed, got %d of %d", processed, total)
+	}
+	got := p.Result().(model.ArchiveVerificationResult)
+	if got.Backfilled != batchSize+7 || got.Checked != 3 || got.Missing != 1 || got.Mismatched != 1 ||
+		len(got.MismatchedIDs) != 1 || got.MismatchedIDs[0] != mismatched.EventID {
+		t.Fatalf("unexpected result %+v", got)
+	}
+}
+
+func TestService_Run_WithoutBackfill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := archiveformatmocks.NewMockarchiveRepo(ctrl)
+	svc := New(repo, archiveformatmocks.NewMockjobService(ctrl))
+
+	// Only comparing, nothing is written.
+	repo.EXPECT().CountArchivedEvents(gomock.Any()).Return(0, nil)
+	repo.EXPECT().CompareArchivedReminders(gomock.Any(), uuid.Nil, batchSize).Return(nil, errors.New("connection reset"))
+
+	if err := svc.Run(context.Background(), newJob(t, model.ArchiveVerification{}), &jobsvc.Progress{}); err == nil {
+		t.Fatal("expected the comparison error")
+	}
+}
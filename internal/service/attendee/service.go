@@ -0,0 +1,522 @@
+package attendee
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	attendeerepo "github.com/aliskhannn/calendar-service/internal/repository/attendee"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/attendee/mock_attendee.go -package=mocks
+
+var (
+	ErrInvalidResponse = errors.New("response must be accepted or declined")
+	ErrInvalidETA      = errors.New("eta must be in the future")
+
+	ErrGuestRSVPDisabled = errors.New("rsvp links are not configured")
+)
+
+const (
+	// tokenBytes is the number of random bytes of an RSVP token.
+	tokenBytes = 32
+
+	// timeFormat is the format of event times in invitations.
+	timeFormat = "Mon, 2 Jan 2006 15:04 MST"
+
+	// defaultGuestTTL and defaultGuestCooldown are the lifetime of guest RSVP links and the time between two
+	// of them used when none are configured.
+	defaultGuestTTL      = 24 * time.Hour
+	defaultGuestCooldown = time.Minute
+)
+
+// attendeeRepo defines the interface for attendee-related database operations.
+type attendeeRepo interface {
+	// Invite invites the user with the given email address to an event of the owner.
+	Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error)
+
+	// ListAttendees retrieves the attendees of an event.
+	ListAttendees(ctx context.Context, eventID uuid.UUID) ([]model.Attendee, error)
+
+	// CanView reports whether a user owns an event or is invited to it.
+	CanView(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+
+	// Respond records the response of an attendee to their invitation.
+	Respond(ctx context.Context, eventID, userID uuid.UUID, status string) (model.Attendee, error)
+
+	// RemoveAttendee withdraws the invitation of a user to an event of the owner.
+	RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error
+
+	// InviteExternal invites a person without an account, identified by email address, to an event of the owner.
+	InviteExternal(ctx context.Context, eventID, ownerID uuid.UUID, email, tokenHash string) (model.Invitation, error)
+
+	// RespondExternal records the response of an external attendee, identified by the token of their RSVP link.
+	RespondExternal(ctx context.Context, tokenHash, status string) (model.Attendee, error)
+
+	// MarkLate records that an attendee who accepted an event is running late.
+	MarkLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.LateNotice, error)
+
+	// RequestGuestRSVP stores a new RSVP link for a guest of an event shared by a short link.
+	RequestGuestRSVP(
+		ctx context.Context, code, email, tokenHash string, expiresAt, now, resendAfter time.Time,
+	) (model.GuestRequest, error)
+
+	// RespondGuest records the response of a guest, identified by the token of their RSVP link.
+	RespondGuest(ctx context.Context, tokenHash, status string, now time.Time) (model.Guest, error)
+
+	// ListGuests retrieves the guests of an event.
+	ListGuests(ctx context.Context, eventID uuid.UUID) ([]model.Guest, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// lateNotifier defines the notification of the people attending an event that one of them is running late.
+type lateNotifier interface {
+	// Recipients retrieves the attendees who accepted an event and are notified of its changes.
+	Recipients(ctx context.Context, eventID uuid.UUID) []model.Attendee
+
+	// NotifyLate notifies the organizer and the other attendees of an event that an attendee is running late.
+	NotifyLate(ctx context.Context, recipients []model.Attendee, notice model.LateNotice)
+}
+
+// sender defines the delivery of email notifications.
+type sender interface {
+	// Send sends a plain text email. The tenant in ctx, if any, is attached to the message.
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// Service manages business logic for attendees, users invited to the events of another user.
+// Attendees who accepted an event can report that they are running late, which notifies its organizer and
+// the other attendees.
+// People without an account are invited by email, with an RSVP link to answer; with tenancy enabled,
+// the tenant is part of the token of the link, since it is opened without a tenant header.
+// Guests of events shared by short links answer them the same way, with short-lived RSVP links they ask for.
+type Service struct {
+	attendeeRepo attendeeRepo      // Repository for attendee database operations
+	config       config.Invitation // Base URL of RSVP links and lifetime of guest links
+	cipher       contentCipher     // Decryption of event titles for invitations and late notices
+	notifier     lateNotifier      // Notification of the organizer and attendees about late attendees
+	sender       sender            // Email delivery of invitations
+	logger       *zap.Logger       // Logger for invitations that cannot be delivered
+}
+
+// New creates a new Service instance with the provided attendee repository, invitation configuration,
+// content cipher, late notifier, email sender, and logger.
+//
+// Parameters:
+//   - r: The attendee repository for database operations.
+//   - cfg: The invitation configuration; without a base URL, only registered users can be invited
+//     and guests cannot answer shared events.
+//   - c: The cipher for event titles.
+//   - n: The notifier of the organizer and attendees about late attendees.
+//   - snd: The email sender for invitations.
+//   - l: The logger for invitations that cannot be delivered.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r attendeeRepo, cfg config.Invitation, c contentCipher, n lateNotifier, snd sender, l *zap.Logger) *Service {
+	if cfg.GuestTTL <= 0 {
+		cfg.GuestTTL = defaultGuestTTL
+	}
+	if cfg.GuestCooldown <= 0 {
+		cfg.GuestCooldown = defaultGuestCooldown
+	}
+
+	return &Service{
+		attendeeRepo: r,
+		config:       cfg,
+		cipher:       c,
+		notifier:     n,
+		sender:       snd,
+		logger:       l,
+	}
+}
+
+// Invite invites another user, identified by email address, to an event of the owner.
+// The event shows up in the invited user's calendar until they decline it.
+// If no user has the address and RSVP links are configured, the person is invited as an external attendee
+// and emailed an RSVP link; the invitation becomes theirs when they register with the address.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//   - email: The email address of the invited user; surrounding whitespace is ignored.
+//
+// Returns:
+//   - The attendee.
+//   - An error if the event does not exist, the user is the owner, no user has the address and RSVP links
+//     are not configured, or the invitation fails.
+func (s *Service) Invite(ctx context.Context, eventID, ownerID uuid.UUID, email string) (model.Attendee, error) {
+	email = strings.TrimSpace(email)
+
+	a, err := s.attendeeRepo.Invite(ctx, eventID, ownerID, email)
+	if errors.Is(err, attendeerepo.ErrUserNotFound) && s.config.BaseURL != "" {
+		return s.inviteExternal(ctx, eventID, ownerID, email)
+	}
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("invite attendee: %w", err)
+	}
+
+	return a, nil
+}
+
+// inviteExternal invites a person without an account with a new RSVP token and emails them the invitation,
+// with the event attached as an iCalendar file.
+// Sending is best effort: the invitation is stored, and inviting the person again sends a new link.
+func (s *Service) inviteExternal(ctx context.Context, eventID, ownerID uuid.UUID, address string) (model.Attendee, error) {
+	token, err := newToken(ctx)
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("invite attendee: failed to generate token: %w", err)
+	}
+
+	inv, err := s.attendeeRepo.InviteExternal(ctx, eventID, ownerID, address, hashToken(token))
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("invite attendee: %w", err)
+	}
+
+	event := inv.Event
+	if event.Title, err = s.cipher.Decrypt(ctx, ownerID, event.Title); err != nil {
+		return model.Attendee{}, fmt.Errorf("invite attendee: %w", err)
+	}
+
+	link := s.config.BaseURL + "/rsvp?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("%s invited you to \"%s\" on %s.\n\nAccept: %s\nDecline: %s\n\n"+
+		"Register with this email address to see the event in your calendar.",
+		inv.Organizer, event.Title, event.EventDate.UTC().Format(timeFormat),
+		link+"&response="+model.AttendeeAccepted, link+"&response="+model.AttendeeDeclined)
+
+	// The event is attached, so calendar clients can add it with an alarm matching its reminder.
+	msgCtx := ctx
+	if invite, err := email.EventAttachment(event); err != nil {
+		s.logger.Warn("failed to attach event to invitation", zap.String("event_id", eventID.String()), zap.Error(err))
+	} else {
+		msgCtx = email.WithAttachments(ctx, invite)
+	}
+
+	if err := s.sender.Send(msgCtx, inv.Attendee.Email, "Invitation: "+event.Title, body); err != nil {
+		s.logger.Warn("failed to send invitation", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+
+	return inv.Attendee, nil
+}
+
+// RSVP records the response of an external attendee through the RSVP link of their invitation.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The token of the RSVP link; its tenant, if any, selects the database.
+//   - response: The response, model.AttendeeAccepted or model.AttendeeDeclined.
+//
+// Returns:
+//   - The updated attendee.
+//   - ErrInvalidResponse for other responses, an error wrapping attendeerepo.ErrInvitationNotFound
+//     for an unknown token, or another error if the update fails.
+func (s *Service) RSVP(ctx context.Context, token, response string) (model.Attendee, error) {
+	if response != model.AttendeeAccepted && response != model.AttendeeDeclined {
+		return model.Attendee{}, ErrInvalidResponse
+	}
+
+	if i := strings.LastIndex(token, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, token[:i])
+	}
+
+	a, err := s.attendeeRepo.RespondExternal(ctx, hashToken(token), response)
+	if err != nil {
+		// A token naming no or an unknown tenant is as unknown as a token that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = attendeerepo.ErrInvitationNotFound
+		}
+		return model.Attendee{}, fmt.Errorf("rsvp: %w", err)
+	}
+
+	return a, nil
+}
+
+// RequestGuestRSVP emails a guest of an event shared by a short link an RSVP link to answer it.
+// Asking is open to anyone with the code, so links expire after the configured lifetime, and a guest
+// gets a new link, replacing the previous one, only once the configured cooldown has passed.
+// Sending is best effort: the link is stored, and asking again after the cooldown sends a new one.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - code: The short code of the link; its tenant, if any, selects the database.
+//   - address: The email address of the guest; surrounding whitespace is ignored.
+//
+// Returns:
+//   - The guest.
+//   - ErrGuestRSVPDisabled without a base URL for RSVP links, an error wrapping attendeerepo.ErrSharedEventNotFound
+//     for an unknown or expired code, attendeerepo.ErrGuestCooldown if a link was sent recently,
+//     or another error if the request fails.
+func (s *Service) RequestGuestRSVP(ctx context.Context, code, address string) (model.Guest, error) {
+	if s.config.BaseURL == "" {
+		return model.Guest{}, ErrGuestRSVPDisabled
+	}
+
+	if i := strings.LastIndex(code, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, code[:i])
+	}
+
+	token, err := newToken(ctx)
+	if err != nil {
+		return model.Guest{}, fmt.Errorf("request guest rsvp: failed to generate token: %w", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.config.GuestTTL)
+	req, err := s.attendeeRepo.RequestGuestRSVP(ctx, code, strings.TrimSpace(address), hashToken(token),
+		expiresAt, now, now.Add(-s.config.GuestCooldown))
+	if err != nil {
+		// A code naming no or an unknown tenant is as unknown as a code that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = attendeerepo.ErrSharedEventNotFound
+		}
+		return model.Guest{}, fmt.Errorf("request guest rsvp: %w", err)
+	}
+
+	// Busy links only reveal when the event takes place.
+	title := "an event"
+	if req.Visibility != model.ShortLinkBusy {
+		if title, err = s.cipher.Decrypt(ctx, req.Event.UserID, req.Event.Title); err != nil {
+			return model.Guest{}, fmt.Errorf("request guest rsvp: %w", err)
+		}
+		title = "\"" + title + "\""
+	}
+
+	link := s.config.BaseURL + "/guests/rsvp?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("You asked to answer %s on %s.\n\nAccept: %s\nDecline: %s\n\n"+
+		"The links expire on %s. If you did not ask for them, ignore this email.",
+		title, req.Event.EventDate.UTC().Format(timeFormat),
+		link+"&response="+model.AttendeeAccepted, link+"&response="+model.AttendeeDeclined,
+		expiresAt.UTC().Format(timeFormat))
+
+	if err := s.sender.Send(ctx, req.Guest.Email, "Your RSVP link", body); err != nil {
+		s.logger.Warn("failed to send guest rsvp link", zap.String("event_id", req.Event.ID.String()), zap.Error(err))
+	}
+
+	return req.Guest, nil
+}
+
+// GuestRSVP records the response of a guest through their RSVP link.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The token of the RSVP link; its tenant, if any, selects the database.
+//   - response: The response, model.AttendeeAccepted or model.AttendeeDeclined.
+//
+// Returns:
+//   - The updated guest.
+//   - ErrInvalidResponse for other responses, an error wrapping attendeerepo.ErrGuestNotFound for an unknown
+//     token or attendeerepo.ErrGuestLinkExpired for an expired one, or another error if the update fails.
+func (s *Service) GuestRSVP(ctx context.Context, token, response string) (model.Guest, error) {
+	if response != model.AttendeeAccepted && response != model.AttendeeDeclined {
+		return model.Guest{}, ErrInvalidResponse
+	}
+
+	if i := strings.LastIndex(token, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, token[:i])
+	}
+
+	g, err := s.attendeeRepo.RespondGuest(ctx, hashToken(token), response, time.Now())
+	if err != nil {
+		// A token naming no or an unknown tenant is as unknown as a token that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = attendeerepo.ErrGuestNotFound
+		}
+		return model.Guest{}, fmt.Errorf("guest rsvp: %w", err)
+	}
+
+	return g, nil
+}
+
+// ListGuests retrieves the guests who asked to answer an event, visible to its owner and its attendees.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user requesting the list.
+//
+// Returns:
+//   - A slice of guests.
+//   - ErrEventNotFound if the user neither owns the event nor is invited to it.
+//   - An error if the retrieval fails.
+func (s *Service) ListGuests(ctx context.Context, eventID, userID uuid.UUID) ([]model.Guest, error) {
+	ok, err := s.attendeeRepo.CanView(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list guests: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("list guests: %w", attendeerepo.ErrEventNotFound)
+	}
+
+	guests, err := s.attendeeRepo.ListGuests(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list guests: %w", err)
+	}
+
+	return guests, nil
+}
+
+// ListAttendees retrieves the attendees of an event, visible to its owner and its attendees.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user requesting the list.
+//
+// Returns:
+//   - A slice of attendees.
+//   - ErrEventNotFound if the user neither owns the event nor is invited to it.
+//   - An error if the retrieval fails.
+func (s *Service) ListAttendees(ctx context.Context, eventID, userID uuid.UUID) ([]model.Attendee, error) {
+	ok, err := s.attendeeRepo.CanView(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list attendees: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("list attendees: %w", attendeerepo.ErrEventNotFound)
+	}
+
+	attendees, err := s.attendeeRepo.ListAttendees(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list attendees: %w", err)
+	}
+
+	return attendees, nil
+}
+
+// Accept records that a user takes part in an event they are invited to.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the invited user.
+//
+// Returns:
+//   - The updated attendee.
+//   - An error if the user is not invited to the event or the update fails.
+func (s *Service) Accept(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error) {
+	a, err := s.attendeeRepo.Respond(ctx, eventID, userID, model.AttendeeAccepted)
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("accept invitation: %w", err)
+	}
+
+	return a, nil
+}
+
+// Decline records that a user does not take part in an event they are invited to.
+// The event no longer shows up in their calendar; they can still accept it later.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the invited user.
+//
+// Returns:
+//   - The updated attendee.
+//   - An error if the user is not invited to the event or the update fails.
+func (s *Service) Decline(ctx context.Context, eventID, userID uuid.UUID) (model.Attendee, error) {
+	a, err := s.attendeeRepo.Respond(ctx, eventID, userID, model.AttendeeDeclined)
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("decline invitation: %w", err)
+	}
+
+	return a, nil
+}
+
+// RunningLate records that a user who accepted an event is running late and notifies its organizer and the
+// other attendees who accepted it of the time they expect to arrive. Reporting again replaces the time.
+// Notifications are best effort: the report is stored even if they cannot be sent.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the late attendee.
+//   - eta: The time the attendee expects to arrive.
+//
+// Returns:
+//   - The updated attendee.
+//   - ErrInvalidETA if the time is not in the future, an error wrapping attendeerepo.ErrAttendeeNotFound
+//     if the user has not accepted the event, or another error if the update fails.
+func (s *Service) RunningLate(ctx context.Context, eventID, userID uuid.UUID, eta time.Time) (model.Attendee, error) {
+	if !eta.After(time.Now()) {
+		return model.Attendee{}, ErrInvalidETA
+	}
+
+	notice, err := s.attendeeRepo.MarkLate(ctx, eventID, userID, eta)
+	if err != nil {
+		return model.Attendee{}, fmt.Errorf("mark running late: %w", err)
+	}
+
+	if notice.Event.Title, err = s.cipher.Decrypt(ctx, notice.Event.UserID, notice.Event.Title); err != nil {
+		s.logger.Warn("failed to notify late attendee", zap.String("event_id", eventID.String()), zap.Error(err))
+		return notice.Attendee, nil
+	}
+
+	// The organizer is notified like the attendees, who do not need to hear about themselves.
+	recipients := slices.DeleteFunc(s.notifier.Recipients(ctx, eventID), func(a model.Attendee) bool {
+		return !a.External && a.UserID == userID
+	})
+	recipients = append(recipients, model.Attendee{EventID: eventID, UserID: notice.Event.UserID, Email: notice.OrganizerEmail})
+	s.notifier.NotifyLate(ctx, recipients, notice)
+
+	return notice.Attendee, nil
+}
+
+// RemoveAttendee withdraws the invitation of a user to an event of the owner.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event.
+//   - userID: The UUID of the attendee.
+//
+// Returns:
+//   - An error if the user is not invited to an event of the owner or the removal fails.
+func (s *Service) RemoveAttendee(ctx context.Context, eventID, ownerID, userID uuid.UUID) error {
+	if err := s.attendeeRepo.RemoveAttendee(ctx, eventID, ownerID, userID); err != nil {
+		return fmt.Errorf("remove attendee: %w", err)
+	}
+
+	return nil
+}
+
+// newToken generates an RSVP token, prefixed with the tenant of ctx, if any.
+func newToken(ctx context.Context) (string, error) {
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		token = tenantID + "." + token
+	}
+
+	return token, nil
+}
+
+// hashToken returns the hex-encoded SHA-256 digest of an RSVP token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,313 @@
+package attendee
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	attendeerepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/attendee"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	attendeerepo "github.com/aliskhannn/calendar-service/internal/repository/attendee"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+type mocks struct {
+	repo     *attendeerepomocks.MockattendeeRepo
+	cipher   *attendeerepomocks.MockcontentCipher
+	notifier *attendeerepomocks.MocklateNotifier
+	sender   *attendeerepomocks.Mocksender
+}
+
+func newTestService(t *testing.T, baseURL string) (*Service, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		repo:     attendeerepomocks.NewMockattendeeRepo(ctrl),
+		cipher:   attendeerepomocks.NewMockcontentCipher(ctrl),
+		notifier: attendeerepomocks.NewMocklateNotifier(ctrl),
+		sender:   attendeerepomocks.NewMocksender(ctrl),
+	}
+	return New(m.repo, config.Invitation{BaseURL: baseURL}, m.cipher, m.notifier, m.sender, zap.NewNop()), m
+}
+
+func TestService_ListAttendees_NotVisible(t *testing.T) {
+	svc, m := newTestService(t, "")
+	mockRepo := m.repo
+
+	eventID, userID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().CanView(gomock.Any(), eventID, userID).Return(false, nil)
+
+	_, err := svc.ListAttendees(context.Background(), eventID, userID)
+	if !errors.Is(err, attendeerepo.ErrEventNotFound) {
+		t.Fatalf("expected ErrEventNotFound, got %v", err)
+	}
+}
+
+func TestService_AcceptDecline(t *testing.T) {
+	svc, m := newTestService(t, "")
+	mockRepo := m.repo
+
+	eventID, userID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().Respond(gomock.Any(), eventID, userID, model.AttendeeAccepted).Return(model.Attendee{Status: model.AttendeeAccepted}, nil)
+	mockRepo.EXPECT().Respond(gomock.Any(), eventID, userID, model.AttendeeDeclined).Return(model.Attendee{Status: model.AttendeeDeclined}, nil)
+
+	if a, err := svc.Accept(context.Background(), eventID, userID); err != nil || a.Status != model.AttendeeAccepted {
+		t.Fatalf("expected the invitation to be accepted, got %v, %v", a.Status, err)
+	}
+	if a, err := svc.Decline(context.Background(), eventID, userID); err != nil || a.Status != model.AttendeeDeclined {
+		t.Fatalf("expected the invitation to be declined, got %v, %v", a.Status, err)
+	}
+}
+
+func TestService_RunningLate(t *testing.T) {
+	svc, m := newTestService(t, "")
+
+	eventID, userID, ownerID := uuid.New(), uuid.New(), uuid.New()
+	eta := time.Now().Add(15 * time.Minute)
+	other := model.Attendee{EventID: eventID, UserID: uuid.New(), Email: "carol@example.com", Status: model.AttendeeAccepted}
+
+	m.repo.EXPECT().MarkLate(gomock.Any(), eventID, userID, eta).Return(model.LateNotice{
+		Attendee:       model.Attendee{EventID: eventID, UserID: userID, Name: "Bob", Status: model.AttendeeAccepted, LateETA: &eta},
+		Event:          model.Event{ID: eventID, UserID: ownerID, Title: "encrypted"},
+		OrganizerEmail: "alice@example.com",
+	}, nil)
+	m.cipher.EXPECT().Decrypt(gomock.Any(), ownerID, "encrypted").Return("Standup", nil)
+	m.notifier.EXPECT().Recipients(gomock.Any(), eventID).Return([]model.Attendee{
+		{EventID: eventID, UserID: userID, Email: "bob@example.com", Status: model.AttendeeAccepted},
+		other,
+	})
+	m.notifier.EXPECT().
+		NotifyLate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, recipients []model.Attendee, notice model.LateNotice) {
+			// The late attendee is not notified of their own report; the organizer is.
+			want := []model.Attendee{other, {EventID: eventID, UserID: ownerID, Email: "alice@example.com"}}
+			if len(recipients) != len(want) || recipients[0] != want[0] || recipients[1] != want[1] {
+				t.Fatalf("expected %+v, got %+v", want, recipients)
+			}
+			if notice.Event.Title != "Standup" {
+				t.Fatalf("expected the decrypted title, got %q", notice.Event.Title)
+			}
+		})
+
+	a, err := svc.RunningLate(context.Background(), eventID, userID, eta)
+	if err != nil || a.LateETA == nil || !a.LateETA.Equal(eta) {
+		t.Fatalf("expected the attendee to be late until %v, got %+v, %v", eta, a, err)
+	}
+}
+
+func TestService_RunningLate_Errors(t *testing.T) {
+	svc, m := newTestService(t, "")
+
+	eventID, userID := uuid.New(), uuid.New()
+
+	if _, err := svc.RunningLate(context.Background(), eventID, userID, time.Now().Add(-time.Minute)); !errors.Is(err, ErrInvalidETA) {
+		t.Fatalf("expected ErrInvalidETA, got %v", err)
+	}
+
+	m.repo.EXPECT().MarkLate(gomock.Any(), eventID, userID, gomock.Any()).Return(model.LateNotice{}, attendeerepo.ErrAttendeeNotFound)
+
+	_, err := svc.RunningLate(context.Background(), eventID, userID, time.Now().Add(time.Hour))
+	if !errors.Is(err, attendeerepo.ErrAttendeeNotFound) {
+		t.Fatalf("expected ErrAttendeeNotFound, got %v", err)
+	}
+}
+
+func TestService_Invite_TrimsEmail(t *testing.T) {
+	svc, m := newTestService(t, "")
+	mockRepo := m.repo
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().Invite(gomock.Any(), eventID, ownerID, "guest@example.com").Return(model.Attendee{}, nil)
+
+	if _, err := svc.Invite(context.Background(), eventID, ownerID, " guest@example.com "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Invite_External(t *testing.T) {
+	svc, m := newTestService(t, "https://calendar.example.com")
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	remindAt := date.Add(-30 * time.Minute)
+	m.repo.EXPECT().Invite(gomock.Any(), eventID, ownerID, "guest@example.com").Return(model.Attendee{}, attendeerepo.ErrUserNotFound)
+
+	var tokenHash string
+	m.repo.EXPECT().InviteExternal(gomock.Any(), eventID, ownerID, "guest@example.com", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uuid.UUID, email, hash string) (model.Invitation, error) {
+			tokenHash = hash
+			return model.Invitation{
+				Attendee:  model.Attendee{EventID: eventID, Email: email, Status: model.AttendeeInvited, External: true},
+				Event:     model.Event{ID: eventID, Title: "enc", EventDate: date, ReminderAt: &remindAt},
+				Organizer: "Owner",
+			}, nil
+		})
+	m.cipher.EXPECT().Decrypt(gomock.Any(), ownerID, "enc").Return("Planning", nil)
+	m.sender.EXPECT().Send(gomock.Any(), "guest@example.com", "Invitation: Planning", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, body string) error {
+			attachments := email.AttachmentsFromContext(ctx)
+			if len(attachments) != 1 || !strings.Contains(string(attachments[0].Content), "TRIGGER;VALUE=DATE-TIME:20251020T133000Z") {
+				t.Errorf("expected the event to be attached with its reminder, got %+v", attachments)
+			}
+			i := strings.Index(body, "/rsvp?token=")
+			if i < 0 || !strings.Contains(body, "Owner invited you to \"Planning\" on Mon, 20 Oct 2025 14:00 UTC") {
+				t.Fatalf("unexpected invitation body %q", body)
+			}
+			token := body[i+len("/rsvp?token="):]
+			token = token[:strings.Index(token, "&")]
+			if hashToken(token) != tokenHash {
+				t.Errorf("expected the link to carry the token of the stored hash")
+			}
+			return nil
+		})
+
+	a, err := svc.Invite(context.Background(), eventID, ownerID, "guest@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.External || a.UserID != uuid.Nil {
+		t.Fatalf("expected an external attendee, got %+v", a)
+	}
+}
+
+func TestService_Invite_ExternalNotConfigured(t *testing.T) {
+	svc, m := newTestService(t, "")
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	m.repo.EXPECT().Invite(gomock.Any(), eventID, ownerID, "guest@example.com").Return(model.Attendee{}, attendeerepo.ErrUserNotFound)
+
+	_, err := svc.Invite(context.Background(), eventID, ownerID, "guest@example.com")
+	if !errors.Is(err, attendeerepo.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestService_RSVP(t *testing.T) {
+	svc, m := newTestService(t, "https://calendar.example.com")
+
+	m.repo.EXPECT().RespondExternal(gomock.Any(), hashToken("token"), model.AttendeeAccepted).
+		Return(model.Attendee{Status: model.AttendeeAccepted, External: true}, nil)
+
+	a, err := svc.RSVP(context.Background(), "token", model.AttendeeAccepted)
+	if err != nil || a.Status != model.AttendeeAccepted {
+		t.Fatalf("expected the invitation to be accepted, got %v, %v", a.Status, err)
+	}
+
+	if _, err := svc.RSVP(context.Background(), "token", "maybe"); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestService_RequestGuestRSVP(t *testing.T) {
+	svc, m := newTestService(t, "https://calendar.example.com")
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+
+	var tokenHash string
+	m.repo.EXPECT().RequestGuestRSVP(gomock.Any(), "Ab3dE9xY", "guest@example.com", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, email, hash string, expiresAt, now, resendAfter time.Time) (model.GuestRequest, error) {
+			if expiresAt.Sub(now) != defaultGuestTTL || now.Sub(resendAfter) != defaultGuestCooldown {
+				t.Errorf("expected the default lifetime and cooldown, got %v and %v", expiresAt.Sub(now), now.Sub(resendAfter))
+			}
+			tokenHash = hash
+			return model.GuestRequest{
+				Guest:      model.Guest{EventID: eventID, Email: email, Status: model.GuestPending, RequestedAt: now},
+				Event:      model.Event{ID: eventID, UserID: ownerID, Title: "enc", EventDate: date},
+				Visibility: model.ShortLinkBasic,
+			}, nil
+		})
+	m.cipher.EXPECT().Decrypt(gomock.Any(), ownerID, "enc").Return("Planning", nil)
+	m.sender.EXPECT().Send(gomock.Any(), "guest@example.com", "Your RSVP link", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, body string) error {
+			i := strings.Index(body, "/guests/rsvp?token=")
+			if i < 0 || !strings.Contains(body, "answer \"Planning\" on Mon, 20 Oct 2025 14:00 UTC") {
+				t.Fatalf("unexpected rsvp body %q", body)
+			}
+			token := body[i+len("/guests/rsvp?token="):]
+			token = token[:strings.Index(token, "&")]
+			if hashToken(token) != tokenHash {
+				t.Errorf("expected the link to carry the token of the stored hash")
+			}
+			return nil
+		})
+
+	g, err := svc.RequestGuestRSVP(context.Background(), "Ab3dE9xY", " guest@example.com ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Status != model.GuestPending {
+		t.Fatalf("expected a pending guest, got %+v", g)
+	}
+}
+
+func TestService_RequestGuestRSVP_BusyLink(t *testing.T) {
+	svc, m := newTestService(t, "https://calendar.example.com")
+
+	m.repo.EXPECT().RequestGuestRSVP(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.GuestRequest{
+			Guest:      model.Guest{Email: "guest@example.com"},
+			Event:      model.Event{Title: "enc"},
+			Visibility: model.ShortLinkBusy,
+		}, nil)
+	m.sender.EXPECT().Send(gomock.Any(), "guest@example.com", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, body string) error {
+			if !strings.Contains(body, "answer an event on") {
+				t.Errorf("expected the title to be withheld, got %q", body)
+			}
+			return nil
+		})
+
+	if _, err := svc.RequestGuestRSVP(context.Background(), "Ab3dE9xY", "guest@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_RequestGuestRSVP_Errors(t *testing.T) {
+	svc, _ := newTestService(t, "")
+	if _, err := svc.RequestGuestRSVP(context.Background(), "Ab3dE9xY", "guest@example.com"); !errors.Is(err, ErrGuestRSVPDisabled) {
+		t.Fatalf("expected ErrGuestRSVPDisabled, got %v", err)
+	}
+
+	svc, m := newTestService(t, "https://calendar.example.com")
+	m.repo.EXPECT().RequestGuestRSVP(gomock.Any(), "nope.Ab3dE9xY", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.GuestRequest{}, tenancy.ErrUnknownTenant)
+	m.repo.EXPECT().RequestGuestRSVP(gomock.Any(), "Ab3dE9xY", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.GuestRequest{}, attendeerepo.ErrGuestCooldown)
+
+	if _, err := svc.RequestGuestRSVP(context.Background(), "nope.Ab3dE9xY", "guest@example.com"); !errors.Is(err, attendeerepo.ErrSharedEventNotFound) {
+		t.Fatalf("expected ErrSharedEventNotFound, got %v", err)
+	}
+	if _, err := svc.RequestGuestRSVP(context.Background(), "Ab3dE9xY", "guest@example.com"); !errors.Is(err, attendeerepo.ErrGuestCooldown) {
+		t.Fatalf("expected ErrGuestCooldown, got %v", err)
+	}
+}
+
+func TestService_GuestRSVP(t *testing.T) {
+	svc, m := newTestService(t, "https://calendar.example.com")
+
+	m.repo.EXPECT().RespondGuest(gomock.Any(), hashToken("token"), model.AttendeeAccepted, gomock.Any()).
+		Return(model.Guest{Status: model.AttendeeAccepted}, nil)
+	m.repo.EXPECT().RespondGuest(gomock.Any(), hashToken("stale"), model.AttendeeDeclined, gomock.Any()).
+		Return(model.Guest{}, attendeerepo.ErrGuestLinkExpired)
+
+	g, err := svc.GuestRSVP(context.Background(), "token", model.AttendeeAccepted)
+	if err != nil || g.Status != model.AttendeeAccepted {
+		t.Fatalf("expected the guest to accept, got %v, %v", g.Status, err)
+	}
+
+	if _, err := svc.GuestRSVP(context.Background(), "stale", model.AttendeeDeclined); !errors.Is(err, attendeerepo.ErrGuestLinkExpired) {
+		t.Fatalf("expected ErrGuestLinkExpired, got %v", err)
+	}
+	if _, err := svc.GuestRSVP(context.Background(), "token", "maybe"); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/calendar/mock_calendar.go -package=mocks
+
+// calendarRepo defines the interface for calendar-related database operations.
+type calendarRepo interface {
+	// CreateCalendar inserts a new calendar and returns its ID.
+	CreateCalendar(ctx context.Context, calendar model.Calendar) (uuid.UUID, error)
+
+	// GetCalendar retrieves a calendar by its ID for the specified user.
+	GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error)
+
+	// ListCalendars retrieves all calendars of a user, the default calendar first.
+	ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error)
+
+	// UpdateCalendar renames and recolors a calendar of the specified user.
+	UpdateCalendar(ctx context.Context, calendar model.Calendar) error
+
+	// DeleteCalendar deletes a calendar that is not the default one, moving its events to the default calendar.
+	DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error
+}
+
+// Service manages business logic for calendars, the named collections a user sorts their events into.
+type Service struct {
+	calendarRepo calendarRepo // Repository for calendar database operations
+}
+
+// New creates a new Service instance with the provided calendar repository.
+//
+// Parameters:
+//   - r: The calendar repository for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r calendarRepo) *Service {
+	return &Service{
+		calendarRepo: r,
+	}
+}
+
+// CreateCalendar creates a new calendar and returns it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - calendar: The calendar to create; UserID and Name must be set.
+//
+// Returns:
+//   - The created calendar.
+//   - An error if the creation fails.
+func (s *Service) CreateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error) {
+	id, err := s.calendarRepo.CreateCalendar(ctx, calendar)
+	if err != nil {
+		return model.Calendar{}, fmt.Errorf("create calendar: %w", err)
+	}
+
+	calendar, err = s.calendarRepo.GetCalendar(ctx, id, calendar.UserID)
+	if err != nil {
+		return model.Calendar{}, fmt.Errorf("create calendar: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// GetCalendar retrieves a calendar of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - calendarID: The UUID of the calendar.
+//   - userID: The UUID of the user who owns the calendar.
+//
+// Returns:
+//   - The calendar.
+//   - An error wrapping calendarrepo.ErrCalendarNotFound if the user has no such calendar,
+//     or an error if the retrieval fails.
+func (s *Service) GetCalendar(ctx context.Context, calendarID, userID uuid.UUID) (model.Calendar, error) {
+	calendar, err := s.calendarRepo.GetCalendar(ctx, calendarID, userID)
+	if err != nil {
+		return model.Calendar{}, fmt.Errorf("get calendar: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// ListCalendars retrieves all calendars of a user, the default calendar first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of calendars.
+//   - An error if the retrieval fails.
+func (s *Service) ListCalendars(ctx context.Context, userID uuid.UUID) ([]model.Calendar, error) {
+	calendars, err := s.calendarRepo.ListCalendars(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list calendars: %w", err)
+	}
+
+	return calendars, nil
+}
+
+// UpdateCalendar renames and recolors a calendar of a user and returns it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - calendar: The calendar with its ID, owner and new name and color.
+//
+// Returns:
+//   - The updated calendar.
+//   - An error wrapping calendarrepo.ErrCalendarNotFound if the user has no such calendar,
+//     or an error if the update fails.
+func (s *Service) UpdateCalendar(ctx context.Context, calendar model.Calendar) (model.Calendar, error) {
+	if err := s.calendarRepo.UpdateCalendar(ctx, calendar); err != nil {
+		return model.Calendar{}, fmt.Errorf("update calendar: %w", err)
+	}
+
+	calendar, err := s.calendarRepo.GetCalendar(ctx, calendar.ID, calendar.UserID)
+	if err != nil {
+		return model.Calendar{}, fmt.Errorf("update calendar: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// DeleteCalendar deletes a calendar of a user. Its events move to the default calendar of the user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - calendarID: The UUID of the calendar to delete.
+//   - userID: The UUID of the user who owns the calendar.
+//
+// Returns:
+//   - An error wrapping calendarrepo.ErrCalendarNotFound if the user has no such calendar,
+//     calendarrepo.ErrDefaultCalendar if it is their default calendar, or an error if the deletion fails.
+func (s *Service) DeleteCalendar(ctx context.Context, calendarID, userID uuid.UUID) error {
+	if err := s.calendarRepo.DeleteCalendar(ctx, calendarID, userID); err != nil {
+		return fmt.Errorf("delete calendar: %w", err)
+	}
+
+	return nil
+}
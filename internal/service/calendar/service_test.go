@@ -0,0 +1,56 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	calendarrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/calendar"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	calendarrepo "github.com/aliskhannn/calendar-service/internal/repository/calendar"
+)
+
+func TestService_CreateCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarrepomocks.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	calendar := model.Calendar{UserID: uuid.New(), Name: "Work", Color: "blue"}
+	created := calendar
+	created.ID = uuid.New()
+
+	mockRepo.EXPECT().CreateCalendar(gomock.Any(), calendar).Return(created.ID, nil)
+	mockRepo.EXPECT().GetCalendar(gomock.Any(), created.ID, calendar.UserID).Return(created, nil)
+
+	got, err := svc.CreateCalendar(context.Background(), calendar)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.ID != created.ID {
+		t.Fatalf("expected calendar %s, got %s", created.ID, got.ID)
+	}
+}
+
+func TestService_DeleteCalendar_Default(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarrepomocks.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	calendarID, userID := uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().DeleteCalendar(gomock.Any(), calendarID, userID).Return(calendarrepo.ErrDefaultCalendar)
+
+	err := svc.DeleteCalendar(context.Background(), calendarID, userID)
+	if !errors.Is(err, calendarrepo.ErrDefaultCalendar) {
+		t.Fatalf("expected ErrDefaultCalendar, got %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+package coldstorage
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/coldstorage/mock_coldstorage.go -package=mocks
+
+var ErrColdStorageDisabled = errors.New("cold storage is not enabled")
+
+// coldStorageRepo defines the database operations of the export of archived events and their restore.
+type coldStorageRepo interface {
+	// ListExportable retrieves a batch of the events archived before a cutoff, oldest first.
+	ListExportable(ctx context.Context, before time.Time, limit int) ([]model.ColdEvent, error)
+
+	// MarkExported deletes exported events from the archive and records the object holding them.
+	MarkExported(ctx context.Context, key string, ids []uuid.UUID) (int, error)
+
+	// ListObjects retrieves the keys of the objects holding exported events, optionally of one user only.
+	ListObjects(ctx context.Context, userID *uuid.UUID) ([]string, error)
+
+	// RestoreEvents moves the events of an object back into the archive, with their reminders.
+	RestoreEvents(ctx context.Context, key string, records []json.RawMessage, userID *uuid.UUID) (int, error)
+}
+
+// objectStore defines the object store exports are written to.
+type objectStore interface {
+	// Put writes an object, replacing an object with the same key.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Get reads an object.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// jobService defines the background jobs restores run as.
+type jobService interface {
+	// Enqueue queues a job for the worker pool.
+	Enqueue(ctx context.Context, job model.Job) (model.Job, error)
+}
+
+// Service moves events that have been archived for longer than the retention to cold storage and back.
+// Exports are gzipped newline-delimited JSON objects, one line per event with its reminders.
+// Service is the runner of cold storage restore jobs.
+type Service struct {
+	repo   coldStorageRepo    // Repository of archived and exported events
+	store  objectStore        // Object store the exports are written to
+	jobs   jobService         // Background jobs the restores run as
+	config config.ColdStorage // Whether exports are enabled, the retention and the key prefix
+	clock  clock.Clock        // Source of the current time the retention is measured from
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - r: The repository of archived and exported events.
+//   - store: The object store the exports are written to.
+//   - j: The job service restores run as.
+//   - cfg: The cold storage configuration.
+//   - clk: The clock the retention is measured with.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r coldStorageRepo, store objectStore, j jobService, cfg config.ColdStorage, clk clock.Clock) *Service {
+	return &Service{
+		repo:   r,
+		store:  store,
+		jobs:   j,
+		config: cfg,
+		clock:  clk,
+	}
+}
+
+// Enabled reports whether archived events are exported to cold storage.
+func (s *Service) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Export writes a batch of the events archived for longer than the retention to a new object
+// and deletes them from the database. Objects of a tenant are prefixed with its ID.
+//
+// Parameters:
+//   - ctx: The context of the tenant.
+//   - limit: The maximum number of events exported.
+//
+// Returns:
+//   - The number of exported events; fewer than limit means no other events are due.
+//   - ErrColdStorageDisabled if exports are not enabled, or an error if the events cannot be read,
+//     written or deleted. Events are only deleted once their object has been written.
+func (s *Service) Export(ctx context.Context, limit int) (int, error) {
+	if !s.config.Enabled {
+		return 0, ErrColdStorageDisabled
+	}
+
+	now := s.clock.Now().UTC()
+	events, err := s.repo.ListExportable(ctx, now.Add(-s.config.Retention), limit)
+	if err != nil {
+		return 0, fmt.Errorf("export to cold storage: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	ids := make([]uuid.UUID, 0, len(events))
+	for _, e := range events {
+		_, _ = zw.Write(e.Record)
+		_, _ = zw.Write([]byte("\n"))
+		ids = append(ids, e.ID)
+	}
+	if err := zw.Close(); err != nil {
+		return 0, fmt.Errorf("export to cold storage: %w", err)
+	}
+
+	key := s.objectKey(ctx, now)
+	if err := s.store.Put(ctx, key, buf.Bytes(), "application/gzip"); err != nil {
+		return 0, fmt.Errorf("export to cold storage: %w", err)
+	}
+
+	exported, err := s.repo.MarkExported(ctx, key, ids)
+	if err != nil {
+		return 0, fmt.Errorf("export to cold storage: %w", err)
+	}
+
+	return exported, nil
+}
+
+// objectKey returns a new, unique key for an export written at now, e.g.
+// "archive/acme/2025/01/31/093000-<uuid>.ndjson.gz" for the tenant acme.
+func (s *Service) objectKey(ctx context.Context, now time.Time) string {
+	key := s.config.Prefix
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		key += tenantID + "/"
+	}
+
+	return key + now.Format("2006/01/02/150405") + "-" + uuid.NewString() + ".ndjson.gz"
+}
+
+// StartRestore queues a job restoring events from cold storage into the archive.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the admin starting the restore.
+//   - req: The user whose events are restored, or all events.
+//
+// Returns:
+//   - The queued job, whose progress and result are polled through the jobs API.
+//   - ErrColdStorageDisabled if exports are not enabled, or another error if the job cannot be queued.
+func (s *Service) StartRestore(ctx context.Context, userID uuid.UUID, req model.ColdStorageRestore) (model.Job, error) {
+	if !s.config.Enabled {
+		return model.Job{}, ErrColdStorageDisabled
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start cold storage restore: %w", err)
+	}
+
+	job, err := s.jobs.Enqueue(ctx, model.Job{
+		UserID:  userID,
+		Kind:    model.JobColdStorageRestore,
+		Payload: payload,
+	})
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start cold storage restore: %w", err)
+	}
+
+	return job, nil
+}
+
+// Run restores the requested events object by object, reporting a model.ColdStorageRestoreResult after each object.
+// Restored events are back in the archive, from where users restore them like other archived events.
+//
+// Parameters:
+//   - ctx: The context of the job; the restore stops between objects when it is done.
+//   - job: The restore job with the model.ColdStorageRestore as payload.
+//   - p: The progress of the job; a unit of work is an object.
+//
+// Returns:
+//   - An error if the payload is invalid, an object cannot be read or restored, or ctx is done.
+//     Objects restored before the error are kept.
+func (s *Service) Run(ctx context.Context, job model.Job, p *jobsvc.Progress) error {
+	var req model.ColdStorageRestore
+	if err := json.Unmarshal(job.Payload, &req); err != nil {
+		return fmt.Errorf("invalid cold storage restore request: %w", err)
+	}
+
+	if !s.config.Enabled {
+		return ErrColdStorageDisabled
+	}
+
+	keys, err := s.repo.ListObjects(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	p.SetTotal(len(keys))
+
+	var result model.ColdStorageRestoreResult
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, err := s.store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		records, err := decodeRecords(data)
+		if err != nil {
+			return fmt.Errorf("decode object %s: %w", key, err)
+		}
+
+		restored, err := s.repo.RestoreEvents(ctx, key, records, req.UserID)
+		if err != nil {
+			return err
+		}
+
+		result.Objects++
+		result.Restored += restored
+		p.Add(1)
+		p.SetResult(result)
+	}
+
+	return nil
+}
+
+// decodeRecords splits a gzipped newline-delimited JSON export into its records.
+func decodeRecords(data []byte) ([]json.RawMessage, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	var records []json.RawMessage
+	scanner := bufio.NewScanner(zr)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if !json.Valid(line) {
+			return nil, fmt.Errorf("invalid record at line %d", len(records)+1)
+		}
+		records = append(records, json.RawMessage(bytes.Clone(line)))
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,145 @@
+package coldstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	coldstoragemocks "github.com/aliskhannn/calendar-service/internal/mocks/service/coldstorage"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var now = time.Date(2026, 1, 31, 9, 30, 0, 0, time.UTC)
+
+func newTestService(t *testing.T, enabled bool) (*Service, *coldstoragemocks.MockcoldStorageRepo, *coldstoragemocks.MockobjectStore) {
+	ctrl := gomock.NewController(t)
+	repo := coldstoragemocks.NewMockcoldStorageRepo(ctrl)
+	store := coldstoragemocks.NewMockobjectStore(ctrl)
+	cfg := config.ColdStorage{Enabled: enabled, Retention: 365 * 24 * time.Hour, Prefix: "archive/"}
+	return New(repo, store, coldstoragemocks.NewMockjobService(ctrl), cfg, clock.NewFake(now)), repo, store
+}
+
+func TestService_Export(t *testing.T) {
+	svc, repo, store := newTestService(t, true)
+	ctx := tenancy.WithTenant(context.Background(), "acme")
+
+	events := []model.ColdEvent{
+		{ID: uuid.New(), UserID: uuid.New(), Record: json.RawMessage(`{"event":{"title":"a"},"reminders":[]}`)},
+		{ID: uuid.New(), UserID: uuid.New(), Record: json.RawMessage(`{"event":{"title":"b"},"reminders":[]}`)},
+	}
+
+	var key string
+	var object []byte
+	repo.EXPECT().ListExportable(gomock.Any(), now.Add(-365*24*time.Hour), 100).Return(events, nil)
+	store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), "application/gzip").
+		DoAndReturn(func(_ context.Context, k string, data []byte, _ string) error {
+			key, object = k, data
+			return nil
+		})
+	repo.EXPECT().MarkExported(gomock.Any(), gomock.Any(), []uuid.UUID{events[0].ID, events[1].ID}).
+		DoAndReturn(func(_ context.Context, k string, _ []uuid.UUID) (int, error) {
+			if k != key {
+				t.Fatalf("expected the events to be recorded in %s, got %s", key, k)
+			}
+			return 2, nil
+		})
+
+	exported, err := svc.Export(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exported != 2 {
+		t.Fatalf("expected 2 exported events, got %d", exported)
+	}
+	if !strings.HasPrefix(key, "archive/acme/2026/01/31/093000-") || !strings.HasSuffix(key, ".ndjson.gz") {
+		t.Fatalf("unexpected object key %s", key)
+	}
+
+	records, err := decodeRecords(object)
+	if err != nil {
+		t.Fatalf("failed to decode object: %v", err)
+	}
+	if len(records) != 2 || string(records[1]) != string(events[1].Record) {
+		t.Fatalf("unexpected records %s", records)
+	}
+}
+
+func TestService_Export_NothingDue(t *testing.T) {
+	svc, repo, _ := newTestService(t, true)
+
+	repo.EXPECT().ListExportable(gomock.Any(), gomock.Any(), 100).Return(nil, nil)
+
+	if exported, err := svc.Export(context.Background(), 100); err != nil || exported != 0 {
+		t.Fatalf("expected nothing to export, got %d, %v", exported, err)
+	}
+}
+
+func TestService_Export_UploadFails(t *testing.T) {
+	svc, repo, store := newTestService(t, true)
+
+	repo.EXPECT().ListExportable(gomock.Any(), gomock.Any(), 100).
+		Return([]model.ColdEvent{{ID: uuid.New(), Record: json.RawMessage(`{}`)}}, nil)
+	store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("access denied"))
+
+	// The events stay in the archive when their object cannot be written.
+	if _, err := svc.Export(context.Background(), 100); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestService_StartRestore_Disabled(t *testing.T) {
+	svc, _, _ := newTestService(t, false)
+
+	if _, err := svc.StartRestore(context.Background(), uuid.New(), model.ColdStorageRestore{}); !errors.Is(err, ErrColdStorageDisabled) {
+		t.Fatalf("expected ErrColdStorageDisabled, got %v", err)
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	svc, repo, store := newTestService(t, true)
+	userID := uuid.New()
+
+	// Write an object the way exports do.
+	records := []model.ColdEvent{{Record: json.RawMessage(`{"event":{"id":"1"}}`)}, {Record: json.RawMessage(`{"event":{"id":"2"}}`)}}
+	var object []byte
+	repo.EXPECT().ListExportable(gomock.Any(), gomock.Any(), 10).Return(records, nil)
+	store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte, _ string) error {
+			object = data
+			return nil
+		})
+	repo.EXPECT().MarkExported(gomock.Any(), gomock.Any(), gomock.Any()).Return(2, nil)
+	if _, err := svc.Export(context.Background(), 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	payload, _ := json.Marshal(model.ColdStorageRestore{UserID: &userID})
+	job := model.Job{ID: uuid.New(), Kind: model.JobColdStorageRestore, Payload: payload}
+
+	repo.EXPECT().ListObjects(gomock.Any(), &userID).Return([]string{"a.ndjson.gz"}, nil)
+	store.EXPECT().Get(gomock.Any(), "a.ndjson.gz").Return(object, nil)
+	repo.EXPECT().RestoreEvents(gomock.Any(), "a.ndjson.gz", []json.RawMessage{records[0].Record, records[1].Record}, &userID).Return(1, nil)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), job, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed, total := p.Counts(); processed != 1 || total != 1 {
+		t.Fatalf("expected 1 of 1 objects processed, got %d of %d", processed, total)
+	}
+	if result := p.Result().(model.ColdStorageRestoreResult); result.Objects != 1 || result.Restored != 1 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
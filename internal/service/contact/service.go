@@ -0,0 +1,104 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/contact/mock_contact.go -package=mocks
+
+// contactRepo defines the interface for contact-related database operations.
+type contactRepo interface {
+	// ListContacts retrieves the contacts of a user whose email address or name starts with a prefix, best ranked first.
+	ListContacts(ctx context.Context, userID uuid.UUID, prefix string, limit int) ([]model.Contact, error)
+
+	// AddContact adds a contact to a user's contacts.
+	AddContact(ctx context.Context, c model.Contact) (model.Contact, error)
+
+	// RemoveContact removes a contact of a user.
+	RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error
+}
+
+// Service manages business logic for contacts, the people users invite to their events.
+// Invitations update the contacts in the attendee repository; this service covers autocomplete and manual changes.
+type Service struct {
+	contactRepo contactRepo // Repository for contact database operations
+}
+
+// New creates a new Service instance with the provided contact repository.
+//
+// Parameters:
+//   - r: The contact repository for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r contactRepo) *Service {
+	return &Service{
+		contactRepo: r,
+	}
+}
+
+// ListContacts retrieves the contacts of a user matching what they typed, e.g. to complete an attendee,
+// the most frequently and recently invited first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - query: The beginning of an email address or of a word of a name; surrounding whitespace is ignored.
+//   - limit: The maximum number of contacts returned.
+//
+// Returns:
+//   - A slice of contacts, empty if none match.
+//   - An error if the retrieval fails.
+func (s *Service) ListContacts(ctx context.Context, userID uuid.UUID, query string, limit int) ([]model.Contact, error) {
+	contacts, err := s.contactRepo.ListContacts(ctx, userID, strings.TrimSpace(query), limit)
+	if err != nil {
+		return nil, fmt.Errorf("list contacts: %w", err)
+	}
+
+	return contacts, nil
+}
+
+// AddContact adds a contact manually, before or without inviting them. It starts without invitations,
+// so it ranks below the contacts the user invited.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - c: The contact to add; its email address is trimmed and lowercased.
+//
+// Returns:
+//   - The stored contact.
+//   - An error if the insertion fails.
+func (s *Service) AddContact(ctx context.Context, c model.Contact) (model.Contact, error) {
+	c.Email = strings.ToLower(strings.TrimSpace(c.Email))
+	c.Name = strings.TrimSpace(c.Name)
+
+	stored, err := s.contactRepo.AddContact(ctx, c)
+	if err != nil {
+		return model.Contact{}, fmt.Errorf("add contact: %w", err)
+	}
+
+	return stored, nil
+}
+
+// RemoveContact removes a contact of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - contactID: The UUID of the contact.
+//   - userID: The UUID of the user the contact belongs to.
+//
+// Returns:
+//   - An error if the user has no such contact or the removal fails.
+func (s *Service) RemoveContact(ctx context.Context, contactID, userID uuid.UUID) error {
+	if err := s.contactRepo.RemoveContact(ctx, contactID, userID); err != nil {
+		return fmt.Errorf("remove contact: %w", err)
+	}
+
+	return nil
+}
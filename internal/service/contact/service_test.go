@@ -0,0 +1,62 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	contactmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/contact"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	contactrepo "github.com/aliskhannn/calendar-service/internal/repository/contact"
+)
+
+func TestService_AddContact_NormalizesEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := contactmocks.NewMockcontactRepo(ctrl)
+	svc := New(mockRepo)
+
+	userID := uuid.New()
+	want := model.Contact{UserID: userID, Email: "ana@example.com", Name: "Ana"}
+	mockRepo.EXPECT().AddContact(gomock.Any(), want).Return(want, nil)
+
+	if _, err := svc.AddContact(context.Background(), model.Contact{UserID: userID, Email: " Ana@Example.com ", Name: " Ana"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_ListContacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := contactmocks.NewMockcontactRepo(ctrl)
+	svc := New(mockRepo)
+
+	userID := uuid.New()
+	mockRepo.EXPECT().ListContacts(gomock.Any(), userID, "an", 10).Return([]model.Contact{{Email: "ana@example.com"}}, nil)
+
+	contacts, err := svc.ListContacts(context.Background(), userID, "  an ", 10)
+	if err != nil || len(contacts) != 1 {
+		t.Fatalf("unexpected result: %+v, %v", contacts, err)
+	}
+}
+
+func TestService_RemoveContact_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := contactmocks.NewMockcontactRepo(ctrl)
+	svc := New(mockRepo)
+
+	contactID, userID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().RemoveContact(gomock.Any(), contactID, userID).Return(contactrepo.ErrContactNotFound)
+
+	if err := svc.RemoveContact(context.Background(), contactID, userID); !errors.Is(err, contactrepo.ErrContactNotFound) {
+		t.Fatalf("expected ErrContactNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package delegate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/delegate/mock_delegate.go -package=mocks
+
+// delegateRepo defines the interface for delegate-related database operations.
+type delegateRepo interface {
+	// AddDelegate allows the user with the given email address to create events for the owner.
+	AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error)
+
+	// ListDelegates retrieves the delegates of a user.
+	ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error)
+
+	// RemoveDelegate revokes the permission of a delegate to create events for the owner.
+	RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error
+
+	// IsDelegate reports whether a user is a delegate of the owner.
+	IsDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) (bool, error)
+}
+
+// Service manages business logic for delegates, users allowed to create events in the calendar of another user.
+type Service struct {
+	delegateRepo delegateRepo // Repository for delegate database operations
+}
+
+// New creates a new Service instance with the provided delegate repository.
+//
+// Parameters:
+//   - r: The delegate repository for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r delegateRepo) *Service {
+	return &Service{
+		delegateRepo: r,
+	}
+}
+
+// AddDelegate allows another user, identified by email address, to create events in the owner's calendar.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - ownerID: The UUID of the user granting the permission.
+//   - email: The email address of the delegate; surrounding whitespace is ignored.
+//
+// Returns:
+//   - The delegate.
+//   - An error if the user does not exist, is the owner, or the grant fails.
+func (s *Service) AddDelegate(ctx context.Context, ownerID uuid.UUID, email string) (model.Delegate, error) {
+	d, err := s.delegateRepo.AddDelegate(ctx, ownerID, strings.TrimSpace(email))
+	if err != nil {
+		return model.Delegate{}, fmt.Errorf("add delegate: %w", err)
+	}
+
+	return d, nil
+}
+
+// ListDelegates retrieves the delegates of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - ownerID: The UUID of the user whose delegates are retrieved.
+//
+// Returns:
+//   - A slice of delegates.
+//   - An error if the retrieval fails.
+func (s *Service) ListDelegates(ctx context.Context, ownerID uuid.UUID) ([]model.Delegate, error) {
+	delegates, err := s.delegateRepo.ListDelegates(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("list delegates: %w", err)
+	}
+
+	return delegates, nil
+}
+
+// RemoveDelegate revokes the permission of a delegate to create events in the owner's calendar.
+// Events the delegate already created stay in the calendar.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - ownerID: The UUID of the user who added the delegate.
+//   - delegateID: The UUID of the delegate.
+//
+// Returns:
+//   - An error if the user is not a delegate of the owner or the removal fails.
+func (s *Service) RemoveDelegate(ctx context.Context, ownerID, delegateID uuid.UUID) error {
+	if err := s.delegateRepo.RemoveDelegate(ctx, ownerID, delegateID); err != nil {
+		return fmt.Errorf("remove delegate: %w", err)
+	}
+
+	return nil
+}
+
+// CanCreateFor reports whether a user may create events in the calendar of the owner.
+// Users may always create events in their own calendar.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - ownerID: The UUID of the user whose calendar the event is created in.
+//   - userID: The UUID of the authenticated user creating the event.
+//
+// Returns:
+//   - True if the user is the owner or one of the owner's delegates.
+//   - An error if the permission cannot be checked.
+func (s *Service) CanCreateFor(ctx context.Context, ownerID, userID uuid.UUID) (bool, error) {
+	if ownerID == userID {
+		return true, nil
+	}
+
+	ok, err := s.delegateRepo.IsDelegate(ctx, ownerID, userID)
+	if err != nil {
+		return false, fmt.Errorf("check delegate: %w", err)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,51 @@
+package delegate
+
+import (
+	"context"
+	"testing"
+
+	delegaterepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/delegate"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestService_CanCreateFor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := delegaterepomocks.NewMockdelegateRepo(ctrl)
+	svc := New(mockRepo)
+
+	ownerID, userID := uuid.New(), uuid.New()
+
+	// Users may always create events in their own calendar, without a lookup.
+	ok, err := svc.CanCreateFor(context.Background(), ownerID, ownerID)
+	if err != nil || !ok {
+		t.Fatalf("expected the owner to be allowed, got %v, %v", ok, err)
+	}
+
+	mockRepo.EXPECT().IsDelegate(gomock.Any(), ownerID, userID).Return(false, nil)
+
+	ok, err = svc.CanCreateFor(context.Background(), ownerID, userID)
+	if err != nil || ok {
+		t.Fatalf("expected a non-delegate to be rejected, got %v, %v", ok, err)
+	}
+}
+
+func TestService_AddDelegate_TrimsEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := delegaterepomocks.NewMockdelegateRepo(ctrl)
+	svc := New(mockRepo)
+
+	ownerID := uuid.New()
+	mockRepo.EXPECT().AddDelegate(gomock.Any(), ownerID, "assistant@example.com").Return(model.Delegate{}, nil)
+
+	if _, err := svc.AddDelegate(context.Background(), ownerID, " assistant@example.com "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
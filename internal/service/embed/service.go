@@ -0,0 +1,250 @@
+package embed
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var (
+	ErrInvalidRange = errors.New("to must be after from")
+	ErrRangeTooLong = errors.New("date range too long")
+)
+
+const (
+	// defaultRangeDays is the range shown by embeds created without one.
+	defaultRangeDays = 30
+
+	// defaultMaxDays is the longest range an embed can be requested for when none is configured.
+	defaultMaxDays = 92
+
+	// maxEmbedEvents caps the number of events published by one embed request.
+	maxEmbedEvents = 500
+
+	// tokenBytes is the number of random bytes of a share token.
+	tokenBytes = 32
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/embed/mock_embed.go -package=mocks
+
+// embedRepo defines the interface for embed-related database operations.
+type embedRepo interface {
+	// CreateEmbed inserts a new embed with the hash of its share token.
+	CreateEmbed(ctx context.Context, embed model.Embed, tokenHash string) (model.Embed, error)
+
+	// ListEmbeds retrieves all embeds of a user.
+	ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error)
+
+	// GetEmbedByTokenHash retrieves the embed a share token belongs to.
+	GetEmbedByTokenHash(ctx context.Context, tokenHash string) (model.Embed, error)
+
+	// DeleteEmbed deletes an embed of the specified user.
+	DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error
+}
+
+// eventLister defines the retrieval of the events an embed publishes.
+type eventLister interface {
+	// ListEvents retrieves the events of a user matching the filter.
+	ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for calendars embedded into websites.
+// An embed publishes event titles and dates under a share token; descriptions, reminders and tags stay private.
+// With tenancy enabled, the tenant is part of the token, since embedding pages cannot send a tenant header.
+type Service struct {
+	embedRepo embedRepo     // Repository for embed database operations
+	events    eventLister   // Source of the published events
+	cipher    contentCipher // Decryption of event titles
+	config    config.Embed  // Range limit of embed requests
+	clock     clock.Clock   // Source of the current day the default range starts at
+}
+
+// New creates a new Service instance with the provided dependencies.
+// A non-positive maximum range falls back to 92 days.
+//
+// Parameters:
+//   - r: The embed repository for database operations.
+//   - e: The source of the published events.
+//   - c: The cipher for event titles.
+//   - cfg: The range limit of embed requests.
+//   - clk: The clock the default range starts at, in UTC.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r embedRepo, e eventLister, c contentCipher, cfg config.Embed, clk clock.Clock) *Service {
+	if cfg.MaxDays <= 0 {
+		cfg.MaxDays = defaultMaxDays
+	}
+
+	return &Service{
+		embedRepo: r,
+		events:    e,
+		cipher:    c,
+		config:    cfg,
+		clock:     clk,
+	}
+}
+
+// CreateEmbed creates an embed with a new share token.
+//
+// Parameters:
+//   - ctx: The context for the operation; its tenant becomes part of the token.
+//   - embed: The embed to create; UserID and Name must be set. A zero RangeDays defaults to 30 days.
+//
+// Returns:
+//   - The created embed including its token, which cannot be retrieved again.
+//   - ErrRangeTooLong if the range exceeds the configured maximum,
+//     an error wrapping embedrepo.ErrProjectNotFound if the user has no such project,
+//     or another error if the creation fails.
+func (s *Service) CreateEmbed(ctx context.Context, embed model.Embed) (model.Embed, error) {
+	if embed.RangeDays == 0 {
+		embed.RangeDays = defaultRangeDays
+	}
+	if embed.RangeDays > s.config.MaxDays {
+		return model.Embed{}, ErrRangeTooLong
+	}
+
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return model.Embed{}, fmt.Errorf("create embed: failed to generate token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		token = tenantID + "." + token
+	}
+
+	created, err := s.embedRepo.CreateEmbed(ctx, embed, hashToken(token))
+	if err != nil {
+		return model.Embed{}, fmt.Errorf("create embed: %w", err)
+	}
+	created.Token = token
+
+	return created, nil
+}
+
+// ListEmbeds retrieves all embeds of a user. Their tokens are not included.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of embeds.
+//   - An error if the retrieval fails.
+func (s *Service) ListEmbeds(ctx context.Context, userID uuid.UUID) ([]model.Embed, error) {
+	embeds, err := s.embedRepo.ListEmbeds(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list embeds: %w", err)
+	}
+
+	return embeds, nil
+}
+
+// DeleteEmbed deletes an embed, so its token stops working immediately.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - embedID: The UUID of the embed to delete.
+//   - userID: The UUID of the user who owns the embed.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteEmbed(ctx context.Context, embedID, userID uuid.UUID) error {
+	if err := s.embedRepo.DeleteEmbed(ctx, embedID, userID); err != nil {
+		return fmt.Errorf("delete embed: %w", err)
+	}
+
+	return nil
+}
+
+// GetCalendar retrieves the embed of a share token and the events it publishes, ordered by date.
+// Without from, the range starts today; without to, it covers the range days of the embed.
+// Only titles are decrypted: descriptions are cleared, since embeds are public.
+// At most 500 events are returned.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The share token.
+//   - from: The first day of the range; nil for today.
+//   - to: The end of the range, exclusive; nil for the range days of the embed after from.
+//
+// Returns:
+//   - The embed with the resolved range and the published events.
+//   - An error wrapping embedrepo.ErrEmbedNotFound for an unknown token, ErrInvalidRange or ErrRangeTooLong
+//     for an invalid range, or another error if the retrieval fails.
+func (s *Service) GetCalendar(ctx context.Context, token string, from, to *time.Time) (model.EmbedCalendar, error) {
+	if i := strings.LastIndex(token, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, token[:i])
+	}
+
+	embed, err := s.embedRepo.GetEmbedByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		// A token naming no or an unknown tenant is as unknown as a token that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = embedrepo.ErrEmbedNotFound
+		}
+		return model.EmbedCalendar{}, fmt.Errorf("get calendar: %w", err)
+	}
+
+	start := s.clock.Now().UTC().Truncate(24 * time.Hour)
+	if from != nil {
+		start = *from
+	}
+	end := start.AddDate(0, 0, embed.RangeDays)
+	if to != nil {
+		end = *to
+	}
+
+	if !end.After(start) {
+		return model.EmbedCalendar{}, ErrInvalidRange
+	}
+	if end.After(start.AddDate(0, 0, s.config.MaxDays)) {
+		return model.EmbedCalendar{}, ErrRangeTooLong
+	}
+
+	events, err := s.events.ListEvents(ctx, embed.UserID, model.EventFilter{
+		From:      &start,
+		To:        &end,
+		ProjectID: embed.ProjectID,
+		Limit:     maxEmbedEvents,
+	})
+	if err != nil {
+		return model.EmbedCalendar{}, fmt.Errorf("get calendar: %w", err)
+	}
+
+	for i := range events {
+		if events[i].Title, err = s.cipher.Decrypt(ctx, embed.UserID, events[i].Title); err != nil {
+			return model.EmbedCalendar{}, fmt.Errorf("get calendar: %w", err)
+		}
+		events[i].Description = ""
+	}
+
+	return model.EmbedCalendar{Embed: embed, From: start, To: end, Events: events}, nil
+}
+
+// hashToken returns the hex-encoded SHA-256 digest of a share token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
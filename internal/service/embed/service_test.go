@@ -0,0 +1,140 @@
+package embed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	embedmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/embed"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	embedrepo "github.com/aliskhannn/calendar-service/internal/repository/embed"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+func TestService_CreateEmbed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := embedmocks.NewMockembedRepo(ctrl)
+	svc := New(mockRepo, embedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Embed{}, clock.Real())
+
+	var storedHash string
+	mockRepo.EXPECT().CreateEmbed(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Embed, hash string) (model.Embed, error) {
+			if e.RangeDays != defaultRangeDays {
+				t.Fatalf("expected the default range, got %d", e.RangeDays)
+			}
+			storedHash = hash
+			e.ID = uuid.New()
+			return e, nil
+		})
+
+	ctx := tenancy.WithTenant(context.Background(), "acme")
+	embed, err := svc.CreateEmbed(ctx, model.Embed{UserID: uuid.New(), Name: "Team"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(embed.Token, "acme.") {
+		t.Fatalf("expected the tenant in the token, got %q", embed.Token)
+	}
+	if storedHash != hashToken(embed.Token) || strings.Contains(storedHash, embed.Token) {
+		t.Fatal("expected only the hash of the token to be stored")
+	}
+}
+
+func TestService_CreateEmbed_RangeTooLong(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(embedmocks.NewMockembedRepo(ctrl), embedmocks.NewMockeventLister(ctrl), encryption.Disabled(),
+		config.Embed{MaxDays: 31}, clock.Real())
+
+	_, err := svc.CreateEmbed(context.Background(), model.Embed{UserID: uuid.New(), Name: "Team", RangeDays: 60})
+	if !errors.Is(err, ErrRangeTooLong) {
+		t.Fatalf("expected ErrRangeTooLong, got %v", err)
+	}
+}
+
+func TestService_GetCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := embedmocks.NewMockembedRepo(ctrl)
+	mockEvents := embedmocks.NewMockeventLister(ctrl)
+	now := time.Date(2030, 3, 10, 15, 30, 0, 0, time.UTC)
+	svc := New(mockRepo, mockEvents, encryption.Disabled(), config.Embed{}, clock.NewFake(now))
+
+	projectID := uuid.New()
+	embed := model.Embed{ID: uuid.New(), UserID: uuid.New(), ProjectID: &projectID, RangeDays: 7}
+
+	mockRepo.EXPECT().GetEmbedByTokenHash(gomock.Any(), hashToken("secret")).Return(embed, nil)
+	mockEvents.EXPECT().ListEvents(gomock.Any(), embed.UserID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, f model.EventFilter) ([]model.Event, error) {
+			if !f.From.Equal(time.Date(2030, 3, 10, 0, 0, 0, 0, time.UTC)) || !f.To.Equal(time.Date(2030, 3, 17, 0, 0, 0, 0, time.UTC)) {
+				t.Fatalf("unexpected range %v - %v", f.From, f.To)
+			}
+			if f.ProjectID == nil || *f.ProjectID != projectID || f.Limit != maxEmbedEvents {
+				t.Fatalf("unexpected filter %+v", f)
+			}
+			return []model.Event{{Title: "Launch", Description: "internal notes"}}, nil
+		})
+
+	cal, err := svc.GetCalendar(context.Background(), "secret", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cal.Events) != 1 || cal.Events[0].Title != "Launch" || cal.Events[0].Description != "" {
+		t.Fatalf("unexpected events %+v", cal.Events)
+	}
+}
+
+func TestService_GetCalendar_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := embedmocks.NewMockembedRepo(ctrl)
+	svc := New(mockRepo, embedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Embed{MaxDays: 31}, clock.Real())
+
+	mockRepo.EXPECT().GetEmbedByTokenHash(gomock.Any(), gomock.Any()).Return(model.Embed{RangeDays: 7}, nil).Times(2)
+
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+	if _, err := svc.GetCalendar(context.Background(), "secret", &from, &before); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("expected ErrInvalidRange, got %v", err)
+	}
+
+	later := from.AddDate(0, 2, 0)
+	if _, err := svc.GetCalendar(context.Background(), "secret", &from, &later); !errors.Is(err, ErrRangeTooLong) {
+		t.Fatalf("expected ErrRangeTooLong, got %v", err)
+	}
+}
+
+func TestService_GetCalendar_UnknownTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := embedmocks.NewMockembedRepo(ctrl)
+	svc := New(mockRepo, embedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Embed{}, clock.Real())
+
+	mockRepo.EXPECT().GetEmbedByTokenHash(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string) (model.Embed, error) {
+			if tenantID, _ := tenancy.FromContext(ctx); tenantID != "initech" {
+				t.Fatalf("expected the tenant of the token, got %q", tenantID)
+			}
+			return model.Embed{}, tenancy.ErrUnknownTenant
+		})
+
+	_, err := svc.GetCalendar(context.Background(), "initech.secret", nil, nil)
+	if !errors.Is(err, embedrepo.ErrEmbedNotFound) {
+		t.Fatalf("expected ErrEmbedNotFound, got %v", err)
+	}
+}
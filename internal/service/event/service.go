@@ -2,12 +2,30 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/aliskhannn/calendar-service/internal/config"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
+	"github.com/aliskhannn/calendar-service/internal/timezone"
+)
+
+var (
+	ErrSelfLink        = errors.New("event cannot be linked to itself")
+	ErrLinkOrderBroken = errors.New("event would take place before an event it depends on")
+	ErrUnknownTimezone = errors.New("unknown time zone")
+	ErrInvalidEnd      = errors.New("event must end after it starts and last at most 366 days")
+	ErrEventLimit      = errors.New("event limit reached")
+
+	ErrOccurrenceNotFound = errors.New("occurrence not found")
 )
 
 //go:generate mockgen -source=service.go -destination=../../mocks/service/event/mock_event.go -package=mocks
@@ -21,62 +39,205 @@ type eventRepo interface {
 	// UpdateEvent updates an existing event in the database.
 	UpdateEvent(ctx context.Context, event model.Event) error
 
-	// DeleteEvent removes an event from the database for the specified event and user IDs.
+	// GetEvent retrieves a single event for the specified event and user IDs.
+	GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error)
+
+	// EventExists reports whether a user has an event with the given ID, without reading the event.
+	EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+
+	// CountEvents counts the stored events of a user matching a filter, without reading them.
+	CountEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) (int, error)
+
+	// DeleteEvent moves an event of the user to the trash.
 	DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error
 
-	// ArchiveOldEvents moves old events to an archive table and deletes them from the events table.
-	ArchiveOldEvents(ctx context.Context) error
+	// DeleteEvents moves the events of a user with the given IDs to the trash and returns the moved events.
+	DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]model.Event, error)
+
+	// DeleteEventsInRange moves the events of a user starting within a time range to the trash and returns the moved events.
+	DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) ([]model.Event, error)
+
+	// ExcludeOccurrence removes a single occurrence from a recurring event.
+	ExcludeOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error
+
+	// DetachOccurrence replaces a single occurrence of a recurring event with a standalone event.
+	DetachOccurrence(ctx context.Context, seriesID uuid.UUID, occurrence time.Time, event model.Event) (uuid.UUID, error)
+
+	// SetOverride changes the time or title of a single occurrence of a recurring event.
+	SetOverride(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error
+
+	// DeleteOverride reverts a single occurrence of a recurring event to the series.
+	DeleteOverride(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error
+
+	// ListOverrides retrieves the overridden occurrences of a recurring event.
+	ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error)
+
+	// ArchiveOldEvents moves a batch of old events to an archive table and deletes them from the events table.
+	ArchiveOldEvents(ctx context.Context, limit int, dualWrite bool) (int, error)
+
+	// RestoreEvent takes an event out of the trash, or moves an archived event back to the events table.
+	RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error)
+
+	// ListTrash retrieves a page of the trashed events of a user, the most recently deleted first.
+	ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error)
+
+	// PurgeTrash permanently deletes a batch of the events trashed longer than the retention
+	// whose owners were notified at least the notice period before.
+	PurgeTrash(ctx context.Context, retention, notice time.Duration, limit int) (int, error)
+
+	// ClaimTrashNotices marks a batch of the trashed events purged within the notice period as notified
+	// and returns a notice per owner, with the number of marked events.
+	ClaimTrashNotices(ctx context.Context, retention, notice time.Duration, limit int) ([]model.TrashNotice, int, error)
+
+	// RestoreTrash takes all the events of a user out of the trash.
+	RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// EmptyTrash permanently deletes all the events of a user in the trash.
+	EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// GetEventsInRange retrieves all events for a user from one day up to, but not including, another.
+	GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) ([]model.Event, error)
+
+	// SearchEvents retrieves a page of the events of a user matching a full-text search, best matches first.
+	SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error)
+
+	// CountSearchResults counts the events of a user matching a full-text search, exactly or estimated.
+	CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error)
+
+	// SuggestTitles retrieves the distinct titles of the user's events starting with a prefix.
+	SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error)
 
 	// GetEventsForDay retrieves all events for a user on a specific day.
-	GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
 
 	// GetEventsForWeek retrieves all events for a user within a week from the given date.
-	GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
 
 	// GetEventsForMonth retrieves all events for a user within a month from the given date.
-	GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error)
+	GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error)
+
+	// CreateLink links an event to a related event it depends on.
+	CreateLink(ctx context.Context, link model.EventLink, userID uuid.UUID) error
+
+	// DeleteLink removes the link between an event and a related event.
+	DeleteLink(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error
+
+	// GetRelatedEvents retrieves the events linked to the given event in either direction.
+	GetRelatedEvents(ctx context.Context, eventID uuid.UUID) ([]model.RelatedEvent, error)
+
+	// CountLinkOrderViolations counts the links violated if the event took place at the given date.
+	CountLinkOrderViolations(ctx context.Context, eventID uuid.UUID, date time.Time) (int, error)
+
+	// GetAttendeeEvents retrieves the times of the other events in the calendars of the attendees of an event.
+	GetAttendeeEvents(ctx context.Context, eventID uuid.UUID, from, to time.Time) ([]model.Event, error)
+}
+
+// contentCipher defines the encryption of user content at rest.
+type contentCipher interface {
+	// Encrypt encrypts a value with the data key of its owner.
+	Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error)
+
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// ruleEngine defines the rules that color and tag new events.
+type ruleEngine interface {
+	// ApplyRules evaluates the rules of the event's owner and updates its color and tags.
+	ApplyRules(ctx context.Context, event *model.Event) error
+}
+
+// changeNotifier defines the notification of attendees when an event they accepted is changed or cancelled,
+// and of owners when their trashed events are about to be purged.
+type changeNotifier interface {
+	// Recipients retrieves the attendees who accepted an event and are notified of its changes.
+	Recipients(ctx context.Context, eventID uuid.UUID) []model.Attendee
+
+	// NotifyChanged notifies attendees of the changes between two versions of an event.
+	NotifyChanged(ctx context.Context, recipients []model.Attendee, before, after model.Event)
+
+	// NotifyCancelled notifies attendees that an event was cancelled.
+	NotifyCancelled(ctx context.Context, recipients []model.Attendee, event model.Event)
+
+	// NotifyTrashExpiring notifies a user that events in their trash are about to be purged.
+	NotifyTrashExpiring(ctx context.Context, notice model.TrashNotice)
 }
 
 // Service manages business logic for event-related operations.
 // It interacts with the event repository to perform CRUD operations and archiving.
+// Event titles and descriptions are encrypted before they reach the repository and decrypted after reading.
 type Service struct {
-	eventRepo eventRepo // Repository for event database operations
+	eventRepo eventRepo      // Repository for event database operations
+	config    config.Event   // Event business rules
+	cipher    contentCipher  // Encryption of event content at rest
+	rules     ruleEngine     // Rules coloring and tagging new events
+	notifier  changeNotifier // Notification of attendees about changed and cancelled events
 }
 
-// New creates a new Service instance with the provided event repository.
+// New creates a new Service instance with the provided event repository, configuration, content cipher, rules,
+// and change notifier.
 //
 // Parameters:
 //   - r: The event repository for database operations.
+//   - cfg: The event business rules.
+//   - c: The cipher for event titles and descriptions.
+//   - rules: The user-defined rules applied to new events.
+//   - n: The notifier of attendees about changed and cancelled events.
 //
 // Returns:
 //   - A pointer to the initialized Service.
-func New(r eventRepo) *Service {
+func New(r eventRepo, cfg config.Event, c contentCipher, rules ruleEngine, n changeNotifier) *Service {
 	return &Service{
 		eventRepo: r,
+		config:    cfg,
+		cipher:    c,
+		rules:     rules,
+		notifier:  n,
 	}
 }
 
-// CreateEvent creates a new event for the specified user and returns its ID.
-// It constructs an event model and delegates to the repository for database insertion.
+// criticalReminderLead is how long before a critical event its default reminder is sent.
+const criticalReminderLead = time.Hour
+
+// CreateEvent creates a new event and returns its ID.
+// Missing priority defaults to normal, and critical events without an explicit reminder
+// get a default one ahead of the event. A reminder with a time zone is resolved as a wall-clock time in it,
+// and a lead time of the notification overrides sets the reminder that long before the event starts.
+// The user's rules are then applied, so events created through the API and imported events are colored
+// and tagged alike; a color given by the caller is kept. A recurrence rule is stored in its canonical form.
+// Users already having the configured maximum number of events cannot create more.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - userID: The UUID of the user creating the event.
-//   - title: The title of the event.
-//   - description: The description of the event.
-//   - date: The date and time of the event.
-//   - reminderAt: The optional reminder time for the event.
+//   - event: The event to create; UserID, Title and EventDate must be set.
 //
 // Returns:
 //   - The UUID of the created event.
-//   - An error if the creation fails.
-func (s *Service) CreateEvent(ctx context.Context, userID uuid.UUID, title, description string, date time.Time, reminderAt *time.Time) (uuid.UUID, error) {
-	event := model.Event{
-		UserID:      userID,
-		Title:       title,
-		Description: description,
-		EventDate:   date,
-		ReminderAt:  reminderAt,
+//   - ErrInvalidEnd if the event ends before it starts or lasts too long, ErrUnknownTimezone if the reminder
+//     time zone does not exist, an error wrapping rrule.ErrInvalidRule if the recurrence rule is invalid,
+//     ErrEventLimit if the user has reached the event limit, or another error if the creation fails.
+func (s *Service) CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error) {
+	if err := checkEnd(event); err != nil {
+		return uuid.Nil, err
+	}
+	if err := resolveReminder(&event); err != nil {
+		return uuid.Nil, err
+	}
+	if err := normalizeRecurrence(&event); err != nil {
+		return uuid.Nil, err
+	}
+	if err := s.checkLimit(ctx, event.UserID); err != nil {
+		return uuid.Nil, err
+	}
+	applyPriorityDefaults(&event, time.Now())
+
+	// Rules match the plaintext, so they run before encryption.
+	if err := s.rules.ApplyRules(ctx, &event); err != nil {
+		return uuid.Nil, fmt.Errorf("create event: %w", err)
+	}
+
+	if err := s.encryptEvent(ctx, &event); err != nil {
+		return uuid.Nil, fmt.Errorf("create event: %w", err)
 	}
 
 	id, err := s.eventRepo.CreateEvent(ctx, event)
@@ -87,29 +248,82 @@ func (s *Service) CreateEvent(ctx context.Context, userID uuid.UUID, title, desc
 	return id, nil
 }
 
-// UpdateEvent updates an existing event for the specified user and event ID.
-// It constructs an event model with updated fields and delegates to the repository.
+// checkLimit rejects new events of users who already have the configured maximum number of events.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - eventID: The UUID of the event to update.
-//   - userID: The UUID of the user who owns the event.
-//   - title: The updated title of the event.
-//   - description: The updated description of the event.
-//   - date: The updated date and time of the event.
-//   - reminderAt: The updated optional reminder time for the event.
+//   - userID: The UUID of the user creating an event.
+//
+// Returns:
+//   - ErrEventLimit if the user has reached the limit, or an error if the events cannot be counted.
+func (s *Service) checkLimit(ctx context.Context, userID uuid.UUID) error {
+	if s.config.MaxPerUser <= 0 {
+		return nil
+	}
+
+	count, err := s.eventRepo.CountEvents(ctx, userID, model.EventFilter{})
+	if err != nil {
+		return fmt.Errorf("create event: %w", err)
+	}
+	if count >= s.config.MaxPerUser {
+		return ErrEventLimit
+	}
+
+	return nil
+}
+
+// UpdateEvent updates an existing event identified by its ID and owner.
+// Priority defaults are applied the same way as on creation. If link ordering is enforced,
+// dates that would place the event before an event it depends on (or after a dependent event) are rejected.
+// For a recurring event, all occurrences are updated; the event date is the first occurrence of the series.
+// Attendees who accepted the event are notified if its title or time changed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - event: The updated event; ID and UserID identify the event to update.
 //
 // Returns:
-//   - An error if the update fails.
-func (s *Service) UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, title, description string, date time.Time, reminderAt *time.Time) error {
-	event := model.Event{
-		ID:          eventID,
-		UserID:      userID,
-		EventDate:   date,
-		Title:       title,
-		Description: description,
-		ReminderAt:  reminderAt,
-		UpdatedAt:   time.Now(),
+//   - ErrInvalidEnd if the event ends before it starts or lasts too long, ErrUnknownTimezone if the reminder
+//     time zone does not exist, an error wrapping rrule.ErrInvalidRule if the recurrence rule is invalid,
+//     or another error if the update fails.
+func (s *Service) UpdateEvent(ctx context.Context, event model.Event) error {
+	if err := checkEnd(event); err != nil {
+		return err
+	}
+	if err := resolveReminder(&event); err != nil {
+		return err
+	}
+	if err := normalizeRecurrence(&event); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	applyPriorityDefaults(&event, now)
+	event.UpdatedAt = now
+
+	if s.config.EnforceLinkOrder {
+		violations, err := s.eventRepo.CountLinkOrderViolations(ctx, event.ID, event.EventDate)
+		if err != nil {
+			return fmt.Errorf("update event: %w", err)
+		}
+		if violations > 0 {
+			return ErrLinkOrderBroken
+		}
+	}
+
+	// The previous version is only needed to tell attendees what changed.
+	var before model.Event
+	recipients := s.notifier.Recipients(ctx, event.ID)
+	if len(recipients) > 0 {
+		var err error
+		if before, err = s.getDecrypted(ctx, event.ID, event.UserID); err != nil {
+			return fmt.Errorf("update event: %w", err)
+		}
+	}
+
+	updated := event
+	if err := s.encryptEvent(ctx, &event); err != nil {
+		return fmt.Errorf("update event: %w", err)
 	}
 
 	err := s.eventRepo.UpdateEvent(ctx, event)
@@ -117,100 +331,1182 @@ func (s *Service) UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, ti
 		return fmt.Errorf("update event: %w", err)
 	}
 
+	if len(recipients) > 0 {
+		s.notifier.NotifyChanged(ctx, recipients, before, updated)
+	}
+
 	return nil
 }
 
-// DeleteEvent deletes an event for the specified user and event ID.
-// It delegates to the repository to perform the deletion.
+// UpdateOccurrence changes a single occurrence of a recurring event. The occurrence is detached from the series
+// and replaced by a standalone event with the given data; the other occurrences are not changed.
+// The standalone event stays in the calendar of the series unless the data names another calendar.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - eventID: The UUID of the event to delete.
-//   - userID: The UUID of the user who owns the event.
+//   - event: The new data of the occurrence; ID and UserID identify the recurring event, RecurrenceRule is ignored.
+//   - occurrence: The start of the occurrence to change.
 //
 // Returns:
-//   - An error if the deletion fails.
-func (s *Service) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
-	err := s.eventRepo.DeleteEvent(ctx, eventID, userID)
+//   - The UUID of the standalone event.
+//   - An error wrapping eventrepo.ErrNotRecurring or ErrOccurrenceNotFound if there is no such occurrence,
+//     ErrInvalidEnd if the occurrence ends before it starts or lasts too long, ErrUnknownTimezone if the reminder
+//     time zone does not exist, or another error if the update fails.
+func (s *Service) UpdateOccurrence(ctx context.Context, event model.Event, occurrence time.Time) (uuid.UUID, error) {
+	series, err := s.checkOccurrence(ctx, event.ID, event.UserID, occurrence)
 	if err != nil {
-		return fmt.Errorf("delete event: %w", err)
+		return uuid.Nil, fmt.Errorf("update occurrence: %w", err)
+	}
+	if event.CalendarID == nil {
+		event.CalendarID = series.CalendarID
+	}
+
+	if err := checkEnd(event); err != nil {
+		return uuid.Nil, err
+	}
+	if err := resolveReminder(&event); err != nil {
+		return uuid.Nil, err
+	}
+	applyPriorityDefaults(&event, time.Now())
+
+	if err := s.encryptEvent(ctx, &event); err != nil {
+		return uuid.Nil, fmt.Errorf("update occurrence: %w", err)
+	}
+
+	id, err := s.eventRepo.DetachOccurrence(ctx, event.ID, occurrence, event)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("update occurrence: %w", err)
+	}
+
+	return id, nil
+}
+
+// DeleteOccurrence deletes a single occurrence of a recurring event; the other occurrences are kept.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - occurrence: The start of the occurrence to delete.
+//
+// Returns:
+//   - An error wrapping eventrepo.ErrNotRecurring or ErrOccurrenceNotFound if there is no such occurrence,
+//     or another error if the deletion fails.
+func (s *Service) DeleteOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	if _, err := s.checkOccurrence(ctx, eventID, userID, occurrence); err != nil {
+		return fmt.Errorf("delete occurrence: %w", err)
+	}
+
+	if err := s.eventRepo.ExcludeOccurrence(ctx, eventID, userID, occurrence); err != nil {
+		return fmt.Errorf("delete occurrence: %w", err)
 	}
 
 	return nil
 }
 
-// ArchiveOldEvents archives events older than the current date.
-// It delegates to the repository to move old events to an archive table and delete them from the events table.
+// OverrideOccurrence changes the time or title of a single occurrence of a recurring event, which stays part
+// of the series, unlike an occurrence changed with UpdateOccurrence. Overriding the first occurrence moves the
+// reminder of the series, which is only sent for it, along with its date.
 //
 // Parameters:
 //   - ctx: The context for the operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - override: The override; Occurrence is the start the rule generates for the occurrence.
 //
 // Returns:
-//   - An error if the archiving fails.
-func (s *Service) ArchiveOldEvents(ctx context.Context) error {
-	err := s.eventRepo.ArchiveOldEvents(ctx)
+//   - An error wrapping eventrepo.ErrNotRecurring or ErrOccurrenceNotFound if there is no such occurrence,
+//     ErrInvalidEnd if the occurrence ends before it starts or lasts too long, or another error if the update fails.
+func (s *Service) OverrideOccurrence(ctx context.Context, eventID, userID uuid.UUID, override model.OccurrenceOverride) error {
+	if _, err := s.checkOccurrence(ctx, eventID, userID, override.Occurrence); err != nil {
+		return fmt.Errorf("override occurrence: %w", err)
+	}
+	if err := checkEnd(model.Event{EventDate: override.EventDate, EndDate: override.EndDate}); err != nil {
+		return err
+	}
+
+	var err error
+	if override.Title, err = s.cipher.Encrypt(ctx, userID, override.Title); err != nil {
+		return fmt.Errorf("override occurrence: %w", err)
+	}
+
+	if err := s.eventRepo.SetOverride(ctx, eventID, userID, override); err != nil {
+		return fmt.Errorf("override occurrence: %w", err)
+	}
+
+	return nil
+}
+
+// RevertOccurrence removes the override of a single occurrence of a recurring event,
+// so it takes place at the time and with the title of the series again.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//   - occurrence: The start the rule generates for the occurrence.
+//
+// Returns:
+//   - An error wrapping eventrepo.ErrNotRecurring or eventrepo.ErrOverrideNotFound if the occurrence is not
+//     overridden, or another error if the deletion fails.
+func (s *Service) RevertOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) error {
+	if err := s.eventRepo.DeleteOverride(ctx, eventID, userID, occurrence); err != nil {
+		return fmt.Errorf("revert occurrence: %w", err)
+	}
+
+	return nil
+}
+
+// ListOverrides retrieves the overridden occurrences of a recurring event with their titles decrypted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the recurring event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The overrides ordered by occurrence, empty if there are none.
+//   - An error wrapping eventrepo.ErrNotRecurring if the user has no such recurring event,
+//     or another error if the retrieval fails.
+func (s *Service) ListOverrides(ctx context.Context, eventID, userID uuid.UUID) ([]model.OccurrenceOverride, error) {
+	overrides, err := s.eventRepo.ListOverrides(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list overrides: %w", err)
+	}
+
+	for i := range overrides {
+		if overrides[i].Title, err = s.cipher.Decrypt(ctx, userID, overrides[i].Title); err != nil {
+			return nil, fmt.Errorf("list overrides: %w", err)
+		}
+	}
+
+	return overrides, nil
+}
+
+// checkOccurrence verifies that a recurring event of the user has a remaining occurrence at the given time
+// and returns the recurring event.
+func (s *Service) checkOccurrence(ctx context.Context, eventID, userID uuid.UUID, occurrence time.Time) (model.Event, error) {
+	series, err := s.eventRepo.GetEvent(ctx, eventID, userID)
+	if err != nil {
+		return model.Event{}, err
+	}
+	if series.RecurrenceRule == "" {
+		return model.Event{}, eventrepo.ErrNotRecurring
+	}
+
+	rule, err := rrule.Parse(series.RecurrenceRule)
 	if err != nil {
-		return fmt.Errorf("archive old events: %w", err)
+		return model.Event{}, err
+	}
+	if !rule.Includes(series.EventDate, occurrence) || slices.ContainsFunc(series.RecurrenceExceptions, occurrence.Equal) {
+		return model.Event{}, ErrOccurrenceNotFound
+	}
+
+	return series, nil
+}
+
+// normalizeRecurrence validates the recurrence rule of an event and stores it in its canonical form.
+func normalizeRecurrence(event *model.Event) error {
+	if event.RecurrenceRule == "" {
+		return nil
+	}
+
+	rule, err := rrule.Parse(event.RecurrenceRule)
+	if err != nil {
+		return err
 	}
 
+	event.RecurrenceRule = rule.String()
 	return nil
 }
 
-// GetEventsForDay retrieves all events for a specific user on a given day.
-// It delegates to the repository to fetch the events.
+// expandOccurrences replaces the recurring events of a list with their occurrences in the range [from, to),
+// ordered by date. Every occurrence keeps the ID of its series and has the occurrence as its event date;
+// occurrences of a series with an end keep its duration, so an occurrence that started before from
+// and is still going on at from is part of the range. Overridden occurrences take place at the time of
+// their override, which may move them into or out of the range, and excluded occurrences stay excluded.
+// Reminders are only scheduled for the first occurrence, so later occurrences carry no reminder.
+// Non-recurring events are kept as they are.
+func expandOccurrences(events []model.Event, from, to time.Time) []model.Event {
+	expanded := make([]model.Event, 0, len(events))
+	recurring := false
+	for _, e := range events {
+		if e.RecurrenceRule == "" {
+			expanded = append(expanded, e)
+			continue
+		}
+		recurring = true
+
+		// Rules are validated when they are stored, so an unparsable rule only lists the first occurrence.
+		rule, err := rrule.Parse(e.RecurrenceRule)
+		if err != nil {
+			if !e.EventDate.Before(from) && e.EventDate.Before(to) {
+				expanded = append(expanded, e)
+			}
+			continue
+		}
+
+		var duration time.Duration
+		if e.EndDate != nil {
+			duration = e.EndDate.Sub(e.EventDate)
+		}
+
+		overridden := func(at time.Time) bool {
+			return slices.ContainsFunc(e.Overrides, func(o model.OccurrenceOverride) bool { return o.Occurrence.Equal(at) })
+		}
+		for _, o := range e.Overrides {
+			if slices.ContainsFunc(e.RecurrenceExceptions, o.Occurrence.Equal) || !rule.Includes(e.EventDate, o.Occurrence) {
+				continue
+			}
+			occurrence := e.Override(o)
+			if !occurrence.EventDate.Before(to) || !occurrence.End().After(from) && occurrence.EventDate.Before(from) {
+				continue
+			}
+			if !o.Occurrence.Equal(e.EventDate) {
+				occurrence.ReminderAt = nil
+				occurrence.ReminderTimezone = ""
+			}
+			expanded = append(expanded, occurrence)
+		}
+
+		for _, at := range rule.Between(e.EventDate, from.Add(-duration), to) {
+			if slices.ContainsFunc(e.RecurrenceExceptions, at.Equal) || overridden(at) || !at.Add(duration).After(from) && at.Before(from) {
+				continue
+			}
+
+			occurrence := e
+			if !at.Equal(e.EventDate) {
+				occurrence.EventDate = at
+				if e.EndDate != nil {
+					end := at.Add(duration)
+					occurrence.EndDate = &end
+				}
+				occurrence.ReminderAt = nil
+				occurrence.ReminderTimezone = ""
+			}
+			expanded = append(expanded, occurrence)
+		}
+	}
+
+	if recurring {
+		slices.SortStableFunc(expanded, func(a, b model.Event) int { return a.EventDate.Compare(b.EventDate) })
+	}
+
+	return expanded
+}
+
+// withRecurrenceFields adds the fields needed to expand recurring events to a sparse fieldset,
+// along with the owner needed to decrypt the events the user is invited to.
+func withRecurrenceFields(opts model.EventListOptions) model.EventListOptions {
+	if len(opts.Fields) == 0 {
+		return opts
+	}
+
+	fields := slices.Clone(opts.Fields)
+	for _, f := range []string{"user_id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"} {
+		if !slices.Contains(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	opts.Fields = fields
+
+	return opts
+}
+
+// checkEnd validates the optional end of an event against its date.
+func checkEnd(event model.Event) error {
+	if event.EndDate == nil {
+		return nil
+	}
+	if !event.EndDate.After(event.EventDate) || event.EndDate.Sub(event.EventDate) > model.MaxEventDuration {
+		return ErrInvalidEnd
+	}
+	return nil
+}
+
+// resolveReminder converts a reminder given as a wall-clock time in a time zone into an instant in that zone,
+// following its DST rules: the offset of reminder_at is ignored, so "09:00" stays 09:00 local time whatever
+// offset the client assumed. Without a reminder time, the time zone is dropped.
+// A lead time of the notification overrides replaces the reminder time, so the reminder follows the event when it moves.
+func resolveReminder(event *model.Event) error {
+	if n := event.Notifications; n != nil && n.LeadTime > 0 {
+		remindAt := event.EventDate.Add(-n.LeadTime)
+		event.ReminderAt, event.ReminderTimezone = &remindAt, ""
+		return nil
+	}
+	if event.ReminderAt == nil {
+		event.ReminderTimezone = ""
+		return nil
+	}
+	if event.ReminderTimezone == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(event.ReminderTimezone)
+	if err != nil || event.ReminderTimezone == "Local" {
+		return fmt.Errorf("%w: %q", ErrUnknownTimezone, event.ReminderTimezone)
+	}
+
+	remindAt := timezone.Resolve(*event.ReminderAt, loc)
+	event.ReminderAt = &remindAt
+	return nil
+}
+
+// applyPriorityDefaults fills in the priority-dependent defaults of an event.
+// The default reminder of a critical event is only set if it is still in the future.
+func applyPriorityDefaults(event *model.Event, now time.Time) {
+	if event.Priority == "" {
+		event.Priority = model.PriorityNormal
+	}
+
+	if event.Priority == model.PriorityCritical && event.ReminderAt == nil {
+		remindAt := event.EventDate.Add(-criticalReminderLead)
+		if remindAt.After(now) {
+			event.ReminderAt = &remindAt
+		}
+	}
+}
+
+// encryptEvent encrypts the title and description of an event with its owner's data key.
+func (s *Service) encryptEvent(ctx context.Context, event *model.Event) error {
+	var err error
+	if event.Title, err = s.cipher.Encrypt(ctx, event.UserID, event.Title); err != nil {
+		return err
+	}
+
+	event.Description, err = s.cipher.Encrypt(ctx, event.UserID, event.Description)
+	return err
+}
+
+// decryptEvent decrypts the title and description of an event read from the repository.
+// The owner is passed explicitly because partial selects may leave UserID unset.
+func (s *Service) decryptEvent(ctx context.Context, userID uuid.UUID, event *model.Event) error {
+	var err error
+	if event.Title, err = s.cipher.Decrypt(ctx, userID, event.Title); err != nil {
+		return err
+	}
+	for i := range event.Overrides {
+		if event.Overrides[i].Title, err = s.cipher.Decrypt(ctx, userID, event.Overrides[i].Title); err != nil {
+			return err
+		}
+	}
+
+	event.Description, err = s.cipher.Decrypt(ctx, userID, event.Description)
+	return err
+}
+
+// getDecrypted retrieves an event of its owner with its title and description decrypted.
+func (s *Service) getDecrypted(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	event, err := s.eventRepo.GetEvent(ctx, eventID, userID)
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	if err := s.decryptEvent(ctx, userID, &event); err != nil {
+		return model.Event{}, err
+	}
+
+	return event, nil
+}
+
+// decryptEvents decrypts a list of events in the calendar of one user read from the repository.
+// Events the user is invited to are decrypted with the data key of their owner.
+func (s *Service) decryptEvents(ctx context.Context, userID uuid.UUID, events []model.Event) error {
+	for i := range events {
+		owner := userID
+		if events[i].AttendeeStatus != "" {
+			owner = events[i].UserID
+		}
+		if err := s.decryptEvent(ctx, owner, &events[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EventExists reports whether a user has an event with the given ID.
+// The event is not read, so it is neither decrypted nor are its related events looked up.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The date for which to retrieve events.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user who owns the event.
 //
 // Returns:
-//   - A slice of events for the specified day.
-//   - An error if the retrieval fails.
-func (s *Service) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
-	events, err := s.eventRepo.GetEventsForDay(ctx, userID, date)
+//   - True if the event exists.
+//   - An error if the check fails.
+func (s *Service) EventExists(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	exists, err := s.eventRepo.EventExists(ctx, eventID, userID)
 	if err != nil {
-		return nil, fmt.Errorf("get events for day: %w", err)
+		return false, fmt.Errorf("event exists: %w", err)
 	}
 
-	return events, nil
+	return exists, nil
 }
 
-// GetEventsForWeek retrieves all events for a specific user within a week from the given date.
-// It delegates to the repository to fetch the events.
+// GetEvent retrieves a single event for the specified user together with its related events.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The reference date for the week.
+//   - eventID: The UUID of the event to retrieve.
+//   - userID: The UUID of the user who owns the event.
 //
 // Returns:
-//   - A slice of events for the specified week.
+//   - The event.
+//   - The events linked to it.
 //   - An error if the retrieval fails.
-func (s *Service) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
-	events, err := s.eventRepo.GetEventsForWeek(ctx, userID, date)
+func (s *Service) GetEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, []model.RelatedEvent, error) {
+	event, err := s.eventRepo.GetEvent(ctx, eventID, userID)
 	if err != nil {
-		return nil, fmt.Errorf("get events for week: %w", err)
+		return model.Event{}, nil, fmt.Errorf("get event: %w", err)
 	}
 
-	return events, nil
+	related, err := s.eventRepo.GetRelatedEvents(ctx, eventID)
+	if err != nil {
+		return model.Event{}, nil, fmt.Errorf("get related events: %w", err)
+	}
+
+	if err := s.decryptEvent(ctx, userID, &event); err != nil {
+		return model.Event{}, nil, fmt.Errorf("get event: %w", err)
+	}
+
+	// Linked events always belong to the same user.
+	for i := range related {
+		if related[i].Title, err = s.cipher.Decrypt(ctx, userID, related[i].Title); err != nil {
+			return model.Event{}, nil, fmt.Errorf("get related events: %w", err)
+		}
+	}
+
+	return event, related, nil
 }
 
-// GetEventsForMonth retrieves all events for a specific user within a month from the given date.
-// It delegates to the repository to fetch the events.
+// availabilityMargin is how long before the start and after the end of an event the busy periods of its attendees
+// are shown, so the organizer sees whether the event can move.
+const availabilityMargin = time.Hour
+
+// GetAvailability retrieves the free/busy status of the attendees of an event at its time, with the periods they
+// are busy from an hour before its start to an hour after its end. Only the times of the other events of the
+// attendees are used, merged into periods, so nothing else about them is revealed. External attendees and
+// attendees who declined have no calendar to check; their status is unknown.
 //
 // Parameters:
 //   - ctx: The context for the operation.
-//   - userID: The UUID of the user whose events are retrieved.
-//   - date: The reference date for the month.
+//   - event: The event, as returned by GetEvent to its owner.
+//   - attendees: The attendees of the event.
 //
 // Returns:
-//   - A slice of events for the specified month.
-//   - An error if the retrieval fails.
-func (s *Service) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time) ([]model.Event, error) {
-	events, err := s.eventRepo.GetEventsForMonth(ctx, userID, date)
+//   - The availability of every attendee, in the order of attendees.
+//   - An error if the events of the attendees cannot be retrieved.
+func (s *Service) GetAvailability(ctx context.Context, event model.Event, attendees []model.Attendee) ([]model.Availability, error) {
+	availability := make([]model.Availability, 0, len(attendees))
+	if len(attendees) == 0 {
+		return availability, nil
+	}
+
+	start, end := event.EventDate, event.End()
+	from, to := start.Add(-availabilityMargin), end.Add(availabilityMargin)
+	events, err := s.eventRepo.GetAttendeeEvents(ctx, event.ID, from, to)
 	if err != nil {
-		return nil, fmt.Errorf("get events for month: %w", err)
+		return nil, fmt.Errorf("get availability: %w", err)
+	}
+
+	// Events without an end take no time, so they do not make anyone busy.
+	periods := make(map[uuid.UUID][]model.BusyPeriod)
+	for _, e := range expandOccurrences(events, from, to) {
+		if e.EndDate == nil || !e.EndDate.After(from) || !e.EventDate.Before(to) {
+			continue
+		}
+		p := model.BusyPeriod{Start: e.EventDate, End: *e.EndDate}
+		if p.Start.Before(from) {
+			p.Start = from
+		}
+		if p.End.After(to) {
+			p.End = to
+		}
+		periods[e.UserID] = append(periods[e.UserID], p)
+	}
+
+	for _, a := range attendees {
+		av := model.Availability{UserID: a.UserID, Email: a.Email, Status: model.AvailabilityUnknown}
+		if !a.External && a.Status != model.AttendeeDeclined {
+			av.Busy = mergePeriods(periods[a.UserID])
+			av.Status = model.AvailabilityFree
+			if slices.ContainsFunc(av.Busy, func(p model.BusyPeriod) bool { return busyAt(p, start, end) }) {
+				av.Status = model.AvailabilityBusy
+			}
+		}
+		availability = append(availability, av)
+	}
+
+	return availability, nil
+}
+
+// mergePeriods orders busy periods by start and merges the overlapping and adjacent ones; it never returns nil.
+func mergePeriods(periods []model.BusyPeriod) []model.BusyPeriod {
+	slices.SortFunc(periods, func(a, b model.BusyPeriod) int { return a.Start.Compare(b.Start) })
+
+	merged := make([]model.BusyPeriod, 0, len(periods))
+	for _, p := range periods {
+		if last := len(merged) - 1; last >= 0 && !p.Start.After(merged[last].End) {
+			if p.End.After(merged[last].End) {
+				merged[last].End = p.End
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+
+	return merged
+}
+
+// busyAt reports whether a busy period overlaps the time [start, end) of an event.
+// Events without an end, whose end is their start, are checked at their start.
+func busyAt(p model.BusyPeriod, start, end time.Time) bool {
+	return p.End.After(start) && (p.Start.Before(end) || !p.Start.After(start))
+}
+
+// LinkEvents makes an event depend on a related event of the same user.
+// If link ordering is enforced, the event must not take place before the related event.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - link: The link to create.
+//   - userID: The UUID of the user who owns both events.
+//
+// Returns:
+//   - An error if the events cannot be linked.
+func (s *Service) LinkEvents(ctx context.Context, link model.EventLink, userID uuid.UUID) error {
+	if link.EventID == link.RelatedEventID {
+		return ErrSelfLink
+	}
+
+	if s.config.EnforceLinkOrder {
+		event, err := s.eventRepo.GetEvent(ctx, link.EventID, userID)
+		if err != nil {
+			return fmt.Errorf("link events: %w", err)
+		}
+
+		related, err := s.eventRepo.GetEvent(ctx, link.RelatedEventID, userID)
+		if err != nil {
+			return fmt.Errorf("link events: %w", err)
+		}
+
+		if event.EventDate.Before(related.EventDate) {
+			return ErrLinkOrderBroken
+		}
+	}
+
+	if err := s.eventRepo.CreateLink(ctx, link, userID); err != nil {
+		return fmt.Errorf("link events: %w", err)
+	}
+
+	return nil
+}
+
+// UnlinkEvents removes the link between an event and a related event.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the dependent event.
+//   - relatedEventID: The UUID of the event it depends on.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - An error if the link cannot be removed.
+func (s *Service) UnlinkEvents(ctx context.Context, eventID, relatedEventID, userID uuid.UUID) error {
+	if err := s.eventRepo.DeleteLink(ctx, eventID, relatedEventID, userID); err != nil {
+		return fmt.Errorf("unlink events: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteEvent moves an event of the user to the trash, from which it can be restored until it is purged.
+// Attendees who accepted the event are notified that it was cancelled; they are looked up first, since trashed
+// events are not read anymore.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event to delete.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
+	var event model.Event
+	recipients := s.notifier.Recipients(ctx, eventID)
+	if len(recipients) > 0 {
+		var err error
+		if event, err = s.getDecrypted(ctx, eventID, userID); err != nil {
+			return fmt.Errorf("delete event: %w", err)
+		}
+	}
+
+	err := s.eventRepo.DeleteEvent(ctx, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("delete event: %w", err)
+	}
+
+	if len(recipients) > 0 {
+		s.notifier.NotifyCancelled(ctx, recipients, event)
+	}
+
+	return nil
+}
+
+// DeleteEvents moves the events of a user with the given IDs to the trash at once.
+// As with DeleteEvent, attendees who accepted a deleted event are notified that it was cancelled.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the events.
+//   - eventIDs: The UUIDs of the events to delete; unknown IDs are skipped.
+//
+// Returns:
+//   - The number of deleted events.
+//   - An error if the deletion fails, or if a deleted event cannot be decrypted for the notices;
+//     the events stay in the trash then and no notices are sent.
+func (s *Service) DeleteEvents(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) (int, error) {
+	deleted, err := s.eventRepo.DeleteEvents(ctx, userID, eventIDs)
+	if err != nil {
+		return 0, fmt.Errorf("delete events: %w", err)
+	}
+
+	if err := s.notifyCancelled(ctx, userID, deleted); err != nil {
+		return len(deleted), fmt.Errorf("delete events: %w", err)
+	}
+
+	return len(deleted), nil
+}
+
+// DeleteEventsInRange moves the events of a user starting within a date range to the trash at once.
+// As with DeleteEvent, attendees who accepted a deleted event are notified that it was cancelled.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the events.
+//   - from: The first day of the range.
+//   - to: The last day of the range, inclusive.
+//   - calendarID: The calendar the deletion is limited to; nil for all calendars of the user.
+//
+// Returns:
+//   - The number of deleted events.
+//   - An error if the deletion fails, or if a deleted event cannot be decrypted for the notices;
+//     the events stay in the trash then and no notices are sent.
+func (s *Service) DeleteEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time, calendarID *uuid.UUID) (int, error) {
+	deleted, err := s.eventRepo.DeleteEventsInRange(ctx, userID, from, to.AddDate(0, 0, 1), calendarID)
+	if err != nil {
+		return 0, fmt.Errorf("delete events in range: %w", err)
+	}
+
+	if err := s.notifyCancelled(ctx, userID, deleted); err != nil {
+		return len(deleted), fmt.Errorf("delete events in range: %w", err)
+	}
+
+	return len(deleted), nil
+}
+
+// notifyCancelled notifies the attendees who accepted the events deleted in bulk that they were cancelled.
+// Attendees stay recorded on trashed events, so they are looked up after the deletion. All events are decrypted
+// before the first notice is sent, so a failure sends none.
+func (s *Service) notifyCancelled(ctx context.Context, userID uuid.UUID, deleted []model.Event) error {
+	type cancellation struct {
+		recipients []model.Attendee
+		event      model.Event
+	}
+
+	var cancellations []cancellation
+	for _, event := range deleted {
+		recipients := s.notifier.Recipients(ctx, event.ID)
+		if len(recipients) == 0 {
+			continue
+		}
+		if err := s.decryptEvent(ctx, userID, &event); err != nil {
+			return fmt.Errorf("decrypt cancelled event: %w", err)
+		}
+		cancellations = append(cancellations, cancellation{recipients: recipients, event: event})
+	}
+
+	for _, c := range cancellations {
+		s.notifier.NotifyCancelled(ctx, c.recipients, c.event)
+	}
+
+	return nil
+}
+
+// ArchiveOldEvents archives a batch of events older than the current date.
+// It delegates to the repository to move old events to an archive table and delete them from the events table.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - limit: The maximum number of events archived in this batch.
+//   - dualWrite: Whether reminders are also written in the new archive format.
+//
+// Returns:
+//   - The number of archived events; fewer than limit means no old events are left.
+//   - An error if the archiving fails.
+func (s *Service) ArchiveOldEvents(ctx context.Context, limit int, dualWrite bool) (int, error) {
+	n, err := s.eventRepo.ArchiveOldEvents(ctx, limit, dualWrite)
+	if err != nil {
+		return 0, fmt.Errorf("archive old events: %w", err)
+	}
+
+	return n, nil
+}
+
+// RestoreEvent takes an event of the user out of the trash, or moves an archived event back to the calendar
+// together with its reminders.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the trashed or archived event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The restored event.
+//   - An error if the event is neither trashed nor archived or the restore fails.
+func (s *Service) RestoreEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error) {
+	event, err := s.eventRepo.RestoreEvent(ctx, eventID, userID)
+	if err != nil {
+		return model.Event{}, fmt.Errorf("restore event: %w", err)
+	}
+
+	if err := s.decryptEvent(ctx, userID, &event); err != nil {
+		return model.Event{}, fmt.Errorf("restore event: %w", err)
+	}
+
+	return event, nil
+}
+
+// ListTrash retrieves a page of the trashed events of a user, the most recently deleted first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the events.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of trashed events, empty if the trash is empty.
+//   - An error if the retrieval fails.
+func (s *Service) ListTrash(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.Event, error) {
+	events, err := s.eventRepo.ListTrash(ctx, userID, page)
+	if err != nil {
+		return nil, fmt.Errorf("list trash: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("list trash: %w", err)
+	}
+
+	return events, nil
+}
+
+// RestoreTrash takes all the events of the user out of the trash, in one transaction.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the events.
+//
+// Returns:
+//   - The number of restored events.
+//   - An error if the restore fails.
+func (s *Service) RestoreTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	n, err := s.eventRepo.RestoreTrash(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("restore trash: %w", err)
+	}
+
+	return n, nil
+}
+
+// EmptyTrash permanently deletes all the events of the user in the trash, without waiting for the trash retention.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the events.
+//
+// Returns:
+//   - The number of deleted events.
+//   - An error if the deletion fails.
+func (s *Service) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	n, err := s.eventRepo.EmptyTrash(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("empty trash: %w", err)
+	}
+
+	return n, nil
+}
+
+// PurgeTrash permanently deletes a batch of the events trashed longer than the retention, of all users.
+// With a notice period, events are only deleted once their owners were notified that long before.
+// Without a retention, trashed events are kept and nothing is deleted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - retention: How long events stay in the trash.
+//   - notice: How long before the purge owners are notified; 0 purges without a notice.
+//   - limit: The maximum number of events deleted in this batch.
+//
+// Returns:
+//   - The number of deleted events; fewer than limit means none are left.
+//   - An error if the deletion fails.
+func (s *Service) PurgeTrash(ctx context.Context, retention, notice time.Duration, limit int) (int, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	n, err := s.eventRepo.PurgeTrash(ctx, retention, notice, limit)
+	if err != nil {
+		return 0, fmt.Errorf("purge trash: %w", err)
+	}
+
+	return n, nil
+}
+
+// NotifyTrashExpiring notifies the owners of a batch of trashed events, of all users, that they are purged within
+// the notice period. Each event is announced once, in a notice per owner; notices are best effort and are not
+// sent again if their delivery fails. Without a retention or a notice period nothing is sent.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - retention: How long events stay in the trash.
+//   - notice: How long before the purge owners are notified.
+//   - limit: The maximum number of events announced in this batch.
+//
+// Returns:
+//   - The number of announced events; fewer than limit means none are left.
+//   - An error if the events cannot be claimed.
+func (s *Service) NotifyTrashExpiring(ctx context.Context, retention, notice time.Duration, limit int) (int, error) {
+	if retention <= 0 || notice <= 0 {
+		return 0, nil
+	}
+
+	notices, n, err := s.eventRepo.ClaimTrashNotices(ctx, retention, notice, limit)
+	if err != nil {
+		return 0, fmt.Errorf("notify trash expiring: %w", err)
+	}
+
+	for _, notice := range notices {
+		s.notifier.NotifyTrashExpiring(ctx, notice)
+	}
+
+	return n, nil
+}
+
+// GetMonthGrid retrieves the events of the weeks a calendar UI renders for the month of the given date:
+// from the week containing the first day of the month through the week containing the last day,
+// which are 5 or 6 weeks (4 for a February starting on weekStart). Events are grouped per day;
+// an event with an end is listed on every day it takes place.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: Any day of the month.
+//   - weekStart: The first day of every week row.
+//   - opts: Optional list parameters such as the fields to select and the time zone the days are taken in;
+//     event_date and end_date are always selected for grouping.
+//
+// Returns:
+//   - The month grid.
+//   - An error if a field is unknown or the query fails.
+func (s *Service) GetMonthGrid(ctx context.Context, userID uuid.UUID, date time.Time, weekStart time.Weekday, opts model.EventListOptions) (model.MonthGrid, error) {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, loc)
+	start := month.AddDate(0, 0, -((int(month.Weekday()) - int(weekStart) + 7) % 7))
+	last := month.AddDate(0, 1, -1)
+	end := last.AddDate(0, 0, 7-(int(last.Weekday())-int(weekStart)+7)%7)
+
+	events, err := s.eventRepo.GetEventsInRange(ctx, userID, start, end, withRecurrenceFields(opts))
+	if err != nil && !errors.Is(err, eventrepo.ErrEventNotFound) {
+		return model.MonthGrid{}, fmt.Errorf("get month grid: %w", err)
+	}
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return model.MonthGrid{}, fmt.Errorf("get month grid: %w", err)
+	}
+	events = expandOccurrences(events, start, end)
+
+	grid := model.MonthGrid{Month: month, WeekStart: weekStart}
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		grid.Days = append(grid.Days, model.MonthGridDay{Date: day, InMonth: day.Month() == month.Month(), Events: []model.Event{}})
+	}
+	for _, e := range events {
+		begin := e.EventDate.In(loc)
+		first := time.Date(begin.Year(), begin.Month(), begin.Day(), 0, 0, 0, 0, loc)
+		last := first
+		if e.EndDate != nil && e.EndDate.After(e.EventDate) {
+			// An event ending at midnight does not take place on the day that starts then.
+			end := e.EndDate.Add(-time.Nanosecond).In(loc)
+			last = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+		}
+		for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+			if i := daysBetween(start, d); i >= 0 && i < len(grid.Days) {
+				grid.Days[i].Events = append(grid.Days[i].Events, e)
+			}
+		}
+	}
+
+	return grid, nil
+}
+
+// daysBetween returns the number of calendar days from one midnight to another in the same location,
+// which is not the number of 24-hour periods across DST changes.
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// GetEventSummary counts the events of a user per day, per calendar and per tag within a date range,
+// e.g. for rendering badges in a month view without loading the events. Recurring events are expanded,
+// so every occurrence counts, and events count on the day they start in the location of the options.
+// Only the columns needed for counting are read.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are counted.
+//   - from: The first day of the range.
+//   - to: The last day of the range, inclusive.
+//   - opts: Optional list parameters; only the location is used.
+//
+// Returns:
+//   - The event summary.
+//   - An error if the query fails.
+func (s *Service) GetEventSummary(ctx context.Context, userID uuid.UUID, from, to time.Time, opts model.EventListOptions) (model.EventSummary, error) {
+	start, _ := eventrepo.DayRange(from, opts.Location)
+	_, end := eventrepo.DayRange(to, opts.Location)
+
+	fields := withRecurrenceFields(model.EventListOptions{Fields: []string{"calendar_id", "tags"}, Location: opts.Location})
+	events, err := s.eventRepo.GetEventsInRange(ctx, userID, start, end, fields)
+	if err != nil && !errors.Is(err, eventrepo.ErrEventNotFound) {
+		return model.EventSummary{}, fmt.Errorf("summarize events: %w", err)
+	}
+
+	summary := model.EventSummary{Days: []model.DayCount{}, Calendars: []model.CalendarCount{}, Tags: []model.TagCount{}}
+	calendars := make(map[uuid.UUID]int)
+	tags := make(map[string]int)
+	for _, e := range expandOccurrences(events, start, end) {
+		// Events going on at the start of the range started on an earlier day.
+		if e.EventDate.Before(start) {
+			continue
+		}
+		summary.Total++
+
+		day, _ := eventrepo.DayRange(e.EventDate.In(start.Location()), start.Location())
+		if n := len(summary.Days); n > 0 && summary.Days[n-1].Date.Equal(day) {
+			summary.Days[n-1].Count++
+		} else {
+			summary.Days = append(summary.Days, model.DayCount{Date: day, Count: 1})
+		}
+
+		var calendar uuid.UUID
+		if e.CalendarID != nil {
+			calendar = *e.CalendarID
+		}
+		calendars[calendar]++
+		for _, tag := range e.Tags {
+			tags[tag]++
+		}
+	}
+
+	// Events without a calendar are counted under the nil UUID and listed last.
+	for _, id := range slices.SortedFunc(maps.Keys(calendars), func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) }) {
+		count := model.CalendarCount{Count: calendars[id]}
+		if id != uuid.Nil {
+			count.CalendarID = &id
+		}
+		summary.Calendars = append(summary.Calendars, count)
+	}
+	if n := len(summary.Calendars); n > 0 && summary.Calendars[0].CalendarID == nil {
+		summary.Calendars = append(summary.Calendars[1:], summary.Calendars[0])
+	}
+	for _, tag := range slices.Sorted(maps.Keys(tags)) {
+		summary.Tags = append(summary.Tags, model.TagCount{Tag: tag, Count: tags[tag]})
+	}
+
+	return summary, nil
+}
+
+// SearchEvents retrieves a page of the events of a user whose title or description match a full-text search,
+// best matches first. Recurring events are matched once and not expanded.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are searched.
+//   - search: The search query and the optional date range.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of matching events, empty if there are none.
+//   - An error if the search fails.
+func (s *Service) SearchEvents(ctx context.Context, userID uuid.UUID, search model.EventSearch, page model.Page) ([]model.Event, error) {
+	events, err := s.eventRepo.SearchEvents(ctx, userID, search, page)
+	if err != nil {
+		return nil, fmt.Errorf("search events: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("search events: %w", err)
+	}
+
+	return events, nil
+}
+
+// CountSearchResults counts the events of a user matching a full-text search.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are searched.
+//   - search: The search query and the optional date range.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of matching events.
+//   - An error if the count fails.
+func (s *Service) CountSearchResults(ctx context.Context, userID uuid.UUID, search model.EventSearch, mode string) (int, error) {
+	n, err := s.eventRepo.CountSearchResults(ctx, userID, search, mode)
+	if err != nil {
+		return 0, fmt.Errorf("count search results: %w", err)
+	}
+
+	return n, nil
+}
+
+// SuggestTitles retrieves the titles of the user's events starting with a prefix, most recently scheduled first,
+// to complete the title of a new event. Case and accents are ignored unless strict is set.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose titles are suggested.
+//   - prefix: The beginning of the title.
+//   - strict: Whether the prefix must match with its case and accents.
+//   - limit: The maximum number of titles.
+//
+// Returns:
+//   - A slice of titles, empty if none matches.
+//   - An error if the retrieval fails.
+func (s *Service) SuggestTitles(ctx context.Context, userID uuid.UUID, prefix string, strict bool, limit int) ([]string, error) {
+	titles, err := s.eventRepo.SuggestTitles(ctx, userID, prefix, strict, limit)
+	if err != nil {
+		return nil, fmt.Errorf("suggest titles: %w", err)
+	}
+
+	return titles, nil
+}
+
+// GetEventsInRange retrieves the events of a user from one instant up to, but not including, another,
+// ordered by date. Recurring events are expanded into their occurrences.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - from: The start of the range.
+//   - to: The end of the range, exclusive.
+//
+// Returns:
+//   - A slice of events, empty if there are none.
+//   - An error if the retrieval fails.
+func (s *Service) GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error) {
+	events, err := s.eventRepo.GetEventsInRange(ctx, userID, from, to, model.EventListOptions{})
+	if err != nil && !errors.Is(err, eventrepo.ErrEventNotFound) {
+		return nil, fmt.Errorf("get events in range: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("get events in range: %w", err)
+	}
+
+	return expandOccurrences(events, from, to), nil
+}
+
+// GetEventsForDay retrieves all events for a specific user on a given day.
+// Recurring events are expanded into their occurrences.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The date for which to retrieve events.
+//   - opts: Optional list parameters such as the fields to select.
+//
+// Returns:
+//   - A slice of events for the specified day.
+//   - An error if the retrieval fails.
+func (s *Service) GetEventsForDay(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	events, err := s.eventRepo.GetEventsForDay(ctx, userID, date, withRecurrenceFields(opts))
+	if err != nil {
+		return nil, fmt.Errorf("get events for day: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("get events for day: %w", err)
+	}
+
+	from, to := eventrepo.DayRange(date, opts.Location)
+	if events = expandOccurrences(events, from, to); len(events) == 0 {
+		return nil, fmt.Errorf("get events for day: %w", eventrepo.ErrEventNotFound)
+	}
+
+	return events, nil
+}
+
+// GetEventsForWeek retrieves all events for a specific user within a week from the given date.
+// Recurring events are expanded into their occurrences.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The reference date for the week.
+//   - opts: Optional list parameters such as the fields to select.
+//
+// Returns:
+//   - A slice of events for the specified week.
+//   - An error if the retrieval fails.
+func (s *Service) GetEventsForWeek(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	events, err := s.eventRepo.GetEventsForWeek(ctx, userID, date, withRecurrenceFields(opts))
+	if err != nil {
+		return nil, fmt.Errorf("get events for week: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("get events for week: %w", err)
+	}
+
+	from, to := eventrepo.WeekRange(date, opts.Location)
+	if events = expandOccurrences(events, from, to); len(events) == 0 {
+		return nil, fmt.Errorf("get events for week: %w", eventrepo.ErrEventNotFound)
+	}
+
+	return events, nil
+}
+
+// GetEventsForMonth retrieves all events for a specific user within a month from the given date.
+// Recurring events are expanded into their occurrences.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are retrieved.
+//   - date: The reference date for the month.
+//   - opts: Optional list parameters such as the fields to select.
+//
+// Returns:
+//   - A slice of events for the specified month.
+//   - An error if the retrieval fails.
+func (s *Service) GetEventsForMonth(ctx context.Context, userID uuid.UUID, date time.Time, opts model.EventListOptions) ([]model.Event, error) {
+	events, err := s.eventRepo.GetEventsForMonth(ctx, userID, date, withRecurrenceFields(opts))
+	if err != nil {
+		return nil, fmt.Errorf("get events for month: %w", err)
+	}
+
+	if err := s.decryptEvents(ctx, userID, events); err != nil {
+		return nil, fmt.Errorf("get events for month: %w", err)
+	}
+
+	from, to := eventrepo.MonthRange(date, opts.Location)
+	if events = expandOccurrences(events, from, to); len(events) == 0 {
+		return nil, fmt.Errorf("get events for month: %w", eventrepo.ErrEventNotFound)
 	}
 
 	return events, nil
@@ -2,23 +2,83 @@ package event
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
+	encryptionmocks "github.com/aliskhannn/calendar-service/internal/mocks/encryption"
 	eventrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/event"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
 )
 
+// noRules is a rule engine without any rules.
+type noRules struct{}
+
+func (noRules) ApplyRules(context.Context, *model.Event) error { return nil }
+
+// noAttendees is a change notifier for events without attendees.
+type noAttendees struct{}
+
+func (noAttendees) Recipients(context.Context, uuid.UUID) []model.Attendee { return nil }
+
+func (noAttendees) NotifyChanged(context.Context, []model.Attendee, model.Event, model.Event) {}
+
+func (noAttendees) NotifyCancelled(context.Context, []model.Attendee, model.Event) {}
+
+func (noAttendees) NotifyTrashExpiring(context.Context, model.TrashNotice) {}
+
+// trashNotices is a change notifier that records trash notices.
+type trashNotices struct {
+	noAttendees
+	sent []model.TrashNotice
+}
+
+func (n *trashNotices) NotifyTrashExpiring(_ context.Context, notice model.TrashNotice) {
+	n.sent = append(n.sent, notice)
+}
+
+func TestService_CreateEvent_Limit(t *testing.T) {
+	for _, count := range []int{2, 3} {
+		ctrl := gomock.NewController(t)
+
+		mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+		svc := New(mockRepo, config.Event{MaxPerUser: 3}, encryption.Disabled(), noRules{}, noAttendees{})
+
+		userID := uuid.New()
+		mockRepo.EXPECT().CountEvents(gomock.Any(), userID, model.EventFilter{}).Return(count, nil)
+		if count < 3 {
+			mockRepo.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+		}
+
+		_, err := svc.CreateEvent(context.Background(), model.Event{UserID: userID, Title: "Standup", EventDate: time.Now()})
+		if count < 3 && err != nil {
+			t.Fatalf("%d events: unexpected error: %v", count, err)
+		}
+		if count >= 3 && !errors.Is(err, ErrEventLimit) {
+			t.Fatalf("%d events: expected ErrEventLimit, got %v", count, err)
+		}
+
+		ctrl.Finish()
+	}
+}
+
 func TestService_CreateEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
 
 	userID := uuid.New()
 	date := time.Now()
@@ -26,18 +86,21 @@ func TestService_CreateEvent(t *testing.T) {
 	description := "Some description"
 	mockID := uuid.New()
 
-	expectedEvent := model.Event{
+	event := model.Event{
 		UserID:      userID,
 		Title:       title,
 		Description: description,
 		EventDate:   date,
 	}
 
+	expectedEvent := event
+	expectedEvent.Priority = model.PriorityNormal
+
 	mockRepo.EXPECT().
 		CreateEvent(gomock.Any(), expectedEvent).
 		Return(mockID, nil)
 
-	id, err := svc.CreateEvent(context.Background(), userID, title, description, date)
+	id, err := svc.CreateEvent(context.Background(), event)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -46,12 +109,318 @@ func TestService_CreateEvent(t *testing.T) {
 	}
 }
 
+// Rules see the plaintext of the event and their color and tags are stored with it.
+func TestService_CreateEvent_Rules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	mockRules := eventrepomocks.NewMockruleEngine(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), mockRules, noAttendees{})
+
+	event := model.Event{UserID: uuid.New(), Title: "Daily standup", EventDate: time.Now()}
+
+	mockRules.EXPECT().
+		ApplyRules(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *model.Event) error {
+			if e.Title != "Daily standup" || e.Priority != model.PriorityNormal {
+				t.Errorf("expected the plaintext event with defaults, got %+v", e)
+			}
+			e.Color, e.Tags = "blue", []string{"work"}
+			return nil
+		})
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			if e.Color != "blue" || len(e.Tags) != 1 || e.Tags[0] != "work" {
+				t.Errorf("expected the color and tags of the rules, got %q %v", e.Color, e.Tags)
+			}
+			return uuid.New(), nil
+		})
+
+	if _, err := svc.CreateEvent(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_CreateEvent_RulesFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRules := eventrepomocks.NewMockruleEngine(ctrl)
+	svc := New(eventrepomocks.NewMockeventRepo(ctrl), config.Event{}, encryption.Disabled(), mockRules, noAttendees{})
+
+	mockRules.EXPECT().ApplyRules(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+	if _, err := svc.CreateEvent(context.Background(), model.Event{UserID: uuid.New(), Title: "Standup"}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+// A reminder at 09:00 set with the summer offset for a date after the switch to winter time
+// keeps its wall-clock time in the given zone.
+func TestService_CreateEvent_ZonedReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	remindAt := time.Date(2030, 11, 4, 9, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+	event := model.Event{
+		UserID:           uuid.New(),
+		Title:            "Dentist",
+		EventDate:        time.Date(2030, 11, 4, 0, 0, 0, 0, time.UTC),
+		ReminderAt:       &remindAt,
+		ReminderTimezone: "Europe/Berlin",
+	}
+
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			want := time.Date(2030, 11, 4, 8, 0, 0, 0, time.UTC)
+			if e.ReminderAt == nil || !e.ReminderAt.Equal(want) {
+				t.Errorf("expected reminder at %v, got %v", want, e.ReminderAt)
+			}
+			if e.ReminderAt.Location().String() != "Europe/Berlin" {
+				t.Errorf("expected reminder in Europe/Berlin, got %v", e.ReminderAt.Location())
+			}
+			return uuid.New(), nil
+		})
+
+	if _, err := svc.CreateEvent(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_CreateEvent_LeadTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	date := time.Date(2030, 11, 4, 9, 0, 0, 0, time.UTC)
+	remindAt := date.Add(-24 * time.Hour)
+
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			// The lead time replaces the reminder time and its time zone.
+			want := date.Add(-15 * time.Minute)
+			if e.ReminderAt == nil || !e.ReminderAt.Equal(want) || e.ReminderTimezone != "" {
+				t.Errorf("expected reminder at %v without a time zone, got %v in %q", want, e.ReminderAt, e.ReminderTimezone)
+			}
+			return uuid.New(), nil
+		})
+
+	_, err := svc.CreateEvent(context.Background(), model.Event{
+		UserID:           uuid.New(),
+		Title:            "Dentist",
+		EventDate:        date,
+		ReminderAt:       &remindAt,
+		ReminderTimezone: "Europe/Berlin",
+		Notifications:    &model.EventNotifications{LeadTime: 15 * time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_CreateEvent_UnknownTimezone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	remindAt := time.Now().Add(time.Hour)
+	_, err := svc.CreateEvent(context.Background(), model.Event{
+		UserID:           uuid.New(),
+		Title:            "Dentist",
+		EventDate:        time.Now(),
+		ReminderAt:       &remindAt,
+		ReminderTimezone: "Mars/Olympus_Mons",
+	})
+	if !errors.Is(err, ErrUnknownTimezone) {
+		t.Fatalf("expected ErrUnknownTimezone, got %v", err)
+	}
+}
+
+func TestService_CreateEvent_Encrypted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kms, err := encryption.NewLocalKMS(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wrapped, _ := kms.Wrap(context.Background(), make([]byte, 32))
+
+	keys := encryptionmocks.NewMockkeyStore(ctrl)
+	keys.EXPECT().GetKey(gomock.Any(), gomock.Any()).Return(wrapped, nil)
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.NewWithKMS(kms, keys), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	var stored model.Event
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			stored = e
+			return uuid.New(), nil
+		})
+	mockRepo.EXPECT().
+		GetEventsForDay(gomock.Any(), userID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, uuid.UUID, time.Time, model.EventListOptions) ([]model.Event, error) {
+			return []model.Event{stored}, nil
+		})
+
+	if _, err := svc.CreateEvent(context.Background(), model.Event{UserID: userID, Title: "Therapy", EventDate: time.Now()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.Title == "Therapy" {
+		t.Fatalf("expected title to be stored encrypted")
+	}
+
+	events, err := svc.GetEventsForDay(context.Background(), userID, time.Now(), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events[0].Title != "Therapy" {
+		t.Fatalf("expected decrypted title, got %q", events[0].Title)
+	}
+}
+
+func TestService_GetEventsForDay_InvitedEncrypted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kms, err := encryption.NewLocalKMS(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wrapped, _ := kms.Wrap(context.Background(), make([]byte, 32))
+
+	// Only the owner's key is used: events the user is invited to are encrypted by their owner.
+	ownerID, inviteeID := uuid.New(), uuid.New()
+	keys := encryptionmocks.NewMockkeyStore(ctrl)
+	keys.EXPECT().GetKey(gomock.Any(), ownerID).Return(wrapped, nil).AnyTimes()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.NewWithKMS(kms, keys), noRules{}, noAttendees{})
+
+	var stored model.Event
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			stored = e
+			return uuid.New(), nil
+		})
+	mockRepo.EXPECT().
+		GetEventsForDay(gomock.Any(), inviteeID, gomock.Any(), model.EventListOptions{Fields: []string{"title", "user_id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"}}).
+		DoAndReturn(func(context.Context, uuid.UUID, time.Time, model.EventListOptions) ([]model.Event, error) {
+			invited := stored
+			invited.AttendeeStatus = model.AttendeeAccepted
+			return []model.Event{invited}, nil
+		})
+
+	if _, err := svc.CreateEvent(context.Background(), model.Event{UserID: ownerID, Title: "Planning", EventDate: time.Now()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := svc.GetEventsForDay(context.Background(), inviteeID, time.Now(), model.EventListOptions{Fields: []string{"title"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events[0].Title != "Planning" {
+		t.Fatalf("expected decrypted title, got %q", events[0].Title)
+	}
+}
+
+func TestService_SearchEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	search := model.EventSearch{Query: "dentist"}
+	page := model.Page{Limit: 20}
+
+	mockRepo.EXPECT().SearchEvents(gomock.Any(), userID, search, page).
+		Return([]model.Event{{ID: uuid.New(), UserID: userID, Title: "Dentist"}}, nil)
+	mockRepo.EXPECT().SearchEvents(gomock.Any(), userID, search, page).
+		Return(nil, errors.New("db down"))
+
+	events, err := svc.SearchEvents(context.Background(), userID, search, page)
+	if err != nil || len(events) != 1 || events[0].Title != "Dentist" {
+		t.Fatalf("unexpected result: %v, %v", events, err)
+	}
+
+	if _, err := svc.SearchEvents(context.Background(), userID, search, page); err == nil || err.Error() != "search events: db down" {
+		t.Fatalf("expected wrapped error, got %v", err)
+	}
+}
+
+func TestService_SuggestTitles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	mockRepo.EXPECT().SuggestTitles(gomock.Any(), userID, "cafe", false, 10).Return([]string{"Café with Anna"}, nil)
+	mockRepo.EXPECT().SuggestTitles(gomock.Any(), userID, "cafe", true, 10).Return(nil, errors.New("db down"))
+
+	titles, err := svc.SuggestTitles(context.Background(), userID, "cafe", false, 10)
+	if err != nil || len(titles) != 1 || titles[0] != "Café with Anna" {
+		t.Fatalf("unexpected result: %v, %v", titles, err)
+	}
+
+	if _, err := svc.SuggestTitles(context.Background(), userID, "cafe", true, 10); err == nil || err.Error() != "suggest titles: db down" {
+		t.Fatalf("expected wrapped error, got %v", err)
+	}
+}
+
+func TestService_CreateEvent_CriticalDefaultReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	date := time.Now().Add(3 * time.Hour)
+
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			if e.ReminderAt == nil || !e.ReminderAt.Equal(date.Add(-criticalReminderLead)) {
+				t.Errorf("expected default reminder %v, got %v", date.Add(-criticalReminderLead), e.ReminderAt)
+			}
+			return uuid.New(), nil
+		})
+
+	_, err := svc.CreateEvent(context.Background(), model.Event{
+		UserID:    uuid.New(),
+		Title:     "Release",
+		EventDate: date,
+		Priority:  model.PriorityCritical,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestService_UpdateEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
 
 	eventID := uuid.New()
 	userID := uuid.New()
@@ -63,18 +432,129 @@ func TestService_UpdateEvent(t *testing.T) {
 		UpdateEvent(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	err := svc.UpdateEvent(context.Background(), eventID, userID, title, description, date)
+	err := svc.UpdateEvent(context.Background(), model.Event{
+		ID:          eventID,
+		UserID:      userID,
+		Title:       title,
+		Description: description,
+		EventDate:   date,
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
+func TestService_UpdateEvent_NotifiesAttendees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	notifier := eventrepomocks.NewMockchangeNotifier(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, notifier)
+
+	date := time.Now().Add(48 * time.Hour)
+	before := model.Event{ID: uuid.New(), UserID: uuid.New(), Title: "Review", Priority: model.PriorityNormal, EventDate: date}
+	event := before
+	event.EventDate = date.Add(time.Hour)
+	recipients := []model.Attendee{{EventID: event.ID, UserID: uuid.New(), Status: model.AttendeeAccepted}}
+
+	gomock.InOrder(
+		notifier.EXPECT().Recipients(gomock.Any(), event.ID).Return(recipients),
+		mockRepo.EXPECT().GetEvent(gomock.Any(), event.ID, event.UserID).Return(before, nil),
+		mockRepo.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).Return(nil),
+		notifier.EXPECT().
+			NotifyChanged(gomock.Any(), recipients, before, gomock.Any()).
+			Do(func(_ context.Context, _ []model.Attendee, _, after model.Event) {
+				if !after.EventDate.Equal(event.EventDate) || after.Title != "Review" {
+					t.Fatalf("expected the updated event, got %+v", after)
+				}
+			}),
+	)
+
+	if err := svc.UpdateEvent(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_UpdateEvent_LinkOrderBroken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{EnforceLinkOrder: true}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	event := model.Event{ID: uuid.New(), UserID: uuid.New(), Title: "Follow-up", EventDate: time.Now()}
+
+	mockRepo.EXPECT().
+		CountLinkOrderViolations(gomock.Any(), event.ID, event.EventDate).
+		Return(1, nil)
+
+	err := svc.UpdateEvent(context.Background(), event)
+	if !errors.Is(err, ErrLinkOrderBroken) {
+		t.Fatalf("expected ErrLinkOrderBroken, got %v", err)
+	}
+}
+
+func TestService_LinkEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{EnforceLinkOrder: true}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	link := model.EventLink{EventID: uuid.New(), RelatedEventID: uuid.New(), Type: model.LinkFollowUpOf}
+	date := time.Now()
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), link.EventID, userID).Return(model.Event{EventDate: date.Add(time.Hour)}, nil)
+	mockRepo.EXPECT().GetEvent(gomock.Any(), link.RelatedEventID, userID).Return(model.Event{EventDate: date}, nil)
+	mockRepo.EXPECT().CreateLink(gomock.Any(), link, userID).Return(nil)
+
+	if err := svc.LinkEvents(context.Background(), link, userID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_LinkEvents_OrderBroken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{EnforceLinkOrder: true}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	link := model.EventLink{EventID: uuid.New(), RelatedEventID: uuid.New(), Type: model.LinkBlockedBy}
+	date := time.Now()
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), link.EventID, userID).Return(model.Event{EventDate: date}, nil)
+	mockRepo.EXPECT().GetEvent(gomock.Any(), link.RelatedEventID, userID).Return(model.Event{EventDate: date.Add(time.Hour)}, nil)
+
+	err := svc.LinkEvents(context.Background(), link, userID)
+	if !errors.Is(err, ErrLinkOrderBroken) {
+		t.Fatalf("expected ErrLinkOrderBroken, got %v", err)
+	}
+}
+
+func TestService_LinkEvents_Self(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	id := uuid.New()
+	err := svc.LinkEvents(context.Background(), model.EventLink{EventID: id, RelatedEventID: id, Type: model.LinkFollowUpOf}, uuid.New())
+	if !errors.Is(err, ErrSelfLink) {
+		t.Fatalf("expected ErrSelfLink, got %v", err)
+	}
+}
+
 func TestService_DeleteEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
 
 	eventID := uuid.New()
 	userID := uuid.New()
@@ -88,74 +568,701 @@ func TestService_DeleteEvent(t *testing.T) {
 	}
 }
 
-func TestService_GetEventsForDay(t *testing.T) {
+func TestService_DeleteEvent_NotifiesAttendees(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	notifier := eventrepomocks.NewMockchangeNotifier(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, notifier)
 
-	mockEvents := []model.Event{
-		{Title: "Event 1", EventDate: time.Now()},
+	event := model.Event{ID: uuid.New(), UserID: uuid.New(), Title: "Review", EventDate: time.Now()}
+	recipients := []model.Attendee{{EventID: event.ID, UserID: uuid.New(), Status: model.AttendeeAccepted}}
+
+	// Attendees and the event are read before the deletion removes them.
+	gomock.InOrder(
+		notifier.EXPECT().Recipients(gomock.Any(), event.ID).Return(recipients),
+		mockRepo.EXPECT().GetEvent(gomock.Any(), event.ID, event.UserID).Return(event, nil),
+		mockRepo.EXPECT().DeleteEvent(gomock.Any(), event.ID, event.UserID).Return(nil),
+		notifier.EXPECT().NotifyCancelled(gomock.Any(), recipients, event),
+	)
+
+	if err := svc.DeleteEvent(context.Background(), event.ID, event.UserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
+}
+
+func TestService_DeleteEventsInRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
 
+	userID := uuid.New()
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	// The last day is inclusive, so the range ends at the following midnight.
 	mockRepo.EXPECT().
-		GetEventsForDay(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(mockEvents, nil)
+		DeleteEventsInRange(gomock.Any(), userID, from, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), (*uuid.UUID)(nil)).
+		Return(make([]model.Event, 7), nil)
 
-	ev, err := svc.GetEventsForDay(context.Background(), uuid.New(), time.Now())
+	n, err := svc.DeleteEventsInRange(context.Background(), userID, from, to, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(ev) != len(mockEvents) {
-		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	if n != 7 {
+		t.Fatalf("expected 7 deleted events, got %d", n)
 	}
 }
 
-func TestService_GetEventsForWeek(t *testing.T) {
+func TestService_DeleteEvents_NotifiesAttendees(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	notifier := eventrepomocks.NewMockchangeNotifier(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, notifier)
 
-	mockEvents := []model.Event{
-		{Title: "Event Week", EventDate: time.Now()},
-	}
+	userID := uuid.New()
+	attended := model.Event{ID: uuid.New(), UserID: userID, Title: "Review", EventDate: time.Now()}
+	alone := model.Event{ID: uuid.New(), UserID: userID, Title: "Focus time", EventDate: time.Now()}
+	recipients := []model.Attendee{{EventID: attended.ID, UserID: uuid.New(), Status: model.AttendeeAccepted}}
 
-	mockRepo.EXPECT().
-		GetEventsForWeek(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(mockEvents, nil)
+	// Attendees stay recorded on trashed events, so they are looked up after the deletion.
+	gomock.InOrder(
+		mockRepo.EXPECT().DeleteEvents(gomock.Any(), userID, []uuid.UUID{attended.ID, alone.ID}).
+			Return([]model.Event{attended, alone}, nil),
+		notifier.EXPECT().Recipients(gomock.Any(), attended.ID).Return(recipients),
+		notifier.EXPECT().Recipients(gomock.Any(), alone.ID).Return(nil),
+		notifier.EXPECT().NotifyCancelled(gomock.Any(), recipients, attended),
+	)
 
-	ev, err := svc.GetEventsForWeek(context.Background(), uuid.New(), time.Now())
+	n, err := svc.DeleteEvents(context.Background(), userID, []uuid.UUID{attended.ID, alone.ID})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(ev) != len(mockEvents) {
-		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	if n != 2 {
+		t.Fatalf("expected 2 deleted events, got %d", n)
 	}
 }
 
-func TestService_GetEventsForMonth(t *testing.T) {
+func TestService_RestoreEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
-	svc := New(mockRepo)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
 
-	mockEvents := []model.Event{
-		{Title: "Event Month", EventDate: time.Now()},
-	}
+	eventID := uuid.New()
+	userID := uuid.New()
+	reminderAt := time.Now()
 
 	mockRepo.EXPECT().
-		GetEventsForMonth(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(mockEvents, nil)
+		RestoreEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{ID: eventID, UserID: userID, Title: "Dentist", ReminderAt: &reminderAt}, nil)
 
-	ev, err := svc.GetEventsForMonth(context.Background(), uuid.New(), time.Now())
+	event, err := svc.RestoreEvent(context.Background(), eventID, userID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(ev) != len(mockEvents) {
-		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	if event.ReminderAt == nil || !event.ReminderAt.Equal(reminderAt) {
+		t.Fatalf("expected reminder at %v, got %v", reminderAt, event.ReminderAt)
+	}
+}
+
+func TestService_PurgeTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	// Without a retention the trash is kept.
+	if n, err := svc.PurgeTrash(context.Background(), 0, 72*time.Hour, 100); err != nil || n != 0 {
+		t.Fatalf("expected nothing purged, got %d, %v", n, err)
+	}
+
+	mockRepo.EXPECT().PurgeTrash(gomock.Any(), 720*time.Hour, 72*time.Hour, 100).Return(4, nil)
+
+	n, err := svc.PurgeTrash(context.Background(), 720*time.Hour, 72*time.Hour, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 4 {
+		t.Fatalf("expected 4 purged events, got %d", n)
+	}
+}
+
+func TestService_NotifyTrashExpiring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	notifier := &trashNotices{}
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, notifier)
+
+	// Without a notice period nothing is sent.
+	if n, err := svc.NotifyTrashExpiring(context.Background(), 720*time.Hour, 0, 100); err != nil || n != 0 {
+		t.Fatalf("expected nothing announced, got %d, %v", n, err)
+	}
+
+	notices := []model.TrashNotice{
+		{UserID: uuid.New(), Email: "a@example.com", Events: 2, PurgeIn: 72 * time.Hour},
+		{UserID: uuid.New(), Email: "b@example.com", Events: 1, PurgeIn: 72 * time.Hour},
+	}
+	mockRepo.EXPECT().ClaimTrashNotices(gomock.Any(), 720*time.Hour, 72*time.Hour, 100).Return(notices, 3, nil)
+
+	n, err := svc.NotifyTrashExpiring(context.Background(), 720*time.Hour, 72*time.Hour, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 {
+		t.Fatalf("expected 3 announced events, got %d", n)
+	}
+	if !slices.Equal(notifier.sent, notices) {
+		t.Fatalf("expected a notice per owner, got %+v", notifier.sent)
+	}
+}
+
+func TestService_GetMonthGrid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID := uuid.New()
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+
+	// March 2025 starts on a Saturday and ends on a Monday: six weeks from Feb 24 through Apr 6.
+	mockRepo.EXPECT().
+		GetEventsInRange(gomock.Any(), userID, day(time.February, 24), day(time.April, 7), model.EventListOptions{Fields: []string{"title", "user_id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"}}).
+		Return([]model.Event{
+			{Title: "Overflow", EventDate: day(time.February, 25)},
+			{Title: "Standup", EventDate: day(time.March, 3)},
+			{Title: "Retro", EventDate: day(time.March, 3)},
+		}, nil)
+
+	grid, err := svc.GetMonthGrid(context.Background(), userID, day(time.March, 15), time.Monday, model.EventListOptions{Fields: []string{"title"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(grid.Days) != 42 {
+		t.Fatalf("expected 42 days, got %d", len(grid.Days))
+	}
+	if grid.Days[0].InMonth || !grid.Days[5].InMonth || grid.Days[41].InMonth {
+		t.Fatalf("unexpected in-month flags: %+v", grid.Days)
+	}
+	if len(grid.Days[1].Events) != 1 || len(grid.Days[7].Events) != 2 || len(grid.Days[8].Events) != 0 {
+		t.Fatalf("events grouped on wrong days: %+v", grid.Days)
+	}
+}
+
+func TestService_GetEventSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	userID, calendarID := uuid.New(), uuid.New()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	day := func(d int) time.Time { return time.Date(2025, time.September, d, 0, 0, 0, 0, tokyo) }
+
+	// Sep 1 23:30 UTC is Sep 2 in Tokyo; the daily standup has three occurrences from Sep 2 through Sep 4,
+	// one of them excluded, and the trip started before the range.
+	standup := time.Date(2025, time.September, 2, 0, 30, 0, 0, time.UTC)
+	tripEnd := time.Date(2025, time.September, 2, 12, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().
+		GetEventsInRange(gomock.Any(), userID, day(1), day(5), model.EventListOptions{Fields: []string{"calendar_id", "tags", "user_id", "event_date", "end_date", "recurrence_rule", "recurrence_exceptions"}, Location: tokyo}).
+		Return([]model.Event{
+			{EventDate: time.Date(2025, time.August, 30, 12, 0, 0, 0, time.UTC), EndDate: &tripEnd, Tags: []string{"travel"}},
+			{EventDate: time.Date(2025, time.September, 1, 23, 30, 0, 0, time.UTC), CalendarID: &calendarID, Tags: []string{"work", "focus"}},
+			{EventDate: standup, CalendarID: &calendarID, Tags: []string{"work"}, RecurrenceRule: "FREQ=DAILY;COUNT=5",
+				RecurrenceExceptions: []time.Time{standup.AddDate(0, 0, 1)}},
+		}, nil)
+
+	summary, err := svc.GetEventSummary(context.Background(), userID, day(1), day(4), model.EventListOptions{Location: tokyo})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Total != 3 {
+		t.Fatalf("expected 3 events, got %d", summary.Total)
+	}
+	if len(summary.Days) != 2 || !summary.Days[0].Date.Equal(day(2)) || summary.Days[0].Count != 2 ||
+		!summary.Days[1].Date.Equal(day(4)) || summary.Days[1].Count != 1 {
+		t.Fatalf("unexpected days: %+v", summary.Days)
+	}
+	if len(summary.Calendars) != 1 || *summary.Calendars[0].CalendarID != calendarID || summary.Calendars[0].Count != 3 {
+		t.Fatalf("unexpected calendars: %+v", summary.Calendars)
+	}
+	if want := []model.TagCount{{Tag: "focus", Count: 1}, {Tag: "work", Count: 3}}; !slices.Equal(summary.Tags, want) {
+		t.Fatalf("expected tags %+v, got %+v", want, summary.Tags)
+	}
+}
+
+func TestService_GetMonthGrid_MultiDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	at := func(month time.Month, d, h int) time.Time { return time.Date(2025, month, d, h, 0, 0, 0, time.UTC) }
+	conferenceEnd := at(time.March, 5, 17)
+	tripEnd := at(time.March, 12, 0)
+
+	// The conference runs from Friday, Feb 28 through Wednesday, Mar 5; the trip ends at midnight of Mar 12.
+	mockRepo.EXPECT().
+		GetEventsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.Event{
+			{Title: "Conference", EventDate: at(time.February, 28, 9), EndDate: &conferenceEnd},
+			{Title: "Trip", EventDate: at(time.March, 10, 6), EndDate: &tripEnd},
+		}, nil)
+
+	grid, err := svc.GetMonthGrid(context.Background(), uuid.New(), at(time.March, 1, 0), time.Monday, model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The grid starts on Monday, Feb 24.
+	for i, want := range map[int]int{3: 0, 4: 1, 9: 1, 10: 0, 14: 1, 15: 1, 16: 0} {
+		if len(grid.Days[i].Events) != want {
+			t.Fatalf("day %s: expected %d events, got %+v", grid.Days[i].Date.Format(time.DateOnly), want, grid.Days[i].Events)
+		}
+	}
+}
+
+func TestService_GetMonthGrid_Empty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	// February 2021 starts on a Monday and has exactly four weeks.
+	mockRepo.EXPECT().
+		GetEventsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, eventrepo.ErrEventNotFound)
+
+	grid, err := svc.GetMonthGrid(context.Background(), uuid.New(), time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC), time.Monday, model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(grid.Days) != 28 || grid.Days[0].Events == nil {
+		t.Fatalf("expected 28 empty days, got %+v", grid.Days)
+	}
+}
+
+func TestService_GetEventsForDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	mockEvents := []model.Event{
+		{Title: "Event 1", EventDate: time.Now()},
+	}
+
+	mockRepo.EXPECT().
+		GetEventsForDay(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(mockEvents, nil)
+
+	ev, err := svc.GetEventsForDay(context.Background(), uuid.New(), time.Now(), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ev) != len(mockEvents) {
+		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	}
+}
+
+func TestService_GetEventsForWeek(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	mockEvents := []model.Event{
+		{Title: "Event Week", EventDate: time.Now()},
+	}
+
+	mockRepo.EXPECT().
+		GetEventsForWeek(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(mockEvents, nil)
+
+	ev, err := svc.GetEventsForWeek(context.Background(), uuid.New(), time.Now(), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ev) != len(mockEvents) {
+		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	}
+}
+
+func TestService_GetEventsForMonth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	mockEvents := []model.Event{
+		{Title: "Event Month", EventDate: time.Now()},
+	}
+
+	mockRepo.EXPECT().
+		GetEventsForMonth(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(mockEvents, nil)
+
+	ev, err := svc.GetEventsForMonth(context.Background(), uuid.New(), time.Now(), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ev) != len(mockEvents) {
+		t.Fatalf("expected %d events, got %d", len(mockEvents), len(ev))
+	}
+}
+
+func TestService_GetEventsForWeek_Recurring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	at := func(d, h int) time.Time { return time.Date(2030, time.January, d, h, 0, 0, 0, time.UTC) }
+	seriesID := uuid.New()
+	reminderAt := at(1, 8)
+
+	// The week of Jan 10 lists Jan 3 through Jan 10; the standup started on Tuesday, Jan 1 and Monday, Jan 7 was deleted.
+	mockRepo.EXPECT().
+		GetEventsForWeek(gomock.Any(), gomock.Any(), at(10, 0), gomock.Any()).
+		Return([]model.Event{
+			{ID: seriesID, Title: "Standup", EventDate: at(1, 9), ReminderAt: &reminderAt,
+				RecurrenceRule: "FREQ=DAILY;BYDAY=MO,WE,FR", RecurrenceExceptions: []time.Time{at(7, 9)}},
+			{Title: "Lunch", EventDate: at(4, 12)},
+		}, nil)
+
+	events, err := svc.GetEventsForWeek(context.Background(), uuid.New(), at(10, 0), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []time.Time{at(4, 9), at(4, 12), at(9, 9)}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), events)
+	}
+	for i, e := range events {
+		if !e.EventDate.Equal(want[i]) {
+			t.Fatalf("event %d at %v, want %v", i, e.EventDate, want[i])
+		}
+	}
+	if events[0].ID != seriesID || events[0].ReminderAt != nil {
+		t.Fatalf("occurrences keep the series ID without a reminder, got %+v", events[0])
+	}
+}
+
+func TestService_GetEventsForDay_RecurringMultiDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	at := func(d, h int) time.Time { return time.Date(2030, time.January, d, h, 0, 0, 0, time.UTC) }
+	end := at(2, 6)
+
+	// A weekly night shift from Tuesday 22:00 to Wednesday 06:00 is still going on Wednesday morning.
+	mockRepo.EXPECT().
+		GetEventsForDay(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.Event{{Title: "Night shift", EventDate: at(1, 22), EndDate: &end, RecurrenceRule: "FREQ=WEEKLY"}}, nil)
+
+	events, err := svc.GetEventsForDay(context.Background(), uuid.New(), at(9, 0), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 || !events[0].EventDate.Equal(at(8, 22)) || events[0].EndDate == nil || !events[0].EndDate.Equal(at(9, 6)) {
+		t.Fatalf("expected the occurrence of Jan 8 ending on Jan 9, got %+v", events)
+	}
+}
+
+func TestService_GetEventsForWeek_Overrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	at := func(d, h int) time.Time { return time.Date(2030, time.January, d, h, 0, 0, 0, time.UTC) }
+	end := at(1, 10)
+	reminderAt := at(1, 8)
+	movedEnd := at(11, 12)
+
+	// The week of Jan 10 lists Jan 3 through Jan 10. The daily standup of Jan 4 is retitled and moved an hour later,
+	// the one of Jan 5 is moved out of the week, the one of Jan 12 into it, and the override of the deleted Jan 6 is ignored.
+	mockRepo.EXPECT().
+		GetEventsForWeek(gomock.Any(), gomock.Any(), at(10, 0), gomock.Any()).
+		Return([]model.Event{{
+			Title: "Standup", EventDate: at(1, 9), EndDate: &end, ReminderAt: &reminderAt,
+			RecurrenceRule: "FREQ=DAILY;COUNT=12", RecurrenceExceptions: []time.Time{at(6, 9)},
+			Overrides: []model.OccurrenceOverride{
+				{Occurrence: at(4, 9), EventDate: at(4, 10), Title: "Standup with demo"},
+				{Occurrence: at(5, 9), EventDate: at(15, 9)},
+				{Occurrence: at(6, 9), EventDate: at(6, 11)},
+				{Occurrence: at(12, 9), EventDate: at(9, 11), EndDate: &movedEnd},
+			},
+		}}, nil)
+
+	events, err := svc.GetEventsForWeek(context.Background(), uuid.New(), at(10, 0), model.EventListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []time.Time{at(3, 9), at(4, 10), at(7, 9), at(8, 9), at(9, 9), at(9, 11), at(10, 9)}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d occurrences, got %+v", len(want), events)
+	}
+	for i, e := range events {
+		if !e.EventDate.Equal(want[i]) {
+			t.Fatalf("occurrence %d at %v, want %v", i, e.EventDate, want[i])
+		}
+	}
+	if events[1].Title != "Standup with demo" || !events[1].EndDate.Equal(at(4, 11)) || events[1].ReminderAt != nil {
+		t.Fatalf("expected the retitled occurrence keeping its duration without a reminder, got %+v", events[1])
+	}
+	if events[5].Title != "Standup" || !events[5].EndDate.Equal(movedEnd) {
+		t.Fatalf("expected the moved occurrence with its own end, got %+v", events[5])
+	}
+}
+
+func TestService_GetEventsForDay_NoOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	// A weekly series starting on a Tuesday has no occurrence on Wednesday.
+	mockRepo.EXPECT().
+		GetEventsForDay(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.Event{{EventDate: time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC), RecurrenceRule: "FREQ=WEEKLY"}}, nil)
+
+	_, err := svc.GetEventsForDay(context.Background(), uuid.New(), time.Date(2030, 1, 9, 0, 0, 0, 0, time.UTC), model.EventListOptions{})
+	if !errors.Is(err, eventrepo.ErrEventNotFound) {
+		t.Fatalf("expected ErrEventNotFound, got %v", err)
+	}
+}
+
+func TestService_CreateEvent_Recurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			if e.RecurrenceRule != "FREQ=WEEKLY;BYDAY=MO,TH" {
+				t.Fatalf("expected the canonical rule, got %q", e.RecurrenceRule)
+			}
+			return uuid.New(), nil
+		})
+
+	event := model.Event{UserID: uuid.New(), Title: "Gym", EventDate: time.Now(), RecurrenceRule: "rrule:freq=weekly;byday=mo,th"}
+	if _, err := svc.CreateEvent(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event.RecurrenceRule = "FREQ=HOURLY"
+	if _, err := svc.CreateEvent(context.Background(), event); !errors.Is(err, rrule.ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule, got %v", err)
+	}
+}
+
+func TestService_UpdateOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	seriesID, userID, detachedID := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	occurrence := start.AddDate(0, 0, 7)
+	event := model.Event{ID: seriesID, UserID: userID, Title: "Standup (moved)", EventDate: occurrence.Add(time.Hour)}
+
+	mockRepo.EXPECT().
+		GetEvent(gomock.Any(), seriesID, userID).
+		Return(model.Event{ID: seriesID, EventDate: start, RecurrenceRule: "FREQ=WEEKLY"}, nil)
+	mockRepo.EXPECT().
+		DetachOccurrence(gomock.Any(), seriesID, occurrence, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ time.Time, e model.Event) (uuid.UUID, error) {
+			if e.Priority != model.PriorityNormal || !e.EventDate.Equal(event.EventDate) {
+				t.Fatalf("unexpected detached event: %+v", e)
+			}
+			return detachedID, nil
+		})
+
+	id, err := svc.UpdateOccurrence(context.Background(), event, occurrence)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != detachedID {
+		t.Fatalf("expected id %v, got %v", detachedID, id)
+	}
+}
+
+func TestService_DeleteOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	eventID, userID := uuid.New(), uuid.New()
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	deleted := start.AddDate(0, 0, 1)
+	series := model.Event{ID: eventID, EventDate: start, RecurrenceRule: "FREQ=DAILY", RecurrenceExceptions: []time.Time{deleted}}
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), eventID, userID).Return(series, nil).Times(3)
+	mockRepo.EXPECT().ExcludeOccurrence(gomock.Any(), eventID, userID, start.AddDate(0, 0, 2)).Return(nil)
+
+	if err := svc.DeleteOccurrence(context.Background(), eventID, userID, start.AddDate(0, 0, 2)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Occurrences that were already deleted and times the rule does not produce are not found.
+	if err := svc.DeleteOccurrence(context.Background(), eventID, userID, deleted); !errors.Is(err, ErrOccurrenceNotFound) {
+		t.Fatalf("expected ErrOccurrenceNotFound, got %v", err)
+	}
+	if err := svc.DeleteOccurrence(context.Background(), eventID, userID, start.Add(time.Hour)); !errors.Is(err, ErrOccurrenceNotFound) {
+		t.Fatalf("expected ErrOccurrenceNotFound, got %v", err)
+	}
+}
+
+func TestService_OverrideOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	eventID, userID := uuid.New(), uuid.New()
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	override := model.OccurrenceOverride{Occurrence: start.AddDate(0, 0, 7), EventDate: start.AddDate(0, 0, 8), Title: "Moved"}
+
+	mockRepo.EXPECT().
+		GetEvent(gomock.Any(), eventID, userID).
+		Return(model.Event{ID: eventID, EventDate: start, RecurrenceRule: "FREQ=WEEKLY"}, nil).
+		Times(3)
+	mockRepo.EXPECT().SetOverride(gomock.Any(), eventID, userID, override).Return(nil)
+
+	if err := svc.OverrideOccurrence(context.Background(), eventID, userID, override); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only occurrences the rule produces can be overridden, and they must end after they start.
+	missing := override
+	missing.Occurrence = start.AddDate(0, 0, 1)
+	if err := svc.OverrideOccurrence(context.Background(), eventID, userID, missing); !errors.Is(err, ErrOccurrenceNotFound) {
+		t.Fatalf("expected ErrOccurrenceNotFound, got %v", err)
+	}
+	backwards := override
+	backwards.EndDate = &start
+	if err := svc.OverrideOccurrence(context.Background(), eventID, userID, backwards); !errors.Is(err, ErrInvalidEnd) {
+		t.Fatalf("expected ErrInvalidEnd, got %v", err)
+	}
+}
+
+func TestService_DeleteOccurrence_NotRecurring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Event{EventDate: time.Now()}, nil)
+
+	err := svc.DeleteOccurrence(context.Background(), uuid.New(), uuid.New(), time.Now())
+	if !errors.Is(err, eventrepo.ErrNotRecurring) {
+		t.Fatalf("expected ErrNotRecurring, got %v", err)
+	}
+}
+
+func TestService_CreateEvent_InvalidEnd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, end := range []time.Time{start, start.Add(-time.Hour), start.Add(model.MaxEventDuration + time.Second)} {
+		_, err := svc.CreateEvent(context.Background(), model.Event{UserID: uuid.New(), Title: "Trip", EventDate: start, EndDate: &end})
+		if !errors.Is(err, ErrInvalidEnd) {
+			t.Fatalf("end %v: expected ErrInvalidEnd, got %v", end, err)
+		}
+	}
+}
+
+func TestService_GetAvailability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventrepomocks.NewMockeventRepo(ctrl)
+	svc := New(mockRepo, config.Event{}, encryption.Disabled(), noRules{}, noAttendees{})
+
+	start := time.Date(2030, 3, 4, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	event := model.Event{ID: uuid.New(), EventDate: start, EndDate: &end}
+
+	busy, free, declined := uuid.New(), uuid.New(), uuid.New()
+	attendees := []model.Attendee{
+		{UserID: busy, Email: "busy@example.com", Status: model.AttendeeAccepted},
+		{UserID: free, Email: "free@example.com", Status: model.AttendeeInvited},
+		{UserID: declined, Email: "declined@example.com", Status: model.AttendeeDeclined},
+		{Email: "guest@example.com", Status: model.AttendeeAccepted, External: true},
+	}
+
+	at := func(h, m int) *time.Time {
+		t := time.Date(2030, 3, 4, h, m, 0, 0, time.UTC)
+		return &t
+	}
+	seriesStart := time.Date(2030, 1, 7, 10, 30, 0, 0, time.UTC)
+	seriesEnd := seriesStart.Add(time.Hour)
+
+	mockRepo.EXPECT().
+		GetAttendeeEvents(gomock.Any(), event.ID, start.Add(-time.Hour), end.Add(time.Hour)).
+		Return([]model.Event{
+			// A weekly series occurring at 10:30 on the day of the event, overlapping an event starting at 11:00.
+			{UserID: busy, EventDate: seriesStart, EndDate: &seriesEnd, RecurrenceRule: "FREQ=WEEKLY"},
+			{UserID: busy, EventDate: *at(11, 0), EndDate: at(12, 30)},
+			// The free attendee is busy right before the event and after the window; events without an end take no time.
+			{UserID: free, EventDate: *at(8, 0), EndDate: at(10, 0)},
+			{UserID: free, EventDate: *at(10, 15)},
+		}, nil)
+
+	availability, err := svc.GetAvailability(context.Background(), event, attendees)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []model.Availability{
+		{UserID: busy, Email: "busy@example.com", Status: model.AvailabilityBusy, Busy: []model.BusyPeriod{{Start: *at(10, 30), End: *at(12, 0)}}},
+		{UserID: free, Email: "free@example.com", Status: model.AvailabilityFree, Busy: []model.BusyPeriod{{Start: *at(9, 0), End: *at(10, 0)}}},
+		{UserID: declined, Email: "declined@example.com", Status: model.AvailabilityUnknown},
+		{Email: "guest@example.com", Status: model.AvailabilityUnknown},
+	}
+	if !reflect.DeepEqual(availability, want) {
+		t.Fatalf("expected %+v, got %+v", want, availability)
 	}
 }
@@ -0,0 +1,289 @@
+package eventchange
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/eventchange/mock_eventchange.go -package=mocks
+
+// timeFormat is the format of event times in notifications.
+const timeFormat = "Mon, 2 Jan 2006 15:04 MST"
+
+// fieldLabels names the fields attendees are notified of in notifications.
+var fieldLabels = map[string]string{
+	"title":      "Title",
+	"event_date": "Start",
+	"end_date":   "End",
+	"location":   "Location",
+}
+
+// attendeeRepo defines the lookup of the attendees of an event.
+type attendeeRepo interface {
+	// ListAttendees retrieves the attendees of an event.
+	ListAttendees(ctx context.Context, eventID uuid.UUID) ([]model.Attendee, error)
+}
+
+// preferenceService defines an interface for the notification preferences of users.
+type preferenceService interface {
+	// Subscribed reports whether a user receives notifications of a category.
+	Subscribed(ctx context.Context, userID uuid.UUID, category string) (bool, error)
+
+	// UnsubscribeURL returns the one-click unsubscribe link of a category, or "" if links are not configured.
+	UnsubscribeURL(ctx context.Context, userID uuid.UUID, category string) string
+}
+
+// sender defines the delivery of email notifications.
+type sender interface {
+	// Send sends a plain text email. The tenant in ctx, if any, is attached to the message.
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// Service notifies the attendees who accepted an event when its organizer changes or cancels it,
+// the organizer and attendees when one of them is running late, and the owners of trashed events before they are purged.
+// Notifications are best effort: the change is already stored, so failures are logged instead of returned.
+type Service struct {
+	attendeeRepo attendeeRepo      // Repository listing the attendees of events
+	preferences  preferenceService // Notification preferences of the attendees
+	sender       sender            // Email delivery of notifications
+	logger       *zap.Logger       // Logger for notifications that cannot be delivered
+}
+
+// New creates a new Service instance with the provided attendee repository, preference service, email sender,
+// and logger.
+//
+// Parameters:
+//   - r: The attendee repository for database operations.
+//   - p: The preference service deciding whether attendees receive change notifications.
+//   - snd: The email sender for notifications.
+//   - l: The logger for notifications that cannot be delivered.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r attendeeRepo, p preferenceService, snd sender, l *zap.Logger) *Service {
+	return &Service{
+		attendeeRepo: r,
+		preferences:  p,
+		sender:       snd,
+		logger:       l,
+	}
+}
+
+// Recipients retrieves the attendees who accepted an event and are notified of its changes.
+// It is called before the change, since cancelling an event removes its attendees.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//
+// Returns:
+//   - The accepted attendees; none if they cannot be retrieved.
+func (s *Service) Recipients(ctx context.Context, eventID uuid.UUID) []model.Attendee {
+	attendees, err := s.attendeeRepo.ListAttendees(ctx, eventID)
+	if err != nil {
+		s.logger.Warn("failed to list attendees to notify", zap.String("event_id", eventID.String()), zap.Error(err))
+		return nil
+	}
+
+	var accepted []model.Attendee
+	for _, a := range attendees {
+		if a.Status == model.AttendeeAccepted {
+			accepted = append(accepted, a)
+		}
+	}
+
+	return accepted
+}
+
+// NotifyChanged notifies attendees of the changes between two versions of an event, with a line per changed field
+// and the updated event attached as an iCalendar file.
+// Nothing is sent if neither the title, the time nor the location of the event changed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - recipients: The attendees to notify, as returned by Recipients.
+//   - before: The event before the change, decrypted.
+//   - after: The event after the change, decrypted.
+func (s *Service) NotifyChanged(ctx context.Context, recipients []model.Attendee, before, after model.Event) {
+	changes := Diff(before, after)
+	if len(changes) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "The event \"%s\" you attend was changed:\n", after.Title)
+	for _, c := range changes {
+		fmt.Fprintf(&b, "\n%s: %s → %s", fieldLabels[c.Field], orNone(c.Before), orNone(c.After))
+	}
+
+	// The updated event is attached, so calendar clients update it with an alarm matching its reminder.
+	if invite, err := email.EventAttachment(after); err != nil {
+		s.logger.Warn("failed to attach event to change notification", zap.String("event_id", after.ID.String()), zap.Error(err))
+	} else {
+		ctx = email.WithAttachments(ctx, invite)
+	}
+
+	s.notify(ctx, recipients, "Event changed: "+after.Title, b.String())
+}
+
+// NotifyCancelled notifies attendees that an event was cancelled by its organizer.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - recipients: The attendees to notify, as returned by Recipients before the cancellation.
+//   - event: The cancelled event, decrypted.
+func (s *Service) NotifyCancelled(ctx context.Context, recipients []model.Attendee, event model.Event) {
+	body := fmt.Sprintf("The event \"%s\" on %s you attend was cancelled.", event.Title, formatTime(&event.EventDate))
+	s.notify(ctx, recipients, "Event cancelled: "+event.Title, body)
+}
+
+// NotifyLate notifies the organizer and the other attendees of an event that an attendee is running late,
+// with the time they expect to arrive.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - recipients: The people to notify, without the late attendee.
+//   - notice: The late attendee and the event, decrypted.
+func (s *Service) NotifyLate(ctx context.Context, recipients []model.Attendee, notice model.LateNotice) {
+	name := notice.Attendee.Name
+	if name == "" {
+		name = notice.Attendee.Email
+	}
+
+	body := fmt.Sprintf("%s is running late for \"%s\" on %s and expects to arrive at %s.",
+		name, notice.Event.Title, formatTime(&notice.Event.EventDate), formatTime(notice.Attendee.LateETA))
+	s.notify(ctx, recipients, "Running late: "+notice.Event.Title, body)
+}
+
+// NotifyTrashExpiring notifies a user that events in their trash are about to be purged, so they can restore them.
+// The notice is sent regardless of the notification preferences, since the events are deleted for good.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - notice: The owner, the number of their events and the time left until the purge.
+func (s *Service) NotifyTrashExpiring(ctx context.Context, notice model.TrashNotice) {
+	when := formatDays(notice.PurgeIn)
+	body := fmt.Sprintf("%s in your trash will be deleted permanently in %s. Restore them before then to keep them.",
+		countEvents(notice.Events), when)
+
+	if err := s.sender.Send(ctx, notice.Email, "Your trash will be emptied in "+when, body); err != nil {
+		s.logger.Warn("failed to send trash notification", zap.String("to", notice.Email), zap.Error(err))
+	}
+}
+
+// Diff compares two versions of an event and returns the changes attendees are notified of:
+// its title, start, end and location, in that order.
+//
+// Parameters:
+//   - before: The event before the change.
+//   - after: The event after the change.
+//
+// Returns:
+//   - The changed fields; empty if none of them changed.
+func Diff(before, after model.Event) []model.EventChange {
+	var changes []model.EventChange
+	if before.Title != after.Title {
+		changes = append(changes, model.EventChange{Field: "title", Before: before.Title, After: after.Title})
+	}
+	if !before.EventDate.Equal(after.EventDate) {
+		changes = append(changes, model.EventChange{
+			Field:  "event_date",
+			Before: formatTime(&before.EventDate),
+			After:  formatTime(&after.EventDate),
+		})
+	}
+	if !equalTime(before.EndDate, after.EndDate) {
+		changes = append(changes, model.EventChange{
+			Field:  "end_date",
+			Before: formatTime(before.EndDate),
+			After:  formatTime(after.EndDate),
+		})
+	}
+	if before.Location != after.Location {
+		changes = append(changes, model.EventChange{Field: "location", Before: before.Location, After: after.Location})
+	}
+
+	return changes
+}
+
+// notify sends a notification to every recipient subscribed to event change notifications,
+// with a link to unsubscribe from them. External attendees have no preferences; they are notified of the
+// events they accepted until they decline them.
+func (s *Service) notify(ctx context.Context, recipients []model.Attendee, subject, body string) {
+	for _, a := range recipients {
+		if a.External {
+			if err := s.sender.Send(ctx, a.Email, subject, body); err != nil {
+				s.logger.Warn("failed to send event change notification", zap.String("to", a.Email), zap.Error(err))
+			}
+			continue
+		}
+
+		subscribed, err := s.preferences.Subscribed(ctx, a.UserID, model.NotificationEventChanges)
+		if err != nil {
+			s.logger.Warn("failed to fetch notification preferences", zap.String("user_id", a.UserID.String()), zap.Error(err))
+			continue
+		}
+		if !subscribed {
+			continue
+		}
+
+		msgCtx, msg := ctx, body
+		if link := s.preferences.UnsubscribeURL(ctx, a.UserID, model.NotificationEventChanges); link != "" {
+			msg += "\n\nUnsubscribe from event change notifications: " + link
+			msgCtx = email.WithHeaders(ctx, email.ListUnsubscribeHeaders(link))
+		}
+
+		if err := s.sender.Send(msgCtx, a.Email, subject, msg); err != nil {
+			s.logger.Warn("failed to send event change notification", zap.String("to", a.Email), zap.Error(err))
+		}
+	}
+}
+
+// orNone returns a value for notifications, or "none" if it is unset.
+func orNone(v string) string {
+	if v == "" {
+		return "none"
+	}
+	return v
+}
+
+// formatDays formats a duration for notifications in whole days, rounded up.
+func formatDays(d time.Duration) string {
+	days := int((d + 24*time.Hour - 1) / (24 * time.Hour))
+	if days <= 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// countEvents formats a number of events for notifications.
+func countEvents(n int) string {
+	if n == 1 {
+		return "1 event"
+	}
+	return fmt.Sprintf("%d events", n)
+}
+
+// formatTime formats an optional event time in UTC for notifications; nil yields "".
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(timeFormat)
+}
+
+// equalTime reports whether two optional times are both unset or the same instant.
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
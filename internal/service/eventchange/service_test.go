@@ -0,0 +1,180 @@
+package eventchange
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventchangemocks "github.com/aliskhannn/calendar-service/internal/mocks/service/eventchange"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestDiff(t *testing.T) {
+	start := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	before := model.Event{Title: "Standup", EventDate: start, Description: "daily"}
+
+	after := before
+	after.EventDate = start.Add(30 * time.Minute)
+	after.EndDate = &end
+	after.Description = "weekly"
+	after.Location = "Room 4"
+
+	changes := Diff(before, after)
+	want := []model.EventChange{
+		{Field: "event_date", Before: "Mon, 20 Oct 2025 09:00 UTC", After: "Mon, 20 Oct 2025 09:30 UTC"},
+		{Field: "end_date", Before: "", After: "Mon, 20 Oct 2025 10:00 UTC"},
+		{Field: "location", Before: "", After: "Room 4"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %+v", len(want), changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("expected change %+v, got %+v", want[i], changes[i])
+		}
+	}
+
+	if changes := Diff(before, before); len(changes) != 0 {
+		t.Fatalf("expected no changes, got %+v", changes)
+	}
+}
+
+func TestService_Recipients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := eventchangemocks.NewMockattendeeRepo(ctrl)
+	svc := New(repo, eventchangemocks.NewMockpreferenceService(ctrl), eventchangemocks.NewMocksender(ctrl), zap.NewNop())
+
+	eventID := uuid.New()
+	accepted := model.Attendee{EventID: eventID, UserID: uuid.New(), Email: "a@example.com", Status: model.AttendeeAccepted}
+
+	repo.EXPECT().ListAttendees(gomock.Any(), eventID).Return([]model.Attendee{
+		accepted,
+		{EventID: eventID, UserID: uuid.New(), Email: "i@example.com", Status: model.AttendeeInvited},
+		{EventID: eventID, UserID: uuid.New(), Email: "d@example.com", Status: model.AttendeeDeclined},
+	}, nil)
+
+	recipients := svc.Recipients(context.Background(), eventID)
+	if len(recipients) != 1 || recipients[0] != accepted {
+		t.Fatalf("expected only the accepted attendee, got %+v", recipients)
+	}
+}
+
+func TestService_NotifyChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prefs := eventchangemocks.NewMockpreferenceService(ctrl)
+	snd := eventchangemocks.NewMocksender(ctrl)
+	svc := New(eventchangemocks.NewMockattendeeRepo(ctrl), prefs, snd, zap.NewNop())
+
+	subscribed := model.Attendee{UserID: uuid.New(), Email: "a@example.com", Status: model.AttendeeAccepted}
+	unsubscribed := model.Attendee{UserID: uuid.New(), Email: "b@example.com", Status: model.AttendeeAccepted}
+	failing := model.Attendee{UserID: uuid.New(), Email: "c@example.com", Status: model.AttendeeAccepted}
+	external := model.Attendee{Email: "guest@example.com", Status: model.AttendeeAccepted, External: true}
+
+	start := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	before := model.Event{Title: "Standup", EventDate: start}
+	after := model.Event{Title: "Standup", EventDate: start.Add(time.Hour)}
+
+	prefs.EXPECT().Subscribed(gomock.Any(), subscribed.UserID, model.NotificationEventChanges).Return(true, nil)
+	prefs.EXPECT().Subscribed(gomock.Any(), unsubscribed.UserID, model.NotificationEventChanges).Return(false, nil)
+	prefs.EXPECT().Subscribed(gomock.Any(), failing.UserID, model.NotificationEventChanges).Return(false, errors.New("db down"))
+	prefs.EXPECT().UnsubscribeURL(gomock.Any(), subscribed.UserID, model.NotificationEventChanges).Return("")
+	snd.EXPECT().
+		Send(gomock.Any(), "a@example.com", "Event changed: Standup", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, body string) error {
+			attachments := email.AttachmentsFromContext(ctx)
+			if len(attachments) != 1 || !strings.Contains(string(attachments[0].Content), "DTSTART:20251020T100000Z") {
+				t.Errorf("expected the updated event to be attached, got %+v", attachments)
+			}
+			if !strings.Contains(body, "Start: Mon, 20 Oct 2025 09:00 UTC → Mon, 20 Oct 2025 10:00 UTC") {
+				t.Fatalf("expected the new start in the body, got %q", body)
+			}
+			return nil
+		})
+	// External attendees have no preferences to check.
+	snd.EXPECT().Send(gomock.Any(), "guest@example.com", "Event changed: Standup", gomock.Any()).Return(nil)
+
+	svc.NotifyChanged(context.Background(), []model.Attendee{subscribed, unsubscribed, failing, external}, before, after)
+}
+
+func TestService_NotifyChanged_NoChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(eventchangemocks.NewMockattendeeRepo(ctrl), eventchangemocks.NewMockpreferenceService(ctrl),
+		eventchangemocks.NewMocksender(ctrl), zap.NewNop())
+
+	event := model.Event{Title: "Standup", EventDate: time.Now(), Description: "old"}
+	updated := event
+	updated.Description = "new"
+
+	// Only the title, time and location are tracked, so nothing is sent.
+	svc.NotifyChanged(context.Background(), []model.Attendee{{UserID: uuid.New(), Email: "a@example.com"}}, event, updated)
+}
+
+func TestService_NotifyLate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prefs := eventchangemocks.NewMockpreferenceService(ctrl)
+	snd := eventchangemocks.NewMocksender(ctrl)
+	svc := New(eventchangemocks.NewMockattendeeRepo(ctrl), prefs, snd, zap.NewNop())
+
+	organizer := model.Attendee{UserID: uuid.New(), Email: "alice@example.com"}
+	start := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	eta := start.Add(15 * time.Minute)
+
+	prefs.EXPECT().Subscribed(gomock.Any(), organizer.UserID, model.NotificationEventChanges).Return(true, nil)
+	prefs.EXPECT().UnsubscribeURL(gomock.Any(), organizer.UserID, model.NotificationEventChanges).Return("")
+	snd.EXPECT().
+		Send(gomock.Any(), "alice@example.com", "Running late: Standup", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, body string) error {
+			if !strings.Contains(body, "Bob is running late for \"Standup\" on Mon, 20 Oct 2025 09:00 UTC "+
+				"and expects to arrive at Mon, 20 Oct 2025 09:15 UTC.") {
+				t.Fatalf("unexpected body: %q", body)
+			}
+			return nil
+		})
+
+	svc.NotifyLate(context.Background(), []model.Attendee{organizer}, model.LateNotice{
+		Attendee: model.Attendee{Name: "Bob", LateETA: &eta},
+		Event:    model.Event{Title: "Standup", EventDate: start},
+	})
+}
+
+func TestService_NotifyTrashExpiring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snd := eventchangemocks.NewMocksender(ctrl)
+	svc := New(eventchangemocks.NewMockattendeeRepo(ctrl), eventchangemocks.NewMockpreferenceService(ctrl), snd, zap.NewNop())
+
+	snd.EXPECT().
+		Send(gomock.Any(), "a@example.com", "Your trash will be emptied in 3 days", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, body string) error {
+			if !strings.Contains(body, "2 events in your trash will be deleted permanently in 3 days") {
+				t.Fatalf("unexpected body: %q", body)
+			}
+			return errors.New("connection refused")
+		})
+
+	// Delivery failures are logged, not returned.
+	svc.NotifyTrashExpiring(context.Background(), model.TrashNotice{
+		UserID:  uuid.New(),
+		Email:   "a@example.com",
+		Events:  2,
+		PurgeIn: 72 * time.Hour,
+	})
+}
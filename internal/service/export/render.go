@@ -0,0 +1,216 @@
+package export
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/pdf"
+)
+
+// Page geometry in points.
+const (
+	margin      = 40.0 // page margin of the week layout
+	gridMargin  = 30.0 // page margin of the month layout
+	lineHeight  = 14.0 // distance between event lines of the week layout
+	cellLine    = 9.0  // distance between event lines of a month cell
+	cellPadding = 4.0  // padding of a month cell
+)
+
+// period is a week or a month of an agenda, from midnight to midnight in the agenda's time zone.
+type period struct {
+	start time.Time // first day
+	end   time.Time // day after the last day
+}
+
+// periods splits the range of an export into the weeks or months its pages show.
+// The first and last period may extend beyond the range; days outside of it are shown without events.
+func periods(req model.PDFExport, loc *time.Location) []period {
+	from := localDay(req.From, loc)
+	to := localDay(req.To, loc).AddDate(0, 0, 1)
+
+	var result []period
+	switch req.Layout {
+	case model.LayoutWeek:
+		start := from.AddDate(0, 0, -((int(from.Weekday()) - int(req.WeekStart) + 7) % 7))
+		for ; start.Before(to); start = start.AddDate(0, 0, 7) {
+			result = append(result, period{start: start, end: start.AddDate(0, 0, 7)})
+		}
+	case model.LayoutMonth:
+		start := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, loc)
+		for ; start.Before(to); start = start.AddDate(0, 1, 0) {
+			result = append(result, period{start: start, end: start.AddDate(0, 1, 0)})
+		}
+	}
+
+	return result
+}
+
+// agenda holds what is needed to draw the pages of an export.
+type agenda struct {
+	req    model.PDFExport
+	loc    *time.Location
+	from   time.Time                   // first day of the range
+	to     time.Time                   // day after the range
+	events map[time.Time][]model.Event // events by day, in date order
+	doc    *pdf.Document
+}
+
+// renderAgenda draws the pages of an export, calling periodDone after every week or month.
+//
+// Parameters:
+//   - ctx: The context; rendering stops when it is done.
+//   - req: The export request.
+//   - loc: The time zone of the export.
+//   - events: The events of the range, ordered by date.
+//   - periodDone: Called after the pages of every period have been drawn.
+//
+// Returns:
+//   - The PDF document.
+//   - An error if ctx is done.
+func renderAgenda(ctx context.Context, req model.PDFExport, loc *time.Location, events []model.Event, periodDone func()) ([]byte, error) {
+	a := &agenda{
+		req:    req,
+		loc:    loc,
+		from:   localDay(req.From, loc),
+		to:     localDay(req.To, loc).AddDate(0, 0, 1),
+		events: make(map[time.Time][]model.Event),
+	}
+	for _, e := range events {
+		day := localDay(e.EventDate.In(loc), loc)
+		a.events[day] = append(a.events[day], e)
+	}
+
+	if req.Layout == model.LayoutMonth {
+		a.doc = pdf.New(pdf.A4Height, pdf.A4Width) // landscape
+	} else {
+		a.doc = pdf.New(pdf.A4Width, pdf.A4Height)
+	}
+
+	for _, p := range periods(req, loc) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if req.Layout == model.LayoutMonth {
+			a.drawMonth(p)
+		} else {
+			a.drawWeek(p)
+		}
+		periodDone()
+	}
+
+	return a.doc.Bytes(), nil
+}
+
+// drawWeek draws the days of a week in the range as a list, continuing on further pages if needed.
+func (a *agenda) drawWeek(p period) {
+	title := "Week of " + p.start.Format("Mon, 2 Jan 2006")
+	page, y := a.weekPage(title)
+
+	for day := p.start; day.Before(p.end); day = day.AddDate(0, 0, 1) {
+		if day.Before(a.from) || !day.Before(a.to) {
+			continue
+		}
+
+		events := a.events[day]
+		if y+lineHeight*float64(min(len(events), 1)+2) > pdf.A4Height-margin {
+			page, y = a.weekPage(title + " (continued)")
+		}
+
+		y += lineHeight * 1.5
+		page.Text(margin, y, pdf.HelveticaBold, 11, day.Format("Monday, 2 January"))
+		page.Line(margin, y+4, pdf.A4Width-margin, y+4, 0.3)
+
+		if len(events) == 0 {
+			y += lineHeight
+			page.Text(margin+10, y, pdf.Helvetica, 9, "No events")
+			continue
+		}
+
+		for _, e := range events {
+			if y+lineHeight > pdf.A4Height-margin {
+				page, y = a.weekPage(title + " (continued)")
+			}
+			y += lineHeight
+			page.Text(margin+10, y, pdf.Helvetica, 10, e.EventDate.In(a.loc).Format("15:04"))
+			page.Text(margin+55, y, pdf.Helvetica, 10, pdf.Fit(eventLabel(e), 10, pdf.A4Width-2*margin-55))
+		}
+	}
+}
+
+// weekPage adds a page of the week layout with its heading and returns the page and the y below the heading.
+func (a *agenda) weekPage(title string) (*pdf.Page, float64) {
+	page := a.doc.AddPage()
+	page.Text(margin, margin+16, pdf.HelveticaBold, 16, title)
+	page.Text(margin, margin+30, pdf.Helvetica, 8, "Times in "+a.loc.String())
+	page.Line(margin, margin+36, pdf.A4Width-margin, margin+36, 1)
+
+	return page, margin + 40
+}
+
+// drawMonth draws a month as a grid of weeks with the events of every day in its cell.
+func (a *agenda) drawMonth(p period) {
+	width, height := pdf.A4Height, pdf.A4Width
+
+	page := a.doc.AddPage()
+	page.Text(gridMargin, gridMargin+18, pdf.HelveticaBold, 18, p.start.Format("January 2006"))
+	page.Text(width-gridMargin-120, gridMargin+18, pdf.Helvetica, 8, "Times in "+a.loc.String())
+
+	start := p.start.AddDate(0, 0, -((int(p.start.Weekday()) - int(a.req.WeekStart) + 7) % 7))
+	weeks := (int(p.end.Sub(start).Hours()/24) + 6) / 7
+
+	top := gridMargin + 40.0
+	cellW := (width - 2*gridMargin) / 7
+	cellH := (height - gridMargin - top - 14) / float64(weeks)
+
+	for i := 0; i < 7; i++ {
+		name := start.AddDate(0, 0, i).Format("Monday")
+		page.Text(gridMargin+float64(i)*cellW+cellPadding, top+10, pdf.HelveticaBold, 9, name)
+	}
+	top += 14
+
+	maxLines := int((cellH - 14 - cellPadding) / cellLine)
+	for i := 0; i < weeks*7; i++ {
+		day := start.AddDate(0, 0, i)
+		x := gridMargin + float64(i%7)*cellW
+		y := top + float64(i/7)*cellH
+
+		if day.Month() != p.start.Month() {
+			page.FillRect(x, y, cellW, cellH, 0.93)
+			page.Rect(x, y, cellW, cellH, 0.5)
+			continue
+		}
+		page.Rect(x, y, cellW, cellH, 0.5)
+		page.Text(x+cellPadding, y+11, pdf.HelveticaBold, 9, day.Format("2"))
+
+		if day.Before(a.from) || !day.Before(a.to) {
+			continue
+		}
+
+		events := a.events[day]
+		for j, e := range events {
+			line := e.EventDate.In(a.loc).Format("15:04") + " " + eventLabel(e)
+			if j == maxLines-1 && len(events) > maxLines {
+				line = "+" + strconv.Itoa(len(events)-j) + " more"
+			}
+			page.Text(x+cellPadding, y+14+cellLine*float64(j+1), pdf.Helvetica, 7, pdf.Fit(line, 7, cellW-2*cellPadding))
+			if j == maxLines-1 {
+				break
+			}
+		}
+	}
+}
+
+// eventLabel returns the title of an event, marking important events.
+func eventLabel(e model.Event) string {
+	switch e.Priority {
+	case model.PriorityCritical:
+		return "!! " + e.Title
+	case model.PriorityHigh:
+		return "! " + e.Title
+	default:
+		return e.Title
+	}
+}
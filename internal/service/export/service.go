@@ -0,0 +1,183 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+var (
+	ErrInvalidRange    = errors.New("to must not be before from")
+	ErrRangeTooLong    = errors.New("date range too long")
+	ErrUnknownLayout   = errors.New("unknown layout")
+	ErrUnknownTimezone = errors.New("unknown time zone")
+)
+
+// mediaTypePDF is the media type of exported agendas.
+const mediaTypePDF = "application/pdf"
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/export/mock_export.go -package=mocks
+
+// eventService defines the retrieval of the events an agenda shows.
+type eventService interface {
+	// GetEventsInRange retrieves the events of a user from one instant up to, but not including, another.
+	GetEventsInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error)
+}
+
+// jobService defines the background jobs long exports run as.
+type jobService interface {
+	// Enqueue queues a job for the worker pool.
+	Enqueue(ctx context.Context, job model.Job) (model.Job, error)
+}
+
+// Service manages business logic for printable PDF agendas.
+// Short ranges are rendered while the request waits; longer ranges are rendered by a background job,
+// whose output is downloaded once it has completed. Service is the runner of PDF export jobs.
+type Service struct {
+	events eventService  // Service retrieving the events
+	jobs   jobService    // Background jobs long exports run as
+	config config.Export // Range limits
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - e: The event service retrieving the events.
+//   - j: The job service long exports run as.
+//   - cfg: The export range limits.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(e eventService, j jobService, cfg config.Export) *Service {
+	return &Service{
+		events: e,
+		jobs:   j,
+		config: cfg,
+	}
+}
+
+// ExportPDF renders the agenda of a user's events as a PDF, or queues a job rendering it
+// if the range is longer than the configured number of days.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user whose events are exported.
+//   - req: The range, layout and time zone of the agenda.
+//
+// Returns:
+//   - The PDF document, or nil if a job was queued.
+//   - The queued job, or nil if the document was rendered right away.
+//   - ErrInvalidRange, ErrRangeTooLong, ErrUnknownLayout or ErrUnknownTimezone if the request is invalid,
+//     or another error if the events cannot be retrieved or the job cannot be queued.
+func (s *Service) ExportPDF(ctx context.Context, userID uuid.UUID, req model.PDFExport) ([]byte, *model.Job, error) {
+	loc, err := s.validate(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if days(req) > s.config.MaxSyncDays {
+		payload, err := json.Marshal(req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("export pdf: %w", err)
+		}
+
+		job, err := s.jobs.Enqueue(ctx, model.Job{
+			UserID:  userID,
+			Kind:    model.JobPDFExport,
+			Payload: payload,
+			Total:   len(periods(req, loc)),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("export pdf: %w", err)
+		}
+		return nil, &job, nil
+	}
+
+	doc, err := s.render(ctx, userID, req, loc, func() {})
+	if err != nil {
+		return nil, nil, fmt.Errorf("export pdf: %w", err)
+	}
+
+	return doc, nil, nil
+}
+
+// Run renders the agenda of a PDF export job and sets it as the output of the job.
+//
+// Parameters:
+//   - ctx: The context of the job; rendering stops when it is done.
+//   - job: The export job with the model.PDFExport as payload.
+//   - p: The progress of the job; a unit of work is a week or a month.
+//
+// Returns:
+//   - An error if the payload is invalid, the events cannot be retrieved, or ctx is done.
+func (s *Service) Run(ctx context.Context, job model.Job, p *jobsvc.Progress) error {
+	var req model.PDFExport
+	if err := json.Unmarshal(job.Payload, &req); err != nil {
+		return fmt.Errorf("invalid export request: %w", err)
+	}
+
+	loc, err := s.validate(req)
+	if err != nil {
+		return err
+	}
+	p.SetTotal(len(periods(req, loc)))
+
+	doc, err := s.render(ctx, job.UserID, req, loc, func() { p.Add(1) })
+	if err != nil {
+		return err
+	}
+
+	p.SetOutput(doc, mediaTypePDF)
+	return nil
+}
+
+// validate checks an export request and returns the location of its time zone.
+func (s *Service) validate(req model.PDFExport) (*time.Location, error) {
+	if req.Layout != model.LayoutWeek && req.Layout != model.LayoutMonth {
+		return nil, ErrUnknownLayout
+	}
+	if req.To.Before(req.From) {
+		return nil, ErrInvalidRange
+	}
+	if days(req) > s.config.MaxDays {
+		return nil, fmt.Errorf("%w: at most %d days", ErrRangeTooLong, s.config.MaxDays)
+	}
+
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return nil, ErrUnknownTimezone
+	}
+
+	return loc, nil
+}
+
+// render retrieves the events of the range and renders the agenda.
+func (s *Service) render(ctx context.Context, userID uuid.UUID, req model.PDFExport, loc *time.Location, periodDone func()) ([]byte, error) {
+	from := localDay(req.From, loc)
+	to := localDay(req.To, loc).AddDate(0, 0, 1)
+
+	events, err := s.events.GetEventsInRange(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderAgenda(ctx, req, loc, events, periodDone)
+}
+
+// days returns the number of days of the range, counting both ends.
+func days(req model.PDFExport) int {
+	return int(req.To.Sub(req.From).Hours()/24) + 1
+}
+
+// localDay returns midnight of the calendar day of d in loc.
+func localDay(d time.Time, loc *time.Location) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+}
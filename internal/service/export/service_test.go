@@ -0,0 +1,161 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	exportmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/export"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+var testConfig = config.Export{MaxSyncDays: 31, MaxDays: 366}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestService_ExportPDF_Sync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvents := exportmocks.NewMockeventService(ctrl)
+	svc := New(mockEvents, exportmocks.NewMockjobService(ctrl), testConfig)
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	userID := uuid.New()
+	req := model.PDFExport{From: date(2030, 3, 4), To: date(2030, 3, 10), Layout: model.LayoutWeek,
+		Timezone: "Europe/Berlin", WeekStart: time.Monday}
+
+	mockEvents.EXPECT().
+		GetEventsInRange(gomock.Any(), userID,
+			time.Date(2030, 3, 4, 0, 0, 0, 0, berlin), time.Date(2030, 3, 11, 0, 0, 0, 0, berlin)).
+		Return([]model.Event{{Title: "Standup", EventDate: time.Date(2030, 3, 5, 8, 0, 0, 0, time.UTC)}}, nil)
+
+	doc, job, err := svc.ExportPDF(context.Background(), userID, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job != nil {
+		t.Fatalf("expected no job for a week, got %+v", job)
+	}
+	if !bytes.HasPrefix(doc, []byte("%PDF-")) {
+		t.Errorf("expected a PDF document, got %q", doc[:min(len(doc), 16)])
+	}
+	if !bytes.Contains(doc, []byte("(09:00)")) || !bytes.Contains(doc, []byte("(Standup)")) {
+		t.Error("expected the event at its local time in the document")
+	}
+}
+
+func TestService_ExportPDF_Async(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := exportmocks.NewMockjobService(ctrl)
+	svc := New(exportmocks.NewMockeventService(ctrl), mockJobs, testConfig)
+
+	userID := uuid.New()
+	req := model.PDFExport{From: date(2030, 1, 15), To: date(2030, 6, 14), Layout: model.LayoutMonth}
+
+	mockJobs.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job model.Job) (model.Job, error) {
+			if job.UserID != userID || job.Kind != model.JobPDFExport || job.Total != 6 {
+				t.Errorf("unexpected job %+v", job)
+			}
+			var payload model.PDFExport
+			if err := json.Unmarshal(job.Payload, &payload); err != nil || !payload.To.Equal(req.To) {
+				t.Errorf("unexpected payload %s: %v", job.Payload, err)
+			}
+			job.ID, job.Status = uuid.New(), model.JobQueued
+			return job, nil
+		})
+
+	doc, job, err := svc.ExportPDF(context.Background(), userID, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if doc != nil || job == nil || job.Status != model.JobQueued {
+		t.Fatalf("expected a queued job, got %+v", job)
+	}
+}
+
+func TestService_ExportPDF_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  model.PDFExport
+		want error
+	}{
+		{"unknown layout", model.PDFExport{From: date(2030, 1, 1), To: date(2030, 1, 1), Layout: "year"}, ErrUnknownLayout},
+		{"reversed range", model.PDFExport{From: date(2030, 1, 2), To: date(2030, 1, 1), Layout: model.LayoutWeek}, ErrInvalidRange},
+		{"too long", model.PDFExport{From: date(2030, 1, 1), To: date(2031, 1, 2), Layout: model.LayoutMonth}, ErrRangeTooLong},
+		{"unknown time zone", model.PDFExport{From: date(2030, 1, 1), To: date(2030, 1, 1), Layout: model.LayoutWeek,
+			Timezone: "Mars/Olympus"}, ErrUnknownTimezone},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := New(exportmocks.NewMockeventService(ctrl), exportmocks.NewMockjobService(ctrl), testConfig)
+			if _, _, err := svc.ExportPDF(context.Background(), uuid.New(), tc.req); !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvents := exportmocks.NewMockeventService(ctrl)
+	svc := New(mockEvents, exportmocks.NewMockjobService(ctrl), testConfig)
+
+	payload, _ := json.Marshal(model.PDFExport{From: date(2030, 1, 1), To: date(2030, 3, 31), Layout: model.LayoutMonth})
+	job := model.Job{ID: uuid.New(), UserID: uuid.New(), Kind: model.JobPDFExport, Payload: payload}
+
+	mockEvents.EXPECT().
+		GetEventsInRange(gomock.Any(), job.UserID, date(2030, 1, 1), date(2030, 4, 1)).
+		Return(nil, nil)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), job, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed, total := p.Counts(); processed != 3 || total != 3 {
+		t.Errorf("expected 3 of 3 months rendered, got %d of %d", processed, total)
+	}
+	if doc, mediaType := p.Output(); mediaType != "application/pdf" || !bytes.HasPrefix(doc, []byte("%PDF-")) {
+		t.Errorf("expected a PDF output, got %q", mediaType)
+	}
+}
+
+func TestPeriods(t *testing.T) {
+	// 2030-03-06 is a Wednesday.
+	req := model.PDFExport{From: date(2030, 3, 6), To: date(2030, 3, 18), Layout: model.LayoutWeek, WeekStart: time.Sunday}
+	weeks := periods(req, time.UTC)
+	if len(weeks) != 3 {
+		t.Fatalf("expected 3 weeks, got %d", len(weeks))
+	}
+	if !weeks[0].start.Equal(date(2030, 3, 3)) || !weeks[2].end.Equal(date(2030, 3, 24)) {
+		t.Errorf("unexpected weeks %v to %v", weeks[0].start, weeks[2].end)
+	}
+
+	req.Layout = model.LayoutMonth
+	months := periods(req, time.UTC)
+	if len(months) != 1 || !months[0].start.Equal(date(2030, 3, 1)) || !months[0].end.Equal(date(2030, 4, 1)) {
+		t.Errorf("unexpected months %+v", months)
+	}
+}
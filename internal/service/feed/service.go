@@ -0,0 +1,302 @@
+package feed
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+const (
+	// defaultPastDays and defaultFutureDays are the range of a feed when none is configured.
+	defaultPastDays   = 30
+	defaultFutureDays = 365
+
+	// maxFeedEvents caps the number of events published by one feed request.
+	maxFeedEvents = 2000
+
+	// tokenBytes is the number of random bytes of a feed token.
+	tokenBytes = 32
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/feed/mock_feed.go -package=mocks
+
+// feedRepo defines the interface for feed-related database operations.
+type feedRepo interface {
+	// CreateFeed inserts a new feed with the hash of its token.
+	CreateFeed(ctx context.Context, feed model.Feed, tokenHash string) (model.Feed, error)
+
+	// ListFeeds retrieves all feeds of a user.
+	ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error)
+
+	// GetFeedByTokenHash retrieves the feed a token belongs to.
+	GetFeedByTokenHash(ctx context.Context, tokenHash string) (model.Feed, error)
+
+	// UpdateToken replaces the token hash of a feed of the specified user.
+	UpdateToken(ctx context.Context, feedID, userID uuid.UUID, tokenHash string) (model.Feed, error)
+
+	// DeleteFeed deletes a feed of the specified user.
+	DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error
+}
+
+// eventLister defines the retrieval of the events a feed publishes.
+type eventLister interface {
+	// GetEventsForFeed retrieves the events of a user in a range, with recurring events as series.
+	GetEventsForFeed(ctx context.Context, userID uuid.UUID, from, to time.Time, projectID *uuid.UUID) ([]model.Event, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for ICS subscription feeds.
+// Unlike embeds, a feed is a private URL of its owner for their own calendar clients, so it
+// publishes titles and descriptions. The URL stays the same until the feed is regenerated.
+// With tenancy enabled, the tenant is part of the token, since calendar clients cannot send a tenant header.
+type Service struct {
+	feedRepo feedRepo      // Repository for feed database operations
+	events   eventLister   // Source of the published events
+	cipher   contentCipher // Decryption of event titles and descriptions
+	config   config.Feed   // Range of the published events
+	clock    clock.Clock   // Source of the current day the range is centered on
+}
+
+// New creates a new Service instance with the provided dependencies.
+// A non-positive range falls back to 30 days before and 365 days after today.
+//
+// Parameters:
+//   - r: The feed repository for database operations.
+//   - e: The source of the published events.
+//   - c: The cipher for event titles and descriptions.
+//   - cfg: The range of the published events.
+//   - clk: The clock the range is computed from, in UTC.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r feedRepo, e eventLister, c contentCipher, cfg config.Feed, clk clock.Clock) *Service {
+	if cfg.PastDays <= 0 {
+		cfg.PastDays = defaultPastDays
+	}
+	if cfg.FutureDays <= 0 {
+		cfg.FutureDays = defaultFutureDays
+	}
+
+	return &Service{
+		feedRepo: r,
+		events:   e,
+		cipher:   c,
+		config:   cfg,
+		clock:    clk,
+	}
+}
+
+// CreateFeed creates a feed with a new token.
+//
+// Parameters:
+//   - ctx: The context for the operation; its tenant becomes part of the token.
+//   - feed: The feed to create; UserID and Name must be set.
+//
+// Returns:
+//   - The created feed including its token, which cannot be retrieved again.
+//   - An error wrapping feedrepo.ErrProjectNotFound if the user has no such project,
+//     or another error if the creation fails.
+func (s *Service) CreateFeed(ctx context.Context, feed model.Feed) (model.Feed, error) {
+	token, err := newToken(ctx)
+	if err != nil {
+		return model.Feed{}, fmt.Errorf("create feed: %w", err)
+	}
+
+	created, err := s.feedRepo.CreateFeed(ctx, feed, hashToken(token))
+	if err != nil {
+		return model.Feed{}, fmt.Errorf("create feed: %w", err)
+	}
+	created.Token = token
+
+	return created, nil
+}
+
+// ListFeeds retrieves all feeds of a user. Their tokens are not included.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of feeds.
+//   - An error if the retrieval fails.
+func (s *Service) ListFeeds(ctx context.Context, userID uuid.UUID) ([]model.Feed, error) {
+	feeds, err := s.feedRepo.ListFeeds(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w", err)
+	}
+
+	return feeds, nil
+}
+
+// RegenerateFeed gives a feed a new token. The previous URL stops working immediately,
+// so clients subscribed to it have to subscribe to the new one.
+//
+// Parameters:
+//   - ctx: The context for the operation; its tenant becomes part of the token.
+//   - feedID: The UUID of the feed.
+//   - userID: The UUID of the user who owns the feed.
+//
+// Returns:
+//   - The feed including its new token.
+//   - An error wrapping feedrepo.ErrFeedNotFound if the user has no such feed, or another error if the update fails.
+func (s *Service) RegenerateFeed(ctx context.Context, feedID, userID uuid.UUID) (model.Feed, error) {
+	token, err := newToken(ctx)
+	if err != nil {
+		return model.Feed{}, fmt.Errorf("regenerate feed: %w", err)
+	}
+
+	feed, err := s.feedRepo.UpdateToken(ctx, feedID, userID, hashToken(token))
+	if err != nil {
+		return model.Feed{}, fmt.Errorf("regenerate feed: %w", err)
+	}
+	feed.Token = token
+
+	return feed, nil
+}
+
+// DeleteFeed deletes a feed, so its URL stops working immediately.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - feedID: The UUID of the feed to delete.
+//   - userID: The UUID of the user who owns the feed.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteFeed(ctx context.Context, feedID, userID uuid.UUID) error {
+	if err := s.feedRepo.DeleteFeed(ctx, feedID, userID); err != nil {
+		return fmt.Errorf("delete feed: %w", err)
+	}
+
+	return nil
+}
+
+// GetFeedCalendar retrieves the feed of a token and the events it publishes, ordered by date.
+// The events range from the configured days before today to the configured days after it.
+// Recurring events are published once, as series, if they have an occurrence in the range; their rules are
+// expanded by the calendar clients. At most 2000 events are returned.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The feed token.
+//
+// Returns:
+//   - The feed with its decrypted events.
+//   - An error wrapping feedrepo.ErrFeedNotFound for an unknown token, or another error if the retrieval fails.
+func (s *Service) GetFeedCalendar(ctx context.Context, token string) (model.FeedCalendar, error) {
+	if i := strings.LastIndex(token, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, token[:i])
+	}
+
+	feed, err := s.feedRepo.GetFeedByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		// A token naming no or an unknown tenant is as unknown as a token that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = feedrepo.ErrFeedNotFound
+		}
+		return model.FeedCalendar{}, fmt.Errorf("get feed calendar: %w", err)
+	}
+
+	today := s.clock.Now().UTC().Truncate(24 * time.Hour)
+	from := today.AddDate(0, 0, -s.config.PastDays)
+	to := today.AddDate(0, 0, s.config.FutureDays+1)
+
+	events, err := s.events.GetEventsForFeed(ctx, feed.UserID, from, to, feed.ProjectID)
+	if err != nil && !errors.Is(err, eventrepo.ErrEventNotFound) {
+		return model.FeedCalendar{}, fmt.Errorf("get feed calendar: %w", err)
+	}
+
+	events = slices.DeleteFunc(events, func(e model.Event) bool { return !occursBetween(e, from, to) })
+	if len(events) > maxFeedEvents {
+		events = events[:maxFeedEvents]
+	}
+
+	for i := range events {
+		// Events the user is invited to are encrypted with the keys of their owners.
+		if events[i].Title, err = s.cipher.Decrypt(ctx, events[i].UserID, events[i].Title); err != nil {
+			return model.FeedCalendar{}, fmt.Errorf("get feed calendar: %w", err)
+		}
+		if events[i].Description, err = s.cipher.Decrypt(ctx, events[i].UserID, events[i].Description); err != nil {
+			return model.FeedCalendar{}, fmt.Errorf("get feed calendar: %w", err)
+		}
+		for j := range events[i].Overrides {
+			o := &events[i].Overrides[j]
+			if o.Title, err = s.cipher.Decrypt(ctx, events[i].UserID, o.Title); err != nil {
+				return model.FeedCalendar{}, fmt.Errorf("get feed calendar: %w", err)
+			}
+		}
+	}
+
+	return model.FeedCalendar{Feed: feed, Events: events}, nil
+}
+
+// occursBetween reports whether a recurring event has an occurrence that is not an exception and is going on
+// in the range [from, to). Other events are selected by their dates, so they always occur in it.
+// Rules are validated when they are stored, so an event with an unparsable rule is kept with its first occurrence.
+func occursBetween(e model.Event, from, to time.Time) bool {
+	if e.RecurrenceRule == "" {
+		return true
+	}
+
+	rule, err := rrule.Parse(e.RecurrenceRule)
+	if err != nil {
+		return true
+	}
+
+	var duration time.Duration
+	if e.EndDate != nil {
+		duration = e.EndDate.Sub(e.EventDate)
+	}
+
+	for _, at := range rule.Between(e.EventDate, from.Add(-duration), to) {
+		if !slices.ContainsFunc(e.RecurrenceExceptions, at.Equal) && (!at.Before(from) || at.Add(duration).After(from)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newToken generates a random feed token, prefixed with the tenant of the context.
+func newToken(ctx context.Context) (string, error) {
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		token = tenantID + "." + token
+	}
+
+	return token, nil
+}
+
+// hashToken returns the hex-encoded SHA-256 digest of a feed token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,151 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	feedmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/feed"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	eventrepo "github.com/aliskhannn/calendar-service/internal/repository/event"
+	feedrepo "github.com/aliskhannn/calendar-service/internal/repository/feed"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+func TestService_CreateFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedmocks.NewMockfeedRepo(ctrl)
+	svc := New(mockRepo, feedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Feed{}, clock.Real())
+
+	var storedHash string
+	mockRepo.EXPECT().CreateFeed(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, f model.Feed, hash string) (model.Feed, error) {
+			storedHash = hash
+			f.ID = uuid.New()
+			return f, nil
+		})
+
+	ctx := tenancy.WithTenant(context.Background(), "acme")
+	feed, err := svc.CreateFeed(ctx, model.Feed{UserID: uuid.New(), Name: "Work"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(feed.Token, "acme.") {
+		t.Fatalf("expected the tenant in the token, got %q", feed.Token)
+	}
+	if storedHash != hashToken(feed.Token) {
+		t.Fatal("expected only the hash of the token to be stored")
+	}
+}
+
+func TestService_RegenerateFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedmocks.NewMockfeedRepo(ctrl)
+	svc := New(mockRepo, feedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Feed{}, clock.Real())
+
+	feedID, userID := uuid.New(), uuid.New()
+
+	var storedHash string
+	mockRepo.EXPECT().UpdateToken(gomock.Any(), feedID, userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uuid.UUID, hash string) (model.Feed, error) {
+			storedHash = hash
+			return model.Feed{ID: feedID, UserID: userID, Name: "Work"}, nil
+		})
+
+	feed, err := svc.RegenerateFeed(context.Background(), feedID, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if feed.Token == "" || storedHash != hashToken(feed.Token) {
+		t.Fatalf("expected a new token whose hash is stored, got %q", feed.Token)
+	}
+}
+
+func TestService_GetFeedCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedmocks.NewMockfeedRepo(ctrl)
+	mockEvents := feedmocks.NewMockeventLister(ctrl)
+	now := time.Date(2030, 3, 10, 15, 30, 0, 0, time.UTC)
+	svc := New(mockRepo, mockEvents, encryption.Disabled(), config.Feed{PastDays: 7, FutureDays: 30}, clock.NewFake(now))
+
+	projectID := uuid.New()
+	feed := model.Feed{ID: uuid.New(), UserID: uuid.New(), ProjectID: &projectID}
+
+	mockRepo.EXPECT().GetFeedByTokenHash(gomock.Any(), hashToken("secret")).Return(feed, nil)
+	ownerID := uuid.New()
+	weekly := model.Event{Title: "Standup", UserID: feed.UserID, EventDate: time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC), RecurrenceRule: "FREQ=WEEKLY"}
+	ended := model.Event{Title: "Old series", UserID: feed.UserID, EventDate: time.Date(2029, 1, 7, 9, 0, 0, 0, time.UTC), RecurrenceRule: "FREQ=DAILY;COUNT=3"}
+	mockEvents.EXPECT().GetEventsForFeed(gomock.Any(), feed.UserID, time.Date(2030, 3, 3, 0, 0, 0, 0, time.UTC), time.Date(2030, 4, 10, 0, 0, 0, 0, time.UTC), &projectID).
+		Return([]model.Event{ended, weekly, {Title: "Launch", Description: "notes", UserID: ownerID, AttendeeStatus: model.AttendeeAccepted}}, nil)
+
+	cal, err := svc.GetFeedCalendar(context.Background(), "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The series that ended before the range is left out; the ongoing one is published once.
+	if len(cal.Events) != 2 || cal.Events[0].Title != "Standup" || cal.Events[0].RecurrenceRule != "FREQ=WEEKLY" {
+		t.Fatalf("unexpected events %+v", cal.Events)
+	}
+	if cal.Events[1].Title != "Launch" || cal.Events[1].Description != "notes" {
+		t.Fatalf("unexpected events %+v", cal.Events)
+	}
+}
+
+func TestService_GetFeedCalendar_NoEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedmocks.NewMockfeedRepo(ctrl)
+	mockEvents := feedmocks.NewMockeventLister(ctrl)
+	svc := New(mockRepo, mockEvents, encryption.Disabled(), config.Feed{}, clock.Real())
+
+	feed := model.Feed{ID: uuid.New(), UserID: uuid.New()}
+	mockRepo.EXPECT().GetFeedByTokenHash(gomock.Any(), hashToken("secret")).Return(feed, nil)
+	mockEvents.EXPECT().GetEventsForFeed(gomock.Any(), feed.UserID, gomock.Any(), gomock.Any(), (*uuid.UUID)(nil)).
+		Return(nil, fmt.Errorf("failed to get events for feed: %w", eventrepo.ErrEventNotFound))
+
+	cal, err := svc.GetFeedCalendar(context.Background(), "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cal.Events) != 0 {
+		t.Fatalf("unexpected events %+v", cal.Events)
+	}
+}
+
+func TestService_GetFeedCalendar_UnknownTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedmocks.NewMockfeedRepo(ctrl)
+	svc := New(mockRepo, feedmocks.NewMockeventLister(ctrl), encryption.Disabled(), config.Feed{}, clock.Real())
+
+	mockRepo.EXPECT().GetFeedByTokenHash(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string) (model.Feed, error) {
+			if tenantID, _ := tenancy.FromContext(ctx); tenantID != "initech" {
+				t.Fatalf("expected the tenant of the token, got %q", tenantID)
+			}
+			return model.Feed{}, tenancy.ErrUnknownTenant
+		})
+
+	_, err := svc.GetFeedCalendar(context.Background(), "initech.secret")
+	if !errors.Is(err, feedrepo.ErrFeedNotFound) {
+		t.Fatalf("expected ErrFeedNotFound, got %v", err)
+	}
+}
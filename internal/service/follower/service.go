@@ -0,0 +1,115 @@
+package follower
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/follower/mock_follower.go -package=mocks
+
+// ErrOwnEvent is returned when a user tries to follow their own event.
+var ErrOwnEvent = errors.New("cannot follow your own event")
+
+// followerRepo defines the interface for follower-related database operations.
+type followerRepo interface {
+	// GetSharedEvent retrieves an event shared through a short link or published by an embed.
+	GetSharedEvent(ctx context.Context, eventID uuid.UUID) (model.Event, error)
+
+	// Follow makes a user follow an event and copies the pending reminders of its owner for the user.
+	Follow(ctx context.Context, eventID, userID uuid.UUID, message string) (model.Follower, error)
+
+	// Unfollow stops a user from following an event and deletes their pending reminders of the event.
+	Unfollow(ctx context.Context, eventID, userID uuid.UUID) error
+}
+
+// contentCipher defines the encryption of user content at rest.
+type contentCipher interface {
+	// Encrypt encrypts a value with the data key of its owner.
+	Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error)
+
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for followers, users watching the shared events of another user without attending them.
+type Service struct {
+	followerRepo followerRepo  // Repository for follower database operations
+	cipher       contentCipher // Encryption of the reminder messages copied for followers
+}
+
+// New creates a new Service instance with the provided follower repository and content cipher.
+//
+// Parameters:
+//   - r: The follower repository for database operations.
+//   - c: The cipher re-encrypting event titles for the reminders of followers.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r followerRepo, c contentCipher) *Service {
+	return &Service{
+		followerRepo: r,
+		cipher:       c,
+	}
+}
+
+// Follow makes a user follow an event shared through a short link or published by an embed.
+// The user receives the pending reminders of the event, with the title encrypted under their own key,
+// so the reminders are read like their own.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the following user.
+//
+// Returns:
+//   - The follower.
+//   - An error if the event is not shared, is the user's own, or following fails.
+func (s *Service) Follow(ctx context.Context, eventID, userID uuid.UUID) (model.Follower, error) {
+	event, err := s.followerRepo.GetSharedEvent(ctx, eventID)
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("follow event: %w", err)
+	}
+
+	if event.UserID == userID {
+		return model.Follower{}, ErrOwnEvent
+	}
+
+	title, err := s.cipher.Decrypt(ctx, event.UserID, event.Title)
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("follow event: %w", err)
+	}
+
+	message, err := s.cipher.Encrypt(ctx, userID, title)
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("follow event: %w", err)
+	}
+
+	f, err := s.followerRepo.Follow(ctx, eventID, userID, message)
+	if err != nil {
+		return model.Follower{}, fmt.Errorf("follow event: %w", err)
+	}
+
+	return f, nil
+}
+
+// Unfollow stops a user from following an event. Their pending reminders of the event are cancelled.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the following user.
+//
+// Returns:
+//   - An error if the user does not follow the event or the deletion fails.
+func (s *Service) Unfollow(ctx context.Context, eventID, userID uuid.UUID) error {
+	if err := s.followerRepo.Unfollow(ctx, eventID, userID); err != nil {
+		return fmt.Errorf("unfollow event: %w", err)
+	}
+
+	return nil
+}
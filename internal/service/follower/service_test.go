@@ -0,0 +1,68 @@
+package follower
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	followermocks "github.com/aliskhannn/calendar-service/internal/mocks/service/follower"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	followerrepo "github.com/aliskhannn/calendar-service/internal/repository/follower"
+)
+
+func TestService_Follow_ReencryptsReminderMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := followermocks.NewMockfollowerRepo(ctrl)
+	mockCipher := followermocks.NewMockcontentCipher(ctrl)
+	svc := New(mockRepo, mockCipher)
+
+	eventID, ownerID, userID := uuid.New(), uuid.New(), uuid.New()
+	mockRepo.EXPECT().GetSharedEvent(gomock.Any(), eventID).Return(model.Event{ID: eventID, UserID: ownerID, Title: "enc:owner:Launch"}, nil)
+	mockCipher.EXPECT().Decrypt(gomock.Any(), ownerID, "enc:owner:Launch").Return("Launch", nil)
+	mockCipher.EXPECT().Encrypt(gomock.Any(), userID, "Launch").Return("enc:follower:Launch", nil)
+	mockRepo.EXPECT().Follow(gomock.Any(), eventID, userID, "enc:follower:Launch").Return(model.Follower{EventID: eventID, UserID: userID}, nil)
+
+	f, err := svc.Follow(context.Background(), eventID, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.EventID != eventID || f.UserID != userID {
+		t.Fatalf("unexpected follower %+v", f)
+	}
+}
+
+func TestService_Follow_OwnEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := followermocks.NewMockfollowerRepo(ctrl)
+	svc := New(mockRepo, followermocks.NewMockcontentCipher(ctrl))
+
+	eventID, ownerID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().GetSharedEvent(gomock.Any(), eventID).Return(model.Event{ID: eventID, UserID: ownerID}, nil)
+
+	if _, err := svc.Follow(context.Background(), eventID, ownerID); !errors.Is(err, ErrOwnEvent) {
+		t.Fatalf("expected ErrOwnEvent, got %v", err)
+	}
+}
+
+func TestService_Follow_NotShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := followermocks.NewMockfollowerRepo(ctrl)
+	svc := New(mockRepo, followermocks.NewMockcontentCipher(ctrl))
+
+	eventID := uuid.New()
+	mockRepo.EXPECT().GetSharedEvent(gomock.Any(), eventID).Return(model.Event{}, followerrepo.ErrEventNotFound)
+
+	if _, err := svc.Follow(context.Background(), eventID, uuid.New()); !errors.Is(err, followerrepo.ErrEventNotFound) {
+		t.Fatalf("expected ErrEventNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/group/mock_group.go -package=mocks
+
+// groupRepo defines the interface for attendee group-related database operations.
+type groupRepo interface {
+	// CreateGroup inserts a new, empty group of a user.
+	CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error)
+
+	// ListGroups retrieves the groups of a user with their member counts.
+	ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error)
+
+	// GetGroup retrieves a group of a user with its members.
+	GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error)
+
+	// RenameGroup changes the name of a group of a user.
+	RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error)
+
+	// DeleteGroup deletes a group of a user.
+	DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error
+
+	// AddMember adds a user to a group and invites them to the upcoming events the group is invited to.
+	AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error)
+
+	// RemoveMember removes a user from a group and withdraws the group's invitations to upcoming events.
+	RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error)
+
+	// InviteGroup invites the members of a group to an event and links the group to it.
+	InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error)
+}
+
+// Service manages business logic for attendee groups, the named lists of users invited to events together.
+// Events follow the membership of the groups invited to them until they start; the repository applies
+// membership changes to their invitations atomically.
+type Service struct {
+	groupRepo groupRepo // Repository for attendee group database operations
+}
+
+// New creates a new Service instance with the provided group repository.
+//
+// Parameters:
+//   - r: The group repository for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r groupRepo) *Service {
+	return &Service{
+		groupRepo: r,
+	}
+}
+
+// CreateGroup creates a new, empty group of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user who owns the group.
+//   - name: The name of the group; surrounding whitespace is ignored.
+//
+// Returns:
+//   - The created group.
+//   - An error if the user already has a group with this name or the insertion fails.
+func (s *Service) CreateGroup(ctx context.Context, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	g, err := s.groupRepo.CreateGroup(ctx, userID, strings.TrimSpace(name))
+	if err != nil {
+		return model.AttendeeGroup{}, fmt.Errorf("create group: %w", err)
+	}
+
+	return g, nil
+}
+
+// ListGroups retrieves the groups of a user with their member counts, ordered by name.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of groups, without their members.
+//   - An error if the retrieval fails.
+func (s *Service) ListGroups(ctx context.Context, userID uuid.UUID) ([]model.AttendeeGroup, error) {
+	groups, err := s.groupRepo.ListGroups(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// GetGroup retrieves a group of a user with its members.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//
+// Returns:
+//   - The group with its members.
+//   - An error if the user has no such group or the retrieval fails.
+func (s *Service) GetGroup(ctx context.Context, groupID, userID uuid.UUID) (model.AttendeeGroup, error) {
+	g, err := s.groupRepo.GetGroup(ctx, groupID, userID)
+	if err != nil {
+		return model.AttendeeGroup{}, fmt.Errorf("get group: %w", err)
+	}
+
+	return g, nil
+}
+
+// RenameGroup changes the name of a group of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//   - name: The new name of the group; surrounding whitespace is ignored.
+//
+// Returns:
+//   - The renamed group.
+//   - An error if the user has no such group, another of their groups has the name, or the update fails.
+func (s *Service) RenameGroup(ctx context.Context, groupID, userID uuid.UUID, name string) (model.AttendeeGroup, error) {
+	g, err := s.groupRepo.RenameGroup(ctx, groupID, userID, strings.TrimSpace(name))
+	if err != nil {
+		return model.AttendeeGroup{}, fmt.Errorf("rename group: %w", err)
+	}
+
+	return g, nil
+}
+
+// DeleteGroup deletes a group of a user. Its members stay invited to the events it was invited to.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - userID: The UUID of the user who owns the group.
+//
+// Returns:
+//   - An error if the user has no such group or the deletion fails.
+func (s *Service) DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error {
+	if err := s.groupRepo.DeleteGroup(ctx, groupID, userID); err != nil {
+		return fmt.Errorf("delete group: %w", err)
+	}
+
+	return nil
+}
+
+// AddMember adds a registered user to a group of the owner and invites them to the events the group is invited to
+// that have not started yet.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//   - email: The email address of the new member; it is trimmed and lowercased.
+//
+// Returns:
+//   - The member.
+//   - The number of events the member was invited to.
+//   - An error if no user has the email address, the owner has no such group, or the insertion fails.
+func (s *Service) AddMember(ctx context.Context, groupID, ownerID uuid.UUID, email string) (model.GroupMember, int, error) {
+	m, invited, err := s.groupRepo.AddMember(ctx, groupID, ownerID, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return model.GroupMember{}, 0, fmt.Errorf("add group member: %w", err)
+	}
+
+	return m, invited, nil
+}
+
+// RemoveMember removes a user from a group of the owner and withdraws the invitations the group sent them
+// to events that have not started yet.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//   - userID: The UUID of the member.
+//
+// Returns:
+//   - The number of withdrawn invitations.
+//   - An error if the user is not a member of a group of the owner or the removal fails.
+func (s *Service) RemoveMember(ctx context.Context, groupID, ownerID, userID uuid.UUID) (int, error) {
+	withdrawn, err := s.groupRepo.RemoveMember(ctx, groupID, ownerID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("remove group member: %w", err)
+	}
+
+	return withdrawn, nil
+}
+
+// InviteGroup invites the current members of a group to an event of the same owner. Members who join the group
+// later are invited, and members who leave it are uninvited, until the event starts.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - ownerID: The UUID of the user who owns the event and the group.
+//   - groupID: The UUID of the group.
+//
+// Returns:
+//   - The number of newly invited members.
+//   - An error if the owner has no such event or group, or the invitation fails.
+func (s *Service) InviteGroup(ctx context.Context, eventID, ownerID, groupID uuid.UUID) (int, error) {
+	invited, err := s.groupRepo.InviteGroup(ctx, eventID, ownerID, groupID)
+	if err != nil {
+		return 0, fmt.Errorf("invite group: %w", err)
+	}
+
+	return invited, nil
+}
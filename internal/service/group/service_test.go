@@ -0,0 +1,61 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	groupmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/group"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	grouprepo "github.com/aliskhannn/calendar-service/internal/repository/group"
+)
+
+func TestService_CreateGroup_TrimsName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := groupmocks.NewMockgroupRepo(ctrl)
+	svc := New(mockRepo)
+
+	userID := uuid.New()
+	mockRepo.EXPECT().CreateGroup(gomock.Any(), userID, "Backend team").Return(model.AttendeeGroup{Name: "Backend team"}, nil)
+
+	if _, err := svc.CreateGroup(context.Background(), userID, " Backend team "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_AddMember_NormalizesEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := groupmocks.NewMockgroupRepo(ctrl)
+	svc := New(mockRepo)
+
+	groupID, ownerID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().AddMember(gomock.Any(), groupID, ownerID, "ana@example.com").Return(model.GroupMember{}, 2, nil)
+
+	_, invited, err := svc.AddMember(context.Background(), groupID, ownerID, " Ana@Example.com")
+	if err != nil || invited != 2 {
+		t.Fatalf("unexpected result: %d, %v", invited, err)
+	}
+}
+
+func TestService_InviteGroup_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := groupmocks.NewMockgroupRepo(ctrl)
+	svc := New(mockRepo)
+
+	eventID, ownerID, groupID := uuid.New(), uuid.New(), uuid.New()
+	mockRepo.EXPECT().InviteGroup(gomock.Any(), eventID, ownerID, groupID).Return(0, grouprepo.ErrGroupNotFound)
+
+	if _, err := svc.InviteGroup(context.Background(), eventID, ownerID, groupID); !errors.Is(err, grouprepo.ErrGroupNotFound) {
+		t.Fatalf("expected ErrGroupNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package imports
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/ical"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrInvalidArchive  = errors.New("invalid archive")
+	ErrNoCalendars     = errors.New("archive contains no calendars")
+	ErrArchiveTooLarge = errors.New("archive is too large")
+)
+
+// plistTitle matches the calendar title in the Info.plist of an Apple .calendar directory.
+var plistTitle = regexp.MustCompile(`<key>Title</key>\s*<string>([^<]*)</string>`)
+
+// calendarDir returns the .calendar directory of an Apple calendar archive a file belongs to, if any.
+// Calendar archives (.icbu) store every event in its own file below <uuid>.calendar/Events/.
+func calendarDir(name string) string {
+	if i := strings.Index(name, ".calendar/"); i >= 0 {
+		return name[:i+len(".calendar/")]
+	}
+	return ""
+}
+
+// readArchive extracts the calendars of a Google Takeout or Apple Calendar archive.
+// Every .ics file is a calendar of its own, except for the files of an Apple .calendar directory,
+// which form one calendar together. Calendars without a name are named after their file.
+//
+// Parameters:
+//   - data: The zip archive.
+//   - maxExtracted: The maximum total size of the extracted calendar files.
+//
+// Returns:
+//   - The detected source of the archive.
+//   - The calendars in archive order.
+//   - ErrInvalidArchive, ErrNoCalendars or ErrArchiveTooLarge if the archive cannot be imported.
+func readArchive(data []byte, maxExtracted int64) (string, []ical.Calendar, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	source := model.ImportAppleCalendar
+	var (
+		calendars []ical.Calendar
+		groups    = make(map[string]int)    // calendar file or .calendar directory -> index in calendars
+		titles    = make(map[string]string) // .calendar directory -> title from its Info.plist
+		remaining = maxExtracted
+	)
+
+	for _, f := range zr.File {
+		name := f.Name
+		if f.FileInfo().IsDir() || strings.HasPrefix(name, "__MACOSX/") {
+			continue
+		}
+		if strings.HasPrefix(name, "Takeout/") {
+			source = model.ImportGoogleTakeout
+		}
+
+		ext := strings.ToLower(path.Ext(name))
+		isPlist := path.Base(name) == "Info.plist" && calendarDir(name) != ""
+		if ext != ".ics" && !isPlist {
+			continue
+		}
+
+		content, err := extract(f, remaining)
+		if err != nil {
+			return "", nil, err
+		}
+		remaining -= int64(len(content))
+
+		if isPlist {
+			if m := plistTitle.FindSubmatch(content); m != nil {
+				titles[calendarDir(name)] = strings.TrimSpace(string(m[1]))
+			}
+			continue
+		}
+
+		parsed, err := ical.Parse(bytes.NewReader(content))
+		if errors.Is(err, ical.ErrNotCalendar) {
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: %s: %v", ErrInvalidArchive, name, err)
+		}
+
+		key := calendarDir(name)
+		if key == "" {
+			key = name
+		}
+		for _, cal := range parsed {
+			i, ok := groups[key]
+			if !ok {
+				if cal.Name == "" && calendarDir(name) == "" {
+					cal.Name = strings.TrimSuffix(path.Base(name), path.Ext(name))
+				}
+				groups[key] = len(calendars)
+				calendars = append(calendars, cal)
+				continue
+			}
+			calendars[i].Events = append(calendars[i].Events, cal.Events...)
+		}
+	}
+
+	if len(calendars) == 0 {
+		return "", nil, ErrNoCalendars
+	}
+
+	for key, i := range groups {
+		if title := titles[key]; title != "" && calendars[i].Name == "" {
+			calendars[i].Name = title
+		}
+	}
+
+	return source, calendars, nil
+}
+
+// extract reads a file of the archive, failing with ErrArchiveTooLarge if it is larger than limit.
+func extract(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidArchive, f.Name, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidArchive, f.Name, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, ErrArchiveTooLarge
+	}
+
+	return content, nil
+}
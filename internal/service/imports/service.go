@@ -0,0 +1,310 @@
+package imports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/ical"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+const (
+	// maxTitleLength and maxDescriptionLength are the limits the events API enforces.
+	maxTitleLength       = 255
+	maxDescriptionLength = 1000
+
+	// untitled is the title of imported events without a summary.
+	untitled = "(no title)"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/imports/mock_imports.go -package=mocks
+
+// jobService defines the background jobs imports run as.
+type jobService interface {
+	// Enqueue queues a job for the worker pool.
+	Enqueue(ctx context.Context, job model.Job) (model.Job, error)
+
+	// GetJob retrieves a job with its progress.
+	GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// RecordEvents stores the events created by a job, so the job can be rolled back.
+	RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error
+}
+
+// eventService defines the creation of imported events.
+type eventService interface {
+	// CreateEvent creates a new event and returns its ID.
+	CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error)
+}
+
+// projectService defines the lookup and creation of the projects imported calendars are mapped to.
+type projectService interface {
+	// ListProjects retrieves all projects of a user.
+	ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+
+	// CreateProject creates a new project and returns its ID.
+	CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error)
+}
+
+// Service manages business logic for calendar archive imports.
+// An archive is validated while the upload request waits and is then imported by a background job,
+// whose progress can be polled. Service is the runner of calendar import jobs.
+// Each calendar of the archive becomes a project of the same name, reusing an existing project.
+type Service struct {
+	jobs     jobService     // Background jobs the imports run as
+	events   eventService   // Service creating the imported events
+	projects projectService // Service for the projects calendars are mapped to
+	config   config.Import  // Import limits
+	clock    clock.Clock    // Source of the current time; past alarms are not imported
+	logger   *zap.Logger    // Logger for events that cannot be imported
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - j: The job service the imports run as.
+//   - e: The event service creating the imported events.
+//   - p: The project service for the projects calendars are mapped to.
+//   - cfg: The import limits.
+//   - clk: The clock alarms are compared against.
+//   - l: The logger for events that cannot be imported.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(j jobService, e eventService, p projectService, cfg config.Import, clk clock.Clock, l *zap.Logger) *Service {
+	return &Service{
+		jobs:     j,
+		events:   e,
+		projects: p,
+		config:   cfg,
+		clock:    clk,
+		logger:   l,
+	}
+}
+
+// StartImport checks a Google Takeout or Apple Calendar archive and queues a job importing its events.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user the events are imported for.
+//   - archive: The zip archive.
+//
+// Returns:
+//   - The queued import job.
+//   - ErrInvalidArchive, ErrNoCalendars or ErrArchiveTooLarge if the archive cannot be imported,
+//     or another error if the job cannot be queued.
+func (s *Service) StartImport(ctx context.Context, userID uuid.UUID, archive []byte) (model.Job, error) {
+	_, calendars, err := readArchive(archive, s.config.MaxExtractedSize)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	total := 0
+	for _, cal := range calendars {
+		total += len(cal.Events)
+	}
+
+	job, err := s.jobs.Enqueue(ctx, model.Job{
+		UserID:  userID,
+		Kind:    model.JobCalendarImport,
+		Payload: archive,
+		Total:   total,
+	})
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start import: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetImport retrieves an import job with its progress.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the import job.
+//   - userID: The UUID of the user who started the import.
+//
+// Returns:
+//   - The import job.
+//   - An error wrapping jobrepo.ErrJobNotFound if the user has no such import, or another error if the retrieval fails.
+func (s *Service) GetImport(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	job, err := s.jobs.GetJob(ctx, jobID, userID)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("get import: %w", err)
+	}
+
+	if job.Kind != model.JobCalendarImport {
+		return model.Job{}, fmt.Errorf("get import: %w", jobrepo.ErrJobNotFound)
+	}
+
+	return job, nil
+}
+
+// Run imports the events of the archive in a calendar import job.
+// Events that cannot be created are counted as skipped; only failing to map the calendars
+// to projects fails the whole import. The events imported before a cancellation are kept.
+// The created events are recorded with the job, so the import can be rolled back.
+//
+// Parameters:
+//   - ctx: The context of the job; the import stops when it is done.
+//   - job: The import job with the archive as payload.
+//   - p: The progress of the job.
+//
+// Returns:
+//   - An error if the archive cannot be read, the projects cannot be created, or ctx is done.
+func (s *Service) Run(ctx context.Context, job model.Job, p *jobsvc.Progress) error {
+	source, calendars, err := readArchive(job.Payload, s.config.MaxExtractedSize)
+	if err != nil {
+		return err
+	}
+
+	result := model.ImportResult{Source: source, Calendars: len(calendars)}
+	total := 0
+	for _, cal := range calendars {
+		total += len(cal.Events)
+	}
+	p.SetTotal(total)
+	p.SetResult(result)
+
+	projectIDs, err := s.mapProjects(ctx, job.UserID, calendars)
+	if err != nil {
+		s.logger.Error("failed to map calendars to projects", zap.String("job_id", job.ID.String()), zap.Error(err))
+		return errors.New("failed to create projects for the calendars")
+	}
+
+	var created []uuid.UUID
+	defer func() { s.recordEvents(ctx, job, created) }()
+
+	now := s.clock.Now()
+	for i, cal := range calendars {
+		for _, e := range cal.Events {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if event, ok := toEvent(job.UserID, projectIDs[i], e, now); !ok {
+				result.Skipped++
+			} else if id, err := s.events.CreateEvent(ctx, event); err != nil {
+				s.logger.Warn("failed to import event", zap.String("job_id", job.ID.String()),
+					zap.String("uid", e.UID), zap.Error(err))
+				result.Skipped++
+			} else {
+				created = append(created, id)
+				result.Imported++
+			}
+
+			p.Add(1)
+			p.SetResult(result)
+		}
+	}
+
+	return nil
+}
+
+// recordEvents records the events created by an import job. It also runs after a cancellation,
+// so the events are recorded with a context that is not canceled.
+// The import is not failed if recording fails; it just cannot be rolled back.
+func (s *Service) recordEvents(ctx context.Context, job model.Job, eventIDs []uuid.UUID) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	if err := s.jobs.RecordEvents(context.WithoutCancel(ctx), job.ID, eventIDs); err != nil {
+		s.logger.Error("failed to record imported events", zap.String("job_id", job.ID.String()), zap.Error(err))
+	}
+}
+
+// mapProjects returns the project of every calendar, creating the projects that do not exist yet.
+// Calendars without a name are imported without a project.
+func (s *Service) mapProjects(ctx context.Context, userID uuid.UUID, calendars []ical.Calendar) ([]*uuid.UUID, error) {
+	existing, err := s.projects.ListProjects(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+
+	byName := make(map[string]uuid.UUID, len(existing))
+	for _, p := range existing {
+		byName[p.Name] = p.ID
+	}
+
+	ids := make([]*uuid.UUID, len(calendars))
+	for i, cal := range calendars {
+		name := truncate(strings.TrimSpace(cal.Name), maxTitleLength)
+		if name == "" {
+			continue
+		}
+
+		id, ok := byName[name]
+		if !ok {
+			if id, err = s.projects.CreateProject(ctx, model.Project{UserID: userID, Name: name}); err != nil {
+				return nil, fmt.Errorf("create project: %w", err)
+			}
+			byName[name] = id
+		}
+		ids[i] = &id
+	}
+
+	return ids, nil
+}
+
+// toEvent maps a calendar event to an event of the user.
+// Cancelled events and overrides of single occurrences are not imported; recurring events
+// are imported as their first occurrence. The first alarm that is still in the future becomes
+// the reminder, pinned to the event's time zone. A color from the palette is kept; rules color the others.
+func toEvent(userID uuid.UUID, projectID *uuid.UUID, e ical.Event, now time.Time) (model.Event, bool) {
+	if e.Cancelled || e.Override || e.Start.IsZero() {
+		return model.Event{}, false
+	}
+
+	title := truncate(strings.TrimSpace(e.Summary), maxTitleLength)
+	if title == "" {
+		title = untitled
+	}
+
+	event := model.Event{
+		UserID:      userID,
+		ProjectID:   projectID,
+		Title:       title,
+		Description: truncate(strings.TrimSpace(e.Description), maxDescriptionLength),
+		EventDate:   e.Start,
+	}
+	if slices.Contains(model.ColorPalette, e.Color) {
+		event.Color = e.Color
+	}
+
+	for _, a := range e.Alarms {
+		at := a.Time(e)
+		if !at.After(now) {
+			continue
+		}
+
+		if loc, err := time.LoadLocation(e.TZID); err == nil && e.TZID != "" {
+			at = at.In(loc)
+			event.ReminderTimezone = e.TZID
+		}
+		event.ReminderAt = &at
+		break
+	}
+
+	return event, true
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
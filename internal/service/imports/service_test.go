@@ -0,0 +1,299 @@
+package imports
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	importmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/imports"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/ical"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+var testConfig = config.Import{MaxArchiveSize: 1 << 20, MaxExtractedSize: 1 << 20}
+
+// now is the time of the test clock; alarms before it are not imported.
+var now = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// zipArchive builds a zip archive from file names and contents.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("failed to add %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to close archive: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// calendar wraps events into a VCALENDAR with the given name.
+func calendar(name string, events ...string) string {
+	s := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"
+	if name != "" {
+		s += "X-WR-CALNAME:" + name + "\r\n"
+	}
+	for _, e := range events {
+		s += "BEGIN:VEVENT\r\n" + e + "END:VEVENT\r\n"
+	}
+	return s + "END:VCALENDAR\r\n"
+}
+
+func TestReadArchive_GoogleTakeout(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"Takeout/Calendar/jane@gmail.com.ics": calendar("Jane", "DTSTART:20300105T090000Z\r\nSUMMARY:Gym\r\n"),
+		"Takeout/Calendar/Holidays.ics":       calendar("", "DTSTART;VALUE=DATE:20301225\r\nSUMMARY:Christmas\r\n"),
+		"Takeout/archive_browser.html":        "<html></html>",
+	})
+
+	source, calendars, err := readArchive(archive, testConfig.MaxExtractedSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source != model.ImportGoogleTakeout {
+		t.Errorf("expected source %s, got %s", model.ImportGoogleTakeout, source)
+	}
+
+	names := map[string]int{}
+	for _, c := range calendars {
+		names[c.Name] = len(c.Events)
+	}
+	if len(names) != 2 || names["Jane"] != 1 || names["Holidays"] != 1 {
+		t.Errorf("unexpected calendars: %v", names)
+	}
+}
+
+func TestReadArchive_AppleCalendarArchive(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"Calendars.icbu/Info.plist":                  "<plist></plist>",
+		"Calendars.icbu/5A1F.calendar/Info.plist":    "<plist><dict><key>Title</key>\n<string>Family</string></dict></plist>",
+		"Calendars.icbu/5A1F.calendar/Events/E1.ics": calendar("", "DTSTART:20300105T090000Z\r\nSUMMARY:Dinner\r\n"),
+		"Calendars.icbu/5A1F.calendar/Events/E2.ics": calendar("", "DTSTART:20300106T090000Z\r\nSUMMARY:Picnic\r\n"),
+		"__MACOSX/Calendars.icbu/._Info.plist":       "junk",
+	})
+
+	source, calendars, err := readArchive(archive, testConfig.MaxExtractedSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source != model.ImportAppleCalendar {
+		t.Errorf("expected source %s, got %s", model.ImportAppleCalendar, source)
+	}
+	if len(calendars) != 1 || calendars[0].Name != "Family" || len(calendars[0].Events) != 2 {
+		t.Errorf("expected one calendar Family with two events, got %+v", calendars)
+	}
+}
+
+func TestReadArchive_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive []byte
+		limit   int64
+		want    error
+	}{
+		{"not a zip", []byte("BEGIN:VCALENDAR"), 1 << 20, ErrInvalidArchive},
+		{"no calendars", zipArchive(t, map[string]string{"notes.txt": "hello"}), 1 << 20, ErrNoCalendars},
+		{"too large", zipArchive(t, map[string]string{"work.ics": calendar("Work")}), 10, ErrArchiveTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := readArchive(tt.archive, tt.limit); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestToEvent(t *testing.T) {
+	userID := uuid.New()
+	start := time.Date(2030, 3, 30, 8, 0, 0, 0, time.UTC) // 09:00 in Berlin
+	e := ical.Event{
+		Summary: "  ",
+		Color:   "navy", // not in the palette, left to the rules
+		Start:   start,
+		TZID:    "Europe/Berlin",
+		Alarms: []ical.Alarm{
+			{Offset: -100 * 24 * time.Hour}, // before the test clock, ignored
+			{Offset: -15 * time.Minute},
+		},
+	}
+
+	event, ok := toEvent(userID, nil, e, now)
+	if !ok {
+		t.Fatal("expected event to be imported")
+	}
+	if event.Title != untitled {
+		t.Errorf("expected title %q, got %q", untitled, event.Title)
+	}
+	if event.Color != "" {
+		t.Errorf("expected no color, got %q", event.Color)
+	}
+	if colored, _ := toEvent(userID, nil, ical.Event{Start: start, Color: "teal"}, now); colored.Color != "teal" {
+		t.Errorf("expected palette color teal, got %q", colored.Color)
+	}
+	if event.ReminderAt == nil || !event.ReminderAt.Equal(start.Add(-15*time.Minute)) {
+		t.Errorf("expected reminder 15 minutes before the start, got %v", event.ReminderAt)
+	}
+	if event.ReminderTimezone != "Europe/Berlin" || event.ReminderAt.Hour() != 8 || event.ReminderAt.Minute() != 45 {
+		t.Errorf("expected reminder at 08:45 Europe/Berlin, got %v in %q", event.ReminderAt, event.ReminderTimezone)
+	}
+
+	for _, skipped := range []ical.Event{{Start: start, Cancelled: true}, {Start: start, Override: true}, {}} {
+		if _, ok := toEvent(userID, nil, skipped, now); ok {
+			t.Errorf("expected %+v to be skipped", skipped)
+		}
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := importmocks.NewMockjobService(ctrl)
+	mockEvents := importmocks.NewMockeventService(ctrl)
+	mockProjects := importmocks.NewMockprojectService(ctrl)
+	svc := New(mockJobs, mockEvents, mockProjects, testConfig, clock.NewFake(now), zap.NewNop())
+
+	userID, workID, homeID := uuid.New(), uuid.New(), uuid.New()
+	archive := zipArchive(t, map[string]string{
+		"Takeout/Calendar/Work.ics": calendar("Work",
+			"DTSTART:20300105T090000Z\r\nSUMMARY:Standup\r\n",
+			"DTSTART:20300105T100000Z\r\nSUMMARY:Cancelled\r\nSTATUS:CANCELLED\r\n"),
+		"Takeout/Calendar/Home.ics": calendar("Home", "DTSTART:20300106T090000Z\r\nSUMMARY:Broken\r\n"),
+	})
+	job := model.Job{ID: uuid.New(), UserID: userID, Kind: model.JobCalendarImport, Payload: archive}
+
+	mockProjects.EXPECT().ListProjects(gomock.Any(), userID).Return([]model.Project{{ID: workID, Name: "Work"}}, nil)
+	mockProjects.EXPECT().CreateProject(gomock.Any(), model.Project{UserID: userID, Name: "Home"}).Return(homeID, nil)
+
+	standupID := uuid.New()
+	mockEvents.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			switch e.Title {
+			case "Standup":
+				if e.ProjectID == nil || *e.ProjectID != workID {
+					t.Errorf("expected Standup in the Work project, got %+v", e)
+				}
+				return standupID, nil
+			default:
+				return uuid.Nil, errors.New("db down")
+			}
+		}).
+		Times(2)
+	mockJobs.EXPECT().RecordEvents(gomock.Any(), job.ID, []uuid.UUID{standupID}).Return(nil)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), job, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed, total := p.Counts(); processed != 3 || total != 3 {
+		t.Errorf("expected 3 of 3 events processed, got %d of %d", processed, total)
+	}
+	got, _ := p.Result().(model.ImportResult)
+	if got.Source != model.ImportGoogleTakeout || got.Calendars != 2 || got.Imported != 1 || got.Skipped != 2 {
+		t.Errorf("unexpected import result: %+v", got)
+	}
+}
+
+func TestService_Run_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProjects := importmocks.NewMockprojectService(ctrl)
+	svc := New(importmocks.NewMockjobService(ctrl), importmocks.NewMockeventService(ctrl), mockProjects,
+		testConfig, clock.NewFake(now), zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockProjects.EXPECT().ListProjects(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockProjects.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+
+	archive := zipArchive(t, map[string]string{"work.ics": calendar("", "DTSTART:20300105T090000Z\r\n")})
+	p := &jobsvc.Progress{}
+	if err := svc.Run(ctx, model.Job{ID: uuid.New(), Payload: archive}, p); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if processed, _ := p.Counts(); processed != 0 {
+		t.Errorf("expected no events processed, got %d", processed)
+	}
+}
+
+func TestService_StartImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := importmocks.NewMockjobService(ctrl)
+	svc := New(mockJobs, importmocks.NewMockeventService(ctrl), importmocks.NewMockprojectService(ctrl),
+		testConfig, clock.NewFake(now), zap.NewNop())
+
+	userID := uuid.New()
+	archive := zipArchive(t, map[string]string{"work.ics": calendar("Work", "DTSTART:20300105T090000Z\r\n")})
+
+	mockJobs.EXPECT().
+		Enqueue(gomock.Any(), model.Job{UserID: userID, Kind: model.JobCalendarImport, Payload: archive, Total: 1}).
+		Return(model.Job{ID: uuid.New(), Status: model.JobQueued}, nil)
+
+	job, err := svc.StartImport(context.Background(), userID, archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status != model.JobQueued {
+		t.Errorf("expected a queued job, got %+v", job)
+	}
+}
+
+func TestService_StartImport_InvalidArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(importmocks.NewMockjobService(ctrl), importmocks.NewMockeventService(ctrl),
+		importmocks.NewMockprojectService(ctrl), testConfig, clock.NewFake(now), zap.NewNop())
+
+	_, err := svc.StartImport(context.Background(), uuid.New(), []byte("not a zip"))
+	if !errors.Is(err, ErrInvalidArchive) {
+		t.Fatalf("expected ErrInvalidArchive, got %v", err)
+	}
+}
+
+func TestService_GetImport_OtherKind(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := importmocks.NewMockjobService(ctrl)
+	svc := New(mockJobs, importmocks.NewMockeventService(ctrl), importmocks.NewMockprojectService(ctrl),
+		testConfig, clock.NewFake(now), zap.NewNop())
+
+	jobID, userID := uuid.New(), uuid.New()
+	mockJobs.EXPECT().GetJob(gomock.Any(), jobID, userID).Return(model.Job{ID: jobID, Kind: "export"}, nil)
+
+	if _, err := svc.GetImport(context.Background(), jobID, userID); !errors.Is(err, jobrepo.ErrJobNotFound) {
+		t.Fatalf("expected ErrJobNotFound, got %v", err)
+	}
+}
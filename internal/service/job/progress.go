@@ -0,0 +1,122 @@
+package job
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Progress collects the progress and result a runner reports while a job runs.
+// It is stored with every heartbeat and when the job ends. It is safe for concurrent use.
+type Progress struct {
+	mu        sync.Mutex
+	total     int         // units of work
+	processed int         // units of work done
+	result    interface{} // kind-specific result, encoded as JSON when stored
+	output    []byte      // file produced by the job; stored when the job ends
+	mediaType string      // media type of output
+}
+
+// SetTotal sets the number of units of work, e.g. once the input has been parsed.
+//
+// Parameters:
+//   - n: The number of units of work.
+func (p *Progress) SetTotal(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.total = n
+}
+
+// Add records that n more units of work are done.
+//
+// Parameters:
+//   - n: The number of units of work done since the last call.
+func (p *Progress) Add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.processed += n
+}
+
+// SetResult replaces the kind-specific result of the job. It is encoded as JSON when stored,
+// so it should not be modified after it has been set.
+//
+// Parameters:
+//   - v: The result, e.g. a model.ImportResult.
+func (p *Progress) SetResult(v interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.result = v
+}
+
+// SetOutput sets the file the job produces, e.g. a rendered PDF. It is stored when the job ends
+// and can be downloaded once the job has completed.
+//
+// Parameters:
+//   - data: The content of the file.
+//   - mediaType: The media type of the file, e.g. application/pdf.
+func (p *Progress) SetOutput(data []byte, mediaType string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.output, p.mediaType = data, mediaType
+}
+
+// Counts returns the units of work done and the total reported so far.
+//
+// Returns:
+//   - The processed units of work.
+//   - The total units of work; 0 if not known yet.
+func (p *Progress) Counts() (int, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.processed, p.total
+}
+
+// Result returns the result reported last, or nil.
+//
+// Returns:
+//   - The kind-specific result.
+func (p *Progress) Result() interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.result
+}
+
+// Output returns the file set last and its media type, or nil.
+//
+// Returns:
+//   - The content of the file.
+//   - The media type of the file.
+func (p *Progress) Output() ([]byte, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.output, p.mediaType
+}
+
+// apply copies the progress, the output and the encoded result into the job.
+func (p *Progress) apply(job *model.Job) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job.Total, job.Processed = p.total, p.processed
+	job.Output, job.OutputType = p.output, p.mediaType
+	if p.result == nil {
+		return nil
+	}
+
+	result, err := json.Marshal(p.result)
+	if err != nil {
+		return fmt.Errorf("encode job result: %w", err)
+	}
+	job.Result = result
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package job
+
+import (
+	"context"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Runner executes the jobs of one kind.
+type Runner interface {
+	// Run executes a job, reporting its progress and result through p.
+	// It must return promptly once ctx is done, e.g. when the job is cancelled.
+	// The returned error is shown to the user as the reason the job failed.
+	Run(ctx context.Context, job model.Job, p *Progress) error
+}
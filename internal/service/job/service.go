@@ -0,0 +1,407 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+)
+
+var (
+	// ErrUnknownKind is returned when no runner is registered for the kind of a job.
+	ErrUnknownKind = errors.New("unknown job kind")
+
+	// ErrCancelled is the cause the context of a running job is canceled with when the user cancels it.
+	ErrCancelled = errors.New("job cancelled")
+
+	// ErrNotReversible is returned when a job of a kind that creates no events is rolled back.
+	ErrNotReversible = errors.New("job cannot be rolled back")
+
+	// ErrJobRunning is returned when a job that has not finished yet is rolled back.
+	ErrJobRunning = errors.New("job has not finished yet")
+
+	// ErrUndoExpired is returned when a job is rolled back after its undo window.
+	ErrUndoExpired = errors.New("undo window has expired")
+)
+
+const (
+	// maxListedJobs caps the number of jobs returned by ListJobs.
+	maxListedJobs = 50
+
+	// defaultUndoWindow is used when the configuration sets no undo window.
+	defaultUndoWindow = 30 * time.Minute
+)
+
+// reversibleKinds are the job kinds whose created events are recorded and can be rolled back.
+var reversibleKinds = map[string]bool{
+	model.JobCalendarImport: true,
+}
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/job/mock_job.go -package=mocks
+
+// jobRepo defines the interface for job-related database operations.
+type jobRepo interface {
+	// CreateJob inserts a new queued job and returns it with its ID.
+	CreateJob(ctx context.Context, job model.Job) (model.Job, error)
+
+	// GetJob retrieves a job by its ID for the specified user.
+	GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// ListJobs retrieves the most recent jobs of a user.
+	ListJobs(ctx context.Context, userID uuid.UUID, limit int) ([]model.Job, error)
+
+	// ClaimNext locks the oldest queued job for the given owner and marks it as running.
+	ClaimNext(ctx context.Context, lease time.Duration, owner string) (*model.Job, error)
+
+	// Heartbeat extends the lease of a running job, stores its progress and reports whether it should be cancelled.
+	Heartbeat(ctx context.Context, job model.Job, lease time.Duration, owner string) (bool, error)
+
+	// FinishJob stores the final status, progress and result of a job.
+	FinishJob(ctx context.Context, job model.Job, owner string) error
+
+	// GetOutput retrieves the file produced by a completed job of the specified user.
+	GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// CancelJob cancels a queued job and asks the worker of a running job to stop.
+	CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error)
+
+	// FailExpired marks running jobs whose lease has expired as failed.
+	FailExpired(ctx context.Context) (int, error)
+
+	// RecordEvents stores the events created by a job.
+	RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error
+
+	// DeleteJobEvents deletes the events created by a job of the user.
+	DeleteJobEvents(ctx context.Context, jobID, userID uuid.UUID) (int, error)
+}
+
+// Service manages business logic for background jobs.
+// Long-running operations are queued as jobs and executed by the job worker pool with the runner
+// registered for their kind, so they do not tie up HTTP requests. While a job runs, its progress is
+// stored with periodic heartbeats that also extend its lease and pick up cancellation requests.
+type Service struct {
+	jobRepo jobRepo           // Repository for job database operations
+	runners map[string]Runner // Runners by job kind
+	config  config.Job        // Lease, heartbeat and undo window settings
+	clock   clock.Clock       // Source of the heartbeat ticker and of the time undo windows are checked against
+}
+
+// New creates a new Service instance with the provided job repository, configuration, and clock.
+//
+// Parameters:
+//   - r: The job repository for database operations.
+//   - cfg: The job worker pool configuration.
+//   - clk: The clock heartbeats are scheduled with.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r jobRepo, cfg config.Job, clk clock.Clock) *Service {
+	return &Service{
+		jobRepo: r,
+		runners: make(map[string]Runner),
+		config:  cfg,
+		clock:   clk,
+	}
+}
+
+// Register sets the runner of a job kind. It must be called before the worker pool starts.
+//
+// Parameters:
+//   - kind: The job kind, e.g. model.JobCalendarImport.
+//   - runner: The runner executing jobs of the kind.
+func (s *Service) Register(kind string, runner Runner) {
+	s.runners[kind] = runner
+}
+
+// Enqueue queues a job for the worker pool.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - job: The job to queue; UserID, Kind and Payload must be set, Total may be.
+//
+// Returns:
+//   - The queued job.
+//   - ErrUnknownKind if no runner is registered for the kind, or another error if the creation fails.
+func (s *Service) Enqueue(ctx context.Context, job model.Job) (model.Job, error) {
+	if _, ok := s.runners[job.Kind]; !ok {
+		return model.Job{}, fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+
+	job, err := s.jobRepo.CreateJob(ctx, job)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("enqueue job: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetJob retrieves a job with its progress.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job.
+//   - An error if the job is not found or the retrieval fails.
+func (s *Service) GetJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	job, err := s.jobRepo.GetJob(ctx, jobID, userID)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ListJobs retrieves the 50 most recent jobs of a user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of jobs.
+//   - An error if the retrieval fails.
+func (s *Service) ListJobs(ctx context.Context, userID uuid.UUID) ([]model.Job, error) {
+	jobs, err := s.jobRepo.ListJobs(ctx, userID, maxListedJobs)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// GetOutput retrieves the file produced by a completed job.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job with its output.
+//   - An error if the job is not found, has no output yet, or the retrieval fails.
+func (s *Service) GetOutput(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	job, err := s.jobRepo.GetOutput(ctx, jobID, userID)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("get job output: %w", err)
+	}
+
+	return job, nil
+}
+
+// CancelJob cancels a job. A queued job is cancelled right away; a running job is stopped by its
+// worker at the next heartbeat, and the work it has done so far is kept.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The job after the cancellation request.
+//   - An error if the job is not found, has already finished, or the update fails.
+func (s *Service) CancelJob(ctx context.Context, jobID, userID uuid.UUID) (model.Job, error) {
+	job, err := s.jobRepo.CancelJob(ctx, jobID, userID)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("cancel job: %w", err)
+	}
+
+	return job, nil
+}
+
+// RecordEvents stores the events a job has created, so the job can be rolled back within its undo window.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the job.
+//   - eventIDs: The UUIDs of the created events.
+//
+// Returns:
+//   - An error if the events cannot be stored.
+func (s *Service) RecordEvents(ctx context.Context, jobID uuid.UUID, eventIDs []uuid.UUID) error {
+	if err := s.jobRepo.RecordEvents(ctx, jobID, eventIDs); err != nil {
+		return fmt.Errorf("record job events: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackJob reverts a finished bulk operation, such as an import, by deleting the events it created.
+// A job can be rolled back until the undo window has passed since it finished; cancelled and failed
+// jobs can be rolled back too, reverting the part they completed. Events changed by the user after the
+// job are deleted as well, and projects the job created are kept.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - jobID: The UUID of the job.
+//   - userID: The UUID of the user who started the job.
+//
+// Returns:
+//   - The number of deleted events; 0 if the job has already been rolled back.
+//   - An error wrapping jobrepo.ErrJobNotFound, ErrNotReversible, ErrJobRunning or ErrUndoExpired
+//     if the job cannot be rolled back, or another error if the deletion fails.
+func (s *Service) RollbackJob(ctx context.Context, jobID, userID uuid.UUID) (int, error) {
+	job, err := s.jobRepo.GetJob(ctx, jobID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("rollback job: %w", err)
+	}
+
+	switch {
+	case !reversibleKinds[job.Kind]:
+		return 0, ErrNotReversible
+	case !job.Finished() || job.FinishedAt == nil:
+		return 0, ErrJobRunning
+	case s.clock.Now().Sub(*job.FinishedAt) > s.undoWindow():
+		return 0, ErrUndoExpired
+	}
+
+	deleted, err := s.jobRepo.DeleteJobEvents(ctx, jobID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("rollback job: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// undoWindow returns how long after finishing a job can be rolled back.
+func (s *Service) undoWindow() time.Duration {
+	if s.config.UndoWindow > 0 {
+		return s.config.UndoWindow
+	}
+	return defaultUndoWindow
+}
+
+// ClaimNext claims the oldest queued job for the given worker.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - owner: The identifier of the claiming worker.
+//
+// Returns:
+//   - The claimed job, or nil if no job is queued.
+//   - An error if claiming fails.
+func (s *Service) ClaimNext(ctx context.Context, owner string) (*model.Job, error) {
+	job, err := s.jobRepo.ClaimNext(ctx, s.config.LeaseDuration, owner)
+	if err != nil {
+		return nil, fmt.Errorf("claim job: %w", err)
+	}
+
+	return job, nil
+}
+
+// FailExpired fails the running jobs whose worker stopped without finishing them.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The number of failed jobs.
+//   - An error if the update fails.
+func (s *Service) FailExpired(ctx context.Context) (int, error) {
+	n, err := s.jobRepo.FailExpired(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail expired jobs: %w", err)
+	}
+
+	return n, nil
+}
+
+// Execute runs a claimed job with the runner of its kind and records the outcome.
+// The job is cancelled when the user asks for it, and fails when ctx is done (e.g. on shutdown),
+// when the runner returns an error, or when another instance has taken over its expired lease.
+//
+// Parameters:
+//   - ctx: The context of the worker; canceling it interrupts the job.
+//   - job: The claimed job.
+//   - owner: The identifier of the worker that claimed the job.
+//
+// Returns:
+//   - The job with its final status.
+//   - An error if the outcome cannot be recorded.
+func (s *Service) Execute(ctx context.Context, job model.Job, owner string) (model.Job, error) {
+	// Heartbeats and the outcome are recorded even while the worker shuts down.
+	recordCtx := context.WithoutCancel(ctx)
+
+	runner, ok := s.runners[job.Kind]
+	if !ok {
+		job.Status, job.Error = model.JobFailed, fmt.Sprintf("%s: %s", ErrUnknownKind, job.Kind)
+		return job, s.finish(recordCtx, job, owner)
+	}
+
+	runCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// The runner gets its own copy of the job, since heartbeats write the progress into job.
+	p := &Progress{total: job.Total}
+	done := make(chan error, 1)
+	go func(job model.Job) {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("job crashed: %v", r)
+			}
+		}()
+		done <- runner.Run(runCtx, job, p)
+	}(job)
+
+	ticker := s.clock.NewTicker(s.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	var runErr error
+	for running := true; running; {
+		select {
+		case runErr = <-done:
+			running = false
+		case <-ticker.C():
+			if err := p.apply(&job); err != nil {
+				cancel(err)
+				continue
+			}
+			cancelRequested, err := s.jobRepo.Heartbeat(recordCtx, job, s.config.LeaseDuration, owner)
+			switch {
+			case errors.Is(err, jobrepo.ErrJobLost):
+				cancel(err)
+			case err == nil && cancelRequested:
+				cancel(ErrCancelled)
+			}
+		}
+	}
+
+	if err := p.apply(&job); err != nil && runErr == nil {
+		runErr = err
+	}
+
+	cause := context.Cause(runCtx)
+	switch {
+	case errors.Is(cause, jobrepo.ErrJobLost):
+		job.Status, job.Error = model.JobFailed, "the job was taken over after its lease expired"
+		return job, fmt.Errorf("execute job: %w", jobrepo.ErrJobLost)
+	case runErr == nil:
+		job.Status = model.JobCompleted
+	case errors.Is(cause, ErrCancelled):
+		job.Status = model.JobCancelled
+	case ctx.Err() != nil:
+		job.Status, job.Error = model.JobFailed, "interrupted by a server shutdown"
+	default:
+		job.Status, job.Error = model.JobFailed, runErr.Error()
+	}
+
+	return job, s.finish(recordCtx, job, owner)
+}
+
+// finish records the final status of a job.
+func (s *Service) finish(ctx context.Context, job model.Job, owner string) error {
+	if err := s.jobRepo.FinishJob(ctx, job, owner); err != nil {
+		return fmt.Errorf("finish job: %w", err)
+	}
+
+	return nil
+}
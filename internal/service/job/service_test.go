@@ -0,0 +1,228 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	jobmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/job"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobrepo "github.com/aliskhannn/calendar-service/internal/repository/job"
+)
+
+var testConfig = config.Job{Workers: 1, LeaseDuration: time.Minute, HeartbeatInterval: 10 * time.Second}
+
+// runnerFunc adapts a function to the Runner interface.
+type runnerFunc func(ctx context.Context, job model.Job, p *Progress) error
+
+func (f runnerFunc) Run(ctx context.Context, job model.Job, p *Progress) error { return f(ctx, job, p) }
+
+// expectFinish expects the job to be finished with the given status and returns the recorded job.
+func expectFinish(t *testing.T, mockRepo *jobmocks.MockjobRepo, status string) *model.Job {
+	t.Helper()
+
+	var finished model.Job
+	mockRepo.EXPECT().
+		FinishJob(gomock.Any(), gomock.Any(), "worker-1").
+		DoAndReturn(func(_ context.Context, job model.Job, _ string) error {
+			if job.Status != status {
+				t.Errorf("expected status %s, got %s (%s)", status, job.Status, job.Error)
+			}
+			finished = job
+			return nil
+		})
+
+	return &finished
+}
+
+func TestService_Execute_Completed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clock.NewFake(time.Now()))
+	svc.Register(model.JobCalendarImport, runnerFunc(func(_ context.Context, _ model.Job, p *Progress) error {
+		p.SetTotal(2)
+		p.Add(2)
+		p.SetResult(model.ImportResult{Imported: 2})
+		return nil
+	}))
+
+	finished := expectFinish(t, mockRepo, model.JobCompleted)
+
+	job, err := svc.Execute(context.Background(), model.Job{ID: uuid.New(), Kind: model.JobCalendarImport}, "worker-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Processed != 2 || job.Total != 2 || string(finished.Result) == "" {
+		t.Errorf("expected the progress and result to be recorded, got %+v", *finished)
+	}
+}
+
+func TestService_Execute_Failed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clock.NewFake(time.Now()))
+	svc.Register(model.JobCalendarImport, runnerFunc(func(context.Context, model.Job, *Progress) error {
+		panic("nil map")
+	}))
+
+	finished := expectFinish(t, mockRepo, model.JobFailed)
+
+	if _, err := svc.Execute(context.Background(), model.Job{ID: uuid.New(), Kind: model.JobCalendarImport}, "worker-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if finished.Error != "job crashed: nil map" {
+		t.Errorf("unexpected error message %q", finished.Error)
+	}
+}
+
+func TestService_Execute_UnknownKind(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clock.NewFake(time.Now()))
+
+	expectFinish(t, mockRepo, model.JobFailed)
+
+	if _, err := svc.Execute(context.Background(), model.Job{ID: uuid.New(), Kind: "export"}, "worker-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Execute_CancelledAtHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clk := clock.NewFake(time.Now())
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clk)
+	started := make(chan struct{})
+	svc.Register(model.JobCalendarImport, runnerFunc(func(ctx context.Context, _ model.Job, p *Progress) error {
+		p.Add(1)
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	mockRepo.EXPECT().
+		Heartbeat(gomock.Any(), gomock.Any(), testConfig.LeaseDuration, "worker-1").
+		DoAndReturn(func(_ context.Context, job model.Job, _ time.Duration, _ string) (bool, error) {
+			if job.Processed != 1 {
+				t.Errorf("expected the heartbeat to store the progress, got %+v", job)
+			}
+			return true, nil
+		})
+	expectFinish(t, mockRepo, model.JobCancelled)
+
+	go func() {
+		<-started
+		clk.BlockUntil(1)
+		clk.Advance(testConfig.HeartbeatInterval)
+	}()
+
+	if _, err := svc.Execute(context.Background(), model.Job{ID: uuid.New(), Kind: model.JobCalendarImport}, "worker-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Execute_LeaseLost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clk := clock.NewFake(time.Now())
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clk)
+	svc.Register(model.JobCalendarImport, runnerFunc(func(ctx context.Context, _ model.Job, _ *Progress) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	// The job belongs to another worker now, so its outcome is not recorded.
+	mockRepo.EXPECT().Heartbeat(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, jobrepo.ErrJobLost)
+
+	go func() {
+		clk.BlockUntil(1)
+		clk.Advance(testConfig.HeartbeatInterval)
+	}()
+
+	_, err := svc.Execute(context.Background(), model.Job{ID: uuid.New(), Kind: model.JobCalendarImport}, "worker-1")
+	if !errors.Is(err, jobrepo.ErrJobLost) {
+		t.Fatalf("expected ErrJobLost, got %v", err)
+	}
+}
+
+func TestService_Enqueue_UnknownKind(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(jobmocks.NewMockjobRepo(ctrl), testConfig, clock.NewFake(time.Now()))
+
+	if _, err := svc.Enqueue(context.Background(), model.Job{Kind: "export"}); !errors.Is(err, ErrUnknownKind) {
+		t.Fatalf("expected ErrUnknownKind, got %v", err)
+	}
+}
+
+func TestService_RollbackJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := jobmocks.NewMockjobRepo(ctrl)
+	svc := New(mockRepo, testConfig, clock.NewFake(now))
+
+	jobID, userID := uuid.New(), uuid.New()
+	finished := now.Add(-10 * time.Minute)
+
+	mockRepo.EXPECT().GetJob(gomock.Any(), jobID, userID).
+		Return(model.Job{ID: jobID, Kind: model.JobCalendarImport, Status: model.JobCancelled, FinishedAt: &finished}, nil)
+	mockRepo.EXPECT().DeleteJobEvents(gomock.Any(), jobID, userID).Return(42, nil)
+
+	deleted, err := svc.RollbackJob(context.Background(), jobID, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted != 42 {
+		t.Errorf("expected 42 deleted events, got %d", deleted)
+	}
+}
+
+func TestService_RollbackJob_Rejected(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	recent, old := now.Add(-time.Minute), now.Add(-31*time.Minute)
+
+	tests := []struct {
+		name string
+		job  model.Job
+		want error
+	}{
+		{"export", model.Job{Kind: model.JobPDFExport, Status: model.JobCompleted, FinishedAt: &recent}, ErrNotReversible},
+		{"running", model.Job{Kind: model.JobCalendarImport, Status: model.JobRunning}, ErrJobRunning},
+		{"expired", model.Job{Kind: model.JobCalendarImport, Status: model.JobCompleted, FinishedAt: &old}, ErrUndoExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := jobmocks.NewMockjobRepo(ctrl)
+			svc := New(mockRepo, testConfig, clock.NewFake(now))
+
+			mockRepo.EXPECT().GetJob(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.job, nil)
+
+			if _, err := svc.RollbackJob(context.Background(), uuid.New(), uuid.New()); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
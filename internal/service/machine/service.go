@@ -0,0 +1,105 @@
+package machine
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrInvalidClient = errors.New("invalid client credentials")
+	ErrInvalidScope  = errors.New("scope not allowed for client")
+)
+
+// Service issues machine tokens to the internal services registered as clients in the configuration.
+type Service struct {
+	clients map[string]config.MachineClient // registered clients by their ID
+	ttl     time.Duration                   // lifetime of issued tokens
+	jwt     config.JWT                      // signing key, issuer and audience shared with user tokens
+	clock   clock.Clock                     // source of the current time for token issuance and expiry
+}
+
+// New creates a new Service instance for the configured machine clients.
+//
+// Parameters:
+//   - machine: The registered clients and the lifetime of their tokens.
+//   - jwtCfg: The JWT configuration tokens are signed with.
+//   - clk: The clock tokens are issued by.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(machine config.Machine, jwtCfg config.JWT, clk clock.Clock) *Service {
+	clients := make(map[string]config.MachineClient, len(machine.Clients))
+	for _, c := range machine.Clients {
+		clients[c.ID] = c
+	}
+
+	return &Service{
+		clients: clients,
+		ttl:     machine.TokenTTL,
+		jwt:     jwtCfg,
+		clock:   clk,
+	}
+}
+
+// IssueToken authenticates a client by its ID and secret and issues a machine token for the requested scopes.
+// Without requested scopes the token carries all scopes of the client.
+//
+// Parameters:
+//   - clientID: The ID of the client.
+//   - secret: The secret of the client.
+//   - scopes: The requested scopes, a subset of the client's scopes.
+//
+// Returns:
+//   - The issued token.
+//   - ErrInvalidClient if the client is unknown or the secret does not match,
+//     ErrInvalidScope if a requested scope is not allowed for the client, or an error if signing fails.
+func (s *Service) IssueToken(clientID, secret string, scopes []string) (model.MachineToken, error) {
+	client, ok := s.clients[clientID]
+	sum := sha256.Sum256([]byte(secret))
+	if !ok || subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(strings.ToLower(client.SecretHash))) != 1 {
+		return model.MachineToken{}, ErrInvalidClient
+	}
+
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(client.Scopes, scope) {
+			return model.MachineToken{}, ErrInvalidScope
+		}
+	}
+
+	now := s.clock.Now()
+	claims := jwt.MapClaims{
+		"client_id": client.ID,
+		"token_use": model.TokenUseMachine,
+		"scope":     strings.Join(scopes, " "),
+		"exp":       now.Add(s.ttl).Unix(), // expiration time
+		"iat":       now.Unix(),            // issued at time
+	}
+	if s.jwt.Issuer != "" {
+		claims["iss"] = s.jwt.Issuer
+	}
+	if s.jwt.Audience != "" {
+		claims["aud"] = s.jwt.Audience
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.jwt.Secret))
+	if err != nil {
+		return model.MachineToken{}, fmt.Errorf("sign machine token: %w", err)
+	}
+
+	return model.MachineToken{Token: token, Scopes: scopes, ExpiresIn: s.ttl}, nil
+}
@@ -0,0 +1,89 @@
+package machine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func newTestService() *Service {
+	sum := sha256.Sum256([]byte("s3cret"))
+	machine := config.Machine{
+		TokenTTL: time.Hour,
+		Clients: []config.MachineClient{{
+			ID:         "notifier",
+			SecretHash: hex.EncodeToString(sum[:]),
+			Scopes:     []string{model.ScopeRemindersDispatch, model.ScopeEventsRead},
+		}},
+	}
+	return New(machine, config.JWT{Secret: "secret", Issuer: "calendar-service"}, clock.Real())
+}
+
+func TestService_IssueToken(t *testing.T) {
+	svc := newTestService()
+
+	token, err := svc.IssueToken("notifier", "s3cret", []string{model.ScopeEventsRead})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.ExpiresIn != time.Hour {
+		t.Fatalf("expected a lifetime of 1h, got %s", token.ExpiresIn)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token.Token, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	}); err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if claims["client_id"] != "notifier" || claims["token_use"] != model.TokenUseMachine ||
+		claims["scope"] != model.ScopeEventsRead || claims["iss"] != "calendar-service" {
+		t.Fatalf("unexpected claims %v", claims)
+	}
+	if _, ok := claims["user_id"]; ok {
+		t.Fatal("expected no user_id claim in a machine token")
+	}
+}
+
+func TestService_IssueToken_AllScopes(t *testing.T) {
+	token, err := newTestService().IssueToken("notifier", "s3cret", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(token.Scopes) != 2 {
+		t.Fatalf("expected all scopes of the client, got %v", token.Scopes)
+	}
+}
+
+func TestService_IssueToken_Rejected(t *testing.T) {
+	svc := newTestService()
+
+	tests := []struct {
+		name     string
+		clientID string
+		secret   string
+		scopes   []string
+		want     error
+	}{
+		{"unknown client", "billing", "s3cret", nil, ErrInvalidClient},
+		{"wrong secret", "notifier", "guess", nil, ErrInvalidClient},
+		{"empty secret", "notifier", "", nil, ErrInvalidClient},
+		{"scope not allowed", "notifier", "s3cret", []string{"events:write"}, ErrInvalidScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.IssueToken(tt.clientID, tt.secret, tt.scopes); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package note
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/note/mock_note.go -package=mocks
+
+// noteRepo defines the interface for note-related database operations.
+type noteRepo interface {
+	// GetNote retrieves the note of a user on an event they can still see.
+	GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error)
+
+	// SetNote writes the note of a user on an event they can see, replacing their previous note on it.
+	SetNote(ctx context.Context, n model.Note) (model.Note, error)
+
+	// DeleteNote deletes the note of a user on an event.
+	DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error
+}
+
+// contentCipher defines the encryption of user content at rest.
+type contentCipher interface {
+	// Encrypt encrypts a value with the data key of its owner.
+	Encrypt(ctx context.Context, userID uuid.UUID, plaintext string) (string, error)
+
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for private notes, texts users attach to the events they can see.
+// Notes are encrypted with the data key of their author, not of the owner of the event,
+// and are never returned to other users.
+type Service struct {
+	noteRepo noteRepo      // Repository for note database operations
+	cipher   contentCipher // Encryption of note texts at rest
+}
+
+// New creates a new Service instance with the provided note repository and content cipher.
+//
+// Parameters:
+//   - r: The note repository for database operations.
+//   - c: The cipher encrypting note texts at rest.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r noteRepo, c contentCipher) *Service {
+	return &Service{
+		noteRepo: r,
+		cipher:   c,
+	}
+}
+
+// GetNote retrieves the note of a user on an event.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the author of the note.
+//
+// Returns:
+//   - The note with its decrypted text.
+//   - An error wrapping noterepo.ErrNoteNotFound if the user has no note on the event,
+//     or an error if the retrieval or decryption fails.
+func (s *Service) GetNote(ctx context.Context, eventID, userID uuid.UUID) (model.Note, error) {
+	n, err := s.noteRepo.GetNote(ctx, eventID, userID)
+	if err != nil {
+		return model.Note{}, fmt.Errorf("get note: %w", err)
+	}
+
+	if n.Text, err = s.cipher.Decrypt(ctx, userID, n.Text); err != nil {
+		return model.Note{}, fmt.Errorf("get note: %w", err)
+	}
+
+	return n, nil
+}
+
+// SetNote writes the note of a user on an event they own, are invited to or follow,
+// replacing their previous note on it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - n: The note with the event, author and text.
+//
+// Returns:
+//   - The note as stored, with its plaintext.
+//   - An error wrapping noterepo.ErrEventNotFound if the user cannot see the event,
+//     or an error if the encryption or storage fails.
+func (s *Service) SetNote(ctx context.Context, n model.Note) (model.Note, error) {
+	text := n.Text
+
+	var err error
+	if n.Text, err = s.cipher.Encrypt(ctx, n.UserID, text); err != nil {
+		return model.Note{}, fmt.Errorf("set note: %w", err)
+	}
+
+	n, err = s.noteRepo.SetNote(ctx, n)
+	if err != nil {
+		return model.Note{}, fmt.Errorf("set note: %w", err)
+	}
+
+	n.Text = text
+	return n, nil
+}
+
+// DeleteNote deletes the note of a user on an event.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the author of the note.
+//
+// Returns:
+//   - An error wrapping noterepo.ErrNoteNotFound if the user has no note on the event,
+//     or an error if the deletion fails.
+func (s *Service) DeleteNote(ctx context.Context, eventID, userID uuid.UUID) error {
+	if err := s.noteRepo.DeleteNote(ctx, eventID, userID); err != nil {
+		return fmt.Errorf("delete note: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package note
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notemocks "github.com/aliskhannn/calendar-service/internal/mocks/service/note"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	noterepo "github.com/aliskhannn/calendar-service/internal/repository/note"
+)
+
+func TestService_SetNote_EncryptsWithAuthorKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := notemocks.NewMocknoteRepo(ctrl)
+	mockCipher := notemocks.NewMockcontentCipher(ctrl)
+	svc := New(mockRepo, mockCipher)
+
+	eventID, userID := uuid.New(), uuid.New()
+	mockCipher.EXPECT().Encrypt(gomock.Any(), userID, "Bring the slides").Return("enc:author:Bring the slides", nil)
+	mockRepo.EXPECT().SetNote(gomock.Any(), model.Note{EventID: eventID, UserID: userID, Text: "enc:author:Bring the slides"}).
+		Return(model.Note{EventID: eventID, UserID: userID, Text: "enc:author:Bring the slides"}, nil)
+
+	n, err := svc.SetNote(context.Background(), model.Note{EventID: eventID, UserID: userID, Text: "Bring the slides"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n.Text != "Bring the slides" {
+		t.Fatalf("expected plaintext note, got %q", n.Text)
+	}
+}
+
+func TestService_GetNote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := notemocks.NewMocknoteRepo(ctrl)
+	mockCipher := notemocks.NewMockcontentCipher(ctrl)
+	svc := New(mockRepo, mockCipher)
+
+	eventID, userID := uuid.New(), uuid.New()
+	mockRepo.EXPECT().GetNote(gomock.Any(), eventID, userID).Return(model.Note{EventID: eventID, UserID: userID, Text: "enc:author:Bring the slides"}, nil)
+	mockCipher.EXPECT().Decrypt(gomock.Any(), userID, "enc:author:Bring the slides").Return("Bring the slides", nil)
+	mockRepo.EXPECT().GetNote(gomock.Any(), eventID, userID).Return(model.Note{}, noterepo.ErrNoteNotFound)
+
+	n, err := svc.GetNote(context.Background(), eventID, userID)
+	if err != nil || n.Text != "Bring the slides" {
+		t.Fatalf("unexpected result: %+v, %v", n, err)
+	}
+
+	if _, err := svc.GetNote(context.Background(), eventID, userID); !errors.Is(err, noterepo.ErrNoteNotFound) {
+		t.Fatalf("expected ErrNoteNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/notification/mock_notification.go -package=mocks
+
+// notificationRepo defines the interface for notification log database operations.
+type notificationRepo interface {
+	// CreateEntry appends a feedback entry to the notification log.
+	CreateEntry(ctx context.Context, entry model.NotificationLogEntry) error
+
+	// ListEntries retrieves a page of the entries of the notification log, newest first.
+	ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error)
+
+	// CountEntries counts the entries of the notification log, exactly or estimated.
+	CountEntries(ctx context.Context, recipient string, mode string) (int, error)
+}
+
+// Service manages the notification log, which records the bounces and complaints
+// that email delivery providers report for sent messages.
+type Service struct {
+	notificationRepo notificationRepo // Repository for notification log database operations
+}
+
+// New creates a new Service instance with the provided notification repository.
+//
+// Parameters:
+//   - r: The notification repository for database operations.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r notificationRepo) *Service {
+	return &Service{
+		notificationRepo: r,
+	}
+}
+
+// RecordFeedback stores feedback entries in the notification log of the tenant each message was sent for.
+// Providers report feedback outside of any request, so the tenant comes from the entry itself.
+// Entries whose tenant is missing or unknown while tenancy is enabled cannot be stored and are dropped.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - entries: The feedback entries reported by a provider.
+//
+// Returns:
+//   - The number of dropped entries.
+//   - An error if an entry cannot be stored.
+func (s *Service) RecordFeedback(ctx context.Context, entries []model.NotificationLogEntry) (int, error) {
+	dropped := 0
+	for _, e := range entries {
+		entryCtx := ctx
+		if e.Tenant != "" {
+			entryCtx = tenancy.WithTenant(ctx, e.Tenant)
+		}
+
+		err := s.notificationRepo.CreateEntry(entryCtx, e)
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			dropped++
+			continue
+		}
+		if err != nil {
+			return dropped, fmt.Errorf("record feedback: %w", err)
+		}
+	}
+
+	return dropped, nil
+}
+
+// ListEntries retrieves a page of the entries of the notification log, newest first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - recipient: The email address to filter by; empty returns entries of all recipients.
+//   - page: The page to return; one entry more than the limit is read.
+//
+// Returns:
+//   - A slice of notification log entries.
+//   - An error if the retrieval fails.
+func (s *Service) ListEntries(ctx context.Context, recipient string, page model.Page) ([]model.NotificationLogEntry, error) {
+	entries, err := s.notificationRepo.ListEntries(ctx, recipient, page)
+	if err != nil {
+		return nil, fmt.Errorf("list notification log: %w", err)
+	}
+
+	return entries, nil
+}
+
+// CountEntries counts the entries of the notification log.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - recipient: The email address to filter by; empty counts entries of all recipients.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of entries.
+//   - An error if the count fails.
+func (s *Service) CountEntries(ctx context.Context, recipient string, mode string) (int, error) {
+	n, err := s.notificationRepo.CountEntries(ctx, recipient, mode)
+	if err != nil {
+		return 0, fmt.Errorf("count notification log: %w", err)
+	}
+
+	return n, nil
+}
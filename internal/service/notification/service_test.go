@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notificationrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/notification"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+func TestService_RecordFeedback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := notificationrepomocks.NewMocknotificationRepo(ctrl)
+	svc := New(mockRepo)
+
+	entries := []model.NotificationLogEntry{
+		{Tenant: "acme", Type: model.FeedbackBounce, Recipient: "gone@example.com"},
+		{Type: model.FeedbackComplaint, Recipient: "angry@example.com"},
+	}
+
+	mockRepo.EXPECT().
+		CreateEntry(gomock.Any(), entries[0]).
+		DoAndReturn(func(ctx context.Context, _ model.NotificationLogEntry) error {
+			if tenantID, _ := tenancy.FromContext(ctx); tenantID != "acme" {
+				t.Errorf("expected entry to be stored for tenant acme, got %q", tenantID)
+			}
+			return nil
+		})
+	mockRepo.EXPECT().
+		CreateEntry(gomock.Any(), entries[1]).
+		Return(tenancy.ErrNoTenant)
+
+	dropped, err := svc.RecordFeedback(context.Background(), entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dropped != 1 {
+		t.Fatalf("expected 1 dropped entry, got %d", dropped)
+	}
+}
+
+func TestService_RecordFeedback_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := notificationrepomocks.NewMocknotificationRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().
+		CreateEntry(gomock.Any(), gomock.Any()).
+		Return(errors.New("connection refused"))
+
+	_, err := svc.RecordFeedback(context.Background(), []model.NotificationLogEntry{{Recipient: "gone@example.com"}})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,226 @@
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	viewrepo "github.com/aliskhannn/calendar-service/internal/repository/view"
+)
+
+// ErrAlreadySeeded is returned when the sample data of a user was already created.
+var ErrAlreadySeeded = errors.New("sample data already created")
+
+// sampleProject is the name of the project the example events are created in.
+const sampleProject = "Getting started"
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/onboarding/mock_onboarding.go -package=mocks
+
+// onboardingRepo defines the interface for the onboarding state of users.
+type onboardingRepo interface {
+	// StartSeed marks the sample data of a user as being created; it reports false if it already was.
+	StartSeed(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// CompleteSeed records the sample project and views of a user.
+	CompleteSeed(ctx context.Context, userID uuid.UUID, seed model.OnboardingSeed) error
+
+	// ClearSeed removes the mark of a user, so the sample data can be created again.
+	ClearSeed(ctx context.Context, userID uuid.UUID) error
+
+	// GetStatus retrieves the progress of a user through the guided setup.
+	GetStatus(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error)
+}
+
+// projectService defines the creation of the sample project.
+type projectService interface {
+	// CreateProject creates a new project and returns its ID.
+	CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error)
+}
+
+// eventService defines the creation of the example events.
+type eventService interface {
+	// CreateEvent creates a new event and returns its ID.
+	CreateEvent(ctx context.Context, event model.Event) (uuid.UUID, error)
+}
+
+// viewService defines the creation of the default saved views.
+type viewService interface {
+	// CreateView saves a new view and returns its ID.
+	CreateView(ctx context.Context, view model.View) (uuid.UUID, error)
+}
+
+// Service manages business logic for onboarding new users.
+// Seeding creates a starter project with example events and the default saved views once per user;
+// the guided setup then tracks which features the user tried on their own data.
+type Service struct {
+	repo     onboardingRepo // Repository for the onboarding state
+	projects projectService // Service creating the sample project
+	events   eventService   // Service creating the example events, applying rules and encryption
+	views    viewService    // Service creating the default views
+	clock    clock.Clock    // Source of the current time the example events are scheduled from
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - r: The onboarding repository.
+//   - p: The project service creating the sample project.
+//   - e: The event service creating the example events.
+//   - v: The view service creating the default views.
+//   - clk: The clock the example events are scheduled from.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r onboardingRepo, p projectService, e eventService, v viewService, clk clock.Clock) *Service {
+	return &Service{
+		repo:     r,
+		projects: p,
+		events:   e,
+		views:    v,
+		clock:    clk,
+	}
+}
+
+// Seed creates the sample data of a new user: a "Getting started" project with example events
+// in the coming week, one of them with a reminder, and the default saved views.
+// Default views whose name the user already uses are skipped. If seeding fails, the data
+// created so far is kept and seeding can be retried.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - loc: The time zone of the user the example events are scheduled in.
+//
+// Returns:
+//   - The created sample data.
+//   - ErrAlreadySeeded if the sample data was already created, or another error if the creation fails.
+func (s *Service) Seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+	started, err := s.repo.StartSeed(ctx, userID)
+	if err != nil {
+		return model.OnboardingSeed{}, fmt.Errorf("seed: %w", err)
+	}
+	if !started {
+		return model.OnboardingSeed{}, ErrAlreadySeeded
+	}
+
+	seed, err := s.seed(ctx, userID, loc)
+	if err != nil {
+		// The mark is cleared even if the request was canceled, so seeding can be retried.
+		_ = s.repo.ClearSeed(context.WithoutCancel(ctx), userID)
+		return model.OnboardingSeed{}, fmt.Errorf("seed: %w", err)
+	}
+
+	return seed, nil
+}
+
+// seed creates the sample project, events and views and records them.
+func (s *Service) seed(ctx context.Context, userID uuid.UUID, loc *time.Location) (model.OnboardingSeed, error) {
+	var (
+		seed model.OnboardingSeed
+		err  error
+	)
+
+	seed.ProjectID, err = s.projects.CreateProject(ctx, model.Project{UserID: userID, Name: sampleProject})
+	if err != nil {
+		return model.OnboardingSeed{}, err
+	}
+
+	for _, event := range sampleEvents(userID, seed.ProjectID, s.clock.Now().In(loc)) {
+		id, err := s.events.CreateEvent(ctx, event)
+		if err != nil {
+			return model.OnboardingSeed{}, err
+		}
+		seed.EventIDs = append(seed.EventIDs, id)
+	}
+
+	for _, view := range defaultViews(userID) {
+		id, err := s.views.CreateView(ctx, view)
+		if errors.Is(err, viewrepo.ErrViewExists) {
+			continue
+		}
+		if err != nil {
+			return model.OnboardingSeed{}, err
+		}
+		seed.ViewIDs = append(seed.ViewIDs, id)
+	}
+
+	if err := s.repo.CompleteSeed(ctx, userID, seed); err != nil {
+		return model.OnboardingSeed{}, err
+	}
+
+	return seed, nil
+}
+
+// Status retrieves the progress of a user through the guided setup.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The onboarding status.
+//   - An error if the retrieval fails.
+func (s *Service) Status(ctx context.Context, userID uuid.UUID) (model.OnboardingStatus, error) {
+	status, err := s.repo.GetStatus(ctx, userID)
+	if err != nil {
+		return model.OnboardingStatus{}, fmt.Errorf("onboarding status: %w", err)
+	}
+
+	return status, nil
+}
+
+// sampleEvents returns the example events, scheduled at wall-clock times in the time zone of now.
+// The welcome event has a reminder 15 minutes before it.
+func sampleEvents(userID, projectID uuid.UUID, now time.Time) []model.Event {
+	day := func(offset, hour int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+offset, hour, 0, 0, 0, now.Location())
+	}
+
+	welcome := day(1, 9)
+	reminder := welcome.Add(-15 * time.Minute)
+	timezone := ""
+	if name := now.Location().String(); name != "UTC" && name != "Local" {
+		timezone = name
+	}
+
+	return []model.Event{
+		{
+			UserID:           userID,
+			ProjectID:        &projectID,
+			EventDate:        welcome,
+			Title:            "Welcome to your calendar",
+			Description:      "This is an example event. Edit or delete it, or create your own events.",
+			ReminderAt:       &reminder,
+			ReminderTimezone: timezone,
+		},
+		{
+			UserID:      userID,
+			ProjectID:   &projectID,
+			EventDate:   day(2, 14),
+			Title:       "Plan your week",
+			Description: "High priority events stand out in your views.",
+			Priority:    model.PriorityHigh,
+		},
+		{
+			UserID:      userID,
+			ProjectID:   &projectID,
+			EventDate:   day(7, 10),
+			Title:       "Review your setup",
+			Description: "Share your calendar with an ICS feed or add rules that tag new events automatically.",
+			Priority:    model.PriorityLow,
+		},
+	}
+}
+
+// defaultViews returns the saved views new users start with.
+func defaultViews(userID uuid.UUID) []model.View {
+	return []model.View{
+		{UserID: userID, Name: "Next 7 days", Filter: model.ViewFilter{Range: model.RangeNext7Days}},
+		{UserID: userID, Name: "Important", Filter: model.ViewFilter{Priorities: []string{model.PriorityHigh, model.PriorityCritical}}},
+	}
+}
@@ -0,0 +1,107 @@
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	onboardingmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/onboarding"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	viewrepo "github.com/aliskhannn/calendar-service/internal/repository/view"
+)
+
+type mocks struct {
+	repo     *onboardingmocks.MockonboardingRepo
+	projects *onboardingmocks.MockprojectService
+	events   *onboardingmocks.MockeventService
+	views    *onboardingmocks.MockviewService
+}
+
+func newTestService(t *testing.T, now time.Time) (*Service, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		repo:     onboardingmocks.NewMockonboardingRepo(ctrl),
+		projects: onboardingmocks.NewMockprojectService(ctrl),
+		events:   onboardingmocks.NewMockeventService(ctrl),
+		views:    onboardingmocks.NewMockviewService(ctrl),
+	}
+	return New(m.repo, m.projects, m.events, m.views, clock.NewFake(now)), m
+}
+
+func TestService_Seed(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	svc, m := newTestService(t, time.Date(2030, 3, 4, 23, 30, 0, 0, time.UTC))
+	userID, projectID, viewID := uuid.New(), uuid.New(), uuid.New()
+
+	var events []model.Event
+	m.repo.EXPECT().StartSeed(gomock.Any(), userID).Return(true, nil)
+	m.projects.EXPECT().CreateProject(gomock.Any(), model.Project{UserID: userID, Name: sampleProject}).Return(projectID, nil)
+	m.events.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(_ context.Context, e model.Event) (uuid.UUID, error) {
+			events = append(events, e)
+			return uuid.New(), nil
+		})
+	m.views.EXPECT().CreateView(gomock.Any(), gomock.Any()).Return(viewID, nil)
+	m.views.EXPECT().CreateView(gomock.Any(), gomock.Any()).Return(uuid.Nil, fmt.Errorf("create view: %w", viewrepo.ErrViewExists))
+	m.repo.EXPECT().CompleteSeed(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, seed model.OnboardingSeed) error {
+			if seed.ProjectID != projectID || len(seed.ViewIDs) != 1 {
+				t.Fatalf("unexpected recorded seed: %+v", seed)
+			}
+			return nil
+		})
+
+	seed, err := svc.Seed(context.Background(), userID, berlin)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(seed.EventIDs) != 3 || len(seed.ViewIDs) != 1 || seed.ViewIDs[0] != viewID {
+		t.Fatalf("unexpected seed: %+v", seed)
+	}
+
+	// 23:30 UTC is already the 5th in Berlin, so "tomorrow" is the 6th.
+	welcome := events[0]
+	want := time.Date(2030, 3, 6, 9, 0, 0, 0, berlin)
+	if !welcome.EventDate.Equal(want) || *welcome.ProjectID != projectID {
+		t.Fatalf("unexpected welcome event: %+v", welcome)
+	}
+	if welcome.ReminderAt == nil || !welcome.ReminderAt.Equal(want.Add(-15*time.Minute)) || welcome.ReminderTimezone != "Europe/Berlin" {
+		t.Fatalf("expected a reminder in the user's time zone, got %v %q", welcome.ReminderAt, welcome.ReminderTimezone)
+	}
+}
+
+func TestService_Seed_AlreadySeeded(t *testing.T) {
+	svc, m := newTestService(t, time.Now())
+
+	m.repo.EXPECT().StartSeed(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	_, err := svc.Seed(context.Background(), uuid.New(), time.UTC)
+	if !errors.Is(err, ErrAlreadySeeded) {
+		t.Fatalf("expected ErrAlreadySeeded, got %v", err)
+	}
+}
+
+func TestService_Seed_FailureAllowsRetry(t *testing.T) {
+	svc, m := newTestService(t, time.Now())
+	userID := uuid.New()
+
+	m.repo.EXPECT().StartSeed(gomock.Any(), userID).Return(true, nil)
+	m.projects.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+	m.events.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(uuid.Nil, errors.New("db error"))
+	m.repo.EXPECT().ClearSeed(gomock.Any(), userID).Return(nil)
+
+	if _, err := svc.Seed(context.Background(), userID, time.UTC); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,240 @@
+package preference
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var (
+	ErrInvalidToken    = errors.New("invalid unsubscribe token")
+	ErrUnknownCategory = errors.New("unknown notification category")
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/preference/mock_preference.go -package=mocks
+
+// preferenceRepo defines the interface for notification preference database operations.
+type preferenceRepo interface {
+	// ListPreferences retrieves the stored notification preferences of a user.
+	ListPreferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error)
+
+	// SetPreference subscribes a user to a notification category or unsubscribes them from it.
+	SetPreference(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error)
+}
+
+// Service manages the notification preferences of users and the signed unsubscribe links of notification emails.
+// A link names a user and a category and is signed with the configured secret, so it works without logging in
+// and cannot be altered to unsubscribe somebody else. With tenancy enabled, the tenant is part of the token,
+// since links opened from a mail client cannot send a tenant header.
+type Service struct {
+	preferenceRepo preferenceRepo // Repository for notification preference database operations
+	baseURL        string         // public URL of the service the links point to
+	secret         []byte         // key signing the tokens
+}
+
+// New creates a new Service instance with the provided repository and link settings.
+//
+// Parameters:
+//   - r: The notification preference repository for database operations.
+//   - cfg: The base URL of the links and the secret signing them.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r preferenceRepo, cfg config.Unsubscribe) *Service {
+	return &Service{
+		preferenceRepo: r,
+		baseURL:        strings.TrimSuffix(cfg.BaseURL, "/"),
+		secret:         []byte(cfg.Secret),
+	}
+}
+
+// Preferences retrieves the subscriptions of a user to every notification category.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - One preference per category, in the order of model.NotificationCategories.
+//   - An error if the retrieval fails.
+func (s *Service) Preferences(ctx context.Context, userID uuid.UUID) ([]model.NotificationPreference, error) {
+	stored, err := s.preferenceRepo.ListPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification preferences: %w", err)
+	}
+
+	prefs := make([]model.NotificationPreference, 0, len(model.NotificationCategories))
+	for _, category := range model.NotificationCategories {
+		p := model.NotificationPreference{Category: category, Subscribed: true}
+		if i := slices.IndexFunc(stored, func(p model.NotificationPreference) bool { return p.Category == category }); i >= 0 {
+			p = stored[i]
+		}
+		prefs = append(prefs, p)
+	}
+
+	return prefs, nil
+}
+
+// Subscribed reports whether a user receives the notifications of a category.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - category: The notification category.
+//
+// Returns:
+//   - True unless the user unsubscribed from the category.
+//   - An error if the preferences cannot be read.
+func (s *Service) Subscribed(ctx context.Context, userID uuid.UUID, category string) (bool, error) {
+	prefs, err := s.Preferences(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range prefs {
+		if p.Category == category {
+			return p.Subscribed, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SetSubscribed subscribes a user to a notification category or unsubscribes them from it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - category: The notification category.
+//   - subscribed: Whether notifications of the category are sent.
+//
+// Returns:
+//   - The updated preference.
+//   - ErrUnknownCategory for a category not in model.NotificationCategories, or an error if the update fails.
+func (s *Service) SetSubscribed(ctx context.Context, userID uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error) {
+	if !slices.Contains(model.NotificationCategories, category) {
+		return model.NotificationPreference{}, fmt.Errorf("%w: %q", ErrUnknownCategory, category)
+	}
+
+	p, err := s.preferenceRepo.SetPreference(ctx, userID, category, subscribed)
+	if err != nil {
+		return model.NotificationPreference{}, fmt.Errorf("set notification preference: %w", err)
+	}
+
+	return p, nil
+}
+
+// Unsubscribe unsubscribes the user of a signed unsubscribe token from its category.
+// Following a link again has no further effect.
+//
+// Parameters:
+//   - ctx: The context for the operation; the tenant of the token is added to it.
+//   - token: The token of the link.
+//
+// Returns:
+//   - The category and the resulting preferences of the user.
+//   - ErrInvalidToken if the token is malformed, not signed with the secret or names an unknown tenant,
+//     or another error if the update fails.
+func (s *Service) Unsubscribe(ctx context.Context, token string) (model.Unsubscription, error) {
+	tenantID, userID, category, err := s.parseToken(token)
+	if err != nil {
+		return model.Unsubscription{}, fmt.Errorf("unsubscribe: %w", err)
+	}
+	if tenantID != "" {
+		ctx = tenancy.WithTenant(ctx, tenantID)
+	}
+
+	if _, err := s.preferenceRepo.SetPreference(ctx, userID, category, false); err != nil {
+		// A token naming an unknown tenant, e.g. one that was removed, is as invalid as a forged one.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = ErrInvalidToken
+		}
+		return model.Unsubscription{}, fmt.Errorf("unsubscribe: %w", err)
+	}
+
+	prefs, err := s.Preferences(ctx, userID)
+	if err != nil {
+		return model.Unsubscription{}, fmt.Errorf("unsubscribe: %w", err)
+	}
+
+	return model.Unsubscription{Category: category, Preferences: prefs}, nil
+}
+
+// UnsubscribeURL returns the link unsubscribing a user from a notification category, for notification emails.
+//
+// Parameters:
+//   - ctx: The context of the notification; its tenant becomes part of the token.
+//   - userID: The UUID of the recipient.
+//   - category: The category of the notification.
+//
+// Returns:
+//   - The absolute URL of the link, or an empty string if no base URL or secret is configured.
+func (s *Service) UnsubscribeURL(ctx context.Context, userID uuid.UUID, category string) string {
+	if s.baseURL == "" || len(s.secret) == 0 {
+		return ""
+	}
+
+	return s.baseURL + "/unsubscribe?token=" + url.QueryEscape(s.token(ctx, userID, category))
+}
+
+// token builds the signed token of a user and category: the optional tenant, the encoded user and category,
+// and the HMAC-SHA256 of both, separated by dots.
+func (s *Service) token(ctx context.Context, userID uuid.UUID, category string) string {
+	signed := base64.RawURLEncoding.EncodeToString([]byte(userID.String() + ":" + category))
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		signed = tenantID + "." + signed
+	}
+
+	return signed + "." + s.sign(signed)
+}
+
+// parseToken verifies the signature of a token and returns its tenant, user and category.
+func (s *Service) parseToken(token string) (string, uuid.UUID, string, error) {
+	i := strings.LastIndex(token, ".")
+	if i < 0 || len(s.secret) == 0 {
+		return "", uuid.Nil, "", ErrInvalidToken
+	}
+
+	signed, signature := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(s.sign(signed))) {
+		return "", uuid.Nil, "", ErrInvalidToken
+	}
+
+	var tenantID string
+	payload := signed
+	if j := strings.LastIndex(signed, "."); j >= 0 {
+		tenantID, payload = signed[:j], signed[j+1:]
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", uuid.Nil, "", ErrInvalidToken
+	}
+
+	id, category, _ := strings.Cut(string(data), ":")
+	userID, err := uuid.Parse(id)
+	if err != nil || !slices.Contains(model.NotificationCategories, category) {
+		return "", uuid.Nil, "", ErrInvalidToken
+	}
+
+	return tenantID, userID, category, nil
+}
+
+// sign returns the encoded HMAC-SHA256 of data with the secret.
+func (s *Service) sign(data string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
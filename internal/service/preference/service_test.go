@@ -0,0 +1,134 @@
+package preference
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	preferencerepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/preference"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var testConfig = config.Unsubscribe{BaseURL: "https://calendar.example.com/", Secret: "secret"}
+
+// tokenOf extracts the token of an unsubscribe link.
+func tokenOf(t *testing.T, link string) string {
+	t.Helper()
+
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("invalid link %q: %v", link, err)
+	}
+	if u.Host != "calendar.example.com" || u.Path != "/unsubscribe" {
+		t.Fatalf("unexpected link %q", link)
+	}
+	return u.Query().Get("token")
+}
+
+func TestService_Unsubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := preferencerepomocks.NewMockpreferenceRepo(ctrl)
+	svc := New(mockRepo, testConfig)
+
+	userID := uuid.New()
+	now := time.Now()
+	token := tokenOf(t, svc.UnsubscribeURL(tenancy.WithTenant(context.Background(), "acme"), userID, model.NotificationReminders))
+
+	// The tenant of the token routes the update, as the link is opened without a tenant header.
+	mockRepo.EXPECT().
+		SetPreference(gomock.Any(), userID, model.NotificationReminders, false).
+		DoAndReturn(func(ctx context.Context, _ uuid.UUID, category string, subscribed bool) (model.NotificationPreference, error) {
+			if tenantID, _ := tenancy.FromContext(ctx); tenantID != "acme" {
+				t.Fatalf("expected tenant acme, got %q", tenantID)
+			}
+			return model.NotificationPreference{Category: category, Subscribed: subscribed, UpdatedAt: &now}, nil
+		})
+	mockRepo.EXPECT().
+		ListPreferences(gomock.Any(), userID).
+		Return([]model.NotificationPreference{{Category: model.NotificationReminders, Subscribed: false, UpdatedAt: &now}}, nil)
+
+	u, err := svc.Unsubscribe(context.Background(), token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u.Category != model.NotificationReminders || len(u.Preferences) != len(model.NotificationCategories) || u.Preferences[0].Subscribed {
+		t.Fatalf("expected to be unsubscribed from reminders, got %+v", u)
+	}
+}
+
+func TestService_Unsubscribe_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(preferencerepomocks.NewMockpreferenceRepo(ctrl), testConfig)
+	token := tokenOf(t, svc.UnsubscribeURL(context.Background(), uuid.New(), model.NotificationReminders))
+
+	// Another user's ID with the original signature.
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := tokenOf(t, svc.UnsubscribeURL(context.Background(), uuid.New(), model.NotificationReminders))
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	other := New(nil, config.Unsubscribe{BaseURL: testConfig.BaseURL, Secret: "other"})
+
+	tokens := map[string]string{
+		"empty":          "",
+		"no signature":   payload,
+		"swapped user":   forgedPayload + "." + signature,
+		"other secret":   tokenOf(t, other.UnsubscribeURL(context.Background(), uuid.New(), model.NotificationReminders)),
+		"added tenant":   "acme." + token,
+		"unknown format": "a.b.c",
+	}
+	for name, token := range tokens {
+		t.Run(name, func(t *testing.T) {
+			if _, err := svc.Unsubscribe(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+				t.Fatalf("expected ErrInvalidToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestService_UnsubscribeURL_NotConfigured(t *testing.T) {
+	svc := New(nil, config.Unsubscribe{Secret: "secret"})
+
+	if link := svc.UnsubscribeURL(context.Background(), uuid.New(), model.NotificationReminders); link != "" {
+		t.Fatalf("expected no link without a base URL, got %q", link)
+	}
+}
+
+func TestService_Preferences_Defaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := preferencerepomocks.NewMockpreferenceRepo(ctrl)
+	svc := New(mockRepo, testConfig)
+
+	userID := uuid.New()
+	mockRepo.EXPECT().ListPreferences(gomock.Any(), userID).Return(nil, nil)
+
+	ok, err := svc.Subscribed(context.Background(), userID, model.NotificationReminders)
+	if err != nil || !ok {
+		t.Fatalf("expected users to be subscribed by default, got %v, %v", ok, err)
+	}
+}
+
+func TestService_SetSubscribed_UnknownCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(preferencerepomocks.NewMockpreferenceRepo(ctrl), testConfig)
+
+	if _, err := svc.SetSubscribed(context.Background(), uuid.New(), "newsletter", false); !errors.Is(err, ErrUnknownCategory) {
+		t.Fatalf("expected ErrUnknownCategory, got %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/project/mock_project.go -package=mocks
+
+// projectRepo defines the interface for project-related database operations.
+type projectRepo interface {
+	// CreateProject inserts a new project and returns its ID.
+	CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error)
+
+	// GetProject retrieves a project by its ID for the specified user.
+	GetProject(ctx context.Context, projectID, userID uuid.UUID) (model.Project, error)
+
+	// ListProjects retrieves all projects of a user.
+	ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+
+	// DeleteProject deletes a project and its tasks, keeping its events.
+	DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error
+
+	// CreateTask inserts a new task into a project of the specified user.
+	CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error)
+
+	// SetTaskDone marks a task as completed or not completed.
+	SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error
+
+	// ListTasks retrieves all tasks of a project.
+	ListTasks(ctx context.Context, projectID uuid.UUID) ([]model.ProjectTask, error)
+
+	// ListEvents retrieves the events assigned to a project.
+	ListEvents(ctx context.Context, projectID uuid.UUID) ([]model.Event, error)
+
+	// ListEventLinks retrieves the links of the events assigned to a project.
+	ListEventLinks(ctx context.Context, projectID uuid.UUID) ([]model.EventLink, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for projects.
+// It groups events and tasks of a project and builds its timeline and progress.
+type Service struct {
+	projectRepo projectRepo   // Repository for project database operations
+	cipher      contentCipher // Decryption of event titles
+}
+
+// New creates a new Service instance with the provided project repository and content cipher.
+//
+// Parameters:
+//   - r: The project repository for database operations.
+//   - c: The cipher for event titles shown on timelines.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r projectRepo, c contentCipher) *Service {
+	return &Service{
+		projectRepo: r,
+		cipher:      c,
+	}
+}
+
+// CreateProject creates a new project and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - project: The project to create; UserID and Name must be set.
+//
+// Returns:
+//   - The UUID of the created project.
+//   - An error if the creation fails.
+func (s *Service) CreateProject(ctx context.Context, project model.Project) (uuid.UUID, error) {
+	id, err := s.projectRepo.CreateProject(ctx, project)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create project: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListProjects retrieves all projects of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of projects.
+//   - An error if the retrieval fails.
+func (s *Service) ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	projects, err := s.projectRepo.ListProjects(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+
+	return projects, nil
+}
+
+// DeleteProject deletes a project and its tasks. Its events are kept.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - projectID: The UUID of the project to delete.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error {
+	if err := s.projectRepo.DeleteProject(ctx, projectID, userID); err != nil {
+		return fmt.Errorf("delete project: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTask adds a task to a project and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - task: The task to create; ProjectID and Title must be set.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - The UUID of the created task.
+//   - An error if the creation fails.
+func (s *Service) CreateTask(ctx context.Context, task model.ProjectTask, userID uuid.UUID) (uuid.UUID, error) {
+	id, err := s.projectRepo.CreateTask(ctx, task, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create task: %w", err)
+	}
+
+	return id, nil
+}
+
+// SetTaskDone marks a task of a project as completed or not completed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - projectID: The UUID of the project the task belongs to.
+//   - taskID: The UUID of the task.
+//   - userID: The UUID of the user who owns the project.
+//   - done: Whether the task is completed.
+//
+// Returns:
+//   - An error if the update fails.
+func (s *Service) SetTaskDone(ctx context.Context, projectID, taskID, userID uuid.UUID, done bool) error {
+	if err := s.projectRepo.SetTaskDone(ctx, projectID, taskID, userID, done); err != nil {
+		return fmt.Errorf("set task done: %w", err)
+	}
+
+	return nil
+}
+
+// GetTimeline builds the timeline of a project: its events, tasks and milestone ordered by date,
+// with event dependencies taken from event links. Progress is the share of completed items,
+// where an event is completed once it has taken place.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - projectID: The UUID of the project.
+//   - userID: The UUID of the user who owns the project.
+//
+// Returns:
+//   - The project timeline.
+//   - An error if the retrieval fails.
+func (s *Service) GetTimeline(ctx context.Context, projectID, userID uuid.UUID) (model.ProjectTimeline, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID, userID)
+	if err != nil {
+		return model.ProjectTimeline{}, fmt.Errorf("get project: %w", err)
+	}
+
+	events, err := s.projectRepo.ListEvents(ctx, projectID)
+	if err != nil {
+		return model.ProjectTimeline{}, fmt.Errorf("list project events: %w", err)
+	}
+
+	for i := range events {
+		if events[i].Title, err = s.cipher.Decrypt(ctx, userID, events[i].Title); err != nil {
+			return model.ProjectTimeline{}, fmt.Errorf("decrypt project events: %w", err)
+		}
+	}
+
+	links, err := s.projectRepo.ListEventLinks(ctx, projectID)
+	if err != nil {
+		return model.ProjectTimeline{}, fmt.Errorf("list project event links: %w", err)
+	}
+
+	tasks, err := s.projectRepo.ListTasks(ctx, projectID)
+	if err != nil {
+		return model.ProjectTimeline{}, fmt.Errorf("list project tasks: %w", err)
+	}
+
+	return buildTimeline(project, events, links, tasks, time.Now()), nil
+}
+
+// buildTimeline assembles a project timeline from its parts.
+// Items without a date (tasks without a due date) are placed last.
+func buildTimeline(project model.Project, events []model.Event, links []model.EventLink, tasks []model.ProjectTask, now time.Time) model.ProjectTimeline {
+	dependsOn := make(map[uuid.UUID][]uuid.UUID, len(links))
+	for _, l := range links {
+		dependsOn[l.EventID] = append(dependsOn[l.EventID], l.RelatedEventID)
+	}
+
+	items := make([]model.TimelineItem, 0, len(events)+len(tasks)+1)
+	completed := 0
+
+	for _, e := range events {
+		date := e.EventDate
+		done := date.Before(now)
+		if done {
+			completed++
+		}
+
+		deps := dependsOn[e.ID]
+		if deps == nil {
+			deps = []uuid.UUID{}
+		}
+
+		items = append(items, model.TimelineItem{
+			ID:        e.ID,
+			Kind:      model.TimelineEvent,
+			Title:     e.Title,
+			Start:     &date,
+			Done:      done,
+			DependsOn: deps,
+		})
+	}
+
+	for _, t := range tasks {
+		if t.Done {
+			completed++
+		}
+
+		items = append(items, model.TimelineItem{
+			ID:        t.ID,
+			Kind:      model.TimelineTask,
+			Title:     t.Title,
+			Start:     t.DueDate,
+			Done:      t.Done,
+			DependsOn: []uuid.UUID{},
+		})
+	}
+
+	var progress float64
+	if total := len(events) + len(tasks); total > 0 {
+		progress = float64(completed) / float64(total)
+	}
+
+	if project.MilestoneDate != nil {
+		items = append(items, model.TimelineItem{
+			ID:        project.ID,
+			Kind:      model.TimelineMilestone,
+			Title:     project.Name,
+			Start:     project.MilestoneDate,
+			Done:      progress == 1,
+			DependsOn: []uuid.UUID{},
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].Start, items[j].Start
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	return model.ProjectTimeline{
+		Project:  project,
+		Progress: progress,
+		Items:    items,
+	}
+}
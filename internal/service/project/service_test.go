@@ -0,0 +1,85 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	projectrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/project"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestService_GetTimeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := projectrepomocks.NewMockprojectRepo(ctrl)
+	svc := New(mockRepo, encryption.Disabled())
+
+	now := time.Now()
+	milestone := now.Add(72 * time.Hour)
+	due := now.Add(24 * time.Hour)
+	project := model.Project{ID: uuid.New(), UserID: uuid.New(), Name: "Launch", MilestoneDate: &milestone}
+
+	kickoff := model.Event{ID: uuid.New(), Title: "Kickoff", EventDate: now.Add(-time.Hour)}
+	review := model.Event{ID: uuid.New(), Title: "Review", EventDate: now.Add(48 * time.Hour)}
+	links := []model.EventLink{{EventID: review.ID, RelatedEventID: kickoff.ID, Type: model.LinkBlockedBy}}
+	tasks := []model.ProjectTask{
+		{ID: uuid.New(), Title: "Draft", DueDate: &due, Done: true},
+		{ID: uuid.New(), Title: "Backlog"},
+	}
+
+	mockRepo.EXPECT().GetProject(gomock.Any(), project.ID, project.UserID).Return(project, nil)
+	mockRepo.EXPECT().ListEvents(gomock.Any(), project.ID).Return([]model.Event{kickoff, review}, nil)
+	mockRepo.EXPECT().ListEventLinks(gomock.Any(), project.ID).Return(links, nil)
+	mockRepo.EXPECT().ListTasks(gomock.Any(), project.ID).Return(tasks, nil)
+
+	timeline, err := svc.GetTimeline(context.Background(), project.ID, project.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Kickoff is over and Draft is done: 2 of 4 items.
+	if timeline.Progress != 0.5 {
+		t.Fatalf("expected progress 0.5, got %v", timeline.Progress)
+	}
+
+	wantOrder := []string{"Kickoff", "Draft", "Review", "Launch", "Backlog"}
+	if len(timeline.Items) != len(wantOrder) {
+		t.Fatalf("expected %d items, got %d", len(wantOrder), len(timeline.Items))
+	}
+	for i, title := range wantOrder {
+		if timeline.Items[i].Title != title {
+			t.Fatalf("item %d: expected %q, got %q", i, title, timeline.Items[i].Title)
+		}
+	}
+
+	if deps := timeline.Items[2].DependsOn; len(deps) != 1 || deps[0] != kickoff.ID {
+		t.Fatalf("expected Review to depend on Kickoff, got %v", deps)
+	}
+	if timeline.Items[3].Kind != model.TimelineMilestone || timeline.Items[3].Done {
+		t.Fatalf("expected an open milestone, got %+v", timeline.Items[3])
+	}
+}
+
+func TestService_GetTimeline_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := projectrepomocks.NewMockprojectRepo(ctrl)
+	svc := New(mockRepo, encryption.Disabled())
+
+	notFound := errors.New("project not found")
+	mockRepo.EXPECT().GetProject(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Project{}, notFound)
+
+	_, err := svc.GetTimeline(context.Background(), uuid.New(), uuid.New())
+	if !errors.Is(err, notFound) {
+		t.Fatalf("expected wrapped not found error, got %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package proposal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	proposalrepo "github.com/aliskhannn/calendar-service/internal/repository/proposal"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/proposal/mock_proposal.go -package=mocks
+
+var (
+	ErrInvalidEnd     = errors.New("proposed end must be after the proposed start")
+	ErrRecurringEvent = errors.New("new times cannot be proposed for recurring events")
+)
+
+// timeFormat is the format of proposed times in notifications.
+const timeFormat = "Mon, 2 Jan 2006 15:04 MST"
+
+// transitions lists the statuses a proposal can move to from each status; accepted and declined are final.
+var transitions = map[string][]string{
+	model.ProposalPending: {model.ProposalAccepted, model.ProposalDeclined},
+}
+
+// proposalRepo defines the interface for proposal-related database operations.
+type proposalRepo interface {
+	// GetAttendedEvent retrieves an event the user is invited to and has not declined.
+	GetAttendedEvent(ctx context.Context, eventID, userID uuid.UUID) (model.Event, error)
+
+	// CreateProposal stores a new time proposed by an attendee, replacing their pending proposal.
+	CreateProposal(ctx context.Context, p model.Proposal) (model.Proposal, error)
+
+	// ListProposals retrieves the proposals of an event visible to the user.
+	ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error)
+
+	// GetProposal retrieves a proposal for an event of the owner, together with the event.
+	GetProposal(ctx context.Context, proposalID, eventID, ownerID uuid.UUID) (model.Proposal, model.Event, error)
+
+	// AcceptProposal accepts a pending proposal and moves its event to the proposed time.
+	AcceptProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error)
+
+	// DeclineProposal declines a pending proposal.
+	DeclineProposal(ctx context.Context, proposalID uuid.UUID) (model.Proposal, error)
+
+	// AttendeeEmails retrieves the email addresses of the attendees of an event who have not declined it.
+	AttendeeEmails(ctx context.Context, eventID uuid.UUID) ([]string, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// sender defines the delivery of email notifications.
+type sender interface {
+	// Send sends a plain text email. The tenant in ctx, if any, is attached to the message.
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// userService defines the lookup of the addresses notifications are sent to.
+type userService interface {
+	// GetByID retrieves a user by their unique ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+}
+
+// Service manages business logic for proposals, new times attendees propose for the events of another user.
+// Proposals move from pending to accepted or declined once; the owner is notified of new proposals,
+// and the attendees of the decisions.
+type Service struct {
+	proposalRepo proposalRepo  // Repository for proposal database operations
+	cipher       contentCipher // Decryption of event titles for notifications
+	sender       sender        // Email delivery of notifications
+	users        userService   // Lookup of the addresses of owners and attendees
+	logger       *zap.Logger   // Logger for notifications that cannot be delivered
+}
+
+// New creates a new Service instance with the provided proposal repository, content cipher, email sender,
+// user service, and logger.
+//
+// Parameters:
+//   - r: The proposal repository for database operations.
+//   - c: The cipher for event titles.
+//   - snd: The email sender for notifications.
+//   - u: The user service resolving the addresses of notifications.
+//   - l: The logger for notifications that cannot be delivered.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r proposalRepo, c contentCipher, snd sender, u userService, l *zap.Logger) *Service {
+	return &Service{
+		proposalRepo: r,
+		cipher:       c,
+		sender:       snd,
+		users:        u,
+		logger:       l,
+	}
+}
+
+// Propose proposes a new time for an event the user attends and notifies the owner of the event,
+// with the endpoints to accept or decline it. A pending proposal of the user for the event is replaced.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - p: The proposal with the event, attendee, proposed date and optional end.
+//
+// Returns:
+//   - The proposal.
+//   - ErrInvalidEnd if the proposed end is not after the start, ErrRecurringEvent for recurring events,
+//     an error wrapping proposalrepo.ErrEventNotFound if the user does not attend the event,
+//     or an error if the proposal cannot be stored.
+func (s *Service) Propose(ctx context.Context, p model.Proposal) (model.Proposal, error) {
+	if p.EndDate != nil && !p.EndDate.After(p.EventDate) {
+		return model.Proposal{}, ErrInvalidEnd
+	}
+
+	event, err := s.proposalRepo.GetAttendedEvent(ctx, p.EventID, p.UserID)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("propose time: %w", err)
+	}
+
+	if event.RecurrenceRule != "" {
+		return model.Proposal{}, ErrRecurringEvent
+	}
+
+	p, err = s.proposalRepo.CreateProposal(ctx, p)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("propose time: %w", err)
+	}
+
+	proposer, err := s.users.GetByID(ctx, p.UserID)
+	if err != nil {
+		s.logger.Warn("failed to notify owner of proposal", zap.String("proposal_id", p.ID.String()), zap.Error(err))
+		return p, nil
+	}
+	p.Email = proposer.Email
+
+	title := s.title(ctx, event)
+	base := fmt.Sprintf("/api/events/%s/proposals/%s", p.EventID, p.ID)
+	body := fmt.Sprintf("%s proposes to move your event \"%s\" to %s.\n\nAccept: POST %s/accept\nDecline: POST %s/decline",
+		p.Email, title, formatTime(p.EventDate, p.EndDate), base, base)
+	s.notifyUser(ctx, event.UserID, "New time proposed: "+title, body)
+
+	return p, nil
+}
+
+// ListProposals retrieves the proposals of an event, newest first: all of them for its owner,
+// the user's own ones for an attendee.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user requesting the list.
+//
+// Returns:
+//   - A slice of proposals.
+//   - An error if the retrieval fails.
+func (s *Service) ListProposals(ctx context.Context, eventID, userID uuid.UUID) ([]model.Proposal, error) {
+	proposals, err := s.proposalRepo.ListProposals(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list proposals: %w", err)
+	}
+
+	return proposals, nil
+}
+
+// Accept accepts a pending proposal for an event of the owner: the event and its reminders move to the proposed time,
+// the other pending proposals are declined, and the attendees are notified of the new time.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - proposalID: The UUID of the proposal.
+//   - ownerID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The accepted proposal.
+//   - An error wrapping proposalrepo.ErrProposalNotFound, proposalrepo.ErrProposalDecided or,
+//     if the event was trashed meanwhile, proposalrepo.ErrEventNotFound; or an error if the update fails.
+func (s *Service) Accept(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error) {
+	p, event, err := s.decide(ctx, eventID, proposalID, ownerID, model.ProposalAccepted)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("accept proposal: %w", err)
+	}
+
+	emails, err := s.proposalRepo.AttendeeEmails(ctx, eventID)
+	if err != nil {
+		s.logger.Warn("failed to notify attendees of accepted proposal", zap.String("proposal_id", p.ID.String()), zap.Error(err))
+		return p, nil
+	}
+
+	title := s.title(ctx, event)
+	body := fmt.Sprintf("The event \"%s\" was moved to %s, as proposed by %s.", title, formatTime(p.EventDate, p.EndDate), p.Email)
+	for _, email := range emails {
+		s.notify(ctx, email, "Event moved: "+title, body)
+	}
+
+	return p, nil
+}
+
+// Decline declines a pending proposal for an event of the owner and notifies the attendee who made it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - proposalID: The UUID of the proposal.
+//   - ownerID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - The declined proposal.
+//   - An error wrapping proposalrepo.ErrProposalNotFound or proposalrepo.ErrProposalDecided,
+//     or an error if the update fails.
+func (s *Service) Decline(ctx context.Context, eventID, proposalID, ownerID uuid.UUID) (model.Proposal, error) {
+	p, event, err := s.decide(ctx, eventID, proposalID, ownerID, model.ProposalDeclined)
+	if err != nil {
+		return model.Proposal{}, fmt.Errorf("decline proposal: %w", err)
+	}
+
+	title := s.title(ctx, event)
+	body := fmt.Sprintf("Your proposal to move the event \"%s\" to %s was declined.", title, formatTime(p.EventDate, p.EndDate))
+	s.notify(ctx, p.Email, "Proposal declined: "+title, body)
+
+	return p, nil
+}
+
+// decide moves a proposal for an event of the owner to the given status, if its current status allows it.
+func (s *Service) decide(ctx context.Context, eventID, proposalID, ownerID uuid.UUID, status string) (model.Proposal, model.Event, error) {
+	p, event, err := s.proposalRepo.GetProposal(ctx, proposalID, eventID, ownerID)
+	if err != nil {
+		return model.Proposal{}, model.Event{}, err
+	}
+
+	if !slices.Contains(transitions[p.Status], status) {
+		return model.Proposal{}, model.Event{}, proposalrepo.ErrProposalDecided
+	}
+
+	var decided model.Proposal
+	if status == model.ProposalAccepted {
+		decided, err = s.proposalRepo.AcceptProposal(ctx, proposalID)
+	} else {
+		decided, err = s.proposalRepo.DeclineProposal(ctx, proposalID)
+	}
+	if err != nil {
+		return model.Proposal{}, model.Event{}, err
+	}
+
+	decided.Email = p.Email
+	return decided, event, nil
+}
+
+// title returns the decrypted title of an event; an undecryptable title is left out of notifications.
+func (s *Service) title(ctx context.Context, event model.Event) string {
+	title, err := s.cipher.Decrypt(ctx, event.UserID, event.Title)
+	if err != nil {
+		s.logger.Warn("failed to decrypt event title", zap.String("event_id", event.ID.String()), zap.Error(err))
+		return "(untitled)"
+	}
+	return title
+}
+
+// notifyUser sends a notification to the address of a user.
+func (s *Service) notifyUser(ctx context.Context, userID uuid.UUID, subject, body string) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Warn("failed to look up notification recipient", zap.String("user_id", userID.String()), zap.Error(err))
+		return
+	}
+	s.notify(ctx, user.Email, subject, body)
+}
+
+// notify sends a notification. Notifications are best effort: the decision is already stored,
+// so a delivery failure is logged instead of failing the request.
+func (s *Service) notify(ctx context.Context, to, subject, body string) {
+	if err := s.sender.Send(ctx, to, subject, body); err != nil {
+		s.logger.Warn("failed to send proposal notification", zap.String("to", to), zap.Error(err))
+	}
+}
+
+// formatTime formats a proposed time range in UTC for notifications.
+func formatTime(start time.Time, end *time.Time) string {
+	if end == nil {
+		return start.UTC().Format(timeFormat)
+	}
+	return start.UTC().Format(timeFormat) + " – " + end.UTC().Format(timeFormat)
+}
@@ -0,0 +1,120 @@
+package proposal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	proposalmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/proposal"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	proposalrepo "github.com/aliskhannn/calendar-service/internal/repository/proposal"
+)
+
+type mocks struct {
+	repo   *proposalmocks.MockproposalRepo
+	cipher *proposalmocks.MockcontentCipher
+	sender *proposalmocks.Mocksender
+	users  *proposalmocks.MockuserService
+}
+
+func newTestService(t *testing.T) (*Service, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		repo:   proposalmocks.NewMockproposalRepo(ctrl),
+		cipher: proposalmocks.NewMockcontentCipher(ctrl),
+		sender: proposalmocks.NewMocksender(ctrl),
+		users:  proposalmocks.NewMockuserService(ctrl),
+	}
+	return New(m.repo, m.cipher, m.sender, m.users, zap.NewNop()), m
+}
+
+func TestService_Propose_NotifiesOwner(t *testing.T) {
+	svc, m := newTestService(t)
+
+	eventID, ownerID, userID, proposalID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	p := model.Proposal{EventID: eventID, UserID: userID, EventDate: date}
+
+	m.repo.EXPECT().GetAttendedEvent(gomock.Any(), eventID, userID).Return(model.Event{ID: eventID, UserID: ownerID, Title: "enc"}, nil)
+	m.repo.EXPECT().CreateProposal(gomock.Any(), p).DoAndReturn(func(_ context.Context, p model.Proposal) (model.Proposal, error) {
+		p.ID, p.Status = proposalID, model.ProposalPending
+		return p, nil
+	})
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(&model.User{ID: userID, Email: "guest@example.com"}, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), ownerID).Return(&model.User{ID: ownerID, Email: "owner@example.com"}, nil)
+	m.cipher.EXPECT().Decrypt(gomock.Any(), ownerID, "enc").Return("Planning", nil)
+	m.sender.EXPECT().Send(gomock.Any(), "owner@example.com", "New time proposed: Planning", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, body string) error {
+			if !strings.Contains(body, "guest@example.com proposes") || !strings.Contains(body, "Mon, 20 Oct 2025 14:00 UTC") ||
+				!strings.Contains(body, "POST /api/events/"+eventID.String()+"/proposals/"+proposalID.String()+"/accept") {
+				t.Errorf("unexpected notification body %q", body)
+			}
+			return nil
+		})
+
+	created, err := svc.Propose(context.Background(), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != proposalID || created.Email != "guest@example.com" {
+		t.Fatalf("unexpected proposal %+v", created)
+	}
+}
+
+func TestService_Propose_Invalid(t *testing.T) {
+	svc, m := newTestService(t)
+
+	eventID, userID := uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+
+	if _, err := svc.Propose(context.Background(), model.Proposal{EventID: eventID, UserID: userID, EventDate: date, EndDate: &date}); !errors.Is(err, ErrInvalidEnd) {
+		t.Fatalf("expected ErrInvalidEnd, got %v", err)
+	}
+
+	m.repo.EXPECT().GetAttendedEvent(gomock.Any(), eventID, userID).Return(model.Event{RecurrenceRule: "FREQ=WEEKLY"}, nil)
+	if _, err := svc.Propose(context.Background(), model.Proposal{EventID: eventID, UserID: userID, EventDate: date}); !errors.Is(err, ErrRecurringEvent) {
+		t.Fatalf("expected ErrRecurringEvent, got %v", err)
+	}
+}
+
+func TestService_Accept_NotifiesAttendees(t *testing.T) {
+	svc, m := newTestService(t)
+
+	eventID, ownerID, proposalID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC)
+	pending := model.Proposal{ID: proposalID, EventID: eventID, Email: "guest@example.com", EventDate: date, Status: model.ProposalPending}
+
+	m.repo.EXPECT().GetProposal(gomock.Any(), proposalID, eventID, ownerID).Return(pending, model.Event{ID: eventID, UserID: ownerID, Title: "enc"}, nil)
+	m.repo.EXPECT().AcceptProposal(gomock.Any(), proposalID).Return(model.Proposal{ID: proposalID, EventID: eventID, EventDate: date, Status: model.ProposalAccepted}, nil)
+	m.repo.EXPECT().AttendeeEmails(gomock.Any(), eventID).Return([]string{"a@example.com", "guest@example.com"}, nil)
+	m.cipher.EXPECT().Decrypt(gomock.Any(), ownerID, "enc").Return("Planning", nil)
+	m.sender.EXPECT().Send(gomock.Any(), "a@example.com", "Event moved: Planning", gomock.Any()).Return(nil)
+	m.sender.EXPECT().Send(gomock.Any(), "guest@example.com", "Event moved: Planning", gomock.Any()).Return(errors.New("smtp down"))
+
+	p, err := svc.Accept(context.Background(), eventID, proposalID, ownerID)
+	if err != nil {
+		t.Fatalf("a failed notification must not fail the decision: %v", err)
+	}
+	if p.Status != model.ProposalAccepted || p.Email != "guest@example.com" {
+		t.Fatalf("unexpected proposal %+v", p)
+	}
+}
+
+func TestService_Decline_AlreadyDecided(t *testing.T) {
+	svc, m := newTestService(t)
+
+	eventID, ownerID, proposalID := uuid.New(), uuid.New(), uuid.New()
+	m.repo.EXPECT().GetProposal(gomock.Any(), proposalID, eventID, ownerID).
+		Return(model.Proposal{ID: proposalID, Status: model.ProposalAccepted}, model.Event{}, nil)
+
+	if _, err := svc.Decline(context.Background(), eventID, proposalID, ownerID); !errors.Is(err, proposalrepo.ErrProposalDecided) {
+		t.Fatalf("expected ErrProposalDecided, got %v", err)
+	}
+}
@@ -0,0 +1,471 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/timezone"
+)
+
+var (
+	ErrUnsupportedChannel = errors.New("notification channel is not supported")
+	ErrTestTooSoon        = errors.New("a test notification was sent recently")
+)
+
+// testEvent is the title of the sample event test notifications are sent for.
+const testEvent = "Example event"
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/reminder/mock_reminder.go -package=mocks
+
+// reminderRepo defines the interface for reminder-related database operations.
+type reminderRepo interface {
+	// ClaimDue locks a batch of due pending reminders for the given owner until the lease expires.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration, owner string) ([]model.Reminder, error)
+
+	// MarkSent marks a reminder leased to owner as delivered.
+	MarkSent(ctx context.Context, id uuid.UUID, owner string) error
+
+	// MarkSkipped marks a reminder leased to owner that is not sent because its user unsubscribed from reminders.
+	MarkSkipped(ctx context.Context, id uuid.UUID, owner string) error
+
+	// Retry keeps a reminder leased to owner pending and delays its redelivery until retryAt.
+	Retry(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error
+
+	// Defer keeps a reminder leased to owner pending until retryAt without counting the attempt.
+	Defer(ctx context.Context, id uuid.UUID, owner string, retryAt time.Time, reason string) error
+
+	// MarkFailed marks a reminder leased to owner as permanently failed.
+	MarkFailed(ctx context.Context, id uuid.UUID, owner, reason string) error
+
+	// QueueStats counts the pending reminders and finds the oldest one that is due.
+	QueueStats(ctx context.Context) (model.ReminderQueueStats, error)
+
+	// ListZoned retrieves the pending reminders set as a wall-clock time in a time zone.
+	ListZoned(ctx context.Context) ([]model.ZonedReminder, error)
+
+	// Reschedule moves a pending reminder to a new time.
+	Reschedule(ctx context.Context, id uuid.UUID, remindAt time.Time) error
+
+	// ListHistory retrieves a page of the sent and failed reminders of a user.
+	ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error)
+
+	// CountHistory counts the sent and failed reminders of a user, exactly or estimated.
+	CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error)
+
+	// DeleteHistory deletes the notification history of a user and returns the number of deleted reminders.
+	DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// DeleteHistoryEntry deletes a sent or failed reminder of a user.
+	DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error
+
+	// PurgeHistory deletes the notification history from before the given time.
+	PurgeHistory(ctx context.Context, before time.Time) (int, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// sender defines the delivery of email notifications.
+type sender interface {
+	// Send sends a plain text email. The tenant in ctx, if any, is attached to the message.
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// userService defines the lookup of the address notifications are sent to.
+type userService interface {
+	// GetByID retrieves a user by their unique ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+}
+
+// Service manages business logic for reminder delivery.
+// It claims due reminders in batches and applies the retry policy for failed deliveries.
+type Service struct {
+	reminderRepo reminderRepo    // Repository for reminder database operations
+	config       config.Reminder // Reminder dispatch configuration (batch size, lease, retries)
+	cipher       contentCipher   // Decryption of reminder messages copied from event titles
+	sender       sender          // Email delivery of test notifications
+	users        userService     // Lookup of the addresses test notifications are sent to
+	clock        clock.Clock     // Source of the current time for retry delays
+
+	mu        sync.Mutex              // guards lastTests
+	lastTests map[uuid.UUID]time.Time // time of the last test notification per user, within the cooldown
+}
+
+// New creates a new Service instance with the provided reminder repository, configuration, content cipher,
+// email sender, user service, and clock.
+//
+// Parameters:
+//   - r: The reminder repository for database operations.
+//   - cfg: The reminder dispatch configuration.
+//   - c: The cipher for reminder messages.
+//   - snd: The email sender for test notifications.
+//   - u: The user service resolving the addresses of test notifications.
+//   - clk: The clock retry delays are computed from.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r reminderRepo, cfg config.Reminder, c contentCipher, snd sender, u userService, clk clock.Clock) *Service {
+	return &Service{
+		reminderRepo: r,
+		config:       cfg,
+		cipher:       c,
+		sender:       snd,
+		users:        u,
+		clock:        clk,
+		lastTests:    make(map[uuid.UUID]time.Time),
+	}
+}
+
+// ClaimDue claims a batch of due reminders for the given worker instance.
+// Claimed reminders are leased to the owner, so other instances skip them until the lease expires.
+// Messages are returned decrypted. A reminder whose message cannot be decrypted is recorded as a failed
+// delivery attempt, as by MarkFailed, and left out, so it does not hold back the rest of the batch.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - owner: The identifier of the claiming worker instance.
+//
+// Returns:
+//   - A slice of claimed reminders.
+//   - An error if claiming fails, or an error for the reminders left out, along with the rest of the batch.
+func (s *Service) ClaimDue(ctx context.Context, owner string) ([]model.Reminder, error) {
+	reminders, err := s.reminderRepo.ClaimDue(ctx, s.config.BatchSize, s.config.LeaseDuration, owner)
+	if err != nil {
+		return nil, fmt.Errorf("claim due reminders: %w", err)
+	}
+
+	claimed := reminders[:0]
+	var errs []error
+	for _, r := range reminders {
+		if r.Message, err = s.cipher.Decrypt(ctx, r.UserID, r.Message); err != nil {
+			cause := fmt.Errorf("decrypt reminder message: %w", err)
+			if err := s.MarkFailed(ctx, r, owner, cause); err != nil {
+				cause = errors.Join(cause, err)
+			}
+			errs = append(errs, fmt.Errorf("reminder %s: %w", r.ID, cause))
+			continue
+		}
+		claimed = append(claimed, r)
+	}
+
+	return claimed, errors.Join(errs...)
+}
+
+// MarkSent records a successful delivery of a reminder.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the delivered reminder.
+//   - owner: The identifier of the worker instance that claimed the reminder.
+//
+// Returns:
+//   - An error wrapping reminderrepo.ErrReminderLost if the reminder is no longer leased to the owner,
+//     or another error if the update fails.
+func (s *Service) MarkSent(ctx context.Context, id uuid.UUID, owner string) error {
+	if err := s.reminderRepo.MarkSent(ctx, id, owner); err != nil {
+		return fmt.Errorf("mark reminder sent: %w", err)
+	}
+
+	return nil
+}
+
+// MarkSkipped records that a reminder is not sent because its user unsubscribed from reminders.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the skipped reminder.
+//   - owner: The identifier of the worker instance that claimed the reminder.
+//
+// Returns:
+//   - An error wrapping reminderrepo.ErrReminderLost if the reminder is no longer leased to the owner,
+//     or another error if the update fails.
+func (s *Service) MarkSkipped(ctx context.Context, id uuid.UUID, owner string) error {
+	if err := s.reminderRepo.MarkSkipped(ctx, id, owner); err != nil {
+		return fmt.Errorf("mark reminder skipped: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed delivery attempt.
+// The reminder is retried with a linear backoff until the maximum number of attempts is reached,
+// after which it is marked as permanently failed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - r: The reminder whose delivery failed.
+//   - owner: The identifier of the worker instance that claimed the reminder.
+//   - cause: The delivery error.
+//
+// Returns:
+//   - An error wrapping reminderrepo.ErrReminderLost if the reminder is no longer leased to the owner,
+//     or another error if the update fails.
+func (s *Service) MarkFailed(ctx context.Context, r model.Reminder, owner string, cause error) error {
+	if r.Attempts >= s.config.MaxAttempts {
+		if err := s.reminderRepo.MarkFailed(ctx, r.ID, owner, cause.Error()); err != nil {
+			return fmt.Errorf("mark reminder failed: %w", err)
+		}
+		return nil
+	}
+
+	retryAt := s.clock.Now().Add(time.Duration(r.Attempts) * s.config.RetryDelay)
+	if err := s.reminderRepo.Retry(ctx, r.ID, owner, retryAt, cause.Error()); err != nil {
+		return fmt.Errorf("retry reminder: %w", err)
+	}
+
+	return nil
+}
+
+// Defer queues a reminder that was not sent because its recipient domain is throttled.
+// The reminder is claimed again at retryAt, and the deferral does not count as a delivery attempt.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - r: The deferred reminder.
+//   - owner: The identifier of the worker instance that claimed the reminder.
+//   - retryAt: The earliest time the reminder is sent again.
+//   - cause: The throttling error.
+//
+// Returns:
+//   - An error wrapping reminderrepo.ErrReminderLost if the reminder is no longer leased to the owner,
+//     or another error if the update fails.
+func (s *Service) Defer(ctx context.Context, r model.Reminder, owner string, retryAt time.Time, cause error) error {
+	if err := s.reminderRepo.Defer(ctx, r.ID, owner, retryAt, cause.Error()); err != nil {
+		return fmt.Errorf("defer reminder: %w", err)
+	}
+
+	return nil
+}
+
+// QueueStats reports the backlog of pending reminders.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The queue statistics.
+//   - An error if the retrieval fails.
+func (s *Service) QueueStats(ctx context.Context) (model.ReminderQueueStats, error) {
+	stats, err := s.reminderRepo.QueueStats(ctx)
+	if err != nil {
+		return model.ReminderQueueStats{}, fmt.Errorf("get reminder queue stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// Resync recomputes the pending reminders that were set in a time zone from their wall-clock time,
+// so a change of the zone's DST rules in the bundled tz database does not shift them.
+// Reminders in zones that no longer exist keep their current time.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The number of rescheduled reminders.
+//   - An error if the retrieval or an update fails.
+func (s *Service) Resync(ctx context.Context) (int, error) {
+	reminders, err := s.reminderRepo.ListZoned(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list zoned reminders: %w", err)
+	}
+
+	updated := 0
+	for _, r := range reminders {
+		loc, err := time.LoadLocation(r.Timezone)
+		if err != nil {
+			continue
+		}
+
+		at := timezone.Resolve(r.LocalTime, loc)
+		if at.Equal(r.RemindAt) {
+			continue
+		}
+
+		if err := s.reminderRepo.Reschedule(ctx, r.ID, at); err != nil {
+			return updated, fmt.Errorf("reschedule reminder: %w", err)
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// ListHistory retrieves a page of the notification history of a user: the sent and failed reminders,
+// most recently due first. Messages are returned decrypted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - page: The page to return; one entry more than the limit is read.
+//
+// Returns:
+//   - A slice of history entries.
+//   - An error if the retrieval fails.
+func (s *Service) ListHistory(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.ReminderHistoryEntry, error) {
+	entries, err := s.reminderRepo.ListHistory(ctx, userID, page)
+	if err != nil {
+		return nil, fmt.Errorf("list reminder history: %w", err)
+	}
+
+	for i := range entries {
+		if entries[i].Message, err = s.cipher.Decrypt(ctx, userID, entries[i].Message); err != nil {
+			return nil, fmt.Errorf("decrypt reminder message: %w", err)
+		}
+	}
+
+	return entries, nil
+}
+
+// CountHistory counts the entries of the notification history of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of entries.
+//   - An error if the count fails.
+func (s *Service) CountHistory(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	n, err := s.reminderRepo.CountHistory(ctx, userID, mode)
+	if err != nil {
+		return 0, fmt.Errorf("count reminder history: %w", err)
+	}
+
+	return n, nil
+}
+
+// DeleteHistory deletes the notification history of a user, including the bounces and complaints
+// recorded for the user's email address. Pending reminders are not affected.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The number of deleted reminders.
+//   - An error if the deletion fails.
+func (s *Service) DeleteHistory(ctx context.Context, userID uuid.UUID) (int, error) {
+	deleted, err := s.reminderRepo.DeleteHistory(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("delete reminder history: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// DeleteHistoryEntry deletes a sent or failed reminder from the notification history of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the reminder.
+//   - userID: The UUID of the user who owns the reminder.
+//
+// Returns:
+//   - An error wrapping reminderrepo.ErrReminderNotFound if the user has no such entry,
+//     or another error if the deletion fails.
+func (s *Service) DeleteHistoryEntry(ctx context.Context, id, userID uuid.UUID) error {
+	if err := s.reminderRepo.DeleteHistoryEntry(ctx, id, userID); err != nil {
+		return fmt.Errorf("delete reminder history entry: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeHistory deletes the notification history older than the configured retention.
+// Without a retention, the history is kept and nothing is deleted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The number of deleted reminders.
+//   - An error if the deletion fails.
+func (s *Service) PurgeHistory(ctx context.Context) (int, error) {
+	if s.config.HistoryRetention <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := s.reminderRepo.PurgeHistory(ctx, s.clock.Now().Add(-s.config.HistoryRetention))
+	if err != nil {
+		return 0, fmt.Errorf("purge reminder history: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// SendTest immediately sends a test notification with a sample reminder through a channel,
+// so users can verify their notification setup. Test notifications bypass the reminder queue
+// and are not part of the notification history. Each user can send one per configured cooldown.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user to notify.
+//   - channel: The channel to send through; only "email" is supported.
+//
+// Returns:
+//   - The sent test notification.
+//   - The time until the next test notification is allowed when ErrTestTooSoon is returned.
+//   - ErrUnsupportedChannel for other channels, ErrTestTooSoon within the cooldown,
+//     or another error if the user cannot be found or sending fails.
+func (s *Service) SendTest(ctx context.Context, userID uuid.UUID, channel string) (model.TestNotification, time.Duration, error) {
+	if channel != model.ChannelEmail {
+		return model.TestNotification{}, 0, ErrUnsupportedChannel
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return model.TestNotification{}, 0, fmt.Errorf("send test notification: %w", err)
+	}
+
+	if wait := s.reserveTest(userID); wait > 0 {
+		return model.TestNotification{}, wait, ErrTestTooSoon
+	}
+
+	subject := fmt.Sprintf("Test notification: %s", testEvent)
+	body := fmt.Sprintf("🔔 Reminder: your event \"%s\" is coming up!\n\n"+
+		"This is a test notification. Your reminders will be delivered like this one.", testEvent)
+	if err := s.sender.Send(ctx, user.Email, subject, body); err != nil {
+		return model.TestNotification{}, 0, fmt.Errorf("send test notification: %w", err)
+	}
+
+	return model.TestNotification{Channel: channel, Recipient: user.Email, SentAt: s.clock.Now()}, 0, nil
+}
+
+// reserveTest records a test notification of a user unless the previous one is within the cooldown,
+// in which case it returns the time left. Entries older than the cooldown are dropped.
+func (s *Service) reserveTest(userID uuid.UUID) time.Duration {
+	if s.config.TestCooldown <= 0 {
+		return 0
+	}
+
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, at := range s.lastTests {
+		if now.Sub(at) >= s.config.TestCooldown {
+			delete(s.lastTests, id)
+		}
+	}
+
+	if at, ok := s.lastTests[userID]; ok {
+		return s.config.TestCooldown - now.Sub(at)
+	}
+
+	s.lastTests[userID] = now
+	return 0
+}
@@ -0,0 +1,239 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	reminderrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/reminder"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var testConfig = config.Reminder{
+	BatchSize:     50,
+	LeaseDuration: time.Minute,
+	MaxAttempts:   3,
+	RetryDelay:    time.Minute,
+}
+
+func TestService_ClaimDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+	svc := New(mockRepo, testConfig, encryption.Disabled(), nil, nil, clock.Real())
+
+	expected := []model.Reminder{{ID: uuid.New(), Message: "Test event"}}
+
+	mockRepo.EXPECT().
+		ClaimDue(gomock.Any(), testConfig.BatchSize, testConfig.LeaseDuration, "worker-1").
+		Return(expected, nil)
+
+	reminders, err := svc.ClaimDue(context.Background(), "worker-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reminders) != 1 || reminders[0].ID != expected[0].ID {
+		t.Fatalf("unexpected reminders: %v", reminders)
+	}
+}
+
+// failingCipher fails to decrypt the value bad and returns other values as they are.
+type failingCipher struct{}
+
+func (failingCipher) Decrypt(_ context.Context, _ uuid.UUID, value string) (string, error) {
+	if value == "bad" {
+		return "", errors.New("message authentication failed")
+	}
+	return value, nil
+}
+
+func TestService_ClaimDue_UndecryptableMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+	now := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	svc := New(mockRepo, testConfig, failingCipher{}, nil, nil, clock.NewFake(now))
+
+	bad := model.Reminder{ID: uuid.New(), Message: "bad", Attempts: 1}
+	good := model.Reminder{ID: uuid.New(), Message: "Standup", Attempts: 1}
+
+	mockRepo.EXPECT().
+		ClaimDue(gomock.Any(), testConfig.BatchSize, testConfig.LeaseDuration, "worker-1").
+		Return([]model.Reminder{bad, good}, nil)
+	// The undecryptable reminder is retried like a failed delivery instead of blocking the batch.
+	mockRepo.EXPECT().
+		Retry(gomock.Any(), bad.ID, "worker-1", now.Add(time.Minute), gomock.Any()).
+		Return(nil)
+
+	reminders, err := svc.ClaimDue(context.Background(), "worker-1")
+	if err == nil {
+		t.Fatal("expected an error for the undecryptable reminder")
+	}
+	if len(reminders) != 1 || reminders[0].ID != good.ID || reminders[0].Message != "Standup" {
+		t.Fatalf("unexpected reminders: %v", reminders)
+	}
+}
+
+func TestService_MarkFailed_Retries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+	now := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	svc := New(mockRepo, testConfig, encryption.Disabled(), nil, nil, clock.NewFake(now))
+
+	r := model.Reminder{ID: uuid.New(), Attempts: 2}
+
+	// Second attempt failed: linear backoff of two retry delays.
+	mockRepo.EXPECT().
+		Retry(gomock.Any(), r.ID, "worker-1", now.Add(2*time.Minute), "smtp down").
+		Return(nil)
+
+	if err := svc.MarkFailed(context.Background(), r, "worker-1", errors.New("smtp down")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_MarkFailed_GivesUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+	svc := New(mockRepo, testConfig, encryption.Disabled(), nil, nil, clock.Real())
+
+	r := model.Reminder{ID: uuid.New(), Attempts: testConfig.MaxAttempts}
+
+	mockRepo.EXPECT().
+		MarkFailed(gomock.Any(), r.ID, "worker-1", "smtp down").
+		Return(nil)
+
+	if err := svc.MarkFailed(context.Background(), r, "worker-1", errors.New("smtp down")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Resync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+	svc := New(mockRepo, testConfig, encryption.Disabled(), nil, nil, clock.Real())
+
+	local := time.Date(2030, 11, 4, 9, 0, 0, 0, time.UTC)
+	stale := model.ZonedReminder{
+		ID:        uuid.New(),
+		Timezone:  "Europe/Berlin",
+		LocalTime: local,
+		RemindAt:  time.Date(2030, 11, 4, 7, 0, 0, 0, time.UTC), // computed with the summer offset
+	}
+	current := model.ZonedReminder{
+		ID:        uuid.New(),
+		Timezone:  "Europe/Berlin",
+		LocalTime: local,
+		RemindAt:  time.Date(2030, 11, 4, 8, 0, 0, 0, time.UTC),
+	}
+	unknown := model.ZonedReminder{ID: uuid.New(), Timezone: "Mars/Olympus_Mons", LocalTime: local}
+
+	mockRepo.EXPECT().ListZoned(gomock.Any()).Return([]model.ZonedReminder{stale, current, unknown}, nil)
+	mockRepo.EXPECT().
+		Reschedule(gomock.Any(), stale.ID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, at time.Time) error {
+			if !at.Equal(current.RemindAt) {
+				t.Errorf("expected reminder rescheduled to %v, got %v", current.RemindAt, at)
+			}
+			return nil
+		})
+
+	updated, err := svc.Resync(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != 1 {
+		t.Fatalf("expected 1 rescheduled reminder, got %d", updated)
+	}
+}
+
+func TestService_PurgeHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := reminderrepomocks.NewMockreminderRepo(ctrl)
+
+	// Without a retention the history is kept.
+	svc := New(mockRepo, testConfig, encryption.Disabled(), nil, nil, clock.NewFake(now))
+	if n, err := svc.PurgeHistory(context.Background()); err != nil || n != 0 {
+		t.Fatalf("expected nothing to be purged, got %d, %v", n, err)
+	}
+
+	cfg := testConfig
+	cfg.HistoryRetention = 30 * 24 * time.Hour
+	svc = New(mockRepo, cfg, encryption.Disabled(), nil, nil, clock.NewFake(now))
+
+	mockRepo.EXPECT().PurgeHistory(gomock.Any(), now.AddDate(0, 0, -30)).Return(7, nil)
+
+	n, err := svc.PurgeHistory(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 7 {
+		t.Fatalf("expected 7 purged reminders, got %d", n)
+	}
+}
+
+func TestService_SendTest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+	mockSender := reminderrepomocks.NewMocksender(ctrl)
+	mockUsers := reminderrepomocks.NewMockuserService(ctrl)
+	cfg := testConfig
+	cfg.TestCooldown = time.Minute
+	svc := New(reminderrepomocks.NewMockreminderRepo(ctrl), cfg, encryption.Disabled(), mockSender, mockUsers, clk)
+
+	userID := uuid.New()
+	mockUsers.EXPECT().GetByID(gomock.Any(), userID).Return(&model.User{ID: userID, Email: "jane@example.com"}, nil).Times(3)
+	mockSender.EXPECT().Send(gomock.Any(), "jane@example.com", gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	sent, _, err := svc.SendTest(context.Background(), userID, model.ChannelEmail)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sent.Recipient != "jane@example.com" || !sent.SentAt.Equal(now) {
+		t.Fatalf("unexpected test notification: %+v", sent)
+	}
+
+	clk.Advance(20 * time.Second)
+	_, wait, err := svc.SendTest(context.Background(), userID, model.ChannelEmail)
+	if !errors.Is(err, ErrTestTooSoon) || wait != 40*time.Second {
+		t.Fatalf("expected ErrTestTooSoon with 40s left, got %v and %s", err, wait)
+	}
+
+	clk.Advance(40 * time.Second)
+	if _, _, err := svc.SendTest(context.Background(), userID, model.ChannelEmail); err != nil {
+		t.Fatalf("expected a test notification after the cooldown, got %v", err)
+	}
+}
+
+func TestService_SendTest_UnsupportedChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(reminderrepomocks.NewMockreminderRepo(ctrl), testConfig, encryption.Disabled(), nil, nil, clock.Real())
+
+	if _, _, err := svc.SendTest(context.Background(), uuid.New(), "telegram"); !errors.Is(err, ErrUnsupportedChannel) {
+		t.Fatalf("expected ErrUnsupportedChannel, got %v", err)
+	}
+}
@@ -0,0 +1,307 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/rrule"
+)
+
+const (
+	// defaultBatchSize is the number of members aggregated per run when none is configured.
+	defaultBatchSize = 100
+
+	// defaultWeeks is the number of weeks aggregated per member when none is configured.
+	defaultWeeks = 12
+
+	// defaultWorkdayStart and defaultWorkdayEnd bound the workday when it is not configured.
+	defaultWorkdayStart = 9 * time.Hour
+	defaultWorkdayEnd   = 17 * time.Hour
+
+	// workdays is the number of workdays of a week, Monday to Friday.
+	workdays = 5
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/report/mock_report.go -package=mocks
+
+// reportRepo defines the interface for meeting load database operations.
+type reportRepo interface {
+	// ListOutdatedMembers retrieves the group members aggregated before the given time or not at all.
+	ListOutdatedMembers(ctx context.Context, before time.Time, limit int) ([]model.User, error)
+
+	// ListMeetings retrieves the meetings of a user that may take place within a time range.
+	ListMeetings(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Event, error)
+
+	// SaveLoad replaces the weekly meeting load of a user.
+	SaveLoad(ctx context.Context, userID uuid.UUID, weeks []model.MeetingLoadWeek) error
+
+	// GetReport retrieves the weekly meeting load of the members of a group of the owner.
+	GetReport(ctx context.Context, groupID, ownerID uuid.UUID) ([]model.MemberMeetingLoad, error)
+}
+
+// Service manages the meeting load reports of attendee group owners: for every member of a group, the hours
+// spent in meetings per week, the share of the workdays this takes and the meetings held after hours.
+// The load is aggregated periodically rather than on every change, so reports may lag behind the calendars
+// by up to the aggregation interval.
+type Service struct {
+	repo   reportRepo    // Repository for the meeting load and the meetings it is aggregated from
+	config config.Report // Aggregation window, batch size and workday
+	clock  clock.Clock   // Source of the current week
+}
+
+// New creates a new Service instance with the provided dependencies.
+// Non-positive limits fall back to 100 members per run and 12 weeks per member,
+// and a workday that does not end after it starts falls back to 9:00 to 17:00.
+//
+// Parameters:
+//   - r: The report repository for database operations.
+//   - cfg: The aggregation window, batch size and workday.
+//   - clk: The clock the current week is taken from.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r reportRepo, cfg config.Report, clk clock.Clock) *Service {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.Weeks <= 0 {
+		cfg.Weeks = defaultWeeks
+	}
+	if cfg.WorkdayStart < 0 || cfg.WorkdayEnd <= cfg.WorkdayStart || cfg.WorkdayEnd > 24*time.Hour {
+		cfg.WorkdayStart, cfg.WorkdayEnd = defaultWorkdayStart, defaultWorkdayEnd
+	}
+
+	return &Service{
+		repo:   r,
+		config: cfg,
+		clock:  clk,
+	}
+}
+
+// GetMeetingReport retrieves the meeting load report of a group of the owner, with the most recent weeks
+// of every member.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - groupID: The UUID of the group.
+//   - ownerID: The UUID of the user who owns the group.
+//   - weeks: The number of most recent weeks reported per member; 0 or more than aggregated reports all of them.
+//
+// Returns:
+//   - The report.
+//   - An error if the owner has no group with this ID or the retrieval fails.
+func (s *Service) GetMeetingReport(ctx context.Context, groupID, ownerID uuid.UUID, weeks int) (model.MeetingReport, error) {
+	members, err := s.repo.GetReport(ctx, groupID, ownerID)
+	if err != nil {
+		return model.MeetingReport{}, fmt.Errorf("get meeting report: %w", err)
+	}
+
+	workday := s.config.WorkdayEnd - s.config.WorkdayStart
+	for i := range members {
+		if weeks > 0 && len(members[i].Weeks) > weeks {
+			members[i].Weeks = members[i].Weeks[:weeks]
+		}
+		for j := range members[i].Weeks {
+			w := &members[i].Weeks[j]
+			w.DayPercent = percent(time.Duration(w.WorkdayMinutes)*time.Minute, workdays*workday)
+		}
+	}
+
+	return model.MeetingReport{
+		GroupID:      groupID,
+		WorkdayStart: s.config.WorkdayStart,
+		WorkdayEnd:   s.config.WorkdayEnd,
+		Members:      members,
+	}, nil
+}
+
+// AggregateOutdated aggregates the meeting load of the group members last aggregated more than the configured
+// interval ago. At most the configured batch size of members is aggregated per call; a member whose aggregation
+// fails does not keep the others from being aggregated.
+//
+// Parameters:
+//   - ctx: The context for the operation; no further members are aggregated once it is done.
+//
+// Returns:
+//   - The number of aggregated members.
+//   - An error if the members cannot be listed, joining the errors of the members that failed otherwise.
+func (s *Service) AggregateOutdated(ctx context.Context) (int, error) {
+	members, err := s.repo.ListOutdatedMembers(ctx, s.clock.Now().Add(-s.config.Interval), s.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("aggregate outdated: %w", err)
+	}
+
+	aggregated := 0
+	var errs []error
+	for _, member := range members {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := s.Aggregate(ctx, member); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", member.ID, err))
+			continue
+		}
+		aggregated++
+	}
+
+	return aggregated, errors.Join(errs...)
+}
+
+// Aggregate computes the meeting load of a user for the configured number of weeks up to the current one,
+// in the time zone of the user, and stores it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - user: The user, with ID and Timezone set; an unknown time zone is taken as UTC.
+//
+// Returns:
+//   - An error if the meetings cannot be read or the load cannot be stored.
+func (s *Service) Aggregate(ctx context.Context, user model.User) error {
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	to := weekStart(s.clock.Now(), loc).AddDate(0, 0, 7)
+	from := to.AddDate(0, 0, -7*s.config.Weeks)
+
+	meetings, err := s.repo.ListMeetings(ctx, user.ID, from, to)
+	if err != nil {
+		return fmt.Errorf("aggregate: %w", err)
+	}
+
+	weeks := aggregate(meetings, from, s.config.Weeks, loc, s.config)
+	if err := s.repo.SaveLoad(ctx, user.ID, weeks); err != nil {
+		return fmt.Errorf("aggregate: %w", err)
+	}
+
+	return nil
+}
+
+// aggregate computes the load of the weeks starting at from, in loc, from the meetings starting in them.
+// Recurring meetings are expanded in loc, so they keep their wall-clock time across daylight saving changes.
+func aggregate(meetings []model.Event, from time.Time, weeks int, loc *time.Location, cfg config.Report) []model.MeetingLoadWeek {
+	load := make([]model.MeetingLoadWeek, weeks)
+	for i := range load {
+		load[i].Week = from.AddDate(0, 0, 7*i)
+	}
+	to := from.AddDate(0, 0, 7*weeks)
+
+	for _, m := range meetings {
+		for _, o := range occurrences(m, from, to, loc) {
+			i := int(weekStart(o.EventDate, loc).Sub(from).Hours()+12) / (7 * 24)
+			if i < 0 || i >= weeks {
+				continue
+			}
+
+			length := o.End().Sub(o.EventDate)
+			within := withinWorkdays(o.EventDate.In(loc), o.End().In(loc), cfg)
+
+			load[i].Meetings++
+			load[i].MeetingMinutes += int(length.Minutes())
+			load[i].WorkdayMinutes += int(within.Minutes())
+			if within < length {
+				load[i].AfterHours++
+			}
+		}
+	}
+
+	return load
+}
+
+// occurrences returns the occurrences of a meeting starting within [from, to), overridden occurrences at their
+// new time. Rules are validated when they are stored, so an unparsable rule only yields the first occurrence.
+func occurrences(m model.Event, from, to time.Time, loc *time.Location) []model.Event {
+	in := func(e model.Event) bool { return !e.EventDate.Before(from) && e.EventDate.Before(to) }
+
+	if m.RecurrenceRule == "" {
+		if in(m) {
+			return []model.Event{m}
+		}
+		return nil
+	}
+
+	rule, err := rrule.Parse(m.RecurrenceRule)
+	if err != nil {
+		if in(m) {
+			return []model.Event{m}
+		}
+		return nil
+	}
+
+	var result []model.Event
+	for _, o := range m.Overrides {
+		if slices.ContainsFunc(m.RecurrenceExceptions, o.Occurrence.Equal) || !rule.Includes(m.EventDate, o.Occurrence) {
+			continue
+		}
+		if occurrence := m.Override(o); in(occurrence) {
+			result = append(result, occurrence)
+		}
+	}
+
+	duration := m.End().Sub(m.EventDate)
+	for _, at := range rule.Between(m.EventDate.In(loc), from, to) {
+		overridden := slices.ContainsFunc(m.Overrides, func(o model.OccurrenceOverride) bool { return o.Occurrence.Equal(at) })
+		if overridden || slices.ContainsFunc(m.RecurrenceExceptions, at.Equal) {
+			continue
+		}
+		end := at.Add(duration)
+		result = append(result, model.Event{ID: m.ID, EventDate: at, EndDate: &end})
+	}
+
+	return result
+}
+
+// withinWorkdays returns how much of [start, end) falls within the workday of the days Monday to Friday,
+// in the location of start.
+func withinWorkdays(start, end time.Time, cfg config.Report) time.Duration {
+	var within time.Duration
+	for day := startOfDay(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+
+		from, to := day.Add(cfg.WorkdayStart), day.Add(cfg.WorkdayEnd)
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			within += to.Sub(from)
+		}
+	}
+
+	return within
+}
+
+// weekStart returns the start of the Monday of the week of t in loc.
+func weekStart(t time.Time, loc *time.Location) time.Time {
+	day := startOfDay(t.In(loc))
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// startOfDay returns the start of the day of t in its location.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// percent returns part as a percentage of total, rounded to one decimal.
+func percent(part, total time.Duration) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*1000) / 10
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	reportmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/report"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestService_Aggregate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 10, day, hour, minute, 0, 0, tokyo).UTC()
+	}
+	meeting := func(start, end time.Time) model.Event {
+		return model.Event{ID: uuid.New(), EventDate: start, EndDate: &end}
+	}
+
+	repo := reportmocks.NewMockreportRepo(ctrl)
+	svc := New(repo, config.Report{Weeks: 2}, clock.NewFake(at(22, 12, 0)))
+	userID := uuid.New()
+
+	standup := meeting(at(6, 16, 30), at(6, 17, 30))
+	standup.RecurrenceRule = "FREQ=WEEKLY"
+	standup.Overrides = []model.OccurrenceOverride{{Occurrence: at(20, 16, 30), EventDate: at(21, 10, 0)}}
+
+	from := time.Date(2025, 10, 13, 0, 0, 0, 0, tokyo)
+	repo.EXPECT().ListMeetings(gomock.Any(), userID, from, from.AddDate(0, 0, 14)).Return([]model.Event{
+		standup,
+		meeting(at(14, 10, 0), at(14, 11, 0)), // within the workday
+		meeting(at(18, 10, 0), at(18, 11, 0)), // on a Saturday
+	}, nil)
+
+	var saved []model.MeetingLoadWeek
+	repo.EXPECT().SaveLoad(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, weeks []model.MeetingLoadWeek) error {
+			saved = weeks
+			return nil
+		})
+
+	if err := svc.Aggregate(context.Background(), model.User{ID: userID, Timezone: "Asia/Tokyo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []model.MeetingLoadWeek{
+		// The standup ends after 17:00 and the Saturday meeting is outside the workday.
+		{Week: from, Meetings: 3, MeetingMinutes: 180, WorkdayMinutes: 90, AfterHours: 2},
+		// The standup was moved into the workday, keeping its length.
+		{Week: from.AddDate(0, 0, 7), Meetings: 1, MeetingMinutes: 60, WorkdayMinutes: 60},
+	}
+	if len(saved) != len(want) {
+		t.Fatalf("expected %d weeks, got %+v", len(want), saved)
+	}
+	for i := range want {
+		if !saved[i].Week.Equal(want[i].Week) {
+			t.Fatalf("expected week %v, got %v", want[i].Week, saved[i].Week)
+		}
+		saved[i].Week = want[i].Week
+		if saved[i] != want[i] {
+			t.Fatalf("expected %+v, got %+v", want[i], saved[i])
+		}
+	}
+}
+
+func TestService_AggregateOutdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 10, 22, 9, 0, 0, 0, time.UTC)
+	repo := reportmocks.NewMockreportRepo(ctrl)
+	svc := New(repo, config.Report{Interval: time.Hour, Weeks: 1}, clock.NewFake(now))
+
+	failing, ok := uuid.New(), uuid.New()
+	repo.EXPECT().ListOutdatedMembers(gomock.Any(), now.Add(-time.Hour), 100).
+		Return([]model.User{{ID: failing}, {ID: ok, Timezone: "Nowhere/Unknown"}}, nil)
+	repo.EXPECT().ListMeetings(gomock.Any(), failing, gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset"))
+	repo.EXPECT().ListMeetings(gomock.Any(), ok, gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().SaveLoad(gomock.Any(), ok, gomock.Len(1)).Return(nil)
+
+	aggregated, err := svc.AggregateOutdated(context.Background())
+	if err == nil {
+		t.Fatal("expected the error of the failing member")
+	}
+	if aggregated != 1 {
+		t.Fatalf("expected 1 aggregated member, got %d", aggregated)
+	}
+}
+
+func TestService_GetMeetingReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := reportmocks.NewMockreportRepo(ctrl)
+	svc := New(repo, config.Report{}, clock.NewFake(time.Now()))
+
+	groupID, ownerID := uuid.New(), uuid.New()
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	repo.EXPECT().GetReport(gomock.Any(), groupID, ownerID).Return([]model.MemberMeetingLoad{{
+		UserID: uuid.New(),
+		Weeks: []model.MeetingLoadWeek{
+			{Week: week, Meetings: 3, MeetingMinutes: 180, WorkdayMinutes: 120},
+			{Week: week.AddDate(0, 0, -7)},
+		},
+	}}, nil)
+
+	report, err := svc.GetMeetingReport(context.Background(), groupID, ownerID, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.WorkdayStart != 9*time.Hour || report.WorkdayEnd != 17*time.Hour {
+		t.Fatalf("expected the default workday, got %v to %v", report.WorkdayStart, report.WorkdayEnd)
+	}
+	if len(report.Members) != 1 || len(report.Members[0].Weeks) != 1 {
+		t.Fatalf("expected the most recent week of the member, got %+v", report.Members)
+	}
+	// 2 of the 40 workday hours of the week.
+	if got := report.Members[0].Weeks[0].DayPercent; got != 5 {
+		t.Fatalf("expected 5%% of the workdays in meetings, got %v", got)
+	}
+}
@@ -0,0 +1,85 @@
+package rule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// normalize checks the conditions and actions of a rule, trims its tags and drops duplicate tags.
+func normalize(rule *model.EventRule) error {
+	for _, c := range rule.Conditions {
+		switch c.Field {
+		case model.RuleFieldTitle, model.RuleFieldDescription, model.RuleFieldPriority:
+		default:
+			return fmt.Errorf("%w: %q", ErrUnknownField, c.Field)
+		}
+		switch c.Operator {
+		case model.RuleOpContains, model.RuleOpEquals, model.RuleOpStartsWith:
+		default:
+			return fmt.Errorf("%w: %q", ErrUnknownOperator, c.Operator)
+		}
+	}
+
+	rule.Tags = addTags(nil, rule.Tags)
+	if rule.Color == "" && len(rule.Tags) == 0 {
+		return ErrNoAction
+	}
+
+	return nil
+}
+
+// matches reports whether an event matches all conditions of a rule.
+// Text is compared case-insensitively and with surrounding whitespace ignored.
+func matches(rule model.EventRule, e model.Event) bool {
+	for _, c := range rule.Conditions {
+		var value string
+		switch c.Field {
+		case model.RuleFieldTitle:
+			value = e.Title
+		case model.RuleFieldDescription:
+			value = e.Description
+		case model.RuleFieldPriority:
+			value = e.Priority
+		}
+
+		value = strings.ToLower(strings.TrimSpace(value))
+		want := strings.ToLower(strings.TrimSpace(c.Value))
+
+		var ok bool
+		switch c.Operator {
+		case model.RuleOpContains:
+			ok = strings.Contains(value, want)
+		case model.RuleOpEquals:
+			ok = value == want
+		case model.RuleOpStartsWith:
+			ok = strings.HasPrefix(value, want)
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// apply gives an event the color of a rule unless it already has one, and adds the tags of the rule.
+func apply(rule model.EventRule, e *model.Event) {
+	if e.Color == "" {
+		e.Color = rule.Color
+	}
+	e.Tags = addTags(e.Tags, rule.Tags)
+}
+
+// addTags appends the trimmed, non-empty tags that are not in tags yet.
+func addTags(tags, add []string) []string {
+	for _, t := range add {
+		t = strings.TrimSpace(t)
+		if t != "" && !slices.Contains(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,261 @@
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+var (
+	ErrNoAction        = errors.New("rule must set a color or tags")
+	ErrInvalidRange    = errors.New("to must not be before from")
+	ErrRangeTooLong    = errors.New("date range too long")
+	ErrUnknownField    = errors.New("unknown rule field")
+	ErrUnknownOperator = errors.New("unknown rule operator")
+)
+
+const (
+	// maxPreviewEvents caps the number of matching events returned by a preview.
+	maxPreviewEvents = 100
+
+	// maxPreviewDays caps the date range a preview is evaluated against.
+	maxPreviewDays = 366
+
+	// defaultPreviewDays is the range of a preview without dates, starting today.
+	defaultPreviewDays = 30
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/rule/mock_rule.go -package=mocks
+
+// ruleRepo defines the interface for rule-related database operations.
+type ruleRepo interface {
+	// CreateRule inserts a new rule and returns its ID.
+	CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error)
+
+	// ListRules retrieves all rules of a user in evaluation order.
+	ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error)
+
+	// UpdateRule replaces a rule of the specified user.
+	UpdateRule(ctx context.Context, rule model.EventRule) error
+
+	// DeleteRule deletes a rule of the specified user.
+	DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error
+}
+
+// eventLister defines the retrieval of the existing events a preview is evaluated against.
+type eventLister interface {
+	// ListEvents retrieves the events of a user matching the filter.
+	ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for event rules.
+// Enabled rules are evaluated in order whenever an event is created or imported: the first matching
+// rule with a color colors an event created without one, and every matching rule adds its tags.
+// Existing events are not changed when rules change; a preview shows which of them a rule would match.
+type Service struct {
+	ruleRepo ruleRepo      // Repository for rule database operations
+	events   eventLister   // Existing events previews are evaluated against
+	cipher   contentCipher // Decryption of event titles and descriptions
+	clock    clock.Clock   // Source of the current time for the default preview range
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - r: The rule repository for database operations.
+//   - e: The source of the existing events previews are evaluated against.
+//   - c: The cipher for event titles and descriptions.
+//   - clk: The clock the default preview range starts at.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r ruleRepo, e eventLister, c contentCipher, clk clock.Clock) *Service {
+	return &Service{
+		ruleRepo: r,
+		events:   e,
+		cipher:   c,
+		clock:    clk,
+	}
+}
+
+// CreateRule saves a new rule and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - rule: The rule to create; UserID, Name and Conditions must be set.
+//
+// Returns:
+//   - The UUID of the created rule.
+//   - ErrNoAction, ErrUnknownField or ErrUnknownOperator if the rule is invalid,
+//     or another error if the creation fails.
+func (s *Service) CreateRule(ctx context.Context, rule model.EventRule) (uuid.UUID, error) {
+	if err := normalize(&rule); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := s.ruleRepo.CreateRule(ctx, rule)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create rule: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListRules retrieves all rules of a user in evaluation order.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of rules.
+//   - An error if the retrieval fails.
+func (s *Service) ListRules(ctx context.Context, userID uuid.UUID) ([]model.EventRule, error) {
+	rules, err := s.ruleRepo.ListRules(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// UpdateRule replaces an existing rule identified by its ID and owner.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - rule: The updated rule; ID and UserID identify the rule to update.
+//
+// Returns:
+//   - ErrNoAction, ErrUnknownField or ErrUnknownOperator if the rule is invalid,
+//     or another error if the update fails.
+func (s *Service) UpdateRule(ctx context.Context, rule model.EventRule) error {
+	if err := normalize(&rule); err != nil {
+		return err
+	}
+
+	if err := s.ruleRepo.UpdateRule(ctx, rule); err != nil {
+		return fmt.Errorf("update rule: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRule deletes a rule. Events it has colored or tagged are not affected.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - ruleID: The UUID of the rule to delete.
+//   - userID: The UUID of the user who owns the rule.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteRule(ctx context.Context, ruleID, userID uuid.UUID) error {
+	if err := s.ruleRepo.DeleteRule(ctx, ruleID, userID); err != nil {
+		return fmt.Errorf("delete rule: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyRules evaluates the enabled rules of the event's owner against an event that is about to be created.
+// The event must not be encrypted yet.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - event: The event; its Color and Tags are updated in place.
+//
+// Returns:
+//   - An error if the rules cannot be retrieved.
+func (s *Service) ApplyRules(ctx context.Context, event *model.Event) error {
+	rules, err := s.ruleRepo.ListRules(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("apply rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if rule.Enabled && matches(rule, *event) {
+			apply(rule, event)
+		}
+	}
+
+	return nil
+}
+
+// Preview evaluates a rule, which does not have to be saved, against the existing events of a user
+// and returns the events it matches with the color and tags it would give them. Nothing is changed.
+// At most 100 events are returned.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - rule: The rule to evaluate; it is treated as enabled.
+//   - from: The first day of the range; nil for today.
+//   - to: The last day of the range, inclusive; nil for 30 days after from.
+//
+// Returns:
+//   - The matching events in date order.
+//   - ErrNoAction, ErrUnknownField, ErrUnknownOperator, ErrInvalidRange or ErrRangeTooLong if the request is invalid,
+//     or another error if the events cannot be retrieved.
+func (s *Service) Preview(ctx context.Context, rule model.EventRule, from, to *time.Time) ([]model.RulePreview, error) {
+	if err := normalize(&rule); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = *from
+	}
+	end := start.AddDate(0, 0, defaultPreviewDays-1)
+	if to != nil {
+		end = *to
+	}
+	if end.Before(start) {
+		return nil, ErrInvalidRange
+	}
+	if end.Sub(start) >= maxPreviewDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: at most %d days", ErrRangeTooLong, maxPreviewDays)
+	}
+	end = end.AddDate(0, 0, 1)
+
+	// Text conditions are matched after decryption, so the limit cannot be pushed down.
+	events, err := s.events.ListEvents(ctx, rule.UserID, model.EventFilter{From: &start, To: &end})
+	if err != nil {
+		return nil, fmt.Errorf("preview rule: %w", err)
+	}
+
+	previews := make([]model.RulePreview, 0)
+	for _, e := range events {
+		if e.Title, err = s.cipher.Decrypt(ctx, rule.UserID, e.Title); err != nil {
+			return nil, fmt.Errorf("preview rule: %w", err)
+		}
+		if e.Description, err = s.cipher.Decrypt(ctx, rule.UserID, e.Description); err != nil {
+			return nil, fmt.Errorf("preview rule: %w", err)
+		}
+
+		if !matches(rule, e) {
+			continue
+		}
+
+		applied := e
+		applied.Tags = append([]string(nil), e.Tags...)
+		apply(rule, &applied)
+		previews = append(previews, model.RulePreview{Event: e, Color: applied.Color, Tags: applied.Tags})
+		if len(previews) == maxPreviewEvents {
+			break
+		}
+	}
+
+	return previews, nil
+}
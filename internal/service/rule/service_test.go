@@ -0,0 +1,173 @@
+package rule
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	rulemocks "github.com/aliskhannn/calendar-service/internal/mocks/service/rule"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// standup colors and tags events with "standup" in their title.
+var standup = model.EventRule{
+	Name:       "Standups",
+	Conditions: []model.RuleCondition{{Field: model.RuleFieldTitle, Operator: model.RuleOpContains, Value: "Standup"}},
+	Color:      "blue",
+	Tags:       []string{"work"},
+	Enabled:    true,
+}
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition model.RuleCondition
+		event     model.Event
+		want      bool
+	}{
+		{"contains ignores case", model.RuleCondition{Field: "title", Operator: "contains", Value: "STANDUP"}, model.Event{Title: "Daily standup"}, true},
+		{"contains", model.RuleCondition{Field: "title", Operator: "contains", Value: "standup"}, model.Event{Title: "Retro"}, false},
+		{"equals", model.RuleCondition{Field: "priority", Operator: "equals", Value: "high"}, model.Event{Priority: "high"}, true},
+		{"equals whole value", model.RuleCondition{Field: "title", Operator: "equals", Value: "standup"}, model.Event{Title: "Daily standup"}, false},
+		{"starts with", model.RuleCondition{Field: "description", Operator: "starts_with", Value: "zoom"}, model.Event{Description: " Zoom link"}, true},
+	}
+
+	for _, c := range cases {
+		rule := model.EventRule{Conditions: []model.RuleCondition{c.condition}}
+		if got := matches(rule, c.event); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+
+	// All conditions must match.
+	rule := model.EventRule{Conditions: []model.RuleCondition{
+		{Field: "title", Operator: "contains", Value: "standup"},
+		{Field: "priority", Operator: "equals", Value: "high"},
+	}}
+	if matches(rule, model.Event{Title: "standup", Priority: "normal"}) {
+		t.Error("expected no match when a condition fails")
+	}
+}
+
+func TestService_ApplyRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := rulemocks.NewMockruleRepo(ctrl)
+	svc := New(mockRepo, rulemocks.NewMockeventLister(ctrl), encryption.Disabled(), clock.Real())
+
+	userID := uuid.New()
+	disabled := standup
+	disabled.Color, disabled.Tags, disabled.Enabled = "red", []string{"ignored"}, false
+	meetings := model.EventRule{
+		Conditions: []model.RuleCondition{{Field: "title", Operator: "contains", Value: "daily"}},
+		Color:      "green",
+		Tags:       []string{"work", "recurring"},
+		Enabled:    true,
+	}
+
+	mockRepo.EXPECT().ListRules(gomock.Any(), userID).Return([]model.EventRule{disabled, standup, meetings}, nil).Times(2)
+
+	event := model.Event{UserID: userID, Title: "Daily standup", Tags: []string{"team"}}
+	if err := svc.ApplyRules(context.Background(), &event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Color != "blue" {
+		t.Errorf("expected the color of the first matching rule, got %q", event.Color)
+	}
+	if want := []string{"team", "work", "recurring"}; !reflect.DeepEqual(event.Tags, want) {
+		t.Errorf("expected tags %v, got %v", want, event.Tags)
+	}
+
+	// A color chosen by the user is kept.
+	event = model.Event{UserID: userID, Title: "Daily standup", Color: "#ff0000"}
+	if err := svc.ApplyRules(context.Background(), &event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Color != "#ff0000" {
+		t.Errorf("expected the color of the event to be kept, got %q", event.Color)
+	}
+}
+
+func TestService_CreateRule_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(rulemocks.NewMockruleRepo(ctrl), rulemocks.NewMockeventLister(ctrl), encryption.Disabled(), clock.Real())
+
+	noAction := standup
+	noAction.Color, noAction.Tags = "", []string{" "}
+	if _, err := svc.CreateRule(context.Background(), noAction); !errors.Is(err, ErrNoAction) {
+		t.Errorf("expected ErrNoAction, got %v", err)
+	}
+
+	unknown := standup
+	unknown.Conditions = []model.RuleCondition{{Field: "location", Operator: "contains", Value: "office"}}
+	if _, err := svc.CreateRule(context.Background(), unknown); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("expected ErrUnknownField, got %v", err)
+	}
+}
+
+func TestService_Preview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvents := rulemocks.NewMockeventLister(ctrl)
+	now := time.Date(2025, 10, 15, 13, 30, 0, 0, time.UTC)
+	svc := New(rulemocks.NewMockruleRepo(ctrl), mockEvents, encryption.Disabled(), clock.NewFake(now))
+
+	rule := standup
+	rule.UserID = uuid.New()
+	from := time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 14, 0, 0, 0, 0, time.UTC)
+
+	mockEvents.EXPECT().
+		ListEvents(gomock.Any(), rule.UserID, model.EventFilter{From: &from, To: &to}).
+		Return([]model.Event{
+			{Title: "Standup", Tags: []string{"team"}},
+			{Title: "Lunch"},
+			{Title: "standup (moved)", Color: "red"},
+		}, nil)
+
+	previews, err := svc.Preview(context.Background(), rule, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(previews) != 2 {
+		t.Fatalf("expected 2 matching events, got %d", len(previews))
+	}
+	if previews[0].Color != "blue" || !reflect.DeepEqual(previews[0].Tags, []string{"team", "work"}) {
+		t.Errorf("unexpected preview %+v", previews[0])
+	}
+	if !reflect.DeepEqual(previews[0].Event.Tags, []string{"team"}) {
+		t.Errorf("expected the stored event to be unchanged, got %v", previews[0].Event.Tags)
+	}
+	if previews[1].Color != "red" {
+		t.Errorf("expected the color of the event to be kept, got %q", previews[1].Color)
+	}
+}
+
+func TestService_Preview_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(rulemocks.NewMockruleRepo(ctrl), rulemocks.NewMockeventLister(ctrl), encryption.Disabled(), clock.Real())
+
+	from := time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)
+	before, tooLate := from.AddDate(0, 0, -1), from.AddDate(1, 0, 1)
+
+	if _, err := svc.Preview(context.Background(), standup, &from, &before); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+	if _, err := svc.Preview(context.Background(), standup, &from, &tooLate); !errors.Is(err, ErrRangeTooLong) {
+		t.Errorf("expected ErrRangeTooLong, got %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+package shortlink
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	shortlinkrepo "github.com/aliskhannn/calendar-service/internal/repository/shortlink"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var (
+	ErrInvalidExpiry = errors.New("expiry must be in the future and within the maximum lifetime")
+	ErrLinkExpired   = errors.New("short link expired")
+)
+
+const (
+	// defaultTTL and defaultMaxTTL are the link lifetimes used when none are configured.
+	defaultTTL    = 30 * 24 * time.Hour
+	defaultMaxTTL = 365 * 24 * time.Hour
+
+	// codeLength is the number of characters of a short code, without the tenant prefix.
+	codeLength = 8
+
+	// codeAttempts is how often a new code is drawn when the previous one is taken.
+	codeAttempts = 3
+
+	// codeAlphabet contains the characters of short codes.
+	codeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/shortlink/mock_shortlink.go -package=mocks
+
+// shortLinkRepo defines the interface for short-link-related database operations.
+type shortLinkRepo interface {
+	// CreateShortLink inserts a short link to an event of the user.
+	CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error)
+
+	// ListShortLinks retrieves the short links of an event of the user.
+	ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error)
+
+	// DeleteShortLink deletes a short link of an event of the user.
+	DeleteShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error
+
+	// GetLinkedEvent retrieves a short link together with the event it shares.
+	GetLinkedEvent(ctx context.Context, code string) (model.ShortLink, model.Event, error)
+
+	// CountAttending counts the people who accepted to attend an event.
+	CountAttending(ctx context.Context, eventID uuid.UUID) (int, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for short links sharing single events.
+// A link resolves until it expires or is revoked; what it shows depends on its visibility.
+// With tenancy enabled, the tenant is part of the code, since invitees cannot send a tenant header.
+type Service struct {
+	repo   shortLinkRepo    // Repository for short link database operations
+	cipher contentCipher    // Decryption of event titles and descriptions
+	config config.ShortLink // Expiry limits of links
+	clock  clock.Clock      // Source of the current time expiries are checked against
+}
+
+// New creates a new Service instance with the provided dependencies.
+// Non-positive lifetimes fall back to 30 days by default and one year at most.
+//
+// Parameters:
+//   - r: The short link repository for database operations.
+//   - c: The cipher for event titles and descriptions.
+//   - cfg: The expiry limits of links.
+//   - clk: The clock expiries are checked against.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r shortLinkRepo, c contentCipher, cfg config.ShortLink, clk clock.Clock) *Service {
+	if cfg.DefaultTTL <= 0 {
+		cfg.DefaultTTL = defaultTTL
+	}
+	if cfg.MaxTTL <= 0 {
+		cfg.MaxTTL = defaultMaxTTL
+	}
+
+	return &Service{
+		repo:   r,
+		cipher: c,
+		config: cfg,
+		clock:  clk,
+	}
+}
+
+// CreateShortLink creates a short link to an event with a new code.
+//
+// Parameters:
+//   - ctx: The context for the operation; its tenant becomes part of the code.
+//   - link: The link to create; EventID and UserID must be set. An empty Visibility defaults to basic,
+//     a zero ExpiresAt to the configured default lifetime.
+//
+// Returns:
+//   - The created link.
+//   - ErrInvalidExpiry if the expiry is in the past or beyond the maximum lifetime,
+//     an error wrapping shortlinkrepo.ErrEventNotFound if the user has no such event,
+//     or another error if the creation fails.
+func (s *Service) CreateShortLink(ctx context.Context, link model.ShortLink) (model.ShortLink, error) {
+	now := s.clock.Now()
+	if link.Visibility == "" {
+		link.Visibility = model.ShortLinkBasic
+	}
+	if link.ExpiresAt.IsZero() {
+		link.ExpiresAt = now.Add(s.config.DefaultTTL)
+	}
+	if !link.ExpiresAt.After(now) || link.ExpiresAt.After(now.Add(s.config.MaxTTL)) {
+		return model.ShortLink{}, ErrInvalidExpiry
+	}
+
+	var prefix string
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		prefix = tenantID + "."
+	}
+
+	for attempt := 1; ; attempt++ {
+		code, err := newCode()
+		if err != nil {
+			return model.ShortLink{}, fmt.Errorf("create short link: failed to generate code: %w", err)
+		}
+		link.Code = prefix + code
+
+		created, err := s.repo.CreateShortLink(ctx, link)
+		if errors.Is(err, shortlinkrepo.ErrCodeTaken) && attempt < codeAttempts {
+			continue
+		}
+		if err != nil {
+			return model.ShortLink{}, fmt.Errorf("create short link: %w", err)
+		}
+
+		return created, nil
+	}
+}
+
+// ListShortLinks retrieves the short links of an event, including expired ones.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - eventID: The UUID of the event.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - A slice of short links.
+//   - An error if the retrieval fails.
+func (s *Service) ListShortLinks(ctx context.Context, eventID, userID uuid.UUID) ([]model.ShortLink, error) {
+	links, err := s.repo.ListShortLinks(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list short links: %w", err)
+	}
+
+	return links, nil
+}
+
+// RevokeShortLink deletes a short link, so its code stops resolving immediately.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - code: The short code.
+//   - eventID: The UUID of the event the link belongs to.
+//   - userID: The UUID of the user who owns the event.
+//
+// Returns:
+//   - An error wrapping shortlinkrepo.ErrShortLinkNotFound if the event has no such link,
+//     or another error if the deletion fails.
+func (s *Service) RevokeShortLink(ctx context.Context, code string, eventID, userID uuid.UUID) error {
+	if err := s.repo.DeleteShortLink(ctx, code, eventID, userID); err != nil {
+		return fmt.Errorf("revoke short link: %w", err)
+	}
+
+	return nil
+}
+
+// Resolve retrieves what a short link shows of its event.
+// Busy links show only the date, basic links add the title and color,
+// and details links also show the description.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - code: The short code.
+//
+// Returns:
+//   - The shared event.
+//   - An error wrapping shortlinkrepo.ErrShortLinkNotFound for an unknown or revoked code,
+//     ErrLinkExpired for an expired one, or another error if the retrieval fails.
+func (s *Service) Resolve(ctx context.Context, code string) (model.SharedEvent, error) {
+	if i := strings.LastIndex(code, "."); i >= 0 {
+		ctx = tenancy.WithTenant(ctx, code[:i])
+	}
+
+	link, event, err := s.repo.GetLinkedEvent(ctx, code)
+	if err != nil {
+		// A code naming no or an unknown tenant is as unknown as a code that does not exist.
+		if errors.Is(err, tenancy.ErrNoTenant) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			err = shortlinkrepo.ErrShortLinkNotFound
+		}
+		return model.SharedEvent{}, fmt.Errorf("resolve short link: %w", err)
+	}
+
+	if !s.clock.Now().Before(link.ExpiresAt) {
+		return model.SharedEvent{}, ErrLinkExpired
+	}
+
+	shared := model.SharedEvent{
+		EventID:    link.EventID,
+		Visibility: link.Visibility,
+		EventDate:  event.EventDate,
+		ExpiresAt:  link.ExpiresAt,
+	}
+	if shared.Attending, err = s.repo.CountAttending(ctx, link.EventID); err != nil {
+		return model.SharedEvent{}, fmt.Errorf("resolve short link: %w", err)
+	}
+	if link.Visibility == model.ShortLinkBusy {
+		return shared, nil
+	}
+
+	if shared.Title, err = s.cipher.Decrypt(ctx, link.UserID, event.Title); err != nil {
+		return model.SharedEvent{}, fmt.Errorf("resolve short link: %w", err)
+	}
+	shared.Color = event.Color
+
+	if link.Visibility == model.ShortLinkDetails {
+		if shared.Description, err = s.cipher.Decrypt(ctx, link.UserID, event.Description); err != nil {
+			return model.SharedEvent{}, fmt.Errorf("resolve short link: %w", err)
+		}
+	}
+
+	return shared, nil
+}
+
+// newCode returns a random short code of codeLength characters from codeAlphabet.
+func newCode() (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+
+	var b strings.Builder
+	for range codeLength {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,140 @@
+package shortlink
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	shortlinkmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/shortlink"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	shortlinkrepo "github.com/aliskhannn/calendar-service/internal/repository/shortlink"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+var now = time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestService(t *testing.T) (*shortlinkmocks.MockshortLinkRepo, *Service) {
+	ctrl := gomock.NewController(t)
+	mockRepo := shortlinkmocks.NewMockshortLinkRepo(ctrl)
+	svc := New(mockRepo, encryption.Disabled(), config.ShortLink{DefaultTTL: 24 * time.Hour, MaxTTL: 48 * time.Hour},
+		clock.NewFake(now))
+	return mockRepo, svc
+}
+
+func TestService_CreateShortLink_Defaults(t *testing.T) {
+	mockRepo, svc := newTestService(t)
+
+	mockRepo.EXPECT().CreateShortLink(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, l model.ShortLink) (model.ShortLink, error) {
+			if l.Visibility != model.ShortLinkBasic {
+				t.Fatalf("expected basic visibility, got %q", l.Visibility)
+			}
+			if !l.ExpiresAt.Equal(now.Add(24 * time.Hour)) {
+				t.Fatalf("expected the default expiry, got %v", l.ExpiresAt)
+			}
+			return l, nil
+		})
+
+	ctx := tenancy.WithTenant(context.Background(), "acme")
+	link, err := svc.CreateShortLink(ctx, model.ShortLink{EventID: uuid.New(), UserID: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(link.Code, "acme.") || len(link.Code) != len("acme.")+codeLength {
+		t.Fatalf("expected a tenant-prefixed code, got %q", link.Code)
+	}
+}
+
+func TestService_CreateShortLink_RetriesTakenCode(t *testing.T) {
+	mockRepo, svc := newTestService(t)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().CreateShortLink(gomock.Any(), gomock.Any()).
+			Return(model.ShortLink{}, shortlinkrepo.ErrCodeTaken),
+		mockRepo.EXPECT().CreateShortLink(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, l model.ShortLink) (model.ShortLink, error) { return l, nil }),
+	)
+
+	if _, err := svc.CreateShortLink(context.Background(), model.ShortLink{EventID: uuid.New(), UserID: uuid.New()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_CreateShortLink_InvalidExpiry(t *testing.T) {
+	_, svc := newTestService(t)
+
+	for _, expiresAt := range []time.Time{now.Add(-time.Minute), now.Add(72 * time.Hour)} {
+		_, err := svc.CreateShortLink(context.Background(), model.ShortLink{ExpiresAt: expiresAt})
+		if !errors.Is(err, ErrInvalidExpiry) {
+			t.Fatalf("expected ErrInvalidExpiry for %v, got %v", expiresAt, err)
+		}
+	}
+}
+
+func TestService_Resolve_Visibility(t *testing.T) {
+	event := model.Event{Title: "Dinner", Description: "Bring wine", EventDate: now.Add(time.Hour), Color: "red"}
+
+	tests := []struct {
+		visibility  string
+		title       string
+		description string
+	}{
+		{model.ShortLinkBusy, "", ""},
+		{model.ShortLinkBasic, "Dinner", ""},
+		{model.ShortLinkDetails, "Dinner", "Bring wine"},
+	}
+	for _, tt := range tests {
+		mockRepo, svc := newTestService(t)
+		mockRepo.EXPECT().GetLinkedEvent(gomock.Any(), "Ab3dE9xY").
+			Return(model.ShortLink{Visibility: tt.visibility, ExpiresAt: now.Add(time.Hour)}, event, nil)
+		mockRepo.EXPECT().CountAttending(gomock.Any(), gomock.Any()).Return(3, nil)
+
+		shared, err := svc.Resolve(context.Background(), "Ab3dE9xY")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if shared.Title != tt.title || shared.Description != tt.description {
+			t.Fatalf("%s: unexpected content %q / %q", tt.visibility, shared.Title, shared.Description)
+		}
+		if !shared.EventDate.Equal(event.EventDate) {
+			t.Fatalf("%s: expected the event date", tt.visibility)
+		}
+		if shared.Attending != 3 {
+			t.Fatalf("%s: expected 3 attending, got %d", tt.visibility, shared.Attending)
+		}
+	}
+}
+
+func TestService_Resolve_Expired(t *testing.T) {
+	mockRepo, svc := newTestService(t)
+	mockRepo.EXPECT().GetLinkedEvent(gomock.Any(), gomock.Any()).
+		Return(model.ShortLink{Visibility: model.ShortLinkDetails, ExpiresAt: now}, model.Event{}, nil)
+
+	if _, err := svc.Resolve(context.Background(), "Ab3dE9xY"); !errors.Is(err, ErrLinkExpired) {
+		t.Fatalf("expected ErrLinkExpired, got %v", err)
+	}
+}
+
+func TestService_Resolve_UnknownTenant(t *testing.T) {
+	mockRepo, svc := newTestService(t)
+	mockRepo.EXPECT().GetLinkedEvent(gomock.Any(), "nope.Ab3dE9xY").
+		DoAndReturn(func(ctx context.Context, _ string) (model.ShortLink, model.Event, error) {
+			if tenantID, _ := tenancy.FromContext(ctx); tenantID != "nope" {
+				t.Fatalf("expected the tenant of the code, got %q", tenantID)
+			}
+			return model.ShortLink{}, model.Event{}, tenancy.ErrUnknownTenant
+		})
+
+	if _, err := svc.Resolve(context.Background(), "nope.Ab3dE9xY"); !errors.Is(err, shortlinkrepo.ErrShortLinkNotFound) {
+		t.Fatalf("expected ErrShortLinkNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package suggestion
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+const (
+	// maxSuggestedTags caps the number of tags suggested for one event.
+	maxSuggestedTags = 3
+
+	// minKeywordLength is the number of runes a word needs to count as a keyword.
+	minKeywordLength = 3
+)
+
+// stopWords are frequent words that say nothing about the tags or project of an event.
+var stopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true, "about": true,
+	"into": true, "our": true, "your": true, "this": true, "that": true, "call": true,
+}
+
+// keywords returns the distinct lower-cased keywords of a title.
+// Words shorter than three runes, numbers and stop words are skipped.
+func keywords(title string) []string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var result []string
+	for _, w := range words {
+		if len([]rune(w)) < minKeywordLength || stopWords[w] || isNumber(w) || slices.Contains(result, w) {
+			continue
+		}
+		result = append(result, w)
+	}
+
+	return result
+}
+
+// isNumber reports whether a word consists of digits only.
+func isNumber(w string) bool {
+	return strings.IndexFunc(w, func(r rune) bool { return !unicode.IsDigit(r) }) < 0
+}
+
+// build computes a model from events with decrypted titles.
+// Keywords found in fewer than minSupport events, or never together with a tag or project, are left out.
+func build(events []model.Event, minSupport int) model.SuggestionModel {
+	all := make(map[string]*model.KeywordStats)
+	for _, e := range events {
+		for _, kw := range keywords(e.Title) {
+			stats, ok := all[kw]
+			if !ok {
+				stats = &model.KeywordStats{}
+				all[kw] = stats
+			}
+			stats.Events++
+
+			for _, tag := range e.Tags {
+				if stats.Tags == nil {
+					stats.Tags = make(map[string]int)
+				}
+				stats.Tags[tag]++
+			}
+			if e.ProjectID != nil {
+				if stats.Projects == nil {
+					stats.Projects = make(map[uuid.UUID]int)
+				}
+				stats.Projects[*e.ProjectID]++
+			}
+		}
+	}
+
+	m := model.SuggestionModel{Events: len(events), Keywords: make(map[string]model.KeywordStats)}
+	for kw, stats := range all {
+		if stats.Events >= minSupport && (len(stats.Tags) > 0 || len(stats.Projects) > 0) {
+			m.Keywords[kw] = *stats
+		}
+	}
+
+	return m
+}
+
+// suggest scores the tags and projects of the model against the keywords of an event's title.
+// A candidate scores the highest share of events it had among the keywords it was found with,
+// and is suggested when that share reaches the configured confidence.
+func suggest(m model.SuggestionModel, event model.Event, cfg config.Suggestion) model.Suggestion {
+	tagScores := make(map[string]float64)
+	projectScores := make(map[uuid.UUID]float64)
+
+	for _, kw := range keywords(event.Title) {
+		stats, ok := m.Keywords[kw]
+		if !ok || stats.Events < cfg.MinSupport {
+			continue
+		}
+
+		for tag, n := range stats.Tags {
+			tagScores[tag] = max(tagScores[tag], float64(n)/float64(stats.Events))
+		}
+		for id, n := range stats.Projects {
+			projectScores[id] = max(projectScores[id], float64(n)/float64(stats.Events))
+		}
+	}
+
+	var suggestion model.Suggestion
+
+	for tag, score := range tagScores {
+		if score >= cfg.MinConfidence && !slices.Contains(event.Tags, tag) {
+			suggestion.Tags = append(suggestion.Tags, tag)
+		}
+	}
+	slices.SortFunc(suggestion.Tags, func(a, b string) int {
+		if tagScores[a] != tagScores[b] {
+			if tagScores[a] > tagScores[b] {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	if len(suggestion.Tags) > maxSuggestedTags {
+		suggestion.Tags = suggestion.Tags[:maxSuggestedTags]
+	}
+
+	if event.ProjectID == nil {
+		var best uuid.UUID
+		bestScore := 0.0
+		for id, score := range projectScores {
+			// Ties go to the smaller ID, so the suggestion does not depend on map order.
+			if score > bestScore || (score == bestScore && id.String() < best.String()) {
+				best, bestScore = id, score
+			}
+		}
+		if bestScore >= cfg.MinConfidence {
+			suggestion.ProjectID = &best
+		}
+	}
+
+	return suggestion
+}
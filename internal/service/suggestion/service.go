@@ -0,0 +1,230 @@
+package suggestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	suggestionrepo "github.com/aliskhannn/calendar-service/internal/repository/suggestion"
+)
+
+const (
+	// defaultBatchSize is the number of models recomputed per run when none is configured.
+	defaultBatchSize = 100
+
+	// defaultMaxEvents is the number of recent events a model is computed from when none is configured.
+	defaultMaxEvents = 1000
+
+	// defaultMinSupport is the number of events a keyword must appear in when none is configured.
+	defaultMinSupport = 2
+
+	// defaultMinConfidence is the share of events a tag or project needs when none is configured.
+	defaultMinConfidence = 0.5
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/suggestion/mock_suggestion.go -package=mocks
+
+// suggestionRepo defines the interface for suggestion model database operations.
+type suggestionRepo interface {
+	// ListOutdatedUsers retrieves users whose model is missing or older than their events.
+	ListOutdatedUsers(ctx context.Context, limit int) ([]uuid.UUID, error)
+
+	// ListRecentEvents retrieves the title, tags and project of the most recent events of a user.
+	ListRecentEvents(ctx context.Context, userID uuid.UUID, limit int) ([]model.Event, error)
+
+	// SaveModel stores the encoded model of a user.
+	SaveModel(ctx context.Context, userID uuid.UUID, data string, events int) error
+
+	// GetModel retrieves the encoded model of a user.
+	GetModel(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// projectLister defines the lookup of the projects a suggested project must still be one of.
+type projectLister interface {
+	// ListProjects retrieves all projects of a user.
+	ListProjects(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+}
+
+// contentCipher defines the encryption of event content and everything derived from it.
+type contentCipher interface {
+	// Encrypt encrypts a value of the given owner for storage.
+	Encrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages tag and project suggestions for new events.
+// Suggestions come from a per-user keyword model: for every keyword of the titles of a user's recent
+// events, it counts how many of those events had each tag and project. A tag or project is suggested
+// when most events sharing a keyword with the new event had it. Models are recomputed periodically
+// rather than on every change, so suggestions may lag behind the latest events.
+type Service struct {
+	repo     suggestionRepo    // Repository for suggestion models and the events they are computed from
+	projects projectLister     // Projects suggested projects are checked against
+	cipher   contentCipher     // Encryption of event titles and the models derived from them
+	config   config.Suggestion // Model size and suggestion thresholds
+}
+
+// New creates a new Service instance with the provided dependencies.
+// Non-positive limits and thresholds fall back to 100 models per run, 1000 events per model,
+// a support of 2 events and a confidence of 0.5.
+//
+// Parameters:
+//   - r: The suggestion repository for database operations.
+//   - p: The lookup of the projects of a user.
+//   - c: The cipher for event titles and models.
+//   - cfg: The model size and suggestion thresholds.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r suggestionRepo, p projectLister, c contentCipher, cfg config.Suggestion) *Service {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.MaxEvents <= 0 {
+		cfg.MaxEvents = defaultMaxEvents
+	}
+	if cfg.MinSupport <= 0 {
+		cfg.MinSupport = defaultMinSupport
+	}
+	if cfg.MinConfidence <= 0 {
+		cfg.MinConfidence = defaultMinConfidence
+	}
+
+	return &Service{
+		repo:     r,
+		projects: p,
+		cipher:   c,
+		config:   cfg,
+	}
+}
+
+// Suggest proposes tags and a project for a new event from the model of its owner.
+// Tags the event already has are not suggested, and a project only for events without one.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - event: The event to be created, with its title in plain text.
+//
+// Returns:
+//   - The suggestion; empty if no model was computed for the user yet.
+//   - An error if the model cannot be loaded or the projects cannot be listed.
+func (s *Service) Suggest(ctx context.Context, event model.Event) (model.Suggestion, error) {
+	data, err := s.repo.GetModel(ctx, event.UserID)
+	if err != nil {
+		if errors.Is(err, suggestionrepo.ErrModelNotFound) {
+			return model.Suggestion{}, nil
+		}
+		return model.Suggestion{}, fmt.Errorf("suggest: %w", err)
+	}
+
+	if data, err = s.cipher.Decrypt(ctx, event.UserID, data); err != nil {
+		return model.Suggestion{}, fmt.Errorf("suggest: %w", err)
+	}
+
+	var m model.SuggestionModel
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return model.Suggestion{}, fmt.Errorf("suggest: failed to decode model: %w", err)
+	}
+
+	suggestion := suggest(m, event, s.config)
+
+	// The model may still refer to a project deleted since it was computed.
+	if suggestion.ProjectID != nil {
+		projects, err := s.projects.ListProjects(ctx, event.UserID)
+		if err != nil {
+			return model.Suggestion{}, fmt.Errorf("suggest: %w", err)
+		}
+		if !containsProject(projects, *suggestion.ProjectID) {
+			suggestion.ProjectID = nil
+		}
+	}
+
+	return suggestion, nil
+}
+
+// RebuildOutdated recomputes the models of users whose events changed since their model was computed.
+// At most the configured batch size of models is recomputed per call; a user whose model fails
+// does not keep the others from being recomputed.
+//
+// Parameters:
+//   - ctx: The context for the operation; no further models are recomputed once it is done.
+//
+// Returns:
+//   - The number of recomputed models.
+//   - An error if the users cannot be listed, joining the errors of the models that failed otherwise.
+func (s *Service) RebuildOutdated(ctx context.Context) (int, error) {
+	userIDs, err := s.repo.ListOutdatedUsers(ctx, s.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("rebuild outdated: %w", err)
+	}
+
+	rebuilt := 0
+	var errs []error
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := s.Rebuild(ctx, userID); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+		rebuilt++
+	}
+
+	return rebuilt, errors.Join(errs...)
+}
+
+// Rebuild computes the model of a user from their most recent events and stores it encrypted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - An error if the events cannot be read or decrypted, or the model cannot be stored.
+func (s *Service) Rebuild(ctx context.Context, userID uuid.UUID) error {
+	events, err := s.repo.ListRecentEvents(ctx, userID, s.config.MaxEvents)
+	if err != nil {
+		return fmt.Errorf("rebuild: %w", err)
+	}
+
+	for i := range events {
+		if events[i].Title, err = s.cipher.Decrypt(ctx, userID, events[i].Title); err != nil {
+			return fmt.Errorf("rebuild: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(build(events, s.config.MinSupport))
+	if err != nil {
+		return fmt.Errorf("rebuild: failed to encode model: %w", err)
+	}
+
+	encrypted, err := s.cipher.Encrypt(ctx, userID, string(data))
+	if err != nil {
+		return fmt.Errorf("rebuild: %w", err)
+	}
+
+	if err := s.repo.SaveModel(ctx, userID, encrypted, len(events)); err != nil {
+		return fmt.Errorf("rebuild: %w", err)
+	}
+
+	return nil
+}
+
+// containsProject reports whether a project is among the projects of a user.
+func containsProject(projects []model.Project, id uuid.UUID) bool {
+	for _, p := range projects {
+		if p.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package suggestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	suggestionmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/suggestion"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	suggestionrepo "github.com/aliskhannn/calendar-service/internal/repository/suggestion"
+)
+
+func TestKeywords(t *testing.T) {
+	got := keywords("Weekly sync: the Q3 roadmap, 2025 & weekly SYNC")
+	want := []string{"weekly", "sync", "roadmap"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBuildAndSuggest(t *testing.T) {
+	work := uuid.New()
+	events := []model.Event{
+		{Title: "Team standup", Tags: []string{"work", "daily"}, ProjectID: &work},
+		{Title: "Standup with design", Tags: []string{"work"}, ProjectID: &work},
+		{Title: "Standup", Tags: []string{"work"}},
+		{Title: "Dentist", Tags: []string{"health"}},
+	}
+	cfg := config.Suggestion{MinSupport: 2, MinConfidence: 0.5}
+
+	m := build(events, cfg.MinSupport)
+	if m.Events != 4 {
+		t.Fatalf("expected 4 events, got %d", m.Events)
+	}
+	if _, ok := m.Keywords["dentist"]; ok {
+		t.Fatal("expected keywords below the support to be left out")
+	}
+	if _, ok := m.Keywords["team"]; ok {
+		t.Fatal("expected keywords below the support to be left out")
+	}
+
+	got := suggest(m, model.Event{Title: "Standup retro"}, cfg)
+	if !reflect.DeepEqual(got.Tags, []string{"work"}) {
+		t.Fatalf("expected tags [work], got %v", got.Tags)
+	}
+	if got.ProjectID == nil || *got.ProjectID != work {
+		t.Fatalf("expected project %s, got %v", work, got.ProjectID)
+	}
+
+	// Existing tags and projects are kept out of the suggestion.
+	other := uuid.New()
+	got = suggest(m, model.Event{Title: "standup", Tags: []string{"work"}, ProjectID: &other}, cfg)
+	if len(got.Tags) != 0 || got.ProjectID != nil {
+		t.Fatalf("expected no suggestion, got %+v", got)
+	}
+
+	// A higher confidence rules out the project, which only two of three standups had.
+	got = suggest(m, model.Event{Title: "standup"}, config.Suggestion{MinSupport: 2, MinConfidence: 0.9})
+	if got.ProjectID != nil {
+		t.Fatalf("expected no project, got %v", got.ProjectID)
+	}
+}
+
+func TestService_Suggest_NoModel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := suggestionmocks.NewMocksuggestionRepo(ctrl)
+	svc := New(mockRepo, suggestionmocks.NewMockprojectLister(ctrl), encryption.Disabled(), config.Suggestion{})
+
+	userID := uuid.New()
+	mockRepo.EXPECT().GetModel(gomock.Any(), userID).Return("", suggestionrepo.ErrModelNotFound)
+
+	got, err := svc.Suggest(context.Background(), model.Event{UserID: userID, Title: "Standup"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Tags) != 0 || got.ProjectID != nil {
+		t.Fatalf("expected an empty suggestion, got %+v", got)
+	}
+}
+
+func TestService_Suggest_DeletedProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := suggestionmocks.NewMocksuggestionRepo(ctrl)
+	mockProjects := suggestionmocks.NewMockprojectLister(ctrl)
+	svc := New(mockRepo, mockProjects, encryption.Disabled(), config.Suggestion{})
+
+	userID := uuid.New()
+	deleted := uuid.New()
+	data, _ := json.Marshal(model.SuggestionModel{Events: 2, Keywords: map[string]model.KeywordStats{
+		"standup": {Events: 2, Tags: map[string]int{"work": 2}, Projects: map[uuid.UUID]int{deleted: 2}},
+	}})
+
+	mockRepo.EXPECT().GetModel(gomock.Any(), userID).Return(string(data), nil)
+	mockProjects.EXPECT().ListProjects(gomock.Any(), userID).Return([]model.Project{{ID: uuid.New()}}, nil)
+
+	got, err := svc.Suggest(context.Background(), model.Event{UserID: userID, Title: "Daily standup"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"work"}) {
+		t.Fatalf("expected tags [work], got %v", got.Tags)
+	}
+	if got.ProjectID != nil {
+		t.Fatalf("expected the deleted project not to be suggested, got %v", got.ProjectID)
+	}
+}
+
+func TestService_Rebuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := suggestionmocks.NewMocksuggestionRepo(ctrl)
+	svc := New(mockRepo, suggestionmocks.NewMockprojectLister(ctrl), encryption.Disabled(), config.Suggestion{})
+
+	userID := uuid.New()
+	mockRepo.EXPECT().ListRecentEvents(gomock.Any(), userID, defaultMaxEvents).Return([]model.Event{
+		{UserID: userID, Title: "Standup", Tags: []string{"work"}},
+		{UserID: userID, Title: "Standup", Tags: []string{"work"}},
+	}, nil)
+	mockRepo.EXPECT().SaveModel(gomock.Any(), userID, gomock.Any(), 2).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, data string, _ int) error {
+			var m model.SuggestionModel
+			if err := json.Unmarshal([]byte(data), &m); err != nil {
+				t.Fatalf("failed to decode saved model: %v", err)
+			}
+			if m.Keywords["standup"].Tags["work"] != 2 {
+				t.Fatalf("unexpected model: %+v", m)
+			}
+			return nil
+		})
+
+	if err := svc.Rebuild(context.Background(), userID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_RebuildOutdated_ContinuesAfterFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := suggestionmocks.NewMocksuggestionRepo(ctrl)
+	svc := New(mockRepo, suggestionmocks.NewMockprojectLister(ctrl), encryption.Disabled(), config.Suggestion{})
+
+	failing, ok := uuid.New(), uuid.New()
+	mockRepo.EXPECT().ListOutdatedUsers(gomock.Any(), defaultBatchSize).Return([]uuid.UUID{failing, ok}, nil)
+	mockRepo.EXPECT().ListRecentEvents(gomock.Any(), failing, gomock.Any()).Return(nil, errors.New("timeout"))
+	mockRepo.EXPECT().ListRecentEvents(gomock.Any(), ok, gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().SaveModel(gomock.Any(), ok, gomock.Any(), 0).Return(nil)
+
+	rebuilt, err := svc.RebuildOutdated(context.Background())
+	if err == nil {
+		t.Fatal("expected the failure to be reported")
+	}
+	if rebuilt != 1 {
+		t.Fatalf("expected 1 rebuilt model, got %d", rebuilt)
+	}
+}
@@ -0,0 +1,222 @@
+package tzmigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+	"github.com/aliskhannn/calendar-service/internal/timezone"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/tzmigration/mock_tzmigration.go -package=mocks
+
+var (
+	ErrUnknownTimezone = errors.New("unknown time zone")
+	ErrNoTimezone      = errors.New("timezone is required unless per_user is set")
+)
+
+// batchSize is the number of dates migrated per transaction.
+const batchSize = 500
+
+// legacyDateRepo defines the database operations on the event dates stored without a time zone.
+type legacyDateRepo interface {
+	// CountLegacyDates counts the events whose dates have not been migrated yet.
+	CountLegacyDates(ctx context.Context) (int, error)
+
+	// ListLegacyDates retrieves a batch of legacy dates with the time zones of their owners, ordered by event ID.
+	ListLegacyDates(ctx context.Context, after uuid.UUID, limit int) ([]model.LegacyEventDate, error)
+
+	// MigrateDates moves events to their reinterpreted dates and removes a batch from the legacy dates.
+	MigrateDates(ctx context.Context, dates []model.LegacyEventDate, newDates []time.Time, done []uuid.UUID) (int, error)
+}
+
+// jobService defines the background jobs migrations run as.
+type jobService interface {
+	// Enqueue queues a job for the worker pool.
+	Enqueue(ctx context.Context, job model.Job) (model.Job, error)
+}
+
+// Service manages the one-time migration of event dates stored before events had a time of day.
+// Such dates were read as UTC midnight when the column became an instant; the migration moves them
+// to midnight in the time zone they were entered in. Service is the runner of timezone migration jobs.
+type Service struct {
+	repo legacyDateRepo // Repository of the legacy dates
+	jobs jobService     // Background jobs the migrations run as
+}
+
+// New creates a new Service instance with the provided dependencies.
+//
+// Parameters:
+//   - r: The repository of the legacy dates.
+//   - j: The job service migrations run as.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r legacyDateRepo, j jobService) *Service {
+	return &Service{
+		repo: r,
+		jobs: j,
+	}
+}
+
+// Start checks a migration request and queues a job running it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the admin starting the migration.
+//   - req: The source time zones and whether it is a dry run.
+//
+// Returns:
+//   - The queued job, whose progress and result are polled through the jobs API.
+//   - ErrNoTimezone or ErrUnknownTimezone if the request is invalid, or another error if the job cannot be queued.
+func (s *Service) Start(ctx context.Context, userID uuid.UUID, req model.TimezoneMigration) (model.Job, error) {
+	if _, err := fallbackZone(req); err != nil {
+		return model.Job{}, err
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start timezone migration: %w", err)
+	}
+
+	job, err := s.jobs.Enqueue(ctx, model.Job{
+		UserID:  userID,
+		Kind:    model.JobTimezoneMigration,
+		Payload: payload,
+	})
+	if err != nil {
+		return model.Job{}, fmt.Errorf("start timezone migration: %w", err)
+	}
+
+	return job, nil
+}
+
+// Run migrates the legacy dates in batches, reporting a model.TimezoneMigrationResult after each batch.
+// A date is set to midnight of its calendar day in its source time zone; dates changed since the upgrade
+// are skipped. A dry run computes the same result without changing events.
+//
+// Parameters:
+//   - ctx: The context of the job; the migration stops between batches when it is done.
+//   - job: The migration job with the model.TimezoneMigration as payload.
+//   - p: The progress of the job; a unit of work is a legacy date.
+//
+// Returns:
+//   - An error if the payload is invalid, a batch cannot be read or migrated, or ctx is done.
+//     Batches migrated before the error are kept.
+func (s *Service) Run(ctx context.Context, job model.Job, p *jobsvc.Progress) error {
+	var req model.TimezoneMigration
+	if err := json.Unmarshal(job.Payload, &req); err != nil {
+		return fmt.Errorf("invalid timezone migration request: %w", err)
+	}
+
+	fallback, err := fallbackZone(req)
+	if err != nil {
+		return err
+	}
+
+	total, err := s.repo.CountLegacyDates(ctx)
+	if err != nil {
+		return err
+	}
+	p.SetTotal(total)
+
+	result := model.TimezoneMigrationResult{DryRun: req.DryRun, Zones: map[string]int{}}
+	zones := map[string]*time.Location{}
+	after := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, err := s.repo.ListLegacyDates(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		var moved []model.LegacyEventDate
+		var newDates []time.Time
+		done := make([]uuid.UUID, 0, len(batch))
+		for _, d := range batch {
+			done = append(done, d.EventID)
+
+			// Events edited since the upgrade were saved with a proper instant.
+			if !d.EventDate.Equal(d.OriginalDate) {
+				result.Skipped++
+				continue
+			}
+
+			loc := sourceZone(req, d, fallback, zones)
+			result.Zones[loc.String()]++
+
+			date := timezone.Resolve(d.OriginalDate, loc).UTC()
+			if date.Equal(d.EventDate) {
+				result.Unchanged++
+				continue
+			}
+			result.Converted++
+			moved = append(moved, d)
+			newDates = append(newDates, date)
+		}
+
+		if !req.DryRun {
+			if _, err := s.repo.MigrateDates(ctx, moved, newDates, done); err != nil {
+				return err
+			}
+		}
+
+		after = batch[len(batch)-1].EventID
+		p.Add(len(batch))
+
+		// The result is stored with the progress, so it must not be modified after it has been set.
+		snapshot := result
+		snapshot.Zones = maps.Clone(result.Zones)
+		p.SetResult(snapshot)
+	}
+}
+
+// fallbackZone returns the source time zone of the request: of all dates, or with PerUser,
+// of the users without a time zone, for whom it defaults to UTC.
+func fallbackZone(req model.TimezoneMigration) (*time.Location, error) {
+	if req.Timezone == "" {
+		if !req.PerUser {
+			return nil, ErrNoTimezone
+		}
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil || req.Timezone == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTimezone, req.Timezone)
+	}
+
+	return loc, nil
+}
+
+// sourceZone returns the time zone a legacy date was entered in, caching the loaded zones.
+// A user time zone that cannot be loaded falls back to the zone of the request.
+func sourceZone(req model.TimezoneMigration, d model.LegacyEventDate, fallback *time.Location, zones map[string]*time.Location) *time.Location {
+	if !req.PerUser || d.UserTimezone == "" {
+		return fallback
+	}
+
+	loc, ok := zones[d.UserTimezone]
+	if !ok {
+		var err error
+		if loc, err = time.LoadLocation(d.UserTimezone); err != nil {
+			loc = fallback
+		}
+		zones[d.UserTimezone] = loc
+	}
+
+	return loc
+}
@@ -0,0 +1,98 @@
+package tzmigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	tzmigrationmocks "github.com/aliskhannn/calendar-service/internal/mocks/service/tzmigration"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/model"
+	jobsvc "github.com/aliskhannn/calendar-service/internal/service/job"
+)
+
+func newJob(t *testing.T, req model.TimezoneMigration) model.Job {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+	return model.Job{ID: uuid.New(), Kind: model.JobTimezoneMigration, Payload: payload}
+}
+
+func TestService_Start_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := New(tzmigrationmocks.NewMocklegacyDateRepo(ctrl), tzmigrationmocks.NewMockjobService(ctrl))
+
+	if _, err := svc.Start(context.Background(), uuid.New(), model.TimezoneMigration{}); !errors.Is(err, ErrNoTimezone) {
+		t.Fatalf("expected ErrNoTimezone, got %v", err)
+	}
+	if _, err := svc.Start(context.Background(), uuid.New(), model.TimezoneMigration{Timezone: "Mars/Olympus"}); !errors.Is(err, ErrUnknownTimezone) {
+		t.Fatalf("expected ErrUnknownTimezone, got %v", err)
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := tzmigrationmocks.NewMocklegacyDateRepo(ctrl)
+	svc := New(repo, tzmigrationmocks.NewMockjobService(ctrl))
+
+	day := time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)
+	berlin := model.LegacyEventDate{EventID: uuid.New(), UserTimezone: "Europe/Berlin", OriginalDate: day, EventDate: day}
+	tokyo := model.LegacyEventDate{EventID: uuid.New(), UserTimezone: "Asia/Tokyo", OriginalDate: day, EventDate: day}
+	utc := model.LegacyEventDate{EventID: uuid.New(), OriginalDate: day, EventDate: day}
+	edited := model.LegacyEventDate{EventID: uuid.New(), UserTimezone: "Europe/Berlin", OriginalDate: day, EventDate: day.Add(9 * time.Hour)}
+	batch := []model.LegacyEventDate{berlin, tokyo, utc, edited}
+
+	repo.EXPECT().CountLegacyDates(gomock.Any()).Return(4, nil)
+	repo.EXPECT().ListLegacyDates(gomock.Any(), uuid.Nil, batchSize).Return(batch, nil)
+	repo.EXPECT().MigrateDates(gomock.Any(),
+		[]model.LegacyEventDate{berlin, tokyo},
+		// Midnight in Berlin is still CET on the night of the switch to summer time.
+		[]time.Time{time.Date(2025, 3, 29, 23, 0, 0, 0, time.UTC), time.Date(2025, 3, 29, 15, 0, 0, 0, time.UTC)},
+		[]uuid.UUID{berlin.EventID, tokyo.EventID, utc.EventID, edited.EventID},
+	).Return(2, nil)
+	repo.EXPECT().ListLegacyDates(gomock.Any(), edited.EventID, batchSize).Return(nil, nil)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), newJob(t, model.TimezoneMigration{PerUser: true}), p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed, total := p.Counts(); processed != 4 || total != 4 {
+		t.Fatalf("expected 4 of 4 dates processed, got %d of %d", processed, total)
+	}
+	want := model.TimezoneMigrationResult{Converted: 2, Unchanged: 1, Skipped: 1, Zones: map[string]int{"Europe/Berlin": 1, "Asia/Tokyo": 1, "UTC": 1}}
+	if got := p.Result().(model.TimezoneMigrationResult); got.Converted != want.Converted || got.Unchanged != want.Unchanged ||
+		got.Skipped != want.Skipped || len(got.Zones) != 3 || got.Zones["UTC"] != 1 {
+		t.Fatalf("expected result %+v, got %+v", want, got)
+	}
+}
+
+func TestService_Run_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := tzmigrationmocks.NewMocklegacyDateRepo(ctrl)
+	svc := New(repo, tzmigrationmocks.NewMockjobService(ctrl))
+
+	day := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	d := model.LegacyEventDate{EventID: uuid.New(), UserTimezone: "Asia/Tokyo", OriginalDate: day, EventDate: day}
+
+	// The global zone applies to every date without per_user; nothing is migrated in a dry run.
+	repo.EXPECT().CountLegacyDates(gomock.Any()).Return(1, nil)
+	repo.EXPECT().ListLegacyDates(gomock.Any(), uuid.Nil, batchSize).Return([]model.LegacyEventDate{d}, nil)
+	repo.EXPECT().ListLegacyDates(gomock.Any(), d.EventID, batchSize).Return(nil, nil)
+
+	p := &jobsvc.Progress{}
+	if err := svc.Run(context.Background(), newJob(t, model.TimezoneMigration{Timezone: "America/New_York", DryRun: true}), p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := p.Result().(model.TimezoneMigrationResult)
+	if !got.DryRun || got.Converted != 1 || got.Zones["America/New_York"] != 1 {
+		t.Fatalf("unexpected result %+v", got)
+	}
+}
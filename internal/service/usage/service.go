@@ -0,0 +1,98 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/usage/mock_usage.go -package=mocks
+
+// usageRepo defines the interface for API usage counters.
+type usageRepo interface {
+	// Increment counts one API call of a user in the given period and returns the new total.
+	Increment(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error)
+
+	// GetCalls returns the number of API calls of a user in the given period.
+	GetCalls(ctx context.Context, userID uuid.UUID, period time.Time) (int64, error)
+}
+
+// Service meters API calls per user and applies the monthly quota.
+type Service struct {
+	usageRepo usageRepo    // Repository for usage counters
+	config    config.Usage // Usage metering configuration
+}
+
+// New creates a new Service instance with the provided usage repository and configuration.
+//
+// Parameters:
+//   - r: The usage repository for database operations.
+//   - cfg: The usage metering configuration.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r usageRepo, cfg config.Usage) *Service {
+	return &Service{
+		usageRepo: r,
+		config:    cfg,
+	}
+}
+
+// Record counts one API call of a user in the current month.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user making the call.
+//
+// Returns:
+//   - The usage in the current period, including this call.
+//   - An error if the call cannot be counted.
+func (s *Service) Record(ctx context.Context, userID uuid.UUID) (model.Usage, error) {
+	usage := s.newUsage(time.Now())
+
+	calls, err := s.usageRepo.Increment(ctx, userID, usage.Period)
+	if err != nil {
+		return model.Usage{}, fmt.Errorf("record api usage: %w", err)
+	}
+	usage.Calls = calls
+
+	return usage, nil
+}
+
+// Get returns the API usage of a user in the current month.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - The usage in the current period.
+//   - An error if the retrieval fails.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID) (model.Usage, error) {
+	usage := s.newUsage(time.Now())
+
+	calls, err := s.usageRepo.GetCalls(ctx, userID, usage.Period)
+	if err != nil {
+		return model.Usage{}, fmt.Errorf("get api usage: %w", err)
+	}
+	usage.Calls = calls
+
+	return usage, nil
+}
+
+// newUsage returns an empty usage for the month containing now, with the configured quota.
+func (s *Service) newUsage(now time.Time) model.Usage {
+	now = now.UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return model.Usage{
+		Period:   period,
+		ResetsAt: period.AddDate(0, 1, 0),
+		Limit:    s.config.MonthlyQuota,
+	}
+}
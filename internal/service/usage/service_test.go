@@ -0,0 +1,64 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	usagerepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/usage"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestService_Record_Exceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usagerepomocks.NewMockusageRepo(ctrl)
+	svc := New(mockRepo, config.Usage{MonthlyQuota: 100})
+
+	userID := uuid.New()
+
+	mockRepo.EXPECT().
+		Increment(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, period time.Time) (int64, error) {
+			if period.Day() != 1 || period.Hour() != 0 || period.Location() != time.UTC {
+				t.Errorf("expected period at the start of the month in UTC, got %v", period)
+			}
+			return 101, nil
+		})
+
+	usage, err := svc.Record(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !usage.Exceeded() || usage.Remaining() != 0 {
+		t.Fatalf("expected exceeded usage, got %+v", usage)
+	}
+	if !usage.ResetsAt.Equal(usage.Period.AddDate(0, 1, 0)) {
+		t.Fatalf("expected reset at the next month, got %v", usage.ResetsAt)
+	}
+}
+
+func TestService_Get_Unlimited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usagerepomocks.NewMockusageRepo(ctrl)
+	svc := New(mockRepo, config.Usage{})
+
+	mockRepo.EXPECT().
+		GetCalls(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(int64(5000), nil)
+
+	usage, err := svc.Get(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage.Exceeded() || usage.Remaining() != -1 {
+		t.Fatalf("expected unlimited usage, got %+v", usage)
+	}
+}
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	usermocks "github.com/aliskhannn/calendar-service/internal/mocks/service/user"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestService_CreateDemo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := usermocks.NewMockuserRepository(ctrl)
+
+	now := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	cfg := &config.Config{
+		JWT:  config.JWT{Secret: "secret", TTL: 48 * time.Hour},
+		Demo: config.Demo{Enabled: true, Retention: 24 * time.Hour},
+	}
+	svc := New(repo, nil, cfg, clock.NewFake(now))
+
+	id := uuid.New()
+	var stored model.User
+	repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u model.User) (uuid.UUID, error) {
+		stored = u
+		return id, nil
+	})
+
+	account, err := svc.CreateDemo(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, id, account.UserID)
+	assert.True(t, strings.HasSuffix(account.Email, "@demo.invalid"), account.Email)
+	assert.Equal(t, now.Add(24*time.Hour), account.ExpiresAt)
+	assert.True(t, stored.Demo)
+	assert.Equal(t, demoName, stored.Name)
+	assert.Equal(t, model.RoleUser, stored.Role)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(stored.Password), []byte(account.Password)))
+
+	// The token expires with the account, not after the longer JWT TTL.
+	token, err := jwt.Parse(account.Token, func(*jwt.Token) (interface{}, error) { return []byte("secret"), nil },
+		jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	exp, err := token.Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.Equal(t, account.ExpiresAt.Unix(), exp.Unix())
+}
+
+func TestService_DeleteExpiredDemoAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := usermocks.NewMockuserRepository(ctrl)
+
+	now := time.Date(2025, 10, 15, 3, 0, 0, 0, time.UTC)
+	svc := New(repo, nil, &config.Config{Demo: config.Demo{Retention: 24 * time.Hour}}, clock.NewFake(now))
+
+	repo.EXPECT().DeleteDemoUsers(gomock.Any(), now.Add(-24*time.Hour)).Return(3, nil)
+
+	deleted, err := svc.DeleteExpiredDemoAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+}
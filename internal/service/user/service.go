@@ -2,10 +2,14 @@ package user
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/aliskhannn/calendar-service/internal/clock"
 	"github.com/aliskhannn/calendar-service/internal/config"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,13 +18,19 @@ import (
 
 	"github.com/aliskhannn/calendar-service/internal/model"
 	userrepo "github.com/aliskhannn/calendar-service/internal/repository/user"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
 )
 
 var (
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrUnknownTimezone    = errors.New("unknown time zone")
+	ErrUnknownLanguage    = errors.New("unknown search language")
 )
 
+// demoName is the name of demo accounts registered without one.
+const demoName = "Demo user"
+
 //go:generate mockgen -source=service.go -destination=../../mocks/service/user/mock_user.go -package=mocks
 
 // userRepository defines the interface for user-related database operations.
@@ -34,27 +44,67 @@ type userRepository interface {
 
 	// GetUserByEmail retrieves a user by their email address.
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+
+	// ListUsers retrieves a page of the users whose email contains the given substring, newest first.
+	ListUsers(ctx context.Context, email string, page model.Page) ([]model.User, error)
+
+	// CountUsers counts the users whose email contains the given substring, exactly or estimated.
+	CountUsers(ctx context.Context, email string, mode string) (int, error)
+
+	// UpdateRole changes the role of a user.
+	UpdateRole(ctx context.Context, id uuid.UUID, role string) error
+
+	// GetTimezone retrieves the time zone of a user.
+	GetTimezone(ctx context.Context, id uuid.UUID) (string, error)
+
+	// UpdateTimezone changes the time zone of a user.
+	UpdateTimezone(ctx context.Context, id uuid.UUID, timezone string) error
+
+	// UpdateSearchLanguage changes the search language of a user and reindexes their events.
+	UpdateSearchLanguage(ctx context.Context, id uuid.UUID, language string) error
+
+	// DeleteDemoUsers deletes the demo accounts created before the given time and returns how many were deleted.
+	DeleteDemoUsers(ctx context.Context, createdBefore time.Time) (int, error)
+}
+
+// securityRepository defines the interface for the security event log of user accounts.
+type securityRepository interface {
+	// CreateSecurityEvent appends an event to the security event log.
+	CreateSecurityEvent(ctx context.Context, event model.SecurityEvent) error
+
+	// ListSecurityEvents retrieves a page of the security events of a user, newest first.
+	ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error)
+
+	// CountSecurityEvents counts the security events of a user, exactly or estimated.
+	CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error)
 }
 
 // Service manages business logic for user-related operations.
-// It handles user creation, retrieval, and authentication, including password hashing and JWT generation.
+// It handles user creation, retrieval, and authentication, including password hashing and JWT generation,
+// and records security-relevant account activity.
 type Service struct {
-	userRepo userRepository // Repository for user database operations
-	config   *config.Config // Application configuration, including JWT settings
+	userRepo     userRepository     // Repository for user database operations
+	securityRepo securityRepository // Repository for the security event log
+	config       *config.Config     // Application configuration, including JWT settings
+	clock        clock.Clock        // Source of the current time for token issuance and expiry
 }
 
-// New creates a new Service instance with the provided user repository and configuration.
+// New creates a new Service instance with the provided repositories, configuration, and clock.
 //
 // Parameters:
 //   - userRepo: The repository for user database operations.
+//   - securityRepo: The repository for the security event log.
 //   - config: The application configuration containing JWT settings.
+//   - clk: The clock tokens are issued by.
 //
 // Returns:
 //   - A pointer to the initialized Service.
-func New(userRepo userRepository, config *config.Config) *Service {
+func New(userRepo userRepository, securityRepo securityRepository, config *config.Config, clk clock.Clock) *Service {
 	return &Service{
-		userRepo: userRepo,
-		config:   config,
+		userRepo:     userRepo,
+		securityRepo: securityRepo,
+		config:       config,
+		clock:        clk,
 	}
 }
 
@@ -101,6 +151,75 @@ func (s *Service) Create(ctx context.Context, email, name, password string) (uui
 	return id, nil
 }
 
+// CreateDemo registers a throwaway account for the demo mode. Its email address and password are generated;
+// the address lies under the reserved .invalid domain, so it cannot collide with real accounts or receive email.
+// The returned token expires with the account at the latest.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - name: The name of the account; a default name is used when empty.
+//
+// Returns:
+//   - The credentials and token of the account.
+//   - An error if generating the credentials, hashing the password, or creating the user fails.
+func (s *Service) CreateDemo(ctx context.Context, name string) (model.DemoAccount, error) {
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return model.DemoAccount{}, fmt.Errorf("generate credentials: %w", err)
+	}
+	if name == "" {
+		name = demoName
+	}
+
+	account := model.DemoAccount{
+		Email:    fmt.Sprintf("demo-%x@demo.invalid", secret[:8]),
+		Password: base64.RawURLEncoding.EncodeToString(secret[8:]),
+	}
+
+	hash, err := hashPassword(account.Password)
+	if err != nil {
+		return model.DemoAccount{}, fmt.Errorf("hash password: %w", err)
+	}
+
+	user := model.User{Email: account.Email, Name: name, Password: hash, Role: model.RoleUser, Demo: true}
+	user.ID, err = s.userRepo.CreateUser(ctx, user)
+	if err != nil {
+		return model.DemoAccount{}, fmt.Errorf("create user: %w", err)
+	}
+	account.UserID = user.ID
+
+	now := s.clock.Now()
+	account.ExpiresAt = now.Add(s.config.Demo.Retention)
+
+	jwtCfg := s.config.JWT
+	jwtCfg.TTL = min(jwtCfg.TTL, s.config.Demo.Retention)
+	tenantID, _ := tenancy.FromContext(ctx)
+
+	account.Token, err = generateToken(&user, tenantID, jwtCfg, now)
+	if err != nil {
+		return model.DemoAccount{}, fmt.Errorf("generate token: %w", err)
+	}
+
+	return account, nil
+}
+
+// DeleteExpiredDemoAccounts deletes the demo accounts older than the configured retention, with all their data.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The number of deleted accounts.
+//   - An error if the deletion fails.
+func (s *Service) DeleteExpiredDemoAccounts(ctx context.Context) (int, error) {
+	deleted, err := s.userRepo.DeleteDemoUsers(ctx, s.clock.Now().Add(-s.config.Demo.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("delete demo users: %w", err)
+	}
+
+	return deleted, nil
+}
+
 // GetByID retrieves a user by their ID.
 // It fetches the user from the database and handles the case where the user is not found.
 //
@@ -125,16 +244,18 @@ func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error
 
 // GetByEmail authenticates a user by their email and password, returning a JWT token if successful.
 // It verifies the password and generates a JWT token with user details upon successful authentication.
+// Successful and failed logins of existing users are recorded in the security event log.
 //
 // Parameters:
 //   - ctx: The context for the operation.
 //   - email: The email address of the user.
 //   - password: The plaintext password to verify.
+//   - client: The client logging in, recorded in the security event log.
 //
 // Returns:
 //   - A JWT token string if authentication is successful.
 //   - An error if the user is not found, the password is invalid, or token generation fails.
-func (s *Service) GetByEmail(ctx context.Context, email, password string) (string, error) {
+func (s *Service) GetByEmail(ctx context.Context, email, password string, client model.ClientInfo) (string, error) {
 	// Retrieve user by email.
 	user, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
@@ -146,18 +267,218 @@ func (s *Service) GetByEmail(ctx context.Context, email, password string) (strin
 
 	// Verify the password.
 	if err := verifyPassword(password, user.Password); err != nil {
+		if err := s.recordSecurityEvent(ctx, user.ID, model.SecurityLoginFailed, client); err != nil {
+			return "", err
+		}
 		return "", ErrInvalidCredentials
 	}
 
-	// Generate JWT token.
-	token, err := generateToken(user, s.config.JWT)
+	// Generate JWT token, bound to the tenant the user logged in to.
+	tenantID, _ := tenancy.FromContext(ctx)
+
+	token, err := generateToken(user, tenantID, s.config.JWT, s.clock.Now())
 	if err != nil {
 		return "", fmt.Errorf("generate token: %w", err)
 	}
 
+	if err := s.recordSecurityEvent(ctx, user.ID, model.SecurityLogin, client); err != nil {
+		return "", err
+	}
+
 	return token, nil
 }
 
+// ListUsers retrieves a page of users for administration, newest first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - email: Optional case-insensitive substring of the email address; empty matches all users.
+//   - page: The page to return; one user more than the limit is read.
+//
+// Returns:
+//   - A slice of users without password hashes.
+//   - An error if the retrieval fails.
+func (s *Service) ListUsers(ctx context.Context, email string, page model.Page) ([]model.User, error) {
+	users, err := s.userRepo.ListUsers(ctx, email, page)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+
+	return users, nil
+}
+
+// CountUsers counts the users for administration.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - email: Optional case-insensitive substring of the email address; empty counts all users.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of users.
+//   - An error if the count fails.
+func (s *Service) CountUsers(ctx context.Context, email string, mode string) (int, error) {
+	n, err := s.userRepo.CountUsers(ctx, email, mode)
+	if err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+
+	return n, nil
+}
+
+// SetTimezone changes the time zone the calendar days of a user are computed in.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the user.
+//   - timezone: An IANA time zone name such as "Europe/Berlin", or empty for UTC.
+//
+// Returns:
+//   - ErrUnknownTimezone if the time zone does not exist, or another error if the update fails.
+func (s *Service) SetTimezone(ctx context.Context, id uuid.UUID, timezone string) error {
+	if _, err := loadLocation(timezone); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdateTimezone(ctx, id, timezone); err != nil {
+		return fmt.Errorf("set time zone: %w", err)
+	}
+
+	return nil
+}
+
+// SetSearchLanguage changes the language the events of a user are searched in. Words of titles, descriptions
+// and queries are reduced to their stem in that language, so searches match their inflected forms.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the user.
+//   - language: One of model.SearchLanguages such as "russian", or empty to match words whole, ignoring case and accents.
+//
+// Returns:
+//   - ErrUnknownLanguage if the language is not supported, or another error if the update fails.
+func (s *Service) SetSearchLanguage(ctx context.Context, id uuid.UUID, language string) error {
+	if language != "" && !slices.Contains(model.SearchLanguages, language) {
+		return fmt.Errorf("%w: %q", ErrUnknownLanguage, language)
+	}
+
+	if err := s.userRepo.UpdateSearchLanguage(ctx, id, language); err != nil {
+		return fmt.Errorf("set search language: %w", err)
+	}
+
+	return nil
+}
+
+// Location returns the time zone the calendar days of a user are computed in.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the user.
+//
+// Returns:
+//   - The user's time zone; UTC if none is set or the stored one no longer exists.
+//   - An error if the time zone cannot be read.
+func (s *Service) Location(ctx context.Context, id uuid.UUID) (*time.Location, error) {
+	timezone, err := s.userRepo.GetTimezone(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get time zone: %w", err)
+	}
+
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		return time.UTC, nil
+	}
+
+	return loc, nil
+}
+
+// loadLocation loads an IANA time zone; the empty name is UTC.
+// The server's local time zone is rejected, since it depends on the host.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || loc == time.Local {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTimezone, name)
+	}
+
+	return loc, nil
+}
+
+// SetRole changes the role of a user and records the change in the user's security event log.
+// The new role applies to tokens issued from the next login on.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - id: The UUID of the user.
+//   - role: The new role, one of the model.Role* constants.
+//   - client: The client of the admin changing the role, recorded in the security event log.
+//
+// Returns:
+//   - userrepo.ErrUserNotFound if the user does not exist, or another error if the update fails.
+func (s *Service) SetRole(ctx context.Context, id uuid.UUID, role string, client model.ClientInfo) error {
+	if err := s.userRepo.UpdateRole(ctx, id, role); err != nil {
+		return fmt.Errorf("update role: %w", err)
+	}
+
+	return s.recordSecurityEvent(ctx, id, model.SecurityRoleChanged, client)
+}
+
+// ListSecurityEvents retrieves a page of the security events of a user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - page: The page to return; one event more than the limit is read.
+//
+// Returns:
+//   - A slice of security events.
+//   - An error if the retrieval fails.
+func (s *Service) ListSecurityEvents(ctx context.Context, userID uuid.UUID, page model.Page) ([]model.SecurityEvent, error) {
+	events, err := s.securityRepo.ListSecurityEvents(ctx, userID, page)
+	if err != nil {
+		return nil, fmt.Errorf("list security events: %w", err)
+	}
+
+	return events, nil
+}
+
+// CountSecurityEvents counts the security events of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//   - mode: model.TotalExact or model.TotalEstimated.
+//
+// Returns:
+//   - The number of security events.
+//   - An error if the count fails.
+func (s *Service) CountSecurityEvents(ctx context.Context, userID uuid.UUID, mode string) (int, error) {
+	n, err := s.securityRepo.CountSecurityEvents(ctx, userID, mode)
+	if err != nil {
+		return 0, fmt.Errorf("count security events: %w", err)
+	}
+
+	return n, nil
+}
+
+// recordSecurityEvent appends an event of the given type to the user's security event log.
+func (s *Service) recordSecurityEvent(ctx context.Context, userID uuid.UUID, eventType string, client model.ClientInfo) error {
+	err := s.securityRepo.CreateSecurityEvent(ctx, model.SecurityEvent{
+		UserID:    userID,
+		Type:      eventType,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("record security event: %w", err)
+	}
+
+	return nil
+}
+
 // hashPassword generates a bcrypt hash for the given password.
 // It uses the default bcrypt cost for hashing.
 //
@@ -186,25 +507,38 @@ func verifyPassword(password, hash string) error {
 }
 
 // generateToken creates a JWT token for the given user.
-// It includes the user's ID, name, email, issuance time, and expiration time in the token claims.
+// It includes the user's ID, name, email, role, issuance time, and expiration time in the token claims,
+// the configured issuer and audience, and the tenant the user belongs to when tenancy is enabled.
 //
 // Parameters:
 //   - user: The user for whom the token is generated.
-//   - jwtCfg: The JWT configuration containing the secret and TTL.
+//   - tenantID: The tenant of the user; omitted from the claims when empty.
+//   - jwtCfg: The JWT configuration containing the secret, TTL, issuer and audience.
+//   - now: The time the token is issued at.
 //
 // Returns:
 //   - The signed JWT token string.
 //   - An error if token generation or signing fails.
-func generateToken(user *model.User, jwtCfg config.JWT) (string, error) {
-	expTime := time.Now().Add(jwtCfg.TTL)
+func generateToken(user *model.User, tenantID string, jwtCfg config.JWT, now time.Time) (string, error) {
+	expTime := now.Add(jwtCfg.TTL)
 
 	// Create JWT claims.
 	claims := jwt.MapClaims{
 		"user_id": user.ID.String(),
 		"name":    user.Name,
 		"email":   user.Email,
-		"exp":     expTime.Unix(),    // expiration time
-		"iat":     time.Now().Unix(), // issued at time
+		"role":    user.Role,
+		"exp":     expTime.Unix(), // expiration time
+		"iat":     now.Unix(),     // issued at time
+	}
+	if jwtCfg.Issuer != "" {
+		claims["iss"] = jwtCfg.Issuer
+	}
+	if jwtCfg.Audience != "" {
+		claims["aud"] = jwtCfg.Audience
+	}
+	if tenantID != "" {
+		claims["tenant"] = tenantID
 	}
 
 	// Create and sign the token.
@@ -24,7 +24,8 @@ func TestCreateUser_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocksuserrepo.NewMockuserRepository(ctrl)
-	svc := New(mockRepo, &config.Config{})
+	mockSecurity := mocksuserrepo.NewMocksecurityRepository(ctrl)
+	svc := New(mockRepo, mockSecurity, &config.Config{})
 
 	ctx := context.Background()
 	testUser := model.User{
@@ -46,7 +47,8 @@ func TestCreateUser_AlreadyExists(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocksuserrepo.NewMockuserRepository(ctrl)
-	svc := New(mockRepo, &config.Config{})
+	mockSecurity := mocksuserrepo.NewMocksecurityRepository(ctrl)
+	svc := New(mockRepo, mockSecurity, &config.Config{})
 
 	ctx := context.Background()
 	testUser := model.User{
@@ -79,7 +81,9 @@ func TestGetByEmail_Success(t *testing.T) {
 		Password: hash,
 	}, nil)
 
-	token, err := svc.GetByEmail(ctx, "john@example.com", password)
+	mockSecurity.EXPECT().CreateSecurityEvent(ctx, gomock.Any()).Return(nil)
+
+	token, err := svc.GetByEmail(ctx, "john@example.com", password, model.ClientInfo{})
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 }
@@ -89,7 +93,8 @@ func TestGetByEmail_InvalidCredentials(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocksuserrepo.NewMockuserRepository(ctrl)
-	svc := New(mockRepo, &config.Config{})
+	mockSecurity := mocksuserrepo.NewMocksecurityRepository(ctrl)
+	svc := New(mockRepo, mockSecurity, &config.Config{})
 
 	ctx := context.Background()
 	password := "password123"
@@ -97,7 +102,7 @@ func TestGetByEmail_InvalidCredentials(t *testing.T) {
 	// Пользователь не найден
 	mockRepo.EXPECT().GetUserByEmail(ctx, "unknown@example.com").Return(nil, userrepo.ErrUserNotFound)
 
-	_, err := svc.GetByEmail(ctx, "unknown@example.com", password)
+	_, err := svc.GetByEmail(ctx, "unknown@example.com", password, model.ClientInfo{})
 	require.ErrorIs(t, err, ErrInvalidCredentials)
 }
 
@@ -106,7 +111,8 @@ func TestGetByEmail_WrongPassword(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocksuserrepo.NewMockuserRepository(ctrl)
-	svc := New(mockRepo, &config.Config{})
+	mockSecurity := mocksuserrepo.NewMocksecurityRepository(ctrl)
+	svc := New(mockRepo, mockSecurity, &config.Config{})
 
 	ctx := context.Background()
 
@@ -119,6 +125,14 @@ func TestGetByEmail_WrongPassword(t *testing.T) {
 		Password: hash,
 	}, nil)
 
-	_, err := svc.GetByEmail(ctx, "john@example.com", "wrongpass")
+	mockSecurity.EXPECT().
+		CreateSecurityEvent(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, e model.SecurityEvent) error {
+			require.Equal(t, model.SecurityLoginFailed, e.Type)
+			require.Equal(t, "203.0.113.7", e.IP)
+			return nil
+		})
+
+	_, err := svc.GetByEmail(ctx, "john@example.com", "wrongpass", model.ClientInfo{IP: "203.0.113.7"})
 	require.ErrorIs(t, err, ErrInvalidCredentials)
 }
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	usermocks "github.com/aliskhannn/calendar-service/internal/mocks/service/user"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+func TestService_SetTimezone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := usermocks.NewMockuserRepository(ctrl)
+	svc := New(repo, nil, &config.Config{}, clock.NewFake(time.Now()))
+
+	id := uuid.New()
+	repo.EXPECT().UpdateTimezone(gomock.Any(), id, "Europe/Berlin").Return(nil)
+	repo.EXPECT().UpdateTimezone(gomock.Any(), id, "").Return(nil)
+
+	require.NoError(t, svc.SetTimezone(context.Background(), id, "Europe/Berlin"))
+	require.NoError(t, svc.SetTimezone(context.Background(), id, ""))
+
+	for _, tz := range []string{"Mars/Olympus_Mons", "Local"} {
+		assert.ErrorIs(t, svc.SetTimezone(context.Background(), id, tz), ErrUnknownTimezone, tz)
+	}
+}
+
+func TestService_SetSearchLanguage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := usermocks.NewMockuserRepository(ctrl)
+	svc := New(repo, nil, &config.Config{}, clock.NewFake(time.Now()))
+
+	id := uuid.New()
+	repo.EXPECT().UpdateSearchLanguage(gomock.Any(), id, "russian").Return(nil)
+	repo.EXPECT().UpdateSearchLanguage(gomock.Any(), id, "").Return(nil)
+
+	require.NoError(t, svc.SetSearchLanguage(context.Background(), id, "russian"))
+	require.NoError(t, svc.SetSearchLanguage(context.Background(), id, ""))
+
+	for _, language := range []string{"klingon", "pg_catalog.english", "English"} {
+		assert.ErrorIs(t, svc.SetSearchLanguage(context.Background(), id, language), ErrUnknownLanguage, language)
+	}
+}
+
+func TestService_Location(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := usermocks.NewMockuserRepository(ctrl)
+	svc := New(repo, nil, &config.Config{}, clock.NewFake(time.Now()))
+
+	tests := []struct {
+		stored string
+		want   string
+	}{
+		{"Asia/Tokyo", "Asia/Tokyo"},
+		{"", "UTC"},
+		{"Gone/Zone", "UTC"},
+	}
+	for _, tt := range tests {
+		id := uuid.New()
+		repo.EXPECT().GetTimezone(gomock.Any(), id).Return(tt.stored, nil)
+
+		loc, err := svc.Location(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, loc.String(), tt.stored)
+	}
+}
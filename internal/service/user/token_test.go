@@ -0,0 +1,47 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestGenerateToken_Expiry(t *testing.T) {
+	issued := time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC)
+	cfg := config.JWT{Secret: "secret", TTL: time.Hour}
+
+	token, err := generateToken(&model.User{ID: uuid.New(), Role: "user"}, "", cfg, issued)
+	require.NoError(t, err)
+
+	parse := func(at time.Time) (*jwt.Token, error) {
+		return jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return []byte(cfg.Secret), nil },
+			jwt.WithTimeFunc(func() time.Time { return at }))
+	}
+
+	parsed, err := parse(issued.Add(59 * time.Minute))
+	require.NoError(t, err)
+	exp, err := parsed.Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.Equal(t, issued.Add(time.Hour).Unix(), exp.Unix())
+
+	_, err = parse(issued.Add(61 * time.Minute))
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+}
+
+func TestGenerateToken_IssuerAndAudience(t *testing.T) {
+	cfg := config.JWT{Secret: "secret", TTL: time.Hour, Issuer: "calendar-service", Audience: "calendar-api"}
+
+	token, err := generateToken(&model.User{ID: uuid.New(), Role: "user"}, "", cfg, time.Now())
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return []byte(cfg.Secret), nil },
+		jwt.WithIssuer(cfg.Issuer), jwt.WithAudience(cfg.Audience))
+	assert.NoError(t, err)
+}
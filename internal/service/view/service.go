@@ -0,0 +1,239 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// ErrUnknownRange is returned when a view refers to a relative range that does not exist.
+var ErrUnknownRange = errors.New("unknown relative range")
+
+// maxViewEvents caps the number of events returned for a single view.
+const maxViewEvents = 500
+
+//go:generate mockgen -source=service.go -destination=../../mocks/service/view/mock_view.go -package=mocks
+
+// viewRepo defines the interface for view-related database operations.
+type viewRepo interface {
+	// CreateView inserts a new view and returns its ID.
+	CreateView(ctx context.Context, view model.View) (uuid.UUID, error)
+
+	// GetView retrieves a view by its ID for the specified user.
+	GetView(ctx context.Context, viewID, userID uuid.UUID) (model.View, error)
+
+	// ListViews retrieves all views of a user.
+	ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error)
+
+	// DeleteView deletes a view of the specified user.
+	DeleteView(ctx context.Context, viewID, userID uuid.UUID) error
+
+	// ListEvents retrieves the events of a user matching the filter.
+	ListEvents(ctx context.Context, userID uuid.UUID, filter model.EventFilter) ([]model.Event, error)
+}
+
+// contentCipher defines the decryption of event content stored encrypted at rest.
+type contentCipher interface {
+	// Decrypt decrypts a stored value of the given owner.
+	Decrypt(ctx context.Context, userID uuid.UUID, value string) (string, error)
+}
+
+// Service manages business logic for saved views.
+// Relative ranges of a view are resolved when it is queried, so "next week" always means
+// the week after the current one. Text criteria are matched after decryption, since event
+// content is encrypted at rest and cannot be searched in the database.
+type Service struct {
+	viewRepo viewRepo      // Repository for view database operations
+	cipher   contentCipher // Decryption of event titles and descriptions
+	clock    clock.Clock   // Source of the current time relative ranges are resolved against
+}
+
+// New creates a new Service instance with the provided view repository, content cipher, and clock.
+//
+// Parameters:
+//   - r: The view repository for database operations.
+//   - c: The cipher for event titles and descriptions.
+//   - clk: The clock relative ranges are resolved against, in UTC.
+//
+// Returns:
+//   - A pointer to the initialized Service.
+func New(r viewRepo, c contentCipher, clk clock.Clock) *Service {
+	return &Service{
+		viewRepo: r,
+		cipher:   c,
+		clock:    clk,
+	}
+}
+
+// CreateView saves a new view and returns its ID.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - view: The view to create; UserID and Name must be set.
+//
+// Returns:
+//   - The UUID of the created view.
+//   - An error if the range is unknown or the creation fails.
+func (s *Service) CreateView(ctx context.Context, view model.View) (uuid.UUID, error) {
+	if _, _, err := ResolveRange(view.Filter.Range, s.clock.Now().UTC()); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := s.viewRepo.CreateView(ctx, view)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create view: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListViews retrieves all views of a user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The UUID of the user.
+//
+// Returns:
+//   - A slice of views.
+//   - An error if the retrieval fails.
+func (s *Service) ListViews(ctx context.Context, userID uuid.UUID) ([]model.View, error) {
+	views, err := s.viewRepo.ListViews(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list views: %w", err)
+	}
+
+	return views, nil
+}
+
+// DeleteView deletes a view. The events it matches are not affected.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - viewID: The UUID of the view to delete.
+//   - userID: The UUID of the user who owns the view.
+//
+// Returns:
+//   - An error if the deletion fails.
+func (s *Service) DeleteView(ctx context.Context, viewID, userID uuid.UUID) error {
+	if err := s.viewRepo.DeleteView(ctx, viewID, userID); err != nil {
+		return fmt.Errorf("delete view: %w", err)
+	}
+
+	return nil
+}
+
+// GetViewEvents retrieves the events currently matching a view, ordered by date.
+// At most 500 events are returned.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - viewID: The UUID of the view.
+//   - userID: The UUID of the user who owns the view.
+//
+// Returns:
+//   - The view.
+//   - The matching events.
+//   - An error if the view is not found or the retrieval fails.
+func (s *Service) GetViewEvents(ctx context.Context, viewID, userID uuid.UUID) (model.View, []model.Event, error) {
+	view, err := s.viewRepo.GetView(ctx, viewID, userID)
+	if err != nil {
+		return model.View{}, nil, fmt.Errorf("get view events: %w", err)
+	}
+
+	from, to, err := ResolveRange(view.Filter.Range, s.clock.Now().UTC())
+	if err != nil {
+		return model.View{}, nil, fmt.Errorf("get view events: %w", err)
+	}
+
+	filter := model.EventFilter{
+		From:           from,
+		To:             to,
+		Priorities:     view.Filter.Priorities,
+		ProjectID:      view.Filter.ProjectID,
+		WithoutProject: view.Filter.WithoutProject,
+	}
+
+	// Text matching happens after decryption, so the limit can only be pushed down without it.
+	text := strings.ToLower(strings.TrimSpace(view.Filter.Text))
+	if text == "" {
+		filter.Limit = maxViewEvents
+	}
+
+	events, err := s.viewRepo.ListEvents(ctx, userID, filter)
+	if err != nil {
+		return model.View{}, nil, fmt.Errorf("get view events: %w", err)
+	}
+
+	matched := events[:0]
+	for _, e := range events {
+		if e.Title, err = s.cipher.Decrypt(ctx, userID, e.Title); err != nil {
+			return model.View{}, nil, fmt.Errorf("get view events: %w", err)
+		}
+		if e.Description, err = s.cipher.Decrypt(ctx, userID, e.Description); err != nil {
+			return model.View{}, nil, fmt.Errorf("get view events: %w", err)
+		}
+
+		if text != "" && !strings.Contains(strings.ToLower(e.Title), text) &&
+			!strings.Contains(strings.ToLower(e.Description), text) {
+			continue
+		}
+
+		matched = append(matched, e)
+		if len(matched) == maxViewEvents {
+			break
+		}
+	}
+
+	return view, matched, nil
+}
+
+// ResolveRange converts a relative range into absolute bounds based on the given time.
+// Days are calendar days in the time zone of now, and weeks start on Monday.
+//
+// Parameters:
+//   - name: The relative range, e.g. "next_week"; empty means no bounds.
+//   - now: The reference time.
+//
+// Returns:
+//   - The inclusive start and exclusive end of the range, both nil for an empty name.
+//   - ErrUnknownRange if the name is not a known range.
+func ResolveRange(name string, now time.Time) (*time.Time, *time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weekStart := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	var from, to time.Time
+	switch name {
+	case "":
+		return nil, nil, nil
+	case model.RangeToday:
+		from, to = today, today.AddDate(0, 0, 1)
+	case model.RangeTomorrow:
+		from, to = today.AddDate(0, 0, 1), today.AddDate(0, 0, 2)
+	case model.RangeThisWeek:
+		from, to = weekStart, weekStart.AddDate(0, 0, 7)
+	case model.RangeNextWeek:
+		from, to = weekStart.AddDate(0, 0, 7), weekStart.AddDate(0, 0, 14)
+	case model.RangeThisMonth:
+		from, to = monthStart, monthStart.AddDate(0, 1, 0)
+	case model.RangeNextMonth:
+		from, to = monthStart.AddDate(0, 1, 0), monthStart.AddDate(0, 2, 0)
+	case model.RangeNext7Days:
+		from, to = today, today.AddDate(0, 0, 7)
+	case model.RangeNext30Days:
+		from, to = today, today.AddDate(0, 0, 30)
+	case model.RangePast7Days:
+		from, to = today.AddDate(0, 0, -7), today
+	default:
+		return nil, nil, ErrUnknownRange
+	}
+
+	return &from, &to, nil
+}
@@ -0,0 +1,135 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	viewrepomocks "github.com/aliskhannn/calendar-service/internal/mocks/service/view"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/encryption"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+func TestResolveRange(t *testing.T) {
+	// Wednesday, 15 October 2025.
+	now := time.Date(2025, 10, 15, 13, 30, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, 10, d, 0, 0, 0, 0, time.UTC) }
+
+	cases := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{model.RangeToday, day(15), day(16)},
+		{model.RangeTomorrow, day(16), day(17)},
+		{model.RangeThisWeek, day(13), day(20)},
+		{model.RangeNextWeek, day(20), day(27)},
+		{model.RangeThisMonth, day(1), time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{model.RangeNextMonth, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{model.RangeNext7Days, day(15), day(22)},
+		{model.RangeNext30Days, day(15), time.Date(2025, 11, 14, 0, 0, 0, 0, time.UTC)},
+		{model.RangePast7Days, day(8), day(15)},
+	}
+
+	for _, c := range cases {
+		from, to, err := ResolveRange(c.name, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !from.Equal(c.from) || !to.Equal(c.to) {
+			t.Fatalf("%s: expected [%v, %v), got [%v, %v)", c.name, c.from, c.to, *from, *to)
+		}
+	}
+
+	// On a Sunday the current week started six days earlier.
+	from, _, _ := ResolveRange(model.RangeThisWeek, time.Date(2025, 10, 19, 9, 0, 0, 0, time.UTC))
+	if !from.Equal(day(13)) {
+		t.Fatalf("expected week of Sunday to start on Monday 13th, got %v", *from)
+	}
+
+	if from, to, err := ResolveRange("", now); err != nil || from != nil || to != nil {
+		t.Fatalf("expected no bounds for an empty range, got %v %v %v", from, to, err)
+	}
+	if _, _, err := ResolveRange("next_year", now); !errors.Is(err, ErrUnknownRange) {
+		t.Fatalf("expected ErrUnknownRange, got %v", err)
+	}
+}
+
+func TestService_GetViewEvents_ResolvesRangeAtQueryTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := viewrepomocks.NewMockviewRepo(ctrl)
+	clk := clock.NewFake(time.Date(2025, 10, 15, 13, 30, 0, 0, time.UTC))
+	svc := New(mockRepo, encryption.Disabled(), clk)
+
+	userID := uuid.New()
+	view := model.View{ID: uuid.New(), UserID: userID, Filter: model.ViewFilter{Priorities: []string{"high"}, Range: model.RangeNextWeek}}
+	mockRepo.EXPECT().GetView(gomock.Any(), view.ID, userID).Return(view, nil).Times(2)
+
+	for _, monday := range []time.Time{
+		time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+	} {
+		mockRepo.EXPECT().ListEvents(gomock.Any(), userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, f model.EventFilter) ([]model.Event, error) {
+				if !f.From.Equal(monday) || !f.To.Equal(monday.AddDate(0, 0, 7)) {
+					t.Fatalf("expected week of %v, got [%v, %v)", monday, *f.From, *f.To)
+				}
+				if len(f.Priorities) != 1 || f.Priorities[0] != "high" || f.Limit != maxViewEvents {
+					t.Fatalf("unexpected filter: %+v", f)
+				}
+				return nil, nil
+			})
+
+		if _, _, err := svc.GetViewEvents(context.Background(), view.ID, userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		clk.Advance(7 * 24 * time.Hour)
+	}
+}
+
+func TestService_GetViewEvents_Text(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := viewrepomocks.NewMockviewRepo(ctrl)
+	svc := New(mockRepo, encryption.Disabled(), clock.Real())
+
+	userID := uuid.New()
+	view := model.View{ID: uuid.New(), UserID: userID, Filter: model.ViewFilter{Text: "Review"}}
+
+	mockRepo.EXPECT().GetView(gomock.Any(), view.ID, userID).Return(view, nil)
+	mockRepo.EXPECT().ListEvents(gomock.Any(), userID, model.EventFilter{}).Return([]model.Event{
+		{Title: "Design review"},
+		{Title: "Standup"},
+		{Title: "Sync", Description: "review the roadmap"},
+	}, nil)
+
+	_, events, err := svc.GetViewEvents(context.Background(), view.ID, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events) != 2 || events[0].Title != "Design review" || events[1].Title != "Sync" {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+}
+
+func TestService_CreateView_UnknownRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(viewrepomocks.NewMockviewRepo(ctrl), encryption.Disabled(), clock.Real())
+
+	_, err := svc.CreateView(context.Background(), model.View{Name: "Later", Filter: model.ViewFilter{Range: "later"}})
+	if !errors.Is(err, ErrUnknownRange) {
+		t.Fatalf("expected ErrUnknownRange, got %v", err)
+	}
+}
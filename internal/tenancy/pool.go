@@ -0,0 +1,332 @@
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// Pool routes queries to the connection pool of the tenant found in the context.
+// With tenancy disabled every query goes to the main database pool.
+// It implements the pgxPool interfaces of the repositories, so they need no tenant awareness.
+//
+// With regional replicas configured, queries whose context is marked with ReadOnly are served
+// by the healthy endpoint with the lowest latency; everything else goes to the primary.
+type Pool struct {
+	main    *pgxpool.Pool            // main database pool, used when tenancy is disabled
+	tenants map[string]*pgxpool.Pool // tenants maps tenant IDs to their pools
+	ids     []string                 // ids are the registered tenant IDs in config order
+
+	reads map[*pgxpool.Pool]*readSet // reads maps primary pools to the endpoints their reads can use
+	stop  context.CancelFunc         // stop ends the replica health checks
+
+	tracer pgx.QueryTracer // tracer of the queries of all pools; nil if tracing is disabled
+}
+
+// Open creates the connection pools for the main database and, with tenancy enabled, for every tenant.
+// Schema-per-tenant pools connect to their database with the tenant schema first on the search path.
+// Replicas are opened for the main database and for schema-per-tenant pools, which share it;
+// tenants with a database of their own read from their primary only.
+//
+// Parameters:
+//   - ctx: Context for creating the pools.
+//   - cfg: The application configuration.
+//   - tracer: The tracer of the queries of all pools; nil disables tracing.
+//
+// Returns:
+//   - A pointer to the initialized Pool.
+//   - An error if a tenant is misconfigured or a pool cannot be created.
+func Open(ctx context.Context, cfg *config.Config, tracer pgx.QueryTracer) (*Pool, error) {
+	mainCfg, err := pgxpool.ParseConfig(cfg.DatabaseURL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database url: %w", err)
+	}
+	mainCfg.ConnConfig.Tracer = tracer
+
+	main, err := pgxpool.NewWithConfig(ctx, mainCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database pool: %w", err)
+	}
+
+	p := &Pool{
+		main:    main,
+		tenants: make(map[string]*pgxpool.Pool),
+		reads:   make(map[*pgxpool.Pool]*readSet),
+		tracer:  tracer,
+	}
+
+	if !cfg.Tenancy.Enabled {
+		if err := p.openReplicas(ctx, main, cfg.Database, config.Tenant{}); err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.startHealthChecks(cfg.Database.HealthCheckInterval)
+		return p, nil
+	}
+
+	for _, t := range cfg.Tenancy.Tenants {
+		if _, ok := p.tenants[t.ID]; ok || t.ID == "" {
+			p.Close()
+			return nil, fmt.Errorf("invalid or duplicate tenant id %q", t.ID)
+		}
+
+		poolCfg, err := PoolConfig(t, cfg.DatabaseURL())
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		poolCfg.ConnConfig.Tracer = tracer
+
+		pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to create pool for tenant %s: %w", t.ID, err)
+		}
+
+		p.tenants[t.ID] = pool
+		p.ids = append(p.ids, t.ID)
+
+		if t.DatabaseURL == "" {
+			if err := p.openReplicas(ctx, pool, cfg.Database, t); err != nil {
+				p.Close()
+				return nil, err
+			}
+		}
+	}
+
+	p.startHealthChecks(cfg.Database.HealthCheckInterval)
+	return p, nil
+}
+
+// openReplicas creates the replica pools serving the reads of a primary pool.
+// Replica pools of a schema-per-tenant tenant use the tenant schema like its primary.
+func (p *Pool) openReplicas(ctx context.Context, primary *pgxpool.Pool, db config.Database, t config.Tenant) error {
+	if len(db.Replicas) == 0 {
+		return nil
+	}
+
+	set := &readSet{endpoints: []*endpoint{{pool: primary}}}
+	p.reads[primary] = set
+
+	for _, r := range db.Replicas {
+		poolCfg, err := PoolConfig(config.Tenant{ID: t.ID, Schema: t.Schema, DatabaseURL: r.URL}, "")
+		if err != nil {
+			return fmt.Errorf("replica %s: %w", r.Region, err)
+		}
+		poolCfg.ConnConfig.Tracer = p.tracer
+
+		pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+		if err != nil {
+			return fmt.Errorf("failed to create pool for replica %s: %w", r.Region, err)
+		}
+
+		set.endpoints = append(set.endpoints, &endpoint{pool: pool})
+	}
+
+	return nil
+}
+
+// startHealthChecks measures the health and latency of every read endpoint until the pool is closed.
+// Until the first check completes all reads go to the primary.
+func (p *Pool) startHealthChecks(interval time.Duration) {
+	if len(p.reads) == 0 {
+		return
+	}
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stop = cancel
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			for _, set := range p.reads {
+				for _, e := range set.endpoints {
+					e.check(ctx)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// PoolConfig builds the connection pool configuration of a tenant.
+//
+// Parameters:
+//   - t: The tenant.
+//   - mainURL: The main database URL, used when the tenant has no database of its own.
+//
+// Returns:
+//   - The pool configuration.
+//   - An error if the connection string cannot be parsed.
+func PoolConfig(t config.Tenant, mainURL string) (*pgxpool.Config, error) {
+	url := t.DatabaseURL
+	if url == "" {
+		url = mainURL
+	}
+
+	poolCfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database url of tenant %s: %w", t.ID, err)
+	}
+
+	if t.Schema != "" {
+		poolCfg.ConnConfig.RuntimeParams["search_path"] = pgx.Identifier{t.Schema}.Sanitize() + ", public"
+	}
+
+	return poolCfg, nil
+}
+
+// Enabled reports whether queries are routed per tenant.
+func (p *Pool) Enabled() bool {
+	return len(p.ids) > 0
+}
+
+// Tenants returns the registered tenant IDs.
+func (p *Pool) Tenants() []string {
+	return p.ids
+}
+
+// Has reports whether the tenant is registered.
+//
+// Parameters:
+//   - tenantID: The tenant ID to look up.
+//
+// Returns:
+//   - True if the tenant has a pool.
+func (p *Pool) Has(tenantID string) bool {
+	_, ok := p.tenants[tenantID]
+	return ok
+}
+
+// Exec executes a statement on the pool of the tenant in the context.
+func (p *Pool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	pool, err := p.pool(ctx)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	return pool.Exec(ctx, sql, args...)
+}
+
+// Query runs a query on the pool of the tenant in the context, or on its closest replica
+// if the context is marked with ReadOnly.
+func (p *Pool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	pool, err := p.readPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.Query(ctx, sql, args...)
+}
+
+// QueryRow runs a single-row query on the pool of the tenant in the context.
+// Like Query it may use a replica. A missing or unknown tenant is reported when the row is scanned.
+func (p *Pool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	pool, err := p.readPool(ctx)
+	if err != nil {
+		return errRow{err: err}
+	}
+
+	return pool.QueryRow(ctx, sql, args...)
+}
+
+// BeginTx starts a transaction on the pool of the tenant in the context.
+func (p *Pool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	pool, err := p.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.BeginTx(ctx, txOptions)
+}
+
+// Ping checks the connection of the main database and every tenant pool.
+func (p *Pool) Ping(ctx context.Context) error {
+	if err := p.main.Ping(ctx); err != nil {
+		return err
+	}
+
+	for _, id := range p.ids {
+		if err := p.tenants[id].Ping(ctx); err != nil {
+			return fmt.Errorf("tenant %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// Close stops the health checks and closes all connection pools.
+func (p *Pool) Close() {
+	if p.stop != nil {
+		p.stop()
+	}
+	for _, set := range p.reads {
+		for _, e := range set.endpoints[1:] {
+			e.pool.Close()
+		}
+	}
+	for _, pool := range p.tenants {
+		pool.Close()
+	}
+	p.main.Close()
+}
+
+// pool resolves the connection pool for the tenant in the context.
+func (p *Pool) pool(ctx context.Context) (*pgxpool.Pool, error) {
+	if !p.Enabled() {
+		return p.main, nil
+	}
+
+	tenantID, ok := FromContext(ctx)
+	if !ok {
+		return nil, ErrNoTenant
+	}
+
+	pool, ok := p.tenants[tenantID]
+	if !ok {
+		return nil, ErrUnknownTenant
+	}
+
+	return pool, nil
+}
+
+// readPool resolves the pool a query runs on: the closest healthy endpoint for read-only
+// contexts, the tenant's primary otherwise.
+func (p *Pool) readPool(ctx context.Context) (*pgxpool.Pool, error) {
+	pool, err := p.pool(ctx)
+	if err != nil || !isReadOnly(ctx) {
+		return pool, err
+	}
+
+	if set, ok := p.reads[pool]; ok {
+		return set.pick().pool, nil
+	}
+
+	return pool, nil
+}
+
+// errRow is a pgx.Row that reports an error on Scan.
+type errRow struct {
+	err error // err is returned by Scan
+}
+
+// Scan returns the stored error.
+func (r errRow) Scan(...interface{}) error {
+	return r.err
+}
@@ -0,0 +1,95 @@
+package tenancy
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// defaultHealthCheckInterval is used when the configuration sets no interval.
+	defaultHealthCheckInterval = 10 * time.Second
+
+	// pingTimeout bounds a single health check, so an unreachable region is marked unhealthy quickly.
+	pingTimeout = 2 * time.Second
+)
+
+// readOnlyKey is the context key marking queries that may be served by a replica.
+type readOnlyKey struct{}
+
+// ReadOnly returns a copy of ctx whose queries may be served by the closest healthy replica.
+// Only reads that tolerate replication lag should be marked; statements and transactions
+// always go to the primary, whatever the context says.
+//
+// Parameters:
+//   - ctx: The parent context.
+//
+// Returns:
+//   - A context marking its queries as read-only.
+func ReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// isReadOnly reports whether the queries of ctx may be served by a replica.
+func isReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+// endpoint is a regional connection pool with its last measured health and latency.
+type endpoint struct {
+	pool    *pgxpool.Pool // pool of the endpoint
+	healthy atomic.Bool   // whether the last health check succeeded
+	latency atomic.Int64  // smoothed round-trip time in nanoseconds
+}
+
+// observe records the result of a health check.
+// Latencies are smoothed, so a single slow ping does not move reads to another region.
+func (e *endpoint) observe(rtt time.Duration, err error) {
+	if err != nil {
+		e.healthy.Store(false)
+		return
+	}
+
+	if prev := e.latency.Load(); prev > 0 && e.healthy.Load() {
+		rtt = time.Duration(0.8*float64(prev) + 0.2*float64(rtt))
+	}
+	e.latency.Store(int64(rtt))
+	e.healthy.Store(true)
+}
+
+// check pings the endpoint and records the result.
+func (e *endpoint) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := e.pool.Ping(ctx)
+	e.observe(time.Since(start), err)
+}
+
+// readSet holds the endpoints a read of one database can be served from.
+// The primary comes first and is used whenever no endpoint is known to be healthy.
+type readSet struct {
+	endpoints []*endpoint // primary followed by the replicas
+}
+
+// pick returns the healthy endpoint with the lowest latency, or the primary if none is healthy.
+func (s *readSet) pick() *endpoint {
+	var best *endpoint
+	for _, e := range s.endpoints {
+		if !e.healthy.Load() {
+			continue
+		}
+		if best == nil || e.latency.Load() < best.latency.Load() {
+			best = e
+		}
+	}
+
+	if best == nil {
+		return s.endpoints[0]
+	}
+	return best
+}
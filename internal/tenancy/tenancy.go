@@ -0,0 +1,61 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNoTenant      = errors.New("tenant is required")
+	ErrUnknownTenant = errors.New("unknown tenant")
+)
+
+// ctxKey is the context key under which the tenant ID is stored.
+type ctxKey struct{}
+
+// WithTenant returns a copy of ctx that carries the given tenant ID.
+//
+// Parameters:
+//   - ctx: The parent context.
+//   - tenantID: The tenant ID to attach.
+//
+// Returns:
+//   - A context carrying the tenant ID.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, tenantID)
+}
+
+// FromContext extracts the tenant ID from the context.
+//
+// Parameters:
+//   - ctx: The context to inspect.
+//
+// Returns:
+//   - The tenant ID.
+//   - Whether a tenant ID was present.
+func FromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(ctxKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+// Contexts returns one context per tenant, derived from ctx, for background jobs that must
+// visit every tenant. Without tenants it returns ctx itself, so single-tenant deployments run once.
+//
+// Parameters:
+//   - ctx: The parent context.
+//   - tenantIDs: The registered tenant IDs.
+//
+// Returns:
+//   - The contexts to run the job with.
+func Contexts(ctx context.Context, tenantIDs []string) []context.Context {
+	if len(tenantIDs) == 0 {
+		return []context.Context{ctx}
+	}
+
+	ctxs := make([]context.Context, 0, len(tenantIDs))
+	for _, id := range tenantIDs {
+		ctxs = append(ctxs, WithTenant(ctx, id))
+	}
+
+	return ctxs
+}
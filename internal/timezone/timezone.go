@@ -0,0 +1,54 @@
+package timezone
+
+import (
+	"time"
+
+	// Embed the IANA time zone database, so zones resolve the same in minimal containers without zoneinfo.
+	_ "time/tzdata"
+)
+
+// transitionWindow is how far before and after a wall-clock time the offsets of a zone are sampled.
+// It is wider than any single DST transition, so both the offset before and after a change are found.
+const transitionWindow = 48 * time.Hour
+
+// Resolve converts a wall-clock time in a time zone into an instant, applying the zone's rules at that date.
+// Only the date and clock reading of wall are used; its location is ignored.
+//
+// Unlike time.Date, whose choice is unspecified, DST transitions are handled deterministically:
+//   - a time skipped by a spring-forward transition is moved forward by the length of the gap
+//     (02:30 on a night that jumps from 02:00 to 03:00 becomes 03:30);
+//   - a time repeated by a fall-back transition resolves to its first, earlier occurrence.
+//
+// Parameters:
+//   - wall: The wall-clock date and time.
+//   - loc: The time zone the wall-clock time is in.
+//
+// Returns:
+//   - The resolved instant, in loc.
+func Resolve(wall time.Time, loc *time.Location) time.Time {
+	// The wall-clock reading taken as if it were UTC; subtracting an offset yields a candidate instant.
+	naive := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), time.UTC)
+
+	_, before := naive.Add(-transitionWindow).In(loc).Zone()
+	_, after := naive.Add(transitionWindow).In(loc).Zone()
+
+	earlier, later := before, after
+	if earlier < later {
+		earlier, later = later, earlier // a larger offset gives an earlier instant
+	}
+
+	for _, offset := range []int{earlier, later} {
+		t := naive.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(t, naive) {
+			return t
+		}
+	}
+
+	// The wall-clock time does not exist: read it with the offset in effect before the gap.
+	return naive.Add(-time.Duration(before) * time.Second).In(loc)
+}
+
+// sameWallClock reports whether t reads the same date and clock as the naive UTC wall-clock time.
+func sameWallClock(t, naive time.Time) bool {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Equal(naive)
+}
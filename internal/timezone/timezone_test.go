@@ -0,0 +1,129 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func load(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestResolve(t *testing.T) {
+	berlin := load(t, "Europe/Berlin")
+	newYork := load(t, "America/New_York")
+	kolkata := load(t, "Asia/Kolkata")
+
+	tests := []struct {
+		name string
+		wall time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{
+			name: "winter time",
+			wall: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 1, 15, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "summer time",
+			wall: time.Date(2025, 7, 15, 9, 0, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 7, 15, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day after spring forward keeps the wall clock",
+			wall: time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 3, 31, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "spring forward gap moves forward",
+			wall: time.Date(2025, 3, 30, 2, 30, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 3, 30, 1, 30, 0, 0, time.UTC), // 03:30 CEST
+		},
+		{
+			name: "just before spring forward",
+			wall: time.Date(2025, 3, 30, 1, 59, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 3, 30, 0, 59, 0, 0, time.UTC),
+		},
+		{
+			name: "fall back overlap takes the first occurrence",
+			wall: time.Date(2025, 10, 26, 2, 30, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 10, 26, 0, 30, 0, 0, time.UTC), // 02:30 CEST, not 02:30 CET
+		},
+		{
+			name: "just after fall back",
+			wall: time.Date(2025, 10, 26, 3, 0, 0, 0, time.UTC),
+			loc:  berlin,
+			want: time.Date(2025, 10, 26, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "new york spring forward gap",
+			wall: time.Date(2025, 3, 9, 2, 15, 0, 0, time.UTC),
+			loc:  newYork,
+			want: time.Date(2025, 3, 9, 7, 15, 0, 0, time.UTC), // 03:15 EDT
+		},
+		{
+			name: "new york fall back overlap",
+			wall: time.Date(2025, 11, 2, 1, 30, 0, 0, time.UTC),
+			loc:  newYork,
+			want: time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+		},
+		{
+			name: "zone without DST",
+			wall: time.Date(2025, 3, 30, 2, 30, 0, 0, time.UTC),
+			loc:  kolkata,
+			want: time.Date(2025, 3, 29, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "location of wall is ignored",
+			wall: time.Date(2025, 7, 15, 9, 0, 0, 0, time.FixedZone("", 5*3600)),
+			loc:  berlin,
+			want: time.Date(2025, 7, 15, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resolve(tt.wall, tt.loc)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got.UTC())
+			assert.Equal(t, tt.loc, got.Location())
+		})
+	}
+}
+
+// A daily reminder at 09:00 local time stays at 09:00 across both transitions,
+// while its UTC instant moves by the change of the offset.
+func TestResolve_DailyAcrossTransitions(t *testing.T) {
+	berlin := load(t, "Europe/Berlin")
+
+	for _, day := range []time.Time{
+		time.Date(2025, 3, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 10, 25, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 10, 26, 9, 0, 0, 0, time.UTC),
+	} {
+		got := Resolve(day, berlin)
+		assert.Equal(t, 9, got.Hour(), day.Format(time.DateOnly))
+	}
+
+	before := Resolve(time.Date(2025, 3, 29, 9, 0, 0, 0, time.UTC), berlin)
+	after := Resolve(time.Date(2025, 3, 30, 9, 0, 0, 0, time.UTC), berlin)
+	assert.Equal(t, 23*time.Hour, after.Sub(before))
+
+	before = Resolve(time.Date(2025, 10, 25, 9, 0, 0, 0, time.UTC), berlin)
+	after = Resolve(time.Date(2025, 10, 26, 9, 0, 0, 0, time.UTC), berlin)
+	assert.Equal(t, 25*time.Hour, after.Sub(before))
+}
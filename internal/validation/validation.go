@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// Defaults of the configurable limits.
+const (
+	DefaultTitleMaxLength       = 255
+	DefaultDescriptionMaxLength = 1000
+	DefaultPasswordMinLength    = 8
+	DefaultBulkMaxEvents        = 100
+)
+
+// Tags of the configurable rules, used in validate struct tags like built-in tags, e.g. `validate:"required,event_title"`.
+const (
+	TagEventTitle       = "event_title"       // length of an event title
+	TagEventDescription = "event_description" // length of an event description
+	TagPassword         = "password"          // length of the password of a new account
+	TagBulkEvents       = "bulk_events"       // number of events of a bulk request
+	TagColor            = "display_color"     // display color, a hex color or a name of model.ColorPalette
+)
+
+// New creates a validator whose configurable rules are built from the deployment's limits.
+// The rules are registered as aliases of built-in tags, so they are resolved once when a struct is first validated.
+//
+// Parameters:
+//   - cfg: The validation limits; zero limits keep their defaults.
+//
+// Returns:
+//   - A pointer to the initialized validator.
+func New(cfg config.Validation) *validator.Validate {
+	v := validator.New()
+
+	v.RegisterAlias(TagEventTitle, fmt.Sprintf("min=3,max=%d", orDefault(cfg.TitleMaxLength, DefaultTitleMaxLength)))
+	v.RegisterAlias(TagEventDescription, fmt.Sprintf("max=%d", orDefault(cfg.DescriptionMaxLength, DefaultDescriptionMaxLength)))
+	v.RegisterAlias(TagPassword, fmt.Sprintf("min=%d", orDefault(cfg.PasswordMinLength, DefaultPasswordMinLength)))
+	v.RegisterAlias(TagBulkEvents, fmt.Sprintf("min=1,max=%d", orDefault(cfg.BulkMaxEvents, DefaultBulkMaxEvents)))
+	v.RegisterAlias(TagColor, "hexcolor|oneof="+strings.Join(model.ColorPalette, " "))
+
+	return v
+}
+
+// orDefault returns limit, or def if the limit is not set.
+func orDefault(limit, def int) int {
+	if limit <= 0 {
+		return def
+	}
+	return limit
+}
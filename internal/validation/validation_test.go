@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+type request struct {
+	Title       string   `validate:"required,event_title"`
+	Description string   `validate:"event_description"`
+	Password    string   `validate:"required,password"`
+	EventIDs    []string `validate:"required,bulk_events"`
+	Color       string   `validate:"omitempty,display_color"`
+}
+
+func TestNew_Defaults(t *testing.T) {
+	v := New(config.Validation{})
+
+	valid := request{Title: strings.Repeat("a", DefaultTitleMaxLength), Password: "password", EventIDs: []string{"1"}, Color: "teal"}
+	if err := v.Struct(valid); err != nil {
+		t.Fatalf("expected request within the default limits to be valid: %v", err)
+	}
+
+	tests := map[string]request{
+		"title too long":   {Title: strings.Repeat("a", DefaultTitleMaxLength+1), Password: "password", EventIDs: []string{"1"}},
+		"title too short":  {Title: "ab", Password: "password", EventIDs: []string{"1"}},
+		"description":      {Title: "Standup", Description: strings.Repeat("a", DefaultDescriptionMaxLength+1), Password: "password", EventIDs: []string{"1"}},
+		"password":         {Title: "Standup", Password: "short", EventIDs: []string{"1"}},
+		"too many events":  {Title: "Standup", Password: "password", EventIDs: make([]string, DefaultBulkMaxEvents+1)},
+		"no events at all": {Title: "Standup", Password: "password", EventIDs: []string{}},
+		"unknown color":    {Title: "Standup", Password: "password", EventIDs: []string{"1"}, Color: "chartreuse"},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := v.Struct(req); err == nil {
+				t.Fatal("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestNew_Configured(t *testing.T) {
+	v := New(config.Validation{TitleMaxLength: 10, DescriptionMaxLength: 5, PasswordMinLength: 12, BulkMaxEvents: 2})
+
+	if err := v.Struct(request{Title: "Standup", Description: "short", Password: "longpassword", EventIDs: []string{"1", "2"}}); err != nil {
+		t.Fatalf("expected request within the configured limits to be valid: %v", err)
+	}
+	if err := v.Struct(request{Title: "Weekly standup", Password: "longpassword", EventIDs: []string{"1"}}); err == nil {
+		t.Fatal("expected a title longer than the configured limit to be rejected")
+	}
+	if err := v.Struct(request{Title: "Standup", Password: "password", EventIDs: []string{"1"}}); err == nil {
+		t.Fatal("expected a password shorter than the configured minimum to be rejected")
+	}
+	if err := v.Struct(request{Title: "Standup", Password: "longpassword", EventIDs: []string{"1", "2", "3"}}); err == nil {
+		t.Fatal("expected more events than the configured limit to be rejected")
+	}
+}
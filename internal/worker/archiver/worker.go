@@ -2,27 +2,97 @@ package archiver
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
 )
 
+// defaultBatchSize is the number of events archived per transaction when none is configured.
+const defaultBatchSize = 5000
+
 // eventService defines an interface for archiving old events.
 type eventService interface {
-	// ArchiveOldEvents moves old events to an archive or marks them as archived.
-	ArchiveOldEvents(ctx context.Context) error
+	// ArchiveOldEvents moves up to limit old events to an archive and returns how many were moved.
+	// With dualWrite their reminders are written in both archive formats.
+	ArchiveOldEvents(ctx context.Context, limit int, dualWrite bool) (int, error)
+
+	// PurgeTrash permanently deletes up to limit events trashed longer than the retention, whose owners were notified
+	// at least the notice period before, and returns how many were deleted.
+	PurgeTrash(ctx context.Context, retention, notice time.Duration, limit int) (int, error)
+
+	// NotifyTrashExpiring notifies the owners of up to limit trashed events purged within the notice period
+	// and returns how many events were announced.
+	NotifyTrashExpiring(ctx context.Context, retention, notice time.Duration, limit int) (int, error)
 }
 
-// Worker is responsible for periodically archiving old events.
+// historyService defines an interface for deleting notification history past its retention.
+type historyService interface {
+	// PurgeHistory deletes the notification history older than the retention and returns how many reminders were deleted.
+	PurgeHistory(ctx context.Context) (int, error)
+}
+
+// coldStorage defines an interface for exporting long-archived events to cold storage.
+type coldStorage interface {
+	// Enabled reports whether archived events are exported to cold storage.
+	Enabled() bool
+
+	// Export moves up to limit events archived for longer than the retention to cold storage and returns how many were moved.
+	Export(ctx context.Context, limit int) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
+// Worker is responsible for periodically archiving old events, exporting events archived for long to cold storage
+// if enabled, and purging trashed events and notification history past their retention.
 type Worker struct {
-	eventService eventService // service that performs the archiving
-	logger       *zap.Logger  // structured logger
+	eventService eventService    // service that performs the archiving and purges the trash
+	history      historyService  // service that purges the notification history
+	coldStorage  coldStorage     // service that exports long-archived events
+	maintenance  maintenanceMode // skips archiving while the service is in maintenance mode
+	tenants      []string        // tenants archived in turn; empty without tenancy
+	config       config.Archiver // batch size and pacing
+	clock        clock.Clock     // source of the current time, the interval ticker and the pauses
+	logger       *zap.Logger     // structured logger
+
+	mu     sync.Mutex           // guards status
+	status model.ArchiverStatus // activity since the worker started
 }
 
 // NewWorker creates a new archiver worker.
-func NewWorker(eventService eventService, l *zap.Logger) *Worker {
+// A non-positive batch size falls back to 5000 events per transaction.
+func NewWorker(
+	eventService eventService,
+	history historyService,
+	cold coldStorage,
+	maintenance maintenanceMode,
+	tenants []string,
+	cfg config.Archiver,
+	clk clock.Clock,
+	l *zap.Logger,
+) *Worker {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
 	return &Worker{
 		eventService: eventService,
+		history:      history,
+		coldStorage:  cold,
+		maintenance:  maintenance,
+		tenants:      tenants,
+		config:       cfg,
+		clock:        clk,
 		logger:       l,
 	}
 }
@@ -31,20 +101,16 @@ func NewWorker(eventService eventService, l *zap.Logger) *Worker {
 // It runs a background goroutine that triggers ArchiveOldEvents
 // at the specified interval. The goroutine stops gracefully when ctx is canceled.
 func (w *Worker) Start(ctx context.Context, interval time.Duration) {
-	ticker := time.NewTicker(interval)
+	ticker := w.clock.NewTicker(interval)
 
 	go func() {
 		defer ticker.Stop() // stop the ticker when the goroutine exits
 
 		for {
 			select {
-			case <-ticker.C:
+			case <-ticker.C():
 				// Time to archive old events.
-				if err := w.eventService.ArchiveOldEvents(ctx); err != nil {
-					w.logger.Error("failed to archive old events", zap.Error(err))
-				} else {
-					w.logger.Info("successfully archived old events")
-				}
+				w.archive(ctx)
 			case <-ctx.Done():
 				// Context cancelled, stop the worker gracefully.
 				w.logger.Info("archiver worker stopped")
@@ -53,3 +119,197 @@ func (w *Worker) Start(ctx context.Context, interval time.Duration) {
 		}
 	}()
 }
+
+// archive runs a single archiving pass over every tenant. After archiving the events of a tenant, it exports its
+// long-archived events to cold storage if enabled, notifies the owners of trashed events about to be purged,
+// and purges its trash and its notification history.
+// A panic during the pass is logged at Error level, so it is reported, and does not stop the worker.
+// Passes are skipped while the service is in maintenance mode.
+func (w *Worker) archive(ctx context.Context) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping archiving")
+		return
+	}
+
+	start := w.clock.Now()
+	total, exportedTotal, purgedTotal := 0, 0, 0
+
+	w.mu.Lock()
+	w.status.Runs++
+	w.status.LastRunAt = &start
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		w.status.LastRunDuration = w.clock.Since(start)
+		w.status.LastRunArchived = total
+		w.status.LastRunExported = exportedTotal
+		w.status.LastRunPurged = purgedTotal
+		w.mu.Unlock()
+	}()
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			w.recordError(fmt.Errorf("panic: %v", rec))
+			w.logger.Error("archiver worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+		}
+	}()
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		tenantID, _ := tenancy.FromContext(tenantCtx)
+
+		archived, err := w.archiveTenant(tenantCtx)
+		total += archived
+		if err != nil {
+			w.recordError(err)
+			w.logger.Error("failed to archive old events",
+				zap.String("tenant", tenantID), zap.Int("archived", archived), zap.Error(err))
+		} else {
+			w.logger.Info("successfully archived old events", zap.String("tenant", tenantID), zap.Int("archived", archived))
+		}
+
+		if w.coldStorage.Enabled() {
+			exported, err := w.exportTenant(tenantCtx)
+			exportedTotal += exported
+			if err != nil {
+				w.recordError(err)
+				w.logger.Error("failed to export archived events to cold storage",
+					zap.String("tenant", tenantID), zap.Int("exported", exported), zap.Error(err))
+			} else if exported > 0 {
+				w.logger.Info("exported archived events to cold storage", zap.String("tenant", tenantID), zap.Int("exported", exported))
+			}
+		}
+
+		notified, err := w.notifyTrashTenant(tenantCtx)
+		if err != nil {
+			w.recordError(err)
+			w.logger.Error("failed to notify owners of expiring trash",
+				zap.String("tenant", tenantID), zap.Int("notified", notified), zap.Error(err))
+		} else if notified > 0 {
+			w.logger.Info("notified owners of expiring trash", zap.String("tenant", tenantID), zap.Int("events", notified))
+		}
+
+		trashed, err := w.purgeTrashTenant(tenantCtx)
+		purgedTotal += trashed
+		if err != nil {
+			w.recordError(err)
+			w.logger.Error("failed to purge trashed events",
+				zap.String("tenant", tenantID), zap.Int("purged", trashed), zap.Error(err))
+		} else if trashed > 0 {
+			w.logger.Info("purged trashed events", zap.String("tenant", tenantID), zap.Int("purged", trashed))
+		}
+
+		purged, err := w.history.PurgeHistory(tenantCtx)
+		if err != nil {
+			w.recordError(err)
+			w.logger.Error("failed to purge notification history", zap.String("tenant", tenantID), zap.Error(err))
+		} else if purged > 0 {
+			w.logger.Info("purged notification history", zap.String("tenant", tenantID), zap.Int("reminders", purged))
+		}
+	}
+}
+
+// recordError counts a failed tenant pass and keeps its error for the status.
+func (w *Worker) recordError(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.status.Errors++
+	w.status.LastError = err.Error()
+}
+
+// Status reports the activity of the worker since it started.
+//
+// Returns:
+//   - The worker status.
+func (w *Worker) Status() model.ArchiverStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.status
+}
+
+// archiveTenant archives old events of one tenant in batches, pausing between batches.
+// It stops when no old events are left, after the configured maximum of batches,
+// or when the worker is stopped or maintenance mode is switched on.
+// Reminders are written in both archive formats while dual-write mode is configured.
+//
+// Parameters:
+//   - ctx: The context of the tenant.
+//
+// Returns:
+//   - The number of archived events.
+//   - An error if a batch fails; earlier batches stay archived.
+func (w *Worker) archiveTenant(ctx context.Context) (int, error) {
+	return w.inBatches(ctx, func(ctx context.Context, limit int) (int, error) {
+		return w.eventService.ArchiveOldEvents(ctx, limit, w.config.DualWrite)
+	})
+}
+
+// exportTenant exports the long-archived events of one tenant to cold storage in batches, like archiveTenant.
+//
+// Parameters:
+//   - ctx: The context of the tenant.
+//
+// Returns:
+//   - The number of exported events.
+//   - An error if a batch fails; earlier batches stay exported.
+func (w *Worker) exportTenant(ctx context.Context) (int, error) {
+	return w.inBatches(ctx, w.coldStorage.Export)
+}
+
+// notifyTrashTenant notifies the owners of the trashed events of one tenant purged within the trash notice period
+// in batches, like archiveTenant. Without a retention or a notice period nothing is sent.
+//
+// Parameters:
+//   - ctx: The context of the tenant.
+//
+// Returns:
+//   - The number of announced events.
+//   - An error if a batch fails; earlier batches stay announced.
+func (w *Worker) notifyTrashTenant(ctx context.Context) (int, error) {
+	return w.inBatches(ctx, func(ctx context.Context, limit int) (int, error) {
+		return w.eventService.NotifyTrashExpiring(ctx, w.config.TrashRetention, w.config.TrashNotice, limit)
+	})
+}
+
+// purgeTrashTenant permanently deletes the events of one tenant trashed longer than the trash retention in batches,
+// like archiveTenant. With a notice period, only events whose owners were notified that long before are deleted.
+// Without a retention nothing is deleted.
+//
+// Parameters:
+//   - ctx: The context of the tenant.
+//
+// Returns:
+//   - The number of deleted events.
+//   - An error if a batch fails; earlier batches stay deleted.
+func (w *Worker) purgeTrashTenant(ctx context.Context) (int, error) {
+	return w.inBatches(ctx, func(ctx context.Context, limit int) (int, error) {
+		return w.eventService.PurgeTrash(ctx, w.config.TrashRetention, w.config.TrashNotice, limit)
+	})
+}
+
+// inBatches calls step with the batch size until it processes fewer events than that, pausing between batches.
+// It stops early after the configured maximum of batches, or when the worker is stopped or maintenance mode
+// is switched on.
+func (w *Worker) inBatches(ctx context.Context, step func(ctx context.Context, limit int) (int, error)) (int, error) {
+	total := 0
+
+	for batch := 1; ; batch++ {
+		n, err := step(ctx, w.config.BatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += n
+
+		if n < w.config.BatchSize || batch == w.config.MaxBatches || w.maintenance.Enabled() {
+			return total, nil
+		}
+
+		select {
+		case <-w.clock.After(w.config.Pause):
+		case <-ctx.Done():
+			return total, nil
+		}
+	}
+}
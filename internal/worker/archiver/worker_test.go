@@ -0,0 +1,240 @@
+package archiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+)
+
+// fakeEventService archives from a fixed number of old events and purges from a fixed number of trashed events.
+type fakeEventService struct {
+	left      int           // old events not archived yet
+	batches   int           // number of calls
+	dualWrite bool          // whether the last call wrote both archive formats
+	err       error         // error returned by the next call
+	trashed   int           // trashed events not purged yet
+	retention time.Duration // trash retention of the last purge
+	notice    time.Duration // trash notice period of the last purge
+	expiring  int           // trashed events whose owners are not notified yet
+	calls     []string      // trash operations in the order they were called
+}
+
+func (s *fakeEventService) ArchiveOldEvents(_ context.Context, limit int, dualWrite bool) (int, error) {
+	s.batches++
+	s.dualWrite = dualWrite
+	if s.err != nil {
+		return 0, s.err
+	}
+	n := min(limit, s.left)
+	s.left -= n
+	return n, nil
+}
+
+func (s *fakeEventService) PurgeTrash(_ context.Context, retention, notice time.Duration, limit int) (int, error) {
+	s.calls = append(s.calls, "purge")
+	s.retention, s.notice = retention, notice
+	n := min(limit, s.trashed)
+	s.trashed -= n
+	return n, nil
+}
+
+func (s *fakeEventService) NotifyTrashExpiring(_ context.Context, _, _ time.Duration, limit int) (int, error) {
+	s.calls = append(s.calls, "notify")
+	n := min(limit, s.expiring)
+	s.expiring -= n
+	return n, nil
+}
+
+// fakeHistoryService counts history purges.
+type fakeHistoryService struct {
+	purges int   // number of calls
+	err    error // error returned by every call
+}
+
+func (s *fakeHistoryService) PurgeHistory(context.Context) (int, error) {
+	s.purges++
+	return 0, s.err
+}
+
+// coldStorageOff is a cold storage that is not enabled.
+type coldStorageOff struct{}
+
+func (coldStorageOff) Enabled() bool { return false }
+
+func (coldStorageOff) Export(context.Context, int) (int, error) {
+	panic("export while cold storage is not enabled")
+}
+
+// fakeColdStorage exports from a fixed number of long-archived events.
+type fakeColdStorage struct {
+	left int // archived events not exported yet
+}
+
+func (*fakeColdStorage) Enabled() bool { return true }
+
+func (s *fakeColdStorage) Export(_ context.Context, limit int) (int, error) {
+	n := min(limit, s.left)
+	s.left -= n
+	return n, nil
+}
+
+// maintenanceOff is a maintenance mode that is never enabled.
+type maintenanceOff struct{}
+
+func (maintenanceOff) Enabled() bool { return false }
+
+func TestWorker_ArchiveTenant_Batches(t *testing.T) {
+	svc := &fakeEventService{left: 25}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10}, clock.Real(), zap.NewNop())
+
+	archived, err := w.archiveTenant(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 25, archived)
+	assert.Equal(t, 3, svc.batches)
+	assert.False(t, svc.dualWrite)
+}
+
+func TestWorker_ArchiveTenant_DualWrite(t *testing.T) {
+	svc := &fakeEventService{left: 5}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10, DualWrite: true}, clock.Real(), zap.NewNop())
+
+	_, err := w.archiveTenant(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, svc.dualWrite)
+}
+
+func TestWorker_ArchiveTenant_MaxBatches(t *testing.T) {
+	svc := &fakeEventService{left: 100}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10, MaxBatches: 2}, clock.Real(), zap.NewNop())
+
+	archived, err := w.archiveTenant(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 20, archived)
+	assert.Equal(t, 80, svc.left)
+}
+
+func TestWorker_ArchiveTenant_Error(t *testing.T) {
+	svc := &fakeEventService{left: 100, err: errors.New("deadlock detected")}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10}, clock.Real(), zap.NewNop())
+
+	_, err := w.archiveTenant(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 1, svc.batches)
+}
+
+func TestWorker_Status(t *testing.T) {
+	svc := &fakeEventService{left: 15}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10}, clock.Real(), zap.NewNop())
+
+	assert.Nil(t, w.Status().LastRunAt)
+
+	w.archive(context.Background())
+	status := w.Status()
+	assert.Equal(t, int64(1), status.Runs)
+	assert.NotNil(t, status.LastRunAt)
+	assert.Equal(t, 15, status.LastRunArchived)
+	assert.Zero(t, status.Errors)
+
+	svc.err = errors.New("deadlock detected")
+	w.archive(context.Background())
+	status = w.Status()
+	assert.Equal(t, int64(2), status.Runs)
+	assert.Equal(t, int64(1), status.Errors)
+	assert.Equal(t, "deadlock detected", status.LastError)
+	assert.Zero(t, status.LastRunArchived)
+}
+
+func TestWorker_ArchiveTenant_PausesBetweenBatches(t *testing.T) {
+	start := time.Date(2025, 10, 15, 3, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+	svc := &fakeEventService{left: 25}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10, Pause: time.Minute}, clk, zap.NewNop())
+
+	done := make(chan int)
+	go func() {
+		archived, _ := w.archiveTenant(context.Background())
+		done <- archived
+	}()
+
+	// Two full batches, each followed by a pause; the third batch is the last one.
+	for i := 0; i < 2; i++ {
+		clk.BlockUntil(1)
+		clk.Advance(time.Minute)
+	}
+
+	assert.Equal(t, 25, <-done)
+	assert.Equal(t, 3, svc.batches)
+	assert.Equal(t, 2*time.Minute, clk.Since(start))
+}
+
+func TestWorker_Start_ArchivesEveryInterval(t *testing.T) {
+	clk := clock.NewFake(time.Date(2025, 10, 15, 3, 0, 0, 0, time.UTC))
+	w := NewWorker(&fakeEventService{left: 5}, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil, config.Archiver{BatchSize: 10}, clk, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx, time.Hour)
+
+	clk.BlockUntil(1)
+	assert.Zero(t, w.Status().Runs)
+
+	clk.Advance(time.Hour)
+	assert.Eventually(t, func() bool { return w.Status().Runs == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, clk.Now(), *w.Status().LastRunAt)
+	assert.Equal(t, 5, w.Status().LastRunArchived)
+}
+
+func TestWorker_Archive_PurgesHistory(t *testing.T) {
+	history := &fakeHistoryService{}
+	w := NewWorker(&fakeEventService{}, history, coldStorageOff{}, maintenanceOff{}, []string{"acme", "globex"}, config.Archiver{BatchSize: 10},
+		clock.Real(), zap.NewNop())
+
+	w.archive(context.Background())
+	assert.Equal(t, 2, history.purges, "history is purged once per tenant")
+	assert.Zero(t, w.Status().Errors)
+
+	history.err = errors.New("connection refused")
+	w.archive(context.Background())
+	assert.Equal(t, int64(2), w.Status().Errors)
+	assert.Equal(t, "connection refused", w.Status().LastError)
+}
+
+func TestWorker_Archive_ExportsToColdStorage(t *testing.T) {
+	cold := &fakeColdStorage{left: 25}
+	w := NewWorker(&fakeEventService{left: 5}, &fakeHistoryService{}, cold, maintenanceOff{}, nil, config.Archiver{BatchSize: 10},
+		clock.Real(), zap.NewNop())
+
+	w.archive(context.Background())
+	assert.Zero(t, cold.left)
+	assert.Equal(t, 5, w.Status().LastRunArchived)
+	assert.Equal(t, 25, w.Status().LastRunExported)
+}
+
+func TestWorker_Archive_PurgesTrash(t *testing.T) {
+	svc := &fakeEventService{trashed: 15}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil,
+		config.Archiver{BatchSize: 10, TrashRetention: 720 * time.Hour}, clock.Real(), zap.NewNop())
+
+	w.archive(context.Background())
+	assert.Zero(t, svc.trashed)
+	assert.Equal(t, 720*time.Hour, svc.retention)
+	assert.Equal(t, 15, w.Status().LastRunPurged)
+}
+
+func TestWorker_Archive_NotifiesBeforePurging(t *testing.T) {
+	svc := &fakeEventService{expiring: 15}
+	w := NewWorker(svc, &fakeHistoryService{}, coldStorageOff{}, maintenanceOff{}, nil,
+		config.Archiver{BatchSize: 10, TrashRetention: 720 * time.Hour, TrashNotice: 72 * time.Hour}, clock.Real(), zap.NewNop())
+
+	w.archive(context.Background())
+	assert.Zero(t, svc.expiring)
+	assert.Equal(t, 72*time.Hour, svc.notice)
+	assert.Equal(t, []string{"notify", "notify", "purge"}, svc.calls)
+}
@@ -0,0 +1,97 @@
+package demo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// userService defines an interface for deleting expired demo accounts.
+type userService interface {
+	// DeleteExpiredDemoAccounts deletes the expired demo accounts with their data and returns how many were deleted.
+	DeleteExpiredDemoAccounts(ctx context.Context) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
+// Worker is responsible for periodically wiping the throwaway accounts of the demo mode.
+type Worker struct {
+	service     userService     // service that deletes the expired accounts
+	maintenance maintenanceMode // skips runs while the service is in maintenance mode
+	tenants     []string        // tenants processed in turn; empty without tenancy
+	clock       clock.Clock     // source of the interval ticker
+	logger      *zap.Logger     // structured logger
+}
+
+// NewWorker creates a new demo cleanup worker.
+func NewWorker(
+	service userService,
+	maintenance maintenanceMode,
+	tenants []string,
+	clk clock.Clock,
+	l *zap.Logger,
+) *Worker {
+	return &Worker{
+		service:     service,
+		maintenance: maintenance,
+		tenants:     tenants,
+		clock:       clk,
+		logger:      l,
+	}
+}
+
+// Start begins wiping expired demo accounts.
+// It runs a background goroutine that triggers DeleteExpiredDemoAccounts
+// at the specified interval. The goroutine stops gracefully when ctx is canceled.
+func (w *Worker) Start(ctx context.Context, interval time.Duration) {
+	ticker := w.clock.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C():
+				w.cleanup(ctx)
+			case <-ctx.Done():
+				w.logger.Info("demo cleanup worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// cleanup deletes the expired demo accounts of every tenant.
+// A panic during the run is logged at Error level and does not stop the worker.
+// Runs are skipped while the service is in maintenance mode.
+func (w *Worker) cleanup(ctx context.Context) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping demo cleanup")
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			w.logger.Error("demo cleanup worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+		}
+	}()
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		tenantID, _ := tenancy.FromContext(tenantCtx)
+
+		deleted, err := w.service.DeleteExpiredDemoAccounts(tenantCtx)
+		if err != nil {
+			w.logger.Error("failed to delete expired demo accounts", zap.String("tenant", tenantID), zap.Error(err))
+		} else if deleted > 0 {
+			w.logger.Info("deleted expired demo accounts", zap.String("tenant", tenantID), zap.Int("deleted", deleted))
+		}
+	}
+}
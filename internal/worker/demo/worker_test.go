@@ -0,0 +1,44 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+)
+
+// fakeUserService counts the runs per tenant.
+type fakeUserService struct {
+	calls int   // number of calls
+	err   error // error returned by every call
+}
+
+func (s *fakeUserService) DeleteExpiredDemoAccounts(_ context.Context) (int, error) {
+	s.calls++
+	return 1, s.err
+}
+
+// maintenance is a maintenance mode with a fixed state.
+type maintenance bool
+
+func (m maintenance) Enabled() bool { return bool(m) }
+
+func TestWorker_Cleanup_Tenants(t *testing.T) {
+	svc := &fakeUserService{err: errors.New("connection reset")}
+	w := NewWorker(svc, maintenance(false), []string{"acme", "globex"}, clock.Real(), zap.NewNop())
+
+	w.cleanup(context.Background())
+	assert.Equal(t, 2, svc.calls, "a failing tenant does not stop the others")
+}
+
+func TestWorker_Cleanup_Maintenance(t *testing.T) {
+	svc := &fakeUserService{}
+	w := NewWorker(svc, maintenance(true), nil, clock.Real(), zap.NewNop())
+
+	w.cleanup(context.Background())
+	assert.Zero(t, svc.calls)
+}
@@ -0,0 +1,233 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// jobService defines an interface for claiming and executing background jobs.
+type jobService interface {
+	// ClaimNext claims the oldest queued job for the given worker instance.
+	ClaimNext(ctx context.Context, owner string) (*model.Job, error)
+
+	// Execute runs a claimed job and records its outcome.
+	Execute(ctx context.Context, job model.Job, owner string) (model.Job, error)
+
+	// FailExpired fails the running jobs whose worker stopped without finishing them.
+	FailExpired(ctx context.Context) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
+// Worker is a pool of workers executing queued background jobs.
+// Each worker of the pool polls for jobs on its own and executes one job at a time,
+// so at most the configured number of jobs run at once on this instance. Jobs are claimed
+// with leases, so several service instances can share the queue.
+type Worker struct {
+	jobService  jobService      // service to claim and execute jobs
+	maintenance maintenanceMode // pauses claiming while the service is in maintenance mode
+	tenants     []string        // tenants polled in turn; empty without tenancy
+	config      config.Job      // pool size and poll interval
+	clock       clock.Clock     // source of the current time and the poll tickers
+	logger      *zap.Logger     // structured logger
+	owner       string          // identifier of this worker instance
+	wg          sync.WaitGroup  // wait group for the workers of the pool
+
+	running    atomic.Int64 // jobs being executed right now
+	completed  atomic.Int64 // jobs that completed
+	failed     atomic.Int64 // jobs that failed
+	cancelled  atomic.Int64 // jobs cancelled while running
+	errCount   atomic.Int64 // failures to claim jobs or to record their outcome
+	lastPollAt atomic.Int64 // time of the last poll in Unix nanoseconds; 0 before the first one
+}
+
+// NewWorker creates a new job worker pool.
+// A non-positive pool size falls back to a single worker.
+func NewWorker(
+	jobService jobService,
+	maintenance maintenanceMode,
+	tenants []string,
+	cfg config.Job,
+	clk clock.Clock,
+	l *zap.Logger,
+) *Worker {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+
+	hostname, _ := os.Hostname()
+
+	return &Worker{
+		jobService:  jobService,
+		maintenance: maintenance,
+		tenants:     tenants,
+		config:      cfg,
+		clock:       clk,
+		logger:      l,
+		owner:       fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+// Start begins executing jobs in the background with the configured number of workers.
+// Every poll interval, each idle worker executes queued jobs until the queue is empty.
+// The first worker also fails the jobs of instances that stopped without finishing them.
+// Canceling ctx stops the pool and interrupts the running jobs, which are marked as failed.
+func (w *Worker) Start(ctx context.Context) {
+	for slot := 0; slot < w.config.Workers; slot++ {
+		ticker := w.clock.NewTicker(w.config.PollInterval)
+
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			defer ticker.Stop() // stop the ticker when the goroutine exits
+
+			for {
+				select {
+				case <-ticker.C():
+					w.poll(ctx, slot == 0)
+				case <-ctx.Done():
+					// Context cancelled, stop claiming new jobs.
+					return
+				}
+			}
+		}()
+	}
+}
+
+// poll executes queued jobs of every tenant until none are left.
+// With expire set, running jobs with expired leases are failed first.
+// Nothing is claimed while the service is in maintenance mode; queued jobs run once it ends.
+func (w *Worker) poll(ctx context.Context, expire bool) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping job poll")
+		return
+	}
+
+	w.lastPollAt.Store(w.clock.Now().UnixNano())
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		if expire {
+			w.failExpired(tenantCtx)
+		}
+		w.pollTenant(tenantCtx)
+	}
+}
+
+// failExpired fails the jobs of the tenant in ctx whose lease has expired.
+func (w *Worker) failExpired(ctx context.Context) {
+	tenantID, _ := tenancy.FromContext(ctx)
+
+	n, err := w.jobService.FailExpired(ctx)
+	if err != nil {
+		w.errCount.Add(1)
+		w.logger.Error("failed to fail expired jobs", zap.String("tenant", tenantID), zap.Error(err))
+		return
+	}
+	if n > 0 {
+		w.logger.Warn("failed jobs with expired leases", zap.String("tenant", tenantID), zap.Int("count", n))
+	}
+}
+
+// pollTenant claims and executes queued jobs of the tenant in ctx one at a time until none are left,
+// the worker is stopped, or maintenance mode is switched on.
+func (w *Worker) pollTenant(ctx context.Context) {
+	tenantID, _ := tenancy.FromContext(ctx)
+
+	for ctx.Err() == nil && !w.maintenance.Enabled() {
+		job, err := w.jobService.ClaimNext(ctx, w.owner)
+		if err != nil {
+			w.errCount.Add(1)
+			w.logger.Error("failed to claim job", zap.String("tenant", tenantID), zap.Error(err))
+			return
+		}
+		if job == nil {
+			return
+		}
+
+		w.execute(ctx, *job)
+	}
+}
+
+// execute runs a claimed job and counts its outcome.
+func (w *Worker) execute(ctx context.Context, job model.Job) {
+	w.running.Add(1)
+	defer w.running.Add(-1)
+	defer w.recoverPanic()
+
+	w.logger.Info("executing job", zap.String("job_id", job.ID.String()), zap.String("kind", job.Kind))
+
+	finished, err := w.jobService.Execute(ctx, job, w.owner)
+
+	switch finished.Status {
+	case model.JobCompleted:
+		w.completed.Add(1)
+	case model.JobCancelled:
+		w.cancelled.Add(1)
+	case model.JobFailed:
+		w.failed.Add(1)
+	}
+
+	if err != nil {
+		w.errCount.Add(1)
+		w.logger.Error("failed to record job outcome", zap.String("job_id", job.ID.String()), zap.Error(err))
+		return
+	}
+
+	w.logger.Info("job finished",
+		zap.String("job_id", job.ID.String()),
+		zap.String("status", finished.Status),
+		zap.String("error", finished.Error),
+	)
+}
+
+// recoverPanic logs a panic in a worker at Error level, so it is reported,
+// instead of crashing the whole process.
+func (w *Worker) recoverPanic() {
+	if rec := recover(); rec != nil {
+		w.errCount.Add(1)
+		w.logger.Error("job worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+	}
+}
+
+// Status reports the activity of the pool since it started.
+//
+// Returns:
+//   - The worker pool status.
+func (w *Worker) Status() model.JobWorkerStatus {
+	status := model.JobWorkerStatus{
+		Workers:   w.config.Workers,
+		Running:   w.running.Load(),
+		Completed: w.completed.Load(),
+		Failed:    w.failed.Load(),
+		Cancelled: w.cancelled.Load(),
+		Errors:    w.errCount.Load(),
+	}
+
+	if ns := w.lastPollAt.Load(); ns != 0 {
+		t := time.Unix(0, ns)
+		status.LastPollAt = &t
+	}
+
+	return status
+}
+
+// Stop waits for the workers of the pool to finish their running jobs.
+// Useful for graceful shutdown, after ctx passed to Start is canceled.
+func (w *Worker) Stop() {
+	w.wg.Wait()
+}
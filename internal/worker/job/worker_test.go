@@ -0,0 +1,121 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/config"
+	"github.com/aliskhannn/calendar-service/internal/model"
+)
+
+// fakeJobService hands out the queued jobs one at a time and finishes them with their preset status.
+type fakeJobService struct {
+	queue    []model.Job // jobs returned by the next claims; Status is the outcome of Execute
+	executed int         // number of executed jobs
+	expired  int         // number of FailExpired calls
+	err      error       // error returned by Execute
+}
+
+func (s *fakeJobService) ClaimNext(context.Context, string) (*model.Job, error) {
+	if len(s.queue) == 0 {
+		return nil, nil
+	}
+	job := s.queue[0]
+	s.queue = s.queue[1:]
+	return &job, nil
+}
+
+func (s *fakeJobService) Execute(_ context.Context, job model.Job, _ string) (model.Job, error) {
+	s.executed++
+	return job, s.err
+}
+
+func (s *fakeJobService) FailExpired(context.Context) (int, error) {
+	s.expired++
+	return 0, nil
+}
+
+// maintenanceSwitch is a maintenance mode that can be switched on.
+type maintenanceSwitch struct{ on bool }
+
+func (m *maintenanceSwitch) Enabled() bool { return m.on }
+
+func TestWorker_Poll(t *testing.T) {
+	svc := &fakeJobService{queue: []model.Job{
+		{ID: uuid.New(), Status: model.JobCompleted},
+		{ID: uuid.New(), Status: model.JobFailed},
+		{ID: uuid.New(), Status: model.JobCancelled},
+	}}
+	w := NewWorker(svc, &maintenanceSwitch{}, nil, config.Job{Workers: 2}, clock.NewFake(time.Now()), zap.NewNop())
+
+	w.poll(context.Background(), true)
+
+	assert.Equal(t, 3, svc.executed)
+	assert.Equal(t, 1, svc.expired)
+
+	status := w.Status()
+	assert.Equal(t, 2, status.Workers)
+	assert.Equal(t, int64(1), status.Completed)
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Equal(t, int64(1), status.Cancelled)
+	assert.Zero(t, status.Running)
+	assert.NotNil(t, status.LastPollAt)
+}
+
+func TestWorker_Poll_WithoutExpiry(t *testing.T) {
+	svc := &fakeJobService{}
+	w := NewWorker(svc, &maintenanceSwitch{}, nil, config.Job{}, clock.NewFake(time.Now()), zap.NewNop())
+
+	w.poll(context.Background(), false)
+
+	assert.Zero(t, svc.expired)
+	assert.Equal(t, 1, w.Status().Workers, "non-positive pool size falls back to one worker")
+}
+
+func TestWorker_Poll_Maintenance(t *testing.T) {
+	svc := &fakeJobService{queue: []model.Job{{ID: uuid.New(), Status: model.JobCompleted}}}
+	w := NewWorker(svc, &maintenanceSwitch{on: true}, nil, config.Job{}, clock.NewFake(time.Now()), zap.NewNop())
+
+	w.poll(context.Background(), true)
+
+	assert.Zero(t, svc.executed)
+	assert.Zero(t, svc.expired)
+	assert.Nil(t, w.Status().LastPollAt)
+}
+
+func TestWorker_Poll_RecordError(t *testing.T) {
+	svc := &fakeJobService{
+		queue: []model.Job{{ID: uuid.New(), Status: model.JobCompleted}},
+		err:   errors.New("connection reset"),
+	}
+	w := NewWorker(svc, &maintenanceSwitch{}, nil, config.Job{}, clock.NewFake(time.Now()), zap.NewNop())
+
+	w.poll(context.Background(), false)
+
+	status := w.Status()
+	assert.Equal(t, int64(1), status.Completed)
+	assert.Equal(t, int64(1), status.Errors)
+}
+
+func TestWorker_StartStop(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	svc := &fakeJobService{queue: []model.Job{{ID: uuid.New(), Status: model.JobCompleted}}}
+	w := NewWorker(svc, &maintenanceSwitch{}, nil, config.Job{Workers: 1, PollInterval: time.Second}, clk, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.Start(ctx)
+
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	assert.Eventually(t, func() bool { return w.Status().Completed == 1 }, time.Second, time.Millisecond)
+
+	cancel()
+	w.Stop()
+}
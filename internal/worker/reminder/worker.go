@@ -2,14 +2,21 @@ package reminder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/email"
 	"github.com/aliskhannn/calendar-service/internal/model"
+	reminderrepo "github.com/aliskhannn/calendar-service/internal/repository/reminder"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
 )
 
 // userService defines an interface for fetching user details.
@@ -18,85 +25,274 @@ type userService interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
 }
 
+// preferenceService defines an interface for the notification preferences of users.
+type preferenceService interface {
+	// Subscribed reports whether a user receives notifications of a category.
+	Subscribed(ctx context.Context, userID uuid.UUID, category string) (bool, error)
+
+	// UnsubscribeURL returns the one-click unsubscribe link of a category, or "" if links are not configured.
+	UnsubscribeURL(ctx context.Context, userID uuid.UUID, category string) string
+}
+
+// errUnsubscribed is returned by send when the user unsubscribed from reminders or muted those of the event.
+var errUnsubscribed = errors.New("unsubscribed from reminders")
+
+// reminderService defines an interface for claiming reminders and recording their delivery.
+type reminderService interface {
+	// ClaimDue claims a batch of due reminders for the given worker instance.
+	ClaimDue(ctx context.Context, owner string) ([]model.Reminder, error)
+
+	// MarkSent records a successful delivery of a reminder leased to owner.
+	MarkSent(ctx context.Context, id uuid.UUID, owner string) error
+
+	// MarkSkipped records a reminder leased to owner not sent because its user unsubscribed from reminders.
+	MarkSkipped(ctx context.Context, id uuid.UUID, owner string) error
+
+	// MarkFailed records a failed delivery attempt of a reminder leased to owner.
+	MarkFailed(ctx context.Context, r model.Reminder, owner string, cause error) error
+
+	// Defer queues a reminder leased to owner whose recipient is throttled until retryAt, without counting the attempt.
+	Defer(ctx context.Context, r model.Reminder, owner string, retryAt time.Time, cause error) error
+
+	// Resync recomputes the pending reminders set in a time zone from their wall-clock time.
+	Resync(ctx context.Context) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
 // Sender defines an interface for sending notifications through a channel.
 type Sender interface {
-	// Send sends a notification message to the specified recipient.
-	Send(to string, msg string) error
+	// Send sends a notification with the given subject and body to the specified recipient.
+	// The tenant in ctx is attached to the message, so delivery feedback can be traced back to it.
+	Send(ctx context.Context, to, subject, body string) error
 }
 
-// Worker is responsible for processing reminders from the channel
-// and sending notifications at the scheduled time.
+// Worker is responsible for dispatching due reminders stored in the database.
+// Reminders are claimed with leases, so several service instances can run the worker
+// at the same time without sending duplicates; reminders of a crashed instance are
+// re-delivered once their lease expires.
 type Worker struct {
-	ch          <-chan model.Reminder // channel with reminders
-	userService userService           // service to fetch user info
-	sender      Sender                // interface to send notifications
-	logger      *zap.Logger           // structured logger
-	wg          sync.WaitGroup        // wait group for active reminder goroutines
+	reminderService reminderService   // service to claim reminders and record delivery
+	userService     userService       // service to fetch user info
+	preferences     preferenceService // notification preferences and unsubscribe links of users
+	sender          Sender            // interface to send notifications
+	maintenance     maintenanceMode   // pauses claiming while the service is in maintenance mode
+	tenants         []string          // tenants polled in turn; empty without tenancy
+	clock           clock.Clock       // source of the current time and the poll ticker
+	logger          *zap.Logger       // structured logger
+	owner           string            // identifier of this worker instance
+	wg              sync.WaitGroup    // wait group for the polling loop and in-flight reminders
+
+	inFlight   atomic.Int64 // reminders being sent right now
+	sent       atomic.Int64 // reminders delivered
+	failed     atomic.Int64 // failed delivery attempts
+	deferred   atomic.Int64 // reminders deferred because their recipient domain was throttled
+	skipped    atomic.Int64 // reminders not sent because their user unsubscribed
+	errCount   atomic.Int64 // failures to claim reminders or to record their outcome
+	lastPollAt atomic.Int64 // time of the last poll in Unix nanoseconds; 0 before the first one
 }
 
 // NewWorker creates a new reminder worker.
 func NewWorker(
-	ch <-chan model.Reminder,
+	reminderService reminderService,
 	userService userService,
+	preferences preferenceService,
 	sender Sender,
+	maintenance maintenanceMode,
+	tenants []string,
+	clk clock.Clock,
 	l *zap.Logger,
 ) *Worker {
+	hostname, _ := os.Hostname()
+
 	return &Worker{
-		ch:          ch,
-		userService: userService,
-		sender:      sender,
-		logger:      l,
+		reminderService: reminderService,
+		userService:     userService,
+		preferences:     preferences,
+		sender:          sender,
+		maintenance:     maintenance,
+		tenants:         tenants,
+		clock:           clk,
+		logger:          l,
+		owner:           fmt.Sprintf("%s-%d", hostname, os.Getpid()),
 	}
 }
 
-// Start begins processing reminders in the background.
-// It listens to the reminder channel and launches a goroutine for each reminder.
-func (w *Worker) Start(ctx context.Context) {
+// Start begins dispatching reminders in the background.
+// It first recomputes the reminders set in a time zone, so they follow the DST rules of the
+// running binary, then claims due reminders every interval and sends each of them concurrently.
+// The worker stops when ctx is canceled.
+func (w *Worker) Start(ctx context.Context, interval time.Duration) {
+	ticker := w.clock.NewTicker(interval)
+
+	w.wg.Add(1)
 	go func() {
+		defer w.wg.Done()
+		defer ticker.Stop() // stop the ticker when the goroutine exits
+
+		w.resync(ctx)
+
 		for {
 			select {
-			case r, ok := <-w.ch:
-				if !ok {
-					// Channel closed, wait for all active reminders to finish.
-					w.wg.Wait()
-					return
-				}
-
-				w.wg.Add(1)
-				go w.handleReminder(ctx, r) // process reminder concurrently
+			case <-ticker.C():
+				w.poll(ctx)
 			case <-ctx.Done():
-				// Context cancelled, wait for all active reminders to finish.
-				w.wg.Wait()
+				// Context cancelled, stop claiming new reminders.
+				w.logger.Info("reminder worker stopped")
 				return
 			}
 		}
 	}()
 }
 
-// handleReminder waits until the scheduled reminder time and sends the notification.
+// resync recomputes the zoned reminders of every tenant.
+// A failure is logged and leaves the reminders at their stored time.
+func (w *Worker) resync(ctx context.Context) {
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		tenantID, _ := tenancy.FromContext(tenantCtx)
+
+		updated, err := w.reminderService.Resync(tenantCtx)
+		if err != nil {
+			w.errCount.Add(1)
+			w.logger.Error("failed to resync zoned reminders", zap.String("tenant", tenantID), zap.Error(err))
+			continue
+		}
+		if updated > 0 {
+			w.logger.Info("rescheduled zoned reminders", zap.String("tenant", tenantID), zap.Int("count", updated))
+		}
+	}
+}
+
+// Poll claims a batch of due reminders of every tenant right away instead of waiting for the next interval,
+// so internal services can trigger the delivery of reminders. The reminders are sent in the background;
+// they are not canceled with ctx, and Stop waits for them like for reminders claimed on schedule.
+func (w *Worker) Poll(ctx context.Context) {
+	w.poll(context.WithoutCancel(ctx))
+}
+
+// poll claims a batch of due reminders of every tenant and processes them concurrently.
+// Nothing is claimed while the service is in maintenance mode; due reminders are sent once it ends.
+func (w *Worker) poll(ctx context.Context) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping reminder poll")
+		return
+	}
+
+	w.lastPollAt.Store(w.clock.Now().UnixNano())
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		w.pollTenant(tenantCtx)
+	}
+}
+
+// pollTenant claims a batch of due reminders of the tenant in ctx and processes them concurrently.
+func (w *Worker) pollTenant(ctx context.Context) {
+	// Reminders that cannot be prepared are reported along with the rest of the batch, which is still sent.
+	reminders, err := w.reminderService.ClaimDue(ctx, w.owner)
+	if err != nil {
+		w.errCount.Add(1)
+		tenantID, _ := tenancy.FromContext(ctx)
+		w.logger.Error("failed to claim reminders", zap.String("tenant", tenantID), zap.Error(err))
+	}
+
+	for _, r := range reminders {
+		w.wg.Add(1)
+		w.inFlight.Add(1)
+		go w.handleReminder(ctx, r) // process reminder concurrently
+	}
+}
+
+// handleReminder sends the notification for a claimed reminder and records the outcome.
 func (w *Worker) handleReminder(ctx context.Context, r model.Reminder) {
 	defer w.wg.Done()
+	defer w.inFlight.Add(-1)
+	defer w.recoverPanic()
 
-	duration := time.Until(r.RemindAt)
-	w.logger.Info("waiting for reminder",
-		zap.String("event", r.Message),
-		zap.Time("remind_at", r.RemindAt),
-		zap.Duration("wait_for", duration),
-	)
-	if duration > 0 {
-		select {
-		case <-time.After(duration):
-			// Time to send the reminder.
-		case <-ctx.Done():
-			// Context cancelled before reminder time.
+	// Record the outcome even if shutdown started meanwhile, so the reminder is not re-delivered.
+	recordCtx := context.WithoutCancel(ctx)
+
+	if err := w.send(ctx, r); err != nil {
+		if errors.Is(err, errUnsubscribed) {
+			w.skipped.Add(1)
+			w.logger.Info("reminder skipped, user unsubscribed", zap.String("reminder_id", r.ID.String()))
+
+			if err := w.reminderService.MarkSkipped(recordCtx, r.ID, w.owner); err != nil {
+				w.recordError(r, "failed to mark reminder skipped", err)
+			}
+			return
+		}
+
+		// Reminders to a throttled domain spill over into the queue and are sent once it has slots again.
+		var throttled *email.ThrottledError
+		if errors.As(err, &throttled) {
+			w.deferred.Add(1)
+			w.logger.Info("reminder deferred, recipient domain throttled",
+				zap.String("reminder_id", r.ID.String()),
+				zap.String("domain", throttled.Domain),
+				zap.Time("retry_at", throttled.RetryAt),
+			)
+
+			if err := w.reminderService.Defer(recordCtx, r, w.owner, throttled.RetryAt, err); err != nil {
+				w.recordError(r, "failed to defer reminder", err)
+			}
 			return
 		}
+
+		w.failed.Add(1)
+		w.logger.Warn("failed to send reminder",
+			zap.String("reminder_id", r.ID.String()),
+			zap.Int("attempts", r.Attempts),
+			zap.Error(err),
+		)
+
+		if err := w.reminderService.MarkFailed(recordCtx, r, w.owner, err); err != nil {
+			w.recordError(r, "failed to record reminder failure", err)
+		}
+		return
+	}
+
+	w.sent.Add(1)
+	if err := w.reminderService.MarkSent(recordCtx, r.ID, w.owner); err != nil {
+		w.recordError(r, "failed to mark reminder sent", err)
+	}
+}
+
+// recordError logs a failure to record the outcome of a reminder.
+// A reminder no longer leased to this instance was moved or deleted with its event, or claimed again by another
+// instance after the lease expired while being sent; its outcome is left to its current state.
+func (w *Worker) recordError(r model.Reminder, msg string, err error) {
+	if errors.Is(err, reminderrepo.ErrReminderLost) {
+		w.logger.Info("reminder lease lost while being sent", zap.String("reminder_id", r.ID.String()))
+		return
+	}
+
+	w.errCount.Add(1)
+	w.logger.Error(msg, zap.String("reminder_id", r.ID.String()), zap.Error(err))
+}
+
+// send delivers the reminder message to the user's email address, with the location of the event if it has one
+// and a link to unsubscribe from reminders.
+// It returns errUnsubscribed without sending anything if the user unsubscribed.
+// The notification overrides of the event take precedence over the user's preferences.
+func (w *Worker) send(ctx context.Context, r model.Reminder) error {
+	subscribed, err := w.subscribed(ctx, r)
+	if err != nil {
+		return err
+	}
+	if !subscribed {
+		return errUnsubscribed
+	}
+	if n := r.Notifications; n != nil && n.Channel != "" && n.Channel != model.ChannelEmail {
+		return fmt.Errorf("notification channel %q is not supported", n.Channel)
 	}
 
 	user, err := w.userService.GetByID(ctx, r.UserID)
 	if err != nil {
-		w.logger.Warn("failed to fetch user", zap.Error(err))
-		return
+		return fmt.Errorf("fetch user: %w", err)
 	}
 
 	w.logger.Info("sending reminder",
@@ -105,17 +301,72 @@ func (w *Worker) handleReminder(ctx context.Context, r model.Reminder) {
 	)
 
 	reminderMsg := fmt.Sprintf("🔔 Reminder: your event \"%s\" is coming up!", r.Message)
-	if err := w.sender.Send(user.Email, reminderMsg); err != nil {
-		w.logger.Warn("failed to send reminder message", zap.Error(err))
-	} else {
-		w.logger.Info("reminder sent successfully",
-			zap.String("to", user.Email),
-			zap.String("event", r.Message),
-		)
+	if r.Location != "" {
+		reminderMsg += "\n\nWhere: " + r.Location
 	}
+	subject := fmt.Sprintf("Reminder: %s", r.Message)
+	if link := w.preferences.UnsubscribeURL(ctx, r.UserID, model.NotificationReminders); link != "" {
+		reminderMsg += "\n\nUnsubscribe from reminders: " + link
+		ctx = email.WithHeaders(ctx, email.ListUnsubscribeHeaders(link))
+	}
+	if err := w.sender.Send(ctx, user.Email, subject, reminderMsg); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+
+	w.logger.Info("reminder sent successfully",
+		zap.String("to", user.Email),
+		zap.String("event", r.Message),
+	)
+
+	return nil
+}
+
+// subscribed reports whether the reminder is sent: as set by the notification overrides of the event if they
+// decide it, otherwise as the user's subscription to reminders.
+func (w *Worker) subscribed(ctx context.Context, r model.Reminder) (bool, error) {
+	if n := r.Notifications; n != nil && n.Reminders != nil {
+		return *n.Reminders, nil
+	}
+
+	subscribed, err := w.preferences.Subscribed(ctx, r.UserID, model.NotificationReminders)
+	if err != nil {
+		return false, fmt.Errorf("fetch notification preferences: %w", err)
+	}
+
+	return subscribed, nil
+}
+
+// recoverPanic logs a panic in a reminder goroutine at Error level, so it is reported,
+// instead of crashing the whole process.
+func (w *Worker) recoverPanic() {
+	if rec := recover(); rec != nil {
+		w.logger.Error("reminder worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+	}
+}
+
+// Status reports the activity of the worker since it started.
+//
+// Returns:
+//   - The worker status.
+func (w *Worker) Status() model.ReminderWorkerStatus {
+	status := model.ReminderWorkerStatus{
+		InFlight: w.inFlight.Load(),
+		Sent:     w.sent.Load(),
+		Failed:   w.failed.Load(),
+		Deferred: w.deferred.Load(),
+		Skipped:  w.skipped.Load(),
+		Errors:   w.errCount.Load(),
+	}
+
+	if ns := w.lastPollAt.Load(); ns != 0 {
+		t := time.Unix(0, ns)
+		status.LastPollAt = &t
+	}
+
+	return status
 }
 
-// Stop waits for all active reminder goroutines to finish.
+// Stop waits for the polling loop and all in-flight reminders to finish.
 // Useful for graceful shutdown.
 func (w *Worker) Stop() {
 	w.wg.Wait()
@@ -0,0 +1,294 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/email"
+	"github.com/aliskhannn/calendar-service/internal/model"
+	reminderrepo "github.com/aliskhannn/calendar-service/internal/repository/reminder"
+)
+
+// fakeReminderService hands out the queued reminders once and records their outcome.
+type fakeReminderService struct {
+	mu       sync.Mutex
+	queue    []model.Reminder // reminders returned by the next claim
+	sent     []uuid.UUID      // reminders marked as sent
+	failed   []uuid.UUID      // reminders marked as failed
+	skipped  []uuid.UUID      // reminders marked as skipped
+	defers   []time.Time      // times deferred reminders are retried at
+	owners   []string         // owners the outcomes were recorded for
+	resyncs  int              // number of resync calls
+	markErr  error            // error returned when an outcome is recorded
+	claimErr error            // error returned along with the next claim
+}
+
+func (s *fakeReminderService) ClaimDue(context.Context, string) ([]model.Reminder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claimed := s.queue
+	s.queue = nil
+	return claimed, s.claimErr
+}
+
+func (s *fakeReminderService) MarkSent(_ context.Context, id uuid.UUID, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, id)
+	s.owners = append(s.owners, owner)
+	return s.markErr
+}
+
+func (s *fakeReminderService) MarkSkipped(_ context.Context, id uuid.UUID, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.skipped = append(s.skipped, id)
+	return nil
+}
+
+func (s *fakeReminderService) MarkFailed(_ context.Context, r model.Reminder, _ string, _ error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failed = append(s.failed, r.ID)
+	return nil
+}
+
+func (s *fakeReminderService) Defer(_ context.Context, _ model.Reminder, _ string, retryAt time.Time, _ error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.defers = append(s.defers, retryAt)
+	return nil
+}
+
+func (s *fakeReminderService) Resync(context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resyncs++
+	return 0, nil
+}
+
+// fakeUserService knows every user under the same address.
+type fakeUserService struct{}
+
+func (fakeUserService) GetByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	return &model.User{ID: id, Email: "user@example.com"}, nil
+}
+
+// fakePreferences subscribes every user except the unsubscribed one and hands out links under link.
+type fakePreferences struct {
+	unsubscribed uuid.UUID
+	link         string
+}
+
+func (p fakePreferences) Subscribed(_ context.Context, userID uuid.UUID, _ string) (bool, error) {
+	return p.unsubscribed == uuid.Nil || userID != p.unsubscribed, nil
+}
+
+func (p fakePreferences) UnsubscribeURL(_ context.Context, _ uuid.UUID, category string) string {
+	if p.link == "" {
+		return ""
+	}
+	return p.link + "?category=" + category
+}
+
+// capturingSender records the body and extra headers of the last message.
+type capturingSender struct {
+	body    string
+	headers []email.Header
+}
+
+func (s *capturingSender) Send(ctx context.Context, _, _, body string) error {
+	s.body = body
+	s.headers = email.HeadersFromContext(ctx)
+	return nil
+}
+
+// fakeSender fails for messages of the given event title.
+type fakeSender struct {
+	failFor string
+}
+
+func (s fakeSender) Send(_ context.Context, _, subject, _ string) error {
+	if s.failFor != "" && strings.Contains(subject, s.failFor) {
+		return errors.New("smtp down")
+	}
+	return nil
+}
+
+// throttledSender reports every recipient domain as throttled until retryAt.
+type throttledSender struct {
+	retryAt time.Time
+}
+
+func (s throttledSender) Send(_ context.Context, to, _, _ string) error {
+	return &email.ThrottledError{Domain: to[strings.IndexByte(to, '@')+1:], RetryAt: s.retryAt}
+}
+
+// maintenanceOff is a maintenance mode that is never enabled.
+type maintenanceOff struct{}
+
+func (maintenanceOff) Enabled() bool { return false }
+
+func TestWorker_PollsOnEveryTick(t *testing.T) {
+	clk := clock.NewFake(time.Date(2025, 10, 15, 9, 0, 0, 0, time.UTC))
+	ok, broken := model.Reminder{ID: uuid.New(), Message: "Standup"}, model.Reminder{ID: uuid.New(), Message: "Review"}
+	svc := &fakeReminderService{queue: []model.Reminder{ok, broken}}
+
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{}, fakeSender{failFor: "Review"}, maintenanceOff{}, nil, clk, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.Start(ctx, time.Minute)
+
+	// Nothing is claimed before the first tick.
+	clk.BlockUntil(1)
+	assert.Nil(t, w.Status().LastPollAt)
+
+	clk.Advance(time.Minute)
+	assert.Eventually(t, func() bool {
+		status := w.Status()
+		return status.Sent == 1 && status.Failed == 1 && status.InFlight == 0
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	w.Stop()
+
+	status := w.Status()
+	assert.True(t, clk.Now().Equal(*status.LastPollAt))
+	assert.Zero(t, status.Errors)
+	assert.Equal(t, []uuid.UUID{ok.ID}, svc.sent)
+	assert.Equal(t, []uuid.UUID{broken.ID}, svc.failed)
+	assert.Equal(t, 1, svc.resyncs, "zoned reminders are resynced once on start")
+}
+
+func TestWorker_SendsPartialBatch(t *testing.T) {
+	r := model.Reminder{ID: uuid.New(), Message: "Standup"}
+	svc := &fakeReminderService{queue: []model.Reminder{r}, claimErr: errors.New("decrypt reminder message: bad key")}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{}, fakeSender{}, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	w.pollTenant(context.Background())
+	w.wg.Wait()
+
+	assert.Equal(t, int64(1), w.Status().Errors)
+	assert.Equal(t, []uuid.UUID{r.ID}, svc.sent, "the rest of the batch is sent")
+}
+
+func TestWorker_ReminderLeaseLostWhileSending(t *testing.T) {
+	svc := &fakeReminderService{markErr: fmt.Errorf("mark reminder sent: %w", reminderrepo.ErrReminderLost)}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{}, fakeSender{}, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), model.Reminder{ID: uuid.New(), Message: "Standup"})
+	assert.Zero(t, w.Status().Errors, "a reminder deleted or claimed again meanwhile is not an error")
+	assert.Equal(t, []string{w.owner}, svc.owners, "the outcome is recorded for the lease of this instance")
+
+	svc.markErr = errors.New("connection refused")
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), model.Reminder{ID: uuid.New(), Message: "Standup"})
+	assert.Equal(t, int64(1), w.Status().Errors)
+}
+
+func TestWorker_DefersThrottledReminders(t *testing.T) {
+	retryAt := time.Date(2025, 10, 15, 9, 1, 0, 0, time.UTC)
+	svc := &fakeReminderService{}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{}, throttledSender{retryAt: retryAt}, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), model.Reminder{ID: uuid.New(), Message: "Standup"})
+
+	status := w.Status()
+	assert.Equal(t, int64(1), status.Deferred)
+	assert.Zero(t, status.Failed, "a throttled reminder is not a failed attempt")
+	assert.Empty(t, svc.failed)
+	assert.Equal(t, []time.Time{retryAt}, svc.defers)
+}
+
+func TestWorker_SkipsUnsubscribedReminders(t *testing.T) {
+	userID := uuid.New()
+	svc := &fakeReminderService{}
+	sender := &capturingSender{}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{unsubscribed: userID}, sender, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	r := model.Reminder{ID: uuid.New(), UserID: userID, Message: "Standup"}
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), r)
+
+	status := w.Status()
+	assert.Equal(t, int64(1), status.Skipped)
+	assert.Zero(t, status.Sent)
+	assert.Zero(t, status.Failed)
+	assert.Equal(t, []uuid.UUID{r.ID}, svc.skipped)
+	assert.Empty(t, sender.body, "nothing is sent to an unsubscribed user")
+}
+
+func TestWorker_HonorsEventOverrides(t *testing.T) {
+	unsubscribed, subscribed := uuid.New(), uuid.New()
+	svc := &fakeReminderService{}
+	sender := &capturingSender{}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{unsubscribed: unsubscribed}, sender, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	on, off := true, false
+	forced := model.Reminder{ID: uuid.New(), UserID: unsubscribed, Message: "Flight", Notifications: &model.EventNotifications{Reminders: &on}}
+	muted := model.Reminder{ID: uuid.New(), UserID: subscribed, Message: "Standup", Notifications: &model.EventNotifications{Reminders: &off}}
+	for _, r := range []model.Reminder{forced, muted} {
+		w.wg.Add(1)
+		w.inFlight.Add(1)
+		w.handleReminder(context.Background(), r)
+	}
+
+	// The event decides over the user's subscription, in both directions.
+	assert.Equal(t, []uuid.UUID{forced.ID}, svc.sent)
+	assert.Equal(t, []uuid.UUID{muted.ID}, svc.skipped)
+	assert.Contains(t, sender.body, "Flight")
+}
+
+func TestWorker_AddsUnsubscribeLink(t *testing.T) {
+	svc := &fakeReminderService{}
+	sender := &capturingSender{}
+	prefs := fakePreferences{link: "https://calendar.example.com/unsubscribe"}
+	w := NewWorker(svc, fakeUserService{}, prefs, sender, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), model.Reminder{ID: uuid.New(), UserID: uuid.New(), Message: "Standup"})
+
+	link := "https://calendar.example.com/unsubscribe?category=reminders"
+	assert.Contains(t, sender.body, "Unsubscribe from reminders: "+link)
+	assert.Equal(t, []email.Header{
+		{Name: "List-Unsubscribe", Value: "<" + link + ">"},
+		{Name: "List-Unsubscribe-Post", Value: "List-Unsubscribe=One-Click"},
+	}, sender.headers)
+	assert.Equal(t, int64(1), w.Status().Sent)
+}
+
+func TestWorker_IncludesLocation(t *testing.T) {
+	svc := &fakeReminderService{}
+	sender := &capturingSender{}
+	w := NewWorker(svc, fakeUserService{}, fakePreferences{}, sender, maintenanceOff{}, nil, clock.Real(), zap.NewNop())
+
+	w.wg.Add(1)
+	w.inFlight.Add(1)
+	w.handleReminder(context.Background(), model.Reminder{ID: uuid.New(), UserID: uuid.New(), Message: "Dentist", Location: "Main St 1"})
+
+	assert.Contains(t, sender.body, "Where: Main St 1")
+	assert.Equal(t, int64(1), w.Status().Sent)
+}
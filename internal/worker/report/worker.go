@@ -0,0 +1,98 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// reportService defines an interface for aggregating the meeting load of group members.
+type reportService interface {
+	// AggregateOutdated aggregates the meeting load of outdated members and returns how many were aggregated.
+	AggregateOutdated(ctx context.Context) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
+// Worker is responsible for periodically aggregating the meeting load reports of attendee group owners.
+type Worker struct {
+	service     reportService   // service that aggregates the meeting load
+	maintenance maintenanceMode // skips runs while the service is in maintenance mode
+	tenants     []string        // tenants processed in turn; empty without tenancy
+	clock       clock.Clock     // source of the interval ticker
+	logger      *zap.Logger     // structured logger
+}
+
+// NewWorker creates a new report worker.
+func NewWorker(
+	service reportService,
+	maintenance maintenanceMode,
+	tenants []string,
+	clk clock.Clock,
+	l *zap.Logger,
+) *Worker {
+	return &Worker{
+		service:     service,
+		maintenance: maintenance,
+		tenants:     tenants,
+		clock:       clk,
+		logger:      l,
+	}
+}
+
+// Start begins aggregating the meeting load.
+// It runs a background goroutine that triggers AggregateOutdated
+// at the specified interval. The goroutine stops gracefully when ctx is canceled.
+func (w *Worker) Start(ctx context.Context, interval time.Duration) {
+	ticker := w.clock.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C():
+				w.aggregate(ctx)
+			case <-ctx.Done():
+				w.logger.Info("report worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// aggregate aggregates the meeting load of the outdated members of every tenant.
+// A panic during the run is logged at Error level and does not stop the worker.
+// Runs are skipped while the service is in maintenance mode.
+func (w *Worker) aggregate(ctx context.Context) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping meeting load reports")
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			w.logger.Error("report worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+		}
+	}()
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		tenantID, _ := tenancy.FromContext(tenantCtx)
+
+		aggregated, err := w.service.AggregateOutdated(tenantCtx)
+		if err != nil {
+			w.logger.Error("failed to aggregate meeting load",
+				zap.String("tenant", tenantID), zap.Int("aggregated", aggregated), zap.Error(err))
+		} else if aggregated > 0 {
+			w.logger.Info("aggregated meeting load", zap.String("tenant", tenantID), zap.Int("members", aggregated))
+		}
+	}
+}
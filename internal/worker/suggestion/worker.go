@@ -0,0 +1,98 @@
+package suggestion
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aliskhannn/calendar-service/internal/clock"
+	"github.com/aliskhannn/calendar-service/internal/tenancy"
+)
+
+// suggestionService defines an interface for recomputing suggestion models.
+type suggestionService interface {
+	// RebuildOutdated recomputes outdated suggestion models and returns how many were recomputed.
+	RebuildOutdated(ctx context.Context) (int, error)
+}
+
+// maintenanceMode reports whether the service is in maintenance mode.
+type maintenanceMode interface {
+	// Enabled reports whether maintenance mode is on.
+	Enabled() bool
+}
+
+// Worker is responsible for periodically recomputing the models tags and projects are suggested from.
+type Worker struct {
+	service     suggestionService // service that recomputes the models
+	maintenance maintenanceMode   // skips runs while the service is in maintenance mode
+	tenants     []string          // tenants processed in turn; empty without tenancy
+	clock       clock.Clock       // source of the interval ticker
+	logger      *zap.Logger       // structured logger
+}
+
+// NewWorker creates a new suggestion worker.
+func NewWorker(
+	service suggestionService,
+	maintenance maintenanceMode,
+	tenants []string,
+	clk clock.Clock,
+	l *zap.Logger,
+) *Worker {
+	return &Worker{
+		service:     service,
+		maintenance: maintenance,
+		tenants:     tenants,
+		clock:       clk,
+		logger:      l,
+	}
+}
+
+// Start begins recomputing models.
+// It runs a background goroutine that triggers RebuildOutdated
+// at the specified interval. The goroutine stops gracefully when ctx is canceled.
+func (w *Worker) Start(ctx context.Context, interval time.Duration) {
+	ticker := w.clock.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C():
+				w.rebuild(ctx)
+			case <-ctx.Done():
+				w.logger.Info("suggestion worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// rebuild recomputes the outdated models of every tenant.
+// A panic during the run is logged at Error level and does not stop the worker.
+// Runs are skipped while the service is in maintenance mode.
+func (w *Worker) rebuild(ctx context.Context) {
+	if w.maintenance.Enabled() {
+		w.logger.Debug("maintenance mode, skipping suggestion models")
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			w.logger.Error("suggestion worker panic", zap.Any("panic", rec), zap.Stack("stack"))
+		}
+	}()
+
+	for _, tenantCtx := range tenancy.Contexts(ctx, w.tenants) {
+		tenantID, _ := tenancy.FromContext(tenantCtx)
+
+		rebuilt, err := w.service.RebuildOutdated(tenantCtx)
+		if err != nil {
+			w.logger.Error("failed to recompute suggestion models",
+				zap.String("tenant", tenantID), zap.Int("rebuilt", rebuilt), zap.Error(err))
+		} else if rebuilt > 0 {
+			w.logger.Info("recomputed suggestion models", zap.String("tenant", tenantID), zap.Int("rebuilt", rebuilt))
+		}
+	}
+}
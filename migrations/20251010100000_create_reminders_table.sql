@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminders
+(
+    id           UUID PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    event_id     UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id      UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    message      TEXT        NOT NULL,
+    remind_at    TIMESTAMPTZ NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'pending',
+    attempts     INT         NOT NULL DEFAULT 0,
+    locked_by    TEXT,
+    locked_until TIMESTAMPTZ,
+    last_error   TEXT,
+    sent_at      TIMESTAMPTZ,
+    created_at   TIMESTAMP            DEFAULT now(),
+    updated_at   TIMESTAMP            DEFAULT now()
+);
+
+CREATE INDEX idx_reminders_pending ON reminders (remind_at) WHERE status = 'pending';
+CREATE INDEX idx_reminders_event ON reminders (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminders;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS event_links
+(
+    event_id         UUID NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    related_event_id UUID NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    type             TEXT NOT NULL CHECK (type IN ('follow_up_of', 'blocked_by')),
+    created_at       TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (event_id, related_event_id),
+    CHECK (event_id <> related_event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_links_related ON event_links (related_event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_links;
+-- +goose StatementEnd
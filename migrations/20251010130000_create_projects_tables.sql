@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS projects
+(
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id        UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name           TEXT NOT NULL,
+    milestone_date TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ DEFAULT now(),
+    updated_at     TIMESTAMPTZ DEFAULT now(),
+    UNIQUE (id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_projects_user ON projects (user_id);
+
+CREATE TABLE IF NOT EXISTS project_tasks
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    title      TEXT NOT NULL,
+    due_date   TIMESTAMPTZ,
+    done       BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_tasks_project ON project_tasks (project_id);
+
+-- Events can only be assigned to projects of the same user.
+ALTER TABLE events
+    ADD COLUMN project_id UUID,
+    ADD CONSTRAINT events_project_fk FOREIGN KEY (project_id, user_id) REFERENCES projects (id, user_id);
+
+CREATE INDEX IF NOT EXISTS idx_events_project ON events (project_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP COLUMN IF EXISTS project_id;
+DROP TABLE IF EXISTS project_tasks;
+DROP TABLE IF EXISTS projects;
+-- +goose StatementEnd
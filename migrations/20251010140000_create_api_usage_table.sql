@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS api_usage
+(
+    user_id    UUID   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    period     DATE   NOT NULL, -- first day of the metered month (UTC)
+    calls      BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (user_id, period)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_usage;
+-- +goose StatementEnd
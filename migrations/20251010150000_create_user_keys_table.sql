@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_keys
+(
+    user_id     UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    wrapped_key BYTEA     NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_keys;
+-- +goose StatementEnd
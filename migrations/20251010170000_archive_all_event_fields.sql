@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE archived_events
+    ADD COLUMN priority    TEXT NOT NULL DEFAULT 'normal',
+    ADD COLUMN project_id  UUID,
+    ADD COLUMN reminder_at TIMESTAMPTZ,
+    ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS idx_archived_events_user ON archived_events (user_id);
+
+-- Reminders of archived events, kept with their delivery state so that restoring an event restores them too.
+CREATE TABLE IF NOT EXISTS archived_reminders
+(
+    id         UUID PRIMARY KEY,
+    event_id   UUID        NOT NULL REFERENCES archived_events (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    message    TEXT        NOT NULL,
+    remind_at  TIMESTAMPTZ NOT NULL,
+    status     TEXT        NOT NULL,
+    attempts   INT         NOT NULL,
+    last_error TEXT,
+    sent_at    TIMESTAMPTZ,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_archived_reminders_event ON archived_reminders (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS archived_reminders;
+DROP INDEX IF EXISTS idx_archived_events_user;
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS reminder_at,
+    DROP COLUMN IF EXISTS project_id,
+    DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
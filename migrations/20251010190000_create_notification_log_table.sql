@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notification_log
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider    TEXT        NOT NULL,
+    type        TEXT        NOT NULL,
+    recipient   TEXT        NOT NULL,
+    reason      TEXT        NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_log_recipient ON notification_log (recipient, occurred_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_log;
+-- +goose StatementEnd
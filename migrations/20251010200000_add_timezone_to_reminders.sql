@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reminders set as a wall-clock time in a time zone keep that time and zone,
+-- so their instant can be recomputed when the zone's DST rules change.
+ALTER TABLE events
+    ADD COLUMN reminder_timezone TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE archived_events
+    ADD COLUMN reminder_timezone TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE reminders
+    ADD COLUMN timezone   TEXT,
+    ADD COLUMN local_time TIMESTAMP;
+
+ALTER TABLE archived_reminders
+    ADD COLUMN timezone   TEXT,
+    ADD COLUMN local_time TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE archived_reminders
+    DROP COLUMN IF EXISTS local_time,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE reminders
+    DROP COLUMN IF EXISTS local_time,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS reminder_timezone;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS reminder_timezone;
+-- +goose StatementEnd
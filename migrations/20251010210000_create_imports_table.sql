@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS imports
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id     UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    source      TEXT NOT NULL,
+    status      TEXT NOT NULL DEFAULT 'pending',
+    calendars   INT  NOT NULL DEFAULT 0,
+    total       INT  NOT NULL DEFAULT 0,
+    processed   INT  NOT NULL DEFAULT 0,
+    imported    INT  NOT NULL DEFAULT 0,
+    skipped     INT  NOT NULL DEFAULT 0,
+    error       TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ DEFAULT now(),
+    updated_at  TIMESTAMPTZ DEFAULT now(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_imports_user_id ON imports (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS imports;
+-- +goose StatementEnd
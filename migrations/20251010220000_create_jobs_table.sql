@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id          UUID    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind             TEXT    NOT NULL,
+    status           TEXT    NOT NULL DEFAULT 'queued',
+    payload          BYTEA,
+    total            INT     NOT NULL DEFAULT 0,
+    processed        INT     NOT NULL DEFAULT 0,
+    result           JSONB,
+    error            TEXT    NOT NULL DEFAULT '',
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    locked_by        TEXT,
+    locked_until     TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ DEFAULT now(),
+    started_at       TIMESTAMPTZ,
+    updated_at       TIMESTAMPTZ DEFAULT now(),
+    finished_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_user_id ON jobs (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_jobs_queued ON jobs (created_at) WHERE status = 'queued';
+
+-- Imports run as jobs now; their progress was only kept for polling.
+DROP TABLE IF EXISTS imports;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+
+CREATE TABLE IF NOT EXISTS imports
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id     UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    source      TEXT NOT NULL,
+    status      TEXT NOT NULL DEFAULT 'pending',
+    calendars   INT  NOT NULL DEFAULT 0,
+    total       INT  NOT NULL DEFAULT 0,
+    processed   INT  NOT NULL DEFAULT 0,
+    imported    INT  NOT NULL DEFAULT 0,
+    skipped     INT  NOT NULL DEFAULT 0,
+    error       TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ DEFAULT now(),
+    updated_at  TIMESTAMPTZ DEFAULT now(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_imports_user_id ON imports (user_id);
+-- +goose StatementEnd
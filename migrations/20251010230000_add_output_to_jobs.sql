@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Jobs can produce a file, e.g. a PDF agenda, that is downloaded once the job has completed.
+ALTER TABLE jobs
+    ADD COLUMN output      BYTEA,
+    ADD COLUMN output_type TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+    DROP COLUMN IF EXISTS output_type,
+    DROP COLUMN IF EXISTS output;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN color TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN tags  TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE archived_events
+    ADD COLUMN color TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN tags  TEXT[] NOT NULL DEFAULT '{}';
+
+-- Rules color and tag events matching all of their conditions when they are created or imported.
+CREATE TABLE IF NOT EXISTS event_rules
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       TEXT    NOT NULL,
+    position   INT     NOT NULL DEFAULT 0,
+    conditions JSONB   NOT NULL DEFAULT '[]',
+    color      TEXT    NOT NULL DEFAULT '',
+    tags       TEXT[]  NOT NULL DEFAULT '{}',
+    enabled    BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_rules_user ON event_rules (user_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_rules;
+
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS color;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS color;
+-- +goose StatementEnd
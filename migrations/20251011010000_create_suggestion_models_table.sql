@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyword models tags and projects of new events are suggested from, one per user.
+-- The model is derived from event titles and therefore stored encrypted like them.
+CREATE TABLE IF NOT EXISTS suggestion_models
+(
+    user_id     UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    model       TEXT      NOT NULL,
+    events      INT       NOT NULL DEFAULT 0,
+    computed_at TIMESTAMP NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS suggestion_models;
+-- +goose StatementEnd
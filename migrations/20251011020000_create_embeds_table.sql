@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Share tokens publishing a user's events, or those of one project, for embedding into websites.
+-- Only a hash of the token is stored; the token itself is shown once when the embed is created.
+CREATE TABLE IF NOT EXISTS embeds
+(
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id         UUID   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name            TEXT   NOT NULL,
+    token_hash      TEXT   NOT NULL UNIQUE,
+    project_id      UUID,
+    allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+    range_days      INT    NOT NULL DEFAULT 30,
+    created_at      TIMESTAMPTZ DEFAULT now(),
+    CONSTRAINT embeds_project_fk FOREIGN KEY (project_id, user_id) REFERENCES projects (id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_embeds_user ON embeds (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS embeds;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Short codes sharing basic information of an event with invitees without an account.
+CREATE TABLE IF NOT EXISTS short_links
+(
+    code       TEXT PRIMARY KEY,
+    event_id   UUID NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id    UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    visibility TEXT NOT NULL DEFAULT 'basic',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_short_links_event ON short_links (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS short_links;
+-- +goose StatementEnd
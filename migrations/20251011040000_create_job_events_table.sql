@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events created by a job, so a bulk operation such as an import can be rolled back.
+CREATE TABLE IF NOT EXISTS job_events
+(
+    job_id   UUID NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_events_event ON job_events (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_events;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Secret ICS subscription URLs publishing a user's events, or those of one project, to calendar clients.
+-- Only a hash of the token is stored; regenerating a feed replaces the hash, so the old URL stops working.
+CREATE TABLE IF NOT EXISTS feeds
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    project_id UUID,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    CONSTRAINT feeds_project_fk FOREIGN KEY (project_id, user_id) REFERENCES projects (id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_feeds_user ON feeds (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS feeds;
+-- +goose StatementEnd
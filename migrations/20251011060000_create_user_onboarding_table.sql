@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Sample data created for new users. The sample project and views are remembered, so the
+-- guided setup only counts the steps a user completed themselves.
+CREATE TABLE IF NOT EXISTS user_onboarding
+(
+    user_id    UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    project_id UUID REFERENCES projects (id) ON DELETE SET NULL,
+    view_ids   UUID[] NOT NULL DEFAULT '{}',
+    seeded_at  TIMESTAMPTZ DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_onboarding;
+-- +goose StatementEnd
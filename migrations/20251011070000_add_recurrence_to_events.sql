@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A recurring event is stored once with its RRULE; occurrences are expanded when events are listed.
+-- Deleted and detached occurrences are recorded as exceptions of the series.
+ALTER TABLE events
+    ADD COLUMN recurrence_rule       TEXT          NOT NULL DEFAULT '',
+    ADD COLUMN recurrence_exceptions TIMESTAMPTZ[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE archived_events
+    ADD COLUMN recurrence_rule       TEXT          NOT NULL DEFAULT '',
+    ADD COLUMN recurrence_exceptions TIMESTAMPTZ[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_events_recurring ON events (user_id, event_date) WHERE recurrence_rule <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_recurring;
+
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS recurrence_exceptions,
+    DROP COLUMN IF EXISTS recurrence_rule;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS recurrence_exceptions,
+    DROP COLUMN IF EXISTS recurrence_rule;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE security_events
+    DROP CONSTRAINT IF EXISTS security_events_type_check,
+    ADD CONSTRAINT security_events_type_check CHECK (type IN ('login', 'login_failed', 'password_changed',
+                                                              'two_factor_changed', 'api_key_created',
+                                                              'session_revoked', 'role_changed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM security_events WHERE type = 'role_changed';
+
+ALTER TABLE security_events
+    DROP CONSTRAINT IF EXISTS security_events_type_check,
+    ADD CONSTRAINT security_events_type_check CHECK (type IN ('login', 'login_failed', 'password_changed',
+                                                              'two_factor_changed', 'api_key_created',
+                                                              'session_revoked'));
+-- +goose StatementEnd
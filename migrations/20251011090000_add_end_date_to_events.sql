@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events with a duration store their end; events without one keep a NULL end and last for their date.
+ALTER TABLE events
+    ADD COLUMN end_date TIMESTAMPTZ;
+
+ALTER TABLE archived_events
+    ADD COLUMN end_date TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS end_date;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS end_date;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Throwaway accounts created in demo mode; they are deleted with all their data once they expire.
+ALTER TABLE users
+    ADD COLUMN demo BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS idx_users_demo_created_at ON users (created_at) WHERE demo;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_demo_created_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS demo;
+-- +goose StatementEnd
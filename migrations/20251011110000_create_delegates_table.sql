@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users allowed to create events in the calendar of another user, e.g. an assistant in the calendar of a manager.
+CREATE TABLE IF NOT EXISTS delegates
+(
+    owner_id    UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    delegate_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (owner_id, delegate_id),
+    CONSTRAINT delegates_not_self CHECK (owner_id <> delegate_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_delegates_delegate ON delegates (delegate_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS delegates;
+-- +goose StatementEnd
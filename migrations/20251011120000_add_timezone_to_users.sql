@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- IANA time zone the calendar days of a user are computed in; empty for UTC.
+ALTER TABLE users
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Registered users invited to the events of another user, with their response to the invitation.
+CREATE TABLE IF NOT EXISTS event_attendees
+(
+    event_id     UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id      UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    status       TEXT        NOT NULL DEFAULT 'invited',
+    invited_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    responded_at TIMESTAMPTZ,
+    PRIMARY KEY (event_id, user_id),
+    CONSTRAINT event_attendees_status CHECK (status IN ('invited', 'accepted', 'declined'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_attendees_user ON event_attendees (user_id, event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_attendees;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Notification categories users unsubscribed from or subscribed to again; users without a row are subscribed.
+CREATE TABLE IF NOT EXISTS notification_preferences
+(
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    category   TEXT        NOT NULL,
+    subscribed BOOLEAN     NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, category)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users following events on the shared or public calendars of another user, without attending them.
+CREATE TABLE IF NOT EXISTS event_followers
+(
+    event_id    UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    followed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_followers_user ON event_followers (user_id, event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_followers;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- New times attendees propose for an event, accepted or declined by its owner.
+CREATE TABLE IF NOT EXISTS event_proposals
+(
+    id         UUID PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    event_id   UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_date TIMESTAMPTZ NOT NULL,
+    end_date   TIMESTAMPTZ,
+    status     TEXT        NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    decided_at TIMESTAMPTZ,
+    CONSTRAINT event_proposals_status CHECK (status IN ('pending', 'accepted', 'declined'))
+);
+
+-- An attendee has at most one pending proposal per event; proposing again replaces it.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_proposals_pending ON event_proposals (event_id, user_id) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_proposals;
+-- +goose StatementEnd
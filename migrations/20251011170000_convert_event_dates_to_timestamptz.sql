@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Event dates become instants in UTC; the time zone they are shown in is the user's.
+-- Existing dates carry no time zone and are read as UTC midnight. They are recorded in legacy_event_dates,
+-- so the timezone migration job can reinterpret them as midnight in the time zone they were entered in.
+ALTER TABLE events
+    ALTER COLUMN event_date TYPE TIMESTAMPTZ USING event_date::timestamp AT TIME ZONE 'UTC';
+
+ALTER TABLE archived_events
+    ALTER COLUMN event_date TYPE TIMESTAMPTZ USING event_date::timestamp AT TIME ZONE 'UTC';
+
+CREATE TABLE IF NOT EXISTS legacy_event_dates
+(
+    event_id      UUID PRIMARY KEY REFERENCES events (id) ON DELETE CASCADE,
+    original_date DATE NOT NULL
+);
+
+INSERT INTO legacy_event_dates (event_id, original_date)
+SELECT id, (event_date AT TIME ZONE 'UTC')::date
+FROM events;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS legacy_event_dates;
+
+ALTER TABLE archived_events
+    ALTER COLUMN event_date TYPE DATE USING (event_date AT TIME ZONE 'UTC')::date;
+
+ALTER TABLE events
+    ALTER COLUMN event_date TYPE DATE USING (event_date AT TIME ZONE 'UTC')::date;
+-- +goose StatementEnd
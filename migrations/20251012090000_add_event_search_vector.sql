@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Full-text search over the titles and descriptions of events; title matches rank above description matches.
+-- Values encrypted at rest are left out, since their ciphertext carries no words.
+ALTER TABLE events
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', CASE WHEN title LIKE 'enc:v1:%' THEN '' ELSE title END), 'A') ||
+        setweight(to_tsvector('english', CASE WHEN description LIKE 'enc:v1:%' THEN '' ELSE COALESCE(description, '') END), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_search_vector;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Accent-insensitive configuration without stemming, used for users who have not chosen a search language.
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE TEXT SEARCH CONFIGURATION simple_unaccent (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION simple_unaccent
+    ALTER MAPPING FOR asciiword, asciihword, hword_asciipart, word, hword, hword_part WITH unaccent, simple;
+
+-- Text search configuration of the events of a user, named after the language of its stemming; empty for simple_unaccent.
+-- Existing users keep the English stemming search was introduced with.
+ALTER TABLE users
+    ADD COLUMN search_language TEXT NOT NULL DEFAULT '';
+UPDATE users SET search_language = 'english';
+
+-- text_search_config resolves a search language of a user to its text search configuration.
+CREATE FUNCTION text_search_config(language TEXT) RETURNS REGCONFIG
+    LANGUAGE sql STABLE
+    AS $$ SELECT COALESCE(NULLIF(language, ''), 'simple_unaccent')::regconfig $$;
+
+-- The configuration is stored with each event, since generated columns cannot read the users table.
+ALTER TABLE events
+    ADD COLUMN search_config REGCONFIG NOT NULL DEFAULT 'simple_unaccent';
+UPDATE events e SET search_config = text_search_config(u.search_language) FROM users u WHERE u.id = e.user_id;
+
+-- Events take the configuration of their owner on insert, whichever statement inserts them.
+CREATE FUNCTION events_set_search_config() RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.search_config := text_search_config((SELECT search_language FROM users WHERE id = NEW.user_id));
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER events_search_config
+    BEFORE INSERT ON events
+    FOR EACH ROW EXECUTE FUNCTION events_set_search_config();
+
+-- The expression of a generated column cannot be altered, so the column is rebuilt.
+DROP INDEX IF EXISTS idx_events_search_vector;
+ALTER TABLE events
+    DROP COLUMN search_vector;
+ALTER TABLE events
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector(search_config, CASE WHEN title LIKE 'enc:v1:%' THEN '' ELSE title END), 'A') ||
+        setweight(to_tsvector(search_config, CASE WHEN description LIKE 'enc:v1:%' THEN '' ELSE COALESCE(description, '') END), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_search_vector;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE events
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', CASE WHEN title LIKE 'enc:v1:%' THEN '' ELSE title END), 'A') ||
+        setweight(to_tsvector('english', CASE WHEN description LIKE 'enc:v1:%' THEN '' ELSE COALESCE(description, '') END), 'B')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+
+DROP TRIGGER IF EXISTS events_search_config ON events;
+DROP FUNCTION IF EXISTS events_set_search_config();
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_config;
+DROP FUNCTION IF EXISTS text_search_config(TEXT);
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS search_language;
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS simple_unaccent;
+-- +goose StatementEnd
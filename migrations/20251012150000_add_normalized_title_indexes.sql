@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- normalize_text lowercases a text and strips its accents, so "Café" and "cafe" compare equal.
+-- unaccent itself is only stable, since its dictionary can be changed; pinning the dictionary makes the wrapper
+-- usable in indexes. The extension lives in public, which is on the search path of every tenant.
+CREATE FUNCTION normalize_text(value TEXT) RETURNS TEXT
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+    AS $$ SELECT lower(public.unaccent('public.unaccent'::regdictionary, value)) $$;
+
+-- Title suggestions match a prefix of the normalized title, or of the title itself in strict mode.
+CREATE INDEX IF NOT EXISTS idx_events_title_normalized ON events (user_id, normalize_text(title) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_events_title ON events (user_id, title text_pattern_ops);
+
+-- Full-text search ignores accents in every search language, not only without one.
+DROP INDEX IF EXISTS idx_events_search_vector;
+ALTER TABLE events
+    DROP COLUMN search_vector;
+ALTER TABLE events
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector(search_config, normalize_text(CASE WHEN title LIKE 'enc:v1:%' THEN '' ELSE title END)), 'A') ||
+        setweight(to_tsvector(search_config, normalize_text(CASE WHEN description LIKE 'enc:v1:%' THEN '' ELSE COALESCE(description, '') END)), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_search_vector;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE events
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector(search_config, CASE WHEN title LIKE 'enc:v1:%' THEN '' ELSE title END), 'A') ||
+        setweight(to_tsvector(search_config, CASE WHEN description LIKE 'enc:v1:%' THEN '' ELSE COALESCE(description, '') END), 'B')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+
+DROP INDEX IF EXISTS idx_events_title;
+DROP INDEX IF EXISTS idx_events_title_normalized;
+DROP FUNCTION IF EXISTS normalize_text(TEXT);
+-- +goose StatementEnd
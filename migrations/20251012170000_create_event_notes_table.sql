@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Private notes of users on the events they can see, shown to their author only.
+CREATE TABLE IF NOT EXISTS event_notes
+(
+    event_id   UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    text       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_notes_user ON event_notes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_notes;
+-- +goose StatementEnd
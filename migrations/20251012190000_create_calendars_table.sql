@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS calendars
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    color      TEXT NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    UNIQUE (id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendars_user ON calendars (user_id);
+
+-- Every user has exactly one default calendar, created at registration.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendars_default ON calendars (user_id) WHERE is_default;
+
+INSERT INTO calendars (user_id, name, is_default)
+SELECT id, 'Personal', true
+FROM users;
+
+-- Events can only be assigned to calendars of the same user.
+ALTER TABLE events
+    ADD COLUMN calendar_id UUID,
+    ADD CONSTRAINT events_calendar_fk FOREIGN KEY (calendar_id, user_id) REFERENCES calendars (id, user_id);
+
+UPDATE events e SET calendar_id = c.id FROM calendars c WHERE c.user_id = e.user_id AND c.is_default;
+
+ALTER TABLE events
+    ALTER COLUMN calendar_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_events_calendar ON events (calendar_id);
+
+ALTER TABLE archived_events
+    ADD COLUMN calendar_id UUID;
+
+UPDATE archived_events e SET calendar_id = c.id FROM calendars c WHERE c.user_id = e.user_id AND c.is_default;
+
+-- Events inserted without a calendar, including restored events whose calendar was deleted, go to the default
+-- calendar of their owner.
+CREATE FUNCTION events_set_calendar() RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    IF NEW.calendar_id IS NULL THEN
+        NEW.calendar_id := (SELECT id FROM calendars WHERE user_id = NEW.user_id AND is_default);
+    END IF;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER events_calendar
+    BEFORE INSERT ON events
+    FOR EACH ROW EXECUTE FUNCTION events_set_calendar();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS events_calendar ON events;
+DROP FUNCTION IF EXISTS events_set_calendar();
+
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS calendar_id;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS calendar_id;
+
+DROP TABLE IF EXISTS calendars;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Archived events exported to cold storage, with the object holding each of them, so they can be restored.
+-- The events themselves, with their reminders, live only in the object store.
+CREATE TABLE IF NOT EXISTS cold_storage_events
+(
+    event_id    UUID PRIMARY KEY,
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    object_key  TEXT        NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL,
+    exported_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_cold_storage_events_user ON cold_storage_events (user_id);
+CREATE INDEX IF NOT EXISTS idx_cold_storage_events_object ON cold_storage_events (object_key);
+
+-- Exports select the events archived before a cutoff.
+CREATE INDEX IF NOT EXISTS idx_archived_events_archived_at ON archived_events (archived_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_archived_events_archived_at;
+DROP TABLE IF EXISTS cold_storage_events;
+-- +goose StatementEnd
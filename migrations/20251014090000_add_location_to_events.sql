@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The place of an event as free text, with optional coordinates for maps; both coordinates are set or neither.
+ALTER TABLE events
+    ADD COLUMN location  TEXT NOT NULL DEFAULT '',
+    ADD COLUMN latitude  DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD CONSTRAINT events_coordinates CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+ALTER TABLE archived_events
+    ADD COLUMN location  TEXT NOT NULL DEFAULT '',
+    ADD COLUMN latitude  DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS location;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_coordinates,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS location;
+-- +goose StatementEnd
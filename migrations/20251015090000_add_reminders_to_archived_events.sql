@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- New archive format: the reminders of an archived event are stored with it, as a JSON array ordered by reminder ID.
+-- NULL until the row has been written in the new format, by the archiver in dual-write mode or by a backfill;
+-- archived_reminders stays the source of truth until the formats have been verified to match.
+ALTER TABLE archived_events
+    ADD COLUMN reminders JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS reminders;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- People a user invites to events, ranked by how often and how recently they were invited, for attendee
+-- autocomplete. Contacts are added automatically when an invitation is sent, or manually.
+CREATE TABLE IF NOT EXISTS contacts
+(
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id         UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email           TEXT        NOT NULL,
+    name            TEXT        NOT NULL DEFAULT '',
+    contact_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    invite_count    INTEGER     NOT NULL DEFAULT 0,
+    last_invited_at TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, email)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contacts_rank ON contacts (user_id, invite_count DESC, last_invited_at DESC);
+
+-- Seed the contacts from the invitations sent so far.
+INSERT INTO contacts (user_id, email, name, contact_user_id, invite_count, last_invited_at)
+SELECT e.user_id, lower(u.email), u.name, u.id, COUNT(*), max(a.invited_at)
+FROM event_attendees a
+JOIN events e ON e.id = a.event_id
+JOIN users u ON u.id = a.user_id
+GROUP BY e.user_id, u.id
+ON CONFLICT (user_id, email) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS contacts;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Named lists of registered users, e.g. "Backend team", that a user invites to events in one step.
+CREATE TABLE IF NOT EXISTS attendee_groups
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS attendee_group_members
+(
+    group_id UUID        NOT NULL REFERENCES attendee_groups (id) ON DELETE CASCADE,
+    user_id  UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_id, user_id)
+);
+
+-- Groups invited to events; changes of their membership are applied to the events that have not started yet.
+CREATE TABLE IF NOT EXISTS event_attendee_groups
+(
+    event_id   UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    group_id   UUID        NOT NULL REFERENCES attendee_groups (id) ON DELETE CASCADE,
+    invited_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, group_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_attendee_groups_group ON event_attendee_groups (group_id);
+
+-- The group an attendee was invited through; NULL for individual invitations, which removing a member of the
+-- group leaves alone. Deleting a group keeps the invitations it sent.
+ALTER TABLE event_attendees
+    ADD COLUMN group_id UUID REFERENCES attendee_groups (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE event_attendees
+    DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS event_attendee_groups;
+DROP TABLE IF EXISTS attendee_group_members;
+DROP TABLE IF EXISTS attendee_groups;
+-- +goose StatementEnd
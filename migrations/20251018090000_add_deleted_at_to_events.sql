@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleted events go to the trash: they keep their row, with the time they were deleted, until they are restored
+-- or the archiver purges them after the trash retention.
+ALTER TABLE events
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_events_trash ON events (user_id, deleted_at DESC) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_trash;
+
+DELETE FROM events WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
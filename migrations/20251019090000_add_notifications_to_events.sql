@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Notification overrides of an event for its owner (channel, lead time, reminders), as a JSON object;
+-- NULL follows the user's notification preferences.
+ALTER TABLE events
+    ADD COLUMN notifications JSONB;
+
+ALTER TABLE archived_events
+    ADD COLUMN notifications JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE archived_events
+    DROP COLUMN IF EXISTS notifications;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS notifications;
+-- +goose StatementEnd
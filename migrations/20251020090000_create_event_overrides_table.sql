@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Occurrences of recurring events moved to another time or retitled, keyed by the start the rule generates for them.
+-- Titles are encrypted like event titles; an empty title keeps the title of the series, a NULL end its duration.
+CREATE TABLE IF NOT EXISTS event_overrides
+(
+    event_id   UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    occurrence TIMESTAMPTZ NOT NULL,
+    event_date TIMESTAMPTZ NOT NULL,
+    end_date   TIMESTAMPTZ,
+    title      TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, occurrence)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_overrides;
+-- +goose StatementEnd
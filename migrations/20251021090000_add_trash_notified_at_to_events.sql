@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Owners are notified before their trashed events are purged; the archiver only purges events whose owner was
+-- notified at least the notice period before. Restoring an event clears the notice.
+ALTER TABLE events
+    ADD COLUMN trash_notified_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP COLUMN IF EXISTS trash_notified_at;
+-- +goose StatementEnd
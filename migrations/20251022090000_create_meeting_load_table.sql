@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Weekly meeting load of the members of attendee groups, aggregated by the report worker for the meeting load
+-- reports of the group owners. Weeks start on Monday in the time zone of the member; every week of the aggregated
+-- window has a row, so computed_at tells when the member was last aggregated.
+CREATE TABLE IF NOT EXISTS meeting_load
+(
+    user_id         UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    week            DATE        NOT NULL,
+    meetings        INT         NOT NULL DEFAULT 0,
+    meeting_minutes INT         NOT NULL DEFAULT 0,
+    workday_minutes INT         NOT NULL DEFAULT 0,
+    after_hours     INT         NOT NULL DEFAULT 0,
+    computed_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, week)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_load;
+-- +goose StatementEnd
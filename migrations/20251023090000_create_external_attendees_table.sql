@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- People without an account invited to events by email address. They answer through the RSVP link of their
+-- invitation, identified by the hash of its token; registering with the address turns them into event_attendees.
+CREATE TABLE IF NOT EXISTS external_attendees
+(
+    event_id     UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    email        TEXT        NOT NULL,
+    token_hash   TEXT        NOT NULL UNIQUE,
+    status       TEXT        NOT NULL DEFAULT 'invited',
+    invited_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    responded_at TIMESTAMPTZ,
+    PRIMARY KEY (event_id, email),
+    CONSTRAINT external_attendees_status CHECK (status IN ('invited', 'accepted', 'declined'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_attendees_email ON external_attendees (email);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS external_attendees;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Attendees who accepted an event can report that they are running late, with the time they expect to arrive.
+-- The organizer and the other attendees are notified; a new report replaces the previous one.
+ALTER TABLE event_attendees
+    ADD COLUMN late_eta TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE event_attendees
+    DROP COLUMN IF EXISTS late_eta;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Guests without an account answering the invitation of an event shared by a short link. A guest asks for an
+-- RSVP link by email address; their answer counts once they open the emailed link, identified by the hash of its
+-- token, before it expires. Asking again sends a new link, at most once per cooldown, which replaces the old one.
+CREATE TABLE IF NOT EXISTS event_guests
+(
+    event_id         UUID        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    email            TEXT        NOT NULL,
+    token_hash       TEXT        NOT NULL UNIQUE,
+    token_expires_at TIMESTAMPTZ NOT NULL,
+    status           TEXT        NOT NULL DEFAULT 'pending',
+    requested_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    responded_at     TIMESTAMPTZ,
+    PRIMARY KEY (event_id, email),
+    CONSTRAINT event_guests_status CHECK (status IN ('pending', 'accepted', 'declined'))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_guests;
+-- +goose StatementEnd